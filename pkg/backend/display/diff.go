@@ -223,6 +223,14 @@ func renderSummaryEvent(event engine.SummaryEventPayload, wroteDiagnosticHeader
 		fprintfIgnoreError(out, "\n")
 	}
 
+	// For previews, print the total of any cost estimates that providers made for the proposed changes.
+	if event.IsPreview && event.EstimatedCost != nil {
+		cost := event.EstimatedCost
+		fprintIgnoreError(out, opts.Color.Colorize(fmt.Sprintf(
+			"\n%sEstimated monthly cost:%s $%.2f (%.0f%% confidence)\n",
+			colors.SpecHeadline, colors.Reset, cost.MonthlyCostUSD, cost.Confidence*100)))
+	}
+
 	// Print policy packs loaded. Data is rendered as a table of {policy-pack-name, version}.
 	renderPolicyPacks(out, event.PolicyPacks, opts)
 
@@ -321,6 +329,8 @@ func renderDiff(
 			PrintObject(
 				&buf, metadata.Old.Inputs, planning, indent+1, deploy.OpSame, true /*prefix*/, opts.TruncateOutput, debug)
 		}
+		writeString(&buf, getDetailedDiffReasons(metadata, indent+1))
+		writeString(&buf, getIgnoredDetailedDiffs(metadata, indent+1))
 		details = buf.String()
 	} else {
 		details = getResourcePropertiesDetails(
@@ -332,6 +342,54 @@ func renderDiff(
 	fprintIgnoreError(out, opts.Color.Colorize(colors.Reset))
 }
 
+// getDetailedDiffReasons returns the explanations that the provider gave for the entries in the step's detailed diff,
+// sorted by property path, or the empty string if the provider gave none.
+func getDetailedDiffReasons(step engine.StepEventMetadata, indent int) string {
+	var paths []string
+	for path, diff := range step.DetailedDiff {
+		if diff.Reason != "" {
+			paths = append(paths, path)
+		}
+	}
+	if len(paths) == 0 {
+		return ""
+	}
+	sort.Strings(paths)
+
+	var b bytes.Buffer
+	writeWithIndentNoPrefix(&b, indent, step.Op, "--reasons:--\n")
+	for _, path := range paths {
+		writeWithIndentNoPrefix(&b, indent, step.Op, "%s: %s\n", path, step.DetailedDiff[path].Reason)
+	}
+	return b.String()
+}
+
+// getIgnoredDetailedDiffs returns the entries in the step's detailed diff that the resource's ignoreChanges option
+// covers, sorted by property path and rendered in a muted style, or the empty string if there are none.
+func getIgnoredDetailedDiffs(step engine.StepEventMetadata, indent int) string {
+	var paths []string
+	for path, diff := range step.DetailedDiff {
+		if diff.Ignored {
+			paths = append(paths, path)
+		}
+	}
+	if len(paths) == 0 {
+		return ""
+	}
+	sort.Strings(paths)
+
+	var b bytes.Buffer
+	writeString(&b, colors.SpecUnimportant)
+	writeString(&b, getIndentationString(indent, step.Op, false))
+	writeString(&b, "--ignored changes:--\n")
+	for _, path := range paths {
+		writeString(&b, getIndentationString(indent, step.Op, false))
+		writeString(&b, fmt.Sprintf("%s: %s\n", path, step.DetailedDiff[path].Kind))
+	}
+	writeString(&b, colors.Reset)
+	return b.String()
+}
+
 func renderDiffResourcePreEvent(
 	payload engine.ResourcePreEventPayload,
 	seen map[resource.URN]engine.StepEventMetadata,
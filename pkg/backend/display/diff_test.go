@@ -10,8 +10,10 @@ import (
 	"testing"
 
 	"github.com/pulumi/pulumi/pkg/v3/engine"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/diag/colors"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
 
@@ -137,3 +139,24 @@ func TestDiffEvents(t *testing.T) {
 		})
 	}
 }
+
+func TestGetIgnoredDetailedDiffs(t *testing.T) {
+	t.Parallel()
+
+	step := engine.StepEventMetadata{
+		Op: deploy.OpUpdate,
+		DetailedDiff: map[string]plugin.PropertyDiff{
+			"tags.Name": {Kind: plugin.DiffUpdate, Ignored: true},
+			"size":      {Kind: plugin.DiffUpdate},
+			"arn":       {Kind: plugin.DiffDelete, Ignored: true},
+		},
+	}
+	assert.Equal(t, colors.SpecUnimportant+
+		"    --ignored changes:--\n"+
+		"    arn: delete\n"+
+		"    tags.Name: update\n"+
+		colors.Reset, getIgnoredDetailedDiffs(step, 1))
+
+	step.DetailedDiff = map[string]plugin.PropertyDiff{"size": {Kind: plugin.DiffUpdate}}
+	assert.Equal(t, "", getIgnoredDetailedDiffs(step, 1))
+}
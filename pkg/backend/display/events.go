@@ -176,6 +176,8 @@ func convertStepEventMetadata(md engine.StepEventMetadata, showSecrets bool) api
 			detailedDiff[k] = apitype.PropertyDiff{
 				Kind:      d,
 				InputDiff: v.InputDiff,
+				Reason:    v.Reason,
+				Ignored:   v.Ignored,
 			}
 		}
 	}
@@ -357,6 +359,8 @@ func convertJSONStepEventMetadata(md apitype.StepEventMetadata) engine.StepEvent
 			detailedDiff[k] = plugin.PropertyDiff{
 				Kind:      d,
 				InputDiff: v.InputDiff,
+				Reason:    v.Reason,
+				Ignored:   v.Ignored,
 			}
 		}
 	}
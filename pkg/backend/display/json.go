@@ -161,6 +161,8 @@ func ShowPreviewDigest(events <-chan engine.Event, done chan<- bool, opts Option
 						detailedDiff[k] = display.PropertyDiff{
 							Kind:      v.Kind.String(),
 							InputDiff: v.InputDiff,
+							Reason:    v.Reason,
+							Ignored:   v.Ignored,
 						}
 					}
 				}
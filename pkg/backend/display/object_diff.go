@@ -228,7 +228,7 @@ func PrintObject(
 
 func (p *propertyPrinter) printObject(props resource.PropertyMap) {
 	// Compute the maximum width of property keys so we can justify everything.
-	keys := props.StableKeys()
+	keys := props.Keys()
 	maxkey := maxKey(keys)
 
 	// Now print out the values intelligently based on the type.
@@ -408,7 +408,7 @@ func getResourceOutputsPropertiesString(
 
 	var keys []resource.PropertyKey
 	if outputDiff == nil {
-		keys = outs.StableKeys()
+		keys = outs.Keys()
 	} else {
 		keys = outputDiff.Keys()
 	}
@@ -216,6 +216,12 @@ func (ssm *sameSnapshotMutation) mustWrite(step *deploy.SameStep) bool {
 		return true
 	}
 
+	// We need to persist the changes if the state was migrated to a new schema version
+	if old.SchemaVersion != new.SchemaVersion {
+		logging.V(9).Infof("SnapshotManager: mustWrite() true because of SchemaVersion")
+		return true
+	}
+
 	contract.Assert(old.ID == new.ID)
 
 	// If this resource's provider has changed, we must write the checkpoint. This can happen in scenarios involving
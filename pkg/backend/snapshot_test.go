@@ -15,6 +15,7 @@
 package backend
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -279,7 +280,7 @@ func TestWriteCheckpointOnceUnsafe(t *testing.T) {
 	provSame := deploy.NewSameStep(nil, nil, provider, provUpdated)
 	mutation, err := manager.BeginMutation(provSame)
 	assert.NoError(t, err)
-	_, _, err = provSame.Apply(false)
+	_, _, err = provSame.Apply(context.Background(), false)
 	assert.NoError(t, err)
 	err = mutation.End(provSame, true)
 	assert.NoError(t, err)
@@ -356,7 +357,7 @@ func TestSamesWithOtherMeaningfulChanges(t *testing.T) {
 		provSame := deploy.NewSameStep(nil, nil, provider, provUpdated)
 		mutation, err := manager.BeginMutation(provSame)
 		assert.NoError(t, err)
-		_, _, err = provSame.Apply(false)
+		_, _, err = provSame.Apply(context.Background(), false)
 		assert.NoError(t, err)
 		err = mutation.End(provSame, true)
 		assert.NoError(t, err)
@@ -413,7 +414,7 @@ func TestSamesWithOtherMeaningfulChanges(t *testing.T) {
 		provSame := deploy.NewSameStep(nil, nil, provider, provUpdated)
 		mutation, err := manager.BeginMutation(provSame)
 		assert.NoError(t, err)
-		_, _, err = provSame.Apply(false)
+		_, _, err = provSame.Apply(context.Background(), false)
 		assert.NoError(t, err)
 		err = mutation.End(provSame, true)
 		assert.NoError(t, err)
@@ -425,7 +426,7 @@ func TestSamesWithOtherMeaningfulChanges(t *testing.T) {
 		prov2Same := deploy.NewSameStep(nil, nil, provider2, prov2Updated)
 		mutation, err = manager.BeginMutation(prov2Same)
 		assert.NoError(t, err)
-		_, _, err = prov2Same.Apply(false)
+		_, _, err = prov2Same.Apply(context.Background(), false)
 		assert.NoError(t, err)
 		err = mutation.End(prov2Same, true)
 		assert.NoError(t, err)
@@ -435,7 +436,7 @@ func TestSamesWithOtherMeaningfulChanges(t *testing.T) {
 		aSame := deploy.NewSameStep(nil, nil, resourceA, c)
 		mutation, err = manager.BeginMutation(aSame)
 		assert.NoError(t, err)
-		_, _, err = aSame.Apply(false)
+		_, _, err = aSame.Apply(context.Background(), false)
 		assert.NoError(t, err)
 		err = mutation.End(aSame, true)
 		assert.NoError(t, err)
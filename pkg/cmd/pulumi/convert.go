@@ -88,7 +88,7 @@ func runConvertPcl(cwd string, outDir string) result.Result {
 		return result.FromError(fmt.Errorf("could not create plugin host: %w", err))
 	}
 	defer contract.IgnoreClose(host)
-	loader := schema.NewPluginLoader(host)
+	loader := schema.NewPluginLoader(commandContext(), host)
 	_, template, diags, err := yamlgen.LoadTemplate(cwd)
 	if err != nil {
 		return result.FromError(err)
@@ -159,7 +159,7 @@ func runConvert(cwd string, language string, outDir string, generateOnly bool) r
 		return result.FromError(fmt.Errorf("could not create plugin host: %w", err))
 	}
 	defer contract.IgnoreClose(host)
-	loader := schema.NewPluginLoader(host)
+	loader := schema.NewPluginLoader(commandContext(), host)
 	proj, pclProgram, err := yamlgen.Eject(cwd, loader)
 	if err != nil {
 		return result.FromError(fmt.Errorf("could not load yaml program: %w", err))
@@ -260,7 +260,7 @@ func generateImportedDefinitions(out io.Writer, stackName tokens.Name, projectNa
 	if err != nil {
 		return false, err
 	}
-	loader := schema.NewPluginLoader(ctx.Host)
+	loader := schema.NewPluginLoader(commandContext(), ctx.Host)
 	return true, importer.GenerateLanguageDefinitions(out, loader, func(w io.Writer, p *pcl.Program) error {
 		files, _, err := programGenerator(p)
 		if err != nil {
@@ -303,6 +303,7 @@ func newImportCmd() *cobra.Command {
 	var yes bool
 	var protectResources bool
 	var properties []string
+	var showImportNotes bool
 
 	cmd := &cobra.Command{
 		Use:   "import [type] [name] [id]",
@@ -498,9 +499,10 @@ func newImportCmd() *cobra.Command {
 			}
 
 			opts.Engine = engine.UpdateOptions{
-				Parallel:      parallel,
-				Debug:         debug,
-				UseLegacyDiff: useLegacyDiff(),
+				Parallel:        parallel,
+				Debug:           debug,
+				UseLegacyDiff:   useLegacyDiff(),
+				ShowImportNotes: showImportNotes,
 			}
 
 			_, res := s.Import(ctx, backend.UpdateOperation{
@@ -611,6 +613,9 @@ func newImportCmd() *cobra.Command {
 	cmd.PersistentFlags().BoolVarP(
 		&protectResources, "protect", "", true,
 		"Allow resources to be imported with protection from deletion enabled")
+	cmd.PersistentFlags().BoolVar(
+		&showImportNotes, "show-import-notes", false,
+		"Display the notes that providers report about the resources being imported")
 
 	if hasDebugCommands() {
 		cmd.PersistentFlags().StringVar(
@@ -15,6 +15,7 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/fs"
@@ -120,7 +121,7 @@ func schemaFromSchemaSource(packageSource string) (*schema.Package, error) {
 			return nil, err
 		}
 		// We assume this was a plugin and not a path, so load the plugin.
-		schema, err := schema.NewPluginLoader(host).LoadPackage(pkg, version)
+		schema, err := schema.NewPluginLoader(commandContext(), host).LoadPackage(pkg, version)
 		if err != nil {
 			// There is an executable with the same name, so suggest that
 			if info, statErr := os.Stat(pkg); statErr == nil && isExecutable(info) {
@@ -154,11 +155,11 @@ func schemaFromSchemaSource(packageSource string) (*schema.Package, error) {
 		return nil, err
 	}
 	defer p.Close()
-	bytes, err := p.GetSchema(0)
+	schema, err := p.GetSchema(context.TODO(), 0)
 	if err != nil {
 		return nil, err
 	}
-	err = json.Unmarshal(bytes, &spec)
+	err = json.Unmarshal(schema.Bytes, &spec)
 	if err != nil {
 		return nil, err
 	}
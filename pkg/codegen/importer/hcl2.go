@@ -645,7 +645,7 @@ func generateValue(typ schema.Type, value resource.PropertyValue) (model.Express
 				elementType = mapType.ElementType
 			}
 
-			for _, k := range obj.StableKeys() {
+			for _, k := range obj.Keys() {
 				// Ignore internal properties.
 				if strings.HasPrefix(string(k), "__") {
 					continue
@@ -15,6 +15,7 @@
 package pcl
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"sort"
@@ -73,7 +74,7 @@ func SkipResourceTypechecking(options *bindOptions) {
 }
 
 func PluginHost(host plugin.Host) BindOption {
-	return Loader(schema.NewPluginLoader(host))
+	return Loader(schema.NewPluginLoader(context.Background(), host))
 }
 
 func Loader(loader schema.Loader) BindOption {
@@ -108,7 +109,7 @@ func BindProgram(files []*syntax.File, opts ...BindOption) (*Program, hcl.Diagno
 		if err != nil {
 			return nil, nil, err
 		}
-		options.loader = schema.NewPluginLoader(ctx.Host)
+		options.loader = schema.NewPluginLoader(ctx.Request(), ctx.Host)
 
 		defer contract.IgnoreClose(ctx)
 	}
@@ -2,6 +2,7 @@ package pcl
 
 import (
 	"bytes"
+	"context"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -56,7 +57,7 @@ func TestBindProgram(t *testing.T) {
 
 				var bindError error
 				var diags hcl.Diagnostics
-				loader := Loader(schema.NewPluginLoader(utils.NewHost(testdataPath)))
+				loader := Loader(schema.NewPluginLoader(context.Background(), utils.NewHost(testdataPath)))
 				if fileName == "simple-range.pp" {
 					// simple-range.pp requires AllowMissingVariables
 					// TODO: remove this once we have a better way to handle this
@@ -258,7 +258,7 @@ func newBinder(info PackageInfoSpec, spec specSource, loader Loader,
 			return nil, nil, err
 		}
 
-		loader, loadCtx = NewPluginLoader(ctx.Host), ctx
+		loader, loadCtx = NewPluginLoader(ctx.Request(), ctx.Host), ctx
 	}
 
 	// Create a type binder.
@@ -1397,6 +1397,7 @@ func (t *types) bindResourceDetails(path, token string, spec ResourceSpec, decl
 		IsComponent:        spec.IsComponent,
 		Methods:            methods,
 		IsOverlay:          spec.IsOverlay,
+		WaitForReady:       spec.WaitForReady,
 	}
 	return diags, nil
 }
@@ -0,0 +1,116 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"context"
+	"os"
+	"strconv"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+)
+
+// DefaultProvider is a provider decorator that applies the default values that a package's schema declares for the
+// input properties of its resources, so that providers do not have to repeat that logic in Check. Before news are
+// passed to the inner provider's Check, each optional input property that is missing or null is set to its default.
+// As in the generated SDKs, the first of a default's environment variables that is set takes precedence over its
+// static value. Defaults are applied to top-level properties only. Resources whose type the schema does not define
+// are checked unchanged, as are all other methods.
+type DefaultProvider struct {
+	plugin.ProviderBase
+
+	pkg    *Package
+	getenv func(key string) (string, bool)
+}
+
+var _ plugin.Provider = (*DefaultProvider)(nil)
+
+// DefaultApplyingProvider wraps the given provider in a DefaultProvider that applies the defaults declared by the
+// given package.
+func DefaultApplyingProvider(inner plugin.Provider, pkg *Package) plugin.Provider {
+	contract.Requiref(pkg != nil, "pkg", "must not be nil")
+	return &DefaultProvider{ProviderBase: plugin.NewProviderBase(inner), pkg: pkg, getenv: os.LookupEnv}
+}
+
+func (p *DefaultProvider) Check(ctx context.Context, urn resource.URN, olds, news resource.PropertyMap,
+	allowUnknowns bool, randomSeed []byte) (resource.PropertyMap, []plugin.CheckFailure, error) {
+
+	if res, ok := lookupResource(p.pkg, urn.Type()); ok {
+		news = p.applyDefaults(res.InputProperties, news)
+	}
+	return p.ProviderBase.Check(ctx, urn, olds, news, allowUnknowns, randomSeed)
+}
+
+// applyDefaults returns a copy of the given inputs in which each optional property that is missing or null is set to
+// its default value, if it has one. The inputs themselves are not modified.
+func (p *DefaultProvider) applyDefaults(defs []*Property, news resource.PropertyMap) resource.PropertyMap {
+	var result resource.PropertyMap
+	for _, def := range defs {
+		if def.IsRequired() || def.DefaultValue == nil {
+			continue
+		}
+		key := resource.PropertyKey(def.Name)
+		if v, has := news[key]; has && !v.IsNull() {
+			continue
+		}
+		value, ok := p.defaultValue(def)
+		if !ok {
+			continue
+		}
+		if result == nil {
+			result = news.Copy()
+		}
+		result[key] = value
+	}
+	if result == nil {
+		return news
+	}
+	return result
+}
+
+// defaultValue returns the default value of the given property. Environment variables whose values cannot be parsed
+// as the property's type are ignored.
+func (p *DefaultProvider) defaultValue(def *Property) (resource.PropertyValue, bool) {
+	t := plainType(def.Type)
+	if enum, ok := t.(*EnumType); ok {
+		t = enum.ElementType
+	}
+
+	for _, env := range def.DefaultValue.Environment {
+		s, ok := p.getenv(env)
+		if !ok {
+			continue
+		}
+		switch t {
+		case BoolType:
+			if b, err := strconv.ParseBool(s); err == nil {
+				return resource.NewBoolProperty(b), true
+			}
+		case IntType, NumberType:
+			if n, err := strconv.ParseFloat(s, 64); err == nil {
+				return resource.NewNumberProperty(n), true
+			}
+		case StringType:
+			return resource.NewStringProperty(s), true
+		}
+	}
+
+	if def.DefaultValue.Value == nil {
+		return resource.PropertyValue{}, false
+	}
+	return resource.NewPropertyValue(def.DefaultValue.Value), true
+}
@@ -0,0 +1,107 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/segmentio/encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	plugintesting "github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin/testing"
+)
+
+const defaultsSchema = `{
+	"name": "test",
+	"version": "1.0.0",
+	"resources": {
+		"test:index:Bucket": {
+			"inputProperties": {
+				"name": {"type": "string"},
+				"region": {"type": "string", "default": "us-west-2"},
+				"versioned": {"type": "boolean", "default": false},
+				"replicas": {"type": "integer", "default": 1, "defaultInfo": {"environment": ["TEST_REPLICAS"]}},
+				"acl": {"type": "string", "default": "private"},
+				"owner": {"type": "string", "default": "admin"}
+			},
+			"requiredInputs": ["name", "owner"]
+		}
+	}
+}`
+
+func TestDefaultApplyingProvider(t *testing.T) {
+	t.Parallel()
+
+	var spec PackageSpec
+	require.NoError(t, json.Unmarshal([]byte(defaultsSchema), &spec))
+	pkg, err := ImportSpec(spec, nil)
+	require.NoError(t, err)
+
+	var checked resource.PropertyMap
+	inner := plugintesting.NewMockProvider(
+		plugintesting.WithCheck(func(ctx context.Context, urn resource.URN, olds, news resource.PropertyMap,
+			allowUnknowns bool, randomSeed []byte) (resource.PropertyMap, []plugin.CheckFailure, error) {
+			checked = news
+			return news, nil, nil
+		}),
+	)
+	env := map[string]string{}
+	prov := DefaultApplyingProvider(inner, pkg).(*DefaultProvider)
+	prov.getenv = func(key string) (string, bool) {
+		v, ok := env[key]
+		return v, ok
+	}
+
+	str := resource.NewStringProperty
+	urn := resource.URN("urn:pulumi:stack::project::test:index:Bucket::b")
+	news := resource.PropertyMap{
+		"name":   str("b"),
+		"acl":    str("public-read"),
+		"region": resource.NewNullProperty(),
+	}
+
+	// Missing and null optional properties get their defaults; set and required properties are left alone.
+	_, _, err = prov.Check(context.Background(), urn, nil, news, false, nil)
+	require.NoError(t, err)
+	assert.Equal(t, resource.PropertyMap{
+		"name":      str("b"),
+		"acl":       str("public-read"),
+		"region":    str("us-west-2"),
+		"versioned": resource.NewBoolProperty(false),
+		"replicas":  resource.NewNumberProperty(1),
+	}, checked)
+	assert.Equal(t, resource.NewNullProperty(), news["region"])
+
+	// Environment variables take precedence over static defaults, unless they can't be parsed.
+	env["TEST_REPLICAS"] = "3"
+	_, _, err = prov.Check(context.Background(), urn, nil, resource.PropertyMap{}, false, nil)
+	require.NoError(t, err)
+	assert.Equal(t, resource.NewNumberProperty(3), checked["replicas"])
+
+	env["TEST_REPLICAS"] = "three"
+	_, _, err = prov.Check(context.Background(), urn, nil, resource.PropertyMap{}, false, nil)
+	require.NoError(t, err)
+	assert.Equal(t, resource.NewNumberProperty(1), checked["replicas"])
+
+	// Resources that the schema does not define are checked unchanged.
+	other := resource.URN("urn:pulumi:stack::project::test:index:Other::o")
+	_, _, err = prov.Check(context.Background(), other, nil, resource.PropertyMap{}, false, nil)
+	require.NoError(t, err)
+	assert.Equal(t, resource.PropertyMap{}, checked)
+}
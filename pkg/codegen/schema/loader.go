@@ -2,6 +2,7 @@ package schema
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -33,8 +34,11 @@ type ReferenceLoader interface {
 type pluginLoader struct {
 	m sync.RWMutex
 
-	host    plugin.Host
-	entries map[string]PackageReference
+	ctx       context.Context // the context used for the RPCs made to the providers that are loaded.
+	host      plugin.Host
+	providers *plugin.ProviderRegistry // the providers that are loaded in order to fetch their schemas
+	entries   map[string]PackageReference
+	checksums map[string]string // the checksums of the schemas that each entry was decoded from
 
 	cacheOptions pluginLoaderCacheOptions
 }
@@ -49,17 +53,27 @@ type pluginLoaderCacheOptions struct {
 	disableMmap bool
 }
 
-func NewPluginLoader(host plugin.Host) ReferenceLoader {
+// NewPluginLoader creates a loader that fetches package schemas from the resource plugins installed on the given host.
+// The given context is used for the RPCs made to the providers that are loaded to fetch those schemas.
+func NewPluginLoader(ctx context.Context, host plugin.Host) ReferenceLoader {
 	return &pluginLoader{
-		host:    host,
-		entries: map[string]PackageReference{},
+		ctx:       ctx,
+		host:      host,
+		providers: plugin.NewProviderRegistry(host),
+		entries:   map[string]PackageReference{},
+		checksums: map[string]string{},
 	}
 }
 
-func newPluginLoaderWithOptions(host plugin.Host, cacheOptions pluginLoaderCacheOptions) ReferenceLoader {
+func newPluginLoaderWithOptions(ctx context.Context, host plugin.Host,
+	cacheOptions pluginLoaderCacheOptions) ReferenceLoader {
+
 	return &pluginLoader{
-		host:    host,
-		entries: map[string]PackageReference{},
+		ctx:       ctx,
+		host:      host,
+		providers: plugin.NewProviderRegistry(host),
+		entries:   map[string]PackageReference{},
+		checksums: map[string]string{},
 
 		cacheOptions: cacheOptions,
 	}
@@ -73,16 +87,27 @@ func (l *pluginLoader) getPackage(key string) (PackageReference, bool) {
 	return p, ok
 }
 
-func (l *pluginLoader) setPackage(key string, p PackageReference) PackageReference {
+// getPackageWithChecksum returns the cached package for the given key if it was decoded from a schema with the given
+// checksum.
+func (l *pluginLoader) getPackageWithChecksum(key, checksum string) (PackageReference, bool) {
+	p, ok := l.getPackage(key)
+	if !ok || checksum == "" || l.checksums[key] != checksum {
+		return nil, false
+	}
+	return p, true
+}
+
+func (l *pluginLoader) setPackage(key, checksum string, p PackageReference) PackageReference {
 	if l.cacheOptions.disableEntryCache {
 		return p
 	}
 
-	if p, ok := l.entries[key]; ok {
+	if p, ok := l.entries[key]; ok && l.checksums[key] == checksum {
 		return p
 	}
 
 	l.entries[key] = p
+	l.checksums[key] = checksum
 	return p
 }
 
@@ -130,7 +155,7 @@ func (l *pluginLoader) LoadPackageReference(pkg string, version *semver.Version)
 		return p, nil
 	}
 
-	schemaBytes, version, err := l.loadSchemaBytes(pkg, version)
+	schemaBytes, checksum, version, err := l.loadSchemaBytes(pkg, version)
 	if err != nil {
 		return nil, err
 	}
@@ -138,6 +163,11 @@ func (l *pluginLoader) LoadPackageReference(pkg string, version *semver.Version)
 		return nil, getSchemaNotImplemented{}
 	}
 
+	// If we have already decoded this exact schema, there is no need to decode it again.
+	if p, ok := l.getPackageWithChecksum(key, checksum); ok {
+		return p, nil
+	}
+
 	var spec PartialPackageSpec
 	if _, err := json.Parse(schemaBytes, &spec, json.ZeroCopy); err != nil {
 		return nil, err
@@ -164,7 +194,7 @@ func (l *pluginLoader) LoadPackageReference(pkg string, version *semver.Version)
 	if err != nil {
 		return nil, err
 	}
-	return l.setPackage(key, p), nil
+	return l.setPackage(key, checksum, p), nil
 }
 
 func LoadPackageReference(loader Loader, pkg string, version *semver.Version) (PackageReference, error) {
@@ -178,19 +208,21 @@ func LoadPackageReference(loader Loader, pkg string, version *semver.Version) (P
 	return p.Reference(), nil
 }
 
-func (l *pluginLoader) loadSchemaBytes(pkg string, version *semver.Version) ([]byte, *semver.Version, error) {
+// loadSchemaBytes loads the schema for the given package, returning the schema bytes, their checksum, and the version
+// of the package.
+func (l *pluginLoader) loadSchemaBytes(pkg string, version *semver.Version) ([]byte, string, *semver.Version, error) {
 	err := l.host.InstallPlugin(workspace.PluginSpec{
 		Kind:    workspace.ResourcePlugin,
 		Name:    pkg,
 		Version: version,
 	})
 	if err != nil {
-		return nil, nil, err
+		return nil, "", nil, err
 	}
 
 	pluginInfo, err := l.host.ResolvePlugin(workspace.ResourcePlugin, pkg, version)
 	if err != nil {
-		return nil, nil, err
+		return nil, "", nil, err
 	}
 	contract.Assertf(pluginInfo != nil, "loading pkg %q: pluginInfo was unexpectedly nil", pkg)
 
@@ -201,47 +233,58 @@ func (l *pluginLoader) loadSchemaBytes(pkg string, version *semver.Version) ([]b
 	if pluginInfo.SchemaPath != "" && version != nil {
 		schemaBytes, ok := l.loadCachedSchemaBytes(pkg, pluginInfo.SchemaPath, pluginInfo.SchemaTime)
 		if ok {
-			return schemaBytes, nil, nil
+			return schemaBytes, plugin.SchemaChecksum(schemaBytes), nil, nil
 		}
 	}
 
-	schemaBytes, provider, err := l.loadPluginSchemaBytes(pkg, version)
+	// The provider is only needed while its schema is fetched, so release it once we are done with it.
+	ref := plugin.ProviderReference{Package: tokens.Package(pkg)}
+	if version != nil {
+		ref.Version = version.String()
+	}
+	provider, err := l.providers.Load(ref)
+	if err != nil {
+		return nil, "", nil, fmt.Errorf("Error loading schema from plugin: %w", err)
+	}
+	defer func() { contract.IgnoreError(l.providers.Unload(ref)) }()
+
+	schema, err := l.loadPluginSchemaBytes(provider)
 	if err != nil {
-		return nil, nil, fmt.Errorf("Error loading schema from plugin: %w", err)
+		return nil, "", nil, fmt.Errorf("Error loading schema from plugin: %w", err)
 	}
 
 	if pluginInfo.SchemaPath != "" {
-		err = atomic.WriteFile(pluginInfo.SchemaPath, bytes.NewReader(schemaBytes))
+		err = atomic.WriteFile(pluginInfo.SchemaPath, bytes.NewReader(schema.Bytes))
 		if err != nil {
-			return nil, nil, fmt.Errorf("Error writing schema from plugin to cache: %w", err)
+			return nil, "", nil, fmt.Errorf("Error writing schema from plugin to cache: %w", err)
 		}
 	}
 
 	if version == nil {
-		info, err := provider.GetPluginInfo()
+		info, err := provider.GetPluginInfo(l.ctx)
 		if err != nil {
 			// Nonfatal
 		}
 		version = info.Version
 	}
 
-	return schemaBytes, version, nil
+	return schema.Bytes, schema.Checksum, version, nil
 }
 
-func (l *pluginLoader) loadPluginSchemaBytes(pkg string, version *semver.Version) ([]byte, plugin.Provider, error) {
-	provider, err := l.host.Provider(tokens.Package(pkg), version)
-	if err != nil {
-		return nil, nil, err
-	}
-	contract.Assert(provider != nil)
-
+// loadPluginSchemaBytes fetches the schema from the given provider, requesting the highest schema version that the
+// provider supports. Providers that do not report their supported versions are asked for version 0.
+func (l *pluginLoader) loadPluginSchemaBytes(provider plugin.Provider) (plugin.GetSchemaResponse, error) {
 	schemaFormatVersion := 0
-	schemaBytes, err := provider.GetSchema(schemaFormatVersion)
-	if err != nil {
-		return nil, nil, err
+	versions, err := provider.GetSupportedVersions(l.ctx)
+	if err != nil && err != plugin.ErrNotYetImplemented {
+		return plugin.GetSchemaResponse{}, err
 	}
-
-	return schemaBytes, provider, nil
+	for _, v := range versions {
+		if v > schemaFormatVersion {
+			schemaFormatVersion = v
+		}
+	}
+	return provider.GetSchema(l.ctx, schemaFormatVersion)
 }
 
 var mmapedFiles = make(map[string]mmap.MMap)
@@ -1,10 +1,15 @@
 package schema
 
 import (
+	"context"
 	"os"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	plugintesting "github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin/testing"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
 )
@@ -15,7 +20,7 @@ func initLoader(b *testing.B, options pluginLoaderCacheOptions) ReferenceLoader
 	sink := cmdutil.Diag()
 	ctx, err := plugin.NewContext(sink, sink, nil, nil, cwd, nil, true, nil)
 	contract.AssertNoError(err)
-	loader := newPluginLoaderWithOptions(ctx.Host, options)
+	loader := newPluginLoaderWithOptions(context.Background(), ctx.Host, options)
 
 	return loader
 }
@@ -103,3 +108,55 @@ func BenchmarkLoadPackageReference(b *testing.B) {
 		}
 	})
 }
+
+func TestPluginLoaderChecksumCache(t *testing.T) {
+	t.Parallel()
+
+	loader := newPluginLoaderWithOptions(context.Background(), nil, pluginLoaderCacheOptions{}).(*pluginLoader)
+
+	first := DefaultPulumiPackage.Reference()
+	assert.Equal(t, first, loader.setPackage("pkg", "checksum-1", first))
+
+	// A package decoded from the same schema is reused.
+	p, ok := loader.getPackageWithChecksum("pkg", "checksum-1")
+	assert.True(t, ok)
+	assert.Equal(t, first, p)
+
+	// A package decoded from a different schema is not.
+	_, ok = loader.getPackageWithChecksum("pkg", "checksum-2")
+	assert.False(t, ok)
+	_, ok = loader.getPackageWithChecksum("pkg", "")
+	assert.False(t, ok)
+
+	// A schema with a new checksum replaces the cached package.
+	second := (&Package{Name: "pkg"}).Reference()
+	assert.Equal(t, second, loader.setPackage("pkg", "checksum-2", second))
+	p, ok = loader.getPackageWithChecksum("pkg", "checksum-2")
+	assert.True(t, ok)
+	assert.Equal(t, second, p)
+}
+
+func TestPluginLoaderSchemaVersion(t *testing.T) {
+	t.Parallel()
+
+	loader := newPluginLoaderWithOptions(context.Background(), nil, pluginLoaderCacheOptions{}).(*pluginLoader)
+
+	var requested []int
+	getSchema := plugintesting.WithGetSchema(func(ctx context.Context, version int) (plugin.GetSchemaResponse, error) {
+		requested = append(requested, version)
+		return plugin.NewGetSchemaResponse([]byte("{}")), nil
+	})
+
+	// The highest supported version is requested.
+	_, err := loader.loadPluginSchemaBytes(plugintesting.NewMockProvider(getSchema,
+		plugintesting.WithGetSupportedVersions(func(ctx context.Context) ([]int, error) {
+			return []int{0, 2, 1}, nil
+		})))
+	require.NoError(t, err)
+
+	// Providers that do not report their supported versions are asked for version 0.
+	_, err = loader.loadPluginSchemaBytes(plugintesting.NewMockProvider(getSchema, plugintesting.WithDefaultNYI()))
+	require.NoError(t, err)
+
+	assert.Equal(t, []int{2, 0}, requested)
+}
@@ -0,0 +1,75 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/segmentio/encoding/json"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+// SchemaRegistry caches the parsed schemas of provider packages so that subsystems that need the same schema do not
+// each have to call GetSchema and decode the result. A SchemaRegistry is safe for concurrent use.
+type SchemaRegistry struct {
+	packages sync.Map // map[tokens.Package]*Package
+}
+
+// DefaultRegistry is the SchemaRegistry returned by SchemaRegistryFromContext for contexts that do not carry one.
+var DefaultRegistry = &SchemaRegistry{}
+
+// Register parses the given JSON schema and records the result for the given package, replacing any schema that was
+// previously registered for it.
+func (r *SchemaRegistry) Register(pkg tokens.Package, schema []byte) error {
+	var spec PackageSpec
+	if err := json.Unmarshal(schema, &spec); err != nil {
+		return fmt.Errorf("unmarshaling schema for package %v: %w", pkg, err)
+	}
+	p, err := ImportSpec(spec, nil)
+	if err != nil {
+		return fmt.Errorf("importing schema for package %v: %w", pkg, err)
+	}
+	r.packages.Store(pkg, p)
+	return nil
+}
+
+// Lookup returns the parsed schema registered for the given package, if any.
+func (r *SchemaRegistry) Lookup(pkg tokens.Package) (*Package, bool) {
+	p, ok := r.packages.Load(pkg)
+	if !ok {
+		return nil, false
+	}
+	return p.(*Package), true
+}
+
+// schemaRegistryKey is the value used as the context key for a SchemaRegistry.
+var schemaRegistryKey struct{}
+
+// WithSchemaRegistry returns a new context.Context that carries the given SchemaRegistry.
+func WithSchemaRegistry(ctx context.Context, r *SchemaRegistry) context.Context {
+	return context.WithValue(ctx, schemaRegistryKey, r)
+}
+
+// SchemaRegistryFromContext returns the SchemaRegistry carried by the given context, or DefaultRegistry if the
+// context does not carry one.
+func SchemaRegistryFromContext(ctx context.Context) *SchemaRegistry {
+	if r, ok := ctx.Value(schemaRegistryKey).(*SchemaRegistry); ok && r != nil {
+		return r
+	}
+	return DefaultRegistry
+}
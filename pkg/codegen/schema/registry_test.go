@@ -0,0 +1,52 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemaRegistry(t *testing.T) {
+	t.Parallel()
+
+	r := &SchemaRegistry{}
+
+	_, ok := r.Lookup("test")
+	assert.False(t, ok)
+
+	require.NoError(t, r.Register("test", []byte(`{"name": "test", "version": "1.0.0"}`)))
+	pkg, ok := r.Lookup("test")
+	require.True(t, ok)
+	assert.Equal(t, "test", pkg.Name)
+
+	// Invalid schemas are rejected and do not replace the registered package.
+	assert.Error(t, r.Register("test", []byte("not json")))
+	pkg, ok = r.Lookup("test")
+	require.True(t, ok)
+	assert.Equal(t, "test", pkg.Name)
+}
+
+func TestSchemaRegistryFromContext(t *testing.T) {
+	t.Parallel()
+
+	assert.Same(t, DefaultRegistry, SchemaRegistryFromContext(context.Background()))
+
+	r := &SchemaRegistry{}
+	assert.Same(t, r, SchemaRegistryFromContext(WithSchemaRegistry(context.Background(), r)))
+}
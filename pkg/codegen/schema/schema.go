@@ -410,6 +410,9 @@ type Resource struct {
 	// IsOverlay indicates whether the type is an overlay provided by the package. Overlay code is generated by the
 	// package rather than using the core Pulumi codegen libraries.
 	IsOverlay bool
+	// WaitForReady indicates that the engine should call the provider's WaitForResourceReady method after creating
+	// the resource.
+	WaitForReady bool
 }
 
 // The set of resource paths where ReplaceOnChanges is true.
@@ -1118,6 +1121,7 @@ func (pkg *Package) marshalResource(r *Resource) (ResourceSpec, error) {
 		DeprecationMessage: r.DeprecationMessage,
 		IsComponent:        r.IsComponent,
 		Methods:            methods,
+		WaitForReady:       r.WaitForReady,
 	}, nil
 }
 
@@ -1502,6 +1506,9 @@ type ResourceSpec struct {
 	IsComponent bool `json:"isComponent,omitempty" yaml:"isComponent,omitempty"`
 	// Methods maps method names to functions in this schema.
 	Methods map[string]string `json:"methods,omitempty" yaml:"methods,omitempty"`
+	// WaitForReady indicates that the engine should call the provider's WaitForResourceReady method after creating
+	// the resource.
+	WaitForReady bool `json:"waitForReady,omitempty" yaml:"waitForReady,omitempty"`
 }
 
 // FunctionSpec is the serializable form of a function description.
@@ -27,6 +27,7 @@ import (
 
 	"github.com/blang/semver"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func readSchemaFile(file string) (pkgSpec PackageSpec) {
@@ -477,6 +478,34 @@ func TestIsOverlay(t *testing.T) {
 	})
 }
 
+func TestWaitForReady(t *testing.T) {
+	t.Parallel()
+
+	pkgSpec := PackageSpec{
+		Name:    "xyz",
+		Version: "0.0.1",
+		Resources: map[string]ResourceSpec{
+			"xyz:index:Database": {WaitForReady: true},
+			"xyz:index:Bucket":   {},
+		},
+	}
+	pkg, err := ImportSpec(pkgSpec, nil)
+	require.NoError(t, err)
+
+	db, ok := pkg.GetResource("xyz:index:Database")
+	require.True(t, ok)
+	assert.True(t, db.WaitForReady)
+	bucket, ok := pkg.GetResource("xyz:index:Bucket")
+	require.True(t, ok)
+	assert.False(t, bucket.WaitForReady)
+
+	// The annotation survives a round trip through the serialized form.
+	spec, err := pkg.MarshalSpec()
+	require.NoError(t, err)
+	assert.True(t, spec.Resources["xyz:index:Database"].WaitForReady)
+	assert.False(t, spec.Resources["xyz:index:Bucket"].WaitForReady)
+}
+
 // Tests that the method ReplaceOnChanges works as expected. Does not test
 // codegen.
 func TestReplaceOnChanges(t *testing.T) {
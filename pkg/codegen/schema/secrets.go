@@ -0,0 +1,180 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"context"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+)
+
+// SecretProvider is a provider decorator that makes sure that the properties a package's schema declares as secret
+// are returned as secrets, for providers that return some of them as plain values. The state returned by Create,
+// StreamCreate, Update, Read, Refresh, ReadStream, and BatchRead is post-processed so that each secret property, at any
+// depth, is wrapped with resource.MakeSecret. The inputs returned by reads are processed in the same way using the
+// resource's input properties. Resources whose type the schema does not define are returned unchanged, as are all
+// other methods.
+type SecretProvider struct {
+	plugin.ProviderBase
+
+	pkg *Package
+}
+
+var _ plugin.Provider = (*SecretProvider)(nil)
+
+// SecretEnforcingProvider wraps the given provider in a SecretProvider that enforces the secrets declared by the
+// given package.
+func SecretEnforcingProvider(inner plugin.Provider, pkg *Package) plugin.Provider {
+	contract.Requiref(pkg != nil, "pkg", "must not be nil")
+	return &SecretProvider{ProviderBase: plugin.NewProviderBase(inner), pkg: pkg}
+}
+
+func (p *SecretProvider) Create(ctx context.Context, urn resource.URN, news resource.PropertyMap, timeout float64,
+	preview bool) (resource.ID, resource.PropertyMap, resource.Status, error) {
+
+	id, outs, status, err := p.ProviderBase.Create(ctx, urn, news, timeout, preview)
+	return id, p.secretOutputs(urn, outs), status, err
+}
+
+func (p *SecretProvider) StreamCreate(ctx context.Context, urn resource.URN, news resource.PropertyMap,
+	timeout float64, preview bool,
+	onNext func(resource.PropertyMap) error) (resource.ID, resource.PropertyMap, resource.Status, error) {
+
+	id, outs, status, err := p.ProviderBase.StreamCreate(ctx, urn, news, timeout, preview,
+		func(state resource.PropertyMap) error {
+			return onNext(p.secretOutputs(urn, state))
+		})
+	return id, p.secretOutputs(urn, outs), status, err
+}
+
+func (p *SecretProvider) Update(ctx context.Context, urn resource.URN, id resource.ID,
+	olds resource.PropertyMap, news resource.PropertyMap, timeout float64,
+	ignoreChanges []string, preview bool) (resource.PropertyMap, resource.Status, error) {
+
+	outs, status, err := p.ProviderBase.Update(ctx, urn, id, olds, news, timeout, ignoreChanges, preview)
+	return p.secretOutputs(urn, outs), status, err
+}
+
+func (p *SecretProvider) Read(ctx context.Context, urn resource.URN, id resource.ID,
+	inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error) {
+
+	result, status, err := p.ProviderBase.Read(ctx, urn, id, inputs, state)
+	return p.secretReadResult(urn, result), status, err
+}
+
+func (p *SecretProvider) Refresh(ctx context.Context, urn resource.URN, id resource.ID,
+	inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error) {
+
+	result, status, err := p.ProviderBase.Refresh(ctx, urn, id, inputs, state)
+	return p.secretReadResult(urn, result), status, err
+}
+
+func (p *SecretProvider) ReadStream(ctx context.Context, urn resource.URN, id resource.ID,
+	inputs, state resource.PropertyMap, onNext func(plugin.ReadResult) error) (resource.Status, error) {
+
+	return p.ProviderBase.ReadStream(ctx, urn, id, inputs, state, func(result plugin.ReadResult) error {
+		return onNext(p.secretReadResult(urn, result))
+	})
+}
+
+func (p *SecretProvider) BatchRead(ctx context.Context,
+	requests []plugin.BatchReadRequest) ([]plugin.BatchReadResponse, error) {
+
+	responses, err := p.ProviderBase.BatchRead(ctx, requests)
+	for i := range responses {
+		if i < len(requests) {
+			responses[i].ReadResult = p.secretReadResult(requests[i].URN, responses[i].ReadResult)
+		}
+	}
+	return responses, err
+}
+
+// secretOutputs marks the secret output properties of the given resource's state as secrets.
+func (p *SecretProvider) secretOutputs(urn resource.URN, outs resource.PropertyMap) resource.PropertyMap {
+	res, ok := lookupResource(p.pkg, urn.Type())
+	if !ok {
+		return outs
+	}
+	return markSecretProperties(res.Properties, outs)
+}
+
+// secretReadResult marks the secret input and output properties of the given read result as secrets.
+func (p *SecretProvider) secretReadResult(urn resource.URN, result plugin.ReadResult) plugin.ReadResult {
+	res, ok := lookupResource(p.pkg, urn.Type())
+	if !ok {
+		return result
+	}
+	result.Inputs = markSecretProperties(res.InputProperties, result.Inputs)
+	result.Outputs = markSecretProperties(res.Properties, result.Outputs)
+	return result
+}
+
+// markSecretProperties returns a copy of the given object in which each property that the given definitions declare
+// secret, at any depth, is a secret. Properties that are not defined are left unchanged. A nil object is returned
+// as-is.
+func markSecretProperties(defs []*Property, props resource.PropertyMap) resource.PropertyMap {
+	if props == nil {
+		return nil
+	}
+
+	result := props.Copy()
+	for _, def := range defs {
+		key := resource.PropertyKey(def.Name)
+		value, has := result[key]
+		if !has {
+			continue
+		}
+		value = markSecretValue(def.Type, value)
+		if def.Secret && !value.IsSecret() && !value.IsNull() {
+			value = resource.MakeSecret(value)
+		}
+		result[key] = value
+	}
+	return result
+}
+
+// markSecretValue returns a copy of the given value in which each nested property that its type declares secret is a
+// secret.
+func markSecretValue(t Type, v resource.PropertyValue) resource.PropertyValue {
+	if v.IsSecret() {
+		return resource.MakeSecret(markSecretValue(t, v.SecretValue().Element))
+	}
+
+	switch t := plainType(t).(type) {
+	case *ObjectType:
+		if v.IsObject() {
+			return resource.NewObjectProperty(markSecretProperties(t.Properties, v.ObjectValue()))
+		}
+	case *ArrayType:
+		if v.IsArray() {
+			elements := make([]resource.PropertyValue, len(v.ArrayValue()))
+			for i, e := range v.ArrayValue() {
+				elements[i] = markSecretValue(t.ElementType, e)
+			}
+			return resource.NewArrayProperty(elements)
+		}
+	case *MapType:
+		if v.IsObject() {
+			elements := make(resource.PropertyMap, len(v.ObjectValue()))
+			for k, e := range v.ObjectValue() {
+				elements[k] = markSecretValue(t.ElementType, e)
+			}
+			return resource.NewObjectProperty(elements)
+		}
+	}
+	return v
+}
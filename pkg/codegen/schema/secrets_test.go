@@ -0,0 +1,144 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/segmentio/encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	plugintesting "github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin/testing"
+)
+
+const secretsSchema = `{
+	"name": "test",
+	"version": "1.0.0",
+	"types": {
+		"test:index:Credentials": {
+			"type": "object",
+			"properties": {
+				"user": {"type": "string"},
+				"token": {"type": "string", "secret": true}
+			}
+		}
+	},
+	"resources": {
+		"test:index:Database": {
+			"inputProperties": {
+				"name": {"type": "string"},
+				"password": {"type": "string", "secret": true}
+			},
+			"properties": {
+				"name": {"type": "string"},
+				"password": {"type": "string", "secret": true},
+				"credentials": {"$ref": "#/types/test:index:Credentials"},
+				"replicas": {"type": "array", "items": {"$ref": "#/types/test:index:Credentials"}},
+				"keys": {"type": "object", "additionalProperties": {"type": "string"}, "secret": true}
+			}
+		}
+	}
+}`
+
+const secretsTestURN = resource.URN("urn:pulumi:stack::project::test:index:Database::db")
+
+func newSecretsTestPackage(t *testing.T) *Package {
+	var spec PackageSpec
+	require.NoError(t, json.Unmarshal([]byte(secretsSchema), &spec))
+	pkg, err := ImportSpec(spec, nil)
+	require.NoError(t, err)
+	return pkg
+}
+
+func credentialsValue(user, token resource.PropertyValue) resource.PropertyValue {
+	return resource.NewObjectProperty(resource.PropertyMap{"user": user, "token": token})
+}
+
+func TestSecretEnforcingProvider(t *testing.T) {
+	t.Parallel()
+
+	str := resource.NewStringProperty
+	state := resource.PropertyMap{
+		"name":        str("db"),
+		"password":    str("hunter2"),
+		"credentials": credentialsValue(str("admin"), str("t0ken")),
+		"replicas": resource.NewArrayProperty([]resource.PropertyValue{
+			credentialsValue(str("r0"), str("t1")),
+			credentialsValue(str("r1"), resource.MakeSecret(str("t2"))),
+		}),
+		"keys": resource.NewObjectProperty(resource.PropertyMap{"a": str("k")}),
+	}
+	expected := resource.PropertyMap{
+		"name":        str("db"),
+		"password":    resource.MakeSecret(str("hunter2")),
+		"credentials": credentialsValue(str("admin"), resource.MakeSecret(str("t0ken"))),
+		"replicas": resource.NewArrayProperty([]resource.PropertyValue{
+			credentialsValue(str("r0"), resource.MakeSecret(str("t1"))),
+			credentialsValue(str("r1"), resource.MakeSecret(str("t2"))),
+		}),
+		"keys": resource.MakeSecret(resource.NewObjectProperty(resource.PropertyMap{"a": str("k")})),
+	}
+
+	inner := plugintesting.NewMockProvider(
+		plugintesting.WithCreate(func(ctx context.Context, urn resource.URN, news resource.PropertyMap,
+			timeout float64, preview bool) (resource.ID, resource.PropertyMap, resource.Status, error) {
+			return "id", state.Copy(), resource.StatusOK, nil
+		}),
+		plugintesting.WithUpdate(func(ctx context.Context, urn resource.URN, id resource.ID,
+			olds, news resource.PropertyMap, timeout float64, ignoreChanges []string,
+			preview bool) (resource.PropertyMap, resource.Status, error) {
+			return state.Copy(), resource.StatusOK, nil
+		}),
+		plugintesting.WithRead(func(ctx context.Context, urn resource.URN, id resource.ID,
+			inputs, olds resource.PropertyMap) (plugin.ReadResult, resource.Status, error) {
+			return plugin.ReadResult{
+				ID:      id,
+				Inputs:  resource.PropertyMap{"name": str("db"), "password": str("hunter2")},
+				Outputs: state.Copy(),
+			}, resource.StatusOK, nil
+		}),
+	)
+	prov := SecretEnforcingProvider(inner, newSecretsTestPackage(t))
+	ctx := context.Background()
+
+	_, outs, _, err := prov.Create(ctx, secretsTestURN, resource.PropertyMap{}, 0, false)
+	require.NoError(t, err)
+	assert.Equal(t, expected, outs)
+
+	outs, _, err = prov.Update(ctx, secretsTestURN, "id", state, resource.PropertyMap{}, 0, nil, false)
+	require.NoError(t, err)
+	assert.Equal(t, expected, outs)
+
+	result, _, err := prov.Read(ctx, secretsTestURN, "id", nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, expected, result.Outputs)
+	assert.Equal(t, resource.PropertyMap{
+		"name":     str("db"),
+		"password": resource.MakeSecret(str("hunter2")),
+	}, result.Inputs)
+
+	// The provider's own state is not modified.
+	assert.Equal(t, str("hunter2"), state["password"])
+
+	// Resources that the schema does not define are returned unchanged.
+	other := resource.URN("urn:pulumi:stack::project::test:index:Other::o")
+	_, outs, _, err = prov.Create(ctx, other, resource.PropertyMap{}, 0, false)
+	require.NoError(t, err)
+	assert.Equal(t, state, outs)
+}
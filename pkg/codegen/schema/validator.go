@@ -0,0 +1,255 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+)
+
+// SchemaValidator checks resource inputs against the types declared by a package's schema, so that providers do not
+// each need to hand-write that validation in Check. It reports missing required properties, values of the wrong type,
+// and values that are not members of their enum. Unknown values are assumed to be valid.
+type SchemaValidator struct {
+	pkg *Package
+}
+
+// NewSchemaValidator returns a SchemaValidator for the resources defined by the given package.
+func NewSchemaValidator(pkg *Package) *SchemaValidator {
+	contract.Requiref(pkg != nil, "pkg", "must not be nil")
+	return &SchemaValidator{pkg: pkg}
+}
+
+// ValidateProperties checks the given inputs against the input properties of the given resource type. Each failure
+// names the path of the offending property, e.g. "tags.env" or "rules[0].port". If the package does not define the
+// resource type, a single failure that names no property is returned.
+func (v *SchemaValidator) ValidateProperties(resType tokens.Type, props resource.PropertyMap) []plugin.CheckFailure {
+	res, ok := lookupResource(v.pkg, resType)
+	if !ok {
+		return []plugin.CheckFailure{{
+			Reason: fmt.Sprintf("package %v does not define resource type %v", v.pkg.Name, resType),
+		}}
+	}
+
+	var failures []plugin.CheckFailure
+	validateProperties(res.InputProperties, props, "", &failures)
+	return failures
+}
+
+// lookupResource returns the resource with the given type token from the given package, including the package's
+// provider resource.
+func lookupResource(pkg *Package, resType tokens.Type) (*Resource, bool) {
+	if res, ok := pkg.GetResource(string(resType)); ok {
+		return res, true
+	}
+	if pkg.Provider != nil && resType == providerType(pkg.Name) {
+		return pkg.Provider, true
+	}
+	return nil, false
+}
+
+// providerType returns the type token of the provider resource for the package with the given name.
+func providerType(pkg string) tokens.Type {
+	return tokens.Type("pulumi:providers:" + pkg)
+}
+
+// validateProperties checks an object's properties against the given property definitions. Properties that are not
+// defined are ignored.
+func validateProperties(defs []*Property, props resource.PropertyMap, prefix string,
+	failures *[]plugin.CheckFailure) {
+
+	for _, def := range defs {
+		path := propertyPath(prefix, def.Name)
+		value, has := props[resource.PropertyKey(def.Name)]
+		if !has || value.IsNull() {
+			if def.IsRequired() {
+				*failures = append(*failures, newCheckFailure(path, "missing required property '%s'", path))
+			}
+			continue
+		}
+		validateValue(def.Type, value, path, failures)
+	}
+}
+
+// validateValue checks that the given value is a member of the given type.
+func validateValue(t Type, v resource.PropertyValue, path string, failures *[]plugin.CheckFailure) {
+	// Unwrap secrets and known outputs, and assume that unknown values are valid.
+	for v.IsSecret() || v.IsOutput() && v.OutputValue().Known {
+		if v.IsSecret() {
+			v = v.SecretValue().Element
+		} else {
+			v = v.OutputValue().Element
+		}
+	}
+	if v.IsComputed() || v.IsOutput() {
+		return
+	}
+
+	switch t := t.(type) {
+	case *OptionalType:
+		if !v.IsNull() {
+			validateValue(t.ElementType, v, path, failures)
+		}
+	case *InputType:
+		validateValue(t.ElementType, v, path, failures)
+	case *TokenType:
+		if t.UnderlyingType != nil {
+			validateValue(t.UnderlyingType, v, path, failures)
+		}
+	case *ArrayType:
+		if !v.IsArray() {
+			*failures = append(*failures, newTypeFailure(path, t, v))
+			return
+		}
+		for i, e := range v.ArrayValue() {
+			validateValue(t.ElementType, e, fmt.Sprintf("%s[%d]", path, i), failures)
+		}
+	case *MapType:
+		if !v.IsObject() {
+			*failures = append(*failures, newTypeFailure(path, t, v))
+			return
+		}
+		for _, k := range v.ObjectValue().Keys() {
+			validateValue(t.ElementType, v.ObjectValue()[k], propertyPath(path, string(k)), failures)
+		}
+	case *ObjectType:
+		if !v.IsObject() {
+			*failures = append(*failures, newTypeFailure(path, t, v))
+			return
+		}
+		validateProperties(t.Properties, v.ObjectValue(), path, failures)
+	case *EnumType:
+		validateEnum(t, v, path, failures)
+	case *UnionType:
+		for _, e := range t.ElementTypes {
+			var elementFailures []plugin.CheckFailure
+			validateValue(e, v, path, &elementFailures)
+			if len(elementFailures) == 0 {
+				return
+			}
+		}
+		*failures = append(*failures, newTypeFailure(path, t, v))
+	case *ResourceType:
+		// Resources may be passed by reference or by ID.
+		if !v.IsResourceReference() && !v.IsString() {
+			*failures = append(*failures, newTypeFailure(path, t, v))
+		}
+	default:
+		if !isPrimitiveValue(t, v) {
+			*failures = append(*failures, newTypeFailure(path, t, v))
+		}
+	}
+}
+
+// validateEnum checks that the given value is one of the enum's elements.
+func validateEnum(t *EnumType, v resource.PropertyValue, path string, failures *[]plugin.CheckFailure) {
+	if !isPrimitiveValue(t.ElementType, v) {
+		*failures = append(*failures, newTypeFailure(path, t, v))
+		return
+	}
+
+	for _, e := range t.Elements {
+		switch value := e.Value.(type) {
+		case string:
+			if v.IsString() && v.StringValue() == value {
+				return
+			}
+		case bool:
+			if v.IsBool() && v.BoolValue() == value {
+				return
+			}
+		case int:
+			if v.IsNumber() && v.NumberValue() == float64(value) {
+				return
+			}
+		case float64:
+			if v.IsNumber() && v.NumberValue() == value {
+				return
+			}
+		}
+	}
+	*failures = append(*failures, newCheckFailure(path, "value %#v of property '%s' is not a member of enum %v",
+		v.Mappable(), path, t.Token))
+}
+
+// isPrimitiveValue returns true if the given value is a member of the given primitive type. Values are never members
+// of types that are not primitive.
+func isPrimitiveValue(t Type, v resource.PropertyValue) bool {
+	switch t {
+	case BoolType:
+		return v.IsBool()
+	case IntType:
+		return v.IsNumber() && v.NumberValue() == math.Trunc(v.NumberValue())
+	case NumberType:
+		return v.IsNumber()
+	case StringType:
+		return v.IsString()
+	case ArchiveType:
+		return v.IsArchive()
+	case AssetType:
+		// Asset-typed properties accept both assets and archives.
+		return v.IsAsset() || v.IsArchive()
+	case AnyType, JSONType:
+		return true
+	default:
+		return false
+	}
+}
+
+// propertyPath appends the given property name to the given path.
+func propertyPath(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
+
+// newTypeFailure returns a CheckFailure for a value that is not a member of its property's type.
+func newTypeFailure(path string, t Type, v resource.PropertyValue) plugin.CheckFailure {
+	return newCheckFailure(path, "property '%s' must be of type %v, not %v", path, typeName(t), v.TypeString())
+}
+
+// typeName returns the name of the given type as it is written in a program, i.e. without any input or optional
+// wrappers.
+func typeName(t Type) string {
+	switch t := plainType(t).(type) {
+	case *ArrayType:
+		return fmt.Sprintf("Array<%v>", typeName(t.ElementType))
+	case *MapType:
+		return fmt.Sprintf("Map<%v>", typeName(t.ElementType))
+	case *UnionType:
+		elements := make([]string, len(t.ElementTypes))
+		for i, e := range t.ElementTypes {
+			elements[i] = typeName(e)
+		}
+		return fmt.Sprintf("Union<%v>", strings.Join(elements, ", "))
+	default:
+		return t.String()
+	}
+}
+
+// newCheckFailure returns a CheckFailure for the property at the given path.
+func newCheckFailure(path, format string, args ...interface{}) plugin.CheckFailure {
+	return plugin.CheckFailure{
+		Property: resource.PropertyKey(path),
+		Reason:   fmt.Sprintf(format, args...),
+	}
+}
@@ -0,0 +1,203 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/segmentio/encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+)
+
+const validatorSchema = `{
+	"name": "test",
+	"version": "1.0.0",
+	"provider": {
+		"inputProperties": {
+			"region": {"type": "string"}
+		},
+		"requiredInputs": ["region"]
+	},
+	"types": {
+		"test:index:Size": {
+			"type": "string",
+			"enum": [{"value": "small"}, {"value": "large"}]
+		},
+		"test:index:Rule": {
+			"type": "object",
+			"properties": {
+				"port": {"type": "integer"},
+				"protocol": {"type": "string"}
+			},
+			"required": ["port"]
+		}
+	},
+	"resources": {
+		"test:index:Server": {
+			"inputProperties": {
+				"name": {"type": "string"},
+				"count": {"type": "integer"},
+				"enabled": {"type": "boolean"},
+				"size": {"$ref": "#/types/test:index:Size"},
+				"rules": {"type": "array", "items": {"$ref": "#/types/test:index:Rule"}},
+				"tags": {"type": "object", "additionalProperties": {"type": "string"}},
+				"portOrName": {"oneOf": [{"type": "integer"}, {"type": "string"}]},
+				"metadata": {"$ref": "pulumi.json#/Any"}
+			},
+			"requiredInputs": ["name"]
+		}
+	}
+}`
+
+func newValidatorTestPackage(t *testing.T) *Package {
+	var spec PackageSpec
+	require.NoError(t, json.Unmarshal([]byte(validatorSchema), &spec))
+	pkg, err := ImportSpec(spec, nil)
+	require.NoError(t, err)
+	return pkg
+}
+
+func TestSchemaValidator(t *testing.T) {
+	t.Parallel()
+
+	v := NewSchemaValidator(newValidatorTestPackage(t))
+
+	cases := []struct {
+		name     string
+		props    resource.PropertyMap
+		expected []plugin.CheckFailure
+	}{
+		{
+			name: "valid",
+			props: resource.NewPropertyMapFromMap(map[string]interface{}{
+				"name":       "server",
+				"count":      3,
+				"enabled":    true,
+				"size":       "large",
+				"rules":      []interface{}{map[string]interface{}{"port": 80, "protocol": "tcp"}},
+				"tags":       map[string]interface{}{"env": "dev"},
+				"portOrName": "http",
+				"metadata":   []interface{}{1, "two"},
+			}),
+		},
+		{
+			name:  "missing required property",
+			props: resource.PropertyMap{"count": resource.NewNumberProperty(1)},
+			expected: []plugin.CheckFailure{
+				{Property: "name", Reason: "missing required property 'name'"},
+			},
+		},
+		{
+			name: "null required property",
+			props: resource.PropertyMap{
+				"name": resource.NewNullProperty(),
+			},
+			expected: []plugin.CheckFailure{
+				{Property: "name", Reason: "missing required property 'name'"},
+			},
+		},
+		{
+			name: "wrong types",
+			props: resource.NewPropertyMapFromMap(map[string]interface{}{
+				"name":    42,
+				"count":   1.5,
+				"enabled": "yes",
+				"tags":    map[string]interface{}{"env": true},
+			}),
+			expected: []plugin.CheckFailure{
+				{Property: "name", Reason: "property 'name' must be of type string, not number"},
+				{Property: "count", Reason: "property 'count' must be of type integer, not number"},
+				{Property: "enabled", Reason: "property 'enabled' must be of type boolean, not string"},
+				{Property: "tags.env", Reason: "property 'tags.env' must be of type string, not bool"},
+			},
+		},
+		{
+			name: "enum",
+			props: resource.NewPropertyMapFromMap(map[string]interface{}{
+				"name": "server",
+				"size": "medium",
+			}),
+			expected: []plugin.CheckFailure{
+				{Property: "size", Reason: `value "medium" of property 'size' is not a member of enum test:index:Size`},
+			},
+		},
+		{
+			name: "nested objects",
+			props: resource.NewPropertyMapFromMap(map[string]interface{}{
+				"name": "server",
+				"rules": []interface{}{
+					map[string]interface{}{"port": 80},
+					map[string]interface{}{"protocol": "udp"},
+					"not an object",
+				},
+			}),
+			expected: []plugin.CheckFailure{
+				{Property: "rules[1].port", Reason: "missing required property 'rules[1].port'"},
+				{Property: "rules[2]", Reason: "property 'rules[2]' must be of type test:index:Rule, not string"},
+			},
+		},
+		{
+			name: "union",
+			props: resource.NewPropertyMapFromMap(map[string]interface{}{
+				"name":       "server",
+				"portOrName": true,
+			}),
+			expected: []plugin.CheckFailure{
+				{Property: "portOrName", Reason: "property 'portOrName' must be of type Union<integer, string>, not bool"},
+			},
+		},
+		{
+			name: "unknowns and secrets",
+			props: resource.PropertyMap{
+				"name":  resource.MakeComputed(resource.NewStringProperty("")),
+				"count": resource.MakeSecret(resource.NewStringProperty("three")),
+				"size":  resource.NewOutputProperty(resource.Output{Element: resource.NewStringProperty("small"), Known: true}),
+			},
+			expected: []plugin.CheckFailure{
+				{Property: "count", Reason: "property 'count' must be of type integer, not string"},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.ElementsMatch(t, c.expected, v.ValidateProperties("test:index:Server", c.props))
+		})
+	}
+}
+
+func TestSchemaValidatorResourceTypes(t *testing.T) {
+	t.Parallel()
+
+	v := NewSchemaValidator(newValidatorTestPackage(t))
+
+	// The package's provider is validated against its configuration.
+	assert.Empty(t, v.ValidateProperties("pulumi:providers:test", resource.NewPropertyMapFromMap(map[string]interface{}{
+		"region": "us-west-2",
+	})))
+	assert.Equal(t, []plugin.CheckFailure{{Property: "region", Reason: "missing required property 'region'"}},
+		v.ValidateProperties("pulumi:providers:test", resource.PropertyMap{}))
+
+	// Resource types that the package does not define are reported.
+	assert.Equal(t, []plugin.CheckFailure{{Reason: "package test does not define resource type test:index:Missing"}},
+		v.ValidateProperties("test:index:Missing", resource.PropertyMap{}))
+}
@@ -37,10 +37,11 @@ import (
 
 const clientRuntimeName = "client"
 
-// ProjectInfoContext returns information about the current project, including its pwd, main, and plugin context.
+// ProjectInfoContext returns information about the current project, including its pwd, main, and plugin context. If
+// host is nil, the plugin context creates a default host with the given options.
 func ProjectInfoContext(projinfo *Projinfo, host plugin.Host,
 	diag, statusDiag diag.Sink, disableProviderPreview bool,
-	tracingSpan opentracing.Span) (string, string, *plugin.Context, error) {
+	tracingSpan opentracing.Span, opts ...plugin.HostOption) (string, string, *plugin.Context, error) {
 
 	contract.Require(projinfo != nil, "projinfo")
 
@@ -52,7 +53,7 @@ func ProjectInfoContext(projinfo *Projinfo, host plugin.Host,
 
 	// Create a context for plugins.
 	ctx, err := plugin.NewContextWithRoot(diag, statusDiag, host, pwd, projinfo.Root,
-		projinfo.Proj.Runtime.Options(), disableProviderPreview, tracingSpan, projinfo.Proj.Plugins)
+		projinfo.Proj.Runtime.Options(), disableProviderPreview, tracingSpan, projinfo.Proj.Plugins, opts...)
 	if err != nil {
 		return "", "", nil, err
 	}
@@ -148,8 +149,12 @@ func newDeployment(ctx *Context, info *deploymentContext, opts deploymentOptions
 	contract.Assert(proj != nil)
 	contract.Assert(target != nil)
 	projinfo := &Projinfo{Proj: proj, Root: info.Update.GetRoot()}
+	var hostOpts []plugin.HostOption
+	for pkg, constraint := range opts.ProviderVersionConstraints {
+		hostOpts = append(hostOpts, plugin.WithProviderVersionConstraint(pkg, constraint))
+	}
 	pwd, main, plugctx, err := ProjectInfoContext(projinfo, opts.Host,
-		opts.Diag, opts.StatusDiag, opts.DisableProviderPreview, info.TracingSpan)
+		opts.Diag, opts.StatusDiag, opts.DisableProviderPreview, info.TracingSpan, hostOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -267,6 +272,8 @@ func (deployment *deployment) run(cancelCtx *Context, actions runActions, policy
 			DisableResourceReferences: deployment.Options.DisableResourceReferences,
 			DisableOutputValues:       deployment.Options.DisableOutputValues,
 			GeneratePlan:              deployment.Options.UpdateOptions.GeneratePlan,
+			Namespace:                 deployment.Options.Namespace,
+			ShowImportNotes:           deployment.Options.ShowImportNotes,
 		}
 		newPlan, walkResult = deployment.Deployment.Execute(ctx, opts, preview)
 		close(done)
@@ -296,13 +303,23 @@ func (deployment *deployment) run(cancelCtx *Context, actions runActions, policy
 	duration := time.Since(start)
 	changes := actions.Changes()
 
+	// If this is a preview, total the cost estimates that providers made for the resources it would change.
+	var estimatedCost *plugin.CostEstimate
+	if costs := deployment.Deployment.Costs(); preview && costs.Len() > 0 {
+		total := costs.Total()
+		estimatedCost = &total
+	}
+
 	// Emit a summary event.
-	deployment.Options.Events.summaryEvent(preview, actions.MaybeCorrupt(), duration, changes, policyPacks)
+	deployment.Options.Events.summaryEvent(preview, actions.MaybeCorrupt(), duration, changes, policyPacks,
+		estimatedCost)
 
 	return newPlan, changes, res
 }
 
 func (deployment *deployment) Close() error {
+	// Close the deployment's providers before the plugin context shuts down the host that loaded them.
+	contract.IgnoreClose(deployment.Deployment)
 	return deployment.Plugctx.Close()
 }
 
@@ -36,15 +36,24 @@ func getProperty(key interface{}, v resource.PropertyValue) resource.PropertyVal
 // If the path consists of a single element, a diff of the indicated kind is inserted directly. Otherwise, if the
 // property named by the first element of the path exists in both parents, we snip off the first element of the path
 // and recurse into the property itself. If the property does not exist in one parent or the other, the diff kind is
-// disregarded and the change is treated as either an Add or a Delete.
-func addDiff(path resource.PropertyPath, kind plugin.DiffKind, parent *resource.ValueDiff,
+// disregarded and the change is treated as either an Add or a Delete. If the provider reported the old or new value
+// of the property, the reported value is recorded in place of the value found in the parent.
+func addDiff(path resource.PropertyPath, pdiff plugin.PropertyDiff, parent *resource.ValueDiff,
 	oldParent, newParent resource.PropertyValue) {
 
 	contract.Require(len(path) > 0, "len(path) > 0")
 
-	element := path[0]
+	element, kind := path[0], pdiff.Kind
 
 	old, new := getProperty(element, oldParent), getProperty(element, newParent)
+	if len(path) == 1 {
+		if !pdiff.OldValue.IsNull() {
+			old = pdiff.OldValue
+		}
+		if !pdiff.NewValue.IsNull() {
+			new = pdiff.NewValue
+		}
+	}
 
 	switch element := element.(type) {
 	case int:
@@ -82,7 +91,7 @@ func addDiff(path resource.PropertyPath, kind plugin.DiffKind, parent *resource.
 				parent.Array.Deletes[element] = old
 			default:
 				ed := parent.Array.Updates[element]
-				addDiff(path[1:], kind, &ed, old, new)
+				addDiff(path[1:], pdiff, &ed, old, new)
 				parent.Array.Updates[element] = ed
 			}
 		}
@@ -120,7 +129,7 @@ func addDiff(path resource.PropertyPath, kind plugin.DiffKind, parent *resource.
 				parent.Object.Deletes[e] = old
 			default:
 				ed := parent.Object.Updates[e]
-				addDiff(path[1:], kind, &ed, old, new)
+				addDiff(path[1:], pdiff, &ed, old, new)
 				parent.Object.Updates[e] = ed
 			}
 		}
@@ -130,16 +139,21 @@ func addDiff(path resource.PropertyPath, kind plugin.DiffKind, parent *resource.
 }
 
 // TranslateDetailedDiff converts the detailed diff stored in the step event into an ObjectDiff that is appropriate
-// for display.
+// for display. Ignored entries are omitted, as they do not describe changes that the step makes.
 func TranslateDetailedDiff(step *StepEventMetadata) *resource.ObjectDiff {
 	contract.Assert(step.DetailedDiff != nil)
 
 	// The rich diff is presented as a list of simple JS property paths and corresponding diffs. We translate this to
-	// an ObjectDiff by iterating the list and inserting ValueDiffs that reflect the changes in the detailed diff. Old
-	// values are always taken from a step's Outputs; new values are always taken from its Inputs.
+	// an ObjectDiff by iterating the list and inserting ValueDiffs that reflect the changes in the detailed diff.
+	// Unless the provider reported them, old values are taken from a step's Outputs and new values are taken from its
+	// Inputs.
 
 	var diff resource.ValueDiff
 	for path, pdiff := range step.DetailedDiff {
+		if pdiff.Ignored {
+			continue
+		}
+
 		elements, err := resource.ParsePropertyPath(path)
 		if err != nil {
 			elements = []interface{}{path}
@@ -149,7 +163,7 @@ func TranslateDetailedDiff(step *StepEventMetadata) *resource.ObjectDiff {
 		if pdiff.InputDiff {
 			olds = resource.NewObjectProperty(step.Old.Inputs)
 		}
-		addDiff(elements, pdiff.Kind, &diff, olds, resource.NewObjectProperty(step.New.Inputs))
+		addDiff(elements, pdiff, &diff, olds, resource.NewObjectProperty(step.New.Inputs))
 	}
 
 	return diff.Object
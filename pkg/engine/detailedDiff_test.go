@@ -724,6 +724,46 @@ func TestTranslateDetailedDiff(t *testing.T) {
 				},
 			},
 		},
+		{
+			// Values that the provider reports take the place of the values in the state and inputs.
+			state: map[string]interface{}{
+				"foo": map[string]interface{}{
+					"bar": "baz",
+				},
+			},
+			inputs: map[string]interface{}{
+				"foo": map[string]interface{}{
+					"bar": "qux",
+				},
+			},
+			detailedDiff: map[string]plugin.PropertyDiff{
+				"foo.bar": {
+					Kind:     plugin.DiffUpdate,
+					OldValue: resource.NewStringProperty("reported-old"),
+					NewValue: resource.NewStringProperty("reported-new"),
+				},
+			},
+			expected: &resource.ObjectDiff{
+				Adds:    resource.PropertyMap{},
+				Deletes: resource.PropertyMap{},
+				Sames:   resource.PropertyMap{},
+				Updates: map[resource.PropertyKey]resource.ValueDiff{
+					"foo": {
+						Object: &resource.ObjectDiff{
+							Adds:    resource.PropertyMap{},
+							Deletes: resource.PropertyMap{},
+							Sames:   resource.PropertyMap{},
+							Updates: map[resource.PropertyKey]resource.ValueDiff{
+								"bar": {
+									Old: resource.NewStringProperty("reported-old"),
+									New: resource.NewStringProperty("reported-new"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -132,6 +132,7 @@ type SummaryEventPayload struct {
 	Duration        time.Duration           // the duration of the entire update operation (zero values for previews)
 	ResourceChanges display.ResourceChanges // count of changed resources, useful for reporting
 	PolicyPacks     map[string]string       // {policy-pack: version} for each policy pack applied
+	EstimatedCost   *plugin.CostEstimate    // the total estimated monthly cost of the changes, if any were estimated
 }
 
 type ResourceOperationFailedPayload struct {
@@ -305,7 +306,13 @@ func makeStepEventMetadata(op display.StepOp, step deploy.Step, debug bool) Step
 	if detailedDiffer, hasDetailedDiff := step.(interface {
 		DetailedDiff() map[string]plugin.PropertyDiff
 	}); hasDetailedDiff {
-		detailedDiff = detailedDiffer.DetailedDiff()
+		detailedDiff = filterDetailedDiff(detailedDiffer.DetailedDiff(), debug)
+	}
+
+	// Display the old outputs as the provider migrated them, if it did, so that they compare with the new outputs.
+	old := makeStepEventStateMetadata(step.Old(), debug)
+	if migrated, ok := step.(interface{ OldOutputs() resource.PropertyMap }); ok && old != nil {
+		old.Outputs = filterResourceProperties(migrated.OldOutputs(), debug)
 	}
 
 	return StepEventMetadata{
@@ -315,7 +322,7 @@ func makeStepEventMetadata(op display.StepOp, step deploy.Step, debug bool) Step
 		Keys:         keys,
 		Diffs:        diffs,
 		DetailedDiff: detailedDiff,
-		Old:          makeStepEventStateMetadata(step.Old(), debug),
+		Old:          old,
 		New:          makeStepEventStateMetadata(step.New(), debug),
 		Res:          makeStepEventStateMetadata(step.Res(), debug),
 		Logical:      step.Logical(),
@@ -405,7 +412,7 @@ func (e *eventEmitter) preludeEvent(isPreview bool, cfg config.Map) {
 }
 
 func (e *eventEmitter) summaryEvent(preview, maybeCorrupt bool, duration time.Duration,
-	resourceChanges display.ResourceChanges, policyPacks map[string]string) {
+	resourceChanges display.ResourceChanges, policyPacks map[string]string, estimatedCost *plugin.CostEstimate) {
 
 	contract.Requiref(e != nil, "e", "!= nil")
 
@@ -415,6 +422,7 @@ func (e *eventEmitter) summaryEvent(preview, maybeCorrupt bool, duration time.Du
 		Duration:        duration,
 		ResourceChanges: resourceChanges,
 		PolicyPacks:     policyPacks,
+		EstimatedCost:   estimatedCost,
 	}))
 }
 
@@ -493,6 +501,20 @@ func (e *eventEmitter) diagWarningEvent(d *diag.Diag, prefix, msg string, epheme
 	diagEvent(e, d, prefix, msg, diag.Warning, ephemeral)
 }
 
+// filterDetailedDiff filters the old and new values that a provider reported in a detailed diff in the same way that
+// the properties of a step's states are filtered.
+func filterDetailedDiff(diff map[string]plugin.PropertyDiff, debug bool) map[string]plugin.PropertyDiff {
+	if diff == nil {
+		return nil
+	}
+	filtered := make(map[string]plugin.PropertyDiff, len(diff))
+	for k, d := range diff {
+		d.OldValue, d.NewValue = filterPropertyValue(d.OldValue, debug), filterPropertyValue(d.NewValue, debug)
+		filtered[k] = d
+	}
+	return filtered
+}
+
 func filterResourceProperties(m resource.PropertyMap, debug bool) resource.PropertyMap {
 	return filterPropertyValue(resource.NewObjectProperty(m), debug).ObjectValue()
 }
@@ -4,6 +4,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
 )
 
 func TestTrySendEvent(t *testing.T) {
@@ -21,3 +24,25 @@ func TestTryCloseEventChan(t *testing.T) {
 	assert.Equal(t, true, tryCloseEventChan(c))
 	assert.Equal(t, false, tryCloseEventChan(c))
 }
+
+func TestFilterDetailedDiff(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, filterDetailedDiff(nil, false))
+
+	filtered := filterDetailedDiff(map[string]plugin.PropertyDiff{
+		"password": {
+			Kind:     plugin.DiffUpdate,
+			OldValue: resource.MakeSecret(resource.NewStringProperty("hunter1")),
+			NewValue: resource.MakeSecret(resource.NewStringProperty("hunter2")),
+		},
+		"size": {Kind: plugin.DiffUpdate, NewValue: resource.NewNumberProperty(3)},
+	}, false)
+
+	// Secret values are masked, and values that were not reported remain null.
+	secret := resource.MakeSecret(resource.NewStringProperty("[secret]"))
+	assert.Equal(t, plugin.PropertyDiff{Kind: plugin.DiffUpdate, OldValue: secret, NewValue: secret},
+		filtered["password"])
+	assert.Equal(t, plugin.PropertyDiff{Kind: plugin.DiffUpdate, NewValue: resource.NewNumberProperty(3)},
+		filtered["size"])
+}
@@ -2,6 +2,7 @@ package lifecycletest
 
 import (
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/blang/semver"
@@ -11,6 +12,7 @@ import (
 	. "github.com/pulumi/pulumi/pkg/v3/engine"
 	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
 	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/result"
@@ -526,6 +528,204 @@ func TestImportPlan(t *testing.T) {
 	assert.Len(t, snap.Resources, 4)
 }
 
+func TestImportNormalizedID(t *testing.T) {
+	t.Parallel()
+
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				GetSchemaF: func(version int) ([]byte, error) {
+					return []byte(importSchema), nil
+				},
+				DiffF: diffImportResource,
+				ReadF: func(urn resource.URN, id resource.ID,
+					inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error) {
+
+					return plugin.ReadResult{
+						ImportID: "canonical-id",
+						Inputs: resource.PropertyMap{
+							"foo":  resource.NewStringProperty("bar"),
+							"frob": resource.NewNumberProperty(1),
+						},
+						Outputs: resource.PropertyMap{
+							"foo":  resource.NewStringProperty("bar"),
+							"frob": resource.NewNumberProperty(1),
+						},
+					}, resource.StatusOK, nil
+				},
+			}, nil
+		}),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		return nil
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	p := &TestPlan{
+		Options: UpdateOptions{Host: host},
+	}
+
+	// Run an import and check that the user is told about the normalized ID.
+	project := p.GetProject()
+	snap, res := ImportOp([]deploy.Import{{
+		Type: "pkgA:m:typA",
+		Name: "resA",
+		ID:   "arn:imported-id",
+	}}).Run(project, p.GetTarget(t, nil), p.Options, false, p.BackendClient,
+		func(_ workspace.Project, _ deploy.Target, _ JournalEntries, events []Event, res result.Result) result.Result {
+			sawNormalization := false
+			for _, evt := range events {
+				if evt.Type == DiagEvent {
+					e := evt.Payload().(DiagEventPayload)
+					if e.Severity == diag.Info && strings.Contains(e.Message, "canonical-id") {
+						sawNormalization = true
+					}
+				}
+			}
+			assert.True(t, sawNormalization)
+			return res
+		})
+	assert.Nil(t, res)
+
+	// The normalized ID should be recorded in the state.
+	require.Len(t, snap.Resources, 3)
+	assert.Equal(t, resource.ID("canonical-id"), snap.Resources[2].ID)
+}
+
+func TestImportMetadata(t *testing.T) {
+	t.Parallel()
+
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				GetSchemaF: func(version int) ([]byte, error) {
+					return []byte(importSchema), nil
+				},
+				DiffF: diffImportResource,
+				ReadF: func(urn resource.URN, id resource.ID,
+					inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error) {
+
+					return plugin.ReadResult{
+						Inputs: resource.PropertyMap{
+							"foo":  resource.NewStringProperty("bar"),
+							"frob": resource.NewNumberProperty(1),
+						},
+						Outputs: resource.PropertyMap{
+							"foo":  resource.NewStringProperty("bar"),
+							"frob": resource.NewNumberProperty(1),
+						},
+						ImportMetadata: &plugin.ImportMetadata{
+							Notes:         []string{"the bucket's lifecycle rules are managed separately"},
+							OriginalOwner: "org/project/other-stack",
+							Warnings:      []string{"the bucket is still referenced by another stack"},
+						},
+					}, resource.StatusOK, nil
+				},
+			}, nil
+		}),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		return nil
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	// Warnings are always reported, but notes and the original owner are only reported if they were asked for.
+	for _, showNotes := range []bool{false, true} {
+		p := &TestPlan{
+			Options: UpdateOptions{Host: host, ShowImportNotes: showNotes},
+		}
+
+		project := p.GetProject()
+		_, res := ImportOp([]deploy.Import{{
+			Type: "pkgA:m:typA",
+			Name: "resA",
+			ID:   "imported-id",
+		}}).Run(project, p.GetTarget(t, nil), p.Options, false, p.BackendClient,
+			func(_ workspace.Project, _ deploy.Target, _ JournalEntries, events []Event,
+				res result.Result) result.Result {
+
+				sawWarning, sawNote, sawOwner := false, false, false
+				for _, evt := range events {
+					if evt.Type == DiagEvent {
+						e := evt.Payload().(DiagEventPayload)
+						switch {
+						case e.Severity == diag.Warning && strings.Contains(e.Message, "another stack"):
+							sawWarning = true
+						case e.Severity == diag.Info && strings.Contains(e.Message, "lifecycle rules"):
+							sawNote = true
+						case e.Severity == diag.Info && strings.Contains(e.Message, "org/project/other-stack"):
+							sawOwner = true
+						}
+					}
+				}
+				assert.True(t, sawWarning)
+				assert.Equal(t, showNotes, sawNote)
+				assert.Equal(t, showNotes, sawOwner)
+				return res
+			})
+		assert.Nil(t, res)
+	}
+}
+
+func TestImportPrepareImport(t *testing.T) {
+	t.Parallel()
+
+	var readInputs resource.PropertyMap
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				GetSchemaF: func(version int) ([]byte, error) {
+					return []byte(importSchema), nil
+				},
+				DiffF: diffImportResource,
+				PrepareImportF: func(urn resource.URN, id resource.ID) (resource.PropertyMap, error) {
+					return resource.PropertyMap{
+						"foo": resource.NewStringProperty(string(id)),
+					}, nil
+				},
+				// Read returns the full state of the resource but no inputs, so the prepared inputs are used.
+				ReadF: func(urn resource.URN, id resource.ID,
+					inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error) {
+
+					readInputs = inputs
+					return plugin.ReadResult{
+						Outputs: resource.PropertyMap{
+							"foo":  resource.NewStringProperty(string(id)),
+							"frob": resource.NewNumberProperty(1),
+						},
+					}, resource.StatusOK, nil
+				},
+			}, nil
+		}),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		return nil
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	p := &TestPlan{
+		Options: UpdateOptions{Host: host},
+	}
+
+	project := p.GetProject()
+	snap, res := ImportOp([]deploy.Import{{
+		Type: "pkgA:m:typA",
+		Name: "resA",
+		ID:   "imported-id",
+	}}).Run(project, p.GetTarget(t, nil), p.Options, false, p.BackendClient, nil)
+	assert.Nil(t, res)
+
+	// The prepared inputs are passed to Read and recorded as the resource's inputs.
+	prepared := resource.PropertyMap{"foo": resource.NewStringProperty("imported-id")}
+	assert.Equal(t, prepared, readInputs)
+	require.Len(t, snap.Resources, 3)
+	assert.Equal(t, prepared, snap.Resources[2].Inputs)
+	assert.Equal(t, resource.NewNumberProperty(1), snap.Resources[2].Outputs["frob"])
+}
+
 func TestImportIgnoreChanges(t *testing.T) {
 	t.Parallel()
 
@@ -2,8 +2,12 @@
 package lifecycletest
 
 import (
+	"context"
+	"errors"
+	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/blang/semver"
 	"github.com/gofrs/uuid"
@@ -14,10 +18,14 @@ import (
 	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
 	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/deploytest"
 	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag/colors"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/display"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/result"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
 )
@@ -963,6 +971,68 @@ func TestDefaultProviderDiffReplacement(t *testing.T) {
 	}
 }
 
+func TestDefaultProviderDiffReplacementAffectedResources(t *testing.T) {
+	t.Parallel()
+
+	p := &TestPlan{}
+	resAURN := p.NewURN("pkgA:m:typA", "resA", "")
+
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("0.17.10"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				// This implementation of DiffConfig requests replacement, but reports that only resA is affected.
+				DiffConfigF: func(_ resource.URN, olds, news resource.PropertyMap,
+					ignoreChanges []string) (plugin.DiffResult, error) {
+
+					return plugin.DiffResult{
+						Changes:           plugin.DiffSome,
+						ReplaceKeys:       []resource.PropertyKey{"version"},
+						AffectedResources: []resource.URN{resAURN},
+					}, nil
+				},
+			}, nil
+		}),
+	}
+
+	version := ""
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		for _, name := range []string{"resA", "resB"} {
+			_, _, _, err := monitor.RegisterResource("pkgA:m:typA", name, true, deploytest.ResourceOptions{
+				Version: version,
+			})
+			assert.NoError(t, err)
+		}
+		return nil
+	})
+	p.Options.Host = deploytest.NewPluginHost(nil, nil, program, loaders...)
+	p.Steps = []TestStep{{Op: Update}}
+
+	snap := p.Run(t, nil)
+
+	// Once the language host sends a version, the default provider is replaced. Only the resource that DiffConfig
+	// reported as affected is replaced along with it.
+	version = "0.17.10"
+	p.Steps = []TestStep{{
+		Op: Update,
+		Validate: func(project workspace.Project, target deploy.Target, entries JournalEntries,
+			events []Event, res result.Result) result.Result {
+
+			ops := make(map[string][]display.StepOp)
+			for _, entry := range entries {
+				if entry.Kind == JournalEntrySuccess && !providers.IsProviderType(entry.Step.Type()) {
+					name := entry.Step.URN().Name().String()
+					ops[name] = append(ops[name], entry.Step.Op())
+				}
+			}
+			assert.ElementsMatch(t,
+				[]display.StepOp{deploy.OpCreateReplacement, deploy.OpReplace, deploy.OpDeleteReplaced}, ops["resA"])
+			assert.Equal(t, []display.StepOp{deploy.OpSame}, ops["resB"])
+			return res
+		},
+	}}
+	p.Run(t, snap)
+}
+
 func TestProviderVersionDefault(t *testing.T) {
 	t.Parallel()
 
@@ -1465,3 +1535,671 @@ func TestProviderVersionAssignment(t *testing.T) {
 		})
 	}
 }
+
+// TestProviderValidationFailure checks that a provider whose pre-flight validation fails is not used to create any
+// resources, and that the validation error is reported to the user.
+func TestProviderValidationFailure(t *testing.T) {
+	t.Parallel()
+
+	created := false
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				ValidateF: func() error {
+					return errors.New("invalid credentials")
+				},
+				CreateF: func(urn resource.URN, news resource.PropertyMap, timeout float64,
+					preview bool) (resource.ID, resource.PropertyMap, resource.Status, error) {
+					created = true
+					return "id", news, resource.StatusOK, nil
+				},
+			}, nil
+		}),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true)
+		assert.Error(t, err)
+		return err
+	})
+
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+	p := &TestPlan{
+		Options: UpdateOptions{Host: host},
+		Steps: []TestStep{{
+			Op:            Update,
+			ExpectFailure: true,
+			SkipPreview:   true,
+			Validate: func(project workspace.Project, target deploy.Target, entries JournalEntries,
+				evts []Event, res result.Result) result.Result {
+
+				sawFailure := false
+				for _, evt := range evts {
+					if evt.Type == DiagEvent {
+						e := evt.Payload().(DiagEventPayload)
+						msg := colors.Never.Colorize(e.Message)
+						if e.Severity == diag.Error && strings.Contains(msg, "failed validation: invalid credentials") {
+							sawFailure = true
+						}
+					}
+				}
+
+				assert.True(t, sawFailure)
+				return res
+			},
+		}},
+	}
+
+	p.Run(t, nil)
+	assert.False(t, created)
+}
+
+// TestProviderParameterization checks that a provider resource whose inputs describe a parameterization is
+// parameterized after it is configured and before it is used to create any resources.
+func TestProviderParameterization(t *testing.T) {
+	t.Parallel()
+
+	var parameters []string
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			var prov *deploytest.Provider
+			prov = &deploytest.Provider{
+				ParameterizeByValueF: func(value []byte) (plugin.ParameterizeResult, error) {
+					assert.NotNil(t, prov.Config)
+					parameters = append(parameters, string(value))
+					return plugin.ParameterizeResult{Name: string(value)}, nil
+				},
+				CreateF: func(urn resource.URN, news resource.PropertyMap, timeout float64,
+					preview bool) (resource.ID, resource.PropertyMap, resource.Status, error) {
+					assert.NotEmpty(t, parameters)
+					return "id", news, resource.StatusOK, nil
+				},
+			}
+			return prov, nil
+		}),
+	}
+
+	providerInputs := resource.PropertyMap{}
+	providers.SetProviderParameterization(providerInputs, providers.Parameterization{Value: []byte("sub")})
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		provURN, provID, _, err := monitor.RegisterResource(providers.MakeProviderType("pkgA"), "provA", true,
+			deploytest.ResourceOptions{Inputs: providerInputs})
+		assert.NoError(t, err)
+
+		provRef, err := providers.NewReference(provURN, provID)
+		assert.NoError(t, err)
+
+		_, _, _, err = monitor.RegisterResource("pkgA:m:typA", "resA", true, deploytest.ResourceOptions{
+			Provider: provRef.String(),
+		})
+		assert.NoError(t, err)
+		return nil
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	p := &TestPlan{
+		Options: UpdateOptions{Host: host},
+	}
+	_, res := TestOp(Update).Run(p.GetProject(), p.GetTarget(t, nil), p.Options, false, p.BackendClient, nil)
+	assert.Nil(t, res)
+	assert.Equal(t, []string{"sub"}, parameters)
+}
+
+// Test that a provider's mappings are reported through the gRPC provider host.
+func TestProviderGetMapping(t *testing.T) {
+	t.Parallel()
+
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				GetMappingF: func(key string) ([]byte, string, error) {
+					if key != "terraform" {
+						return nil, "", nil
+					}
+					return []byte("mapping-data"), "pkgA", nil
+				},
+			}, nil
+		}, deploytest.WithGrpc),
+	}
+
+	host := deploytest.NewPluginHost(nil, nil, nil, loaders...)
+	defer contract.IgnoreClose(host)
+
+	version := semver.MustParse("1.0.0")
+	prov, err := host.Provider("pkgA", &version)
+	require.NoError(t, err)
+
+	data, provider, err := prov.GetMapping(context.Background(), "terraform")
+	require.NoError(t, err)
+	assert.Equal(t, "pkgA", provider)
+	assert.Equal(t, []byte("mapping-data"), data)
+
+	data, provider, err = prov.GetMapping(context.Background(), "other")
+	require.NoError(t, err)
+	assert.Empty(t, provider)
+	assert.Empty(t, data)
+}
+
+func TestMigrateState(t *testing.T) {
+	t.Parallel()
+
+	schemaVersion, migrateCalls, canMigrate := 1, 0, true
+	var diffOlds resource.PropertyMap
+
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				SchemaVersionF: func() (int, error) {
+					return schemaVersion, nil
+				},
+				MigrateStateF: func(urn resource.URN, stateVersion int,
+					state resource.PropertyMap) (resource.PropertyMap, error) {
+
+					migrateCalls++
+					if !canMigrate {
+						return nil, plugin.ErrNotYetImplemented
+					}
+					// Version 2 renamed "size" to "name".
+					assert.Equal(t, 1, stateVersion)
+					return resource.PropertyMap{"name": state["size"]}, nil
+				},
+				CreateF: func(urn resource.URN, news resource.PropertyMap, timeout float64,
+					preview bool) (resource.ID, resource.PropertyMap, resource.Status, error) {
+					return "created-id", news, resource.StatusOK, nil
+				},
+				DiffF: func(urn resource.URN, id resource.ID,
+					olds, news resource.PropertyMap, ignoreChanges []string) (plugin.DiffResult, error) {
+
+					diffOlds = olds
+					return plugin.DiffResult{Changes: plugin.DiffNone}, nil
+				},
+			}, nil
+		}, deploytest.WithoutGrpc),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true, deploytest.ResourceOptions{
+			Inputs: resource.PropertyMap{"size": resource.NewStringProperty("large")},
+		})
+		assert.NoError(t, err)
+		return nil
+	})
+	p := &TestPlan{
+		Options: UpdateOptions{Host: deploytest.NewPluginHost(nil, nil, program, loaders...)},
+	}
+	project := p.GetProject()
+
+	// The first update records the provider's schema version.
+	snap, res := TestOp(Update).Run(project, p.GetTarget(t, nil), p.Options, false, p.BackendClient, nil)
+	assert.Nil(t, res)
+	require.Len(t, snap.Resources, 2)
+	assert.Equal(t, 1, snap.Resources[1].SchemaVersion)
+	assert.Equal(t, 0, migrateCalls)
+
+	// Once the provider's schema version changes, the old state is migrated before it is diffed. Previews leave the
+	// old state itself as it is.
+	schemaVersion = 2
+	original := resource.PropertyMap{"size": resource.NewStringProperty("large")}
+	expected := resource.PropertyMap{"name": resource.NewStringProperty("large")}
+	target := p.GetTarget(t, snap)
+	_, res = TestOp(Update).Run(project, target, p.Options, true, p.BackendClient, nil)
+	assert.Nil(t, res)
+	assert.Equal(t, expected, diffOlds)
+	assert.Equal(t, original, target.Snapshot.Resources[1].Outputs)
+	assert.Equal(t, 1, target.Snapshot.Resources[1].SchemaVersion)
+	assert.Equal(t, 1, migrateCalls)
+
+	snap, res = TestOp(Update).Run(project, p.GetTarget(t, snap), p.Options, false, p.BackendClient, nil)
+	assert.Nil(t, res)
+	require.Len(t, snap.Resources, 2)
+	assert.Equal(t, expected, diffOlds)
+	assert.Equal(t, expected, snap.Resources[1].Outputs)
+	assert.Equal(t, 2, snap.Resources[1].SchemaVersion)
+	assert.Equal(t, 2, migrateCalls)
+
+	// Migration is skipped if the schema version is unchanged.
+	snap, res = TestOp(Update).Run(project, p.GetTarget(t, snap), p.Options, false, p.BackendClient, nil)
+	assert.Nil(t, res)
+	assert.Equal(t, 2, migrateCalls)
+
+	// Providers that cannot migrate state are given the old state as-is, which keeps its schema version.
+	schemaVersion, canMigrate = 3, false
+	snap, res = TestOp(Update).Run(project, p.GetTarget(t, snap), p.Options, false, p.BackendClient, nil)
+	assert.Nil(t, res)
+	require.Len(t, snap.Resources, 2)
+	assert.Equal(t, 3, migrateCalls)
+	assert.Equal(t, expected, diffOlds)
+	assert.Equal(t, expected, snap.Resources[1].Outputs)
+	assert.Equal(t, 2, snap.Resources[1].SchemaVersion)
+}
+
+func TestRefreshMigratesState(t *testing.T) {
+	t.Parallel()
+
+	migrateCalls := 0
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				SchemaVersionF: func() (int, error) {
+					return 1, nil
+				},
+				MigrateStateF: func(urn resource.URN, stateVersion int,
+					state resource.PropertyMap) (resource.PropertyMap, error) {
+
+					migrateCalls++
+					// Version 2 renamed "size" to "name".
+					assert.Equal(t, 1, stateVersion)
+					return resource.PropertyMap{"name": state["size"]}, nil
+				},
+				CreateF: func(urn resource.URN, news resource.PropertyMap, timeout float64,
+					preview bool) (resource.ID, resource.PropertyMap, resource.Status, error) {
+					return "created-id", news, resource.StatusOK, nil
+				},
+				ReadF: func(urn resource.URN, id resource.ID,
+					inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error) {
+
+					// The live resource is read with a newer state schema than the one it was created with.
+					return plugin.ReadResult{
+						Outputs:       resource.PropertyMap{"name": resource.NewStringProperty("large")},
+						SchemaVersion: 2,
+					}, resource.StatusOK, nil
+				},
+			}, nil
+		}, deploytest.WithoutGrpc),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true, deploytest.ResourceOptions{
+			Inputs: resource.PropertyMap{"size": resource.NewStringProperty("large")},
+		})
+		assert.NoError(t, err)
+		return nil
+	})
+	p := &TestPlan{
+		Options: UpdateOptions{Host: deploytest.NewPluginHost(nil, nil, program, loaders...)},
+	}
+	project := p.GetProject()
+
+	snap, res := TestOp(Update).Run(project, p.GetTarget(t, nil), p.Options, false, p.BackendClient, nil)
+	assert.Nil(t, res)
+	require.Len(t, snap.Resources, 2)
+	assert.Equal(t, 1, snap.Resources[1].SchemaVersion)
+
+	// The old outputs are migrated to the schema version of the read state, so the refresh sees no changes. The old
+	// state itself is left as it is.
+	original := resource.PropertyMap{"size": resource.NewStringProperty("large")}
+	expected := resource.PropertyMap{"name": resource.NewStringProperty("large")}
+	validate := func(project workspace.Project, target deploy.Target, entries JournalEntries,
+		_ []Event, res result.Result) result.Result {
+
+		for _, entry := range entries {
+			if entry.Step.URN() == snap.Resources[1].URN {
+				refresh := entry.Step.(*deploy.RefreshStep)
+				assert.Equal(t, expected, refresh.OldOutputs())
+				assert.Equal(t, original, refresh.Old().Outputs)
+				assert.Equal(t, 1, refresh.Old().SchemaVersion)
+				assert.Equal(t, deploy.OpSame, refresh.ResultOp())
+			}
+		}
+		return res
+	}
+	snap, res = TestOp(Refresh).Run(project, p.GetTarget(t, snap), p.Options, false, p.BackendClient, validate)
+	assert.Nil(t, res)
+	require.Len(t, snap.Resources, 2)
+	assert.Equal(t, expected, snap.Resources[1].Outputs)
+	assert.Equal(t, 2, snap.Resources[1].SchemaVersion)
+	assert.Equal(t, 1, migrateCalls)
+}
+
+func TestProviderEventEmitterRefresh(t *testing.T) {
+	t.Parallel()
+
+	var changed []resource.URN
+	var diffOlds resource.PropertyMap
+
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				RegisterEventEmitterF: func(e plugin.EventEmitter) error {
+					// Report the changes that the provider has observed as soon as the engine subscribes.
+					for _, urn := range changed {
+						if err := e.EmitResourceChanged(urn, "created-id"); err != nil {
+							return err
+						}
+					}
+					return nil
+				},
+				CreateF: func(urn resource.URN, news resource.PropertyMap, timeout float64,
+					preview bool) (resource.ID, resource.PropertyMap, resource.Status, error) {
+					return "created-id", news, resource.StatusOK, nil
+				},
+				ReadF: func(urn resource.URN, id resource.ID,
+					inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error) {
+
+					live := state.Copy()
+					live["size"] = resource.NewStringProperty("small")
+					return plugin.ReadResult{Outputs: live}, resource.StatusOK, nil
+				},
+				DiffF: func(urn resource.URN, id resource.ID,
+					olds, news resource.PropertyMap, ignoreChanges []string) (plugin.DiffResult, error) {
+
+					diffOlds = olds
+					return plugin.DiffResult{Changes: plugin.DiffNone}, nil
+				},
+			}, nil
+		}, deploytest.WithoutGrpc),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true, deploytest.ResourceOptions{
+			Inputs: resource.PropertyMap{"size": resource.NewStringProperty("large")},
+		})
+		assert.NoError(t, err)
+		return nil
+	})
+	p := &TestPlan{
+		Options: UpdateOptions{Host: deploytest.NewPluginHost(nil, nil, program, loaders...)},
+	}
+	project := p.GetProject()
+
+	snap, res := TestOp(Update).Run(project, p.GetTarget(t, nil), p.Options, false, p.BackendClient, nil)
+	assert.Nil(t, res)
+	require.Len(t, snap.Resources, 2)
+	urn := snap.Resources[1].URN
+
+	// Without any reported changes, the resource is diffed against its stored state.
+	snap, res = TestOp(Update).Run(project, p.GetTarget(t, snap), p.Options, false, p.BackendClient, nil)
+	assert.Nil(t, res)
+	assert.Equal(t, resource.NewStringProperty("large"), diffOlds["size"])
+
+	// Once the provider reports that the resource changed, it is refreshed before it is diffed. Previews leave the
+	// old state itself as it is.
+	changed = []resource.URN{urn}
+	target := p.GetTarget(t, snap)
+	_, res = TestOp(Update).Run(project, target, p.Options, true, p.BackendClient, nil)
+	assert.Nil(t, res)
+	assert.Equal(t, resource.NewStringProperty("small"), diffOlds["size"])
+	assert.Equal(t, resource.NewStringProperty("large"), target.Snapshot.Resources[1].Outputs["size"])
+
+	snap, res = TestOp(Update).Run(project, p.GetTarget(t, snap), p.Options, false, p.BackendClient, nil)
+	assert.Nil(t, res)
+	require.Len(t, snap.Resources, 2)
+	assert.Equal(t, resource.NewStringProperty("small"), diffOlds["size"])
+	assert.Equal(t, resource.NewStringProperty("small"), snap.Resources[1].Outputs["size"])
+}
+
+// Test that the interim states that a provider reports from StreamCreate are displayed as status messages.
+func TestProviderStreamCreate(t *testing.T) {
+	t.Parallel()
+
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				StreamCreateF: func(urn resource.URN, inputs resource.PropertyMap, timeout float64, preview bool,
+					onNext func(resource.PropertyMap) error) (resource.ID, resource.PropertyMap, resource.Status,
+					error) {
+
+					for _, status := range []string{"pending", "running"} {
+						err := onNext(resource.PropertyMap{
+							"status": resource.NewStringProperty(status),
+							"token":  resource.MakeSecret(resource.NewStringProperty("hunter2")),
+						})
+						if err != nil {
+							return "", nil, resource.StatusUnknown, err
+						}
+					}
+					return "created-id", resource.PropertyMap{"status": resource.NewStringProperty("succeeded")},
+						resource.StatusOK, nil
+				},
+			}, nil
+		}, deploytest.WithGrpc),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true)
+		assert.NoError(t, err)
+		return nil
+	})
+
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+	p := &TestPlan{
+		Options: UpdateOptions{Host: host},
+		Steps: []TestStep{{
+			Op:          Update,
+			SkipPreview: true,
+			Validate: func(project workspace.Project, target deploy.Target, entries JournalEntries,
+				evts []Event, res result.Result) result.Result {
+
+				var statuses []string
+				for _, evt := range evts {
+					if evt.Type == DiagEvent {
+						e := evt.Payload().(DiagEventPayload)
+						if e.Ephemeral && e.URN.Name() == "resA" {
+							statuses = append(statuses, colors.Never.Colorize(e.Message))
+						}
+					}
+				}
+				assert.Equal(t, []string{
+					"status=pending, token=[secret]\n",
+					"status=running, token=[secret]\n",
+				}, statuses)
+				return res
+			},
+		}},
+	}
+
+	snap := p.Run(t, nil)
+	require.Len(t, snap.Resources, 2)
+	assert.Equal(t, resource.ID("created-id"), snap.Resources[1].ID)
+	assert.Equal(t, resource.NewStringProperty("succeeded"), snap.Resources[1].Outputs["status"])
+}
+
+func TestProviderEstimateCost(t *testing.T) {
+	t.Parallel()
+
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				EstimateCostF: func(urn resource.URN, news resource.PropertyMap) (plugin.CostEstimate, error) {
+					if news["size"].StringValue() == "large" {
+						return plugin.CostEstimate{MonthlyCostUSD: 40, Currency: "USD", Confidence: 0.5}, nil
+					}
+					return plugin.CostEstimate{MonthlyCostUSD: 10, Currency: "USD", Confidence: 1}, nil
+				},
+			}, nil
+		}, deploytest.WithGrpc),
+		// pkgB cannot estimate costs, so its resources are left out of the total.
+		deploytest.NewProviderLoader("pkgB", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{}, nil
+		}),
+	}
+
+	sizeB := "small"
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true, deploytest.ResourceOptions{
+			Inputs: resource.PropertyMap{"size": resource.NewStringProperty("small")},
+		})
+		assert.NoError(t, err)
+		_, _, _, err = monitor.RegisterResource("pkgA:m:typA", "resB", true, deploytest.ResourceOptions{
+			Inputs: resource.PropertyMap{"size": resource.NewStringProperty(sizeB)},
+		})
+		assert.NoError(t, err)
+		_, _, _, err = monitor.RegisterResource("pkgB:m:typB", "resC", true)
+		assert.NoError(t, err)
+		return nil
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	p := &TestPlan{
+		Options: UpdateOptions{Host: host},
+	}
+	project := p.GetProject()
+
+	expectEstimatedCost := func(expected *plugin.CostEstimate) ValidateFunc {
+		return func(_ workspace.Project, _ deploy.Target, _ JournalEntries,
+			events []Event, res result.Result) result.Result {
+
+			var summaries []SummaryEventPayload
+			for _, e := range events {
+				if e.Type == SummaryEvent {
+					summaries = append(summaries, e.Payload().(SummaryEventPayload))
+				}
+			}
+			require.Len(t, summaries, 1)
+			assert.Equal(t, expected, summaries[0].EstimatedCost)
+			return res
+		}
+	}
+
+	// A preview of the initial update totals the estimates for the resources that it would create.
+	_, res := TestOp(Update).Run(project, p.GetTarget(t, nil), p.Options, true, p.BackendClient,
+		expectEstimatedCost(&plugin.CostEstimate{MonthlyCostUSD: 20, Currency: "USD", Confidence: 1}))
+	assert.Nil(t, res)
+
+	// Updates do not estimate costs.
+	snap, res := TestOp(Update).Run(project, p.GetTarget(t, nil), p.Options, false, p.BackendClient,
+		expectEstimatedCost(nil))
+	assert.Nil(t, res)
+
+	// A preview of a change to one resource only includes the estimate for that resource.
+	sizeB = "large"
+	_, res = TestOp(Update).Run(project, p.GetTarget(t, snap), p.Options, true, p.BackendClient,
+		expectEstimatedCost(&plugin.CostEstimate{MonthlyCostUSD: 40, Currency: "USD", Confidence: 0.5}))
+	assert.Nil(t, res)
+}
+
+func TestProviderWaitForResourceReady(t *testing.T) {
+	t.Parallel()
+
+	var waited []resource.URN
+	var readyErr error
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				GetSchemaF: func(version int) ([]byte, error) {
+					return []byte(`{"name":"pkgA","resources":{` +
+						`"pkgA:m:typA":{"waitForReady":true},"pkgA:m:typB":{}}}`), nil
+				},
+				SupportsFeatureF: func(feature string) (bool, error) {
+					return feature == plugin.FeatureWaitForResourceReady, nil
+				},
+				CreateF: func(urn resource.URN, news resource.PropertyMap, timeout float64,
+					preview bool) (resource.ID, resource.PropertyMap, resource.Status, error) {
+					return resource.ID(urn.Name() + "-id"), resource.PropertyMap{}, resource.StatusOK, nil
+				},
+				WaitForResourceReadyF: func(urn resource.URN, id resource.ID, timeout float64) error {
+					assert.Equal(t, resource.ID(urn.Name()+"-id"), id)
+					assert.Equal(t, float64(60), timeout)
+					waited = append(waited, urn)
+					return readyErr
+				},
+			}, nil
+		}, deploytest.WithGrpc),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		opts := deploytest.ResourceOptions{CustomTimeouts: &resource.CustomTimeouts{Create: 60}}
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true, opts)
+		assert.NoError(t, err)
+		_, _, _, err = monitor.RegisterResource("pkgA:m:typB", "resB", true, opts)
+		assert.NoError(t, err)
+		return nil
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+	p := &TestPlan{
+		Options: UpdateOptions{Host: host},
+	}
+	project := p.GetProject()
+
+	// Only the resource whose schema sets waitForReady is waited on, and only when it is actually created.
+	_, res := TestOp(Update).Run(project, p.GetTarget(t, nil), p.Options, true, p.BackendClient, nil)
+	assert.Nil(t, res)
+	assert.Empty(t, waited)
+
+	snap, res := TestOp(Update).Run(project, p.GetTarget(t, nil), p.Options, false, p.BackendClient, nil)
+	assert.Nil(t, res)
+	require.Len(t, waited, 1)
+	assert.Equal(t, "resA", string(waited[0].Name()))
+	require.Len(t, snap.Resources, 3)
+
+	// A resource that does not become ready fails the update, but is still recorded in the state.
+	waited, readyErr = nil, &plugin.WaitTimeoutError{Timeout: 60 * time.Second}
+	snap, res = TestOp(Update).Run(project, p.GetTarget(t, nil), p.Options, false, p.BackendClient, nil)
+	assert.NotNil(t, res)
+	require.Len(t, waited, 1)
+	var found bool
+	for _, r := range snap.Resources {
+		if r.URN == waited[0] {
+			found = true
+			assert.Equal(t, resource.ID("resA-id"), r.ID)
+		}
+	}
+	assert.True(t, found)
+}
+
+func TestProviderResourceAliases(t *testing.T) {
+	t.Parallel()
+
+	creates, deletes, aliasCalls := 0, 0, 0
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				SupportsFeatureF: func(feature string) (bool, error) {
+					return feature == plugin.FeatureGetResourceAliases, nil
+				},
+				// Version 2 of the provider renamed pkgA:m:typA to pkgA:m:typB.
+				GetResourceAliasesF: func(urn resource.URN) ([]resource.Alias, error) {
+					aliasCalls++
+					if urn.Type() != "pkgA:m:typB" {
+						return nil, nil
+					}
+					return []resource.Alias{{Type: "pkgA:m:typA"}}, nil
+				},
+				CreateF: func(urn resource.URN, news resource.PropertyMap, timeout float64,
+					preview bool) (resource.ID, resource.PropertyMap, resource.Status, error) {
+					creates++
+					return "created-id", news, resource.StatusOK, nil
+				},
+				DeleteF: func(urn resource.URN, id resource.ID, olds resource.PropertyMap,
+					timeout float64) (resource.Status, error) {
+					deletes++
+					return resource.StatusOK, nil
+				},
+			}, nil
+		}, deploytest.WithoutGrpc),
+	}
+
+	typ := tokens.Type("pkgA:m:typA")
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource(typ, "resA", true)
+		assert.NoError(t, err)
+		return nil
+	})
+	p := &TestPlan{
+		Options: UpdateOptions{Host: deploytest.NewPluginHost(nil, nil, program, loaders...)},
+	}
+	project := p.GetProject()
+
+	// Resources that are new to the stack do not need aliases.
+	snap, res := TestOp(Update).Run(project, p.GetTarget(t, nil), p.Options, false, p.BackendClient, nil)
+	assert.Nil(t, res)
+	assert.Equal(t, 1, creates)
+	assert.Equal(t, 0, aliasCalls)
+
+	// Resources that are found under their own URN do not need aliases either.
+	snap, res = TestOp(Update).Run(project, p.GetTarget(t, snap), p.Options, false, p.BackendClient, nil)
+	assert.Nil(t, res)
+	assert.Equal(t, 0, aliasCalls)
+
+	// Once the type is renamed, the resource's old state is found using the alias that the provider declares, so the
+	// resource is neither created nor deleted.
+	typ = "pkgA:m:typB"
+	snap, res = TestOp(Update).Run(project, p.GetTarget(t, snap), p.Options, false, p.BackendClient, nil)
+	assert.Nil(t, res)
+	assert.Equal(t, 1, aliasCalls)
+	assert.Equal(t, 1, creates)
+	assert.Equal(t, 0, deletes)
+	require.Len(t, snap.Resources, 2)
+	assert.Equal(t, tokens.Type("pkgA:m:typB"), snap.Resources[1].Type)
+	assert.Equal(t, resource.ID("created-id"), snap.Resources[1].ID)
+}
@@ -311,6 +311,63 @@ func TestCheckFailureInvalidPropertyRecord(t *testing.T) {
 
 }
 
+// Test that check failures with warning severity are reported as warnings and do not fail the update.
+func TestCheckFailureWarningRecord(t *testing.T) {
+	t.Parallel()
+
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				CheckF: func(urn resource.URN,
+					olds, news resource.PropertyMap, randomSeed []byte) (resource.PropertyMap, []plugin.CheckFailure, error) {
+					return news, []plugin.CheckFailure{{
+						Property: "someprop",
+						Reason:   "field will be corrected",
+						Severity: plugin.CheckSeverityWarning,
+						Code:     "corrected",
+					}}, nil
+				},
+			}, nil
+		}),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true)
+		assert.NoError(t, err)
+		return err
+	})
+
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+	p := &TestPlan{
+		Options: UpdateOptions{Host: host},
+		Steps: []TestStep{{
+			Op: Update,
+			Validate: func(project workspace.Project, target deploy.Target, entries JournalEntries,
+				evts []Event, res result.Result) result.Result {
+
+				sawWarning := false
+				for _, evt := range evts {
+					if evt.Type == DiagEvent {
+						e := evt.Payload().(DiagEventPayload)
+						msg := colors.Never.Colorize(e.Message)
+						assert.NotEqual(t, diag.Error, e.Severity, msg)
+						if strings.Contains(msg, "field will be corrected") && e.Severity == diag.Warning {
+							sawWarning = true
+						}
+					}
+				}
+
+				assert.True(t, sawWarning)
+				assert.Nil(t, res)
+				return res
+			},
+		}},
+	}
+
+	snap := p.Run(t, nil)
+	assert.Len(t, snap.Resources, 2)
+}
+
 // Tests that errors returned directly from the language host get logged by the engine.
 func TestLanguageHostDiagnostics(t *testing.T) {
 	t.Parallel()
@@ -913,6 +970,78 @@ func TestUpdatePartialFailure(t *testing.T) {
 	p.Run(t, old)
 }
 
+// Tests that a PartialFailureError returned from Create causes the engine to persist the partially created resource
+// and to report the error's underlying cause.
+func TestCreatePartialFailureError(t *testing.T) {
+	t.Parallel()
+
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				CreateF: func(urn resource.URN, news resource.PropertyMap, timeout float64,
+					preview bool) (resource.ID, resource.PropertyMap, resource.Status, error) {
+
+					if preview {
+						return "", news, resource.StatusOK, nil
+					}
+					return "", nil, resource.StatusUnknown, &plugin.PartialFailureError{
+						ID: "created-id",
+						Properties: resource.NewPropertyMapFromMap(map[string]interface{}{
+							"output_prop": 42,
+						}),
+						Cause: errors.New("resource failed to become ready"),
+					}
+				},
+			}, nil
+		}),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, mon *deploytest.ResourceMonitor) error {
+		_, _, _, err := mon.RegisterResource("pkgA:m:typA", "resA", true)
+		return err
+	})
+
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+	p := &TestPlan{Options: UpdateOptions{Host: host}}
+
+	resURN := p.NewURN("pkgA:m:typA", "resA", "")
+	p.Steps = []TestStep{{
+		Op:            Update,
+		ExpectFailure: true,
+		SkipPreview:   true,
+		Validate: func(project workspace.Project, target deploy.Target, entries JournalEntries,
+			evts []Event, res result.Result) result.Result {
+
+			assertIsErrorOrBailResult(t, res)
+
+			sawCause := false
+			for _, evt := range evts {
+				if evt.Type == DiagEvent {
+					e := evt.Payload().(DiagEventPayload)
+					msg := colors.Never.Colorize(e.Message)
+					if strings.Contains(msg, "resource failed to become ready") && e.Severity == diag.Error {
+						sawCause = true
+					}
+				}
+			}
+			assert.True(t, sawCause)
+
+			return res
+		},
+	}}
+
+	snap := p.Run(t, nil)
+	var res *resource.State
+	for _, r := range snap.Resources {
+		if r.URN == resURN {
+			res = r
+		}
+	}
+	require.NotNil(t, res)
+	assert.Equal(t, resource.ID("created-id"), res.ID)
+	assert.Equal(t, resource.NewNumberProperty(42), res.Outputs["output_prop"])
+}
+
 // Tests that the StackReference resource works as intended,
 func TestStackReference(t *testing.T) {
 	t.Parallel()
@@ -2878,6 +3007,223 @@ func TestSingleComponentDefaultProviderLifecycle(t *testing.T) {
 	p.Run(t, nil)
 }
 
+func TestConstructTimeouts(t *testing.T) {
+	t.Parallel()
+
+	var timeouts plugin.OperationTimeouts
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			construct := func(monitor *deploytest.ResourceMonitor,
+				typ, name string, parent resource.URN, inputs resource.PropertyMap,
+				options plugin.ConstructOptions) (plugin.ConstructResult, error) {
+
+				timeouts = options.Timeouts
+
+				urn, _, _, err := monitor.RegisterResource(tokens.Type(typ), name, false, deploytest.ResourceOptions{
+					Parent: parent,
+				})
+				assert.NoError(t, err)
+
+				return plugin.ConstructResult{URN: urn}, nil
+			}
+
+			return &deploytest.Provider{
+				ConstructF: construct,
+			}, nil
+		}),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", false, deploytest.ResourceOptions{
+			Remote:         true,
+			CustomTimeouts: &resource.CustomTimeouts{Create: 600, Delete: 120},
+		})
+		assert.NoError(t, err)
+		return nil
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	p := &TestPlan{
+		Options: UpdateOptions{Host: host},
+	}
+	project := p.GetProject()
+	_, res := TestOp(Update).Run(project, p.GetTarget(t, nil), p.Options, false, p.BackendClient, nil)
+	assert.Nil(t, res)
+
+	// The component's timeouts should have been passed through to Construct.
+	assert.Equal(t, plugin.OperationTimeouts{Create: 600, Delete: 120}, timeouts)
+}
+
+func TestConstructIgnoreChanges(t *testing.T) {
+	t.Parallel()
+
+	var ignoreChanges []string
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			construct := func(monitor *deploytest.ResourceMonitor,
+				typ, name string, parent resource.URN, inputs resource.PropertyMap,
+				options plugin.ConstructOptions) (plugin.ConstructResult, error) {
+
+				ignoreChanges = options.IgnoreChanges
+
+				urn, _, _, err := monitor.RegisterResource(tokens.Type(typ), name, false, deploytest.ResourceOptions{
+					Parent: parent,
+				})
+				assert.NoError(t, err)
+
+				return plugin.ConstructResult{URN: urn}, nil
+			}
+
+			return &deploytest.Provider{
+				ConstructF: construct,
+			}, nil
+		}),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", false, deploytest.ResourceOptions{
+			Remote:        true,
+			IgnoreChanges: []string{"foo", "bar.baz"},
+		})
+		assert.NoError(t, err)
+		return nil
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	p := &TestPlan{
+		Options: UpdateOptions{Host: host},
+	}
+	project := p.GetProject()
+	_, res := TestOp(Update).Run(project, p.GetTarget(t, nil), p.Options, false, p.BackendClient, nil)
+	assert.Nil(t, res)
+
+	// The component's ignoreChanges should have been passed through to Construct.
+	assert.Equal(t, []string{"foo", "bar.baz"}, ignoreChanges)
+}
+
+func TestConstructDanglingOutputDependencies(t *testing.T) {
+	t.Parallel()
+
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			construct := func(monitor *deploytest.ResourceMonitor,
+				typ, name string, parent resource.URN, inputs resource.PropertyMap,
+				options plugin.ConstructOptions) (plugin.ConstructResult, error) {
+
+				urn, _, _, err := monitor.RegisterResource(tokens.Type(typ), name, false, deploytest.ResourceOptions{
+					Parent: parent,
+				})
+				assert.NoError(t, err)
+
+				return plugin.ConstructResult{
+					URN:     urn,
+					Outputs: resource.PropertyMap{"foo": resource.NewStringProperty("bar")},
+					OutputDependencies: map[resource.PropertyKey][]resource.URN{
+						"foo": {urn, "urn:pulumi:test::test::pkgA:m:typB::missing"},
+					},
+				}, nil
+			}
+
+			return &deploytest.Provider{
+				ConstructF: construct,
+			}, nil
+		}),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", false, deploytest.ResourceOptions{
+			Remote: true,
+		})
+		assert.ErrorContains(t, err, "output foo depends on unregistered resource")
+		return err
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	p := &TestPlan{
+		Options: UpdateOptions{Host: host},
+	}
+	project := p.GetProject()
+	_, res := TestOp(Update).Run(project, p.GetTarget(t, nil), p.Options, false, p.BackendClient, nil)
+	assert.NotNil(t, res)
+}
+
+// TestConstructResultInputs validates that the normalized inputs and input dependencies that a provider reports for a
+// component it constructed are recorded in the component's state, whether or not the component registered its outputs.
+func TestConstructResultInputs(t *testing.T) {
+	t.Parallel()
+
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			construct := func(monitor *deploytest.ResourceMonitor,
+				typ, name string, parent resource.URN, inputs resource.PropertyMap,
+				options plugin.ConstructOptions) (plugin.ConstructResult, error) {
+
+				urn, _, _, err := monitor.RegisterResource(tokens.Type(typ), name, false, deploytest.ResourceOptions{
+					Parent: parent,
+					Inputs: inputs,
+				})
+				assert.NoError(t, err)
+
+				childURN, _, _, err := monitor.RegisterResource("pkgA:m:typB", name+"-child", true,
+					deploytest.ResourceOptions{Parent: urn})
+				assert.NoError(t, err)
+
+				if name == "resA" {
+					err = monitor.RegisterResourceOutputs(urn, resource.PropertyMap{})
+					assert.NoError(t, err)
+				}
+
+				return plugin.ConstructResult{
+					URN:    urn,
+					Inputs: resource.PropertyMap{"size": resource.NewNumberProperty(10)},
+					InputDependencies: map[resource.PropertyKey][]resource.URN{
+						"size": {childURN},
+					},
+				}, nil
+			}
+
+			return &deploytest.Provider{
+				ConstructF: construct,
+			}, nil
+		}),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		for _, name := range []string{"resA", "resB"} {
+			_, _, _, err := monitor.RegisterResource("pkgA:m:typA", name, false, deploytest.ResourceOptions{
+				Remote: true,
+				Inputs: resource.PropertyMap{"size": resource.NewStringProperty("10")},
+			})
+			assert.NoError(t, err)
+		}
+		return nil
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	p := &TestPlan{
+		Options: UpdateOptions{Host: host},
+	}
+	project := p.GetProject()
+	snap, res := TestOp(Update).Run(project, p.GetTarget(t, nil), p.Options, false, p.BackendClient, nil)
+	assert.Nil(t, res)
+
+	for _, name := range []string{"resA", "resB"} {
+		urn := p.NewURN("pkgA:m:typA", name, "")
+		childURN := p.NewURN("pkgA:m:typB", name+"-child", urn)
+
+		var component *resource.State
+		for _, r := range snap.Resources {
+			if r.URN == urn {
+				component = r
+			}
+		}
+		require.NotNil(t, component, name)
+		assert.Equal(t, resource.PropertyMap{"size": resource.NewNumberProperty(10)}, component.Inputs, name)
+		assert.Equal(t, map[resource.PropertyKey][]resource.URN{"size": {childURN}},
+			component.PropertyDependencies, name)
+	}
+}
+
 type updateContext struct {
 	*deploytest.ResourceMonitor
 
@@ -3323,6 +3669,210 @@ func TestSingleComponentMethodResourceDefaultProviderLifecycle(t *testing.T) {
 	p.Run(t, nil)
 }
 
+// TestComponentMethodPartialFailure validates that a method that fails after computing some of its return values
+// returns those values to the program and reports the failure as a warning rather than failing the update.
+func TestComponentMethodPartialFailure(t *testing.T) {
+	t.Parallel()
+
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			construct := func(monitor *deploytest.ResourceMonitor,
+				typ, name string, parent resource.URN, inputs resource.PropertyMap,
+				options plugin.ConstructOptions) (plugin.ConstructResult, error) {
+
+				urn, _, _, err := monitor.RegisterResource(tokens.Type(typ), name, false, deploytest.ResourceOptions{
+					Parent: parent,
+				})
+				assert.NoError(t, err)
+
+				return plugin.ConstructResult{URN: urn}, nil
+			}
+
+			call := func(monitor *deploytest.ResourceMonitor, tok tokens.ModuleMember, args resource.PropertyMap,
+				info plugin.CallInfo, options plugin.CallOptions) (plugin.CallResult, error) {
+
+				return plugin.CallResult{
+					Return: resource.PropertyMap{"first": resource.NewStringProperty("done")},
+					Status: resource.StatusPartialFailure,
+				}, errors.New("second step timed out")
+			}
+
+			return &deploytest.Provider{
+				ConstructF: construct,
+				CallF:      call,
+			}, nil
+		}, deploytest.WithGrpc),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", false, deploytest.ResourceOptions{
+			Remote: true,
+		})
+		assert.NoError(t, err)
+
+		outs, _, _, err := monitor.Call("pkgA:m:typA/methodA", resource.PropertyMap{}, "", "")
+		assert.NoError(t, err)
+		assert.Equal(t, resource.PropertyMap{"first": resource.NewStringProperty("done")}, outs)
+		return nil
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	p := &TestPlan{
+		Options: UpdateOptions{Host: host},
+	}
+
+	project := p.GetProject()
+	_, res := TestOp(Update).Run(project, p.GetTarget(t, nil), p.Options, false, p.BackendClient,
+		func(_ workspace.Project, _ deploy.Target, _ JournalEntries, events []Event, res result.Result) result.Result {
+			sawWarning := false
+			for _, evt := range events {
+				if evt.Type == DiagEvent {
+					e := evt.Payload().(DiagEventPayload)
+					if e.Severity == diag.Warning && strings.Contains(e.Message, "second step timed out") {
+						sawWarning = true
+					}
+				}
+			}
+			assert.True(t, sawWarning)
+			return res
+		})
+	assert.Nil(t, res)
+}
+
+// TestComponentMethodDiagnostics validates that diagnostics a provider reports about a method call are shown to the
+// user once the call completes.
+func TestComponentMethodDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			construct := func(monitor *deploytest.ResourceMonitor,
+				typ, name string, parent resource.URN, inputs resource.PropertyMap,
+				options plugin.ConstructOptions) (plugin.ConstructResult, error) {
+
+				urn, _, _, err := monitor.RegisterResource(tokens.Type(typ), name, false, deploytest.ResourceOptions{
+					Parent: parent,
+				})
+				assert.NoError(t, err)
+
+				return plugin.ConstructResult{URN: urn}, nil
+			}
+
+			call := func(monitor *deploytest.ResourceMonitor, tok tokens.ModuleMember, args resource.PropertyMap,
+				info plugin.CallInfo, options plugin.CallOptions) (plugin.CallResult, error) {
+
+				return plugin.CallResult{
+					Return: resource.PropertyMap{"message": resource.NewStringProperty("hello")},
+					Diagnostics: []plugin.ProviderDiagnostic{{
+						Severity: diag.Warning,
+						Summary:  "methodA is deprecated",
+						URL:      "https://example.com/methodB",
+					}},
+				}, nil
+			}
+
+			return &deploytest.Provider{
+				ConstructF: construct,
+				CallF:      call,
+			}, nil
+		}, deploytest.WithGrpc),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", false, deploytest.ResourceOptions{
+			Remote: true,
+		})
+		assert.NoError(t, err)
+
+		outs, _, _, err := monitor.Call("pkgA:m:typA/methodA", resource.PropertyMap{}, "", "")
+		assert.NoError(t, err)
+		assert.Equal(t, resource.PropertyMap{"message": resource.NewStringProperty("hello")}, outs)
+		return nil
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	p := &TestPlan{
+		Options: UpdateOptions{Host: host},
+	}
+
+	project := p.GetProject()
+	_, res := TestOp(Update).Run(project, p.GetTarget(t, nil), p.Options, false, p.BackendClient,
+		func(_ workspace.Project, _ deploy.Target, _ JournalEntries, events []Event, res result.Result) result.Result {
+			sawWarning := false
+			for _, evt := range events {
+				if evt.Type == DiagEvent {
+					e := evt.Payload().(DiagEventPayload)
+					if e.Severity == diag.Warning && strings.Contains(e.Message, "methodA is deprecated") &&
+						strings.Contains(e.Message, "https://example.com/methodB") {
+						sawWarning = true
+					}
+				}
+			}
+			assert.True(t, sawWarning)
+			return res
+		})
+	assert.Nil(t, res)
+}
+
+// diagnosingProvider is a test provider that reports a diagnostic about each resource that it checks.
+type diagnosingProvider struct {
+	deploytest.Provider
+}
+
+func (p *diagnosingProvider) Check(ctx context.Context, urn resource.URN, olds, news resource.PropertyMap,
+	allowUnknowns bool, randomSeed []byte) (resource.PropertyMap, []plugin.CheckFailure, error) {
+
+	plugin.ReportDiagnostic(ctx, urn, plugin.ProviderDiagnostic{
+		Severity: diag.Warning,
+		Summary:  "propA is deprecated",
+		URL:      "https://example.com/propB",
+	})
+	return p.Provider.Check(ctx, urn, olds, news, allowUnknowns, randomSeed)
+}
+
+// TestProviderDiagnostics validates that diagnostics a provider reports during a resource operation are shown to the
+// user and attributed to the resource.
+func TestProviderDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &diagnosingProvider{}, nil
+		}, deploytest.WithGrpc),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true)
+		assert.NoError(t, err)
+		return nil
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	p := &TestPlan{
+		Options: UpdateOptions{Host: host},
+	}
+
+	project := p.GetProject()
+	resURN := p.NewURN("pkgA:m:typA", "resA", "")
+	_, res := TestOp(Update).Run(project, p.GetTarget(t, nil), p.Options, false, p.BackendClient,
+		func(_ workspace.Project, _ deploy.Target, _ JournalEntries, events []Event, res result.Result) result.Result {
+			sawWarning := false
+			for _, evt := range events {
+				if evt.Type == DiagEvent {
+					e := evt.Payload().(DiagEventPayload)
+					if e.Severity == diag.Warning && e.URN == resURN &&
+						strings.Contains(e.Message, "propA is deprecated") &&
+						strings.Contains(e.Message, "https://example.com/propB") {
+						sawWarning = true
+					}
+				}
+			}
+			assert.True(t, sawWarning)
+			return res
+		})
+	assert.Nil(t, res)
+}
+
 // This tests a scenario involving two remote components with interdependencies that are only represented in the
 // user program.
 func TestComponentDeleteDependencies(t *testing.T) {
@@ -286,6 +286,43 @@ func TestRefreshWithDelete(t *testing.T) {
 	}
 }
 
+// Tests that returning ErrResourceNotFound from Read is treated the same as returning nil outputs.
+func TestRefreshWithResourceNotFound(t *testing.T) {
+	t.Parallel()
+
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				ReadF: func(
+					urn resource.URN, id resource.ID, inputs, state resource.PropertyMap,
+				) (plugin.ReadResult, resource.Status, error) {
+					return plugin.ReadResult{}, resource.StatusUnknown, plugin.ErrResourceNotFound
+				},
+			}, nil
+		}),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true)
+		assert.NoError(t, err)
+		return err
+	})
+
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+	p := &TestPlan{Options: UpdateOptions{Host: host}}
+
+	p.Steps = []TestStep{{Op: Update}}
+	snap := p.Run(t, nil)
+
+	p.Steps = []TestStep{{Op: Refresh}}
+	snap = p.Run(t, snap)
+
+	// Refresh succeeds and removes the resource from the snapshot.
+	provURN := p.NewProviderURN("pkgA", "default", "")
+	assert.Len(t, snap.Resources, 1)
+	assert.Equal(t, provURN, snap.Resources[0].URN)
+}
+
 // Tests that dependencies are correctly rewritten when refresh removes deleted resources.
 func TestRefreshDeleteDependencies(t *testing.T) {
 	t.Parallel()
@@ -916,3 +953,214 @@ func TestRefreshUpdateWithDeletedResource(t *testing.T) {
 	snap := p.Run(t, old)
 	assert.Equal(t, 0, len(snap.Resources))
 }
+
+// TestRefreshBatchRead validates that a refresh reads the resources managed by a provider using a single call to
+// BatchRead.
+func TestRefreshBatchRead(t *testing.T) {
+	t.Parallel()
+
+	p := &TestPlan{}
+
+	urns := []resource.URN{
+		p.NewURN("pkgA:m:typA", "resA", ""),
+		p.NewURN("pkgA:m:typA", "resB", ""),
+		p.NewURN("pkgA:m:typA", "resC", ""),
+	}
+
+	var batches [][]plugin.BatchReadRequest
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				ReadF: func(
+					urn resource.URN, id resource.ID, inputs, state resource.PropertyMap,
+				) (plugin.ReadResult, resource.Status, error) {
+					t.Errorf("unexpected call to Read for %v", urn)
+					return plugin.ReadResult{}, resource.StatusUnknown, fmt.Errorf("unexpected read")
+				},
+				BatchReadF: func(requests []plugin.BatchReadRequest) ([]plugin.BatchReadResponse, error) {
+					batches = append(batches, requests)
+
+					responses := make([]plugin.BatchReadResponse, len(requests))
+					for i, req := range requests {
+						responses[i] = plugin.BatchReadResponse{
+							ReadResult: plugin.ReadResult{
+								ID:      req.ID,
+								Inputs:  req.Inputs,
+								Outputs: resource.PropertyMap{"refreshed": resource.NewStringProperty(string(req.ID))},
+							},
+							Status: resource.StatusOK,
+						}
+					}
+					return responses, nil
+				},
+			}, nil
+		}),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		return nil
+	})
+	p.Options.Host = deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	old := &deploy.Snapshot{}
+	for i, urn := range urns {
+		old.Resources = append(old.Resources, &resource.State{
+			Type:    urn.Type(),
+			URN:     urn,
+			Custom:  true,
+			ID:      resource.ID(fmt.Sprintf("id%d", i)),
+			Inputs:  resource.PropertyMap{},
+			Outputs: resource.PropertyMap{},
+		})
+	}
+
+	p.Steps = []TestStep{{Op: Refresh, SkipPreview: true}}
+	snap := p.Run(t, old)
+
+	assert.Len(t, batches, 1)
+	assert.Len(t, batches[0], len(urns))
+
+	refreshed := map[resource.URN]resource.PropertyMap{}
+	for _, res := range snap.Resources {
+		refreshed[res.URN] = res.Outputs
+	}
+	for i, urn := range urns {
+		assert.Equal(t, resource.NewStringProperty(fmt.Sprintf("id%d", i)), refreshed[urn]["refreshed"])
+	}
+}
+
+// TestRefreshReadStream validates that a refresh reads a resource using ReadStream and records the last state that
+// the provider reports.
+func TestRefreshReadStream(t *testing.T) {
+	t.Parallel()
+
+	p := &TestPlan{}
+
+	resURN := p.NewURN("pkgA:m:typA", "resA", "")
+
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				ReadF: func(
+					urn resource.URN, id resource.ID, inputs, state resource.PropertyMap,
+				) (plugin.ReadResult, resource.Status, error) {
+					t.Errorf("unexpected call to Read for %v", urn)
+					return plugin.ReadResult{}, resource.StatusUnknown, fmt.Errorf("unexpected read")
+				},
+				ReadStreamF: func(urn resource.URN, id resource.ID, inputs, state resource.PropertyMap,
+					onNext func(plugin.ReadResult) error) (resource.Status, error) {
+					for _, status := range []string{"pending", "running", "succeeded"} {
+						err := onNext(plugin.ReadResult{
+							ID:      id,
+							Inputs:  inputs,
+							Outputs: resource.PropertyMap{"status": resource.NewStringProperty(status)},
+						})
+						if err != nil {
+							return resource.StatusUnknown, err
+						}
+					}
+					return resource.StatusOK, nil
+				},
+			}, nil
+		}),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		return nil
+	})
+	p.Options.Host = deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	old := &deploy.Snapshot{
+		Resources: []*resource.State{
+			{
+				Type:    resURN.Type(),
+				URN:     resURN,
+				Custom:  true,
+				ID:      "job-1",
+				Inputs:  resource.PropertyMap{},
+				Outputs: resource.PropertyMap{"status": resource.NewStringProperty("pending")},
+			},
+		},
+	}
+
+	p.Steps = []TestStep{{Op: Refresh, SkipPreview: true}}
+	snap := p.Run(t, old)
+
+	var found bool
+	for _, res := range snap.Resources {
+		if res.URN == resURN {
+			found = true
+			assert.Equal(t, resource.NewStringProperty("succeeded"), res.Outputs["status"])
+		}
+	}
+	assert.True(t, found)
+}
+
+// TestRefreshCallsRefresh validates that a refresh reads resources using Refresh rather than Read, so that providers
+// can report resources that no longer exist as deleted.
+func TestRefreshCallsRefresh(t *testing.T) {
+	t.Parallel()
+
+	p := &TestPlan{}
+
+	resURNs := []resource.URN{
+		p.NewURN("pkgA:m:typA", "resA", ""),
+		p.NewURN("pkgA:m:typA", "resB", ""),
+		p.NewURN("pkgA:m:typA", "resC", ""),
+	}
+
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				ReadF: func(
+					urn resource.URN, id resource.ID, inputs, state resource.PropertyMap,
+				) (plugin.ReadResult, resource.Status, error) {
+					t.Errorf("unexpected call to Read for %v", urn)
+					return plugin.ReadResult{}, resource.StatusUnknown, fmt.Errorf("resource %v not found", id)
+				},
+				RefreshF: func(
+					urn resource.URN, id resource.ID, inputs, state resource.PropertyMap,
+				) (plugin.ReadResult, resource.Status, error) {
+					// resB no longer exists.
+					if id == "resB" {
+						return plugin.ReadResult{}, resource.StatusOK, nil
+					}
+					return plugin.ReadResult{ID: id, Inputs: inputs, Outputs: state}, resource.StatusOK, nil
+				},
+			}, nil
+		}),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		return nil
+	})
+	p.Options.Host = deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	old := &deploy.Snapshot{}
+	for _, urn := range resURNs {
+		old.Resources = append(old.Resources, &resource.State{
+			Type:    urn.Type(),
+			URN:     urn,
+			Custom:  true,
+			ID:      resource.ID(urn.Name()),
+			Inputs:  resource.PropertyMap{},
+			Outputs: resource.PropertyMap{},
+		})
+	}
+
+	// Refresh both with and without batching.
+	for _, parallel := range []int{1, 4} {
+		opts := p.Options
+		opts.Parallel = parallel
+		snap, res := TestOp(Refresh).Run(p.GetProject(), p.GetTarget(t, old), opts, false, p.BackendClient, nil)
+		assert.Nil(t, res)
+
+		var urns []resource.URN
+		for _, r := range snap.Resources {
+			if !providers.IsProviderType(r.Type) {
+				urns = append(urns, r.URN)
+			}
+		}
+		assert.ElementsMatch(t, []resource.URN{resURNs[0], resURNs[2]}, urns)
+	}
+}
@@ -146,11 +146,22 @@ type UpdateOptions struct {
 	// the plugin host to use for this update
 	Host plugin.Host
 
+	// semver constraints, such as ">=3.0.0, <4.0.0", that the versions of the providers loaded for each package must
+	// satisfy. Only applies to the default plugin host, i.e. if Host is nil.
+	ProviderVersionConstraints map[tokens.Package]string
+
 	// The plan to use for the update, if any.
 	Plan *deploy.Plan
 
 	// true if plans should be generated.
 	GeneratePlan bool
+
+	// the namespace that component providers should use to prefix the names of the resources they create, e.g. to
+	// avoid collisions between stacks that share an account. Empty if resources should not be namespaced.
+	Namespace string
+
+	// true if the notes that providers return for imported resources should be displayed.
+	ShowImportNotes bool
 }
 
 // HasChanges returns true if there are any non-same changes in the resulting summary.
@@ -656,11 +667,18 @@ func ShouldRecordReadStep(step deploy.Step) bool {
 	// If reading a resource didn't result in any change to the resource, we then want to
 	// record this as a 'same'.  That way, when things haven't actually changed, but a user
 	// app did any 'reads' these don't show up in the resource summary at the end.
-	return step.Old() != nil &&
-		step.New() != nil &&
-		step.Old().Outputs != nil &&
+	if step.Old() == nil || step.New() == nil {
+		return false
+	}
+
+	// Compare the old outputs as the provider migrated them to the schema version of the read state, if it did.
+	oldOutputs := step.Old().Outputs
+	if migrated, ok := step.(interface{ OldOutputs() resource.PropertyMap }); ok {
+		oldOutputs = migrated.OldOutputs()
+	}
+	return oldOutputs != nil &&
 		step.New().Outputs != nil &&
-		step.Old().Outputs.Diff(step.New().Outputs) != nil
+		oldOutputs.Diff(step.New().Outputs) != nil
 }
 
 func newPreviewActions(opts deploymentOptions) *previewActions {
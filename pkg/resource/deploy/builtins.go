@@ -42,30 +42,51 @@ func (p *builtinProvider) Pkg() tokens.Package {
 }
 
 // GetSchema returns the JSON-serialized schema for the provider.
-func (p *builtinProvider) GetSchema(version int) ([]byte, error) {
-	return []byte("{}"), nil
+func (p *builtinProvider) GetSchema(ctx context.Context, version int) (plugin.GetSchemaResponse, error) {
+	return plugin.NewGetSchemaResponse([]byte("{}")), nil
+}
+
+func (p *builtinProvider) GetSupportedVersions(ctx context.Context) ([]int, error) {
+	return nil, plugin.ErrNotYetImplemented
 }
 
 // CheckConfig validates the configuration for this resource provider.
-func (p *builtinProvider) CheckConfig(urn resource.URN, olds,
+func (p *builtinProvider) CheckConfig(ctx context.Context, urn resource.URN, olds,
 	news resource.PropertyMap, allowUnknowns bool) (resource.PropertyMap, []plugin.CheckFailure, error) {
 
 	return nil, nil, nil
 }
 
+func (p *builtinProvider) ConfigChecksumMatch(ctx context.Context, urn resource.URN, checksum string) (bool, error) {
+	return false, plugin.ErrNotYetImplemented
+}
+
 // DiffConfig checks what impacts a hypothetical change to this provider's configuration will have on the provider.
-func (p *builtinProvider) DiffConfig(urn resource.URN, olds, news resource.PropertyMap,
+func (p *builtinProvider) DiffConfig(ctx context.Context, urn resource.URN, olds, news resource.PropertyMap,
 	allowUnknowns bool, ignoreChanges []string) (plugin.DiffResult, error) {
 	return plugin.DiffResult{Changes: plugin.DiffNone}, nil
 }
 
-func (p *builtinProvider) Configure(props resource.PropertyMap) error {
+func (p *builtinProvider) Configure(ctx context.Context, cfg plugin.ProviderConfig) error {
 	return nil
 }
 
+func (p *builtinProvider) Validate(ctx context.Context) error {
+	return nil
+}
+
+func (p *builtinProvider) ParameterizeByValue(ctx context.Context, value []byte) (plugin.ParameterizeResult, error) {
+	return plugin.ParameterizeResult{}, plugin.ErrNotYetImplemented
+}
+
+func (p *builtinProvider) ParameterizeByReference(ctx context.Context,
+	ref plugin.ParameterizeReference) (plugin.ParameterizeResult, error) {
+	return plugin.ParameterizeResult{}, plugin.ErrNotYetImplemented
+}
+
 const stackReferenceType = "pulumi:pulumi:StackReference"
 
-func (p *builtinProvider) Check(urn resource.URN, state, inputs resource.PropertyMap,
+func (p *builtinProvider) Check(ctx context.Context, urn resource.URN, state, inputs resource.PropertyMap,
 	allowUnknowns bool, randomSeed []byte) (resource.PropertyMap, []plugin.CheckFailure, error) {
 
 	typ := urn.Type()
@@ -90,8 +111,8 @@ func (p *builtinProvider) Check(urn resource.URN, state, inputs resource.Propert
 	return inputs, nil, nil
 }
 
-func (p *builtinProvider) Diff(urn resource.URN, id resource.ID, state, inputs resource.PropertyMap,
-	allowUnknowns bool, ignoreChanges []string) (plugin.DiffResult, error) {
+func (p *builtinProvider) Diff(ctx context.Context, urn resource.URN, id resource.ID,
+	state, inputs resource.PropertyMap, allowUnknowns bool, ignoreChanges []string) (plugin.DiffResult, error) {
 
 	contract.Assert(urn.Type() == stackReferenceType)
 
@@ -105,7 +126,7 @@ func (p *builtinProvider) Diff(urn resource.URN, id resource.ID, state, inputs r
 	return plugin.DiffResult{Changes: plugin.DiffNone}, nil
 }
 
-func (p *builtinProvider) Create(urn resource.URN, inputs resource.PropertyMap, timeout float64,
+func (p *builtinProvider) Create(ctx context.Context, urn resource.URN, inputs resource.PropertyMap, timeout float64,
 	preview bool) (resource.ID, resource.PropertyMap, resource.Status, error) {
 
 	contract.Assert(urn.Type() == stackReferenceType)
@@ -128,7 +149,8 @@ func (p *builtinProvider) Create(urn resource.URN, inputs resource.PropertyMap,
 	return id, state, resource.StatusOK, nil
 }
 
-func (p *builtinProvider) Update(urn resource.URN, id resource.ID, state, inputs resource.PropertyMap, timeout float64,
+func (p *builtinProvider) Update(ctx context.Context, urn resource.URN, id resource.ID,
+	state, inputs resource.PropertyMap, timeout float64,
 	ignoreChanges []string, preview bool) (resource.PropertyMap, resource.Status, error) {
 
 	contract.Failf("unexpected update for builtin resource %v", urn)
@@ -137,7 +159,7 @@ func (p *builtinProvider) Update(urn resource.URN, id resource.ID, state, inputs
 	return state, resource.StatusOK, errors.New("unexpected update for builtin resource")
 }
 
-func (p *builtinProvider) Delete(urn resource.URN, id resource.ID,
+func (p *builtinProvider) Delete(ctx context.Context, urn resource.URN, id resource.ID,
 	state resource.PropertyMap, timeout float64) (resource.Status, error) {
 
 	contract.Assert(urn.Type() == stackReferenceType)
@@ -145,7 +167,18 @@ func (p *builtinProvider) Delete(urn resource.URN, id resource.ID,
 	return resource.StatusOK, nil
 }
 
-func (p *builtinProvider) Read(urn resource.URN, id resource.ID,
+func (p *builtinProvider) StreamCreate(ctx context.Context, urn resource.URN, inputs resource.PropertyMap,
+	timeout float64, preview bool,
+	onNext func(resource.PropertyMap) error) (resource.ID, resource.PropertyMap, resource.Status, error) {
+	return plugin.SingleStreamCreate(ctx, p, urn, inputs, timeout, preview, onNext)
+}
+
+func (p *builtinProvider) WaitForResourceReady(ctx context.Context, urn resource.URN, id resource.ID,
+	timeout float64) error {
+	return plugin.ErrNotYetImplemented
+}
+
+func (p *builtinProvider) Read(ctx context.Context, urn resource.URN, id resource.ID,
 	inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error) {
 	contract.Assertf(urn != "", "Read URN was empty")
 	contract.Assertf(id != "", "Read ID was empty")
@@ -162,8 +195,41 @@ func (p *builtinProvider) Read(urn resource.URN, id resource.ID,
 	}, resource.StatusOK, nil
 }
 
-func (p *builtinProvider) Construct(info plugin.ConstructInfo, typ tokens.Type, name tokens.QName, parent resource.URN,
-	inputs resource.PropertyMap, options plugin.ConstructOptions) (plugin.ConstructResult, error) {
+func (p *builtinProvider) PrepareImport(ctx context.Context, urn resource.URN,
+	id resource.ID) (resource.PropertyMap, error) {
+	return nil, plugin.ErrNotYetImplemented
+}
+
+func (p *builtinProvider) Refresh(ctx context.Context, urn resource.URN, id resource.ID,
+	inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error) {
+	return plugin.RefreshWithReadStream(ctx, p, urn, id, inputs, state)
+}
+
+func (p *builtinProvider) SchemaVersion(ctx context.Context) (int, error) {
+	return 0, nil
+}
+
+func (p *builtinProvider) MigrateState(ctx context.Context, urn resource.URN, stateVersion int,
+	state resource.PropertyMap) (resource.PropertyMap, error) {
+	return nil, plugin.ErrNotYetImplemented
+}
+
+func (p *builtinProvider) GetResourceAliases(ctx context.Context, urn resource.URN) ([]resource.Alias, error) {
+	return nil, plugin.ErrNotYetImplemented
+}
+
+func (p *builtinProvider) ReadStream(ctx context.Context, urn resource.URN, id resource.ID,
+	inputs, state resource.PropertyMap, onNext func(plugin.ReadResult) error) (resource.Status, error) {
+	return plugin.SingleReadStream(ctx, p, urn, id, inputs, state, onNext)
+}
+
+func (p *builtinProvider) BatchRead(ctx context.Context,
+	requests []plugin.BatchReadRequest) ([]plugin.BatchReadResponse, error) {
+	return plugin.SequentialBatchRead(ctx, p, requests)
+}
+
+func (p *builtinProvider) Construct(ctx context.Context, info plugin.ConstructInfo, typ tokens.Type, name tokens.QName,
+	parent resource.URN, inputs resource.PropertyMap, options plugin.ConstructOptions) (plugin.ConstructResult, error) {
 	return plugin.ConstructResult{}, errors.New("builtin resources may not be constructed")
 }
 
@@ -171,7 +237,7 @@ const readStackOutputs = "pulumi:pulumi:readStackOutputs"
 const readStackResourceOutputs = "pulumi:pulumi:readStackResourceOutputs"
 const getResource = "pulumi:pulumi:getResource"
 
-func (p *builtinProvider) Invoke(tok tokens.ModuleMember,
+func (p *builtinProvider) Invoke(ctx context.Context, tok tokens.ModuleMember,
 	args resource.PropertyMap) (resource.PropertyMap, []plugin.CheckFailure, error) {
 
 	switch tok {
@@ -199,24 +265,46 @@ func (p *builtinProvider) Invoke(tok tokens.ModuleMember,
 }
 
 func (p *builtinProvider) StreamInvoke(
+	ctx context.Context,
 	tok tokens.ModuleMember, args resource.PropertyMap,
-	onNext func(resource.PropertyMap) error) ([]plugin.CheckFailure, error) {
+	onNext func(plugin.StreamInvokeEvent) error) ([]plugin.CheckFailure, error) {
 
 	return nil, fmt.Errorf("the builtin provider does not implement streaming invokes")
 }
 
-func (p *builtinProvider) Call(tok tokens.ModuleMember, args resource.PropertyMap, info plugin.CallInfo,
-	options plugin.CallOptions) (plugin.CallResult, error) {
+func (p *builtinProvider) Call(ctx context.Context, tok tokens.ModuleMember, args resource.PropertyMap,
+	info plugin.CallInfo, options plugin.CallOptions) (plugin.CallResult, error) {
 
 	return plugin.CallResult{}, fmt.Errorf("the builtin provider does not implement call")
 }
 
-func (p *builtinProvider) GetPluginInfo() (workspace.PluginInfo, error) {
+func (p *builtinProvider) GetPluginInfo(ctx context.Context) (workspace.PluginInfo, error) {
 	// return an error: this should not be called for the builtin provider
 	return workspace.PluginInfo{}, errors.New("the builtin provider does not report plugin info")
 }
 
-func (p *builtinProvider) SignalCancellation() error {
+func (p *builtinProvider) GetMapping(ctx context.Context, key string) ([]byte, string, error) {
+	return nil, "", nil
+}
+
+func (p *builtinProvider) SupportsFeature(ctx context.Context, feature string) (bool, error) {
+	return false, nil
+}
+
+func (p *builtinProvider) RegisterEventEmitter(e plugin.EventEmitter) error {
+	return plugin.ErrNotYetImplemented
+}
+
+func (p *builtinProvider) WatchConfig(ctx context.Context, onChange func(resource.PropertyMap)) error {
+	return plugin.ErrNotYetImplemented
+}
+
+func (p *builtinProvider) EstimateCost(ctx context.Context, urn resource.URN,
+	news resource.PropertyMap) (plugin.CostEstimate, error) {
+	return plugin.CostEstimate{}, plugin.ErrNotYetImplemented
+}
+
+func (p *builtinProvider) SignalCancellation(ctx context.Context) error {
 	p.cancel()
 	return nil
 }
@@ -31,6 +31,7 @@ import (
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/logging"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/result"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
 )
@@ -64,6 +65,8 @@ type Options struct {
 	DisableResourceReferences bool           // true to disable resource reference support.
 	DisableOutputValues       bool           // true to disable output value support.
 	GeneratePlan              bool           // true to enable plan generation.
+	Namespace                 string         // the namespace that component providers prefix resource names with.
+	ShowImportNotes           bool           // true to display the notes that providers return for imports.
 }
 
 // DegreeOfParallelism returns the degree of parallelism that should be used during the
@@ -186,6 +189,8 @@ type Deployment struct {
 	goals                *goalMap                         // the set of resource goals generated by the deployment.
 	news                 *resourceMap                     // the set of new resources generated by the deployment
 	newPlans             *resourcePlans                   // the set of new resource plans.
+	costs                *plugin.CostSummary              // the estimated costs of the resources a preview changes.
+	readyTypes           readyTypes                       // the resource types that must be waited on after create.
 }
 
 // addDefaultProviders adds any necessary default provider definitions and references to the given snapshot. Version
@@ -354,7 +359,7 @@ func NewDeployment(ctx *plugin.Context, target *Target, prev *Snapshot, plan *Pl
 	// Create a new provider registry. Although we really only need to pass in any providers that were present in the
 	// old resource list, the registry itself will filter out other sorts of resources when processing the prior state,
 	// so we just pass all of the old resources.
-	reg, err := providers.NewRegistry(ctx.Host, oldResources, preview, builtins)
+	reg, err := providers.NewRegistry(ctx.Request(), ctx.Host, oldResources, preview, builtins)
 	if err != nil {
 		return nil, err
 	}
@@ -373,6 +378,7 @@ func NewDeployment(ctx *plugin.Context, target *Target, prev *Snapshot, plan *Pl
 		goals:                newGoals,
 		news:                 newResources,
 		newPlans:             newResourcePlan(target.Config),
+		costs:                &plugin.CostSummary{},
 	}, nil
 }
 
@@ -383,6 +389,10 @@ func (d *Deployment) Prev() *Snapshot                        { return d.prev }
 func (d *Deployment) Olds() map[resource.URN]*resource.State { return d.olds }
 func (d *Deployment) Source() Source                         { return d.source }
 
+// Costs returns the cost estimates that providers made for the resources that this deployment would create or update.
+// Costs are only estimated during previews.
+func (d *Deployment) Costs() *plugin.CostSummary { return d.costs }
+
 func (d *Deployment) SameProvider(ref providers.Reference) {
 	d.providers.Same(ref)
 }
@@ -391,6 +401,31 @@ func (d *Deployment) GetProvider(ref providers.Reference) (plugin.Provider, bool
 	return d.providers.GetProvider(ref)
 }
 
+// Close releases the resources held by the deployment's provider registry. It must only be called once the deployment
+// has finished executing.
+func (d *Deployment) Close() error {
+	return d.providers.Close()
+}
+
+// reportDiagnostic displays a diagnostic that a provider reported during an operation on the resource with the given
+// URN.
+func (d *Deployment) reportDiagnostic(urn resource.URN, pd plugin.ProviderDiagnostic) {
+	d.Diag().Logf(pd.Severity, diag.RawMessage(urn, pd.String()))
+}
+
+// estimateCost records the provider's estimate of the monthly cost of a resource that a preview would create or update.
+// Estimates are advisory, so resources whose provider cannot estimate their cost are left out of the total.
+func (d *Deployment) estimateCost(ctx context.Context, prov plugin.Provider, urn resource.URN, news resource.PropertyMap) {
+	estimate, err := prov.EstimateCost(ctx, urn, news)
+	if err != nil {
+		if err != plugin.ErrNotYetImplemented {
+			logging.V(7).Infof("EstimateCost(%s): failed to estimate cost: %v", urn, err)
+		}
+		return
+	}
+	d.costs.Add(urn, estimate)
+}
+
 // generateURN generates a resource's URN from its parent, type, and name under the scope of the deployment's stack and
 // project.
 func (d *Deployment) generateURN(parent resource.URN, ty tokens.Type, name tokens.QName) resource.URN {
@@ -421,6 +456,8 @@ func (d *Deployment) generateEventURN(event SourceEvent) resource.URN {
 		return d.generateURN(e.Parent(), e.Type(), e.Name())
 	case RegisterResourceOutputsEvent:
 		return e.URN()
+	case ConstructResultEvent:
+		return e.URN()
 	default:
 		return ""
 	}
@@ -204,19 +204,20 @@ func (ex *deploymentExecutor) Execute(callerCtx context.Context, opts Options, p
 		return nil, res
 	}
 
+	// Derive a cancellable context for this deployment. We will only cancel this context if some piece of the
+	// deployment's execution fails.
+	ctx, cancel := context.WithCancel(callerCtx)
+
 	// Set up a step generator for this deployment.
-	ex.stepGen = newStepGenerator(ex.deployment, opts, updateTargetsOpt, replaceTargetsOpt)
+	ex.stepGen = newStepGenerator(ctx, ex.deployment, opts, updateTargetsOpt, replaceTargetsOpt)
 
 	// Retire any pending deletes that are currently present in this deployment.
 	if res := ex.retirePendingDeletes(callerCtx, opts, preview); res != nil {
+		cancel()
 		return nil, res
 	}
 
-	// Derive a cancellable context for this deployment. We will only cancel this context if some piece of the
-	// deployment's execution fails.
-	ctx, cancel := context.WithCancel(callerCtx)
-
-	// Set up a step generator and executor for this deployment.
+	// Set up a step executor for this deployment.
 	ex.stepExec = newStepExecutor(ctx, cancel, ex.deployment, opts, preview, false)
 
 	// We iterate the source in its own goroutine because iteration is blocking and we want the main loop to be able to
@@ -449,6 +450,9 @@ func (ex *deploymentExecutor) handleSingleEvent(event SourceEvent) result.Result
 	case RegisterResourceOutputsEvent:
 		logging.V(4).Infof("deploymentExecutor.handleSingleEvent(...): received register resource outputs")
 		return ex.stepExec.ExecuteRegisterResourceOutputs(e)
+	case ConstructResultEvent:
+		logging.V(4).Infof("deploymentExecutor.handleSingleEvent(...): received construct result")
+		return ex.stepExec.ExecuteConstructResult(e)
 	}
 
 	if res != nil {
@@ -571,8 +575,10 @@ func (ex *deploymentExecutor) refresh(callerCtx context.Context, opts Options, p
 		}
 	}
 
-	// Fire up a worker pool and issue each refresh in turn.
+	// Fire up a worker pool and issue each refresh in turn. Reads from the same provider are grouped so that they can
+	// be serviced by BatchRead.
 	ctx, cancel := context.WithCancel(callerCtx)
+	batchRefreshSteps(ctx, steps, opts.DegreeOfParallelism())
 	stepExec := newStepExecutor(ctx, cancel, ex.deployment, opts, preview, true)
 	stepExec.ExecuteParallel(steps)
 	stepExec.SignalCompletion()
@@ -230,6 +230,7 @@ type pluginHost struct {
 	providers []plugin.Provider
 	analyzers []plugin.Analyzer
 	plugins   map[interface{}]io.Closer
+	factories map[string]plugin.ProviderFactoryFunc
 	closed    bool
 	m         sync.Mutex
 }
@@ -326,6 +327,19 @@ func (host *pluginHost) plugin(kind workspace.PluginKind, name string, version *
 }
 
 func (host *pluginHost) Provider(pkg tokens.Package, version *semver.Version) (plugin.Provider, error) {
+	if f, v, ok := host.factory(pkg, version); ok {
+		prov, err := f(pkg, v)
+		if err != nil {
+			return nil, err
+		}
+
+		host.m.Lock()
+		defer host.m.Unlock()
+		host.providers = append(host.providers, prov)
+		host.plugins[prov] = nopCloser
+		return prov, nil
+	}
+
 	plug, err := host.plugin(workspace.ResourcePlugin, string(pkg), version, nil)
 	if err != nil {
 		return nil, err
@@ -340,6 +354,36 @@ func (host *pluginHost) Provider(pkg tokens.Package, version *semver.Version) (p
 	return plug.(plugin.Provider), nil
 }
 
+func (host *pluginHost) RegisterFactory(pkg tokens.Package, version string, f plugin.ProviderFactoryFunc) error {
+	host.m.Lock()
+	defer host.m.Unlock()
+
+	if host.factories == nil {
+		host.factories = map[string]plugin.ProviderFactoryFunc{}
+	}
+	host.factories[string(pkg)+"@"+version] = f
+	return nil
+}
+
+// factory returns the factory registered for the given package and version, if any, along with the version string to
+// pass to it.
+func (host *pluginHost) factory(pkg tokens.Package,
+	version *semver.Version) (plugin.ProviderFactoryFunc, string, bool) {
+
+	host.m.Lock()
+	defer host.m.Unlock()
+
+	var v string
+	if version != nil {
+		v = version.String()
+	}
+	f, ok := host.factories[string(pkg)+"@"+v]
+	if !ok {
+		f, ok = host.factories[string(pkg)+"@"]
+	}
+	return f, v, ok
+}
+
 func (host *pluginHost) LanguageRuntime(runtime string) (plugin.LanguageRuntime, error) {
 	return host.languageRuntime, nil
 }
@@ -350,7 +394,7 @@ func (host *pluginHost) SignalCancellation() error {
 
 	var err error
 	for _, prov := range host.providers {
-		if pErr := prov.SignalCancellation(); pErr != nil {
+		if pErr := prov.SignalCancellation(context.TODO()); pErr != nil {
 			err = pErr
 		}
 	}
@@ -36,13 +36,19 @@ type Provider struct {
 	Config     resource.PropertyMap
 	configured bool
 
-	GetSchemaF func(version int) ([]byte, error)
+	GetSchemaF            func(version int) ([]byte, error)
+	GetSupportedVersionsF func() ([]int, error)
 
 	CheckConfigF func(urn resource.URN, olds,
 		news resource.PropertyMap, allowUnknowns bool) (resource.PropertyMap, []plugin.CheckFailure, error)
-	DiffConfigF func(urn resource.URN, olds, news resource.PropertyMap,
+	ConfigChecksumMatchF func(urn resource.URN, checksum string) (bool, error)
+	DiffConfigF          func(urn resource.URN, olds, news resource.PropertyMap,
 		ignoreChanges []string) (plugin.DiffResult, error)
 	ConfigureF func(news resource.PropertyMap) error
+	ValidateF  func() error
+
+	ParameterizeByValueF     func(value []byte) (plugin.ParameterizeResult, error)
+	ParameterizeByReferenceF func(ref plugin.ParameterizeReference) (plugin.ParameterizeResult, error)
 
 	CheckF func(urn resource.URN,
 		olds, news resource.PropertyMap, randomSeed []byte) (resource.PropertyMap, []plugin.CheckFailure, error)
@@ -50,12 +56,31 @@ type Provider struct {
 		ignoreChanges []string) (plugin.DiffResult, error)
 	CreateF func(urn resource.URN, inputs resource.PropertyMap, timeout float64,
 		preview bool) (resource.ID, resource.PropertyMap, resource.Status, error)
+	StreamCreateF func(urn resource.URN, inputs resource.PropertyMap, timeout float64, preview bool,
+		onNext func(resource.PropertyMap) error) (resource.ID, resource.PropertyMap, resource.Status, error)
+	WaitForResourceReadyF func(urn resource.URN, id resource.ID,
+		timeout float64) error
 	UpdateF func(urn resource.URN, id resource.ID, olds, news resource.PropertyMap, timeout float64,
 		ignoreChanges []string, preview bool) (resource.PropertyMap, resource.Status, error)
 	DeleteF func(urn resource.URN, id resource.ID, olds resource.PropertyMap, timeout float64) (resource.Status, error)
 	ReadF   func(urn resource.URN, id resource.ID,
 		inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error)
 
+	PrepareImportF func(urn resource.URN, id resource.ID) (resource.PropertyMap, error)
+
+	RefreshF func(urn resource.URN, id resource.ID,
+		inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error)
+
+	ReadStreamF func(urn resource.URN, id resource.ID, inputs, state resource.PropertyMap,
+		onNext func(plugin.ReadResult) error) (resource.Status, error)
+
+	BatchReadF func(requests []plugin.BatchReadRequest) ([]plugin.BatchReadResponse, error)
+
+	SchemaVersionF func() (int, error)
+	MigrateStateF  func(urn resource.URN, stateVersion int, state resource.PropertyMap) (resource.PropertyMap, error)
+
+	GetResourceAliasesF func(urn resource.URN) ([]resource.Alias, error)
+
 	ConstructF func(monitor *ResourceMonitor, typ, name string, parent resource.URN, inputs resource.PropertyMap,
 		options plugin.ConstructOptions) (plugin.ConstructResult, error)
 
@@ -66,9 +91,19 @@ type Provider struct {
 		options plugin.CallOptions) (plugin.CallResult, error)
 
 	CancelF func() error
+
+	GetMappingF func(key string) ([]byte, string, error)
+
+	SupportsFeatureF func(feature string) (bool, error)
+
+	RegisterEventEmitterF func(e plugin.EventEmitter) error
+
+	WatchConfigF func(ctx context.Context, onChange func(resource.PropertyMap)) error
+
+	EstimateCostF func(urn resource.URN, news resource.PropertyMap) (plugin.CostEstimate, error)
 }
 
-func (prov *Provider) SignalCancellation() error {
+func (prov *Provider) SignalCancellation(ctx context.Context) error {
 	if prov.CancelF == nil {
 		return nil
 	}
@@ -83,46 +118,120 @@ func (prov *Provider) Pkg() tokens.Package {
 	return prov.Package
 }
 
-func (prov *Provider) GetPluginInfo() (workspace.PluginInfo, error) {
+func (prov *Provider) GetPluginInfo(ctx context.Context) (workspace.PluginInfo, error) {
 	return workspace.PluginInfo{
 		Name:    prov.Name,
 		Version: &prov.Version,
 	}, nil
 }
 
-func (prov *Provider) GetSchema(version int) ([]byte, error) {
+func (prov *Provider) GetMapping(ctx context.Context, key string) ([]byte, string, error) {
+	if prov.GetMappingF == nil {
+		return nil, "", nil
+	}
+	return prov.GetMappingF(key)
+}
+
+func (prov *Provider) SupportsFeature(ctx context.Context, feature string) (bool, error) {
+	if prov.SupportsFeatureF == nil {
+		return false, nil
+	}
+	return prov.SupportsFeatureF(feature)
+}
+
+func (prov *Provider) RegisterEventEmitter(e plugin.EventEmitter) error {
+	if prov.RegisterEventEmitterF == nil {
+		return plugin.ErrNotYetImplemented
+	}
+	return prov.RegisterEventEmitterF(e)
+}
+
+func (prov *Provider) WatchConfig(ctx context.Context, onChange func(resource.PropertyMap)) error {
+	if prov.WatchConfigF == nil {
+		return plugin.ErrNotYetImplemented
+	}
+	return prov.WatchConfigF(ctx, onChange)
+}
+
+func (prov *Provider) EstimateCost(ctx context.Context, urn resource.URN,
+	news resource.PropertyMap) (plugin.CostEstimate, error) {
+	if prov.EstimateCostF == nil {
+		return plugin.CostEstimate{}, plugin.ErrNotYetImplemented
+	}
+	return prov.EstimateCostF(urn, news)
+}
+
+func (prov *Provider) GetSchema(ctx context.Context, version int) (plugin.GetSchemaResponse, error) {
 	if prov.GetSchemaF == nil {
-		return []byte("{}"), nil
+		return plugin.NewGetSchemaResponse([]byte("{}")), nil
 	}
-	return prov.GetSchemaF(version)
+	schema, err := prov.GetSchemaF(version)
+	if err != nil {
+		return plugin.GetSchemaResponse{}, err
+	}
+	return plugin.NewGetSchemaResponse(schema), nil
 }
 
-func (prov *Provider) CheckConfig(urn resource.URN, olds,
+func (prov *Provider) GetSupportedVersions(ctx context.Context) ([]int, error) {
+	if prov.GetSupportedVersionsF == nil {
+		return nil, plugin.ErrNotYetImplemented
+	}
+	return prov.GetSupportedVersionsF()
+}
+
+func (prov *Provider) CheckConfig(ctx context.Context, urn resource.URN, olds,
 	news resource.PropertyMap, allowUnknowns bool) (resource.PropertyMap, []plugin.CheckFailure, error) {
 	if prov.CheckConfigF == nil {
 		return news, nil, nil
 	}
 	return prov.CheckConfigF(urn, olds, news, allowUnknowns)
 }
-func (prov *Provider) DiffConfig(urn resource.URN, olds, news resource.PropertyMap, _ bool,
+func (prov *Provider) ConfigChecksumMatch(ctx context.Context, urn resource.URN, checksum string) (bool, error) {
+	if prov.ConfigChecksumMatchF == nil {
+		return false, plugin.ErrNotYetImplemented
+	}
+	return prov.ConfigChecksumMatchF(urn, checksum)
+}
+func (prov *Provider) DiffConfig(ctx context.Context, urn resource.URN, olds, news resource.PropertyMap, _ bool,
 	ignoreChanges []string) (plugin.DiffResult, error) {
 	if prov.DiffConfigF == nil {
 		return plugin.DiffResult{}, nil
 	}
 	return prov.DiffConfigF(urn, olds, news, ignoreChanges)
 }
-func (prov *Provider) Configure(inputs resource.PropertyMap) error {
+func (prov *Provider) Configure(ctx context.Context, cfg plugin.ProviderConfig) error {
 	contract.Assert(!prov.configured)
 	prov.configured = true
 
 	if prov.ConfigureF == nil {
-		prov.Config = inputs
+		prov.Config = cfg.PropertyMap
+		return nil
+	}
+	return prov.ConfigureF(cfg.PropertyMap)
+}
+func (prov *Provider) Validate(ctx context.Context) error {
+	if prov.ValidateF == nil {
 		return nil
 	}
-	return prov.ConfigureF(inputs)
+	return prov.ValidateF()
 }
 
-func (prov *Provider) Check(urn resource.URN,
+func (prov *Provider) ParameterizeByValue(ctx context.Context, value []byte) (plugin.ParameterizeResult, error) {
+	if prov.ParameterizeByValueF == nil {
+		return plugin.ParameterizeResult{}, plugin.ErrNotYetImplemented
+	}
+	return prov.ParameterizeByValueF(value)
+}
+
+func (prov *Provider) ParameterizeByReference(ctx context.Context,
+	ref plugin.ParameterizeReference) (plugin.ParameterizeResult, error) {
+	if prov.ParameterizeByReferenceF == nil {
+		return plugin.ParameterizeResult{}, plugin.ErrNotYetImplemented
+	}
+	return prov.ParameterizeByReferenceF(ref)
+}
+
+func (prov *Provider) Check(ctx context.Context, urn resource.URN,
 	olds, news resource.PropertyMap, _ bool, randomSeed []byte) (resource.PropertyMap, []plugin.CheckFailure, error) {
 	contract.Assert(randomSeed != nil)
 	if prov.CheckF == nil {
@@ -130,7 +239,7 @@ func (prov *Provider) Check(urn resource.URN,
 	}
 	return prov.CheckF(urn, olds, news, randomSeed)
 }
-func (prov *Provider) Create(urn resource.URN, props resource.PropertyMap, timeout float64,
+func (prov *Provider) Create(ctx context.Context, urn resource.URN, props resource.PropertyMap, timeout float64,
 	preview bool) (resource.ID, resource.PropertyMap, resource.Status, error) {
 
 	if prov.CreateF == nil {
@@ -143,21 +252,41 @@ func (prov *Provider) Create(urn resource.URN, props resource.PropertyMap, timeo
 	}
 	return prov.CreateF(urn, props, timeout, preview)
 }
-func (prov *Provider) Diff(urn resource.URN, id resource.ID,
+
+func (prov *Provider) StreamCreate(ctx context.Context, urn resource.URN, props resource.PropertyMap,
+	timeout float64, preview bool,
+	onNext func(resource.PropertyMap) error) (resource.ID, resource.PropertyMap, resource.Status, error) {
+
+	if prov.StreamCreateF == nil {
+		return plugin.SingleStreamCreate(ctx, prov, urn, props, timeout, preview, onNext)
+	}
+	return prov.StreamCreateF(urn, props, timeout, preview, onNext)
+}
+
+func (prov *Provider) WaitForResourceReady(ctx context.Context, urn resource.URN, id resource.ID,
+	timeout float64) error {
+
+	if prov.WaitForResourceReadyF == nil {
+		return plugin.ErrNotYetImplemented
+	}
+	return prov.WaitForResourceReadyF(urn, id, timeout)
+}
+func (prov *Provider) Diff(ctx context.Context, urn resource.URN, id resource.ID,
 	olds resource.PropertyMap, news resource.PropertyMap, _ bool, ignoreChanges []string) (plugin.DiffResult, error) {
 	if prov.DiffF == nil {
 		return plugin.DiffResult{}, nil
 	}
 	return prov.DiffF(urn, id, olds, news, ignoreChanges)
 }
-func (prov *Provider) Update(urn resource.URN, id resource.ID, olds resource.PropertyMap, news resource.PropertyMap,
+func (prov *Provider) Update(ctx context.Context, urn resource.URN, id resource.ID, olds resource.PropertyMap,
+	news resource.PropertyMap,
 	timeout float64, ignoreChanges []string, preview bool) (resource.PropertyMap, resource.Status, error) {
 	if prov.UpdateF == nil {
 		return news, resource.StatusOK, nil
 	}
 	return prov.UpdateF(urn, id, olds, news, timeout, ignoreChanges, preview)
 }
-func (prov *Provider) Delete(urn resource.URN,
+func (prov *Provider) Delete(ctx context.Context, urn resource.URN,
 	id resource.ID, props resource.PropertyMap, timeout float64) (resource.Status, error) {
 	if prov.DeleteF == nil {
 		return resource.StatusOK, nil
@@ -165,7 +294,7 @@ func (prov *Provider) Delete(urn resource.URN,
 	return prov.DeleteF(urn, id, props, timeout)
 }
 
-func (prov *Provider) Read(urn resource.URN, id resource.ID,
+func (prov *Provider) Read(ctx context.Context, urn resource.URN, id resource.ID,
 	inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error) {
 	contract.Assertf(urn != "", "Read URN was empty")
 	contract.Assertf(id != "", "Read ID was empty")
@@ -178,8 +307,66 @@ func (prov *Provider) Read(urn resource.URN, id resource.ID,
 	return prov.ReadF(urn, id, inputs, state)
 }
 
-func (prov *Provider) Construct(info plugin.ConstructInfo, typ tokens.Type, name tokens.QName, parent resource.URN,
-	inputs resource.PropertyMap, options plugin.ConstructOptions) (plugin.ConstructResult, error) {
+func (prov *Provider) PrepareImport(ctx context.Context, urn resource.URN,
+	id resource.ID) (resource.PropertyMap, error) {
+	if prov.PrepareImportF == nil {
+		return nil, plugin.ErrNotYetImplemented
+	}
+	return prov.PrepareImportF(urn, id)
+}
+
+func (prov *Provider) Refresh(ctx context.Context, urn resource.URN, id resource.ID,
+	inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error) {
+	contract.Assertf(urn != "", "Refresh URN was empty")
+	contract.Assertf(id != "", "Refresh ID was empty")
+	if prov.RefreshF == nil {
+		return plugin.RefreshWithReadStream(ctx, prov, urn, id, inputs, state)
+	}
+	return prov.RefreshF(urn, id, inputs, state)
+}
+
+func (prov *Provider) SchemaVersion(ctx context.Context) (int, error) {
+	if prov.SchemaVersionF == nil {
+		return 0, nil
+	}
+	return prov.SchemaVersionF()
+}
+
+func (prov *Provider) MigrateState(ctx context.Context, urn resource.URN, stateVersion int,
+	state resource.PropertyMap) (resource.PropertyMap, error) {
+	if prov.MigrateStateF == nil {
+		return nil, plugin.ErrNotYetImplemented
+	}
+	return prov.MigrateStateF(urn, stateVersion, state)
+}
+
+func (prov *Provider) GetResourceAliases(ctx context.Context, urn resource.URN) ([]resource.Alias, error) {
+	if prov.GetResourceAliasesF == nil {
+		return nil, plugin.ErrNotYetImplemented
+	}
+	return prov.GetResourceAliasesF(urn)
+}
+
+func (prov *Provider) ReadStream(ctx context.Context, urn resource.URN, id resource.ID,
+	inputs, state resource.PropertyMap, onNext func(plugin.ReadResult) error) (resource.Status, error) {
+	contract.Assertf(urn != "", "ReadStream URN was empty")
+	contract.Assertf(id != "", "ReadStream ID was empty")
+	if prov.ReadStreamF == nil {
+		return plugin.SingleReadStream(ctx, prov, urn, id, inputs, state, onNext)
+	}
+	return prov.ReadStreamF(urn, id, inputs, state, onNext)
+}
+
+func (prov *Provider) BatchRead(ctx context.Context,
+	requests []plugin.BatchReadRequest) ([]plugin.BatchReadResponse, error) {
+	if prov.BatchReadF == nil {
+		return plugin.SequentialBatchRead(ctx, prov, requests)
+	}
+	return prov.BatchReadF(requests)
+}
+
+func (prov *Provider) Construct(ctx context.Context, info plugin.ConstructInfo, typ tokens.Type, name tokens.QName,
+	parent resource.URN, inputs resource.PropertyMap, options plugin.ConstructOptions) (plugin.ConstructResult, error) {
 	if prov.ConstructF == nil {
 		return plugin.ConstructResult{}, nil
 	}
@@ -187,10 +374,14 @@ func (prov *Provider) Construct(info plugin.ConstructInfo, typ tokens.Type, name
 	if err != nil {
 		return plugin.ConstructResult{}, err
 	}
+
+	// Apply the transforms to the component's inputs and to the inputs of the children that it registers.
+	monitor.transforms = options.Transforms
+	inputs = plugin.ApplyResourceTransforms(options.Transforms, inputs)
 	return prov.ConstructF(monitor, string(typ), string(name), parent, inputs, options)
 }
 
-func (prov *Provider) Invoke(tok tokens.ModuleMember,
+func (prov *Provider) Invoke(ctx context.Context, tok tokens.ModuleMember,
 	args resource.PropertyMap) (resource.PropertyMap, []plugin.CheckFailure, error) {
 	if prov.InvokeF == nil {
 		return resource.PropertyMap{}, nil, nil
@@ -199,14 +390,15 @@ func (prov *Provider) Invoke(tok tokens.ModuleMember,
 }
 
 func (prov *Provider) StreamInvoke(
+	ctx context.Context,
 	tok tokens.ModuleMember, args resource.PropertyMap,
-	onNext func(resource.PropertyMap) error) ([]plugin.CheckFailure, error) {
+	onNext func(plugin.StreamInvokeEvent) error) ([]plugin.CheckFailure, error) {
 
 	return nil, fmt.Errorf("not implemented")
 }
 
-func (prov *Provider) Call(tok tokens.ModuleMember, args resource.PropertyMap, info plugin.CallInfo,
-	options plugin.CallOptions) (plugin.CallResult, error) {
+func (prov *Provider) Call(ctx context.Context, tok tokens.ModuleMember, args resource.PropertyMap,
+	info plugin.CallInfo, options plugin.CallOptions) (plugin.CallResult, error) {
 	if prov.CallF == nil {
 		return plugin.CallResult{}, nil
 	}
@@ -33,6 +33,9 @@ type ResourceMonitor struct {
 
 	supportsSecrets            bool
 	supportsResourceReferences bool
+
+	// transforms are applied to the inputs of each resource registered through this monitor.
+	transforms []plugin.ResourceTransform
 }
 
 func dialMonitor(ctx context.Context, endpoint string) (*ResourceMonitor, error) {
@@ -120,6 +123,7 @@ func (rm *ResourceMonitor) RegisterResource(t tokens.Type, name string, custom b
 	if opts.Inputs == nil {
 		opts.Inputs = resource.PropertyMap{}
 	}
+	opts.Inputs = plugin.ApplyResourceTransforms(rm.transforms, opts.Inputs)
 
 	// marshal inputs
 	ins, err := plugin.MarshalProperties(opts.Inputs, plugin.MarshalOptions{
@@ -82,7 +82,7 @@ func NewImportDeployment(ctx *plugin.Context, target *Target, projectName tokens
 	builtins := newBuiltinProvider(nil, nil)
 
 	// Create a new provider registry.
-	reg, err := providers.NewRegistry(ctx.Host, oldResources, preview, builtins)
+	reg, err := providers.NewRegistry(ctx.Request(), ctx.Host, oldResources, preview, builtins)
 	if err != nil {
 		return nil, err
 	}
@@ -96,7 +96,7 @@ func NewImportDeployment(ctx *plugin.Context, target *Target, projectName tokens
 		goals:        newGoals,
 		imports:      imports,
 		isImport:     true,
-		schemaLoader: schema.NewPluginLoader(ctx.Host),
+		schemaLoader: schema.NewPluginLoader(ctx.Request(), ctx.Host),
 		source:       NewErrorSource(projectName),
 		preview:      preview,
 		providers:    reg,
@@ -249,7 +249,7 @@ func (i *importer) registerProviders(ctx context.Context) (map[resource.URN]stri
 		if url := req.PluginDownloadURL(); url != "" {
 			providers.SetProviderURL(inputs, url)
 		}
-		inputs, failures, err := i.deployment.providers.Check(urn, nil, inputs, false, nil)
+		inputs, failures, err := i.deployment.providers.Check(ctx, urn, nil, inputs, false, nil)
 		if err != nil {
 			return nil, result.Errorf("failed to validate provider config: %v", err), false
 		}
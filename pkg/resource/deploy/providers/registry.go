@@ -15,14 +15,19 @@
 package providers
 
 import (
+	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/blang/semver"
 	uuid "github.com/gofrs/uuid"
 
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
@@ -32,6 +37,7 @@ import (
 
 const versionKey resource.PropertyKey = "version"
 const pluginDownloadKey resource.PropertyKey = "pluginDownloadURL"
+const parameterizationKey resource.PropertyKey = "parameterization"
 
 // SetProviderURL sets the provider plugin download server URL in the given property map.
 func SetProviderURL(inputs resource.PropertyMap, value string) {
@@ -75,6 +81,69 @@ func GetProviderVersion(inputs resource.PropertyMap) (*semver.Version, error) {
 	return &sv, nil
 }
 
+// Parameterization describes how a provider plugin is parameterized after it has been configured. Exactly one of
+// Value and Reference is set.
+type Parameterization struct {
+	Value     []byte                        // the opaque value to parameterize the provider with.
+	Reference *plugin.ParameterizeReference // the package to parameterize the provider with.
+}
+
+// SetProviderParameterization sets the provider parameterization in the given property map. Values are recorded as
+// base64-encoded strings.
+func SetProviderParameterization(inputs resource.PropertyMap, value Parameterization) {
+	obj := resource.PropertyMap{}
+	if value.Reference != nil {
+		obj["name"] = resource.NewStringProperty(value.Reference.Name)
+		if value.Reference.Version != nil {
+			obj["version"] = resource.NewStringProperty(value.Reference.Version.String())
+		}
+	} else {
+		obj["value"] = resource.NewStringProperty(base64.StdEncoding.EncodeToString(value.Value))
+	}
+	inputs[parameterizationKey] = resource.NewObjectProperty(obj)
+}
+
+// GetProviderParameterization fetches and parses a provider parameterization from the given property map. If the
+// parameterization property is not present, this function returns nil.
+func GetProviderParameterization(inputs resource.PropertyMap) (*Parameterization, error) {
+	param, ok := inputs[parameterizationKey]
+	if !ok {
+		return nil, nil
+	}
+	if !param.IsObject() {
+		return nil, fmt.Errorf("'%s' must be an object", parameterizationKey)
+	}
+	obj := param.ObjectValue()
+
+	if value, ok := obj["value"]; ok {
+		if !value.IsString() {
+			return nil, fmt.Errorf("'%s.value' must be a string", parameterizationKey)
+		}
+		bytes, err := base64.StdEncoding.DecodeString(value.StringValue())
+		if err != nil {
+			return nil, fmt.Errorf("could not decode provider parameter value: %v", err)
+		}
+		return &Parameterization{Value: bytes}, nil
+	}
+
+	name, ok := obj["name"]
+	if !ok || !name.IsString() || name.StringValue() == "" {
+		return nil, fmt.Errorf("'%s' must have either a value or a name", parameterizationKey)
+	}
+	ref := &plugin.ParameterizeReference{Name: name.StringValue()}
+	if version, ok := obj["version"]; ok {
+		if !version.IsString() {
+			return nil, fmt.Errorf("'%s.version' must be a string", parameterizationKey)
+		}
+		sv, err := semver.ParseTolerant(version.StringValue())
+		if err != nil {
+			return nil, fmt.Errorf("could not parse provider parameter version: %v", err)
+		}
+		ref.Version = &sv
+	}
+	return &Parameterization{Reference: ref}, nil
+}
+
 // Registry manages the lifecylce of provider resources and their plugins and handles the resolution of provider
 // references to loaded plugins.
 //
@@ -84,6 +153,12 @@ func GetProviderVersion(inputs resource.PropertyMap) (*semver.Version, error) {
 // prepared to be used to manage the lifecycle of these providers as well as any new provider resources requested by
 // invoking the registry's CRUD operations.
 //
+// Each configured provider is also asked to watch its configuration. When a provider reports that its configuration
+// has changed outside of Pulumi, e.g. because a credential has been rotated, the registry checks the new configuration
+// and registers a newly loaded provider configured with it in place of the old one, so that the resources which use
+// the provider are managed with the new configuration for the remainder of the deployment. The replaced provider may
+// still be in use by operations that are in flight, so it is closed along with the registry.
+//
 // In order to fit neatly in to the existing infrastructure for managing resources using Pulumi, a provider regidstry
 // itself implements the plugin.Provider interface.
 type Registry struct {
@@ -92,9 +167,19 @@ type Registry struct {
 	providers map[Reference]plugin.Provider
 	builtins  plugin.Provider
 	aliases   map[resource.URN]resource.URN
+	watches   map[Reference]*configWatch
+	retired   []plugin.Provider  // providers that were replaced after their configuration changed.
+	ctx       context.Context    // the context from which configuration watches are derived.
+	cancel    context.CancelFunc // cancels every configuration watch.
 	m         sync.RWMutex
 }
 
+// configWatch tracks the configuration of a provider whose configuration is being watched.
+type configWatch struct {
+	cancel context.CancelFunc
+	inputs resource.PropertyMap // the inputs that the provider was configured with.
+}
+
 var _ plugin.Provider = (*Registry)(nil)
 
 func loadProvider(pkg tokens.Package, version *semver.Version, host plugin.Host,
@@ -109,16 +194,21 @@ func loadProvider(pkg tokens.Package, version *semver.Version, host plugin.Host,
 
 // NewRegistry creates a new provider registry using the given host and old resources. Each provider present in the old
 // resources will be loaded, configured, and added to the returned registry under its reference. If any provider is not
-// loadable/configurable or has an invalid ID, this function returns an error.
-func NewRegistry(host plugin.Host, prev []*resource.State, isPreview bool,
+// loadable/configurable or has an invalid ID, this function returns an error. The given context is used to configure
+// the providers in the old resources, and configuration watches are derived from it.
+func NewRegistry(ctx context.Context, host plugin.Host, prev []*resource.State, isPreview bool,
 	builtins plugin.Provider) (*Registry, error) {
 
+	watchCtx, cancel := context.WithCancel(ctx)
 	r := &Registry{
 		host:      host,
 		isPreview: isPreview,
 		providers: make(map[Reference]plugin.Provider),
 		builtins:  builtins,
 		aliases:   make(map[resource.URN]resource.URN),
+		watches:   make(map[Reference]*configWatch),
+		ctx:       watchCtx,
+		cancel:    cancel,
 	}
 
 	for _, res := range prev {
@@ -153,19 +243,77 @@ func NewRegistry(host plugin.Host, prev []*resource.State, isPreview bool,
 		if provider == nil {
 			return nil, fmt.Errorf("could not find plugin for %v provider '%v' at version %v", providerPkg, urn, version)
 		}
-		if err := provider.Configure(res.Inputs); err != nil {
+		if err := provider.Configure(ctx, providerConfig(urn, res.Inputs)); err != nil {
 			closeErr := host.CloseProvider(provider)
 			contract.IgnoreError(closeErr)
 			return nil, fmt.Errorf("could not configure provider '%v': %v", urn, err)
 		}
+		if err := parameterizeProvider(ctx, urn, provider, res.Inputs); err != nil {
+			closeErr := host.CloseProvider(provider)
+			contract.IgnoreError(closeErr)
+			return nil, err
+		}
+		if err := validateProvider(ctx, urn, provider); err != nil {
+			closeErr := host.CloseProvider(provider)
+			contract.IgnoreError(closeErr)
+			return nil, err
+		}
 
 		logging.V(7).Infof("loaded provider %v", ref)
-		r.providers[ref] = provider
+		r.publishProvider(ref, provider, res.Inputs, nil)
 	}
 
 	return r, nil
 }
 
+// providerConfig returns the configuration for the provider with the given URN. Inputs with secret values, such as
+// those that were read from secrets in the stack's configuration, are reported as secret configuration keys.
+func providerConfig(urn resource.URN, inputs resource.PropertyMap) plugin.ProviderConfig {
+	pkg := GetProviderPackage(urn.Type())
+
+	var secretKeys []config.Key
+	for k, v := range inputs {
+		if v.ContainsSecrets() {
+			secretKeys = append(secretKeys, config.MustMakeKey(string(pkg), string(k)))
+		}
+	}
+	return plugin.NewProviderConfig(inputs, secretKeys)
+}
+
+// parameterizeProvider parameterizes a provider that has just been configured, if its inputs describe a
+// parameterization.
+func parameterizeProvider(ctx context.Context, urn resource.URN, provider plugin.Provider,
+	inputs resource.PropertyMap) error {
+
+	param, err := GetProviderParameterization(inputs)
+	if err != nil {
+		return fmt.Errorf("could not parse parameterization for provider '%v': %w", urn, err)
+	}
+	if param == nil {
+		return nil
+	}
+
+	var result plugin.ParameterizeResult
+	if param.Reference != nil {
+		result, err = provider.ParameterizeByReference(ctx, *param.Reference)
+	} else {
+		result, err = provider.ParameterizeByValue(ctx, param.Value)
+	}
+	if err != nil {
+		return fmt.Errorf("could not parameterize provider '%v': %w", urn, err)
+	}
+	logging.V(7).Infof("parameterized provider %v as %v@%v", urn, result.Name, result.Version)
+	return nil
+}
+
+// validateProvider runs the pre-flight checks for a provider that has just been configured.
+func validateProvider(ctx context.Context, urn resource.URN, provider plugin.Provider) error {
+	if err := provider.Validate(ctx); err != nil {
+		return fmt.Errorf("provider '%v' failed validation: %w", urn, err)
+	}
+	return nil
+}
+
 // GetProvider returns the provider plugin that is currently registered under the given reference, if any.
 func (r *Registry) GetProvider(ref Reference) (plugin.Provider, bool) {
 	r.m.RLock()
@@ -181,6 +329,11 @@ func (r *Registry) setProvider(ref Reference, provider plugin.Provider) {
 	r.m.Lock()
 	defer r.m.Unlock()
 
+	r.setProviderLocked(ref, provider)
+}
+
+// setProviderLocked is setProvider for callers that hold the registry's lock.
+func (r *Registry) setProviderLocked(ref Reference, provider plugin.Provider) {
 	logging.V(7).Infof("setProvider(%v)", ref)
 
 	r.providers[ref] = provider
@@ -202,9 +355,145 @@ func (r *Registry) deleteProvider(ref Reference) (plugin.Provider, bool) {
 	return provider, true
 }
 
+// publishProvider registers the given provider, which was configured with the given inputs, under the given reference
+// and watches its configuration in place of any earlier watch of the reference. If replacing is not nil, the provider
+// is only published if replacing is still the reference's watch and the registry has not been closed; publishProvider
+// returns false if it is not. The provider that it replaces is then retired, to be closed when the registry is closed.
+func (r *Registry) publishProvider(ref Reference, provider plugin.Provider, inputs resource.PropertyMap,
+	replacing *configWatch) bool {
+
+	ctx, cancel := context.WithCancel(r.ctx)
+	w := &configWatch{cancel: cancel, inputs: inputs}
+
+	r.m.Lock()
+	old, watched := r.watches[ref]
+	if replacing != nil && (old != replacing || r.ctx.Err() != nil) {
+		r.m.Unlock()
+		cancel()
+		return false
+	}
+	if watched {
+		old.cancel()
+	}
+	if replaced, ok := r.providers[ref]; ok && replacing != nil {
+		r.retired = append(r.retired, replaced)
+	}
+	r.setProviderLocked(ref, provider)
+	r.watches[ref] = w
+	r.m.Unlock()
+
+	err := provider.WatchConfig(ctx, func(changes resource.PropertyMap) {
+		r.reconfigure(ctx, ref, w, changes)
+	})
+	if err != nil {
+		cancel()
+		if !errors.Is(err, plugin.ErrNotYetImplemented) {
+			logging.V(7).Infof("could not watch the configuration of provider %v; ignoring: %v", ref, err)
+		}
+	}
+	return true
+}
+
+// unwatchConfig cancels the watch of the configuration of the provider registered under the given reference, if any.
+func (r *Registry) unwatchConfig(ref Reference) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	if w, ok := r.watches[ref]; ok {
+		w.cancel()
+		delete(r.watches, ref)
+	}
+}
+
+// reconfigure applies a change to the configuration of the provider registered under the given reference, as reported
+// by the watch w. The changed values are merged into the provider's inputs and checked; if they are valid, a new
+// provider is loaded, configured with them, and registered in place of the old provider. The old provider is not
+// closed until the registry is, as operations that are already in flight may still be using it. Failures are reported
+// as warnings and leave the old provider in place.
+func (r *Registry) reconfigure(ctx context.Context, ref Reference, w *configWatch, changes resource.PropertyMap) {
+	urn := ref.URN()
+	logging.V(7).Infof("reconfiguring provider %v (#changes=%v)", ref, len(changes))
+
+	err := func() error {
+		// The provider has been replaced or deleted since it reported the change.
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		olds := w.inputs
+		news := olds.Copy()
+		for k, v := range changes {
+			news[k] = v
+		}
+
+		current, ok := r.GetProvider(ref)
+		if !ok {
+			return nil
+		}
+		inputs, failures, err := current.CheckConfig(ctx, urn, olds, news, false)
+		if err != nil {
+			return err
+		}
+		if len(failures) != 0 {
+			reasons := make([]string, len(failures))
+			for i, f := range failures {
+				reasons[i] = f.Reason
+			}
+			return fmt.Errorf("invalid configuration: %v", strings.Join(reasons, "; "))
+		}
+
+		version, err := GetProviderVersion(inputs)
+		if err != nil {
+			return err
+		}
+		provider, err := loadProvider(GetProviderPackage(urn.Type()), version, r.host, r.builtins)
+		if err != nil {
+			return err
+		}
+		if provider == nil {
+			return fmt.Errorf("could not find plugin at version %v", version)
+		}
+		if err := provider.Configure(ctx, providerConfig(urn, inputs)); err != nil {
+			contract.IgnoreError(r.host.CloseProvider(provider))
+			return err
+		}
+		if err := parameterizeProvider(ctx, urn, provider, inputs); err != nil {
+			contract.IgnoreError(r.host.CloseProvider(provider))
+			return err
+		}
+		if err := validateProvider(ctx, urn, provider); err != nil {
+			contract.IgnoreError(r.host.CloseProvider(provider))
+			return err
+		}
+
+		// If the old provider was replaced or deleted while the new provider was being configured, the change no
+		// longer applies.
+		if !r.publishProvider(ref, provider, inputs, w) {
+			contract.IgnoreError(r.host.CloseProvider(provider))
+		}
+		return nil
+	}()
+	if err != nil {
+		r.host.Log(diag.Warning, urn, fmt.Sprintf("could not reconfigure provider after its configuration changed: %v",
+			err), 0)
+	}
+}
+
 // The rest of the methods below are the implementation of the plugin.Provider interface methods.
 
+// Close stops watching the configuration of the registry's providers and closes the providers that were replaced
+// after their configuration changed. It must only be called once no operations are using the registry's providers.
 func (r *Registry) Close() error {
+	r.cancel()
+
+	r.m.Lock()
+	retired := r.retired
+	r.watches, r.retired = make(map[Reference]*configWatch), nil
+	r.m.Unlock()
+
+	for _, provider := range retired {
+		contract.IgnoreError(r.host.CloseProvider(provider))
+	}
 	return nil
 }
 
@@ -217,32 +506,75 @@ func (r *Registry) label() string {
 }
 
 // GetSchema returns the JSON-serialized schema for the provider.
-func (r *Registry) GetSchema(version int) ([]byte, error) {
+func (r *Registry) GetSchema(ctx context.Context, version int) (plugin.GetSchemaResponse, error) {
 	contract.Fail()
 
-	return nil, errors.New("the provider registry has no schema")
+	return plugin.GetSchemaResponse{}, errors.New("the provider registry has no schema")
+}
+
+func (r *Registry) GetSupportedVersions(ctx context.Context) ([]int, error) {
+	return nil, plugin.ErrNotYetImplemented
 }
 
 // CheckConfig validates the configuration for this resource provider.
-func (r *Registry) CheckConfig(urn resource.URN, olds,
+func (r *Registry) CheckConfig(ctx context.Context, urn resource.URN, olds,
 	news resource.PropertyMap, allowUnknowns bool) (resource.PropertyMap, []plugin.CheckFailure, error) {
 
 	contract.Fail()
 	return nil, nil, errors.New("the provider registry is not configurable")
 }
 
+// ConfigChecksumMatch asks the provider that is configured for the existing provider resource with the given URN, or
+// the URN that it is aliased to, whether it has already validated config with the given checksum. It returns
+// ErrNotYetImplemented if there is no such provider.
+func (r *Registry) ConfigChecksumMatch(ctx context.Context, urn resource.URN, checksum string) (bool, error) {
+	provider, ok := r.configuredProvider(urn)
+	if !ok {
+		return false, plugin.ErrNotYetImplemented
+	}
+	return provider.ConfigChecksumMatch(ctx, urn, checksum)
+}
+
+// configuredProvider returns the provider that was loaded and configured for the existing provider resource with the
+// given URN, or the URN that it is aliased to, if there is one.
+func (r *Registry) configuredProvider(urn resource.URN) (plugin.Provider, bool) {
+	r.m.RLock()
+	defer r.m.RUnlock()
+
+	alias, hasAlias := r.aliases[urn]
+	for ref, provider := range r.providers {
+		if ref.ID() != UnknownID && (ref.URN() == urn || hasAlias && ref.URN() == alias) {
+			return provider, true
+		}
+	}
+	return nil, false
+}
+
 // DiffConfig checks what impacts a hypothetical change to this provider's configuration will have on the provider.
-func (r *Registry) DiffConfig(urn resource.URN, olds, news resource.PropertyMap,
+func (r *Registry) DiffConfig(ctx context.Context, urn resource.URN, olds, news resource.PropertyMap,
 	allowUnknowns bool, ignoreChanges []string) (plugin.DiffResult, error) {
 	contract.Fail()
 	return plugin.DiffResult{}, errors.New("the provider registry is not configurable")
 }
 
-func (r *Registry) Configure(props resource.PropertyMap) error {
+func (r *Registry) Configure(ctx context.Context, cfg plugin.ProviderConfig) error {
 	contract.Fail()
 	return errors.New("the provider registry is not configurable")
 }
 
+func (r *Registry) Validate(ctx context.Context) error {
+	return nil
+}
+
+func (r *Registry) ParameterizeByValue(ctx context.Context, value []byte) (plugin.ParameterizeResult, error) {
+	return plugin.ParameterizeResult{}, plugin.ErrNotYetImplemented
+}
+
+func (r *Registry) ParameterizeByReference(ctx context.Context,
+	ref plugin.ParameterizeReference) (plugin.ParameterizeResult, error) {
+	return plugin.ParameterizeResult{}, plugin.ErrNotYetImplemented
+}
+
 // Check validates the configuration for a particular provider resource.
 //
 // The particulars of Check are a bit subtle for a few reasons:
@@ -251,7 +583,7 @@ func (r *Registry) Configure(props resource.PropertyMap) error {
 //   - we need to keep the newly-loaded provider around in case we need to diff its config
 //   - if we are running a preview, we need to configure the provider, as its corresponding CRUD operations will not run
 //     (we would normally configure the provider in Create or Update).
-func (r *Registry) Check(urn resource.URN, olds, news resource.PropertyMap,
+func (r *Registry) Check(ctx context.Context, urn resource.URN, olds, news resource.PropertyMap,
 	allowUnknowns bool, randomSeed []byte) (resource.PropertyMap, []plugin.CheckFailure, error) {
 
 	contract.Require(IsProviderType(urn.Type()), "urn")
@@ -264,6 +596,9 @@ func (r *Registry) Check(urn resource.URN, olds, news resource.PropertyMap,
 	if err != nil {
 		return nil, []plugin.CheckFailure{{Property: "version", Reason: err.Error()}}, nil
 	}
+	if _, err := GetProviderParameterization(news); err != nil {
+		return nil, []plugin.CheckFailure{{Property: parameterizationKey, Reason: err.Error()}}, nil
+	}
 	provider, err := loadProvider(GetProviderPackage(urn.Type()), version, r.host, r.builtins)
 	if err != nil {
 		return nil, nil, err
@@ -272,9 +607,29 @@ func (r *Registry) Check(urn resource.URN, olds, news resource.PropertyMap,
 		return nil, nil, errors.New("could not find plugin")
 	}
 
-	// Check the provider's config. If the check fails, unload the provider.
-	inputs, failures, err := provider.CheckConfig(urn, olds, news, allowUnknowns)
-	if len(failures) != 0 || err != nil {
+	// If the provider that is already configured for this resource has validated this exact config, skip CheckConfig
+	// and use the config as is. The newly loaded provider has not seen any config yet, so it cannot answer. Providers
+	// that do not cache validated config report ErrNotYetImplemented, in which case we always check it.
+	checksum, err := plugin.ConfigChecksum(news)
+	if err != nil {
+		return nil, nil, err
+	}
+	match, err := r.ConfigChecksumMatch(ctx, urn, checksum)
+	if err != nil && !errors.Is(err, plugin.ErrNotYetImplemented) {
+		closeErr := r.host.CloseProvider(provider)
+		contract.IgnoreError(closeErr)
+		return nil, nil, err
+	}
+	if match {
+		logging.V(7).Infof("%s config checksum matched; skipping CheckConfig", label)
+		r.setProvider(mustNewReference(urn, UnknownID), provider)
+		return news, nil, nil
+	}
+
+	// Check the provider's config. If the check fails, unload the provider. Warnings do not fail the check, so they
+	// are passed through alongside the checked inputs.
+	inputs, failures, err := provider.CheckConfig(ctx, urn, olds, news, allowUnknowns)
+	if plugin.HasCheckErrors(failures) || err != nil {
 		closeErr := r.host.CloseProvider(provider)
 		contract.IgnoreError(closeErr)
 		return nil, failures, err
@@ -283,7 +638,7 @@ func (r *Registry) Check(urn resource.URN, olds, news resource.PropertyMap,
 	// Create a provider reference using the URN and the unknown ID and register the provider.
 	r.setProvider(mustNewReference(urn, UnknownID), provider)
 
-	return inputs, nil, nil
+	return inputs, failures, nil
 }
 
 // RegisterAliases informs the registry that the new provider object with the given URN is aliased to the given list
@@ -296,7 +651,7 @@ func (r *Registry) RegisterAlias(providerURN, alias resource.URN) {
 
 // Diff diffs the configuration of the indicated provider. The provider corresponding to the given URN must have
 // previously been loaded by a call to Check.
-func (r *Registry) Diff(urn resource.URN, id resource.ID, olds, news resource.PropertyMap,
+func (r *Registry) Diff(ctx context.Context, urn resource.URN, id resource.ID, olds, news resource.PropertyMap,
 	allowUnknowns bool, ignoreChanges []string) (plugin.DiffResult, error) {
 	contract.Require(id != "", "id")
 
@@ -313,7 +668,7 @@ func (r *Registry) Diff(urn resource.URN, id resource.ID, olds, news resource.Pr
 		provider, ok = r.GetProvider(mustNewReference(urn, id))
 		contract.Assertf(ok, "Provider must have been registered by NewRegistry for DBR Diff (%v::%v)", urn, id)
 
-		diff, err := provider.DiffConfig(urn, olds, news, allowUnknowns, ignoreChanges)
+		diff, err := provider.DiffConfig(ctx, urn, olds, news, allowUnknowns, ignoreChanges)
 		if err != nil {
 			return plugin.DiffResult{Changes: plugin.DiffUnknown}, err
 		}
@@ -321,7 +676,7 @@ func (r *Registry) Diff(urn resource.URN, id resource.ID, olds, news resource.Pr
 	}
 
 	// Diff the properties.
-	diff, err := provider.DiffConfig(urn, olds, news, allowUnknowns, ignoreChanges)
+	diff, err := provider.DiffConfig(ctx, urn, olds, news, allowUnknowns, ignoreChanges)
 	if err != nil {
 		return plugin.DiffResult{Changes: plugin.DiffUnknown}, err
 	}
@@ -334,7 +689,7 @@ func (r *Registry) Diff(urn resource.URN, id resource.ID, olds, news resource.Pr
 	}
 
 	// If the diff requires replacement, unload the provider: the engine will reload it during its replacememnt Check.
-	if diff.Replace() {
+	if diff.RequiresReplacement() {
 		closeErr := r.host.CloseProvider(provider)
 		contract.IgnoreError(closeErr)
 	}
@@ -364,7 +719,7 @@ func (r *Registry) Same(ref Reference) {
 // registers it under the assigned (URN, ID).
 //
 // The provider must have been loaded by a prior call to Check.
-func (r *Registry) Create(urn resource.URN, news resource.PropertyMap, timeout float64,
+func (r *Registry) Create(ctx context.Context, urn resource.URN, news resource.PropertyMap, timeout float64,
 	preview bool) (resource.ID, resource.PropertyMap, resource.Status, error) {
 
 	label := fmt.Sprintf("%s.Create(%s)", r.label(), urn)
@@ -374,7 +729,13 @@ func (r *Registry) Create(urn resource.URN, news resource.PropertyMap, timeout f
 	provider, ok := r.GetProvider(mustNewReference(urn, UnknownID))
 	contract.Assertf(ok, "'Check' must be called before 'Create' (%v)", urn)
 
-	if err := provider.Configure(news); err != nil {
+	if err := provider.Configure(ctx, providerConfig(urn, news)); err != nil {
+		return "", nil, resource.StatusOK, err
+	}
+	if err := parameterizeProvider(ctx, urn, provider, news); err != nil {
+		return "", nil, resource.StatusOK, err
+	}
+	if err := validateProvider(ctx, urn, provider); err != nil {
 		return "", nil, resource.StatusOK, err
 	}
 
@@ -389,7 +750,7 @@ func (r *Registry) Create(urn resource.URN, news resource.PropertyMap, timeout f
 		contract.Assert(id != UnknownID)
 	}
 
-	r.setProvider(mustNewReference(urn, id), provider)
+	r.publishProvider(mustNewReference(urn, id), provider, news, nil)
 	return id, news, resource.StatusOK, nil
 }
 
@@ -397,8 +758,8 @@ func (r *Registry) Create(urn resource.URN, news resource.PropertyMap, timeout f
 // reference indicated by the (URN, ID) pair.
 //
 // THe provider must have been loaded by a prior call to Check.
-func (r *Registry) Update(urn resource.URN, id resource.ID, olds, news resource.PropertyMap, timeout float64,
-	ignoreChanges []string, preview bool) (resource.PropertyMap, resource.Status, error) {
+func (r *Registry) Update(ctx context.Context, urn resource.URN, id resource.ID, olds, news resource.PropertyMap,
+	timeout float64, ignoreChanges []string, preview bool) (resource.PropertyMap, resource.Status, error) {
 
 	label := fmt.Sprintf("%s.Update(%s,%s)", r.label(), id, urn)
 	logging.V(7).Infof("%s executing (#olds=%v,#news=%v)", label, len(olds), len(news))
@@ -407,41 +768,91 @@ func (r *Registry) Update(urn resource.URN, id resource.ID, olds, news resource.
 	provider, ok := r.GetProvider(mustNewReference(urn, UnknownID))
 	contract.Assertf(ok, "'Check' and 'Diff' must be called before 'Update' (%v)", urn)
 
-	if err := provider.Configure(news); err != nil {
+	if err := provider.Configure(ctx, providerConfig(urn, news)); err != nil {
+		return nil, resource.StatusUnknown, err
+	}
+	if err := parameterizeProvider(ctx, urn, provider, news); err != nil {
+		return nil, resource.StatusUnknown, err
+	}
+	if err := validateProvider(ctx, urn, provider); err != nil {
 		return nil, resource.StatusUnknown, err
 	}
 
 	// Publish the configured provider.
-	r.setProvider(mustNewReference(urn, id), provider)
+	r.publishProvider(mustNewReference(urn, id), provider, news, nil)
 	return news, resource.StatusOK, nil
 }
 
 // Delete unregisters and unloads the provider with the given URN and ID. The provider must have been loaded when the
 // registry was created (i.e. it must have been present in the state handed to NewRegistry).
-func (r *Registry) Delete(urn resource.URN, id resource.ID, props resource.PropertyMap,
+func (r *Registry) Delete(ctx context.Context, urn resource.URN, id resource.ID, props resource.PropertyMap,
 	timeout float64) (resource.Status, error) {
 	contract.Assert(!r.isPreview)
 
 	ref := mustNewReference(urn, id)
 	provider, has := r.deleteProvider(ref)
 	contract.Assert(has)
+	r.unwatchConfig(ref)
 
 	closeErr := r.host.CloseProvider(provider)
 	contract.IgnoreError(closeErr)
 	return resource.StatusOK, nil
 }
 
-func (r *Registry) Read(urn resource.URN, id resource.ID,
+func (r *Registry) StreamCreate(ctx context.Context, urn resource.URN, news resource.PropertyMap, timeout float64,
+	preview bool, onNext func(resource.PropertyMap) error) (resource.ID, resource.PropertyMap, resource.Status, error) {
+	return plugin.SingleStreamCreate(ctx, r, urn, news, timeout, preview, onNext)
+}
+
+func (r *Registry) WaitForResourceReady(ctx context.Context, urn resource.URN, id resource.ID,
+	timeout float64) error {
+	return plugin.ErrNotYetImplemented
+}
+
+func (r *Registry) Read(ctx context.Context, urn resource.URN, id resource.ID,
+	inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error) {
+	return plugin.ReadResult{}, resource.StatusUnknown, errors.New("provider resources may not be read")
+}
+
+func (r *Registry) PrepareImport(ctx context.Context, urn resource.URN,
+	id resource.ID) (resource.PropertyMap, error) {
+	return nil, plugin.ErrNotYetImplemented
+}
+
+func (r *Registry) Refresh(ctx context.Context, urn resource.URN, id resource.ID,
 	inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error) {
 	return plugin.ReadResult{}, resource.StatusUnknown, errors.New("provider resources may not be read")
 }
 
-func (r *Registry) Construct(info plugin.ConstructInfo, typ tokens.Type, name tokens.QName, parent resource.URN,
-	inputs resource.PropertyMap, options plugin.ConstructOptions) (plugin.ConstructResult, error) {
+func (r *Registry) SchemaVersion(ctx context.Context) (int, error) {
+	return 0, nil
+}
+
+func (r *Registry) MigrateState(ctx context.Context, urn resource.URN, stateVersion int,
+	state resource.PropertyMap) (resource.PropertyMap, error) {
+	return nil, plugin.ErrNotYetImplemented
+}
+
+func (r *Registry) GetResourceAliases(ctx context.Context, urn resource.URN) ([]resource.Alias, error) {
+	return nil, plugin.ErrNotYetImplemented
+}
+
+func (r *Registry) ReadStream(ctx context.Context, urn resource.URN, id resource.ID,
+	inputs, state resource.PropertyMap, onNext func(plugin.ReadResult) error) (resource.Status, error) {
+	return resource.StatusUnknown, errors.New("provider resources may not be read")
+}
+
+func (r *Registry) BatchRead(ctx context.Context,
+	requests []plugin.BatchReadRequest) ([]plugin.BatchReadResponse, error) {
+	return nil, errors.New("provider resources may not be read")
+}
+
+func (r *Registry) Construct(ctx context.Context, info plugin.ConstructInfo, typ tokens.Type, name tokens.QName,
+	parent resource.URN, inputs resource.PropertyMap, options plugin.ConstructOptions) (plugin.ConstructResult, error) {
 	return plugin.ConstructResult{}, errors.New("provider resources may not be constructed")
 }
 
-func (r *Registry) Invoke(tok tokens.ModuleMember,
+func (r *Registry) Invoke(ctx context.Context, tok tokens.ModuleMember,
 	args resource.PropertyMap) (resource.PropertyMap, []plugin.CheckFailure, error) {
 
 	// It is the responsibility of the eval source to ensure that we never attempt an invoke using the provider
@@ -451,13 +862,14 @@ func (r *Registry) Invoke(tok tokens.ModuleMember,
 }
 
 func (r *Registry) StreamInvoke(
+	ctx context.Context,
 	tok tokens.ModuleMember, args resource.PropertyMap,
-	onNext func(resource.PropertyMap) error) ([]plugin.CheckFailure, error) {
+	onNext func(plugin.StreamInvokeEvent) error) ([]plugin.CheckFailure, error) {
 
 	return nil, fmt.Errorf("the provider registry does not implement streaming invokes")
 }
 
-func (r *Registry) Call(tok tokens.ModuleMember, args resource.PropertyMap, info plugin.CallInfo,
+func (r *Registry) Call(ctx context.Context, tok tokens.ModuleMember, args resource.PropertyMap, info plugin.CallInfo,
 	options plugin.CallOptions) (plugin.CallResult, error) {
 
 	// It is the responsibility of the eval source to ensure that we never attempt an call using the provider
@@ -466,12 +878,34 @@ func (r *Registry) Call(tok tokens.ModuleMember, args resource.PropertyMap, info
 	return plugin.CallResult{}, errors.New("the provider registry is not callable")
 }
 
-func (r *Registry) GetPluginInfo() (workspace.PluginInfo, error) {
+func (r *Registry) GetPluginInfo(ctx context.Context) (workspace.PluginInfo, error) {
 	// return an error: this should not be called for the provider registry
 	return workspace.PluginInfo{}, errors.New("the provider registry does not report plugin info")
 }
 
-func (r *Registry) SignalCancellation() error {
+func (r *Registry) GetMapping(ctx context.Context, key string) ([]byte, string, error) {
+	// return an error: this should not be called for the provider registry
+	return nil, "", errors.New("the provider registry does not report mappings")
+}
+
+func (r *Registry) SupportsFeature(ctx context.Context, feature string) (bool, error) {
+	return false, nil
+}
+
+func (r *Registry) RegisterEventEmitter(e plugin.EventEmitter) error {
+	return plugin.ErrNotYetImplemented
+}
+
+func (r *Registry) WatchConfig(ctx context.Context, onChange func(resource.PropertyMap)) error {
+	return plugin.ErrNotYetImplemented
+}
+
+func (r *Registry) EstimateCost(ctx context.Context, urn resource.URN,
+	news resource.PropertyMap) (plugin.CostEstimate, error) {
+	return plugin.CostEstimate{}, plugin.ErrNotYetImplemented
+}
+
+func (r *Registry) SignalCancellation(ctx context.Context) error {
 	// At the moment there isn't anything reasonable we can do here. In the future, it might be nice to plumb
 	// cancellation through the plugin loader and cancel any outstanding load requests here.
 	return nil
@@ -15,6 +15,7 @@
 package providers
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -22,9 +23,11 @@ import (
 	"github.com/blang/semver"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
@@ -68,6 +71,9 @@ func (host *testPluginHost) Provider(pkg tokens.Package, version *semver.Version
 func (host *testPluginHost) CloseProvider(provider plugin.Provider) error {
 	return host.closeProvider(provider)
 }
+func (host *testPluginHost) RegisterFactory(pkg tokens.Package, version string, f plugin.ProviderFactoryFunc) error {
+	return errors.New("unsupported")
+}
 func (host *testPluginHost) LanguageRuntime(runtime string) (plugin.LanguageRuntime, error) {
 	return nil, errors.New("unsupported")
 }
@@ -99,9 +105,18 @@ type testProvider struct {
 		resource.PropertyMap, bool) (resource.PropertyMap, []plugin.CheckFailure, error)
 	diffConfig func(resource.URN, resource.PropertyMap, resource.PropertyMap, bool, []string) (plugin.DiffResult, error)
 	config     func(resource.PropertyMap) error
+	validate   func() error
+
+	configChecksumMatch func(resource.URN, string) (bool, error)
+	watchConfig         func(context.Context, func(resource.PropertyMap)) error
+
+	// parameterization records the parameterization that the provider received, if any.
+	parameterization *Parameterization
+	// secretKeys records the secret configuration keys that the provider was configured with.
+	secretKeys []config.Key
 }
 
-func (prov *testProvider) SignalCancellation() error {
+func (prov *testProvider) SignalCancellation(ctx context.Context) error {
 	return nil
 }
 func (prov *testProvider) Close() error {
@@ -110,68 +125,156 @@ func (prov *testProvider) Close() error {
 func (prov *testProvider) Pkg() tokens.Package {
 	return prov.pkg
 }
-func (prov *testProvider) GetSchema(version int) ([]byte, error) {
-	return []byte("{}"), nil
+func (prov *testProvider) GetSchema(ctx context.Context, version int) (plugin.GetSchemaResponse, error) {
+	return plugin.NewGetSchemaResponse([]byte("{}")), nil
+}
+func (prov *testProvider) GetSupportedVersions(ctx context.Context) ([]int, error) {
+	return nil, errors.New("unsupported")
 }
-func (prov *testProvider) CheckConfig(urn resource.URN, olds,
+func (prov *testProvider) CheckConfig(ctx context.Context, urn resource.URN, olds,
 	news resource.PropertyMap, allowUnknowns bool) (resource.PropertyMap, []plugin.CheckFailure, error) {
 	return prov.checkConfig(urn, olds, news, allowUnknowns)
 }
-func (prov *testProvider) DiffConfig(urn resource.URN, olds, news resource.PropertyMap,
+func (prov *testProvider) ConfigChecksumMatch(ctx context.Context, urn resource.URN,
+	checksum string) (bool, error) {
+	if prov.configChecksumMatch == nil {
+		return false, plugin.ErrNotYetImplemented
+	}
+	return prov.configChecksumMatch(urn, checksum)
+}
+func (prov *testProvider) DiffConfig(ctx context.Context, urn resource.URN, olds, news resource.PropertyMap,
 	allowUnknowns bool, ignoreChanges []string) (plugin.DiffResult, error) {
 	return prov.diffConfig(urn, olds, news, allowUnknowns, ignoreChanges)
 }
-func (prov *testProvider) Configure(inputs resource.PropertyMap) error {
-	if err := prov.config(inputs); err != nil {
+func (prov *testProvider) Validate(ctx context.Context) error {
+	if prov.validate == nil {
+		return nil
+	}
+	return prov.validate()
+}
+func (prov *testProvider) ParameterizeByValue(ctx context.Context,
+	value []byte) (plugin.ParameterizeResult, error) {
+	if !prov.configured {
+		return plugin.ParameterizeResult{}, errors.New("not configured")
+	}
+	prov.parameterization = &Parameterization{Value: value}
+	return plugin.ParameterizeResult{Name: string(value)}, nil
+}
+func (prov *testProvider) ParameterizeByReference(ctx context.Context,
+	ref plugin.ParameterizeReference) (plugin.ParameterizeResult, error) {
+	if !prov.configured {
+		return plugin.ParameterizeResult{}, errors.New("not configured")
+	}
+	prov.parameterization = &Parameterization{Reference: &ref}
+	return plugin.ParameterizeResult{Name: ref.Name, Version: ref.Version}, nil
+}
+func (prov *testProvider) Configure(ctx context.Context, cfg plugin.ProviderConfig) error {
+	if err := prov.config(cfg.PropertyMap); err != nil {
 		return err
 	}
 	prov.configured = true
+	prov.secretKeys = cfg.SecretKeys()
 	return nil
 }
-func (prov *testProvider) Check(urn resource.URN,
+func (prov *testProvider) Check(ctx context.Context, urn resource.URN,
 	olds, news resource.PropertyMap, _ bool, _ []byte) (resource.PropertyMap, []plugin.CheckFailure, error) {
 	return nil, nil, errors.New("unsupported")
 }
-func (prov *testProvider) Create(urn resource.URN, props resource.PropertyMap, timeout float64,
+func (prov *testProvider) Create(ctx context.Context, urn resource.URN, props resource.PropertyMap, timeout float64,
 	preview bool) (resource.ID, resource.PropertyMap, resource.Status, error) {
 	return "", nil, resource.StatusOK, errors.New("unsupported")
 }
-func (prov *testProvider) Read(urn resource.URN, id resource.ID,
+func (prov *testProvider) StreamCreate(ctx context.Context, urn resource.URN, props resource.PropertyMap,
+	timeout float64, preview bool,
+	onNext func(resource.PropertyMap) error) (resource.ID, resource.PropertyMap, resource.Status, error) {
+	return "", nil, resource.StatusOK, errors.New("unsupported")
+}
+func (prov *testProvider) WaitForResourceReady(ctx context.Context, urn resource.URN, id resource.ID,
+	timeout float64) error {
+	return plugin.ErrNotYetImplemented
+}
+func (prov *testProvider) Read(ctx context.Context, urn resource.URN, id resource.ID,
 	inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error) {
 	return plugin.ReadResult{}, resource.StatusUnknown, errors.New("unsupported")
 }
-func (prov *testProvider) Diff(urn resource.URN, id resource.ID,
+func (prov *testProvider) PrepareImport(ctx context.Context, urn resource.URN,
+	id resource.ID) (resource.PropertyMap, error) {
+	return nil, errors.New("unsupported")
+}
+func (prov *testProvider) Refresh(ctx context.Context, urn resource.URN, id resource.ID,
+	inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error) {
+	return plugin.ReadResult{}, resource.StatusUnknown, errors.New("unsupported")
+}
+func (prov *testProvider) SchemaVersion(ctx context.Context) (int, error) {
+	return 0, nil
+}
+func (prov *testProvider) MigrateState(ctx context.Context, urn resource.URN, stateVersion int,
+	state resource.PropertyMap) (resource.PropertyMap, error) {
+	return nil, errors.New("unsupported")
+}
+func (prov *testProvider) GetResourceAliases(ctx context.Context, urn resource.URN) ([]resource.Alias, error) {
+	return nil, errors.New("unsupported")
+}
+func (prov *testProvider) ReadStream(ctx context.Context, urn resource.URN, id resource.ID,
+	inputs, state resource.PropertyMap, onNext func(plugin.ReadResult) error) (resource.Status, error) {
+	return resource.StatusUnknown, errors.New("unsupported")
+}
+func (prov *testProvider) BatchRead(ctx context.Context,
+	requests []plugin.BatchReadRequest) ([]plugin.BatchReadResponse, error) {
+	return nil, errors.New("unsupported")
+}
+func (prov *testProvider) Diff(ctx context.Context, urn resource.URN, id resource.ID,
 	olds resource.PropertyMap, news resource.PropertyMap, _ bool, _ []string) (plugin.DiffResult, error) {
 	return plugin.DiffResult{}, errors.New("unsupported")
 }
-func (prov *testProvider) Update(urn resource.URN, id resource.ID,
+func (prov *testProvider) Update(ctx context.Context, urn resource.URN, id resource.ID,
 	olds resource.PropertyMap, news resource.PropertyMap, timeout float64,
 	ignoreChanges []string, preview bool) (resource.PropertyMap, resource.Status, error) {
 	return nil, resource.StatusOK, errors.New("unsupported")
 }
-func (prov *testProvider) Delete(urn resource.URN,
+func (prov *testProvider) Delete(ctx context.Context, urn resource.URN,
 	id resource.ID, props resource.PropertyMap, timeout float64) (resource.Status, error) {
 	return resource.StatusOK, errors.New("unsupported")
 }
-func (prov *testProvider) Construct(info plugin.ConstructInfo, typ tokens.Type, name tokens.QName, parent resource.URN,
-	inputs resource.PropertyMap, options plugin.ConstructOptions) (plugin.ConstructResult, error) {
+func (prov *testProvider) Construct(ctx context.Context, info plugin.ConstructInfo, typ tokens.Type, name tokens.QName,
+	parent resource.URN, inputs resource.PropertyMap, options plugin.ConstructOptions) (plugin.ConstructResult, error) {
 	return plugin.ConstructResult{}, errors.New("unsupported")
 }
-func (prov *testProvider) Invoke(tok tokens.ModuleMember,
+func (prov *testProvider) Invoke(ctx context.Context, tok tokens.ModuleMember,
 	args resource.PropertyMap) (resource.PropertyMap, []plugin.CheckFailure, error) {
 	return nil, nil, errors.New("unsupported")
 }
 func (prov *testProvider) StreamInvoke(
+	ctx context.Context,
 	tok tokens.ModuleMember, args resource.PropertyMap,
-	onNext func(resource.PropertyMap) error) ([]plugin.CheckFailure, error) {
+	onNext func(plugin.StreamInvokeEvent) error) ([]plugin.CheckFailure, error) {
 
 	return nil, fmt.Errorf("not implemented")
 }
-func (prov *testProvider) Call(tok tokens.ModuleMember, args resource.PropertyMap, info plugin.CallInfo,
-	options plugin.CallOptions) (plugin.CallResult, error) {
+func (prov *testProvider) Call(ctx context.Context, tok tokens.ModuleMember, args resource.PropertyMap,
+	info plugin.CallInfo, options plugin.CallOptions) (plugin.CallResult, error) {
 	return plugin.CallResult{}, errors.New("unsupported")
 }
-func (prov *testProvider) GetPluginInfo() (workspace.PluginInfo, error) {
+func (prov *testProvider) GetMapping(ctx context.Context, key string) ([]byte, string, error) {
+	return nil, "", nil
+}
+func (prov *testProvider) SupportsFeature(ctx context.Context, feature string) (bool, error) {
+	return false, nil
+}
+func (prov *testProvider) RegisterEventEmitter(e plugin.EventEmitter) error {
+	return errors.New("unsupported")
+}
+func (prov *testProvider) WatchConfig(ctx context.Context, onChange func(resource.PropertyMap)) error {
+	if prov.watchConfig == nil {
+		return plugin.ErrNotYetImplemented
+	}
+	return prov.watchConfig(ctx, onChange)
+}
+func (prov *testProvider) EstimateCost(ctx context.Context, urn resource.URN,
+	news resource.PropertyMap) (plugin.CostEstimate, error) {
+	return plugin.CostEstimate{}, errors.New("unsupported")
+}
+func (prov *testProvider) GetPluginInfo(ctx context.Context) (workspace.PluginInfo, error) {
 	return workspace.PluginInfo{
 		Name:    "testProvider",
 		Version: &prov.version,
@@ -272,11 +375,11 @@ func newProviderState(pkg, name, id string, delete bool, inputs resource.Propert
 func TestNewRegistryNoOldState(t *testing.T) {
 	t.Parallel()
 
-	r, err := NewRegistry(&testPluginHost{}, nil, false, nil)
+	r, err := NewRegistry(context.Background(), &testPluginHost{}, nil, false, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, r)
 
-	r, err = NewRegistry(&testPluginHost{}, nil, true, nil)
+	r, err = NewRegistry(context.Background(), &testPluginHost{}, nil, true, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, r)
 }
@@ -307,7 +410,7 @@ func TestNewRegistryOldState(t *testing.T) {
 	}
 	host := newPluginHost(t, loaders)
 
-	r, err := NewRegistry(host, olds, false, nil)
+	r, err := NewRegistry(context.Background(), host, olds, false, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, r)
 
@@ -328,7 +431,7 @@ func TestNewRegistryOldState(t *testing.T) {
 		ver, err := GetProviderVersion(old.Inputs)
 		assert.NoError(t, err)
 		if ver != nil {
-			info, err := p.GetPluginInfo()
+			info, err := p.GetPluginInfo(context.Background())
 			assert.NoError(t, err)
 			assert.True(t, info.Version.GTE(*ver))
 		}
@@ -343,7 +446,7 @@ func TestNewRegistryOldStateNoProviders(t *testing.T) {
 	}
 	host := newPluginHost(t, []*providerLoader{})
 
-	r, err := NewRegistry(host, olds, false, nil)
+	r, err := NewRegistry(context.Background(), host, olds, false, nil)
 	assert.Error(t, err)
 	assert.Nil(t, r)
 }
@@ -359,7 +462,7 @@ func TestNewRegistryOldStateWrongPackage(t *testing.T) {
 	}
 	host := newPluginHost(t, loaders)
 
-	r, err := NewRegistry(host, olds, false, nil)
+	r, err := NewRegistry(context.Background(), host, olds, false, nil)
 	assert.Error(t, err)
 	assert.Nil(t, r)
 }
@@ -377,7 +480,7 @@ func TestNewRegistryOldStateWrongVersion(t *testing.T) {
 	}
 	host := newPluginHost(t, loaders)
 
-	r, err := NewRegistry(host, olds, false, nil)
+	r, err := NewRegistry(context.Background(), host, olds, false, nil)
 	assert.Error(t, err)
 	assert.Nil(t, r)
 }
@@ -393,7 +496,7 @@ func TestNewRegistryOldStateNoID(t *testing.T) {
 	}
 	host := newPluginHost(t, loaders)
 
-	r, err := NewRegistry(host, olds, false, nil)
+	r, err := NewRegistry(context.Background(), host, olds, false, nil)
 	assert.Error(t, err)
 	assert.Nil(t, r)
 }
@@ -409,7 +512,7 @@ func TestNewRegistryOldStateUnknownID(t *testing.T) {
 	}
 	host := newPluginHost(t, loaders)
 
-	r, err := NewRegistry(host, olds, false, nil)
+	r, err := NewRegistry(context.Background(), host, olds, false, nil)
 	assert.Error(t, err)
 	assert.Nil(t, r)
 }
@@ -426,11 +529,38 @@ func TestNewRegistryOldStateDuplicates(t *testing.T) {
 	}
 	host := newPluginHost(t, loaders)
 
-	r, err := NewRegistry(host, olds, false, nil)
+	r, err := NewRegistry(context.Background(), host, olds, false, nil)
 	assert.Error(t, err)
 	assert.Nil(t, r)
 }
 
+func TestNewRegistryOldStateValidationFailure(t *testing.T) {
+	t.Parallel()
+
+	olds := []*resource.State{
+		newProviderState("pkgA", "a", "id1", false, nil),
+	}
+	loaders := []*providerLoader{
+		newLoader(t, "pkgA", "", func(pkg tokens.Package, ver semver.Version) (plugin.Provider, error) {
+			return &testProvider{
+				pkg:     pkg,
+				version: ver,
+				config: func(resource.PropertyMap) error {
+					return nil
+				},
+				validate: func() error {
+					return errors.New("invalid credentials")
+				},
+			}, nil
+		}),
+	}
+	host := newPluginHost(t, loaders)
+
+	r, err := NewRegistry(context.Background(), host, olds, false, nil)
+	assert.ErrorContains(t, err, "failed validation: invalid credentials")
+	assert.Nil(t, r)
+}
+
 func TestCRUD(t *testing.T) {
 	t.Parallel()
 
@@ -446,7 +576,7 @@ func TestCRUD(t *testing.T) {
 	}
 	host := newPluginHost(t, loaders)
 
-	r, err := NewRegistry(host, olds, false, nil)
+	r, err := NewRegistry(context.Background(), host, olds, false, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, r)
 
@@ -471,7 +601,7 @@ func TestCRUD(t *testing.T) {
 		timeout := float64(120)
 
 		// Check
-		inputs, failures, err := r.Check(urn, olds, news, false, nil)
+		inputs, failures, err := r.Check(context.Background(), urn, olds, news, false, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, news, inputs)
 		assert.Empty(t, failures)
@@ -482,7 +612,7 @@ func TestCRUD(t *testing.T) {
 		assert.False(t, p.(*testProvider).configured)
 
 		// Create
-		id, outs, status, err := r.Create(urn, inputs, timeout, false)
+		id, outs, status, err := r.Create(context.Background(), urn, inputs, timeout, false)
 		assert.NoError(t, err)
 		assert.NotEqual(t, "", id)
 		assert.NotEqual(t, UnknownID, id)
@@ -506,7 +636,7 @@ func TestCRUD(t *testing.T) {
 		assert.True(t, ok)
 
 		// Check
-		inputs, failures, err := r.Check(urn, olds, news, false, nil)
+		inputs, failures, err := r.Check(context.Background(), urn, olds, news, false, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, news, inputs)
 		assert.Empty(t, failures)
@@ -518,7 +648,7 @@ func TestCRUD(t *testing.T) {
 		assert.False(t, p.(*testProvider).configured)
 
 		// Diff
-		diff, err := r.Diff(urn, id, olds, news, false, nil)
+		diff, err := r.Diff(context.Background(), urn, id, olds, news, false, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, plugin.DiffResult{Changes: plugin.DiffNone}, diff)
 
@@ -528,7 +658,7 @@ func TestCRUD(t *testing.T) {
 		assert.Equal(t, old, p2)
 
 		// Update
-		outs, status, err := r.Update(urn, id, olds, inputs, timeout, nil, false)
+		outs, status, err := r.Update(context.Background(), urn, id, olds, inputs, timeout, nil, false)
 		assert.NoError(t, err)
 		assert.Equal(t, resource.PropertyMap{}, outs)
 		assert.Equal(t, resource.StatusOK, status)
@@ -549,7 +679,7 @@ func TestCRUD(t *testing.T) {
 		assert.True(t, ok)
 
 		// Delete
-		status, err := r.Delete(urn, id, resource.PropertyMap{}, timeout)
+		status, err := r.Delete(context.Background(), urn, id, resource.PropertyMap{}, timeout)
 		assert.NoError(t, err)
 		assert.Equal(t, resource.StatusOK, status)
 
@@ -592,7 +722,7 @@ func TestCRUDPreview(t *testing.T) {
 	}
 	host := newPluginHost(t, loaders)
 
-	r, err := NewRegistry(host, olds, true, nil)
+	r, err := NewRegistry(context.Background(), host, olds, true, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, r)
 
@@ -616,7 +746,7 @@ func TestCRUDPreview(t *testing.T) {
 		olds, news := resource.PropertyMap{}, resource.PropertyMap{}
 
 		// Check
-		inputs, failures, err := r.Check(urn, olds, news, false, nil)
+		inputs, failures, err := r.Check(context.Background(), urn, olds, news, false, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, news, inputs)
 		assert.Empty(t, failures)
@@ -637,7 +767,7 @@ func TestCRUDPreview(t *testing.T) {
 		assert.True(t, ok)
 
 		// Check
-		inputs, failures, err := r.Check(urn, olds, news, false, nil)
+		inputs, failures, err := r.Check(context.Background(), urn, olds, news, false, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, news, inputs)
 		assert.Empty(t, failures)
@@ -649,7 +779,7 @@ func TestCRUDPreview(t *testing.T) {
 		assert.False(t, p.(*testProvider).configured)
 
 		// Diff
-		diff, err := r.Diff(urn, id, olds, news, false, nil)
+		diff, err := r.Diff(context.Background(), urn, id, olds, news, false, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, plugin.DiffResult{Changes: plugin.DiffNone}, diff)
 
@@ -670,7 +800,7 @@ func TestCRUDPreview(t *testing.T) {
 		assert.True(t, ok)
 
 		// Check
-		inputs, failures, err := r.Check(urn, olds, news, false, nil)
+		inputs, failures, err := r.Check(context.Background(), urn, olds, news, false, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, news, inputs)
 		assert.Empty(t, failures)
@@ -682,9 +812,9 @@ func TestCRUDPreview(t *testing.T) {
 		assert.False(t, p.(*testProvider).configured)
 
 		// Diff
-		diff, err := r.Diff(urn, id, olds, news, false, nil)
+		diff, err := r.Diff(context.Background(), urn, id, olds, news, false, nil)
 		assert.NoError(t, err)
-		assert.True(t, diff.Replace())
+		assert.True(t, diff.RequiresReplacement())
 
 		// The new provider should be not be registered; the registered provider should still be the original.
 		p2, ok := r.GetProvider(Reference{urn: urn, id: id})
@@ -699,7 +829,7 @@ func TestCRUDNoProviders(t *testing.T) {
 
 	host := newPluginHost(t, []*providerLoader{})
 
-	r, err := NewRegistry(host, []*resource.State{}, false, nil)
+	r, err := NewRegistry(context.Background(), host, []*resource.State{}, false, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, r)
 
@@ -708,7 +838,7 @@ func TestCRUDNoProviders(t *testing.T) {
 	olds, news := resource.PropertyMap{}, resource.PropertyMap{}
 
 	// Check
-	inputs, failures, err := r.Check(urn, olds, news, false, nil)
+	inputs, failures, err := r.Check(context.Background(), urn, olds, news, false, nil)
 	assert.Error(t, err)
 	assert.Empty(t, failures)
 	assert.Nil(t, inputs)
@@ -722,7 +852,7 @@ func TestCRUDWrongPackage(t *testing.T) {
 	}
 	host := newPluginHost(t, loaders)
 
-	r, err := NewRegistry(host, []*resource.State{}, false, nil)
+	r, err := NewRegistry(context.Background(), host, []*resource.State{}, false, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, r)
 
@@ -731,7 +861,7 @@ func TestCRUDWrongPackage(t *testing.T) {
 	olds, news := resource.PropertyMap{}, resource.PropertyMap{}
 
 	// Check
-	inputs, failures, err := r.Check(urn, olds, news, false, nil)
+	inputs, failures, err := r.Check(context.Background(), urn, olds, news, false, nil)
 	assert.Error(t, err)
 	assert.Empty(t, failures)
 	assert.Nil(t, inputs)
@@ -745,7 +875,7 @@ func TestCRUDWrongVersion(t *testing.T) {
 	}
 	host := newPluginHost(t, loaders)
 
-	r, err := NewRegistry(host, []*resource.State{}, false, nil)
+	r, err := NewRegistry(context.Background(), host, []*resource.State{}, false, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, r)
 
@@ -754,7 +884,7 @@ func TestCRUDWrongVersion(t *testing.T) {
 	olds, news := resource.PropertyMap{}, resource.PropertyMap{"version": resource.NewStringProperty("1.0.0")}
 
 	// Check
-	inputs, failures, err := r.Check(urn, olds, news, false, nil)
+	inputs, failures, err := r.Check(context.Background(), urn, olds, news, false, nil)
 	assert.Error(t, err)
 	assert.Empty(t, failures)
 	assert.Nil(t, inputs)
@@ -768,7 +898,7 @@ func TestCRUDBadVersionNotString(t *testing.T) {
 	}
 	host := newPluginHost(t, loaders)
 
-	r, err := NewRegistry(host, []*resource.State{}, false, nil)
+	r, err := NewRegistry(context.Background(), host, []*resource.State{}, false, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, r)
 
@@ -777,7 +907,7 @@ func TestCRUDBadVersionNotString(t *testing.T) {
 	olds, news := resource.PropertyMap{}, resource.PropertyMap{"version": resource.NewBoolProperty(true)}
 
 	// Check
-	inputs, failures, err := r.Check(urn, olds, news, false, nil)
+	inputs, failures, err := r.Check(context.Background(), urn, olds, news, false, nil)
 	assert.NoError(t, err)
 	assert.Len(t, failures, 1)
 	assert.Equal(t, "version", string(failures[0].Property))
@@ -792,7 +922,7 @@ func TestCRUDBadVersion(t *testing.T) {
 	}
 	host := newPluginHost(t, loaders)
 
-	r, err := NewRegistry(host, []*resource.State{}, false, nil)
+	r, err := NewRegistry(context.Background(), host, []*resource.State{}, false, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, r)
 
@@ -801,9 +931,242 @@ func TestCRUDBadVersion(t *testing.T) {
 	olds, news := resource.PropertyMap{}, resource.PropertyMap{"version": resource.NewStringProperty("foo")}
 
 	// Check
-	inputs, failures, err := r.Check(urn, olds, news, false, nil)
+	inputs, failures, err := r.Check(context.Background(), urn, olds, news, false, nil)
 	assert.NoError(t, err)
 	assert.Len(t, failures, 1)
 	assert.Equal(t, "version", string(failures[0].Property))
 	assert.Nil(t, inputs)
 }
+
+func TestNewRegistryOldStateParameterized(t *testing.T) {
+	t.Parallel()
+
+	inputs := resource.PropertyMap{}
+	SetProviderParameterization(inputs, Parameterization{Value: []byte("sub")})
+	olds := []*resource.State{
+		newProviderState("pkgA", "a", "id1", false, inputs),
+	}
+	host := newPluginHost(t, []*providerLoader{newSimpleLoader(t, "pkgA", "", nil)})
+
+	r, err := NewRegistry(context.Background(), host, olds, false, nil)
+	require.NoError(t, err)
+
+	p, ok := r.GetProvider(Reference{urn: olds[0].URN, id: olds[0].ID})
+	require.True(t, ok)
+	assert.Equal(t, &Parameterization{Value: []byte("sub")}, p.(*testProvider).parameterization)
+}
+
+func TestCRUDParameterized(t *testing.T) {
+	t.Parallel()
+
+	host := newPluginHost(t, []*providerLoader{newSimpleLoader(t, "pkgA", "", nil)})
+	r, err := NewRegistry(context.Background(), host, []*resource.State{}, false, nil)
+	require.NoError(t, err)
+
+	typ := MakeProviderType("pkgA")
+	urn := resource.NewURN("test", "test", "", typ, "b")
+	version := semver.MustParse("1.2.3")
+	ref := &plugin.ParameterizeReference{Name: "sub", Version: &version}
+	news := resource.PropertyMap{}
+	SetProviderParameterization(news, Parameterization{Reference: ref})
+
+	// Check
+	inputs, failures, err := r.Check(context.Background(), urn, resource.PropertyMap{}, news, false, nil)
+	require.NoError(t, err)
+	assert.Empty(t, failures)
+
+	// Create parameterizes the provider after configuring it.
+	id, _, _, err := r.Create(context.Background(), urn, inputs, 0, false)
+	require.NoError(t, err)
+
+	p, ok := r.GetProvider(Reference{urn: urn, id: id})
+	require.True(t, ok)
+	assert.Equal(t, &Parameterization{Reference: ref}, p.(*testProvider).parameterization)
+
+	// A malformed parameterization fails the check.
+	news = resource.PropertyMap{"parameterization": resource.NewStringProperty("sub")}
+	inputs, failures, err = r.Check(context.Background(), urn, resource.PropertyMap{}, news, false, nil)
+	assert.NoError(t, err)
+	assert.Len(t, failures, 1)
+	assert.Equal(t, "parameterization", string(failures[0].Property))
+	assert.Nil(t, inputs)
+}
+
+func TestCheckConfigChecksumMatch(t *testing.T) {
+	t.Parallel()
+
+	validated := resource.PropertyMap{"region": resource.NewStringProperty("us-west-2")}
+
+	// Each provider caches the checksum of the config that it was configured with.
+	checkConfigCalls := 0
+	loader := newLoader(t, "pkgA", "", func(pkg tokens.Package, ver semver.Version) (plugin.Provider, error) {
+		var configuredChecksum string
+		return &testProvider{
+			pkg:     pkg,
+			version: ver,
+			checkConfig: func(urn resource.URN, olds,
+				news resource.PropertyMap, allowUnknowns bool) (resource.PropertyMap, []plugin.CheckFailure, error) {
+				checkConfigCalls++
+				return news, nil, nil
+			},
+			config: func(inputs resource.PropertyMap) error {
+				checksum, err := plugin.ConfigChecksum(inputs)
+				configuredChecksum = checksum
+				return err
+			},
+			configChecksumMatch: func(urn resource.URN, checksum string) (bool, error) {
+				return configuredChecksum != "" && checksum == configuredChecksum, nil
+			},
+		}, nil
+	})
+	olds := []*resource.State{newProviderState("pkgA", "a", "id1", false, validated)}
+	r, err := NewRegistry(context.Background(), newPluginHost(t, []*providerLoader{loader}), olds, false, nil)
+	require.NoError(t, err)
+
+	urn := olds[0].URN
+
+	// The config of an existing provider that matches the checksum of the config that it was configured with skips
+	// CheckConfig and is returned as is.
+	inputs, failures, err := r.Check(context.Background(), urn, validated, validated, false, nil)
+	require.NoError(t, err)
+	assert.Empty(t, failures)
+	assert.Equal(t, validated, inputs)
+	assert.Equal(t, 0, checkConfigCalls)
+
+	_, ok := r.GetProvider(Reference{urn: urn, id: UnknownID})
+	assert.True(t, ok)
+
+	// Any other config is checked as usual.
+	news := resource.PropertyMap{"region": resource.NewStringProperty("us-east-1")}
+	inputs, failures, err = r.Check(context.Background(), urn, validated, news, false, nil)
+	require.NoError(t, err)
+	assert.Empty(t, failures)
+	assert.Equal(t, news, inputs)
+	assert.Equal(t, 1, checkConfigCalls)
+
+	// So is the config of a new provider, as there is no configured provider to ask.
+	newURN := resource.NewURN("test", "test", "", MakeProviderType("pkgA"), "b")
+	inputs, failures, err = r.Check(context.Background(), newURN, resource.PropertyMap{}, validated, false, nil)
+	require.NoError(t, err)
+	assert.Empty(t, failures)
+	assert.Equal(t, validated, inputs)
+	assert.Equal(t, 2, checkConfigCalls)
+}
+
+func TestConfigureSecretKeys(t *testing.T) {
+	t.Parallel()
+
+	secretInputs := func() resource.PropertyMap {
+		return resource.PropertyMap{
+			"region": resource.NewStringProperty("us-west-2"),
+			"token":  resource.MakeSecret(resource.NewStringProperty("hunter2")),
+			"assumeRole": resource.NewObjectProperty(resource.PropertyMap{
+				"externalId": resource.MakeSecret(resource.NewStringProperty("abc")),
+			}),
+		}
+	}
+	expected := []config.Key{config.MustMakeKey("pkgA", "assumeRole"), config.MustMakeKey("pkgA", "token")}
+
+	// Providers loaded from the old state are configured with the secret keys of their inputs.
+	olds := []*resource.State{newProviderState("pkgA", "a", "id1", false, secretInputs())}
+	host := newPluginHost(t, []*providerLoader{newSimpleLoader(t, "pkgA", "", nil)})
+	r, err := NewRegistry(context.Background(), host, olds, false, nil)
+	require.NoError(t, err)
+
+	p, ok := r.GetProvider(Reference{urn: olds[0].URN, id: olds[0].ID})
+	require.True(t, ok)
+	assert.Equal(t, expected, p.(*testProvider).secretKeys)
+
+	// As are new providers.
+	urn := resource.NewURN("test", "test", "", MakeProviderType("pkgA"), "b")
+	inputs, failures, err := r.Check(context.Background(), urn, resource.PropertyMap{}, secretInputs(), false, nil)
+	require.NoError(t, err)
+	assert.Empty(t, failures)
+
+	id, _, _, err := r.Create(context.Background(), urn, inputs, 0, false)
+	require.NoError(t, err)
+
+	p, ok = r.GetProvider(Reference{urn: urn, id: id})
+	require.True(t, ok)
+	assert.Equal(t, expected, p.(*testProvider).secretKeys)
+}
+
+func TestWatchConfig(t *testing.T) {
+	t.Parallel()
+
+	type watch struct {
+		ctx      context.Context
+		onChange func(resource.PropertyMap)
+	}
+	var configs []resource.PropertyMap
+	var watches []watch
+	loader := newLoader(t, "pkgA", "", func(pkg tokens.Package, ver semver.Version) (plugin.Provider, error) {
+		return &testProvider{
+			pkg:     pkg,
+			version: ver,
+			checkConfig: func(urn resource.URN, olds,
+				news resource.PropertyMap, allowUnknowns bool) (resource.PropertyMap, []plugin.CheckFailure, error) {
+				if !news["region"].IsString() {
+					return nil, []plugin.CheckFailure{{Property: "region", Reason: "region must be a string"}}, nil
+				}
+				return news, nil, nil
+			},
+			config: func(inputs resource.PropertyMap) error {
+				configs = append(configs, inputs)
+				return nil
+			},
+			watchConfig: func(ctx context.Context, onChange func(resource.PropertyMap)) error {
+				watches = append(watches, watch{ctx: ctx, onChange: onChange})
+				return nil
+			},
+		}, nil
+	})
+
+	str := resource.NewStringProperty
+	olds := []*resource.State{newProviderState("pkgA", "a", "id1", false, resource.PropertyMap{
+		"region":  str("us-west-2"),
+		"profile": str("default"),
+	})}
+	var closed []plugin.Provider
+	host := newPluginHost(t, []*providerLoader{loader}).(*testPluginHost)
+	host.closeProvider = func(provider plugin.Provider) error {
+		closed = append(closed, provider)
+		return nil
+	}
+	r, err := NewRegistry(context.Background(), host, olds, false, nil)
+	require.NoError(t, err)
+	ref := Reference{urn: olds[0].URN, id: olds[0].ID}
+	require.Len(t, watches, 1)
+
+	// A change to the configuration is merged into the provider's inputs, and a new provider configured with them
+	// replaces the old one.
+	old, ok := r.GetProvider(ref)
+	require.True(t, ok)
+	watches[0].onChange(resource.PropertyMap{"region": str("us-east-1")})
+
+	p, ok := r.GetProvider(ref)
+	require.True(t, ok)
+	assert.NotSame(t, old, p)
+	require.Len(t, configs, 2)
+	assert.Equal(t, resource.PropertyMap{"region": str("us-east-1"), "profile": str("default")}, configs[1])
+
+	// The old provider is no longer watched, but the new one is.
+	assert.Error(t, watches[0].ctx.Err())
+	require.Len(t, watches, 2)
+	assert.NoError(t, watches[1].ctx.Err())
+
+	// Invalid changes leave the provider in place.
+	watches[1].onChange(resource.PropertyMap{"region": resource.NewNumberProperty(1)})
+	current, ok := r.GetProvider(ref)
+	require.True(t, ok)
+	assert.Same(t, p, current)
+	assert.Len(t, configs, 2)
+	assert.NoError(t, watches[1].ctx.Err())
+
+	// The replaced provider may still be in use, so it is only closed along with the registry, which also stops
+	// watching the current provider.
+	assert.Empty(t, closed)
+	require.NoError(t, r.Close())
+	assert.Equal(t, []plugin.Provider{old}, closed)
+	assert.Error(t, watches[1].ctx.Err())
+}
@@ -0,0 +1,105 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/logging"
+)
+
+// readyTypes records, for each provider, the resource types whose schema asks the engine to wait for them to become
+// ready after they are created. Each provider's schema is fetched at most once per deployment.
+type readyTypes struct {
+	m     sync.Mutex
+	types map[plugin.Provider]*providerReadyTypes
+}
+
+// providerReadyTypes holds the resource types that a single provider can wait on, which are loaded on first use.
+type providerReadyTypes struct {
+	once  sync.Once
+	types map[string]bool
+}
+
+// get returns the set of resource types that the given provider can wait on. The lock only guards the lookup: the
+// provider's schema is loaded outside it, so resources of other providers do not wait for it.
+func (r *readyTypes) get(ctx context.Context, prov plugin.Provider) map[string]bool {
+	r.m.Lock()
+	entry, ok := r.types[prov]
+	if !ok {
+		if r.types == nil {
+			r.types = map[plugin.Provider]*providerReadyTypes{}
+		}
+		entry = &providerReadyTypes{}
+		r.types[prov] = entry
+	}
+	r.m.Unlock()
+
+	entry.once.Do(func() {
+		entry.types = loadReadyTypes(ctx, prov)
+	})
+	return entry.types
+}
+
+// loadReadyTypes reads the resource types that set waitForReady from the provider's schema. Providers that do not
+// support the waitForResourceReady feature are not asked for their schema.
+func loadReadyTypes(ctx context.Context, prov plugin.Provider) map[string]bool {
+	pkg := prov.Pkg()
+	supported, err := prov.SupportsFeature(ctx, plugin.FeatureWaitForResourceReady)
+	if err != nil || !supported {
+		return nil
+	}
+
+	resp, err := prov.GetSchema(ctx, 0)
+	if err != nil {
+		logging.V(7).Infof("waitForResourceReady(%s): failed to get schema: %v", pkg, err)
+		return nil
+	}
+	var spec schema.PackageSpec
+	if err := json.Unmarshal(resp.Bytes, &spec); err != nil {
+		logging.V(7).Infof("waitForResourceReady(%s): failed to decode schema: %v", pkg, err)
+		return nil
+	}
+
+	types := map[string]bool{}
+	for tok, res := range spec.Resources {
+		if res.WaitForReady {
+			types[tok] = true
+		}
+	}
+	return types
+}
+
+// waitForResourceReady waits for a resource that was just created to become ready if its schema sets waitForReady.
+// Providers that do not implement WaitForResourceReady are treated as if the resource were already ready.
+func (d *Deployment) waitForResourceReady(ctx context.Context, prov plugin.Provider, urn resource.URN, id resource.ID,
+	timeout float64) error {
+
+	if !d.readyTypes.get(ctx, prov)[string(urn.Type())] {
+		return nil
+	}
+
+	logging.V(7).Infof("waitForResourceReady(%s): waiting for %s", urn, id)
+	err := prov.WaitForResourceReady(ctx, urn, id, timeout)
+	if err == plugin.ErrNotYetImplemented {
+		return nil
+	}
+	return err
+}
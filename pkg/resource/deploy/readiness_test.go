@@ -0,0 +1,87 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+func newReadyTypesProvider(pkg string, getSchema func(version int) ([]byte, error)) *deploytest.Provider {
+	return &deploytest.Provider{
+		Package: tokens.Package("pkg" + pkg),
+		SupportsFeatureF: func(feature string) (bool, error) {
+			return feature == plugin.FeatureWaitForResourceReady, nil
+		},
+		GetSchemaF: getSchema,
+	}
+}
+
+func TestReadyTypesLoadsEachProviderOnce(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	release := make(chan struct{})
+	slow := newReadyTypesProvider("A", func(int) ([]byte, error) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return []byte(`{"name":"pkgA","resources":{"pkgA:index:Res":{"waitForReady":true}}}`), nil
+	})
+	fast := newReadyTypesProvider("B", func(int) ([]byte, error) {
+		return []byte(`{"name":"pkgB","resources":{"pkgB:index:Res":{"waitForReady":true}}}`), nil
+	})
+
+	var r readyTypes
+
+	// Start two lookups for the slow provider. Its schema is only fetched once, and neither lookup returns until it
+	// has been.
+	var wg sync.WaitGroup
+	slowTypes := make([]map[string]bool, 2)
+	for i := range slowTypes {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			slowTypes[i] = r.get(context.Background(), slow)
+		}()
+	}
+	assert.Eventually(t, func() bool { return atomic.LoadInt32(&calls) == 1 }, 10*time.Second, time.Millisecond)
+
+	// A lookup for another provider does not wait for the slow provider's schema.
+	done := make(chan map[string]bool)
+	go func() { done <- r.get(context.Background(), fast) }()
+	select {
+	case types := <-done:
+		assert.Equal(t, map[string]bool{"pkgB:index:Res": true}, types)
+	case <-time.After(10 * time.Second):
+		t.Fatal("lookup for one provider waited on another provider's schema")
+	}
+
+	close(release)
+	wg.Wait()
+	for _, types := range slowTypes {
+		assert.Equal(t, map[string]bool{"pkgA:index:Res": true}, types)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
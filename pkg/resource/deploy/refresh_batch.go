@@ -0,0 +1,141 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+)
+
+// refreshBatch is a group of refresh steps whose resources are read from the same provider using a single call to
+// BatchRead. The batch is read lazily by whichever of its steps is applied first; the remaining steps wait for that
+// read to complete and then pick up their individual responses.
+type refreshBatch struct {
+	ctx   context.Context // the context of the refresh; reads are not issued once it is canceled.
+	steps []*RefreshStep  // the steps in this batch, in request order.
+
+	once      sync.Once
+	responses map[*RefreshStep]plugin.BatchReadResponse
+}
+
+// batchRefreshSteps groups the refresh steps that need to read from a provider into batches. Each provider's steps
+// are distributed round-robin over at most `parallelism` batches so that the degree of parallelism of the refresh is
+// preserved for providers that implement BatchRead with sequential reads. Steps that would end up alone in a batch
+// are left to issue an ordinary Refresh.
+func batchRefreshSteps(ctx context.Context, steps []Step, parallelism int) {
+	var order []string
+	byProvider := map[string][]*RefreshStep{}
+	for _, step := range steps {
+		s, ok := step.(*RefreshStep)
+		if !ok || !s.needsRead() {
+			continue
+		}
+		if _, has := byProvider[s.old.Provider]; !has {
+			order = append(order, s.old.Provider)
+		}
+		byProvider[s.old.Provider] = append(byProvider[s.old.Provider], s)
+	}
+
+	for _, ref := range order {
+		provSteps := byProvider[ref]
+
+		count := parallelism
+		if count > len(provSteps) {
+			count = len(provSteps)
+		}
+		batches := make([]*refreshBatch, count)
+		for i := range batches {
+			batches[i] = &refreshBatch{ctx: ctx}
+		}
+		for i, s := range provSteps {
+			b := batches[i%count]
+			b.steps = append(b.steps, s)
+		}
+
+		for _, b := range batches {
+			if len(b.steps) < 2 {
+				continue
+			}
+			for _, s := range b.steps {
+				s.batch = b
+			}
+		}
+	}
+}
+
+// read returns the result of reading the given step's resource, issuing the batch's BatchRead call if it has not
+// yet been issued.
+func (b *refreshBatch) read(s *RefreshStep) (plugin.ReadResult, resource.Status, error) {
+	b.once.Do(b.execute)
+
+	resp := b.responses[s]
+	return resp.ReadResult, resp.Status, resp.Error
+}
+
+// execute reads all of the resources in the batch from their provider and records the responses.
+func (b *refreshBatch) execute() {
+	b.responses = make(map[*RefreshStep]plugin.BatchReadResponse, len(b.steps))
+
+	// All of the steps in a batch share a provider reference, but errors are reported per-resource.
+	prov, err := getProvider(b.steps[0])
+	if err != nil {
+		for _, s := range b.steps {
+			_, err := getProvider(s)
+			b.fail(s, err)
+		}
+		return
+	}
+
+	requests := make([]plugin.BatchReadRequest, len(b.steps))
+	for i, s := range b.steps {
+		requests[i] = plugin.BatchReadRequest{
+			URN:    s.old.URN,
+			ID:     s.old.ID,
+			Inputs: s.old.Inputs,
+			State:  s.old.Outputs,
+		}
+	}
+
+	responses, err := prov.BatchRead(b.ctx, requests)
+	if err == nil && len(responses) != len(requests) {
+		err = fmt.Errorf("provider returned %d responses to a batch of %d reads", len(responses), len(requests))
+	}
+	if err != nil {
+		for _, s := range b.steps {
+			b.fail(s, err)
+		}
+		return
+	}
+
+	for i, s := range b.steps {
+		b.responses[s] = responses[i]
+	}
+}
+
+// fail records a failed read for the given step.
+func (b *refreshBatch) fail(s *RefreshStep, err error) {
+	b.responses[s] = plugin.BatchReadResponse{Status: resource.StatusOK, Error: err}
+}
+
+// needsRead returns true if refreshing this step's resource requires reading it from its provider.
+func (s *RefreshStep) needsRead() bool {
+	// Component, provider, and pending-replace resources never change with a refresh.
+	return s.old.Custom && !providers.IsProviderType(s.old.Type) && !s.old.PendingReplacement
+}
@@ -0,0 +1,56 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"sync"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/logging"
+)
+
+// resourceChanges is a plugin.EventEmitter that records the resources whose providers have reported a change to their
+// live state. Providers may report changes at any time, so the set is safe to use concurrently.
+type resourceChanges struct {
+	m       sync.Mutex
+	changed map[resource.URN]resource.ID // the ID of each changed resource, or "" if its provider did not say.
+}
+
+func newResourceChanges() *resourceChanges {
+	return &resourceChanges{changed: make(map[resource.URN]resource.ID)}
+}
+
+func (c *resourceChanges) EmitResourceChanged(urn resource.URN, id resource.ID) error {
+	logging.V(7).Infof("provider reported a change to %v (%v)", urn, id)
+
+	c.m.Lock()
+	defer c.m.Unlock()
+	c.changed[urn] = id
+	return nil
+}
+
+// take returns true if a change has been reported for the resource with the given URN and ID since the last call to
+// take for that resource. Changes reported for a different ID refer to a different physical resource and are ignored.
+func (c *resourceChanges) take(urn resource.URN, id resource.ID) bool {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	changedID, has := c.changed[urn]
+	if !has {
+		return false
+	}
+	delete(c.changed, urn)
+	return changedID == "" || changedID == id
+}
@@ -103,6 +103,22 @@ type RegisterResourceOutputsEvent interface {
 	Done()
 }
 
+// ConstructResultEvent is an event that asks the engine to record the inputs that a provider reported for a remote
+// component that it constructed. The component registers its own state while it is being constructed, so the inputs
+// replace those in that state.
+type ConstructResultEvent interface {
+	SourceEvent
+	// URN is the URN of the constructed component.
+	URN() resource.URN
+	// Inputs returns the component's normalized inputs, or nil if the provider did not report them.
+	Inputs() resource.PropertyMap
+	// InputDependencies returns the resources that each of the component's inputs depends on, or nil if the provider
+	// did not report them.
+	InputDependencies() map[resource.PropertyKey][]resource.URN
+	// Done indicates that we are done with this event.  It must be called to perform cleanup associated with the event.
+	Done()
+}
+
 // ReadResourceEvent is an event that asks the engine to read the state of an existing resource.
 type ReadResourceEvent interface {
 	SourceEvent
@@ -20,17 +20,21 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/blang/semver"
 	pbempty "github.com/golang/protobuf/ptypes/empty"
 	"github.com/grpc-ecosystem/grpc-opentracing/go/otgrpc"
+	"github.com/hashicorp/go-multierror"
 	opentracing "github.com/opentracing/opentracing-go"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
+	"github.com/pulumi/pulumi/pkg/v3/version"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
@@ -110,20 +114,22 @@ func (src *evalSource) Iterate(
 	regChan := make(chan *registerResourceEvent)
 	regOutChan := make(chan *registerResourceOutputsEvent)
 	regReadChan := make(chan *readResourceEvent)
+	constructChan := make(chan *constructResultEvent)
 	mon, err := newResourceMonitor(
-		src, providers, regChan, regOutChan, regReadChan, opts, config, configSecretKeys, tracingSpan)
+		src, providers, regChan, regOutChan, regReadChan, constructChan, opts, config, configSecretKeys, tracingSpan)
 	if err != nil {
 		return nil, result.FromError(fmt.Errorf("failed to start resource monitor: %w", err))
 	}
 
 	// Create a new iterator with appropriate channels, and gear up to go!
 	iter := &evalSourceIterator{
-		mon:         mon,
-		src:         src,
-		regChan:     regChan,
-		regOutChan:  regOutChan,
-		regReadChan: regReadChan,
-		finChan:     make(chan result.Result),
+		mon:           mon,
+		src:           src,
+		regChan:       regChan,
+		regOutChan:    regOutChan,
+		regReadChan:   regReadChan,
+		constructChan: constructChan,
+		finChan:       make(chan result.Result),
 	}
 
 	// Now invoke Run in a goroutine.  All subsequent resource creation events will come in over the gRPC channel,
@@ -135,13 +141,14 @@ func (src *evalSource) Iterate(
 }
 
 type evalSourceIterator struct {
-	mon         SourceResourceMonitor              // the resource monitor, per iterator.
-	src         *evalSource                        // the owning eval source object.
-	regChan     chan *registerResourceEvent        // the channel that contains resource registrations.
-	regOutChan  chan *registerResourceOutputsEvent // the channel that contains resource completions.
-	regReadChan chan *readResourceEvent            // the channel that contains read resource requests.
-	finChan     chan result.Result                 // the channel that communicates completion.
-	done        bool                               // set to true when the evaluation is done.
+	mon           SourceResourceMonitor              // the resource monitor, per iterator.
+	src           *evalSource                        // the owning eval source object.
+	regChan       chan *registerResourceEvent        // the channel that contains resource registrations.
+	regOutChan    chan *registerResourceOutputsEvent // the channel that contains resource completions.
+	regReadChan   chan *readResourceEvent            // the channel that contains read resource requests.
+	constructChan chan *constructResultEvent         // the channel that contains remote component construct results.
+	finChan       chan result.Result                 // the channel that communicates completion.
+	done          bool                               // set to true when the evaluation is done.
 }
 
 func (iter *evalSourceIterator) Close() error {
@@ -176,6 +183,11 @@ func (iter *evalSourceIterator) Next() (SourceEvent, result.Result) {
 		contract.Assert(read != nil)
 		logging.V(5).Infoln("EvalSourceIterator produced a read")
 		return read, nil
+	case construct := <-iter.constructChan:
+		contract.Assert(construct != nil)
+		logging.V(5).Infof("EvalSourceIterator produced a construct result: urn=%v,#inputs=%v",
+			construct.URN(), len(construct.Inputs()))
+		return construct, nil
 	case res := <-iter.finChan:
 		// If we are finished, we can safely exit.  The contract with the language provider is that this implies
 		// that the language runtime has exited and so calling Close on the plugin is fine.
@@ -280,6 +292,10 @@ func (d *defaultProviders) newRegisterDefaultProviderEvent(
 		return nil, nil, err
 	}
 
+	// The version and download URL of the provider are recorded alongside the package's configuration. These override
+	// any configuration keys with the same name.
+	defaults := resource.PropertyMap{}
+
 	// Request that the engine instantiate a specific version of this provider, if one was requested. We'll figure out
 	// what version to request by:
 	//   1. Providing the Version field of the ProviderRequest verbatim, if it was provided, otherwise
@@ -292,13 +308,13 @@ func (d *defaultProviders) newRegisterDefaultProviderEvent(
 	// problematic for a lot of reasons.
 	if req.Version() != nil {
 		logging.V(5).Infof("newRegisterDefaultProviderEvent(%s): using version %s from request", req, req.Version())
-		providers.SetProviderVersion(inputs, req.Version())
+		providers.SetProviderVersion(defaults, req.Version())
 	} else {
 		logging.V(5).Infof(
 			"newRegisterDefaultProviderEvent(%s): no version specified, falling back to default version", req)
 		if version := d.defaultProviderInfo[req.Package()].Version; version != nil {
 			logging.V(5).Infof("newRegisterDefaultProviderEvent(%s): default version hit on version %s", req, version)
-			providers.SetProviderVersion(inputs, version)
+			providers.SetProviderVersion(defaults, version)
 		} else {
 			logging.V(5).Infof(
 				"newRegisterDefaultProviderEvent(%s): default provider miss, sending nil version to engine", req)
@@ -308,7 +324,7 @@ func (d *defaultProviders) newRegisterDefaultProviderEvent(
 	if req.PluginDownloadURL() != "" {
 		logging.V(5).Infof("newRegisterDefaultProviderEvent(%s): using pluginDownloadURL %s from request",
 			req, req.PluginDownloadURL())
-		providers.SetProviderURL(inputs, req.PluginDownloadURL())
+		providers.SetProviderURL(defaults, req.PluginDownloadURL())
 	} else {
 		logging.V(5).Infof(
 			"newRegisterDefaultProviderEvent(%s): no pluginDownloadURL specified, falling back to default pluginDownloadURL",
@@ -316,13 +332,16 @@ func (d *defaultProviders) newRegisterDefaultProviderEvent(
 		if pluginDownloadURL := d.defaultProviderInfo[req.Package()].PluginDownloadURL; pluginDownloadURL != "" {
 			logging.V(5).Infof("newRegisterDefaultProviderEvent(%s): default pluginDownloadURL hit on %s",
 				req, pluginDownloadURL)
-			providers.SetProviderURL(inputs, pluginDownloadURL)
+			providers.SetProviderURL(defaults, pluginDownloadURL)
 		} else {
 			logging.V(5).Infof(
 				"newRegisterDefaultProviderEvent(%s): default pluginDownloadURL miss, sending empty string to engine", req)
 		}
 	}
 
+	inputs, err = inputs.Merge(defaults, resource.MergePreferRight)
+	contract.AssertNoError(err)
+
 	// Create the result channel and the event.
 	done := make(chan *RegisterResult)
 	event := &registerResourceEvent{
@@ -478,17 +497,67 @@ type resmon struct {
 	regChan                   chan *registerResourceEvent        // the channel to send resource registrations to.
 	regOutChan                chan *registerResourceOutputsEvent // the channel to send resource output registrations to.
 	regReadChan               chan *readResourceEvent            // the channel to send resource reads to.
+	constructChan             chan *constructResultEvent         // the channel to send construct results to.
 	cancel                    chan bool                          // a channel that can cancel the server.
 	done                      chan error                         // a channel that resolves when the server completes.
 	disableResourceReferences bool                               // true if resource references are disabled.
 	disableOutputValues       bool                               // true if output values are disabled.
+
+	registeredLock sync.Mutex                    // a lock that protects registered and parents.
+	registered     []resource.URN                // the URNs of the resources registered with the monitor so far.
+	parents        map[resource.URN]resource.URN // the parent of each registered resource that has one.
 }
 
 var _ SourceResourceMonitor = (*resmon)(nil)
 
+// recordRegistration records that the resource with the given URN and parent has been registered with the monitor.
+func (rm *resmon) recordRegistration(urn, parent resource.URN) {
+	rm.registeredLock.Lock()
+	defer rm.registeredLock.Unlock()
+	rm.registered = append(rm.registered, urn)
+	if parent != "" {
+		if rm.parents == nil {
+			rm.parents = map[resource.URN]resource.URN{}
+		}
+		rm.parents[urn] = parent
+	}
+}
+
+// checkChildren checks that each of the given child resources that a provider declared for the component with the
+// given URN was registered either as a descendant of the component or without a parent of its own. Children that
+// were not registered at all are reported by plugin.ValidateConstructResult.
+func (rm *resmon) checkChildren(component resource.URN, children []resource.URN) []error {
+	rm.registeredLock.Lock()
+	defer rm.registeredLock.Unlock()
+
+	var errs []error
+	for _, child := range children {
+		parent, ok := rm.parents[child]
+		if !ok {
+			continue
+		}
+		ancestor := parent
+		for ancestor != "" && ancestor != component {
+			ancestor = rm.parents[ancestor]
+		}
+		if ancestor == "" {
+			errs = append(errs, fmt.Errorf("child resource %v was registered with parent %v", child, parent))
+		}
+	}
+	return errs
+}
+
+// registeredURNs returns the URNs of the resources that have been registered with the monitor so far.
+func (rm *resmon) registeredURNs() []resource.URN {
+	rm.registeredLock.Lock()
+	defer rm.registeredLock.Unlock()
+	return append([]resource.URN(nil), rm.registered...)
+}
+
 // newResourceMonitor creates a new resource monitor RPC server.
 func newResourceMonitor(src *evalSource, provs ProviderSource, regChan chan *registerResourceEvent,
-	regOutChan chan *registerResourceOutputsEvent, regReadChan chan *readResourceEvent, opts Options,
+	regOutChan chan *registerResourceOutputsEvent, regReadChan chan *readResourceEvent,
+	constructChan chan *constructResultEvent, opts Options,
 	config map[config.Key]string, configSecretKeys []config.Key, tracingSpan opentracing.Span) (*resmon, error) {
 
 	// Create our cancellation channel.
@@ -512,6 +581,7 @@ func newResourceMonitor(src *evalSource, provs ProviderSource, regChan chan *reg
 		regChan:                   regChan,
 		regOutChan:                regOutChan,
 		regReadChan:               regReadChan,
+		constructChan:             constructChan,
 		cancel:                    cancel,
 		disableResourceReferences: opts.DisableResourceReferences,
 		disableOutputValues:       opts.DisableOutputValues,
@@ -536,6 +606,8 @@ func newResourceMonitor(src *evalSource, provs ProviderSource, regChan chan *reg
 		DryRun:           src.dryRun,
 		Parallel:         opts.Parallel,
 		MonitorAddress:   fmt.Sprintf("127.0.0.1:%d", port),
+		EngineVersion:    version.Version,
+		Namespace:        opts.Namespace,
 	}
 	resmon.done = done
 
@@ -666,7 +738,7 @@ func (rm *resmon) Invoke(ctx context.Context, req *pulumirpc.ResourceInvokeReque
 
 	// Do the invoke and then return the arguments.
 	logging.V(5).Infof("ResourceMonitor.Invoke received: tok=%v #args=%v", tok, len(args))
-	ret, failures, err := prov.Invoke(tok, args)
+	ret, failures, err := prov.Invoke(ctx, tok, args)
 	if err != nil {
 		return nil, fmt.Errorf("invocation of %v returned an error: %w", tok, err)
 	}
@@ -679,13 +751,7 @@ func (rm *resmon) Invoke(ctx context.Context, req *pulumirpc.ResourceInvokeReque
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal %v return: %w", tok, err)
 	}
-	var chkfails []*pulumirpc.CheckFailure
-	for _, failure := range failures {
-		chkfails = append(chkfails, &pulumirpc.CheckFailure{
-			Property: string(failure.Property),
-			Reason:   failure.Reason,
-		})
-	}
+	chkfails := plugin.MarshalCheckFailures(failures)
 	return &pulumirpc.InvokeResponse{Return: mret, Failures: chkfails}, nil
 }
 
@@ -735,6 +801,7 @@ func (rm *resmon) Call(ctx context.Context, req *pulumirpc.CallRequest) (*pulumi
 		Parallel:       rm.constructInfo.Parallel,
 		MonitorAddress: rm.constructInfo.MonitorAddress,
 	}
+	info.TraceID, info.SpanID = plugin.SpanIDs(opentracing.SpanFromContext(ctx))
 	options := plugin.CallOptions{
 		ArgDependencies: argDependencies,
 	}
@@ -742,9 +809,17 @@ func (rm *resmon) Call(ctx context.Context, req *pulumirpc.CallRequest) (*pulumi
 	// Do the all and then return the arguments.
 	logging.V(5).Infof(
 		"ResourceMonitor.Call received: tok=%v #args=%v #info=%v #options=%v", tok, len(args), info, options)
-	ret, err := prov.Call(tok, args, info, options)
+	ret, err := prov.Call(ctx, tok, args, info, options)
+	// Surface anything the provider had to say about the call, even if it ultimately failed.
+	for _, d := range ret.Diagnostics {
+		rm.diagostics.Logf(d.Severity, diag.RawMessage("", d.String()))
+	}
 	if err != nil {
-		return nil, fmt.Errorf("call of %v returned an error: %w", tok, err)
+		if ret.Status != resource.StatusPartialFailure {
+			return nil, fmt.Errorf("call of %v returned an error: %w", tok, err)
+		}
+		// A partial failure should not fail the program: report it and return the values that were computed.
+		rm.diagostics.Warningf(diag.RawMessage("", fmt.Sprintf("call of %v partially failed: %v", tok, err)))
 	}
 	mret, err := plugin.MarshalProperties(ret.Return, plugin.MarshalOptions{
 		Label:         label,
@@ -765,13 +840,7 @@ func (rm *resmon) Call(ctx context.Context, req *pulumirpc.CallRequest) (*pulumi
 		returnDependencies[string(name)] = &pulumirpc.CallResponse_ReturnDependencies{Urns: urns}
 	}
 
-	var chkfails []*pulumirpc.CheckFailure
-	for _, failure := range ret.Failures {
-		chkfails = append(chkfails, &pulumirpc.CheckFailure{
-			Property: string(failure.Property),
-			Reason:   failure.Reason,
-		})
-	}
+	chkfails := plugin.MarshalCheckFailures(ret.Failures)
 	return &pulumirpc.CallResponse{Return: mret, ReturnDependencies: returnDependencies, Failures: chkfails}, nil
 }
 
@@ -804,8 +873,8 @@ func (rm *resmon) StreamInvoke(
 	// Synchronously do the StreamInvoke and then return the arguments. This will block until the
 	// streaming operation completes!
 	logging.V(5).Infof("ResourceMonitor.StreamInvoke received: tok=%v #args=%v", tok, len(args))
-	failures, err := prov.StreamInvoke(tok, args, func(event resource.PropertyMap) error {
-		mret, err := plugin.MarshalProperties(event, plugin.MarshalOptions{
+	failures, err := prov.StreamInvoke(stream.Context(), tok, args, func(event plugin.StreamInvokeEvent) error {
+		mret, err := plugin.MarshalProperties(event.Payload, plugin.MarshalOptions{
 			Label:         label,
 			KeepUnknowns:  true,
 			KeepResources: req.GetAcceptResources(),
@@ -814,19 +883,19 @@ func (rm *resmon) StreamInvoke(
 			return fmt.Errorf("failed to marshal return: %w", err)
 		}
 
-		return stream.Send(&pulumirpc.InvokeResponse{Return: mret})
+		// Pass page boundaries along so that the caller can tell when a paginated stream has finished a page.
+		resp := &pulumirpc.InvokeResponse{Return: mret}
+		if event.PageNumber != 0 {
+			logging.V(7).Infof("%s: page %d (last=%v)", label, event.PageNumber, event.IsLastPage)
+			resp.PageNumber, resp.IsLastPage = int32(event.PageNumber), event.IsLastPage
+		}
+		return stream.Send(resp)
 	})
 	if err != nil {
 		return fmt.Errorf("streaming invocation of %v returned an error: %w", tok, err)
 	}
 
-	var chkfails []*pulumirpc.CheckFailure
-	for _, failure := range failures {
-		chkfails = append(chkfails, &pulumirpc.CheckFailure{
-			Property: string(failure.Property),
-			Reason:   failure.Reason,
-		})
-	}
+	chkfails := plugin.MarshalCheckFailures(failures)
 
 	if len(chkfails) > 0 {
 		return stream.Send(&pulumirpc.InvokeResponse{Failures: chkfails})
@@ -912,6 +981,8 @@ func (rm *resmon) ReadResource(ctx context.Context,
 	}
 
 	contract.Assert(result != nil)
+	rm.recordRegistration(result.State.URN, parent)
+
 	marshaled, err := plugin.MarshalProperties(result.State.Outputs, plugin.MarshalOptions{
 		Label:         label,
 		KeepUnknowns:  true,
@@ -1132,13 +1203,54 @@ func (rm *resmon) RegisterResource(ctx context.Context,
 			Protect:              protect,
 			PropertyDependencies: propertyDependencies,
 			Providers:            providerRefs,
+			Timeouts: plugin.OperationTimeouts{
+				Create: timeouts.Create,
+				Update: timeouts.Update,
+				Delete: timeouts.Delete,
+			},
+			IgnoreChanges: ignoreChanges,
+		}
+
+		// Pass along the version of the SDK that registered the component, if it reported one.
+		info := rm.constructInfo
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if v := md.Get(plugin.SDKVersionMetadataKey); len(v) > 0 {
+				info.SDKVersion = v[0]
+			}
 		}
-		constructResult, err := provider.Construct(rm.constructInfo, t, name, parent, props, options)
+		info.TraceID, info.SpanID = plugin.SpanIDs(opentracing.SpanFromContext(ctx))
+
+		constructResult, err := provider.Construct(ctx, info, t, name, parent, props, options)
 		if err != nil {
 			return nil, err
 		}
 
-		result = &RegisterResult{State: &resource.State{URN: constructResult.URN, Outputs: constructResult.Outputs}}
+		// Every resource that the component's outputs depend on must have been registered with the monitor, either
+		// by the component itself or before it was constructed, and every child that the provider declared must have
+		// been registered as part of the component.
+		errs := plugin.ValidateConstructResult(constructResult, rm.registeredURNs())
+		errs = append(errs, rm.checkChildren(constructResult.URN, constructResult.ChildResources)...)
+		if len(errs) > 0 {
+			var result *multierror.Error
+			for _, err := range errs {
+				result = multierror.Append(result, err)
+			}
+			return nil, fmt.Errorf("invalid result from constructing %v: %w", constructResult.URN, result)
+		}
+
+		// The component registered its own state while it was being constructed, so ask the engine to record the
+		// normalized inputs that the provider reported, if any, in that state.
+		if constructResult.Inputs != nil || constructResult.InputDependencies != nil {
+			if err := rm.recordConstructResult(constructResult); err != nil {
+				return nil, err
+			}
+		}
+
+		result = &RegisterResult{State: &resource.State{
+			URN:     constructResult.URN,
+			Inputs:  constructResult.Inputs,
+			Outputs: constructResult.Outputs,
+		}}
 
 		outputDeps = map[string]*pulumirpc.RegisterResourceResponse_PropertyDependencies{}
 		for k, deps := range constructResult.OutputDependencies {
@@ -1172,6 +1284,7 @@ func (rm *resmon) RegisterResource(ctx context.Context,
 			return nil, rpcerror.New(codes.Unavailable, "resource monitor shut down while waiting on step's done channel")
 		}
 	}
+	rm.recordRegistration(result.State.URN, parent)
 
 	// Filter out partially-known values if the requestor does not support them.
 	outputs := result.State.Outputs
@@ -1203,7 +1316,7 @@ func (rm *resmon) RegisterResource(ctx context.Context,
 	// TODO(@platform):
 	// Currently component resources ignore these options:
 	// • ignoreChanges
-	// • customTimeouts
+	// • customTimeouts (unless the component is remote)
 	// • additionalSecretOutputs
 	// • replaceOnChanges
 	// • retainOnDelete
@@ -1211,10 +1324,12 @@ func (rm *resmon) RegisterResource(ctx context.Context,
 	// See this issue for more: https://github.com/pulumi/pulumi/issues/9704
 	if !custom {
 		rm.checkComponentOption(result.State.URN, "ignoreChanges", func() bool {
-			return len(ignoreChanges) > 0
+			// Remote components forward their ignoreChanges to their provider via Construct.
+			return len(ignoreChanges) > 0 && !remote
 		})
 		rm.checkComponentOption(result.State.URN, "customTimeouts", func() bool {
-			if customTimeouts == nil {
+			// Remote components forward their timeouts to their children via Construct.
+			if customTimeouts == nil || remote {
 				return false
 			}
 			var hasUpdateTimeout = customTimeouts.Update != ""
@@ -1318,6 +1433,32 @@ func (rm *resmon) RegisterResourceOutputs(ctx context.Context,
 	return &pbempty.Empty{}, nil
 }
 
+// recordConstructResult asks the engine to record the inputs that a provider reported for a component that it
+// constructed in the component's state.
+func (rm *resmon) recordConstructResult(constructResult plugin.ConstructResult) error {
+	event := &constructResultEvent{
+		urn:       constructResult.URN,
+		inputs:    constructResult.Inputs,
+		inputDeps: constructResult.InputDependencies,
+		done:      make(chan bool),
+	}
+
+	select {
+	case rm.constructChan <- event:
+	case <-rm.cancel:
+		logging.V(5).Infof("ResourceMonitor.RegisterResource operation canceled, urn=%s", constructResult.URN)
+		return rpcerror.New(codes.Unavailable, "resource monitor shut down while sending construct result")
+	}
+
+	select {
+	case <-event.done:
+	case <-rm.cancel:
+		logging.V(5).Infof("ResourceMonitor.RegisterResource operation canceled, urn=%s", constructResult.URN)
+		return rpcerror.New(codes.Unavailable, "resource monitor shut down while waiting on construct result")
+	}
+	return nil
+}
+
 type registerResourceEvent struct {
 	goal *resource.Goal       // the resource goal state produced by the iterator.
 	done chan *RegisterResult // the channel to communicate with after the resource state is available.
@@ -1389,6 +1530,34 @@ func (g *readResourceEvent) Done(result *ReadResult) {
 	g.done <- result
 }
 
+type constructResultEvent struct {
+	urn       resource.URN                            // the URN of the constructed component.
+	inputs    resource.PropertyMap                    // the component's normalized inputs, if any.
+	inputDeps map[resource.PropertyKey][]resource.URN // the dependencies of each of the component's inputs, if any.
+	done      chan bool                               // the channel to communicate with after the event is handled.
+}
+
+var _ ConstructResultEvent = (*constructResultEvent)(nil)
+
+func (g *constructResultEvent) event() {}
+
+func (g *constructResultEvent) URN() resource.URN {
+	return g.urn
+}
+
+func (g *constructResultEvent) Inputs() resource.PropertyMap {
+	return g.inputs
+}
+
+func (g *constructResultEvent) InputDependencies() map[resource.PropertyKey][]resource.URN {
+	return g.inputDeps
+}
+
+func (g *constructResultEvent) Done() {
+	// Communicate the result back to the RPC thread, which is parked awaiting our reply.
+	g.done <- true
+}
+
 func generateTimeoutInSeconds(timeout string) (float64, error) {
 	duration, err := time.ParseDuration(timeout)
 	if err != nil {
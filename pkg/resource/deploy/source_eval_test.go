@@ -529,6 +529,34 @@ func TestReadInvokeDefaultProviders(t *testing.T) {
 	assert.Equal(t, expectedInvokes, int(invokes))
 }
 
+func TestResmonCheckChildren(t *testing.T) {
+	t.Parallel()
+
+	component := resource.URN("urn:pulumi:stack::project::pkg:index:Component::comp")
+	child := resource.URN("urn:pulumi:stack::project::pkg:index:Component$pkg:index:Resource::child")
+	grandchild := resource.URN("urn:pulumi:stack::project::pkg:index:Component$pkg:index:Resource$pkg:index:Resource::gc")
+	parentless := resource.URN("urn:pulumi:stack::project::pkg:index:Resource::parentless")
+	other := resource.URN("urn:pulumi:stack::project::pkg:index:Other::other")
+	stray := resource.URN("urn:pulumi:stack::project::pkg:index:Other$pkg:index:Resource::stray")
+
+	rm := &resmon{}
+	rm.recordRegistration(component, "")
+	rm.recordRegistration(child, component)
+	rm.recordRegistration(grandchild, child)
+	rm.recordRegistration(parentless, "")
+	rm.recordRegistration(other, "")
+	rm.recordRegistration(stray, other)
+
+	// Descendants of the component and resources registered without a parent may be declared as children.
+	assert.Empty(t, rm.checkChildren(component, []resource.URN{child, grandchild, parentless}))
+
+	// Resources registered with a parent outside of the component may not.
+	errs := rm.checkChildren(component, []resource.URN{child, stray})
+	if assert.Len(t, errs, 1) {
+		assert.EqualError(t, errs[0], "child resource "+string(stray)+" was registered with parent "+string(other))
+	}
+}
+
 // Test that we can run operations with default providers disabled.
 //
 // We run against the matrix of
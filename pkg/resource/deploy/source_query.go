@@ -51,7 +51,7 @@ func NewQuerySource(cancel context.Context, plugctx *plugin.Context, client Back
 	// Create a new builtin provider. This provider implements features such as `getStack`.
 	builtins := newBuiltinProvider(client, nil)
 
-	reg, err := providers.NewRegistry(plugctx.Host, nil, false, builtins)
+	reg, err := providers.NewRegistry(cancel, plugctx.Host, nil, false, builtins)
 	if err != nil {
 		return nil, fmt.Errorf("failed to start resource monitor: %w", err)
 	}
@@ -64,7 +64,7 @@ func NewQuerySource(cancel context.Context, plugctx *plugin.Context, client Back
 	//
 	// NOTE: Using the queryResourceMonitor here is *VERY* important, as its job is to disallow
 	// resource operations in query mode!
-	mon, err := newQueryResourceMonitor(builtins, defaultProviderVersions, provs, reg, plugctx,
+	mon, err := newQueryResourceMonitor(cancel, builtins, defaultProviderVersions, provs, reg, plugctx,
 		providerRegErrChan, opentracing.SpanFromContext(cancel), runinfo)
 	if err != nil {
 		return nil, fmt.Errorf("failed to start resource monitor: %w", err)
@@ -196,7 +196,7 @@ func runLangPlugin(src *querySource) result.Result {
 
 // newQueryResourceMonitor creates a new resource monitor RPC server intended to be used in Pulumi's
 // "query mode".
-func newQueryResourceMonitor(
+func newQueryResourceMonitor(ctx context.Context,
 	builtins *builtinProvider, defaultProviderInfo map[tokens.Package]workspace.PluginSpec,
 	provs ProviderSource, reg *providers.Registry, plugctx *plugin.Context,
 	providerRegErrChan chan<- result.Result, tracingSpan opentracing.Span, runinfo *EvalRunInfo) (*queryResmon, error) {
@@ -221,12 +221,12 @@ func newQueryResourceMonitor(
 		for e := range providerRegChan {
 			urn := syntheticProviderURN(e.goal)
 
-			inputs, _, err := reg.Check(urn, resource.PropertyMap{}, e.goal.Properties, false, nil)
+			inputs, _, err := reg.Check(ctx, urn, resource.PropertyMap{}, e.goal.Properties, false, nil)
 			if err != nil {
 				providerRegErrChan <- result.FromError(err)
 				return
 			}
-			_, _, _, err = reg.Create(urn, inputs, 9999, false)
+			_, _, _, err = reg.Create(ctx, urn, inputs, 9999, false)
 			if err != nil {
 				providerRegErrChan <- result.FromError(err)
 				return
@@ -351,7 +351,7 @@ func (rm *queryResmon) Invoke(
 
 	// Do the invoke and then return the arguments.
 	logging.V(5).Infof("QueryResourceMonitor.Invoke received: tok=%v #args=%v", tok, len(args))
-	ret, failures, err := prov.Invoke(tok, args)
+	ret, failures, err := prov.Invoke(ctx, tok, args)
 	if err != nil {
 		return nil, fmt.Errorf("invocation of %v returned an error: %w", tok, err)
 	}
@@ -364,13 +364,7 @@ func (rm *queryResmon) Invoke(
 		return nil, fmt.Errorf("failed to marshal return: %w", err)
 	}
 
-	var chkfails []*pulumirpc.CheckFailure
-	for _, failure := range failures {
-		chkfails = append(chkfails, &pulumirpc.CheckFailure{
-			Property: string(failure.Property),
-			Reason:   failure.Reason,
-		})
-	}
+	chkfails := plugin.MarshalCheckFailures(failures)
 
 	return &pulumirpc.InvokeResponse{Return: mret, Failures: chkfails}, nil
 }
@@ -399,8 +393,8 @@ func (rm *queryResmon) StreamInvoke(
 	// Synchronously do the StreamInvoke and then return the arguments. This will block until the
 	// streaming operation completes!
 	logging.V(5).Infof("QueryResourceMonitor.StreamInvoke received: tok=%v #args=%v", tok, len(args))
-	failures, err := prov.StreamInvoke(tok, args, func(event resource.PropertyMap) error {
-		mret, err := plugin.MarshalProperties(event, plugin.MarshalOptions{Label: label,
+	failures, err := prov.StreamInvoke(stream.Context(), tok, args, func(event plugin.StreamInvokeEvent) error {
+		mret, err := plugin.MarshalProperties(event.Payload, plugin.MarshalOptions{Label: label,
 			KeepUnknowns:  true,
 			KeepResources: req.GetAcceptResources(),
 		})
@@ -408,19 +402,19 @@ func (rm *queryResmon) StreamInvoke(
 			return fmt.Errorf("failed to marshal return: %w", err)
 		}
 
-		return stream.Send(&pulumirpc.InvokeResponse{Return: mret})
+		// Pass page boundaries along so that the caller can tell when a paginated stream has finished a page.
+		resp := &pulumirpc.InvokeResponse{Return: mret}
+		if event.PageNumber != 0 {
+			logging.V(7).Infof("%s: page %d (last=%v)", label, event.PageNumber, event.IsLastPage)
+			resp.PageNumber, resp.IsLastPage = int32(event.PageNumber), event.IsLastPage
+		}
+		return stream.Send(resp)
 	})
 	if err != nil {
 		return fmt.Errorf("streaming invocation of %v returned an error: %w", tok, err)
 	}
 
-	var chkfails []*pulumirpc.CheckFailure
-	for _, failure := range failures {
-		chkfails = append(chkfails, &pulumirpc.CheckFailure{
-			Property: string(failure.Property),
-			Reason:   failure.Reason,
-		})
-	}
+	chkfails := plugin.MarshalCheckFailures(failures)
 
 	if len(chkfails) > 0 {
 		return stream.Send(&pulumirpc.InvokeResponse{Failures: chkfails})
@@ -464,11 +458,13 @@ func (rm *queryResmon) Call(ctx context.Context, req *pulumirpc.CallRequest) (*p
 	options := plugin.CallOptions{
 		ArgDependencies: argDependencies,
 	}
+	info := rm.callInfo
+	info.TraceID, info.SpanID = plugin.SpanIDs(opentracing.SpanFromContext(ctx))
 
 	// Do the call and then return the arguments.
 	logging.V(5).Infof(
-		"QueryResourceMonitor.Call received: tok=%v #args=%v #info=%v #options=%v", tok, len(args), rm.callInfo, options)
-	ret, err := prov.Call(tok, args, rm.callInfo, options)
+		"QueryResourceMonitor.Call received: tok=%v #args=%v #info=%v #options=%v", tok, len(args), info, options)
+	ret, err := prov.Call(ctx, tok, args, info, options)
 	if err != nil {
 		return nil, fmt.Errorf("call of %v returned an error: %w", tok, err)
 	}
@@ -491,13 +487,7 @@ func (rm *queryResmon) Call(ctx context.Context, req *pulumirpc.CallRequest) (*p
 		returnDependencies[string(name)] = &pulumirpc.CallResponse_ReturnDependencies{Urns: urns}
 	}
 
-	var chkfails []*pulumirpc.CheckFailure
-	for _, failure := range ret.Failures {
-		chkfails = append(chkfails, &pulumirpc.CheckFailure{
-			Property: string(failure.Property),
-			Reason:   failure.Reason,
-		})
-	}
+	chkfails := plugin.MarshalCheckFailures(ret.Failures)
 
 	return &pulumirpc.CallResponse{Return: mret, ReturnDependencies: returnDependencies, Failures: chkfails}, nil
 }
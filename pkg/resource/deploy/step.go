@@ -15,6 +15,7 @@
 package deploy
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -38,11 +39,11 @@ type StepCompleteFunc func()
 type Step interface {
 	// Apply applies or previews this step. It returns the status of the resource after the step application,
 	// a function to call to signal that this step has fully completed, and an error, if one occurred while applying
-	// the step.
+	// the step. Provider calls made by the step are canceled when ctx is.
 	//
 	// The returned StepCompleteFunc, if not nil, must be called after committing the results of this step into
 	// the state of the deployment.
-	Apply(preview bool) (resource.Status, StepCompleteFunc, error) // applies or previews this step.
+	Apply(ctx context.Context, preview bool) (resource.Status, StepCompleteFunc, error) // applies or previews this step.
 
 	Op() display.StepOp      // the operation performed by this step.
 	URN() resource.URN       // the resource URN (for before and after).
@@ -61,6 +62,7 @@ type SameStep struct {
 	reg        RegisterResourceEvent // the registration intent to convey a URN back to.
 	old        *resource.State       // the state of the resource before this step.
 	new        *resource.State       // the state of the resource after this step.
+	oldOutputs resource.PropertyMap  // the outputs of the resource before this step, migrated to the current schema.
 
 	// If this is a same-step for a resource being created but which was not --target'ed by the user
 	// (and thus was skipped).
@@ -70,6 +72,15 @@ type SameStep struct {
 var _ Step = (*SameStep)(nil)
 
 func NewSameStep(deployment *Deployment, reg RegisterResourceEvent, old, new *resource.State) Step {
+	contract.Assert(old != nil)
+	return newSameStep(deployment, reg, old, new, old.Outputs)
+}
+
+// newSameStep produces a SameStep that retains the given outputs of the old resource, which may differ from the old
+// state's outputs if they have been migrated to the provider's current state schema version.
+func newSameStep(deployment *Deployment, reg RegisterResourceEvent, old, new *resource.State,
+	oldOutputs resource.PropertyMap) Step {
+
 	contract.Assert(old != nil)
 	contract.Assert(old.URN != "")
 	contract.Assert(old.ID != "" || !old.Custom)
@@ -85,6 +96,7 @@ func NewSameStep(deployment *Deployment, reg RegisterResourceEvent, old, new *re
 		reg:        reg,
 		old:        old,
 		new:        new,
+		oldOutputs: oldOutputs,
 	}
 }
 
@@ -106,6 +118,7 @@ func NewSkippedCreateStep(deployment *Deployment, reg RegisterResourceEvent, new
 		reg:           reg,
 		old:           &old,
 		new:           new,
+		oldOutputs:    old.Outputs,
 		skippedCreate: true,
 	}
 }
@@ -120,10 +133,10 @@ func (s *SameStep) New() *resource.State    { return s.new }
 func (s *SameStep) Res() *resource.State    { return s.new }
 func (s *SameStep) Logical() bool           { return true }
 
-func (s *SameStep) Apply(preview bool) (resource.Status, StepCompleteFunc, error) {
+func (s *SameStep) Apply(ctx context.Context, preview bool) (resource.Status, StepCompleteFunc, error) {
 	// Retain the ID and outputs
 	s.new.ID = s.old.ID
-	s.new.Outputs = s.old.Outputs
+	s.new.Outputs = s.oldOutputs
 
 	// If the resource is a provider, ensure that it is present in the registry under the appropriate URNs.
 	if providers.IsProviderType(s.new.Type) {
@@ -220,7 +233,7 @@ func (s *CreateStep) Diffs() []resource.PropertyKey                { return s.di
 func (s *CreateStep) DetailedDiff() map[string]plugin.PropertyDiff { return s.detailedDiff }
 func (s *CreateStep) Logical() bool                                { return !s.replacing }
 
-func (s *CreateStep) Apply(preview bool) (resource.Status, StepCompleteFunc, error) {
+func (s *CreateStep) Apply(ctx context.Context, preview bool) (resource.Status, StepCompleteFunc, error) {
 	var resourceError error
 	resourceStatus := resource.StatusOK
 	if s.new.Custom {
@@ -230,7 +243,9 @@ func (s *CreateStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 			return resource.StatusOK, nil, err
 		}
 
-		id, outs, rst, err := prov.Create(s.URN(), s.new.Inputs, s.new.CustomTimeouts.Create, s.deployment.preview)
+		id, outs, rst, err := prov.StreamCreate(ctx, s.URN(), s.new.Inputs, s.new.CustomTimeouts.Create,
+			s.deployment.preview, s.reportInterimState)
+		id, outs, rst, err = unwrapPartialFailure(id, outs, rst, err)
 		if err != nil {
 			if rst != resource.StatusPartialFailure {
 				return rst, nil, err
@@ -251,6 +266,21 @@ func (s *CreateStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 		// Copy any of the default and output properties on the live object state.
 		s.new.ID = id
 		s.new.Outputs = outs
+
+		if preview && resourceError == nil {
+			s.deployment.estimateCost(ctx, prov, s.URN(), s.new.Inputs)
+		}
+
+		// Some resources are not usable as soon as they have been created, e.g. a database that is still starting up.
+		// If the resource's schema asks for it, wait for the provider to report that the resource is ready. The
+		// resource exists either way, so a failure to become ready is a partial failure.
+		if !preview && resourceError == nil {
+			err = s.deployment.waitForResourceReady(ctx, prov, s.URN(), id, s.new.CustomTimeouts.Create)
+			if err != nil {
+				resourceError = err
+				resourceStatus = resource.StatusPartialFailure
+			}
+		}
 	}
 
 	// Mark the old resource as pending deletion if necessary.
@@ -265,6 +295,62 @@ func (s *CreateStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 	return resourceStatus, complete, resourceError
 }
 
+// reportInterimState displays an interim state that the provider reported while creating the resource as a status
+// message for the resource.
+func (s *CreateStep) reportInterimState(state resource.PropertyMap) error {
+	logging.V(7).Infof("CreateStep(%s): received interim state (#state=%d)", s.URN(), len(state))
+	if s.deployment.ctx == nil || s.deployment.ctx.StatusDiag == nil {
+		return nil
+	}
+	if summary := summarizeInterimState(state); summary != "" {
+		s.deployment.ctx.StatusDiag.Infof(diag.StreamMessage(s.URN(), summary, 0))
+	}
+	return nil
+}
+
+// summarizeInterimState returns a one-line summary of the top-level properties of an interim state that have
+// primitive values, e.g. "status=running, progress=40". Secret values are masked, and other values are omitted.
+func summarizeInterimState(state resource.PropertyMap) string {
+	var parts []string
+	for _, k := range state.Keys() {
+		v := state[k]
+		switch {
+		case v.IsSecret():
+			parts = append(parts, fmt.Sprintf("%s=[secret]", k))
+		case v.IsString(), v.IsNumber(), v.IsBool():
+			parts = append(parts, fmt.Sprintf("%s=%v", k, v.V))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// unwrapPartialFailure extracts the state carried by a plugin.PartialFailureError, if err is one, so that the state
+// of the partially created or updated resource is persisted. The returned error is the failure's underlying cause,
+// which is what is reported to the user.
+func unwrapPartialFailure(id resource.ID, outs resource.PropertyMap, rst resource.Status,
+	err error) (resource.ID, resource.PropertyMap, resource.Status, error) {
+
+	var partial *plugin.PartialFailureError
+	if !errors.As(err, &partial) {
+		return id, outs, rst, err
+	}
+
+	if partial.ID != "" {
+		id = partial.ID
+	}
+	if partial.Properties != nil {
+		outs = partial.Properties
+	}
+	rst = partial.Status
+	if rst == resource.StatusOK {
+		rst = resource.StatusPartialFailure
+	}
+	if partial.Cause != nil {
+		err = partial.Cause
+	}
+	return id, outs, rst, err
+}
+
 // DeleteStep is a mutating step that deletes an existing resource. If `old` is marked "External",
 // DeleteStep is a no-op.
 type DeleteStep struct {
@@ -335,7 +421,7 @@ func (s *DeleteStep) New() *resource.State    { return nil }
 func (s *DeleteStep) Res() *resource.State    { return s.old }
 func (s *DeleteStep) Logical() bool           { return !s.replacing }
 
-func (s *DeleteStep) Apply(preview bool) (resource.Status, StepCompleteFunc, error) {
+func (s *DeleteStep) Apply(ctx context.Context, preview bool) (resource.Status, StepCompleteFunc, error) {
 	// Refuse to delete protected resources (unless we're replacing them in
 	// which case we will of checked protect elsewhere)
 	if !s.replacing && s.old.Protect {
@@ -361,7 +447,8 @@ func (s *DeleteStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 			return resource.StatusOK, nil, err
 		}
 
-		if rst, err := prov.Delete(s.URN(), s.old.ID, s.old.Outputs, s.old.CustomTimeouts.Delete); err != nil {
+		rst, err := prov.Delete(ctx, s.URN(), s.old.ID, s.old.Outputs, s.old.CustomTimeouts.Delete)
+		if err != nil {
 			return rst, nil, err
 		}
 	}
@@ -395,7 +482,7 @@ func (s *RemovePendingReplaceStep) New() *resource.State    { return nil }
 func (s *RemovePendingReplaceStep) Res() *resource.State    { return s.old }
 func (s *RemovePendingReplaceStep) Logical() bool           { return false }
 
-func (s *RemovePendingReplaceStep) Apply(preview bool) (resource.Status, StepCompleteFunc, error) {
+func (s *RemovePendingReplaceStep) Apply(ctx context.Context, preview bool) (resource.Status, StepCompleteFunc, error) {
 	return resource.StatusOK, nil, nil
 }
 
@@ -405,6 +492,7 @@ type UpdateStep struct {
 	reg           RegisterResourceEvent          // the registration intent to convey a URN back to.
 	old           *resource.State                // the state of the existing resource.
 	new           *resource.State                // the newly computed state of the resource after updating.
+	oldOutputs    resource.PropertyMap           // the outputs of the existing resource, migrated to the current schema.
 	stables       []resource.PropertyKey         // an optional list of properties that won't change during this update.
 	diffs         []resource.PropertyKey         // the keys causing a diff.
 	detailedDiff  map[string]plugin.PropertyDiff // the structured diff.
@@ -417,6 +505,16 @@ func NewUpdateStep(deployment *Deployment, reg RegisterResourceEvent, old, new *
 	stables, diffs []resource.PropertyKey, detailedDiff map[string]plugin.PropertyDiff,
 
 	ignoreChanges []string) Step {
+	contract.Assert(old != nil)
+	return newUpdateStep(deployment, reg, old, new, old.Outputs, stables, diffs, detailedDiff, ignoreChanges)
+}
+
+// newUpdateStep produces an UpdateStep that updates the resource from the given outputs of the old resource, which may
+// differ from the old state's outputs if they have been migrated to the provider's current state schema version.
+func newUpdateStep(deployment *Deployment, reg RegisterResourceEvent, old, new *resource.State,
+	oldOutputs resource.PropertyMap, stables, diffs []resource.PropertyKey,
+	detailedDiff map[string]plugin.PropertyDiff, ignoreChanges []string) Step {
+
 	contract.Assert(old != nil)
 	contract.Assert(old.URN != "")
 	contract.Assert(old.ID != "" || !old.Custom)
@@ -434,6 +532,7 @@ func NewUpdateStep(deployment *Deployment, reg RegisterResourceEvent, old, new *
 		reg:           reg,
 		old:           old,
 		new:           new,
+		oldOutputs:    oldOutputs,
 		stables:       stables,
 		diffs:         diffs,
 		detailedDiff:  detailedDiff,
@@ -453,7 +552,7 @@ func (s *UpdateStep) Logical() bool                                { return true
 func (s *UpdateStep) Diffs() []resource.PropertyKey                { return s.diffs }
 func (s *UpdateStep) DetailedDiff() map[string]plugin.PropertyDiff { return s.detailedDiff }
 
-func (s *UpdateStep) Apply(preview bool) (resource.Status, StepCompleteFunc, error) {
+func (s *UpdateStep) Apply(ctx context.Context, preview bool) (resource.Status, StepCompleteFunc, error) {
 	// Always propagate the ID, even in previews and refreshes.
 	s.new.ID = s.old.ID
 
@@ -467,8 +566,9 @@ func (s *UpdateStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 		}
 
 		// Update to the combination of the old "all" state, but overwritten with new inputs.
-		outs, rst, upderr := prov.Update(s.URN(), s.old.ID, s.old.Outputs, s.new.Inputs,
+		outs, rst, upderr := prov.Update(ctx, s.URN(), s.old.ID, s.oldOutputs, s.new.Inputs,
 			s.new.CustomTimeouts.Update, s.ignoreChanges, s.deployment.preview)
+		_, outs, rst, upderr = unwrapPartialFailure(s.old.ID, outs, rst, upderr)
 		if upderr != nil {
 			if rst != resource.StatusPartialFailure {
 				return rst, nil, upderr
@@ -484,6 +584,10 @@ func (s *UpdateStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 
 		// Now copy any output state back in case the update triggered cascading updates to other properties.
 		s.new.Outputs = outs
+
+		if preview && resourceError == nil {
+			s.deployment.estimateCost(ctx, prov, s.URN(), s.new.Inputs)
+		}
 	}
 
 	// Finally, mark this operation as complete.
@@ -544,7 +648,7 @@ func (s *ReplaceStep) Diffs() []resource.PropertyKey                { return s.d
 func (s *ReplaceStep) DetailedDiff() map[string]plugin.PropertyDiff { return s.detailedDiff }
 func (s *ReplaceStep) Logical() bool                                { return true }
 
-func (s *ReplaceStep) Apply(preview bool) (resource.Status, StepCompleteFunc, error) {
+func (s *ReplaceStep) Apply(ctx context.Context, preview bool) (resource.Status, StepCompleteFunc, error) {
 	// If this is a pending delete, we should have marked the old resource for deletion in the CreateReplacement step.
 	contract.Assert(!s.pendingDelete || s.old.Delete)
 	return resource.StatusOK, func() {}, nil
@@ -566,6 +670,9 @@ type ReadStep struct {
 	old        *resource.State   // the old resource state, if one exists for this urn
 	new        *resource.State   // the new resource state, to be used to query the provider
 	replacing  bool              // whether or not the new resource is replacing the old resource
+
+	// the old resource's outputs migrated to the schema version of the new resource state, if they needed migrating.
+	oldOutputs resource.PropertyMap
 }
 
 // NewReadStep creates a new Read step.
@@ -627,7 +734,16 @@ func (s *ReadStep) New() *resource.State    { return s.new }
 func (s *ReadStep) Res() *resource.State    { return s.new }
 func (s *ReadStep) Logical() bool           { return !s.replacing }
 
-func (s *ReadStep) Apply(preview bool) (resource.Status, StepCompleteFunc, error) {
+// OldOutputs returns the old resource's outputs, migrated to the schema version of the read state if the provider
+// read the resource with a different schema version than the one recorded in the old state.
+func (s *ReadStep) OldOutputs() resource.PropertyMap {
+	if s.oldOutputs == nil && s.old != nil {
+		return s.old.Outputs
+	}
+	return s.oldOutputs
+}
+
+func (s *ReadStep) Apply(ctx context.Context, preview bool) (resource.Status, StepCompleteFunc, error) {
 	urn := s.new.URN
 	id := s.new.ID
 
@@ -643,7 +759,7 @@ func (s *ReadStep) Apply(preview bool) (resource.Status, StepCompleteFunc, error
 			return resource.StatusOK, nil, err
 		}
 
-		result, rst, err := prov.Read(urn, id, nil, s.new.Inputs)
+		result, rst, err := readNotFound(prov.Read(ctx, urn, id, nil, s.new.Inputs))
 		if err != nil {
 			if rst != resource.StatusPartialFailure {
 				return rst, nil, err
@@ -662,10 +778,19 @@ func (s *ReadStep) Apply(preview bool) (resource.Status, StepCompleteFunc, error
 			return resource.StatusOK, nil, fmt.Errorf("resource '%s' does not exist", id)
 		}
 		s.new.Outputs = result.Outputs
+		s.new.SchemaVersion = result.SchemaVersion
 
 		if result.ID != "" {
 			s.new.ID = result.ID
 		}
+
+		if s.old != nil && result.SchemaVersion != 0 && result.SchemaVersion != s.old.SchemaVersion {
+			migrated, err := migrateReadState(ctx, prov, s.old, result.SchemaVersion)
+			if err != nil {
+				return resource.StatusOK, nil, err
+			}
+			s.oldOutputs = migrated
+		}
 	}
 
 	// If we were asked to replace an existing, non-External resource, pend the
@@ -689,6 +814,10 @@ type RefreshStep struct {
 	old        *resource.State // the old resource state, if one exists for this urn
 	new        *resource.State // the new resource state, to be used to query the provider
 	done       chan<- bool     // the channel to use to signal completion, if any
+	batch      *refreshBatch   // the batch this step's read belongs to, if any
+
+	// the old resource's outputs migrated to the schema version of the refreshed state, if they needed migrating.
+	oldOutputs resource.PropertyMap
 }
 
 // NewRefreshStep creates a new Refresh step.
@@ -714,19 +843,28 @@ func (s *RefreshStep) New() *resource.State    { return s.new }
 func (s *RefreshStep) Res() *resource.State    { return s.old }
 func (s *RefreshStep) Logical() bool           { return false }
 
+// OldOutputs returns the old resource's outputs, migrated to the schema version of the refreshed state if the
+// provider read the resource with a different schema version than the one recorded in the old state.
+func (s *RefreshStep) OldOutputs() resource.PropertyMap {
+	if s.oldOutputs == nil {
+		return s.old.Outputs
+	}
+	return s.oldOutputs
+}
+
 // ResultOp returns the operation that corresponds to the change to this resource after reading its current state, if
 // any.
 func (s *RefreshStep) ResultOp() display.StepOp {
 	if s.new == nil {
 		return OpDelete
 	}
-	if s.new == s.old || s.old.Outputs.Diff(s.new.Outputs) == nil {
+	if s.new == s.old || s.OldOutputs().Diff(s.new.Outputs) == nil {
 		return OpSame
 	}
 	return OpUpdate
 }
 
-func (s *RefreshStep) Apply(preview bool) (resource.Status, StepCompleteFunc, error) {
+func (s *RefreshStep) Apply(ctx context.Context, preview bool) (resource.Status, StepCompleteFunc, error) {
 	var complete func()
 	if s.done != nil {
 		complete = func() { close(s.done) }
@@ -735,18 +873,12 @@ func (s *RefreshStep) Apply(preview bool) (resource.Status, StepCompleteFunc, er
 	resourceID := s.old.ID
 
 	// Component, provider, and pending-replace resources never change with a refresh; just return the current state.
-	if !s.old.Custom || providers.IsProviderType(s.old.Type) || s.old.PendingReplacement {
+	if !s.needsRead() {
 		return resource.StatusOK, complete, nil
 	}
 
-	// For a custom resource, fetch the resource's provider and read the resource's current state.
-	prov, err := getProvider(s)
-	if err != nil {
-		return resource.StatusOK, nil, err
-	}
-
 	var initErrors []string
-	refreshed, rst, err := prov.Read(s.old.URN, resourceID, s.old.Inputs, s.old.Outputs)
+	refreshed, rst, err := s.read(ctx)
 	if err != nil {
 		if rst != resource.StatusPartialFailure {
 			return rst, nil, err
@@ -780,11 +912,23 @@ func (s *RefreshStep) Apply(preview bool) (resource.Status, StepCompleteFunc, er
 			logging.V(7).Infof("Refreshing ID; oldId=%s, newId=%s", resourceID, refreshed.ID)
 			resourceID = refreshed.ID
 		}
+		if refreshed.ImportID != "" && refreshed.ImportID != resourceID {
+			s.Deployment().Diag().Infof(diag.Message(s.URN(),
+				"the provider normalized the ID '%v' to '%v'; the normalized ID will be recorded in the state"),
+				resourceID, refreshed.ImportID)
+			resourceID = refreshed.ImportID
+		}
 
 		s.new = resource.NewState(s.old.Type, s.old.URN, s.old.Custom, s.old.Delete, resourceID, inputs, outputs,
 			s.old.Parent, s.old.Protect, s.old.External, s.old.Dependencies, initErrors, s.old.Provider,
 			s.old.PropertyDependencies, s.old.PendingReplacement, s.old.AdditionalSecretOutputs, s.old.Aliases,
 			&s.old.CustomTimeouts, s.old.ImportID, s.old.RetainOnDelete)
+
+		version, err := s.migrateOld(ctx, refreshed)
+		if err != nil {
+			return resource.StatusOK, nil, err
+		}
+		s.new.SchemaVersion = version
 	} else {
 		s.new = nil
 	}
@@ -792,6 +936,42 @@ func (s *RefreshStep) Apply(preview bool) (resource.Status, StepCompleteFunc, er
 	return rst, complete, err
 }
 
+// read reads the current state of a custom resource from its provider, either directly or as part of the step's
+// batch.
+func (s *RefreshStep) read(ctx context.Context) (plugin.ReadResult, resource.Status, error) {
+	if s.batch != nil {
+		return readNotFound(s.batch.read(s))
+	}
+
+	prov, err := getProvider(s)
+	if err != nil {
+		return plugin.ReadResult{}, resource.StatusOK, err
+	}
+
+	return readNotFound(prov.Refresh(ctx, s.old.URN, s.old.ID, s.old.Inputs, s.old.Outputs))
+}
+
+// migrateOld returns the state schema version to record for the refreshed state. If the provider read the resource's
+// state with a different schema version than the one recorded in its old state, the old outputs are first migrated
+// with MigrateState so that the old and refreshed outputs use the same property names. The old state itself is left
+// as it is.
+func (s *RefreshStep) migrateOld(ctx context.Context, refreshed plugin.ReadResult) (int, error) {
+	if refreshed.SchemaVersion == 0 || refreshed.SchemaVersion == s.old.SchemaVersion {
+		return s.old.SchemaVersion, nil
+	}
+
+	prov, err := getProvider(s)
+	if err != nil {
+		return 0, err
+	}
+	migrated, err := migrateReadState(ctx, prov, s.old, refreshed.SchemaVersion)
+	if err != nil {
+		return 0, err
+	}
+	s.oldOutputs = migrated
+	return refreshed.SchemaVersion, nil
+}
+
 type ImportStep struct {
 	deployment    *Deployment                    // the current deployment.
 	reg           RegisterResourceEvent          // the registration intent to convey a URN back to.
@@ -804,6 +984,7 @@ type ImportStep struct {
 	detailedDiff  map[string]plugin.PropertyDiff // the structured property diff.
 	ignoreChanges []string                       // a list of property paths to ignore when updating.
 	randomSeed    []byte                         // the random seed to use for Check.
+	metadata      *plugin.ImportMetadata         // the import metadata returned by the provider, if any.
 }
 
 func NewImportStep(deployment *Deployment, reg RegisterResourceEvent, new *resource.State,
@@ -885,7 +1066,21 @@ func (s *ImportStep) Logical() bool                                { return !s.r
 func (s *ImportStep) Diffs() []resource.PropertyKey                { return s.diffs }
 func (s *ImportStep) DetailedDiff() map[string]plugin.PropertyDiff { return s.detailedDiff }
 
-func (s *ImportStep) Apply(preview bool) (resource.Status, StepCompleteFunc, error) {
+// reportNotes displays the notes and original owner that the provider reported while reading the resource, if any.
+func (s *ImportStep) reportNotes() {
+	if s.metadata == nil {
+		return
+	}
+	if s.metadata.OriginalOwner != "" {
+		s.deployment.Diag().Infof(diag.Message(s.new.URN, "the resource was originally created by %v"),
+			s.metadata.OriginalOwner)
+	}
+	for _, note := range s.metadata.Notes {
+		s.deployment.Diag().Infof(diag.RawMessage(s.new.URN, note))
+	}
+}
+
+func (s *ImportStep) Apply(ctx context.Context, preview bool) (resource.Status, StepCompleteFunc, error) {
 	complete := func() { s.reg.Done(&RegisterResult{State: s.new}) }
 
 	// If this is a planned import, ensure that the resource does not exist in the old state file.
@@ -901,13 +1096,18 @@ func (s *ImportStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 		}
 	}
 
-	// Read the current state of the resource to import. If the provider does not hand us back any inputs for the
-	// resource, it probably needs to be updated. If the resource does not exist at all, fail the import.
+	// Ask the provider for the minimal inputs required to import the resource, then read its current state using
+	// those inputs. Providers that do not implement PrepareImport must instead return inputs from Read; if they do
+	// not, they probably need to be updated. If the resource does not exist at all, fail the import.
 	prov, err := getProvider(s)
 	if err != nil {
 		return resource.StatusOK, nil, err
 	}
-	read, rst, err := prov.Read(s.new.URN, s.new.ID, nil, nil)
+	prepared, err := prov.PrepareImport(ctx, s.new.URN, s.new.ID)
+	if err != nil && err != plugin.ErrNotYetImplemented {
+		return resource.StatusOK, nil, err
+	}
+	read, rst, err := readNotFound(prov.Read(ctx, s.new.URN, s.new.ID, prepared, nil))
 	if err != nil {
 		if initErr, isInitErr := err.(*plugin.InitError); isInitErr {
 			s.new.InitErrors = initErr.Reasons
@@ -918,15 +1118,32 @@ func (s *ImportStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 	if read.Outputs == nil {
 		return rst, nil, fmt.Errorf("resource '%v' does not exist", s.new.ID)
 	}
+	if read.Inputs == nil && prepared != nil {
+		read.Inputs = prepared
+	}
 	if read.Inputs == nil {
 		return resource.StatusOK, nil,
 			fmt.Errorf("provider does not support importing resources; please try updating the '%v' plugin",
 				s.new.URN.Type().Package())
 	}
+	importID := s.new.ID
 	if read.ID != "" {
 		s.new.ID = read.ID
 	}
+	if read.ImportID != "" && read.ImportID != importID {
+		s.deployment.Diag().Infof(diag.Message(s.new.URN,
+			"the provider normalized the import ID '%v' to '%v'; the normalized ID will be recorded in the state"),
+			importID, read.ImportID)
+		s.new.ID = read.ImportID
+	}
 	s.new.Outputs = read.Outputs
+	s.new.SchemaVersion = read.SchemaVersion
+	s.metadata = read.ImportMetadata
+	if s.metadata != nil {
+		for _, w := range s.metadata.Warnings {
+			s.deployment.Diag().Warningf(diag.RawMessage(s.new.URN, w))
+		}
+	}
 
 	// Magic up an old state so the frontend can display a proper diff. This state is the output of the just-executed
 	// `Read` combined with the resource identity and metadata from the desired state. This ensures that the only
@@ -942,7 +1159,7 @@ func (s *ImportStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 		// Get the import object and see if it had properties set
 		var inputProperties []string
 		for _, imp := range s.deployment.imports {
-			if imp.ID == s.old.ID {
+			if imp.ID == importID {
 				inputProperties = imp.Properties
 				break
 			}
@@ -964,7 +1181,7 @@ func (s *ImportStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 		// Check the provider inputs for consistency. If the inputs fail validation, the import will still succeed, but
 		// we will display the validation failures and a message informing the user that the failures are almost
 		// definitely a provider bug.
-		_, failures, err := prov.Check(s.new.URN, s.old.Inputs, s.new.Inputs, preview, s.randomSeed)
+		_, failures, err := prov.Check(ctx, s.new.URN, s.old.Inputs, s.new.Inputs, preview, s.randomSeed)
 		if err != nil {
 			return rst, nil, err
 		}
@@ -990,7 +1207,7 @@ func (s *ImportStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 				errorMessage)
 		}
 
-		issueCheckFailures(s.deployment.Diag().Warningf, s.new, s.new.URN, failures)
+		issueCheckFailures(s.deployment.Diag().Warningf, s.deployment.Diag().Warningf, s.new, s.new.URN, failures)
 
 		s.diffs, s.detailedDiff = []resource.PropertyKey{}, map[string]plugin.PropertyDiff{}
 		return rst, complete, err
@@ -1004,7 +1221,7 @@ func (s *ImportStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 	s.new.Inputs = processedInputs
 
 	// Check the inputs using the provider inputs for defaults.
-	inputs, failures, err := prov.Check(s.new.URN, s.old.Inputs, s.new.Inputs, preview, s.randomSeed)
+	inputs, failures, err := prov.Check(ctx, s.new.URN, s.old.Inputs, s.new.Inputs, preview, s.randomSeed)
 	if err != nil {
 		return rst, nil, err
 	}
@@ -1015,7 +1232,7 @@ func (s *ImportStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 
 	// Diff the user inputs against the provider inputs. If there are any differences, fail the import unless this step
 	// is from an import deployment.
-	diff, err := diffResource(s.new.URN, s.new.ID, s.old.Inputs, s.old.Outputs, s.new.Inputs, prov, preview,
+	diff, err := diffResource(ctx, s.new.URN, s.new.ID, s.old.Inputs, s.old.Outputs, s.new.Inputs, prov, preview,
 		s.ignoreChanges)
 	if err != nil {
 		return rst, nil, err
@@ -1216,6 +1433,35 @@ func ConstrainedTo(op display.StepOp, constraint display.StepOp) bool {
 	return false
 }
 
+// migrateReadState returns the outputs of the given old state migrated to the provider's current state schema version
+// after a read reported the given, different, version. The old state itself is left as it is. Providers that cannot
+// migrate state return the old outputs as they are.
+func migrateReadState(ctx context.Context, prov plugin.Provider, old *resource.State,
+	version int) (resource.PropertyMap, error) {
+
+	migrated, err := prov.MigrateState(ctx, old.URN, old.SchemaVersion, old.Outputs)
+	if err == plugin.ErrNotYetImplemented {
+		logging.V(7).Infof("MigrateState(%s): provider cannot migrate state from schema version %d to %d",
+			old.URN, old.SchemaVersion, version)
+		return old.Outputs, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("migrating the state of %v from schema version %d to %d: %w",
+			old.URN, old.SchemaVersion, version, err)
+	}
+	return migrated, nil
+}
+
+// readNotFound normalizes the result of reading a resource: providers may report that a resource does not exist
+// either by returning nil outputs or by returning plugin.ErrResourceNotFound. The latter is converted into the former.
+func readNotFound(result plugin.ReadResult, rst resource.Status,
+	err error) (plugin.ReadResult, resource.Status, error) {
+
+	if errors.Is(err, plugin.ErrResourceNotFound) {
+		return plugin.ReadResult{}, resource.StatusOK, nil
+	}
+	return result, rst, err
+}
+
 // getProvider fetches the provider for the given step.
 func getProvider(s Step) (plugin.Provider, error) {
 	if providers.IsProviderType(s.Type()) {
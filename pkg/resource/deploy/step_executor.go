@@ -23,6 +23,7 @@ import (
 
 	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/logging"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/result"
@@ -90,6 +91,7 @@ type stepExecutor struct {
 	opts            Options     // The options for this current deployment.
 	preview         bool        // Whether or not we are doing a preview.
 	pendingNews     sync.Map    // Resources that have been created but are pending a RegisterResourceOutputs.
+	completedNews   sync.Map    // Resources whose RegisterResourceOutputs has completed.
 	continueOnError bool        // True if we want to continue the deployment after a step error.
 
 	workers        sync.WaitGroup     // WaitGroup tracking the worker goroutines that are owned by this step executor.
@@ -156,6 +158,7 @@ func (se *stepExecutor) ExecuteRegisterResourceOutputs(e RegisterResourceOutputs
 	reg := value.(Step)
 	contract.Assertf(reg != nil, "expected a non-nil resource step ('%v')", urn)
 	se.pendingNews.Delete(urn)
+	se.completedNews.Store(urn, reg)
 	// Unconditionally set the resource's outputs to what was provided.  This intentionally overwrites whatever
 	// might already be there, since otherwise "deleting" outputs would have no affect.
 	outs := e.Outputs()
@@ -213,6 +216,45 @@ func (se *stepExecutor) ExecuteRegisterResourceOutputs(e RegisterResourceOutputs
 	return nil
 }
 
+// ExecuteConstructResult services a ConstructResultEvent synchronously on the calling goroutine.
+func (se *stepExecutor) ExecuteConstructResult(e ConstructResultEvent) result.Result {
+	// The component may or may not have registered its outputs while it was being constructed.
+	urn := e.URN()
+	value, has := se.pendingNews.Load(urn)
+	if !has {
+		value, has = se.completedNews.Load(urn)
+	}
+	contract.Assertf(has, "cannot record the construct result of a resource '%v' that was not registered", urn)
+	reg := value.(Step)
+	contract.Assertf(reg != nil, "expected a non-nil resource step ('%v')", urn)
+
+	// Replace the inputs and their dependencies that the component registered with those that the provider reported.
+	state := reg.New()
+	if inputs := e.Inputs(); inputs != nil {
+		se.log(synchronousWorkerID,
+			"recorded construct result inputs %s: old=#%d, new=#%d", urn, len(state.Inputs), len(inputs))
+		state.Inputs = inputs
+	}
+	if deps := e.InputDependencies(); deps != nil {
+		state.PropertyDependencies = deps
+	}
+
+	// Save the updated state. Like RegisterResourceOutputs, this does not execute on a worker goroutine.
+	if e := se.opts.Events; e != nil {
+		if eventerr := e.OnResourceOutputs(reg); eventerr != nil {
+			se.log(synchronousWorkerID, "record construct result failed: %s", eventerr.Error())
+
+			outErr := fmt.Errorf("resource complete event returned an error: %w", eventerr)
+			diagMsg := diag.RawMessage(reg.URN(), outErr.Error())
+			se.deployment.Diag().Errorf(diagMsg)
+			se.cancelDueToError()
+			return nil
+		}
+	}
+	e.Done()
+	return nil
+}
+
 // Errored returns whether or not this step executor saw a step whose execution ended in failure.
 func (se *stepExecutor) Errored() bool {
 	return se.sawError.Load().(bool)
@@ -299,7 +341,7 @@ func (se *stepExecutor) executeStep(workerID int, step Step) error {
 	}
 
 	se.log(workerID, "applying step %v on %v (preview %v)", step.Op(), step.URN(), se.preview)
-	status, stepComplete, err := step.Apply(se.preview)
+	status, stepComplete, err := step.Apply(se.ctx, se.preview)
 
 	if err == nil {
 		// If we have a state object, and this is a create or update, remember it, as we may need to update it later.
@@ -310,6 +352,10 @@ func (se *stepExecutor) executeStep(workerID int, step Step) error {
 
 			se.pendingNews.Store(step.URN(), step)
 		}
+
+		if importStep, ok := step.(*ImportStep); ok && se.opts.ShowImportNotes {
+			importStep.reportNotes()
+		}
 	}
 
 	// Ensure that any secrets properties in the output are marked as such and that the resource is tracked in the set
@@ -456,7 +502,7 @@ func newStepExecutor(ctx context.Context, cancel context.CancelFunc, deployment
 		preview:         preview,
 		continueOnError: continueOnError,
 		incomingChains:  make(chan incomingChain),
-		ctx:             ctx,
+		ctx:             plugin.ContextWithDiagnosticReporter(ctx, deployment.reportDiagnostic),
 		cancel:          cancel,
 	}
 
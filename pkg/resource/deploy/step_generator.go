@@ -15,6 +15,7 @@
 package deploy
 
 import (
+	"context"
 	cryptorand "crypto/rand"
 	"fmt"
 	"strings"
@@ -35,8 +36,9 @@ import (
 // It does this by consulting the deployment and calculating the appropriate step action based on the requested goal
 // state and the existing state of the world.
 type stepGenerator struct {
-	deployment *Deployment // the deployment to which this step generator belongs
-	opts       Options     // options for this step generator
+	ctx        context.Context // cancellation context for the provider calls made while generating steps
+	deployment *Deployment     // the deployment to which this step generator belongs
+	opts       Options         // options for this step generator
 
 	updateTargetsOpt  map[resource.URN]bool // the set of resources to update; resources not in this set will be same'd
 	replaceTargetsOpt map[resource.URN]bool // the set of resoures to replace
@@ -69,6 +71,12 @@ type stepGenerator struct {
 	aliased map[resource.URN]resource.URN
 	// a map from current URN of the resource to the old URN that it was aliased from.
 	aliases map[resource.URN]resource.URN
+
+	changes *resourceChanges         // the resources whose providers have reported a change to their live state.
+	watched map[plugin.Provider]bool // the providers that have been asked to report those changes.
+
+	// a map from provider to whether it supports declaring the aliases of its resources.
+	declaresAliases map[plugin.Provider]bool
 }
 
 func (sg *stepGenerator) isTargetedUpdate() bool {
@@ -447,8 +455,7 @@ func (sg *stepGenerator) generateSteps(event RegisterResourceEvent) ([]Step, res
 	var old *resource.State
 	var hasOld bool
 	var alias []resource.Alias
-	aliases[urn] = struct{}{}
-	for urnOrAlias := range aliases {
+	findOld := func(urnOrAlias resource.URN) bool {
 		old, hasOld = sg.deployment.Olds()[urnOrAlias]
 		if hasOld {
 			oldInputs = old.Inputs
@@ -470,10 +477,36 @@ func (sg *stepGenerator) generateSteps(event RegisterResourceEvent) ([]Step, res
 				// Save the alias actually being used so we can look it up later if anything has this as a parent
 				sg.aliases[urn] = urnOrAlias
 			}
+		}
+		return hasOld
+	}
+	aliases[urn] = struct{}{}
+	for urnOrAlias := range aliases {
+		if findOld(urnOrAlias) {
 			break
 		}
 	}
 
+	// Fetch the provider for this resource.
+	prov, res := sg.loadResourceProvider(urn, goal.Custom, goal.Provider, goal.Type)
+	if res != nil {
+		return nil, res
+	}
+
+	// If the resource was not found under its URN or any of the aliases from the program, fall back to any aliases that
+	// its provider declares, e.g. because the resource's type has been renamed in a later version of the provider.
+	if !hasOld && prov != nil && len(sg.deployment.Olds()) > 0 {
+		providerAliases, err := sg.providerAliases(urn, prov)
+		if err != nil {
+			return nil, result.FromError(err)
+		}
+		for _, urnOrAlias := range providerAliases {
+			if findOld(urnOrAlias) {
+				break
+			}
+		}
+	}
+
 	// Create the desired inputs from the goal state
 	inputs := goal.Properties
 	if hasOld {
@@ -503,10 +536,33 @@ func (sg *stepGenerator) generateSteps(event RegisterResourceEvent) ([]Step, res
 		sg.providers[urn] = new
 	}
 
-	// Fetch the provider for this resource.
-	prov, res := sg.loadResourceProvider(urn, goal.Custom, goal.Provider, goal.Type)
-	if res != nil {
-		return nil, res
+	// Bring the old state up to the provider's current state schema version.
+	if prov != nil {
+		var oldState *resource.State
+		if hasOld {
+			oldState = old
+		}
+		migrated, err := sg.migrateState(urn, oldState, new, prov)
+		if err != nil {
+			return nil, result.FromError(err)
+		}
+		if hasOld {
+			oldOutputs = migrated
+		}
+
+		// If the provider has reported that the resource changed outside of this deployment, refresh its old state so
+		// that it is diffed against the live state. Provider resources are managed by the provider registry, which
+		// does not report such changes.
+		if !providers.IsProviderType(goal.Type) {
+			sg.watchProvider(prov)
+			if hasOld && old.ID != "" && sg.changes.take(urn, old.ID) {
+				refreshed, err := sg.refreshState(urn, old, oldOutputs, prov)
+				if err != nil {
+					return nil, result.FromError(err)
+				}
+				oldOutputs = refreshed
+			}
+		}
 	}
 
 	// We only allow unknown property values to be exposed to the provider if we are performing an update preview.
@@ -581,9 +637,9 @@ func (sg *stepGenerator) generateSteps(event RegisterResourceEvent) ([]Step, res
 		// don't consider those inputs since Pulumi does not own them. Finally, if the resource has been
 		// targeted for replacement, ignore its old state.
 		if recreating || wasExternal || sg.isTargetedReplace(urn) || !hasOld {
-			inputs, failures, err = prov.Check(urn, nil, goal.Properties, allowUnknowns, randomSeed)
+			inputs, failures, err = prov.Check(sg.ctx, urn, nil, goal.Properties, allowUnknowns, randomSeed)
 		} else {
-			inputs, failures, err = prov.Check(urn, oldInputs, inputs, allowUnknowns, randomSeed)
+			inputs, failures, err = prov.Check(sg.ctx, urn, oldInputs, inputs, allowUnknowns, randomSeed)
 		}
 
 		if err != nil {
@@ -770,7 +826,7 @@ func (sg *stepGenerator) generateSteps(event RegisterResourceEvent) ([]Step, res
 
 		// No need to update anything, the properties didn't change.
 		sg.sames[urn] = true
-		return []Step{NewSameStep(sg.deployment, event, old, new)}, nil
+		return []Step{newSameStep(sg.deployment, event, old, new, oldOutputs)}, nil
 	}
 
 	// Case 4: Not Case 1, 2, or 3
@@ -842,7 +898,7 @@ func (sg *stepGenerator) generateStepsFromDiff(
 
 	// If there were changes check for a replacement vs. an in-place update.
 	if diff.Changes == plugin.DiffSome {
-		if diff.Replace() {
+		if diff.RequiresReplacement() {
 			// If this resource is protected we can't replace it because that entails a delete
 			// Note that we do allow unprotecting and replacing to happen in a single update
 			// cycle, we don't look at old.Protect here.
@@ -876,7 +932,7 @@ func (sg *stepGenerator) generateStepsFromDiff(
 			// Note that if we're performing a targeted replace, we already have the correct inputs.
 			if prov != nil && !sg.isTargetedReplace(urn) {
 				var failures []plugin.CheckFailure
-				inputs, failures, err = prov.Check(urn, nil, goal.Properties, allowUnknowns, randomSeed)
+				inputs, failures, err = prov.Check(sg.ctx, urn, nil, goal.Properties, allowUnknowns, randomSeed)
 				if err != nil {
 					return nil, result.FromError(err)
 				} else if issueCheckErrors(sg.deployment, new, urn, failures) {
@@ -980,8 +1036,8 @@ func (sg *stepGenerator) generateStepsFromDiff(
 			logging.V(7).Infof("Planner decided to update '%v' (oldprops=%v inputs=%v)", urn, oldInputs, new.Inputs)
 		}
 		return []Step{
-			NewUpdateStep(sg.deployment, event, old, new, diff.StableKeys, diff.ChangedKeys, diff.DetailedDiff,
-				goal.IgnoreChanges),
+			newUpdateStep(sg.deployment, event, old, new, oldOutputs, diff.StableKeys, diff.ChangedKeys,
+				diff.DetailedDiff, goal.IgnoreChanges),
 		}, nil
 	}
 
@@ -989,7 +1045,7 @@ func (sg *stepGenerator) generateStepsFromDiff(
 	// step to attempt to "continue" awaiting initialization.
 	if hasInitErrors {
 		sg.updates[urn] = true
-		return []Step{NewUpdateStep(sg.deployment, event, old, new, diff.StableKeys, nil, nil, nil)}, nil
+		return []Step{newUpdateStep(sg.deployment, event, old, new, oldOutputs, diff.StableKeys, nil, nil, nil)}, nil
 	}
 
 	// Else there are no changes needed
@@ -1400,13 +1456,19 @@ func (sg *stepGenerator) providerChanged(urn resource.URN, old, new *resource.St
 	newRes, ok := sg.providers[newRef.URN()]
 	contract.Assertf(ok, "new deployment didn't have provider, despite resource using it?")
 
-	diff, err := newProv.DiffConfig(newRef.URN(), oldRes.Inputs, newRes.Inputs, true, nil)
+	diff, err := newProv.DiffConfig(sg.ctx, newRef.URN(), oldRes.Inputs, newRes.Inputs, true, nil)
 	if err != nil {
 		return false, err
 	}
 
-	// If there is a replacement diff, we must also replace this resource.
-	if diff.Replace() {
+	// If there is a replacement diff, we must also replace this resource, unless the provider has told us which
+	// resources the change affects and this is not one of them.
+	if diff.RequiresReplacement() && !isAffectedByConfigDiff(urn, diff) {
+		logging.V(stepExecutorLogLevel).Infof(
+			"sg.diffProvider(%s, ...): new provider's DiffConfig reported replacement of other resources", urn)
+		return false, nil
+	}
+	if diff.RequiresReplacement() {
 		logging.V(stepExecutorLogLevel).Infof(
 			"sg.diffProvider(%s, ...): new provider's DiffConfig reported replacement", urn)
 		return true, nil
@@ -1418,6 +1480,20 @@ func (sg *stepGenerator) providerChanged(urn resource.URN, old, new *resource.St
 	return false, nil
 }
 
+// isAffectedByConfigDiff returns true if the given resource is affected by the provider configuration change described
+// by the given DiffConfig result. Every resource is affected unless the provider listed the affected resources.
+func isAffectedByConfigDiff(urn resource.URN, diff plugin.DiffResult) bool {
+	if len(diff.AffectedResources) == 0 {
+		return true
+	}
+	for _, affected := range diff.AffectedResources {
+		if affected == urn {
+			return true
+		}
+	}
+	return false
+}
+
 // diff returns a DiffResult for the given resource.
 func (sg *stepGenerator) diff(urn resource.URN, old, new *resource.State, oldInputs, oldOutputs,
 	newInputs resource.PropertyMap, prov plugin.Provider, allowUnknowns bool,
@@ -1452,11 +1528,11 @@ func (sg *stepGenerator) diff(urn resource.URN, old, new *resource.State, oldInp
 		return plugin.DiffResult{Changes: plugin.DiffSome}, nil
 	}
 
-	return diffResource(urn, old.ID, oldInputs, oldOutputs, newInputs, prov, allowUnknowns, ignoreChanges)
+	return diffResource(sg.ctx, urn, old.ID, oldInputs, oldOutputs, newInputs, prov, allowUnknowns, ignoreChanges)
 }
 
 // diffResource invokes the Diff function for the given custom resource's provider and returns the result.
-func diffResource(urn resource.URN, id resource.ID, oldInputs, oldOutputs,
+func diffResource(ctx context.Context, urn resource.URN, id resource.ID, oldInputs, oldOutputs,
 	newInputs resource.PropertyMap, prov plugin.Provider, allowUnknowns bool,
 	ignoreChanges []string) (plugin.DiffResult, error) {
 
@@ -1464,7 +1540,7 @@ func diffResource(urn resource.URN, id resource.ID, oldInputs, oldOutputs,
 
 	// Grab the diff from the provider. At this point we know that there were changes to the Pulumi inputs, so if the
 	// provider returns an "unknown" diff result, pretend it returned "diffs exist".
-	diff, err := prov.Diff(urn, id, oldOutputs, newInputs, allowUnknowns, ignoreChanges)
+	diff, err := prov.Diff(ctx, urn, id, oldOutputs, newInputs, allowUnknowns, ignoreChanges)
 	if err != nil {
 		return diff, err
 	}
@@ -1482,17 +1558,130 @@ func diffResource(urn resource.URN, id resource.ID, oldInputs, oldOutputs,
 			diff.Changes = plugin.DiffNone
 		}
 	}
+
+	// Mark, rather than remove, the changes that the resource's ignoreChanges option covers, so that the full diff is
+	// still displayed and recorded while the ignored changes do not affect how the resource is updated.
+	diff.DetailedDiff = plugin.MarkIgnoredDetailedDiff(diff.DetailedDiff, ignoreChanges)
+
+	// Record the shape of the detailed diff so that providers that produce very large diffs, which are expensive to
+	// render, can be identified.
+	if len(diff.DetailedDiff) > 0 {
+		logging.V(7).Infof("Diff(%s): detailed diff has %d entries with a maximum depth of %d",
+			urn, diff.DetailedDiffCount(), diff.DetailedDiffDepth())
+	}
+	logging.V(7).Infof("Diff(%s): %s", urn, diff.Verbose())
 	return diff, nil
 }
 
-// issueCheckErrors prints any check errors to the diagnostics error sink.
+// migrateState returns the outputs of the old state migrated to the provider's current state schema version, and
+// records the schema version of those outputs in the new state. The old state itself is left as it is, as it remains
+// the resource's recorded state until a step replaces it. Providers that do not report a schema version or cannot
+// migrate state leave the old outputs, and their schema version, as they are.
+func (sg *stepGenerator) migrateState(urn resource.URN, old, new *resource.State,
+	prov plugin.Provider) (resource.PropertyMap, error) {
+
+	var outputs resource.PropertyMap
+	if old != nil {
+		outputs = old.Outputs
+		new.SchemaVersion = old.SchemaVersion
+	}
+
+	version, err := prov.SchemaVersion(sg.ctx)
+	if err == plugin.ErrNotYetImplemented {
+		return outputs, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("getting the state schema version of %v: %w", urn, err)
+	}
+	if old == nil || old.SchemaVersion == version {
+		new.SchemaVersion = version
+		return outputs, nil
+	}
+
+	migrated, err := prov.MigrateState(sg.ctx, urn, old.SchemaVersion, old.Outputs)
+	if err == plugin.ErrNotYetImplemented {
+		logging.V(7).Infof("MigrateState(%s): provider cannot migrate state from schema version %d to %d",
+			urn, old.SchemaVersion, version)
+		return outputs, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("migrating the state of %v from schema version %d to %d: %w",
+			urn, old.SchemaVersion, version, err)
+	}
+	new.SchemaVersion = version
+	return migrated, nil
+}
+
+// providerAliases returns the URNs under which the given resource's provider declares that it may have been stored.
+// Providers that do not support the getResourceAliases feature are not asked, so that registering a resource does not
+// cost an extra call to every provider.
+func (sg *stepGenerator) providerAliases(urn resource.URN, prov plugin.Provider) ([]resource.URN, error) {
+	supported, has := sg.declaresAliases[prov]
+	if !has {
+		var err error
+		supported, err = prov.SupportsFeature(sg.ctx, plugin.FeatureGetResourceAliases)
+		if err != nil {
+			return nil, fmt.Errorf("checking whether the provider of %v declares aliases: %w", urn, err)
+		}
+		sg.declaresAliases[prov] = supported
+	}
+	if !supported {
+		return nil, nil
+	}
+
+	aliases, err := prov.GetResourceAliases(sg.ctx, urn)
+	if err == plugin.ErrNotYetImplemented {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("getting the aliases of %v: %w", urn, err)
+	}
+
+	urns := make([]resource.URN, len(aliases))
+	for i, alias := range aliases {
+		urns[i] = plugin.ResolveResourceAlias(urn, alias)
+	}
+	return urns, nil
+}
+
+// watchProvider asks the given provider to report changes to the live state of its resources, unless it has already
+// been asked to. Providers that cannot observe such changes are ignored.
+func (sg *stepGenerator) watchProvider(prov plugin.Provider) {
+	if sg.watched[prov] {
+		return
+	}
+	sg.watched[prov] = true
+
+	if err := prov.RegisterEventEmitter(sg.changes); err != nil {
+		logging.V(7).Infof("RegisterEventEmitter: provider does not report resource changes: %v", err)
+	}
+}
+
+// refreshState reads the live state of a resource whose provider has reported that it changed outside of this
+// deployment, given the resource's old outputs, and returns the refreshed outputs for the subsequent steps for this
+// resource to use in their place. The old state itself is left as it is. If the resource no longer exists, the old
+// outputs are returned as they are.
+func (sg *stepGenerator) refreshState(urn resource.URN, old *resource.State, oldOutputs resource.PropertyMap,
+	prov plugin.Provider) (resource.PropertyMap, error) {
+
+	refreshed, _, err := readNotFound(prov.Refresh(sg.ctx, urn, old.ID, old.Inputs, oldOutputs))
+	if err != nil {
+		return nil, fmt.Errorf("refreshing the state of %v: %w", urn, err)
+	}
+	if refreshed.Outputs == nil {
+		logging.V(7).Infof("Refresh(%s): resource no longer exists; using its old state", urn)
+		return oldOutputs, nil
+	}
+	return refreshed.Outputs, nil
+}
+
+// issueCheckErrors prints any check failures to the diagnostics sink: errors are reported as errors and warnings as
+// warnings. It returns true if any of the failures were errors.
 func issueCheckErrors(deployment *Deployment, new *resource.State, urn resource.URN,
 	failures []plugin.CheckFailure) bool {
-	return issueCheckFailures(deployment.Diag().Errorf, new, urn, failures)
+	return issueCheckFailures(deployment.Diag().Errorf, deployment.Diag().Warningf, new, urn, failures)
 }
 
-// issueCheckErrors prints any check errors to the given printer function.
-func issueCheckFailures(printf func(*diag.Diag, ...interface{}), new *resource.State, urn resource.URN,
+// issueCheckFailures prints any check failures using the given printer functions for errors and warnings. It returns
+// true if any of the failures were errors.
+func issueCheckFailures(errorf, warningf func(*diag.Diag, ...interface{}), new *resource.State, urn resource.URN,
 	failures []plugin.CheckFailure) bool {
 
 	if len(failures) == 0 {
@@ -1500,6 +1689,10 @@ func issueCheckFailures(printf func(*diag.Diag, ...interface{}), new *resource.S
 	}
 	inputs := new.Inputs
 	for _, failure := range failures {
+		printf := errorf
+		if !failure.IsError() {
+			printf = warningf
+		}
 		if failure.Property != "" {
 			printf(diag.GetResourcePropertyInvalidValueError(urn),
 				new.Type, urn.Name(), failure.Property, inputs[failure.Property], failure.Reason)
@@ -1508,7 +1701,7 @@ func issueCheckFailures(printf func(*diag.Diag, ...interface{}), new *resource.S
 				diag.GetResourceInvalidError(urn), new.Type, urn.Name(), failure.Reason)
 		}
 	}
-	return true
+	return plugin.HasCheckErrors(failures)
 }
 
 // processIgnoreChanges sets the value for each ignoreChanges property in inputs to the value from oldInputs.  This has
@@ -1637,7 +1830,7 @@ func applyReplaceOnChanges(diff plugin.DiffResult,
 					break
 				}
 			}
-			if changeToReplace {
+			if changeToReplace && !v.Ignored {
 				v = v.ToReplace()
 			}
 			modifiedDiff[p] = v
@@ -1774,11 +1967,11 @@ func (sg *stepGenerator) calculateDependentReplacements(root *resource.State) ([
 		contract.Assert(prov != nil)
 
 		// Call the provider's `Diff` method and return.
-		diff, err := prov.Diff(r.URN, r.ID, r.Outputs, inputsForDiff, true, nil)
+		diff, err := prov.Diff(sg.ctx, r.URN, r.ID, r.Outputs, inputsForDiff, true, nil)
 		if err != nil {
 			return false, nil, result.FromError(err)
 		}
-		return diff.Replace(), diff.ReplaceKeys, nil
+		return diff.RequiresReplacement(), diff.ReplaceKeys, nil
 	}
 
 	// Walk the root resource's dependents in order and build up the set of resources that require replacement.
@@ -1873,10 +2066,11 @@ func (sg *stepGenerator) AnalyzeResources() result.Result {
 }
 
 // newStepGenerator creates a new step generator that operates on the given deployment.
-func newStepGenerator(
+func newStepGenerator(ctx context.Context,
 	deployment *Deployment, opts Options, updateTargetsOpt, replaceTargetsOpt map[resource.URN]bool) *stepGenerator {
 
 	return &stepGenerator{
+		ctx:                  plugin.ContextWithDiagnosticReporter(ctx, deployment.reportDiagnostic),
 		deployment:           deployment,
 		opts:                 opts,
 		updateTargetsOpt:     updateTargetsOpt,
@@ -1894,5 +2088,8 @@ func newStepGenerator(
 		dependentReplaceKeys: make(map[resource.URN][]resource.PropertyKey),
 		aliased:              make(map[resource.URN]resource.URN),
 		aliases:              make(map[resource.URN]resource.URN),
+		changes:              newResourceChanges(),
+		watched:              make(map[plugin.Provider]bool),
+		declaresAliases:      make(map[plugin.Provider]bool),
 	}
 }
@@ -15,6 +15,7 @@
 package deploy
 
 import (
+	"context"
 	"testing"
 
 	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/deploytest"
@@ -304,7 +305,7 @@ func TestEngineDiff(t *testing.T) {
 		t.Run(c.name, func(t *testing.T) {
 			t.Parallel()
 
-			diff, err := diffResource(urn, id, c.oldInputs, oldOutputs, c.newInputs, &provider, allowUnknowns, c.ignoreChanges)
+			diff, err := diffResource(context.Background(), urn, id, c.oldInputs, oldOutputs, c.newInputs, &provider, allowUnknowns, c.ignoreChanges)
 			t.Logf("diff.ChangedKeys = %v", diff.ChangedKeys)
 			t.Logf("diff.StableKeys = %v", diff.StableKeys)
 			t.Logf("diff.ReplaceKeys = %v", diff.ReplaceKeys)
@@ -314,3 +315,31 @@ func TestEngineDiff(t *testing.T) {
 		})
 	}
 }
+
+func TestEngineDiffMarksIgnoredChanges(t *testing.T) {
+	t.Parallel()
+
+	urn := resource.URN("urn:pulumi:dev::website-and-lambda::aws:s3/bucket:Bucket::my-bucket")
+	provider := deploytest.Provider{
+		DiffF: func(urn resource.URN, id resource.ID, olds, news resource.PropertyMap,
+			ignoreChanges []string) (plugin.DiffResult, error) {
+			return plugin.DiffResult{
+				Changes: plugin.DiffSome,
+				DetailedDiff: map[string]plugin.PropertyDiff{
+					"tags.Name": {Kind: plugin.DiffUpdateReplace},
+					"size":      {Kind: plugin.DiffUpdate},
+				},
+			}, nil
+		},
+	}
+
+	diff, err := diffResource(context.Background(), urn, "someid", nil, nil, resource.PropertyMap{}, &provider, false, []string{"tags"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]plugin.PropertyDiff{
+		"tags.Name": {Kind: plugin.DiffUpdateReplace, Ignored: true},
+		"size":      {Kind: plugin.DiffUpdate},
+	}, diff.DetailedDiff)
+
+	// The ignored change is kept, but does not cause the resource to be replaced.
+	assert.False(t, diff.RequiresReplacement())
+}
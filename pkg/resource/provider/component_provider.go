@@ -165,6 +165,97 @@ func (p *componentProvider) Read(ctx context.Context, req *pulumirpc.ReadRequest
 	return nil, status.Error(codes.Unimplemented, "Read is not yet implemented")
 }
 
+// Refresh reads the current live state associated with a resource as part of a refresh.
+func (p *componentProvider) Refresh(ctx context.Context, req *pulumirpc.ReadRequest) (*pulumirpc.ReadResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "Refresh is not yet implemented")
+}
+
+// MigrateState upgrades the stored state of a resource to the provider's current state schema version.
+func (p *componentProvider) MigrateState(ctx context.Context,
+	req *pulumirpc.MigrateStateRequest) (*pulumirpc.MigrateStateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "MigrateState is not yet implemented")
+}
+
+// GetResourceAliases returns the URNs that a resource may previously have been stored under.
+func (p *componentProvider) GetResourceAliases(ctx context.Context,
+	req *pulumirpc.GetResourceAliasesRequest) (*pulumirpc.GetResourceAliasesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "GetResourceAliases is not yet implemented")
+}
+
+// WatchConfig streams the provider's configuration each time it changes outside of Pulumi. Component providers do
+// not observe such changes.
+func (p *componentProvider) WatchConfig(_ *pbempty.Empty, server pulumirpc.ResourceProvider_WatchConfigServer) error {
+	return status.Error(codes.Unimplemented, "WatchConfig is not yet implemented")
+}
+
+// ValidateProvider runs the provider's pre-flight checks. Component providers have no credentials to check.
+func (p *componentProvider) ValidateProvider(context.Context, *pbempty.Empty) (*pbempty.Empty, error) {
+	return &pbempty.Empty{}, nil
+}
+
+// GetSupportedVersions returns the schema versions that GetSchema can serve.
+func (p *componentProvider) GetSupportedVersions(ctx context.Context,
+	req *pbempty.Empty) (*pulumirpc.GetSupportedVersionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "GetSupportedVersions is not yet implemented")
+}
+
+// ParameterizeByValue parameterizes the provider with an opaque value.
+func (p *componentProvider) ParameterizeByValue(ctx context.Context,
+	req *pulumirpc.ParameterizeByValueRequest) (*pulumirpc.ParameterizeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "ParameterizeByValue is not yet implemented")
+}
+
+// ParameterizeByReference parameterizes the provider with a package that it resolves itself.
+func (p *componentProvider) ParameterizeByReference(ctx context.Context,
+	req *pulumirpc.ParameterizeByReferenceRequest) (*pulumirpc.ParameterizeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "ParameterizeByReference is not yet implemented")
+}
+
+// PrepareImport returns the inputs required to import a resource.
+func (p *componentProvider) PrepareImport(ctx context.Context,
+	req *pulumirpc.PrepareImportRequest) (*pulumirpc.PrepareImportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "PrepareImport is not yet implemented")
+}
+
+// ConfigChecksumMatch reports whether a config checksum matches the last validated config.
+func (p *componentProvider) ConfigChecksumMatch(ctx context.Context,
+	req *pulumirpc.ConfigChecksumMatchRequest) (*pulumirpc.ConfigChecksumMatchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "ConfigChecksumMatch is not yet implemented")
+}
+
+// WaitForResourceReady blocks until a newly created resource is ready to be used.
+func (p *componentProvider) WaitForResourceReady(ctx context.Context,
+	req *pulumirpc.WaitForResourceReadyRequest) (*pbempty.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "WaitForResourceReady is not yet implemented")
+}
+
+// EstimateCost estimates the monthly cost of running a resource.
+func (p *componentProvider) EstimateCost(ctx context.Context,
+	req *pulumirpc.EstimateCostRequest) (*pulumirpc.EstimateCostResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "EstimateCost is not yet implemented")
+}
+
+// StreamCreate allocates a new instance of the provided resource, streaming interim states back as a series of
+// messages.
+func (p *componentProvider) StreamCreate(req *pulumirpc.CreateRequest,
+	server pulumirpc.ResourceProvider_StreamCreateServer) error {
+	return status.Error(codes.Unimplemented, "StreamCreate is not yet implemented")
+}
+
+// WatchResourceChanges streams notifications of changes to the live state of resources. Component providers do not
+// observe such changes.
+func (p *componentProvider) WatchResourceChanges(_ *pbempty.Empty,
+	server pulumirpc.ResourceProvider_WatchResourceChangesServer) error {
+	return status.Error(codes.Unimplemented, "WatchResourceChanges is not yet implemented")
+}
+
+// ReadStream reads the current live state associated with a resource, streaming interim states back as a series of
+// messages.
+func (p *componentProvider) ReadStream(req *pulumirpc.ReadRequest,
+	server pulumirpc.ResourceProvider_ReadStreamServer) error {
+	return status.Error(codes.Unimplemented, "ReadStream is not yet implemented")
+}
+
 // Update updates an existing resource with new values.
 func (p *componentProvider) Update(ctx context.Context,
 	req *pulumirpc.UpdateRequest) (*pulumirpc.UpdateResponse, error) {
@@ -192,6 +283,20 @@ func (p *componentProvider) Call(ctx context.Context,
 	return nil, status.Error(codes.Unimplemented, "Call is not yet implemented")
 }
 
+// GetMapping fetches the conversion mapping (if any) for this resource provider. Component providers have no
+// mappings, so this always returns an empty response.
+func (p *componentProvider) GetMapping(context.Context,
+	*pulumirpc.GetMappingRequest) (*pulumirpc.GetMappingResponse, error) {
+	return &pulumirpc.GetMappingResponse{}, nil
+}
+
+// SupportsFeature returns true if this resource provider supports the given feature. Component providers do not
+// advertise any optional features.
+func (p *componentProvider) SupportsFeature(context.Context,
+	*pulumirpc.ProviderSupportsFeatureRequest) (*pulumirpc.ProviderSupportsFeatureResponse, error) {
+	return &pulumirpc.ProviderSupportsFeatureResponse{}, nil
+}
+
 // Cancel signals the provider to gracefully shut down and abort any ongoing resource operations.
 // Operations aborted in this way will return an error (e.g., `Update` and `Create` will either a
 // creation error or an initialization error). Since Cancel is advisory and non-blocking, it is up
@@ -329,6 +329,7 @@ func SerializeResource(res *resource.State, enc config.Encrypter, showSecrets bo
 		Aliases:                 res.Aliases,
 		ImportID:                res.ImportID,
 		RetainOnDelete:          res.RetainOnDelete,
+		SchemaVersion:           res.SchemaVersion,
 	}
 
 	if res.CustomTimeouts.IsNotEmpty() {
@@ -353,7 +354,7 @@ func SerializeOperation(op resource.Operation, enc config.Encrypter, showSecrets
 func SerializeProperties(props resource.PropertyMap, enc config.Encrypter,
 	showSecrets bool) (map[string]interface{}, error) {
 	dst := make(map[string]interface{})
-	for _, k := range props.StableKeys() {
+	for _, k := range props.Keys() {
 		v, err := SerializePropertyValue(props[k], enc, showSecrets)
 		if err != nil {
 			return nil, err
@@ -508,11 +509,13 @@ func DeserializeResource(res apitype.ResourceV3, dec config.Decrypter, enc confi
 		return nil, fmt.Errorf("resource '%s' has 'custom' false but non-empty ID", res.URN)
 	}
 
-	return resource.NewState(
+	state := resource.NewState(
 		res.Type, res.URN, res.Custom, res.Delete, res.ID,
 		inputs, outputs, res.Parent, res.Protect, res.External, res.Dependencies, res.InitErrors, res.Provider,
 		res.PropertyDependencies, res.PendingReplacement, res.AdditionalSecretOutputs, res.Aliases, res.CustomTimeouts,
-		res.ImportID, res.RetainOnDelete), nil
+		res.ImportID, res.RetainOnDelete)
+	state.SchemaVersion = res.SchemaVersion
+	return state, nil
 }
 
 func DeserializeOperation(op apitype.OperationV2, dec config.Decrypter,
@@ -326,6 +326,8 @@ type ResourceV3 struct {
 	ImportID resource.ID `json:"importID,omitempty" yaml:"importID,omitempty"`
 	// If set to True, the providers Delete method will not be called for this resource. Pulumi simply stops tracking the deleted resource.
 	RetainOnDelete bool `json:"retainOnDelete,omitempty" yaml:"retainOnDelete,omitempty"`
+	// SchemaVersion is the version of the provider's state schema that the resource's outputs were written with.
+	SchemaVersion int `json:"schemaVersion,omitempty" yaml:"schemaVersion,omitempty"`
 }
 
 // ManifestV1 captures meta-information about this checkpoint file, such as versions of binaries, etc.
@@ -106,6 +106,10 @@ type PropertyDiff struct {
 	Kind DiffKind `json:"diffKind"`
 	// InputDiff is true if this is a difference between old and new inputs rather than old state and new inputs.
 	InputDiff bool `json:"inputDiff"`
+	// Reason is an optional, human-readable explanation of the difference, e.g. why it requires replacement.
+	Reason string `json:"reason,omitempty"`
+	// Ignored is true if the property is covered by the resource's ignoreChanges option.
+	Ignored bool `json:"ignored,omitempty"`
 }
 
 // StepEventMetadata describes a "step" within the Pulumi engine, which is any concrete action
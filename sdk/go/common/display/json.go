@@ -53,6 +53,10 @@ type PropertyDiff struct {
 	Kind string `json:"kind"`
 	// InputDiff is true if this is a difference between old and new inputs instead of old state and new inputs.
 	InputDiff bool `json:"inputDiff"`
+	// Reason is an optional, human-readable explanation of the difference, e.g. why it requires replacement.
+	Reason string `json:"reason,omitempty"`
+	// Ignored is true if the property is covered by the resource's ignoreChanges option.
+	Ignored bool `json:"ignored,omitempty"`
 }
 
 // PreviewStep is a detailed overview of a step the engine intends to take.
@@ -2,7 +2,6 @@ package resource
 
 import (
 	"fmt"
-	"strings"
 )
 
 type Alias struct {
@@ -33,11 +32,11 @@ func CreateURN(name string, t string, parent URN, project string, stack string)
 		if parent == "" {
 			parentPrefix = "urn:pulumi:" + stack + "::" + project + "::"
 		} else {
-			ix := strings.LastIndex(parentString, "::")
-			if ix == -1 {
-				panic(fmt.Sprintf("Expected 'parent' string '%s' to contain '::'", parent))
+			c, err := ParseURN(parentString)
+			if err != nil {
+				panic(fmt.Sprintf("Expected 'parent' string '%s' to be a valid URN: %v", parent, err))
 			}
-			parentPrefix = parentString[0:ix] + "$"
+			parentPrefix = parentString[:len(parentString)-len(URNNameDelimiter)-len(c.Name)] + URNTypeDelimiter
 		}
 		return URN(parentPrefix + t + "::" + name)
 	}
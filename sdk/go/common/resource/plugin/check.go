@@ -15,6 +15,7 @@
 package plugin
 
 import (
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/mapper"
 	lumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
 )
@@ -42,3 +43,60 @@ func NewCheckResponse(err error) *lumirpc.CheckResponse {
 	}
 	return &lumirpc.CheckResponse{Failures: failures}
 }
+
+// MarshalCheckFailures converts check failures into their gRPC representation.
+func MarshalCheckFailures(failures []CheckFailure) []*lumirpc.CheckFailure {
+	if len(failures) == 0 {
+		return nil
+	}
+	rpcFailures := make([]*lumirpc.CheckFailure, len(failures))
+	for i, f := range failures {
+		severity := lumirpc.CheckFailure_ERROR
+		if f.Severity == CheckSeverityWarning {
+			severity = lumirpc.CheckFailure_WARNING
+		}
+		rpcFailures[i] = &lumirpc.CheckFailure{
+			Property: string(f.Property),
+			Reason:   f.Reason,
+			Severity: severity,
+			Code:     f.Code,
+		}
+		if f.Range != nil {
+			rpcFailures[i].Range = &lumirpc.CheckFailure_SourceRange{
+				File:   f.Range.File,
+				Line:   int32(f.Range.Line),
+				Column: int32(f.Range.Column),
+			}
+		}
+	}
+	return rpcFailures
+}
+
+// UnmarshalCheckFailures converts check failures from their gRPC representation. Unrecognized severities are treated
+// as errors.
+func UnmarshalCheckFailures(rpcFailures []*lumirpc.CheckFailure) []CheckFailure {
+	if len(rpcFailures) == 0 {
+		return nil
+	}
+	failures := make([]CheckFailure, len(rpcFailures))
+	for i, f := range rpcFailures {
+		severity := CheckSeverityError
+		if f.GetSeverity() == lumirpc.CheckFailure_WARNING {
+			severity = CheckSeverityWarning
+		}
+		failures[i] = CheckFailure{
+			Property: resource.PropertyKey(f.GetProperty()),
+			Reason:   f.GetReason(),
+			Severity: severity,
+			Code:     f.GetCode(),
+		}
+		if r := f.GetRange(); r != nil {
+			failures[i].Range = &SourceRange{
+				File:   r.GetFile(),
+				Line:   int(r.GetLine()),
+				Column: int(r.GetColumn()),
+			}
+		}
+	}
+	return failures
+}
@@ -67,10 +67,11 @@ func NewContext(d, statusD diag.Sink, host Host, _ ConfigSource,
 		disableProviderPreview, parentSpan, plugins)
 }
 
-// Variation of NewContext that also sets known project Root. Additionally accepts Plugins
+// Variation of NewContext that also sets known project Root. Additionally accepts Plugins, and options for the default
+// host that is created if host is nil.
 func NewContextWithRoot(d, statusD diag.Sink, host Host,
 	pwd, root string, runtimeOptions map[string]interface{}, disableProviderPreview bool,
-	parentSpan opentracing.Span, plugins *workspace.Plugins) (*Context, error) {
+	parentSpan opentracing.Span, plugins *workspace.Plugins, opts ...HostOption) (*Context, error) {
 
 	if d == nil {
 		d = diag.DefaultSink(ioutil.Discard, ioutil.Discard, diag.FormatOptions{Color: colors.Never})
@@ -87,7 +88,7 @@ func NewContextWithRoot(d, statusD diag.Sink, host Host,
 		tracingSpan: parentSpan,
 	}
 	if host == nil {
-		h, err := NewDefaultHost(ctx, runtimeOptions, disableProviderPreview, plugins)
+		h, err := NewDefaultHost(ctx, runtimeOptions, disableProviderPreview, plugins, opts...)
 		if err != nil {
 			return nil, err
 		}
@@ -102,7 +103,16 @@ func (ctx *Context) Request() context.Context {
 	if c == nil {
 		c = context.Background()
 	}
-	c = opentracing.ContextWithSpan(c, ctx.tracingSpan)
+	return ctx.RequestWithContext(c)
+}
+
+// RequestWithContext allocates a request sub-context derived from the given context. The result is canceled when
+// either the given context is done or this plugin context is closed. If the given context does not already carry a
+// tracing span, the plugin context's span is used as the parent for the request.
+func (ctx *Context) RequestWithContext(c context.Context) context.Context {
+	if opentracing.SpanFromContext(c) == nil {
+		c = opentracing.ContextWithSpan(c, ctx.tracingSpan)
+	}
 	c, cancel := context.WithCancel(c)
 	ctx.cancelFuncs = append(ctx.cancelFuncs, cancel)
 	return c
@@ -0,0 +1,59 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// DependencyTracker computes the OutputDependencies of a ConstructResult from the dependencies of a component's
+// inputs. Component authors record which inputs each output is derived from with Track, and then call Resolve with
+// the input dependencies passed to Construct (ConstructOptions.PropertyDependencies) to produce the resources that
+// each output depends on. The zero value is ready to use.
+type DependencyTracker struct {
+	outputs map[resource.PropertyKey][]resource.PropertyKey
+}
+
+// Track records that the output with the given key is derived from the inputs with the given keys. Calling Track more
+// than once for the same output adds to its inputs. An output that is tracked with no inputs has no dependencies.
+func (t *DependencyTracker) Track(outputKey resource.PropertyKey, inputKeys ...resource.PropertyKey) {
+	if t.outputs == nil {
+		t.outputs = map[resource.PropertyKey][]resource.PropertyKey{}
+	}
+	t.outputs[outputKey] = append(t.outputs[outputKey], inputKeys...)
+}
+
+// Resolve returns a map from each tracked output to the resources that its inputs depend on, according to the given
+// input dependencies. Each output's dependencies are listed once each, in the order in which they are first reached
+// through its inputs. Inputs that are missing from inputDependencies contribute no dependencies.
+func (t *DependencyTracker) Resolve(
+	inputDependencies map[resource.PropertyKey][]resource.URN) map[resource.PropertyKey][]resource.URN {
+
+	result := make(map[resource.PropertyKey][]resource.URN, len(t.outputs))
+	for output, inputs := range t.outputs {
+		seen := map[resource.URN]bool{}
+		var deps []resource.URN
+		for _, input := range inputs {
+			for _, dep := range inputDependencies[input] {
+				if !seen[dep] {
+					seen[dep] = true
+					deps = append(deps, dep)
+				}
+			}
+		}
+		result[output] = deps
+	}
+	return result
+}
@@ -0,0 +1,54 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+func TestDependencyTracker(t *testing.T) {
+	t.Parallel()
+
+	vpc := resource.URN("urn:pulumi:stack::project::aws:ec2/vpc:Vpc::vpc")
+	subnet := resource.URN("urn:pulumi:stack::project::aws:ec2/subnet:Subnet::subnet")
+	role := resource.URN("urn:pulumi:stack::project::aws:iam/role:Role::role")
+
+	var tracker DependencyTracker
+	tracker.Track("clusterId", "vpcId", "subnetIds")
+	tracker.Track("clusterId", "roleArn")
+	tracker.Track("endpoint", "subnetIds", "vpcId")
+	tracker.Track("name", "unknownInput")
+	tracker.Track("version")
+
+	deps := tracker.Resolve(map[resource.PropertyKey][]resource.URN{
+		"vpcId":     {vpc},
+		"subnetIds": {subnet, vpc},
+		"roleArn":   {role},
+	})
+	assert.Equal(t, map[resource.PropertyKey][]resource.URN{
+		"clusterId": {vpc, subnet, role},
+		"endpoint":  {subnet, vpc},
+		"name":      nil,
+		"version":   nil,
+	}, deps)
+
+	// An empty tracker resolves to no outputs.
+	var empty DependencyTracker
+	assert.Empty(t, empty.Resolve(map[resource.PropertyKey][]resource.URN{"vpcId": {vpc}}))
+}
@@ -67,6 +67,10 @@ type Host interface {
 	Provider(pkg tokens.Package, version *semver.Version) (Provider, error)
 	// CloseProvider closes the given provider plugin and deregisters it from this host.
 	CloseProvider(provider Provider) error
+	// RegisterFactory registers a factory that creates providers for the given package in place of launching a
+	// provider plugin. If version is empty, the factory is used for any version of the package that does not have a
+	// factory of its own. The factory is not invoked until a provider for the package is first requested.
+	RegisterFactory(pkg tokens.Package, version string, f ProviderFactoryFunc) error
 	// LanguageRuntime fetches the language runtime plugin for a given language, lazily allocating if necessary.  If
 	// an implementation of this language runtime wasn't found, on an error occurs, a non-nil error is returned.
 	LanguageRuntime(runtime string) (LanguageRuntime, error)
@@ -93,9 +97,60 @@ type Host interface {
 	Close() error
 }
 
+// ProviderFactoryFunc creates a provider for the given package and version. The version is empty if no particular
+// version of the provider was requested.
+type ProviderFactoryFunc func(pkg tokens.Package, version string) (Provider, error)
+
+// HostOption configures optional behavior of the host returned by NewDefaultHost.
+type HostOption func(host *defaultHost)
+
+// WithRetryPolicy makes the host recover from provider plugins that crash or drop their connection. When a call to a
+// provider fails with a connection error, the host relaunches the provider and configures it as before. Calls that
+// have no side effects, such as Check, Diff, Read and Invoke, are then retried, up to the policy's maximum number of
+// attempts; any other call fails with the connection error, since it may have taken effect before the connection was
+// lost.
+func WithRetryPolicy(policy RetryPolicy) HostOption {
+	return func(host *defaultHost) {
+		host.retryPolicy = &policy
+	}
+}
+
+// WithConcurrencyLimit bounds the number of resource operations and invokes that each provider loaded by the host may
+// have in flight at once. Calls beyond the limit wait for an earlier call to finish. A limit of zero or less means that
+// calls are not limited. WithPerProviderConcurrencyLimit overrides this limit for particular packages.
+func WithConcurrencyLimit(n int) HostOption {
+	return func(host *defaultHost) {
+		host.concurrencyLimit = n
+	}
+}
+
+// WithPerProviderConcurrencyLimit bounds the number of resource operations and invokes that each provider for the
+// given package may have in flight at once, in place of any limit set by WithConcurrencyLimit. A limit of zero or less
+// means that calls to the package's providers are not limited.
+func WithPerProviderConcurrencyLimit(pkg tokens.Package, n int) HostOption {
+	return func(host *defaultHost) {
+		if host.providerConcurrencyLimits == nil {
+			host.providerConcurrencyLimits = map[tokens.Package]int{}
+		}
+		host.providerConcurrencyLimits[pkg] = n
+	}
+}
+
+// WithProviderVersionConstraint requires that the providers the host loads for the given package have a version that
+// satisfies the given semver constraint, such as ">=3.0.0, <4.0.0". Loading a provider whose version does not satisfy
+// the constraint fails before any operations are sent to it. Providers that do not report a version are not checked.
+func WithProviderVersionConstraint(pkg tokens.Package, constraint string) HostOption {
+	return func(host *defaultHost) {
+		if host.providerVersionConstraints == nil {
+			host.providerVersionConstraints = map[tokens.Package]string{}
+		}
+		host.providerVersionConstraints[pkg] = constraint
+	}
+}
+
 // NewDefaultHost implements the standard plugin logic, using the standard installation root to find them.
 func NewDefaultHost(ctx *Context, runtimeOptions map[string]interface{},
-	disableProviderPreview bool, plugins *workspace.Plugins) (Host, error) {
+	disableProviderPreview bool, plugins *workspace.Plugins, opts ...HostOption) (Host, error) {
 	// Create plugin info from providers
 	projectPlugins := make([]workspace.ProjectPlugin, 0)
 	if plugins != nil {
@@ -129,12 +184,16 @@ func NewDefaultHost(ctx *Context, runtimeOptions map[string]interface{},
 		languagePlugins:         make(map[string]*languagePlugin),
 		resourcePlugins:         make(map[Provider]*resourcePlugin),
 		reportedResourcePlugins: make(map[string]struct{}),
+		providerFactories:       make(map[string]ProviderFactoryFunc),
 		languageLoadRequests:    make(chan pluginLoadRequest),
 		loadRequests:            make(chan pluginLoadRequest),
 		disableProviderPreview:  disableProviderPreview,
 		closer:                  new(sync.Once),
 		projectPlugins:          projectPlugins,
 	}
+	for _, opt := range opts {
+		opt(host)
+	}
 
 	// Fire up a gRPC server to listen for requests.  This acts as a RPC interface that plugins can use
 	// to "phone home" in case there are things the host must do on behalf of the plugins (like log, etc).
@@ -211,6 +270,12 @@ type defaultHost struct {
 	loadRequests            chan pluginLoadRequest           // a channel used to satisfy plugin load requests.
 	server                  *hostServer                      // the server's RPC machinery.
 	disableProviderPreview  bool                             // true if provider plugins should disable provider preview
+	retryPolicy             *RetryPolicy                     // if non-nil, how to recover from provider crashes.
+	providerFactories       map[string]ProviderFactoryFunc   // factories for providers that are not plugins.
+
+	concurrencyLimit           int                       // if positive, the maximum concurrent calls to each provider.
+	providerConcurrencyLimits  map[tokens.Package]int    // per-package overrides of concurrencyLimit.
+	providerVersionConstraints map[tokens.Package]string // per-package constraints on the versions of providers.
 
 	closer         *sync.Once
 	projectPlugins []workspace.ProjectPlugin
@@ -328,9 +393,9 @@ func (host *defaultHost) ListAnalyzers() []Analyzer {
 func (host *defaultHost) Provider(pkg tokens.Package, version *semver.Version) (Provider, error) {
 	plugin, err := loadPlugin(host.loadRequests, func() (interface{}, error) {
 		// Try to load and bind to a plugin.
-		plug, err := NewProvider(host, host.ctx, pkg, version, host.runtimeOptions, host.disableProviderPreview)
+		plug, err := host.newProvider(pkg, version)
 		if err == nil && plug != nil {
-			info, infoerr := plug.GetPluginInfo()
+			info, infoerr := plug.GetPluginInfo(host.ctx.Request())
 			if infoerr != nil {
 				return nil, infoerr
 			}
@@ -350,6 +415,14 @@ func (host *defaultHost) Provider(pkg tokens.Package, version *semver.Version) (
 				}
 			}
 
+			// Fail if the plugin version does not satisfy the package's version constraint.
+			if constraint, has := host.providerVersionConstraints[pkg]; has && info.Version != nil {
+				if err := CheckVersionConstraint(info.Version.String(), constraint); err != nil {
+					contract.IgnoreError(plug.Close())
+					return nil, fmt.Errorf("resource plugin %s: %w", info.Name, err)
+				}
+			}
+
 			// Record the result and add the plugin's info to our list of loaded plugins if it's the first copy of its
 			// kind.
 			key := info.Name
@@ -360,6 +433,14 @@ func (host *defaultHost) Provider(pkg tokens.Package, version *semver.Version) (
 			if !alreadyReported {
 				host.reportedResourcePlugins[key] = struct{}{}
 			}
+			if host.retryPolicy != nil {
+				plug = newRestartingProvider(plug, *host.retryPolicy, func() (Provider, error) {
+					return host.relaunchProvider(pkg, version)
+				})
+			}
+			if limit := host.providerConcurrencyLimit(pkg); limit > 0 {
+				plug = newLimitingProvider(plug, limit)
+			}
 			host.resourcePlugins[plug] = &resourcePlugin{Plugin: plug, Info: info}
 		}
 
@@ -371,6 +452,71 @@ func (host *defaultHost) Provider(pkg tokens.Package, version *semver.Version) (
 	return plugin.(Provider), nil
 }
 
+// providerConcurrencyLimit returns the maximum number of concurrent calls to allow to a provider for the given
+// package, or zero if calls should not be limited.
+func (host *defaultHost) providerConcurrencyLimit(pkg tokens.Package) int {
+	if limit, has := host.providerConcurrencyLimits[pkg]; has {
+		return limit
+	}
+	return host.concurrencyLimit
+}
+
+// relaunchProvider launches a new instance of a provider plugin to replace one that has crashed.
+func (host *defaultHost) relaunchProvider(pkg tokens.Package, version *semver.Version) (Provider, error) {
+	plugin, err := loadPlugin(host.loadRequests, func() (interface{}, error) {
+		return host.newProvider(pkg, version)
+	})
+	if plugin == nil || err != nil {
+		return nil, err
+	}
+	return plugin.(Provider), nil
+}
+
+// providerFactoryKey returns the key under which a factory for the given package and version is registered.
+func providerFactoryKey(pkg tokens.Package, version string) string {
+	if version == "" {
+		return string(pkg)
+	}
+	return string(pkg) + "@" + version
+}
+
+func (host *defaultHost) RegisterFactory(pkg tokens.Package, version string, f ProviderFactoryFunc) error {
+	contract.Requiref(f != nil, "f", "must not be nil")
+
+	if version != "" {
+		v, err := semver.ParseTolerant(version)
+		if err != nil {
+			return fmt.Errorf("invalid version for provider factory %s: %w", pkg, err)
+		}
+		version = v.String()
+	}
+
+	// Registrations are serialized with loads so that the factories need no locking of their own.
+	_, err := loadPlugin(host.loadRequests, func() (interface{}, error) {
+		host.providerFactories[providerFactoryKey(pkg, version)] = f
+		return nil, nil
+	})
+	return err
+}
+
+// newProvider creates a new provider for the given package, using a registered factory if there is one and launching
+// the provider's plugin otherwise. This must only be called from the loader goroutine.
+func (host *defaultHost) newProvider(pkg tokens.Package, version *semver.Version) (Provider, error) {
+	var v string
+	if version != nil {
+		v = version.String()
+	}
+	f, ok := host.providerFactories[providerFactoryKey(pkg, v)]
+	if !ok {
+		f, ok = host.providerFactories[providerFactoryKey(pkg, "")]
+	}
+	if ok {
+		logging.V(9).Infof("newProvider(%s, %s): using registered provider factory", pkg, v)
+		return f(pkg, v)
+	}
+	return NewProvider(host, host.ctx, pkg, version, host.runtimeOptions, host.disableProviderPreview)
+}
+
 func (host *defaultHost) LanguageRuntime(runtime string) (LanguageRuntime, error) {
 	// Language runtimes use their own loading channel not the main one
 	plugin, err := loadPlugin(host.languageLoadRequests, func() (interface{}, error) {
@@ -472,7 +618,7 @@ func (host *defaultHost) SignalCancellation() error {
 	_, err := loadPlugin(host.loadRequests, func() (interface{}, error) {
 		var result error
 		for _, plug := range host.resourcePlugins {
-			if err := plug.Plugin.SignalCancellation(); err != nil {
+			if err := plug.Plugin.SignalCancellation(host.ctx.Request()); err != nil {
 				result = multierror.Append(result, errors.Wrapf(err,
 					"Error signaling cancellation to resource provider '%s'", plug.Info.Name))
 			}
@@ -0,0 +1,124 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/blang/semver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+type factoryProvider struct {
+	Provider
+
+	pkg     tokens.Package
+	version string
+	closed  bool
+}
+
+func (p *factoryProvider) Pkg() tokens.Package {
+	return p.pkg
+}
+
+func (p *factoryProvider) GetPluginInfo(context.Context) (workspace.PluginInfo, error) {
+	info := workspace.PluginInfo{Name: string(p.pkg), Kind: workspace.ResourcePlugin}
+	if p.version != "" {
+		v := semver.MustParse(p.version)
+		info.Version = &v
+	}
+	return info, nil
+}
+
+func (p *factoryProvider) Close() error {
+	p.closed = true
+	return nil
+}
+
+func TestRegisterFactory(t *testing.T) {
+	t.Parallel()
+
+	ctx, err := NewContext(nil, nil, nil, nil, t.TempDir(), nil, false, nil)
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, ctx.Close()) }()
+
+	var calls []string
+	factory := func(pkg tokens.Package, version string) (Provider, error) {
+		calls = append(calls, string(pkg)+"@"+version)
+		return &factoryProvider{pkg: pkg, version: version}, nil
+	}
+	require.NoError(t, ctx.Host.RegisterFactory("pkgA", "", factory))
+	require.NoError(t, ctx.Host.RegisterFactory("pkgA", "v2.0.0", factory))
+	assert.Error(t, ctx.Host.RegisterFactory("pkgA", "not-a-version", factory))
+
+	// Factories are not invoked until a provider is requested.
+	assert.Empty(t, calls)
+
+	v1, v2 := semver.MustParse("1.0.0"), semver.MustParse("2.0.0")
+	prov, err := ctx.Host.Provider("pkgA", &v2)
+	require.NoError(t, err)
+	assert.Equal(t, "2.0.0", prov.(*factoryProvider).version)
+
+	// Versions without a factory of their own fall back to the unversioned factory.
+	_, err = ctx.Host.Provider("pkgA", &v1)
+	require.NoError(t, err)
+	_, err = ctx.Host.Provider("pkgA", nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"pkgA@2.0.0", "pkgA@1.0.0", "pkgA@"}, calls)
+
+	require.NoError(t, ctx.Host.CloseProvider(prov))
+	assert.True(t, prov.(*factoryProvider).closed)
+}
+
+func TestProviderVersionConstraint(t *testing.T) {
+	t.Parallel()
+
+	ctx, err := NewContext(nil, nil, nil, nil, t.TempDir(), nil, false, nil)
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, ctx.Close()) }()
+
+	host, err := NewDefaultHost(ctx, nil, false, nil, WithProviderVersionConstraint("pkgA", ">=3.0.0, <4.0.0"))
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, host.Close()) }()
+
+	var provs []*factoryProvider
+	require.NoError(t, host.RegisterFactory("pkgA", "", func(pkg tokens.Package, version string) (Provider, error) {
+		prov := &factoryProvider{pkg: pkg, version: version}
+		provs = append(provs, prov)
+		return prov, nil
+	}))
+	require.NoError(t, host.RegisterFactory("pkgB", "", func(pkg tokens.Package, version string) (Provider, error) {
+		return &factoryProvider{pkg: pkg, version: version}, nil
+	}))
+
+	v3, v4 := semver.MustParse("3.1.0"), semver.MustParse("4.0.0")
+	_, err = host.Provider("pkgA", &v3)
+	assert.NoError(t, err)
+
+	// A provider that does not satisfy the constraint is closed and not returned.
+	_, err = host.Provider("pkgA", &v4)
+	assert.EqualError(t, err, `resource plugin pkgA: version 4.0.0 does not satisfy constraint ">=3.0.0, <4.0.0"`)
+	require.Len(t, provs, 2)
+	assert.True(t, provs[1].closed)
+
+	// Other packages are not constrained.
+	_, err = host.Provider("pkgB", &v4)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,118 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package metrics instruments providers with Prometheus metrics.
+package metrics
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+)
+
+// methodLabel is the label that identifies the provider method that a metric describes.
+const methodLabel = "method"
+
+// MetricsProvider wraps the given provider so that every call made to it is recorded in Prometheus metrics registered
+// with the default registerer:
+//
+//   - pulumi_provider_calls_total counts the calls made to each method;
+//   - pulumi_provider_call_errors_total counts the calls to each method that returned an error;
+//   - pulumi_provider_call_duration_seconds records the latency of each method; and
+//   - pulumi_provider_inflight_operations is the number of Create, Update, and Delete calls in progress.
+//
+// Each metric carries a "method" label and the given labels. Providers wrapped with the same labels share metrics.
+func MetricsProvider(inner plugin.Provider, labels prometheus.Labels) plugin.Provider {
+	return newMetricsProvider(inner, labels, prometheus.DefaultRegisterer)
+}
+
+// newMetricsProvider is like MetricsProvider, but registers its metrics with the given registerer.
+func newMetricsProvider(inner plugin.Provider, labels prometheus.Labels, reg prometheus.Registerer) plugin.Provider {
+	hook := &metricsHook{
+		calls: register(reg, prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name:        "pulumi_provider_calls_total",
+			Help:        "The number of calls made to each provider method.",
+			ConstLabels: labels,
+		}, []string{methodLabel})).(*prometheus.CounterVec),
+		errors: register(reg, prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name:        "pulumi_provider_call_errors_total",
+			Help:        "The number of calls to each provider method that returned an error.",
+			ConstLabels: labels,
+		}, []string{methodLabel})).(*prometheus.CounterVec),
+		latency: register(reg, prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:        "pulumi_provider_call_duration_seconds",
+			Help:        "The latency of each provider method.",
+			ConstLabels: labels,
+			Buckets:     prometheus.DefBuckets,
+		}, []string{methodLabel})).(*prometheus.HistogramVec),
+		inflight: register(reg, prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name:        "pulumi_provider_inflight_operations",
+			Help:        "The number of provider Create, Update, and Delete calls in progress.",
+			ConstLabels: labels,
+		}, []string{methodLabel})).(*prometheus.GaugeVec),
+	}
+	return plugin.WithHooks(inner, hook)
+}
+
+// register registers the given collector with reg. If an identical collector is already registered, that collector
+// is returned instead so that its values are shared.
+func register(reg prometheus.Registerer, c prometheus.Collector) prometheus.Collector {
+	if err := reg.Register(c); err != nil {
+		var already prometheus.AlreadyRegisteredError
+		if errors.As(err, &already) {
+			return already.ExistingCollector
+		}
+		panic(err)
+	}
+	return c
+}
+
+// metricsHook is the OperationHook that records the metrics for a provider wrapped by MetricsProvider.
+type metricsHook struct {
+	calls    *prometheus.CounterVec
+	errors   *prometheus.CounterVec
+	latency  *prometheus.HistogramVec
+	inflight *prometheus.GaugeVec
+}
+
+// isMutation returns true if the given operation is one whose in-flight calls are tracked.
+func isMutation(op plugin.OperationType) bool {
+	return op == plugin.OperationCreate || op == plugin.OperationUpdate || op == plugin.OperationDelete
+}
+
+func (h *metricsHook) BeforeOperation(ctx context.Context, op plugin.OperationType, urn resource.URN) error {
+	if isMutation(op) {
+		h.inflight.WithLabelValues(string(op)).Inc()
+	}
+	return nil
+}
+
+func (h *metricsHook) AfterOperation(ctx context.Context, op plugin.OperationType, urn resource.URN, err error) {
+	method := string(op)
+	if isMutation(op) {
+		h.inflight.WithLabelValues(method).Dec()
+	}
+	h.calls.WithLabelValues(method).Inc()
+	if err != nil {
+		h.errors.WithLabelValues(method).Inc()
+	}
+	if start, ok := plugin.OperationStartTime(ctx); ok {
+		h.latency.WithLabelValues(method).Observe(time.Since(start).Seconds())
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	plugintesting "github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin/testing"
+)
+
+func TestMetricsProvider(t *testing.T) {
+	t.Parallel()
+
+	reg := prometheus.NewRegistry()
+
+	// While a Create is running, it is counted as in flight.
+	inflightDuringCreate := `
+# HELP pulumi_provider_inflight_operations The number of provider Create, Update, and Delete calls in progress.
+# TYPE pulumi_provider_inflight_operations gauge
+pulumi_provider_inflight_operations{method="Create",provider="mock"} 1
+`
+	inner := plugintesting.NewMockProvider(
+		plugintesting.WithDefaultNYI(),
+		plugintesting.WithCreate(func(ctx context.Context, urn resource.URN, news resource.PropertyMap,
+			timeout float64, preview bool) (resource.ID, resource.PropertyMap, resource.Status, error) {
+			assert.NoError(t, testutil.GatherAndCompare(reg, strings.NewReader(inflightDuringCreate),
+				"pulumi_provider_inflight_operations"))
+			return "id", news, resource.StatusOK, nil
+		}),
+		plugintesting.WithDelete(func(ctx context.Context, urn resource.URN, id resource.ID,
+			props resource.PropertyMap, timeout float64) (resource.Status, error) {
+			return resource.StatusUnknown, errors.New("boom")
+		}))
+	prov := newMetricsProvider(inner, prometheus.Labels{"provider": "mock"}, reg)
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		_, _, _, err := prov.Create(ctx, "urn:a", resource.PropertyMap{}, 0, false)
+		require.NoError(t, err)
+	}
+	_, err := prov.Delete(ctx, "urn:a", "id", nil, 0)
+	assert.EqualError(t, err, "boom")
+	_, err = prov.GetPluginInfo(ctx)
+	assert.Error(t, err)
+
+	expected := `
+# HELP pulumi_provider_call_errors_total The number of calls to each provider method that returned an error.
+# TYPE pulumi_provider_call_errors_total counter
+pulumi_provider_call_errors_total{method="Delete",provider="mock"} 1
+pulumi_provider_call_errors_total{method="GetPluginInfo",provider="mock"} 1
+# HELP pulumi_provider_calls_total The number of calls made to each provider method.
+# TYPE pulumi_provider_calls_total counter
+pulumi_provider_calls_total{method="Create",provider="mock"} 2
+pulumi_provider_calls_total{method="Delete",provider="mock"} 1
+pulumi_provider_calls_total{method="GetPluginInfo",provider="mock"} 1
+# HELP pulumi_provider_inflight_operations The number of provider Create, Update, and Delete calls in progress.
+# TYPE pulumi_provider_inflight_operations gauge
+pulumi_provider_inflight_operations{method="Create",provider="mock"} 0
+pulumi_provider_inflight_operations{method="Delete",provider="mock"} 0
+`
+	assert.NoError(t, testutil.GatherAndCompare(reg, strings.NewReader(expected),
+		"pulumi_provider_calls_total", "pulumi_provider_call_errors_total", "pulumi_provider_inflight_operations"))
+
+	// Every call's latency is observed. The observed durations vary, so only their number is checked.
+	families, err := reg.Gather()
+	require.NoError(t, err)
+	counts := map[string]uint64{}
+	for _, f := range families {
+		if f.GetName() != "pulumi_provider_call_duration_seconds" {
+			continue
+		}
+		for _, m := range f.GetMetric() {
+			for _, l := range m.GetLabel() {
+				if l.GetName() == methodLabel {
+					counts[l.GetValue()] = m.GetHistogram().GetSampleCount()
+				}
+			}
+		}
+	}
+	assert.Equal(t, map[string]uint64{"Create": 2, "Delete": 1, "GetPluginInfo": 1}, counts)
+}
+
+func TestMetricsProviderSharesMetrics(t *testing.T) {
+	t.Parallel()
+
+	reg := prometheus.NewRegistry()
+	inner := plugintesting.NewMockProvider(plugintesting.WithDefaultNYI())
+	labels := prometheus.Labels{"provider": "mock"}
+
+	// Wrapping two providers with the same labels does not fail, and both record into the same metrics.
+	a := newMetricsProvider(inner, labels, reg)
+	b := newMetricsProvider(inner, labels, reg)
+	_, err := a.GetPluginInfo(context.Background())
+	assert.Error(t, err)
+	_, err = b.GetPluginInfo(context.Background())
+	assert.Error(t, err)
+
+	expected := `
+# HELP pulumi_provider_calls_total The number of calls made to each provider method.
+# TYPE pulumi_provider_calls_total counter
+pulumi_provider_calls_total{method="GetPluginInfo",provider="mock"} 2
+`
+	assert.NoError(t, testutil.GatherAndCompare(reg, strings.NewReader(expected), "pulumi_provider_calls_total"))
+}
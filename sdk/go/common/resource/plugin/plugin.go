@@ -23,6 +23,7 @@ import (
 	"os/exec"
 	"strconv"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"syscall"
 	"time"
@@ -87,6 +88,9 @@ type plugin struct {
 	stdoutDone <-chan bool
 	stderrDone <-chan bool
 
+	logSinkLock sync.Mutex
+	logSink     io.Writer // if non-nil, the writer that receives the plugin's stderr instead of the diag sink.
+
 	Bin  string
 	Args []string
 	// Env specifies the environment of the plugin in the same format as go's os/exec.Cmd.Env
@@ -223,7 +227,7 @@ func newPlugin(ctx *Context, pwd, bin, prefix string, args, env []string, option
 				}
 
 				if stderr {
-					ctx.Diag.Infoerrf(diag.StreamMessage("" /*urn*/, msg, errStreamID))
+					plug.logStderr(ctx, msg, errStreamID)
 				} else {
 					ctx.Diag.Infof(diag.StreamMessage("" /*urn*/, msg, outStreamID))
 				}
@@ -293,6 +297,27 @@ func newPlugin(ctx *Context, pwd, bin, prefix string, args, env []string, option
 	return plug, nil
 }
 
+// setLogSink redirects the plugin's stderr to the given writer. A nil writer restores the default behavior of
+// reporting stderr to the plugin context's diagnostics sink.
+func (p *plugin) setLogSink(sink io.Writer) {
+	p.logSinkLock.Lock()
+	defer p.logSinkLock.Unlock()
+	p.logSink = sink
+}
+
+// logStderr reports a line that the plugin wrote to stderr, either to the plugin's log sink or, if it doesn't have one,
+// to the diagnostics sink.
+func (p *plugin) logStderr(ctx *Context, msg string, streamID int32) {
+	p.logSinkLock.Lock()
+	defer p.logSinkLock.Unlock()
+	if p.logSink != nil {
+		_, err := io.WriteString(p.logSink, msg)
+		contract.IgnoreError(err)
+		return
+	}
+	ctx.Diag.Infoerrf(diag.StreamMessage("" /*urn*/, msg, streamID))
+}
+
 // execPlugin starts the plugin executable.
 func execPlugin(bin string, pluginArgs []string, pwd string, env []string) (*plugin, error) {
 	args := buildPluginArguments(pluginArgumentOptions{
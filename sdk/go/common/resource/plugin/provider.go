@@ -15,10 +15,19 @@
 package plugin
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
+	"sort"
+	"strings"
 
+	"github.com/blang/semver"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
@@ -36,6 +45,11 @@ import (
 // range from benign to catastrophic (possibly leaving behind a corrupt resource).  It is up to the provider to make a
 // best effort to ensure catastrophes do not occur.  The errors returned from mutating operations indicate both the
 // underlying error condition in addition to a bit indicating whether the operation was successfully rolled back.
+//
+// Each operation accepts a context.Context that scopes that single call. If the context is canceled or its deadline
+// expires before the operation completes, the operation is abandoned and returns the context's error (i.e.
+// context.Canceled or context.DeadlineExceeded). SignalCancellation remains available for asking the provider as a
+// whole to wind down.
 type Provider interface {
 	// Closer closes any underlying OS resources associated with this provider (like processes, RPC channels, etc).
 	io.Closer
@@ -43,64 +57,215 @@ type Provider interface {
 	Pkg() tokens.Package
 
 	// GetSchema returns the schema for the provider.
-	GetSchema(version int) ([]byte, error)
+	GetSchema(ctx context.Context, version int) (GetSchemaResponse, error)
+	// GetSupportedVersions returns the schema versions that GetSchema can serve. Providers that only serve version 0
+	// return ErrNotYetImplemented.
+	GetSupportedVersions(ctx context.Context) ([]int, error)
 
 	// CheckConfig validates the configuration for this resource provider.
-	CheckConfig(urn resource.URN, olds, news resource.PropertyMap,
+	CheckConfig(ctx context.Context, urn resource.URN, olds, news resource.PropertyMap,
 		allowUnknowns bool) (resource.PropertyMap, []CheckFailure, error)
+	// ConfigChecksumMatch reports whether checksum, as computed by ConfigChecksum, matches the configuration that the
+	// provider last validated for the given provider resource. Callers skip CheckConfig when it does. Providers that
+	// do not cache validated configuration return ErrNotYetImplemented, and callers always call CheckConfig.
+	ConfigChecksumMatch(ctx context.Context, urn resource.URN, checksum string) (bool, error)
 	// DiffConfig checks what impacts a hypothetical change to this provider's configuration will have on the provider.
-	DiffConfig(urn resource.URN, olds, news resource.PropertyMap, allowUnknowns bool,
+	DiffConfig(ctx context.Context, urn resource.URN, olds, news resource.PropertyMap, allowUnknowns bool,
 		ignoreChanges []string) (DiffResult, error)
 	// Configure configures the resource provider with "globals" that control its behavior.
-	Configure(inputs resource.PropertyMap) error
+	Configure(ctx context.Context, cfg ProviderConfig) error
+	// Validate runs provider-level pre-flight checks, such as verifying credentials or permissions, without touching
+	// any resources. The engine calls Validate after Configure and before the first resource operation. Providers
+	// that have no such checks should return nil.
+	Validate(ctx context.Context) error
+	// ParameterizeByValue parameterizes the provider with an opaque value, e.g. the description of a bridged
+	// sub-provider, and returns the package that the parameterized provider implements. The engine calls either
+	// ParameterizeByValue or ParameterizeByReference after Configure and before any resource operations.
+	ParameterizeByValue(ctx context.Context, value []byte) (ParameterizeResult, error)
+	// ParameterizeByReference parameterizes the provider with a package that the provider knows how to resolve
+	// itself, e.g. from a registry, and returns the package that the parameterized provider implements.
+	ParameterizeByReference(ctx context.Context, ref ParameterizeReference) (ParameterizeResult, error)
 
 	// Check validates that the given property bag is valid for a resource of the given type and returns the inputs
 	// that should be passed to successive calls to Diff, Create, or Update for this resource.
-	Check(urn resource.URN, olds, news resource.PropertyMap,
+	Check(ctx context.Context, urn resource.URN, olds, news resource.PropertyMap,
 		allowUnknowns bool, randomSeed []byte) (resource.PropertyMap, []CheckFailure, error)
 	// Diff checks what impacts a hypothetical update will have on the resource's properties.
-	Diff(urn resource.URN, id resource.ID, olds resource.PropertyMap, news resource.PropertyMap,
+	Diff(ctx context.Context, urn resource.URN, id resource.ID, olds resource.PropertyMap, news resource.PropertyMap,
 		allowUnknowns bool, ignoreChanges []string) (DiffResult, error)
 	// Create allocates a new instance of the provided resource and returns its unique resource.ID.
-	Create(urn resource.URN, news resource.PropertyMap, timeout float64, preview bool) (resource.ID,
+	Create(ctx context.Context, urn resource.URN, news resource.PropertyMap, timeout float64, preview bool) (resource.ID,
 		resource.PropertyMap, resource.Status, error)
+	// StreamCreate allocates a new instance of the provided resource like Create, but calls onNext with each interim
+	// state that the provider reports while the resource is being created, e.g. while a long-running job makes
+	// progress. The final state is returned rather than passed to onNext. If onNext returns an error, the creation
+	// stops and that error is returned. Providers that cannot report interim states may implement this method using
+	// SingleStreamCreate.
+	StreamCreate(ctx context.Context, urn resource.URN, news resource.PropertyMap, timeout float64, preview bool,
+		onNext func(resource.PropertyMap) error) (resource.ID, resource.PropertyMap, resource.Status, error)
+	// WaitForResourceReady blocks until a resource that was just created is ready to be used, e.g. until a database
+	// accepts connections. It returns nil once the resource is ready, or a *WaitTimeoutError if the resource is not
+	// ready within timeout seconds. A timeout of zero or less means that the provider chooses its own timeout.
+	// Providers that do not support readiness checks return ErrNotYetImplemented.
+	WaitForResourceReady(ctx context.Context, urn resource.URN, id resource.ID, timeout float64) error
 	// Read the current live state associated with a resource.  Enough state must be include in the inputs to uniquely
 	// identify the resource; this is typically just the resource ID, but may also include some properties.  If the
 	// resource is missing (for instance, because it has been deleted), the resulting property map will be nil.
-	Read(urn resource.URN, id resource.ID,
+	// Providers may instead return ErrResourceNotFound, which the engine treats the same way.
+	Read(ctx context.Context, urn resource.URN, id resource.ID,
+		inputs, state resource.PropertyMap) (ReadResult, resource.Status, error)
+	// PrepareImport returns the minimal inputs that are required to import the resource with the given ID. Importers
+	// pass these inputs to Read, which returns the full state of the resource, and then validate them with Check.
+	PrepareImport(ctx context.Context, urn resource.URN, id resource.ID) (resource.PropertyMap, error)
+	// Refresh reads the current live state associated with a resource as part of a refresh. Read is also used to
+	// import resources, where a missing resource is an error; Refresh lets providers instead report a resource that no
+	// longer exists by returning a nil property map so that it is removed from the state. Providers that do not
+	// distinguish the two may implement this method using RefreshWithReadStream.
+	Refresh(ctx context.Context, urn resource.URN, id resource.ID,
 		inputs, state resource.PropertyMap) (ReadResult, resource.Status, error)
+	// ReadStream reads the current live state associated with a resource like Read, but calls onNext with each interim
+	// state that the provider reports while the read is in progress, e.g. while waiting for an asynchronous job to
+	// finish. The last state passed to onNext is the final state of the resource. If onNext returns an error, the read
+	// stops and that error is returned. Providers that cannot report interim states may implement this method using
+	// SingleReadStream.
+	ReadStream(ctx context.Context, urn resource.URN, id resource.ID, inputs, state resource.PropertyMap,
+		onNext func(ReadResult) error) (resource.Status, error)
+	// BatchRead refreshes several resources at once. Responses are returned in the same order as the requests. A
+	// failure to read an individual resource is reported in its response; the error result is reserved for failures
+	// of the batch as a whole. Providers without a native batch API may implement this method using
+	// SequentialBatchRead.
+	BatchRead(ctx context.Context, requests []BatchReadRequest) ([]BatchReadResponse, error)
+	// SchemaVersion returns the version of the resource state schema that the provider currently writes. Providers
+	// that do not version their state return 0.
+	SchemaVersion(ctx context.Context) (int, error)
+	// MigrateState upgrades the stored state of a resource that was written with the given state schema version to
+	// the provider's current schema version. Providers that cannot migrate state return ErrNotYetImplemented, in which
+	// case the stored state is used as-is.
+	MigrateState(ctx context.Context, urn resource.URN, stateVersion int,
+		state resource.PropertyMap) (resource.PropertyMap, error)
+	// GetResourceAliases returns aliases under which the resource with the given URN may have been stored by earlier
+	// versions of the provider, e.g. because its type token has since been renamed. Fields of an alias that are not
+	// set default to those of the resource, including its parent; see ResolveResourceAlias. The engine only asks
+	// providers that report FeatureGetResourceAliases. Providers that do not declare aliases return
+	// ErrNotYetImplemented.
+	GetResourceAliases(ctx context.Context, urn resource.URN) ([]resource.Alias, error)
 	// Update updates an existing resource with new values.
-	Update(urn resource.URN, id resource.ID,
+	Update(ctx context.Context, urn resource.URN, id resource.ID,
 		olds resource.PropertyMap, news resource.PropertyMap, timeout float64,
 		ignoreChanges []string, preview bool) (resource.PropertyMap, resource.Status, error)
 	// Delete tears down an existing resource.
-	Delete(urn resource.URN, id resource.ID, props resource.PropertyMap, timeout float64) (resource.Status, error)
+	Delete(ctx context.Context, urn resource.URN, id resource.ID, props resource.PropertyMap,
+		timeout float64) (resource.Status, error)
 
 	// Construct creates a new component resource.
-	Construct(info ConstructInfo, typ tokens.Type, name tokens.QName, parent resource.URN, inputs resource.PropertyMap,
-		options ConstructOptions) (ConstructResult, error)
+	Construct(ctx context.Context, info ConstructInfo, typ tokens.Type, name tokens.QName, parent resource.URN,
+		inputs resource.PropertyMap, options ConstructOptions) (ConstructResult, error)
 
 	// Invoke dynamically executes a built-in function in the provider.
-	Invoke(tok tokens.ModuleMember, args resource.PropertyMap) (resource.PropertyMap, []CheckFailure, error)
+	Invoke(ctx context.Context, tok tokens.ModuleMember,
+		args resource.PropertyMap) (resource.PropertyMap, []CheckFailure, error)
 	// StreamInvoke dynamically executes a built-in function in the provider, which returns a stream
-	// of responses.
+	// of responses. Providers that paginate their responses report the page that each response belongs to.
 	StreamInvoke(
+		ctx context.Context,
 		tok tokens.ModuleMember,
 		args resource.PropertyMap,
-		onNext func(resource.PropertyMap) error) ([]CheckFailure, error)
+		onNext func(StreamInvokeEvent) error) ([]CheckFailure, error)
 	// Call dynamically executes a method in the provider associated with a component resource.
-	Call(tok tokens.ModuleMember, args resource.PropertyMap, info CallInfo,
+	Call(ctx context.Context, tok tokens.ModuleMember, args resource.PropertyMap, info CallInfo,
 		options CallOptions) (CallResult, error)
 
 	// GetPluginInfo returns this plugin's information.
-	GetPluginInfo() (workspace.PluginInfo, error)
+	GetPluginInfo(ctx context.Context) (workspace.PluginInfo, error)
+
+	// GetMapping returns the mapping (if any) for the provider. A provider should return an empty response (not an
+	// error) if it doesn't have a mapping for the given key. The returned string is the name of the underlying
+	// provider that the mapping applies to (e.g. "aws" for the Terraform mapping of the Pulumi "aws" provider), and
+	// the returned data is opaque to the engine.
+	GetMapping(ctx context.Context, key string) ([]byte, string, error)
+
+	// SupportsFeature returns true if the provider supports the given feature (e.g. FeatureBatchRead). Providers
+	// should return false, not an error, for features that they do not recognize.
+	SupportsFeature(ctx context.Context, feature string) (bool, error)
+
+	// RegisterEventEmitter asks the provider to notify the given emitter whenever it observes a change to the live
+	// state of one of the resources it manages, e.g. a change made outside of Pulumi. The engine uses these
+	// notifications to refresh the state of changed resources before diffing them. Providers that cannot observe such
+	// changes return ErrNotYetImplemented.
+	RegisterEventEmitter(e EventEmitter) error
+
+	// WatchConfig asks the provider to call onChange whenever its configuration changes outside of Pulumi, e.g.
+	// because a credential that it reads has been rotated. onChange receives the changed configuration values; values
+	// that are not included keep their current values. The engine re-validates the new configuration and reconfigures
+	// the provider with it. The provider stops watching once ctx is done. Providers that cannot observe such changes
+	// return ErrNotYetImplemented.
+	WatchConfig(ctx context.Context, onChange func(resource.PropertyMap)) error
+
+	// EstimateCost estimates the monthly cost of running the resource with the given URN and inputs. The engine sums
+	// the estimates for the resources that a preview would create or update. Providers that cannot estimate costs
+	// return ErrNotYetImplemented.
+	EstimateCost(ctx context.Context, urn resource.URN, news resource.PropertyMap) (CostEstimate, error)
 
 	// SignalCancellation asks all resource providers to gracefully shut down and abort any ongoing
 	// operations. Operation aborted in this way will return an error (e.g., `Update` and `Create`
 	// will either a creation error or an initialization error. SignalCancellation is advisory and
 	// non-blocking; it is up to the host to decide how long to wait after SignalCancellation is
 	// called before (e.g.) hard-closing any gRPC connection.
-	SignalCancellation() error
+	SignalCancellation(ctx context.Context) error
+}
+
+// ProviderDiagnostic is a structured, non-fatal diagnostic, such as the use of a deprecated property, that a provider
+// reports during an operation with ReportDiagnostic or returns from Call. Diagnostics supplement, rather than replace,
+// the errors returned by the provider's methods; they are surfaced to the user and do not fail the operation.
+type ProviderDiagnostic struct {
+	Severity diag.Severity // the severity of the diagnostic: diag.Info, diag.Warning, or diag.Error.
+	Summary  string        // a short, one-line summary of the problem.
+	Detail   string        // an optional, more detailed description of the problem.
+	URL      string        // an optional link to further information, e.g. the property's documentation.
+}
+
+// String returns the text of the diagnostic as it is displayed to the user.
+func (d ProviderDiagnostic) String() string {
+	msg := d.Summary
+	if d.Detail != "" {
+		msg += "\n" + d.Detail
+	}
+	if d.URL != "" {
+		msg += "\nSee " + d.URL + " for more information."
+	}
+	return msg
+}
+
+// ParameterizeReference refers to a package that a provider can resolve itself when it is parameterized.
+type ParameterizeReference struct {
+	Name    string          // the name of the package.
+	Version *semver.Version // the version of the package, or nil for the newest version available.
+}
+
+// ParameterizeResult describes the package that a parameterized provider implements.
+type ParameterizeResult struct {
+	Name    string          // the name of the package.
+	Version *semver.Version // the version of the package, if it has one.
+}
+
+// StreamInvokeEvent is a single response in the stream of responses returned by StreamInvoke.
+type StreamInvokeEvent struct {
+	Payload    resource.PropertyMap // the values returned in this response.
+	PageNumber int                  // the 1-based page that this response belongs to, or 0 if it is not paginated.
+	IsLastPage bool                 // true if this response belongs to the last page of the stream.
+}
+
+// NewStreamInvokeEvent returns an event for a response that is not paginated, i.e. one whose PageNumber is 0 and that
+// is considered to be its own last page. Providers that do not paginate their responses use this to wrap each one.
+func NewStreamInvokeEvent(payload resource.PropertyMap) StreamInvokeEvent {
+	return StreamInvokeEvent{Payload: payload, IsLastPage: true}
+}
+
+// EventEmitter receives the notifications that a provider sends after it has been registered with
+// RegisterEventEmitter. Implementations must be safe to call concurrently.
+type EventEmitter interface {
+	// EmitResourceChanged reports that the live state of the resource with the given URN and ID has changed.
+	EmitResourceChanged(urn resource.URN, id resource.ID) error
 }
 
 type GrpcProvider interface {
@@ -109,18 +274,160 @@ type GrpcProvider interface {
 	// Attach triggers an attach for a currently running provider to the engine
 	// TODO It would be nice if this was a HostClient rather than the string address but due to dependency
 	// ordering we don't have access to declare that here.
-	Attach(address string) error
+	Attach(ctx context.Context, address string) error
+
+	// SetLogSink redirects the log output that the provider plugin writes to stderr to the given writer, rather than
+	// to the engine's diagnostics. Passing nil restores the default behavior.
+	SetLogSink(sink io.Writer)
+}
+
+// The features that the engine may ask a provider about using SupportsFeature.
+const (
+	// FeatureDetailedDiff indicates that the provider returns detailed diffs from Diff.
+	FeatureDetailedDiff = "detailedDiff"
+	// FeatureBatchRead indicates that the provider implements BatchRead natively rather than reading each resource in
+	// turn.
+	FeatureBatchRead = "batchRead"
+	// FeatureGetMapping indicates that the provider implements GetMapping.
+	FeatureGetMapping = "getMapping"
+	// FeatureValidate indicates that the provider implements Validate.
+	FeatureValidate = "validate"
+	// FeatureWaitForResourceReady indicates that the provider implements WaitForResourceReady for the resource types
+	// whose schema sets waitForReady.
+	FeatureWaitForResourceReady = "waitForResourceReady"
+	// FeatureBinaryPropertyMap indicates that the provider can exchange property maps in the MessagePack encoding
+	// produced by MarshalBinaryProperties.
+	FeatureBinaryPropertyMap = "binaryPropertyMap"
+	// FeatureGetResourceAliases indicates that the provider implements GetResourceAliases.
+	FeatureGetResourceAliases = "getResourceAliases"
+)
+
+// ResolveResourceAlias returns the URN that the given alias of the resource with the given URN refers to. If the alias
+// has a URN, that URN is returned. Otherwise, each field of the alias that is not set defaults to that of the resource:
+// for example, an alias that only sets Type refers to a resource with the same name, project, stack and parent but the
+// given type.
+func ResolveResourceAlias(urn resource.URN, alias resource.Alias) resource.URN {
+	if alias.URN != "" {
+		return alias.URN
+	}
+
+	name, typ := urn.Name(), urn.Type()
+	if alias.Name != "" {
+		name = tokens.QName(alias.Name)
+	}
+	if alias.Type != "" {
+		typ = tokens.Type(alias.Type)
+	}
+	stack, project := urn.Stack(), urn.Project()
+	if alias.Stack != "" {
+		stack = tokens.QName(alias.Stack)
+	}
+	if alias.Project != "" {
+		project = tokens.PackageName(alias.Project)
+	}
+
+	var parentType tokens.Type
+	if alias.Parent != "" {
+		if alias.Parent.Type() != resource.RootStackType {
+			parentType = alias.Parent.QualifiedType()
+		}
+	} else if qualified := string(urn.QualifiedType()); strings.Contains(qualified, resource.URNTypeDelimiter) {
+		parentType = tokens.Type(qualified[:strings.LastIndex(qualified, resource.URNTypeDelimiter)])
+	}
+
+	return resource.NewURN(stack, project, parentType, typ, name)
+}
+
+// CheckSeverity indicates how serious a check failure is.
+type CheckSeverity int
+
+const (
+	// CheckSeverityError indicates that the property is invalid and that the operation must fail.
+	CheckSeverityError CheckSeverity = 0
+	// CheckSeverityWarning indicates that the property is suspect (e.g. it will be corrected by the provider), but
+	// that the operation may proceed.
+	CheckSeverityWarning CheckSeverity = 1
+)
+
+func (s CheckSeverity) String() string {
+	switch s {
+	case CheckSeverityError:
+		return "error"
+	case CheckSeverityWarning:
+		return "warning"
+	default:
+		return fmt.Sprintf("CheckSeverity(%d)", int(s))
+	}
 }
 
 // CheckFailure indicates that a call to check failed; it contains the property and reason for the failure.
 type CheckFailure struct {
 	Property resource.PropertyKey // the property that failed checking.
 	Reason   string               // the reason the property failed to check.
+	Severity CheckSeverity        // the severity of the failure; warnings do not fail the operation.
+	Code     string               // an optional machine-readable code that classifies the failure.
+	Range    *SourceRange         // an optional location in the program's source that the failure applies to.
+}
+
+// SourceRange identifies a position in a program's source, e.g. so that an IDE can map a check failure back to the
+// code that produced it. Lines and columns are 1-based; zero means unknown.
+type SourceRange struct {
+	File   string // the path of the source file.
+	Line   int    // the line within the file.
+	Column int    // the column within the line.
+}
+
+// IsError returns true if this failure should fail the operation that produced it.
+func (f CheckFailure) IsError() bool {
+	return f.Severity != CheckSeverityWarning
+}
+
+// HasCheckErrors returns true if any of the given failures should fail the operation that produced them.
+func HasCheckErrors(failures []CheckFailure) bool {
+	for _, f := range failures {
+		if f.IsError() {
+			return true
+		}
+	}
+	return false
 }
 
 // ErrNotYetImplemented may be returned from a provider for optional methods that are not yet implemented.
 var ErrNotYetImplemented = errors.New("NYI")
 
+// ErrResourceNotFound may be returned from Read to indicate that the resource no longer exists. It is equivalent to
+// returning a ReadResult with nil Outputs and no error.
+var ErrResourceNotFound = errors.New("resource not found")
+
+// PartialFailureError may be returned from Create or Update when the operation partially succeeded: the resource
+// exists, but the operation did not complete. It carries the state of the resource as it was left by the failed
+// operation so that the engine can record it rather than losing track of the resource.
+type PartialFailureError struct {
+	ID         resource.ID          // the ID of the resource, if it was created.
+	Properties resource.PropertyMap // the last known state of the resource.
+	Status     resource.Status      // the status of the resource; defaults to resource.StatusPartialFailure.
+	Cause      error                // the underlying failure.
+}
+
+var _ error = (*PartialFailureError)(nil)
+
+func (e *PartialFailureError) Error() string {
+	if e.Cause == nil {
+		return "resource operation partially failed"
+	}
+	return e.Cause.Error()
+}
+
+func (e *PartialFailureError) Unwrap() error {
+	return e.Cause
+}
+
+// IsPartialFailure returns true if the given error is or wraps a PartialFailureError.
+func IsPartialFailure(err error) bool {
+	var partial *PartialFailureError
+	return errors.As(err, &partial)
+}
+
 // DiffChanges represents the kind of changes detected by a diff operation.
 type DiffChanges int
 
@@ -206,6 +513,14 @@ const (
 type PropertyDiff struct {
 	Kind      DiffKind // The kind of diff.
 	InputDiff bool     // True if this is a diff between old and new inputs rather than old state and new inputs.
+	Reason    string   // An optional, human-readable explanation of the diff, e.g. why it requires replacement.
+	Ignored   bool     // True if the property is covered by the resource's ignoreChanges option.
+
+	// OldValue and NewValue are the property's old and new values, if the provider reports them. When they are set,
+	// they are displayed in place of the values that the engine looks up in the resource's old and new states, which
+	// allows richer display of changes to deeply nested values. A null value means that it was not reported.
+	OldValue resource.PropertyValue
+	NewValue resource.PropertyValue
 }
 
 // ToReplace converts the kind of a PropertyDiff into the equivalent replacement if it not already
@@ -214,6 +529,10 @@ func (p PropertyDiff) ToReplace() PropertyDiff {
 	return PropertyDiff{
 		InputDiff: p.InputDiff,
 		Kind:      p.Kind.AsReplace(),
+		Reason:    p.Reason,
+		Ignored:   p.Ignored,
+		OldValue:  p.OldValue,
+		NewValue:  p.NewValue,
 	}
 }
 
@@ -225,19 +544,51 @@ type DiffResult struct {
 	ChangedKeys         []resource.PropertyKey  // an optional list of keys that changed.
 	DetailedDiff        map[string]PropertyDiff // an optional structured diff
 	DeleteBeforeReplace bool                    // if true, this resource must be deleted before recreating it.
+
+	// AffectedResources is an optional list of the resources that are affected by a change to a provider's
+	// configuration. It is only set by DiffConfig, and only by providers that can compute it. If it is empty, every
+	// resource that uses the provider is assumed to be affected.
+	AffectedResources []resource.URN
+}
+
+// DefaultMaxDetailedDiffDepth is the default maximum depth of the property paths in a detailed diff computed by
+// NewDetailedDiffFromObjectDiff.
+const DefaultMaxDetailedDiffDepth = 128
+
+// DetailedDiffOption configures the detailed diff computed by NewDetailedDiffFromObjectDiff.
+type DetailedDiffOption func(opts *detailedDiffOptions)
+
+type detailedDiffOptions struct {
+	maxDepth int
+}
+
+// WithMaxDetailedDiffDepth limits the depth of the property paths in a detailed diff, where a top-level property has a
+// depth of 1 and each nested object key or array index adds 1. Changes nested within an object or array at the maximum
+// depth are reported as a single update of that object or array. A limit of zero or less means that depth is not
+// limited.
+func WithMaxDetailedDiffDepth(n int) DetailedDiffOption {
+	return func(opts *detailedDiffOptions) {
+		opts.maxDepth = n
+	}
 }
 
-// Computes the detailed diff of Updated, Added and Deleted keys.
-func NewDetailedDiffFromObjectDiff(diff *resource.ObjectDiff) map[string]PropertyDiff {
+// Computes the detailed diff of Updated, Added and Deleted keys. Paths are limited to DefaultMaxDetailedDiffDepth
+// unless WithMaxDetailedDiffDepth is given, so that deeply nested state cannot exhaust the stack.
+func NewDetailedDiffFromObjectDiff(diff *resource.ObjectDiff, opts ...DetailedDiffOption) map[string]PropertyDiff {
 	if diff == nil {
 		return map[string]PropertyDiff{}
 	}
+	options := detailedDiffOptions{maxDepth: DefaultMaxDetailedDiffDepth}
+	for _, o := range opts {
+		o(&options)
+	}
 	out := map[string]PropertyDiff{}
-	objectDiffToDetailedDiff("", diff, out)
+	objectDiffToDetailedDiff("", 0, options.maxDepth, diff, out)
 	return out
 }
 
-func objectDiffToDetailedDiff(prefix string, diff *resource.ObjectDiff, acc map[string]PropertyDiff) {
+func objectDiffToDetailedDiff(prefix string, depth, maxDepth int, diff *resource.ObjectDiff,
+	acc map[string]PropertyDiff) {
 
 	getPrefix := func(k resource.PropertyKey) string {
 		if prefix == "" {
@@ -248,7 +599,7 @@ func objectDiffToDetailedDiff(prefix string, diff *resource.ObjectDiff, acc map[
 
 	for k, vd := range diff.Updates {
 		nestedPrefix := getPrefix(k)
-		valueDiffToDetailedDiff(nestedPrefix, vd, acc)
+		valueDiffToDetailedDiff(nestedPrefix, depth+1, maxDepth, vd, acc)
 	}
 
 	for k := range diff.Adds {
@@ -262,10 +613,12 @@ func objectDiffToDetailedDiff(prefix string, diff *resource.ObjectDiff, acc map[
 	}
 }
 
-func arrayDiffToDetailedDiff(prefix string, d *resource.ArrayDiff, acc map[string]PropertyDiff) {
+func arrayDiffToDetailedDiff(prefix string, depth, maxDepth int, d *resource.ArrayDiff,
+	acc map[string]PropertyDiff) {
+
 	nestedPrefix := func(i int) string { return fmt.Sprintf("%s[%d]", prefix, i) }
 	for i, vd := range d.Updates {
-		valueDiffToDetailedDiff(nestedPrefix(i), vd, acc)
+		valueDiffToDetailedDiff(nestedPrefix(i), depth+1, maxDepth, vd, acc)
 	}
 	for i := range d.Adds {
 		acc[nestedPrefix(i)] = PropertyDiff{Kind: DiffAdd}
@@ -276,11 +629,18 @@ func arrayDiffToDetailedDiff(prefix string, d *resource.ArrayDiff, acc map[strin
 
 }
 
-func valueDiffToDetailedDiff(prefix string, vd resource.ValueDiff, acc map[string]PropertyDiff) {
-	if vd.Object != nil {
-		objectDiffToDetailedDiff(prefix, vd.Object, acc)
+func valueDiffToDetailedDiff(prefix string, depth, maxDepth int, vd resource.ValueDiff,
+	acc map[string]PropertyDiff) {
+
+	if (vd.Object != nil || vd.Array != nil) && maxDepth > 0 && depth >= maxDepth {
+		acc[prefix] = PropertyDiff{
+			Kind:   DiffUpdate,
+			Reason: fmt.Sprintf("changes nested more than %d levels deep are not shown", maxDepth),
+		}
+	} else if vd.Object != nil {
+		objectDiffToDetailedDiff(prefix, depth, maxDepth, vd.Object, acc)
 	} else if vd.Array != nil {
-		arrayDiffToDetailedDiff(prefix, vd.Array, acc)
+		arrayDiffToDetailedDiff(prefix, depth, maxDepth, vd.Array, acc)
 	} else {
 		switch {
 		case vd.Old.V == nil && vd.New.V != nil:
@@ -293,16 +653,475 @@ func valueDiffToDetailedDiff(prefix string, vd resource.ValueDiff, acc map[strin
 	}
 }
 
-// Replace returns true if this diff represents a replacement.
-func (r DiffResult) Replace() bool {
+// NewObjectDiffFromDetailedDiff reconstructs an ObjectDiff from a detailed diff and the old and new property maps that
+// it describes. It is the inverse of NewDetailedDiffFromObjectDiff, and allows tools that only have a detailed diff to
+// use the ObjectDiff rendering code. The reconstruction is best-effort: paths that cannot be parsed are ignored, and
+// nested paths that do not match the shape of the old and new values are treated as updates of the value that they
+// would have descended into. Properties that the detailed diff does not mention are treated as unchanged.
+func NewObjectDiffFromDetailedDiff(detailedDiff map[string]PropertyDiff,
+	olds, news resource.PropertyMap) *resource.ObjectDiff {
+
+	if len(detailedDiff) == 0 {
+		return nil
+	}
+
+	root := &detailedDiffNode{}
+	for path, diff := range detailedDiff {
+		elements, err := resource.ParsePropertyPath(path)
+		if err != nil || len(elements) == 0 {
+			continue
+		}
+		root.insert(elements, diff)
+	}
+	return root.objectDiff(olds, news)
+}
+
+// detailedDiffNode is a node in the tree of property paths built from a detailed diff. Each node corresponds to a
+// single path element, and records the diff for the path that ends at that element, if any.
+type detailedDiffNode struct {
+	diff     *PropertyDiff
+	children map[interface{}]*detailedDiffNode
+}
+
+func (n *detailedDiffNode) insert(path resource.PropertyPath, diff PropertyDiff) {
+	for _, element := range path {
+		if n.children == nil {
+			n.children = map[interface{}]*detailedDiffNode{}
+		}
+		child, ok := n.children[element]
+		if !ok {
+			child = &detailedDiffNode{}
+			n.children[element] = child
+		}
+		n = child
+	}
+	n.diff = &diff
+}
+
+// objectDiff builds the ObjectDiff for the children of this node.
+func (n *detailedDiffNode) objectDiff(olds, news resource.PropertyMap) *resource.ObjectDiff {
+	diff := &resource.ObjectDiff{
+		Adds:    resource.PropertyMap{},
+		Deletes: resource.PropertyMap{},
+		Sames:   resource.PropertyMap{},
+		Updates: map[resource.PropertyKey]resource.ValueDiff{},
+	}
+
+	for element, child := range n.children {
+		name, ok := element.(string)
+		if !ok {
+			continue
+		}
+		k := resource.PropertyKey(name)
+		switch child.kind() {
+		case DiffAdd, DiffAddReplace:
+			diff.Adds[k] = news[k]
+		case DiffDelete, DiffDeleteReplace:
+			diff.Deletes[k] = olds[k]
+		default:
+			diff.Updates[k] = child.valueDiff(olds[k], news[k])
+		}
+	}
+
+	for k, old := range olds {
+		if _, has := news[k]; has && !n.has(string(k)) {
+			diff.Sames[k] = old
+		}
+	}
+	return diff
+}
+
+// arrayDiff builds the ArrayDiff for the children of this node.
+func (n *detailedDiffNode) arrayDiff(olds, news []resource.PropertyValue) *resource.ArrayDiff {
+	diff := &resource.ArrayDiff{
+		Adds:    map[int]resource.PropertyValue{},
+		Deletes: map[int]resource.PropertyValue{},
+		Sames:   map[int]resource.PropertyValue{},
+		Updates: map[int]resource.ValueDiff{},
+	}
+
+	at := func(values []resource.PropertyValue, i int) resource.PropertyValue {
+		if i < 0 || i >= len(values) {
+			return resource.NewNullProperty()
+		}
+		return values[i]
+	}
+
+	for element, child := range n.children {
+		i, ok := element.(int)
+		if !ok {
+			continue
+		}
+		switch child.kind() {
+		case DiffAdd, DiffAddReplace:
+			diff.Adds[i] = at(news, i)
+		case DiffDelete, DiffDeleteReplace:
+			diff.Deletes[i] = at(olds, i)
+		default:
+			diff.Updates[i] = child.valueDiff(at(olds, i), at(news, i))
+		}
+	}
+
+	for i := 0; i < len(olds) && i < len(news); i++ {
+		if !n.has(i) {
+			diff.Sames[i] = olds[i]
+		}
+	}
+	return diff
+}
+
+// valueDiff builds the ValueDiff for the value at this node, descending into objects and arrays if the detailed diff
+// describes changes to their elements.
+func (n *detailedDiffNode) valueDiff(old, new resource.PropertyValue) resource.ValueDiff {
+	diff := resource.ValueDiff{Old: old, New: new}
+	if len(n.children) == 0 {
+		return diff
+	}
+
+	switch {
+	case old.IsObject() && new.IsObject():
+		diff.Object = n.objectDiff(old.ObjectValue(), new.ObjectValue())
+	case old.IsArray() && new.IsArray():
+		diff.Array = n.arrayDiff(old.ArrayValue(), new.ArrayValue())
+	}
+	return diff
+}
+
+// kind returns the kind of the diff recorded at this node. Nodes that only exist because the detailed diff describes
+// changes to their descendants are updates.
+func (n *detailedDiffNode) kind() DiffKind {
+	if n.diff == nil {
+		return DiffUpdate
+	}
+	return n.diff.Kind
+}
+
+func (n *detailedDiffNode) has(element interface{}) bool {
+	_, ok := n.children[element]
+	return ok
+}
+
+// MergeDetailedDiff combines two detailed diffs into a new detailed diff. Neither input is modified. If both inputs
+// are nil, the result is nil.
+//
+// If a key is present in both a and b, the entries are combined as follows:
+//
+//   - if either entry requires replacement, the result requires replacement;
+//   - if the entries have the same kind of change, that kind is kept;
+//   - an add or a delete takes precedence over an update;
+//   - an add combined with a delete is an update.
+//
+// The InputDiff flag and Reason of the combined entry are taken from the entry whose kind of change was kept, or from
+// b if the kinds were combined into an update or were the same. If that entry has no Reason, the other entry's Reason
+// is used. The combined entry is only ignored if both entries are ignored.
+func MergeDetailedDiff(a, b map[string]PropertyDiff) map[string]PropertyDiff {
+	if a == nil && b == nil {
+		return nil
+	}
+
+	merged := make(map[string]PropertyDiff, len(a)+len(b))
+	for k, v := range a {
+		merged[k] = v
+	}
+	for k, v := range b {
+		if existing, has := merged[k]; has {
+			v = mergePropertyDiff(existing, v)
+		}
+		merged[k] = v
+	}
+	return merged
+}
+
+// mergePropertyDiff combines two diffs of the same property using the precedence rules described by
+// MergeDetailedDiff.
+func mergePropertyDiff(a, b PropertyDiff) PropertyDiff {
+	baseKind := func(k DiffKind) DiffKind {
+		switch k {
+		case DiffAddReplace:
+			return DiffAdd
+		case DiffDeleteReplace:
+			return DiffDelete
+		case DiffUpdateReplace:
+			return DiffUpdate
+		default:
+			return k
+		}
+	}
+
+	aKind, bKind := baseKind(a.Kind), baseKind(b.Kind)
+
+	var result PropertyDiff
+	switch {
+	case aKind == bKind:
+		result = b
+	case aKind == DiffUpdate:
+		result = b
+	case bKind == DiffUpdate:
+		result = a
+	default:
+		// An add combined with a delete.
+		result = PropertyDiff{Kind: DiffUpdate, InputDiff: b.InputDiff, Reason: b.Reason, OldValue: a.OldValue,
+			NewValue: b.NewValue}
+	}
+	switch {
+	case result.Reason != "":
+	case b.Reason != "":
+		result.Reason = b.Reason
+	default:
+		result.Reason = a.Reason
+	}
+
+	result.Ignored = a.Ignored && b.Ignored
+
+	result.Kind = baseKind(result.Kind)
+	if a.Kind.IsReplace() || b.Kind.IsReplace() {
+		result = result.ToReplace()
+	}
+	return result
+}
+
+// FilterDetailedDiff returns a copy of the given detailed diff without any entries for properties that are covered
+// by the given ignore-changes paths. An entry is removed if its key names the same property as an ignore path or a
+// property nested within it; ignore paths may use the same wildcards as the ignoreChanges resource option. Keys and
+// paths that cannot be parsed as property paths are compared literally.
+func FilterDetailedDiff(diff map[string]PropertyDiff, ignoreKeys []string) map[string]PropertyDiff {
+	if diff == nil {
+		return nil
+	}
+
+	ignorePaths, ignoreLiterals := parseIgnoreKeys(ignoreKeys)
+	filtered := make(map[string]PropertyDiff, len(diff))
+	for k, v := range diff {
+		if !isIgnoredDiffKey(k, ignorePaths, ignoreLiterals) {
+			filtered[k] = v
+		}
+	}
+	return filtered
+}
+
+// MarkIgnoredDetailedDiff returns a copy of the given detailed diff in which the entries for properties that are
+// covered by the given ignore-changes paths are marked as Ignored. Unlike FilterDetailedDiff, ignored entries are kept
+// so that the full diff can still be displayed and recorded. Entries are matched as described by FilterDetailedDiff.
+func MarkIgnoredDetailedDiff(diff map[string]PropertyDiff, ignoreKeys []string) map[string]PropertyDiff {
+	if diff == nil {
+		return nil
+	}
+
+	ignorePaths, ignoreLiterals := parseIgnoreKeys(ignoreKeys)
+	marked := make(map[string]PropertyDiff, len(diff))
+	for k, v := range diff {
+		if isIgnoredDiffKey(k, ignorePaths, ignoreLiterals) {
+			v.Ignored = true
+		}
+		marked[k] = v
+	}
+	return marked
+}
+
+// parseIgnoreKeys parses the given ignore-changes paths. Paths that cannot be parsed are returned as literals.
+func parseIgnoreKeys(ignoreKeys []string) ([]resource.PropertyPath, map[string]bool) {
+	ignorePaths := make([]resource.PropertyPath, 0, len(ignoreKeys))
+	ignoreLiterals := make(map[string]bool)
+	for _, k := range ignoreKeys {
+		path, err := resource.ParsePropertyPath(k)
+		if err != nil {
+			ignoreLiterals[k] = true
+			continue
+		}
+		ignorePaths = append(ignorePaths, path)
+	}
+	return ignorePaths, ignoreLiterals
+}
+
+// isIgnoredDiffKey returns true if the given detailed diff key is covered by any of the given ignore paths.
+func isIgnoredDiffKey(key string, ignorePaths []resource.PropertyPath, ignoreLiterals map[string]bool) bool {
+	if ignoreLiterals[key] {
+		return true
+	}
+	path, err := resource.ParsePropertyPath(key)
+	if err != nil {
+		return false
+	}
+	for _, ignore := range ignorePaths {
+		if ignore.Contains(path) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasDetailedReplacement returns true if this diff's DetailedDiff contains a property change that requires the
+// resource to be replaced. Ignored changes do not require replacement.
+func (r DiffResult) HasDetailedReplacement() bool {
 	for _, v := range r.DetailedDiff {
-		if v.Kind.IsReplace() {
+		if v.Kind.IsReplace() && !v.Ignored {
 			return true
 		}
 	}
+	return false
+}
+
+// HasLegacyReplacement returns true if this diff's legacy ReplaceKeys list names any properties that require the
+// resource to be replaced.
+func (r DiffResult) HasLegacyReplacement() bool {
 	return len(r.ReplaceKeys) > 0
 }
 
+// RequiresReplacement returns true if this diff represents a replacement, either because of a replacing property
+// change in DetailedDiff or because ReplaceKeys is non-empty.
+func (r DiffResult) RequiresReplacement() bool {
+	return r.HasDetailedReplacement() || r.HasLegacyReplacement()
+}
+
+// Replace returns true if this diff represents a replacement.
+//
+// Deprecated: use RequiresReplacement instead.
+func (r DiffResult) Replace() bool {
+	return r.RequiresReplacement()
+}
+
+// StableProperties returns the entries of old whose keys are listed in this diff's StableKeys. Keys that are not
+// present in old are omitted.
+func (r DiffResult) StableProperties(old resource.PropertyMap) resource.PropertyMap {
+	return filterPropertyMap(old, r.StableKeys)
+}
+
+// ChangedProperties returns the entries of old whose keys are listed in this diff's ChangedKeys. Keys that are not
+// present in old are omitted.
+func (r DiffResult) ChangedProperties(old resource.PropertyMap) resource.PropertyMap {
+	return filterPropertyMap(old, r.ChangedKeys)
+}
+
+// DetailedDiffDepth returns the maximum nesting depth of the property paths in this diff's DetailedDiff, where a
+// top-level property has a depth of 1 and each nested object key or array index adds 1. It returns 0 if there is no
+// detailed diff. Keys that cannot be parsed as property paths are measured by counting their '.' and '[' separators.
+func (r DiffResult) DetailedDiffDepth() int {
+	depth := 0
+	for k := range r.DetailedDiff {
+		if d := detailedDiffKeyDepth(k); d > depth {
+			depth = d
+		}
+	}
+	return depth
+}
+
+// DetailedDiffCount returns the number of entries in this diff's DetailedDiff. Each entry describes the change to a
+// single property, so this is the number of leaf changes that the engine has to render.
+func (r DiffResult) DetailedDiffCount() int {
+	return len(r.DetailedDiff)
+}
+
+// Summary returns a one-line description of this diff, such as "3 properties changed, 1 requires replacement", based
+// on its Changes, ChangedKeys, ReplaceKeys, and DeleteBeforeReplace fields.
+func (r DiffResult) Summary() string {
+	if r.Changes == DiffNone {
+		return "no changes"
+	}
+	if r.Changes == DiffUnknown && len(r.ChangedKeys) == 0 && len(r.ReplaceKeys) == 0 {
+		return "changes unknown"
+	}
+
+	var parts []string
+	switch len(r.ChangedKeys) {
+	case 0:
+		parts = append(parts, "changes detected")
+	case 1:
+		parts = append(parts, "1 property changed")
+	default:
+		parts = append(parts, fmt.Sprintf("%d properties changed", len(r.ChangedKeys)))
+	}
+	switch len(r.ReplaceKeys) {
+	case 0:
+	case 1:
+		parts = append(parts, "1 requires replacement")
+	default:
+		parts = append(parts, fmt.Sprintf("%d require replacement", len(r.ReplaceKeys)))
+	}
+	if r.DeleteBeforeReplace {
+		parts = append(parts, "delete before replace")
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Verbose returns the Summary of this diff followed by the keys that changed and the keys that require replacement,
+// such as "2 properties changed, 1 requires replacement (changed: a, b; replace: a)".
+func (r DiffResult) Verbose() string {
+	var lists []string
+	if len(r.ChangedKeys) > 0 {
+		lists = append(lists, "changed: "+joinPropertyKeys(r.ChangedKeys))
+	}
+	if len(r.ReplaceKeys) > 0 {
+		lists = append(lists, "replace: "+joinPropertyKeys(r.ReplaceKeys))
+	}
+	if len(lists) == 0 {
+		return r.Summary()
+	}
+	return fmt.Sprintf("%s (%s)", r.Summary(), strings.Join(lists, "; "))
+}
+
+// PropertyChangeSummary describes the change to a single property in a form that is suitable for machine-readable
+// change reports.
+type PropertyChangeSummary struct {
+	PropertyKey resource.PropertyKey // the key or, for detailed diffs, the property path of the changed property.
+	Kind        DiffKind             // the kind of change.
+	IsSecret    bool                 // true if the property's old or new value, as reported by the provider, is secret.
+}
+
+// ChangedKeyDetails returns a summary of each property change in this diff, sorted by property key. If the diff has a
+// DetailedDiff, the summaries are computed from its entries, skipping any that are ignored; otherwise each of the
+// ChangedKeys is reported as a DiffUpdate.
+func (r DiffResult) ChangedKeyDetails() []PropertyChangeSummary {
+	var summaries []PropertyChangeSummary
+	if r.DetailedDiff != nil {
+		for k, d := range r.DetailedDiff {
+			if d.Ignored {
+				continue
+			}
+			summaries = append(summaries, PropertyChangeSummary{
+				PropertyKey: resource.PropertyKey(k),
+				Kind:        d.Kind,
+				IsSecret:    d.OldValue.ContainsSecrets() || d.NewValue.ContainsSecrets(),
+			})
+		}
+	} else {
+		for _, k := range r.ChangedKeys {
+			summaries = append(summaries, PropertyChangeSummary{PropertyKey: k, Kind: DiffUpdate})
+		}
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].PropertyKey < summaries[j].PropertyKey
+	})
+	return summaries
+}
+
+// joinPropertyKeys returns the given keys separated by commas.
+func joinPropertyKeys(keys []resource.PropertyKey) string {
+	strs := make([]string, len(keys))
+	for i, k := range keys {
+		strs[i] = string(k)
+	}
+	return strings.Join(strs, ", ")
+}
+
+// detailedDiffKeyDepth returns the nesting depth of the given detailed diff key.
+func detailedDiffKeyDepth(key string) int {
+	if path, err := resource.ParsePropertyPath(key); err == nil {
+		return len(path)
+	}
+	return 1 + strings.Count(key, ".") + strings.Count(key, "[")
+}
+
+// filterPropertyMap returns a new map containing the entries of m that are named by keys.
+func filterPropertyMap(m resource.PropertyMap, keys []resource.PropertyKey) resource.PropertyMap {
+	result := resource.PropertyMap{}
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			result[k] = v
+		}
+	}
+	return result
+}
+
 // DiffUnavailableError may be returned by a provider if the provider is unable to diff a resource.
 type DiffUnavailableError struct {
 	reason string
@@ -318,6 +1137,46 @@ func (e DiffUnavailableError) Error() string {
 	return e.reason
 }
 
+// GetSchemaResponse is the result of a call to GetSchema.
+type GetSchemaResponse struct {
+	// Bytes is the JSON-encoded schema.
+	Bytes []byte
+	// Checksum is the hex-encoded SHA-256 checksum of Bytes. Callers may use it to avoid decoding a schema that they
+	// have already decoded.
+	Checksum string
+}
+
+// NewGetSchemaResponse returns a GetSchemaResponse for the given schema bytes, including their checksum.
+func NewGetSchemaResponse(schema []byte) GetSchemaResponse {
+	return GetSchemaResponse{Bytes: schema, Checksum: SchemaChecksum(schema)}
+}
+
+// SchemaChecksum returns the hex-encoded SHA-256 checksum of the given schema bytes.
+func SchemaChecksum(schema []byte) string {
+	sum := sha256.Sum256(schema)
+	return hex.EncodeToString(sum[:])
+}
+
+// ConfigChecksum returns the hex-encoded SHA-256 checksum of the given provider configuration. The checksum covers
+// secrets, unknowns, and resource references, and does not depend on the order of the configuration's keys.
+func ConfigChecksum(config resource.PropertyMap) (string, error) {
+	props, err := MarshalProperties(config, MarshalOptions{
+		Label:         "config",
+		KeepUnknowns:  true,
+		KeepSecrets:   true,
+		KeepResources: true,
+	})
+	if err != nil {
+		return "", err
+	}
+	bytes, err := proto.MarshalOptions{Deterministic: true}.Marshal(props)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(bytes)
+	return hex.EncodeToString(sum[:]), nil
+}
+
 // ReadResult is the result of a call to Read.
 type ReadResult struct {
 	// This is the ID for the resource. This ID will always be populated and will ensure we get the most up-to-date
@@ -329,6 +1188,73 @@ type ReadResult struct {
 	// Outputs contains the new outputs/state for the resource, if any. If this field is nil, the resource does not
 	// exist.
 	Outputs resource.PropertyMap
+	// ImportID is the canonical form of the ID that was passed to Read, if the provider normalized it (e.g. an ARN
+	// that was shortened to a name). If this field is non-empty, the engine records this ID in the resource's state in
+	// place of the supplied ID and lets the user know that the ID was normalized.
+	ImportID resource.ID
+	// SchemaVersion is the version of the provider's state schema that Outputs was written with, or 0 if the provider
+	// does not report one. If it differs from the version recorded for the resource, the engine migrates the recorded
+	// state with MigrateState before storing the state that was read.
+	SchemaVersion int
+	// ImportMetadata is additional information about the resource that the provider reports when the resource is
+	// read as part of an import, if any.
+	ImportMetadata *ImportMetadata
+}
+
+// ImportMetadata is information about a resource being imported that a provider returns from Read. The engine reports
+// warnings as part of every import, and notes only when asked to, e.g. by `pulumi import --show-import-notes`.
+type ImportMetadata struct {
+	Notes         []string // informational notes about the resource, e.g. how it will be managed once imported.
+	OriginalOwner string   // the Pulumi stack or other tool that originally created the resource, if known.
+	Warnings      []string // warnings about importing the resource, e.g. that it is managed by another stack.
+}
+
+// BatchReadRequest is a single read in a call to BatchRead. Its fields mirror the arguments to Read.
+type BatchReadRequest struct {
+	URN    resource.URN         // the URN of the resource to read.
+	ID     resource.ID          // the ID of the resource to read.
+	Inputs resource.PropertyMap // the last known inputs of the resource, if any.
+	State  resource.PropertyMap // the last known state of the resource, if any.
+}
+
+// BatchReadResponse is the result of a single read in a call to BatchRead.
+type BatchReadResponse struct {
+	ReadResult
+
+	// Status is the status of the read, as would have been returned by Read.
+	Status resource.Status
+	// Error is the error encountered while reading the resource, if any.
+	Error error
+}
+
+// SequentialBatchRead implements BatchRead for providers that have no native support for batched reads by issuing
+// one call to Refresh per request, in order. If the context is canceled part way through the batch, the requests that
+// have not yet been issued fail with the context's error.
+func SequentialBatchRead(ctx context.Context, p Provider, requests []BatchReadRequest) ([]BatchReadResponse, error) {
+	responses := make([]BatchReadResponse, len(requests))
+	for i, req := range requests {
+		if err := ctx.Err(); err != nil {
+			responses[i] = BatchReadResponse{Status: resource.StatusOK, Error: err}
+			continue
+		}
+
+		result, status, err := p.Refresh(ctx, req.URN, req.ID, req.Inputs, req.State)
+		responses[i] = BatchReadResponse{ReadResult: result, Status: status, Error: err}
+	}
+	return responses, nil
+}
+
+// RefreshWithReadStream implements Refresh for providers that do not distinguish refreshes from reads by reading the
+// resource with ReadStream and returning the last state that it reports.
+func RefreshWithReadStream(ctx context.Context, p Provider, urn resource.URN, id resource.ID,
+	inputs, state resource.PropertyMap) (ReadResult, resource.Status, error) {
+
+	var result ReadResult
+	status, err := p.ReadStream(ctx, urn, id, inputs, state, func(r ReadResult) error {
+		result = r
+		return nil
+	})
+	return result, status, err
 }
 
 // ConstructInfo contains all of the information required to register resources as part of a call to Construct.
@@ -340,8 +1266,17 @@ type ConstructInfo struct {
 	DryRun           bool                  // true if we are performing a dry-run (preview).
 	Parallel         int                   // the degree of parallelism for resource operations (<=1 for serial).
 	MonitorAddress   string                // the RPC address to the host resource monitor.
+	SDKVersion       string                // the version of the SDK that registered the component, if known.
+	EngineVersion    string                // the version of the engine running the deployment, if known.
+	TraceID          string                // the hex-encoded ID of the engine's trace, if tracing is enabled.
+	SpanID           string                // the hex-encoded ID of the engine's span for the request, if any.
+	Namespace        string                // the namespace with which to prefix the component's resource names, if any.
 }
 
+// SDKVersionMetadataKey is the gRPC metadata key under which a language SDK may report its version when registering
+// resources with the engine. The engine forwards the version to component providers as ConstructInfo.SDKVersion.
+const SDKVersionMetadataKey = "pulumi-sdk-version"
+
 // ConstructOptions captures options for a call to Construct.
 type ConstructOptions struct {
 	// Aliases is the set of aliases for the component.
@@ -354,6 +1289,42 @@ type ConstructOptions struct {
 	Providers map[string]string
 	// PropertyDependencies is a map from property name to a list of resources that property depends on.
 	PropertyDependencies map[resource.PropertyKey][]resource.URN
+	// Timeouts are the custom timeouts that should be applied to the component's child resources.
+	Timeouts OperationTimeouts
+	// IgnoreChanges is the list of the component's properties whose changes should be ignored. The engine does not
+	// diff components, so providers should honour these by adding the corresponding properties of the component's
+	// child resources to those resources' ignoreChanges options.
+	IgnoreChanges []string
+	// Transforms are applied, in order, to the component's inputs once they have been resolved. Transforms cannot be
+	// sent over gRPC, so they are applied by the caller before the inputs are sent to a plugin; providers that
+	// construct components in-process may also apply them to the component's children with ApplyResourceTransforms.
+	Transforms []ResourceTransform
+}
+
+// ResourceTransform rewrites the properties of a resource before it is registered.
+type ResourceTransform func(resource.PropertyMap) resource.PropertyMap
+
+// ApplyResourceTransforms returns the result of applying each of the given transforms, in order, to the given
+// properties. If there are no transforms, the properties are returned unchanged.
+func ApplyResourceTransforms(transforms []ResourceTransform, props resource.PropertyMap) resource.PropertyMap {
+	for _, transform := range transforms {
+		props = transform(props)
+	}
+	return props
+}
+
+// OperationTimeouts captures the custom timeouts, in seconds, for each kind of resource operation. A zero value means
+// that the provider's default timeout should be used for that operation, as with the timeouts passed to Create,
+// Update, and Delete.
+type OperationTimeouts struct {
+	Create float64 // the timeout for create operations.
+	Update float64 // the timeout for update operations.
+	Delete float64 // the timeout for delete operations.
+}
+
+// IsZero returns true if none of the timeouts are set.
+func (t OperationTimeouts) IsZero() bool {
+	return t.Create == 0 && t.Update == 0 && t.Delete == 0
 }
 
 // ConstructResult is the result of a call to Construct.
@@ -364,6 +1335,79 @@ type ConstructResult struct {
 	Outputs resource.PropertyMap
 	// The resources that each output property depends on.
 	OutputDependencies map[resource.PropertyKey][]resource.URN
+	// The component's inputs after any normalization by the provider, or nil if the provider did not report them.
+	Inputs resource.PropertyMap
+	// The resources that each normalized input property depends on.
+	InputDependencies map[resource.PropertyKey][]resource.URN
+	// The URNs of the component's child resources. Providers that register children without a Pulumi SDK may declare
+	// them here. The engine checks that each was registered either as a descendant of the component or without a
+	// parent of its own.
+	ChildResources []resource.URN
+}
+
+// ValidateConstructResult checks that a ConstructResult is consistent with the resources that were registered while the
+// component was being constructed. Every URN referenced by the result's output dependencies, including the dependencies
+// of any output values in its outputs, must be one of the given registered URNs, as must every declared child resource,
+// and no unknown output value may carry a value. The returned errors describe each inconsistency that was found, or are
+// empty if there are none.
+func ValidateConstructResult(result ConstructResult, registeredURNs []resource.URN) []error {
+	registered := make(map[resource.URN]bool, len(registeredURNs))
+	for _, urn := range registeredURNs {
+		registered[urn] = true
+	}
+
+	var errs []error
+	checkDeps := func(path string, deps []resource.URN) {
+		for _, dep := range deps {
+			if !registered[dep] {
+				errs = append(errs, fmt.Errorf("output %v depends on unregistered resource %v", path, dep))
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(result.OutputDependencies))
+	for k := range result.OutputDependencies {
+		keys = append(keys, string(k))
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		checkDeps(k, result.OutputDependencies[resource.PropertyKey(k)])
+	}
+
+	var checkValue func(path string, v resource.PropertyValue)
+	checkValue = func(path string, v resource.PropertyValue) {
+		switch {
+		case v.IsArray():
+			for i, e := range v.ArrayValue() {
+				checkValue(fmt.Sprintf("%s[%d]", path, i), e)
+			}
+		case v.IsObject():
+			obj := v.ObjectValue()
+			for _, k := range obj.Keys() {
+				checkValue(fmt.Sprintf("%s.%s", path, k), obj[k])
+			}
+		case v.IsSecret():
+			checkValue(path, v.SecretValue().Element)
+		case v.IsOutput():
+			output := v.OutputValue()
+			if !output.Known && !output.Element.IsNull() {
+				errs = append(errs, fmt.Errorf("output %v is unknown but has a value", path))
+			}
+			checkDeps(path, output.Dependencies)
+			checkValue(path, output.Element)
+		}
+	}
+	for _, k := range result.Outputs.Keys() {
+		checkValue(string(k), result.Outputs[k])
+	}
+
+	for _, child := range result.ChildResources {
+		if !registered[child] {
+			errs = append(errs, fmt.Errorf("child resource %v was not registered", child))
+		}
+	}
+
+	return errs
 }
 
 // CallInfo contains all of the information required to register resources as part of a call to Construct.
@@ -374,12 +1418,16 @@ type CallInfo struct {
 	DryRun         bool                  // true if we are performing a dry-run (preview).
 	Parallel       int                   // the degree of parallelism for resource operations (<=1 for serial).
 	MonitorAddress string                // the RPC address to the host resource monitor.
+	TraceID        string                // the hex-encoded ID of the engine's trace, if tracing is enabled.
+	SpanID         string                // the hex-encoded ID of the engine's span for the request, if any.
 }
 
 // CallOptions captures options for a call to Call.
 type CallOptions struct {
 	// ArgDependencies is a map from argument keys to a list of resources that the argument depends on.
 	ArgDependencies map[resource.PropertyKey][]resource.URN
+	// Timeout is the number of seconds that the call may run for. Zero means that the call has no timeout.
+	Timeout float64
 }
 
 // CallResult is the result of a call to Call.
@@ -390,4 +1438,10 @@ type CallResult struct {
 	ReturnDependencies map[resource.PropertyKey][]resource.URN
 	// The failures if any arguments didn't pass verification.
 	Failures []CheckFailure
+	// Any non-fatal diagnostics the provider reported about the call. These are shown to the user once the call
+	// completes.
+	Diagnostics []ProviderDiagnostic
+	// The status of the call. If the call failed after computing some of its return values, the status is
+	// StatusPartialFailure and Return holds the values that were computed.
+	Status resource.Status
 }
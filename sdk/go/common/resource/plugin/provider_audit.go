@@ -0,0 +1,106 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+// AuditRecord is a single provider call recorded by a ProviderAuditLog.
+type AuditRecord struct {
+	Time       time.Time      `json:"time"`                 // the time at which the call started.
+	Package    tokens.Package `json:"package"`              // the package of the provider that was called.
+	Operation  OperationType  `json:"operation"`            // the provider method that was called.
+	URN        resource.URN   `json:"urn,omitempty"`        // the URN of the resource, if the call targeted one.
+	InputHash  string         `json:"inputHash,omitempty"`  // the hash of the call's input properties, if any.
+	OutputHash string         `json:"outputHash,omitempty"` // the hash of the call's output properties, if any.
+	Duration   time.Duration  `json:"duration"`             // how long the call took, in nanoseconds.
+	Error      string         `json:"error,omitempty"`      // the error returned by the call, if it failed.
+}
+
+// ProviderAuditLog is an OperationHook that writes an AuditRecord for each completed provider call to an io.Writer as
+// a line of JSON. Inputs and outputs are recorded as hex-encoded SHA-256 hashes of their JSON form rather than in full,
+// so that the log does not disclose secrets. Writes are serialized, so the log records calls in the order in which
+// they complete and may be shared by providers that are called concurrently.
+type ProviderAuditLog struct {
+	pkg tokens.Package
+
+	m sync.Mutex
+	e *json.Encoder
+}
+
+var _ OperationHook = (*ProviderAuditLog)(nil)
+
+// NewProviderAuditLog returns a ProviderAuditLog that records calls to a provider for the given package in w.
+func NewProviderAuditLog(pkg tokens.Package, w io.Writer) *ProviderAuditLog {
+	return &ProviderAuditLog{pkg: pkg, e: json.NewEncoder(w)}
+}
+
+// NewAuditLoggingProvider wraps the given provider so that every call made to it is recorded in w by a
+// ProviderAuditLog.
+func NewAuditLoggingProvider(inner Provider, w io.Writer) Provider {
+	return WithHooks(inner, NewProviderAuditLog(inner.Pkg(), w))
+}
+
+func (l *ProviderAuditLog) BeforeOperation(ctx context.Context, op OperationType, urn resource.URN) error {
+	return nil
+}
+
+func (l *ProviderAuditLog) AfterOperation(ctx context.Context, op OperationType, urn resource.URN, err error) {
+	record := AuditRecord{
+		Time:      time.Now().UTC(),
+		Package:   l.pkg,
+		Operation: op,
+		URN:       urn,
+	}
+	if data := operationDataFromContext(ctx); data != nil {
+		record.Time = data.start.UTC()
+		record.Duration = time.Since(data.start)
+		record.InputHash = hashProperties(data.inputs)
+		record.OutputHash = hashProperties(data.outputs)
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+
+	l.m.Lock()
+	defer l.m.Unlock()
+
+	// Auditing is best-effort: a failure to write the log must not fail the operation being observed.
+	_ = l.e.Encode(record)
+}
+
+// hashProperties returns the hex-encoded SHA-256 hash of the JSON form of the given properties, or the empty string
+// if there are no properties.
+func hashProperties(props resource.PropertyMap) string {
+	if props == nil {
+		return ""
+	}
+	bytes, err := json.Marshal(props.Mappable())
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(bytes)
+	return hex.EncodeToString(sum[:])
+}
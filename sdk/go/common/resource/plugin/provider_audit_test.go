@@ -0,0 +1,77 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+func TestAuditLoggingProvider(t *testing.T) {
+	t.Parallel()
+
+	crashes := 1
+	var buf bytes.Buffer
+	prov := NewAuditLoggingProvider(&crashingProvider{crashes: &crashes}, &buf)
+
+	ctx := context.Background()
+	urn := resource.URN("urn:pulumi:stack::project::test:index:Resource::r")
+	news := resource.PropertyMap{"foo": resource.NewStringProperty("bar")}
+
+	// The first call fails and the second succeeds; both are recorded in order.
+	_, _, _, err := prov.Create(ctx, urn, news, 0, false)
+	assert.Error(t, err)
+	_, _, _, err = prov.Create(ctx, urn, news, 0, false)
+	require.NoError(t, err)
+	require.NoError(t, prov.Configure(ctx, NewProviderConfigFromMap(resource.PropertyMap{
+		"region": resource.NewStringProperty("us-west-2"),
+	})))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 3)
+
+	records := make([]AuditRecord, len(lines))
+	for i, line := range lines {
+		require.NoError(t, json.Unmarshal([]byte(line), &records[i]))
+	}
+
+	failed, created, configured := records[0], records[1], records[2]
+	assert.Equal(t, "test", string(failed.Package))
+	assert.Equal(t, OperationCreate, failed.Operation)
+	assert.Equal(t, urn, failed.URN)
+	assert.Equal(t, hashProperties(news), failed.InputHash)
+	assert.Empty(t, failed.OutputHash)
+	assert.Contains(t, failed.Error, "transport is closing")
+	assert.False(t, failed.Time.IsZero())
+
+	// The provider echoes its inputs, so the hashes of its inputs and outputs match.
+	assert.Equal(t, OperationCreate, created.Operation)
+	assert.Equal(t, created.InputHash, created.OutputHash)
+	assert.Empty(t, created.Error)
+	assert.False(t, created.Time.Before(failed.Time))
+
+	assert.Equal(t, OperationConfigure, configured.Operation)
+	assert.Empty(t, configured.URN)
+	assert.NotEmpty(t, configured.InputHash)
+	assert.Empty(t, configured.OutputHash)
+}
@@ -0,0 +1,53 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+// ProviderBase is a Provider that forwards every method to an underlying Provider. It is intended to be embedded in
+// provider decorators (e.g. for caching, retries, or metrics) so that they only need to implement the methods whose
+// behavior they change:
+//
+//	type loggingProvider struct {
+//		plugin.ProviderBase
+//	}
+//
+//	func (p *loggingProvider) Create(ctx context.Context, urn resource.URN, ...) (...) {
+//		log.Printf("creating %v", urn)
+//		return p.ProviderBase.Create(ctx, urn, ...)
+//	}
+type ProviderBase struct {
+	Provider
+}
+
+// NewProviderBase returns a ProviderBase that forwards to the given provider.
+func NewProviderBase(provider Provider) ProviderBase {
+	return ProviderBase{Provider: provider}
+}
+
+// Unwrap returns the provider that this ProviderBase forwards to.
+func (p ProviderBase) Unwrap() Provider {
+	return p.Provider
+}
+
+// ProviderMiddleware decorates a Provider with additional behavior.
+type ProviderMiddleware func(Provider) Provider
+
+// WrapProvider applies the given middleware to a provider. The first middleware is outermost, so it sees each call
+// before any of the others.
+func WrapProvider(provider Provider, middleware ...ProviderMiddleware) Provider {
+	for i := len(middleware) - 1; i >= 0; i-- {
+		provider = middleware[i](provider)
+	}
+	return provider
+}
@@ -0,0 +1,64 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+type tracingProvider struct {
+	ProviderBase
+
+	name  string
+	trace *[]string
+}
+
+func (p *tracingProvider) Delete(ctx context.Context, urn resource.URN, id resource.ID, props resource.PropertyMap,
+	timeout float64) (resource.Status, error) {
+	*p.trace = append(*p.trace, p.name)
+	return p.ProviderBase.Delete(ctx, urn, id, props, timeout)
+}
+
+func TestWrapProvider(t *testing.T) {
+	t.Parallel()
+
+	var trace []string
+	tracing := func(name string) ProviderMiddleware {
+		return func(provider Provider) Provider {
+			return &tracingProvider{ProviderBase: NewProviderBase(provider), name: name, trace: &trace}
+		}
+	}
+
+	inner := &mutatingProvider{
+		deleteF: func(id resource.ID) (resource.Status, error) {
+			trace = append(trace, "inner")
+			return resource.StatusOK, nil
+		},
+	}
+
+	prov := WrapProvider(inner, tracing("outer"), tracing("middle"))
+	_, err := prov.Delete(context.Background(), "", "id", nil, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"outer", "middle", "inner"}, trace)
+
+	// Each decorator exposes the provider that it wraps.
+	middle := prov.(*tracingProvider).Unwrap()
+	assert.Equal(t, Provider(inner), middle.(*tracingProvider).Unwrap())
+}
@@ -0,0 +1,63 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// ProviderCache is a provider decorator that memoizes calls to Configure. A call to Configure with a configuration
+// that is deeply equal to that of the last successful call is not forwarded to the wrapped provider. All other
+// methods are forwarded unchanged.
+//
+// Configurations that contain unknown values are always forwarded, as are calls that follow a failed Configure.
+type ProviderCache struct {
+	ProviderBase
+
+	m          sync.Mutex
+	configured bool                 // true if the last call to Configure succeeded.
+	config     resource.PropertyMap // the configuration passed to the last successful call to Configure.
+}
+
+var _ Provider = (*ProviderCache)(nil)
+
+// NewCachingProvider wraps the given provider in a ProviderCache.
+func NewCachingProvider(inner Provider) Provider {
+	return &ProviderCache{ProviderBase: NewProviderBase(inner)}
+}
+
+// Configure configures the wrapped provider unless it has already been configured with an identical configuration.
+func (p *ProviderCache) Configure(ctx context.Context, cfg ProviderConfig) error {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	if p.configured && p.config.DeepEquals(cfg.PropertyMap) {
+		return nil
+	}
+
+	// Forget the previous configuration before calling the provider: if the call fails, the provider may have been
+	// left partially configured, so the next call must not be skipped.
+	p.configured, p.config = false, nil
+	if err := p.ProviderBase.Configure(ctx, cfg); err != nil {
+		return err
+	}
+	if !cfg.ContainsUnknowns() {
+		p.configured, p.config = true, cfg.PropertyMap.Copy()
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+type configureProvider struct {
+	Provider
+
+	configureF func(cfg ProviderConfig) error
+}
+
+func (p *configureProvider) Configure(ctx context.Context, cfg ProviderConfig) error {
+	return p.configureF(cfg)
+}
+
+func TestCachingProvider(t *testing.T) {
+	t.Parallel()
+
+	var calls []resource.PropertyMap
+	var configureErr error
+	prov := NewCachingProvider(&configureProvider{configureF: func(cfg ProviderConfig) error {
+		calls = append(calls, cfg.PropertyMap)
+		return configureErr
+	}})
+
+	configure := func(m resource.PropertyMap) error {
+		return prov.Configure(context.Background(), NewProviderConfigFromMap(m))
+	}
+	region := func(r string) resource.PropertyMap {
+		return resource.PropertyMap{
+			"region": resource.NewStringProperty(r),
+			"token":  resource.MakeSecret(resource.NewStringProperty("s3cr3t")),
+		}
+	}
+
+	// The first call is always forwarded; an identical configuration is not.
+	assert.NoError(t, configure(region("us-west-2")))
+	assert.NoError(t, configure(region("us-west-2")))
+	assert.Len(t, calls, 1)
+
+	// A changed configuration is forwarded, as is a change back to the original configuration.
+	assert.NoError(t, configure(region("us-east-1")))
+	assert.NoError(t, configure(region("us-west-2")))
+	assert.Len(t, calls, 3)
+
+	// A value that becomes secret (or stops being secret) is a change.
+	plain := region("us-west-2")
+	plain["token"] = resource.NewStringProperty("s3cr3t")
+	assert.NoError(t, configure(plain))
+	assert.Len(t, calls, 4)
+
+	// A failed configuration is not cached, and the next call is forwarded even if it matches an earlier success.
+	configureErr = errors.New("bad credentials")
+	assert.ErrorIs(t, configure(region("us-west-2")), configureErr)
+	configureErr = nil
+	assert.NoError(t, configure(region("us-west-2")))
+	assert.Len(t, calls, 6)
+
+	// Configurations that contain unknowns are always forwarded.
+	unknown := resource.PropertyMap{"region": resource.MakeComputed(resource.NewStringProperty(""))}
+	assert.NoError(t, configure(unknown))
+	assert.NoError(t, configure(unknown))
+	assert.Len(t, calls, 8)
+}
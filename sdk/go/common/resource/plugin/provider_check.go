@@ -0,0 +1,69 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// CheckModifier inspects the result of a provider's Check and may adjust it. Modifiers let callers such as policy
+// packs add failures against the provider's normalized inputs without duplicating the provider's own validation.
+type CheckModifier interface {
+	// ModifyCheckResult is called with the inputs and failures returned by Check for the resource with the given URN,
+	// and returns the inputs and failures to report in their place.
+	ModifyCheckResult(urn resource.URN, result resource.PropertyMap,
+		failures []CheckFailure) (resource.PropertyMap, []CheckFailure)
+}
+
+// CheckModifierFunc adapts an ordinary function to the CheckModifier interface.
+type CheckModifierFunc func(urn resource.URN, result resource.PropertyMap,
+	failures []CheckFailure) (resource.PropertyMap, []CheckFailure)
+
+// ModifyCheckResult calls f(urn, result, failures).
+func (f CheckModifierFunc) ModifyCheckResult(urn resource.URN, result resource.PropertyMap,
+	failures []CheckFailure) (resource.PropertyMap, []CheckFailure) {
+	return f(urn, result, failures)
+}
+
+type checkModifierProvider struct {
+	ProviderBase
+
+	modifiers []CheckModifier
+}
+
+// WithCheckModifiers wraps the given provider so that the result of each successful call to Check is passed through
+// the given modifiers in order. Calls to Check that fail are returned unchanged, and all other methods are forwarded
+// to the wrapped provider.
+func WithCheckModifiers(inner Provider, modifiers ...CheckModifier) Provider {
+	if len(modifiers) == 0 {
+		return inner
+	}
+	return &checkModifierProvider{ProviderBase: NewProviderBase(inner), modifiers: modifiers}
+}
+
+func (p *checkModifierProvider) Check(ctx context.Context, urn resource.URN, olds, news resource.PropertyMap,
+	allowUnknowns bool, randomSeed []byte) (resource.PropertyMap, []CheckFailure, error) {
+
+	result, failures, err := p.ProviderBase.Check(ctx, urn, olds, news, allowUnknowns, randomSeed)
+	if err != nil {
+		return result, failures, err
+	}
+	for _, m := range p.modifiers {
+		result, failures = m.ModifyCheckResult(urn, result, failures)
+	}
+	return result, failures, nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+type checkProvider struct {
+	Provider
+
+	checkF func(news resource.PropertyMap) (resource.PropertyMap, []CheckFailure, error)
+}
+
+func (p *checkProvider) Check(ctx context.Context, urn resource.URN, olds, news resource.PropertyMap,
+	allowUnknowns bool, randomSeed []byte) (resource.PropertyMap, []CheckFailure, error) {
+	return p.checkF(news)
+}
+
+func TestWithCheckModifiers(t *testing.T) {
+	t.Parallel()
+
+	urn := resource.URN("urn:pulumi:stack::project::pkg:index:bucket::b")
+	inner := &checkProvider{checkF: func(news resource.PropertyMap) (resource.PropertyMap, []CheckFailure, error) {
+		// Normalize the inputs, as a real provider might.
+		result := news.Copy()
+		result["acl"] = resource.NewStringProperty("private")
+		return result, []CheckFailure{{Property: "name", Reason: "name is too long"}}, nil
+	}}
+
+	var seen []resource.URN
+	requireTags := CheckModifierFunc(func(urn resource.URN, result resource.PropertyMap,
+		failures []CheckFailure) (resource.PropertyMap, []CheckFailure) {
+		seen = append(seen, urn)
+		// Modifiers see the provider's normalized inputs.
+		assert.Equal(t, resource.NewStringProperty("private"), result["acl"])
+		if _, ok := result["tags"]; !ok {
+			failures = append(failures, CheckFailure{Property: "tags", Reason: "tags are required"})
+		}
+		return result, failures
+	})
+	addDefault := CheckModifierFunc(func(urn resource.URN, result resource.PropertyMap,
+		failures []CheckFailure) (resource.PropertyMap, []CheckFailure) {
+		// Modifiers run in order, so this sees the failure added by requireTags.
+		assert.Len(t, failures, 2)
+		result["versioning"] = resource.NewBoolProperty(true)
+		return result, failures
+	})
+
+	prov := WithCheckModifiers(inner, requireTags, addDefault)
+	news := resource.PropertyMap{"name": resource.NewStringProperty("b")}
+	result, failures, err := prov.Check(context.Background(), urn, nil, news, false, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []resource.URN{urn}, seen)
+	assert.Equal(t, resource.PropertyMap{
+		"name":       resource.NewStringProperty("b"),
+		"acl":        resource.NewStringProperty("private"),
+		"versioning": resource.NewBoolProperty(true),
+	}, result)
+	assert.Equal(t, []CheckFailure{
+		{Property: "name", Reason: "name is too long"},
+		{Property: "tags", Reason: "tags are required"},
+	}, failures)
+
+	// Modifiers are not called if Check fails.
+	checkErr := errors.New("provider unavailable")
+	inner.checkF = func(news resource.PropertyMap) (resource.PropertyMap, []CheckFailure, error) {
+		return nil, nil, checkErr
+	}
+	_, _, err = prov.Check(context.Background(), urn, nil, news, false, nil)
+	assert.ErrorIs(t, err, checkErr)
+	assert.Len(t, seen, 1)
+
+	// Without modifiers, the provider is returned unwrapped.
+	assert.Same(t, inner, WithCheckModifiers(inner))
+}
@@ -0,0 +1,177 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
+)
+
+// ProviderConfig is the configuration passed to a provider's Configure method. It embeds the raw property map and
+// adds typed accessors for reading individual settings.
+//
+// Provider configuration that has round-tripped through the stack's config is often string-encoded, so the accessors
+// for non-string values also accept the string form of the value (e.g. "true" or "42"). Secret values are unwrapped.
+type ProviderConfig struct {
+	resource.PropertyMap
+
+	secretKeys []config.Key // the configuration keys that have secret values.
+}
+
+// NewProviderConfigFromMap returns a ProviderConfig that wraps the given property map.
+func NewProviderConfigFromMap(m resource.PropertyMap) ProviderConfig {
+	return ProviderConfig{PropertyMap: m}
+}
+
+// NewProviderConfig returns a ProviderConfig that wraps the given property map and records which of its keys have
+// secret values.
+func NewProviderConfig(m resource.PropertyMap, secretKeys []config.Key) ProviderConfig {
+	return ProviderConfig{PropertyMap: m, secretKeys: secretKeys}
+}
+
+// SecretKeys returns the configuration keys that have secret values, in sorted order. Providers that are configured
+// by the engine receive the keys of any secret values in their stack configuration.
+func (c ProviderConfig) SecretKeys() []config.Key {
+	if len(c.secretKeys) == 0 {
+		return nil
+	}
+	keys := make(config.KeyArray, len(c.secretKeys))
+	copy(keys, c.secretKeys)
+	sort.Sort(keys)
+	return keys
+}
+
+// lookup returns the value of the given key with any secret unwrapped. It returns false if the key is missing, null,
+// or unknown.
+func (c ProviderConfig) lookup(key resource.PropertyKey) (resource.PropertyValue, bool) {
+	v, ok := c.PropertyMap[key]
+	if !ok {
+		return resource.PropertyValue{}, false
+	}
+	for v.IsSecret() {
+		v = v.SecretValue().Element
+	}
+	if v.IsNull() || v.IsComputed() || v.IsOutput() {
+		return resource.PropertyValue{}, false
+	}
+	return v, true
+}
+
+// GetString returns the string value of the given key. It returns false if the key is missing or is not a string.
+func (c ProviderConfig) GetString(key resource.PropertyKey) (string, bool) {
+	v, ok := c.lookup(key)
+	if !ok || !v.IsString() {
+		return "", false
+	}
+	return v.StringValue(), true
+}
+
+// GetBool returns the boolean value of the given key. It returns false if the key is missing or is not a boolean.
+func (c ProviderConfig) GetBool(key resource.PropertyKey) (bool, bool) {
+	v, ok := c.lookup(key)
+	if !ok {
+		return false, false
+	}
+	switch {
+	case v.IsBool():
+		return v.BoolValue(), true
+	case v.IsString():
+		b, err := strconv.ParseBool(v.StringValue())
+		return b, err == nil
+	default:
+		return false, false
+	}
+}
+
+// GetInt returns the integer value of the given key. It returns false if the key is missing or is not an integer.
+func (c ProviderConfig) GetInt(key resource.PropertyKey) (int, bool) {
+	v, ok := c.lookup(key)
+	if !ok {
+		return 0, false
+	}
+	switch {
+	case v.IsNumber():
+		n := v.NumberValue()
+		if n != float64(int(n)) {
+			return 0, false
+		}
+		return int(n), true
+	case v.IsString():
+		n, err := strconv.Atoi(v.StringValue())
+		return n, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// OptionalBool is like GetBool, but returns the given default if the key is missing or is not a boolean.
+func (c ProviderConfig) OptionalBool(key resource.PropertyKey, def bool) bool {
+	if b, ok := c.GetBool(key); ok {
+		return b
+	}
+	return def
+}
+
+// SecretString returns the plaintext of the string value of the given key, which is expected to be a secret such as a
+// password or token. It returns false if the key is missing or is not a string. The value is returned whether or not
+// it is marked as a secret, as the engine only sends secret values to providers that accept them.
+func (c ProviderConfig) SecretString(key resource.PropertyKey) (string, bool) {
+	return c.GetString(key)
+}
+
+// All returns a copy of the raw configuration.
+func (c ProviderConfig) All() resource.PropertyMap {
+	return c.PropertyMap.Copy()
+}
+
+// RequireString is like GetString, but returns a check failure if the key is missing or is not a string.
+func (c ProviderConfig) RequireString(key resource.PropertyKey) (string, []CheckFailure) {
+	s, ok := c.GetString(key)
+	if !ok {
+		return "", c.requireFailure(key, "a string")
+	}
+	return s, nil
+}
+
+// RequireBool is like GetBool, but returns a check failure if the key is missing or is not a boolean.
+func (c ProviderConfig) RequireBool(key resource.PropertyKey) (bool, []CheckFailure) {
+	b, ok := c.GetBool(key)
+	if !ok {
+		return false, c.requireFailure(key, "a boolean")
+	}
+	return b, nil
+}
+
+// RequireInt is like GetInt, but returns a check failure if the key is missing or is not an integer.
+func (c ProviderConfig) RequireInt(key resource.PropertyKey) (int, []CheckFailure) {
+	n, ok := c.GetInt(key)
+	if !ok {
+		return 0, c.requireFailure(key, "an integer")
+	}
+	return n, nil
+}
+
+// requireFailure returns the check failure for a required key that is either missing or not of the expected kind.
+func (c ProviderConfig) requireFailure(key resource.PropertyKey, expected string) []CheckFailure {
+	reason := fmt.Sprintf("missing required configuration property '%v'", key)
+	if _, ok := c.lookup(key); ok {
+		reason = fmt.Sprintf("configuration property '%v' must be %v", key, expected)
+	}
+	return []CheckFailure{{Property: key, Reason: reason}}
+}
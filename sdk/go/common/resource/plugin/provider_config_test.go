@@ -0,0 +1,144 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
+)
+
+func TestProviderConfigAccessors(t *testing.T) {
+	t.Parallel()
+
+	cfg := NewProviderConfigFromMap(resource.PropertyMap{
+		"region":     resource.NewStringProperty("us-west-2"),
+		"token":      resource.MakeSecret(resource.NewStringProperty("s3cr3t")),
+		"skip":       resource.NewBoolProperty(true),
+		"skipString": resource.NewStringProperty("false"),
+		"retries":    resource.NewNumberProperty(3),
+		"retriesStr": resource.NewStringProperty("5"),
+		"fraction":   resource.NewNumberProperty(1.5),
+		"unknown":    resource.MakeComputed(resource.NewStringProperty("")),
+	})
+
+	s, ok := cfg.GetString("region")
+	assert.True(t, ok)
+	assert.Equal(t, "us-west-2", s)
+
+	s, ok = cfg.GetString("token")
+	assert.True(t, ok)
+	assert.Equal(t, "s3cr3t", s)
+
+	_, ok = cfg.GetString("skip")
+	assert.False(t, ok)
+	_, ok = cfg.GetString("unknown")
+	assert.False(t, ok)
+
+	b, ok := cfg.GetBool("skip")
+	assert.True(t, ok)
+	assert.True(t, b)
+
+	b, ok = cfg.GetBool("skipString")
+	assert.True(t, ok)
+	assert.False(t, b)
+
+	n, ok := cfg.GetInt("retries")
+	assert.True(t, ok)
+	assert.Equal(t, 3, n)
+
+	n, ok = cfg.GetInt("retriesStr")
+	assert.True(t, ok)
+	assert.Equal(t, 5, n)
+
+	_, ok = cfg.GetInt("fraction")
+	assert.False(t, ok)
+}
+
+func TestProviderConfigOptionalSecretAndAll(t *testing.T) {
+	t.Parallel()
+
+	m := resource.PropertyMap{
+		"skip":     resource.NewStringProperty("true"),
+		"invalid":  resource.NewStringProperty("maybe"),
+		"token":    resource.MakeSecret(resource.NewStringProperty("s3cr3t")),
+		"password": resource.NewStringProperty("plain"),
+	}
+	cfg := NewProviderConfigFromMap(m)
+
+	assert.True(t, cfg.OptionalBool("skip", false))
+	assert.True(t, cfg.OptionalBool("invalid", true))
+	assert.False(t, cfg.OptionalBool("missing", false))
+
+	token, ok := cfg.SecretString("token")
+	assert.True(t, ok)
+	assert.Equal(t, "s3cr3t", token)
+
+	password, ok := cfg.SecretString("password")
+	assert.True(t, ok)
+	assert.Equal(t, "plain", password)
+
+	_, ok = cfg.SecretString("missing")
+	assert.False(t, ok)
+
+	// All returns a copy, so changes to it do not affect the configuration.
+	all := cfg.All()
+	assert.Equal(t, m, all)
+	delete(all, "token")
+	assert.Contains(t, cfg.PropertyMap, resource.PropertyKey("token"))
+}
+
+func TestProviderConfigRequire(t *testing.T) {
+	t.Parallel()
+
+	cfg := NewProviderConfigFromMap(resource.PropertyMap{
+		"region":  resource.NewStringProperty("us-west-2"),
+		"retries": resource.NewStringProperty("many"),
+	})
+
+	region, failures := cfg.RequireString("region")
+	assert.Empty(t, failures)
+	assert.Equal(t, "us-west-2", region)
+
+	_, failures = cfg.RequireBool("insecure")
+	assert.Equal(t, []CheckFailure{{
+		Property: "insecure",
+		Reason:   "missing required configuration property 'insecure'",
+	}}, failures)
+
+	_, failures = cfg.RequireInt("retries")
+	assert.Equal(t, []CheckFailure{{
+		Property: "retries",
+		Reason:   "configuration property 'retries' must be an integer",
+	}}, failures)
+
+	// A zero-valued config behaves like an empty map.
+	_, failures = ProviderConfig{}.RequireString("region")
+	assert.Len(t, failures, 1)
+}
+
+func TestProviderConfigSecretKeys(t *testing.T) {
+	t.Parallel()
+
+	m := resource.PropertyMap{"region": resource.NewStringProperty("us-west-2")}
+	assert.Nil(t, NewProviderConfigFromMap(m).SecretKeys())
+
+	cfg := NewProviderConfig(m, []config.Key{config.MustMakeKey("aws", "token"), config.MustMakeKey("aws", "key")})
+	assert.Equal(t, []config.Key{config.MustMakeKey("aws", "key"), config.MustMakeKey("aws", "token")},
+		cfg.SecretKeys())
+}
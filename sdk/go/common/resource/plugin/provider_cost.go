@@ -0,0 +1,74 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"sync"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// CostEstimate is a provider's estimate of the cost of running a resource.
+type CostEstimate struct {
+	MonthlyCostUSD float64 // the estimated monthly cost of the resource, in US dollars.
+	Currency       string  // the currency that the provider priced the resource in, e.g. "EUR".
+	Confidence     float64 // the provider's confidence in the estimate, from 0 (a guess) to 1 (exact).
+}
+
+// CostSummary sums the cost estimates for a set of resources, e.g. the resources changed by a preview. The zero value
+// is ready to use, and a CostSummary is safe to use concurrently.
+type CostSummary struct {
+	m         sync.Mutex
+	estimates map[resource.URN]CostEstimate
+}
+
+// Add records the estimate for the resource with the given URN, replacing any estimate previously recorded for it.
+func (s *CostSummary) Add(urn resource.URN, estimate CostEstimate) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if s.estimates == nil {
+		s.estimates = make(map[resource.URN]CostEstimate)
+	}
+	s.estimates[urn] = estimate
+}
+
+// Len returns the number of resources that have a recorded estimate.
+func (s *CostSummary) Len() int {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	return len(s.estimates)
+}
+
+// Total returns the sum of the recorded estimates, priced in US dollars. The total is only as reliable as the least
+// reliable estimate that it includes, so its confidence is the lowest confidence of any recorded estimate. An empty
+// summary totals zero with zero confidence.
+func (s *CostSummary) Total() CostEstimate {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	total := CostEstimate{Currency: "USD", Confidence: 1}
+	for _, e := range s.estimates {
+		total.MonthlyCostUSD += e.MonthlyCostUSD
+		if e.Confidence < total.Confidence {
+			total.Confidence = e.Confidence
+		}
+	}
+	if len(s.estimates) == 0 {
+		total.Confidence = 0
+	}
+	return total
+}
@@ -0,0 +1,44 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+func TestCostSummary(t *testing.T) {
+	t.Parallel()
+
+	a := resource.URN("urn:pulumi:stack::project::test:index:res::a")
+	b := resource.URN("urn:pulumi:stack::project::test:index:res::b")
+
+	var s CostSummary
+	assert.Equal(t, 0, s.Len())
+	assert.Equal(t, CostEstimate{Currency: "USD"}, s.Total())
+
+	s.Add(a, CostEstimate{MonthlyCostUSD: 10, Currency: "USD", Confidence: 1})
+	s.Add(b, CostEstimate{MonthlyCostUSD: 2.5, Currency: "EUR", Confidence: 0.5})
+	assert.Equal(t, 2, s.Len())
+	assert.Equal(t, CostEstimate{MonthlyCostUSD: 12.5, Currency: "USD", Confidence: 0.5}, s.Total())
+
+	// A later estimate for the same resource replaces the earlier one.
+	s.Add(a, CostEstimate{MonthlyCostUSD: 20, Currency: "USD", Confidence: 1})
+	assert.Equal(t, 2, s.Len())
+	assert.Equal(t, CostEstimate{MonthlyCostUSD: 22.5, Currency: "USD", Confidence: 0.5}, s.Total())
+}
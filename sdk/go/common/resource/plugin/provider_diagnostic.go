@@ -0,0 +1,130 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
+)
+
+// DiagnosticReporter receives the diagnostics that providers report during the operations that it was passed to with
+// ContextWithDiagnosticReporter. The URN is that of the resource that the diagnostic is about, or empty for
+// diagnostics about the provider itself. Reporters must be safe for concurrent use.
+type DiagnosticReporter func(urn resource.URN, d ProviderDiagnostic)
+
+type diagnosticReporterKey struct{}
+
+// ContextWithDiagnosticReporter returns a context that sends the diagnostics that providers report during operations
+// issued with it to the given reporter.
+func ContextWithDiagnosticReporter(ctx context.Context, report DiagnosticReporter) context.Context {
+	return context.WithValue(ctx, diagnosticReporterKey{}, report)
+}
+
+// ReportDiagnostic reports a non-fatal diagnostic about the resource with the given URN, or about the provider itself
+// if the URN is empty, to the caller of the operation that ctx was passed to. Diagnostics reported while serving a
+// request over gRPC are returned to the engine on the operation's response. The diagnostic is dropped if the caller
+// did not ask for diagnostics.
+func ReportDiagnostic(ctx context.Context, urn resource.URN, d ProviderDiagnostic) {
+	if report, ok := ctx.Value(diagnosticReporterKey{}).(DiagnosticReporter); ok && report != nil {
+		report(urn, d)
+	}
+}
+
+// reportDiagnostics reports the diagnostics that a provider returned on the response to an operation on the resource
+// with the given URN.
+func reportDiagnostics(ctx context.Context, urn resource.URN, rpcDiags []*pulumirpc.ProviderDiagnostic) {
+	for _, d := range UnmarshalProviderDiagnostics(rpcDiags) {
+		ReportDiagnostic(ctx, urn, d)
+	}
+}
+
+// diagnosticCollector gathers the diagnostics that a provider reports while the server handles a request, so that
+// they can be returned on the response.
+type diagnosticCollector struct {
+	m     sync.Mutex
+	diags []ProviderDiagnostic
+}
+
+// collectDiagnostics returns a context that collects the diagnostics reported during an operation into the returned
+// collector.
+func collectDiagnostics(ctx context.Context) (context.Context, *diagnosticCollector) {
+	c := &diagnosticCollector{}
+	return ContextWithDiagnosticReporter(ctx, func(_ resource.URN, d ProviderDiagnostic) {
+		c.m.Lock()
+		defer c.m.Unlock()
+		c.diags = append(c.diags, d)
+	}), c
+}
+
+// marshal returns the collected diagnostics in their gRPC representation.
+func (c *diagnosticCollector) marshal() []*pulumirpc.ProviderDiagnostic {
+	c.m.Lock()
+	defer c.m.Unlock()
+	return MarshalProviderDiagnostics(c.diags)
+}
+
+// MarshalProviderDiagnostics converts provider diagnostics into their gRPC representation. Severities other than
+// diag.Warning and diag.Error are sent as informational messages.
+func MarshalProviderDiagnostics(diags []ProviderDiagnostic) []*pulumirpc.ProviderDiagnostic {
+	if len(diags) == 0 {
+		return nil
+	}
+	rpcDiags := make([]*pulumirpc.ProviderDiagnostic, len(diags))
+	for i, d := range diags {
+		severity := pulumirpc.ProviderDiagnostic_INFO
+		switch d.Severity {
+		case diag.Warning:
+			severity = pulumirpc.ProviderDiagnostic_WARNING
+		case diag.Error:
+			severity = pulumirpc.ProviderDiagnostic_ERROR
+		}
+		rpcDiags[i] = &pulumirpc.ProviderDiagnostic{
+			Severity: severity,
+			Summary:  d.Summary,
+			Detail:   d.Detail,
+			Url:      d.URL,
+		}
+	}
+	return rpcDiags
+}
+
+// UnmarshalProviderDiagnostics converts provider diagnostics from their gRPC representation. Unrecognized severities
+// are treated as errors.
+func UnmarshalProviderDiagnostics(rpcDiags []*pulumirpc.ProviderDiagnostic) []ProviderDiagnostic {
+	if len(rpcDiags) == 0 {
+		return nil
+	}
+	diags := make([]ProviderDiagnostic, len(rpcDiags))
+	for i, d := range rpcDiags {
+		severity := diag.Error
+		switch d.GetSeverity() {
+		case pulumirpc.ProviderDiagnostic_INFO:
+			severity = diag.Info
+		case pulumirpc.ProviderDiagnostic_WARNING:
+			severity = diag.Warning
+		}
+		diags[i] = ProviderDiagnostic{
+			Severity: severity,
+			Summary:  d.GetSummary(),
+			Detail:   d.GetDetail(),
+			URL:      d.GetUrl(),
+		}
+	}
+	return diags
+}
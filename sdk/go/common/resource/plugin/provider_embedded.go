@@ -0,0 +1,388 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/golang/protobuf/proto"
+	pbempty "github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
+)
+
+// EmbeddedProvider is a Provider that runs in the engine's own process. Rather than launching a plugin and talking to
+// it over gRPC, each method call is dispatched directly to a ResourceProviderServer, e.g. one created with
+// NewProviderServer. There is no subprocess and no gRPC connection, but the calls are not free: every request and
+// response is still converted to and from the same protobuf messages that a plugin would exchange. They are just never
+// serialized or sent over a connection. This keeps an embedded provider's behavior identical to that of its
+// out-of-process equivalent. Callers that already hold an in-process Provider should call it directly instead of
+// wrapping it with NewProviderServer and NewEmbeddedProvider.
+//
+// There is no process to attach to or to signal, so Attach and SignalCancellation do nothing.
+type EmbeddedProvider struct {
+	ProviderBase
+}
+
+var _ GrpcProvider = (*EmbeddedProvider)(nil)
+
+// NewEmbeddedProvider returns a provider for the given package that dispatches its calls to the given server.
+func NewEmbeddedProvider(ctx *Context, pkg tokens.Package, server pulumirpc.ResourceProviderServer) *EmbeddedProvider {
+	client := &embeddedProviderClient{server: server}
+	return &EmbeddedProvider{ProviderBase: NewProviderBase(NewProviderWithClient(ctx, pkg, client, false))}
+}
+
+// Attach does nothing, as an embedded provider already shares the engine's process.
+func (p *EmbeddedProvider) Attach(ctx context.Context, address string) error {
+	return nil
+}
+
+// SetLogSink does nothing, as an embedded provider has no stderr of its own.
+func (p *EmbeddedProvider) SetLogSink(sink io.Writer) {}
+
+// SignalCancellation does nothing, as there is no plugin process to signal.
+func (p *EmbeddedProvider) SignalCancellation(ctx context.Context) error {
+	return nil
+}
+
+// embeddedProviderClient is a ResourceProviderClient that calls the methods of a ResourceProviderServer directly.
+// Call options are ignored.
+type embeddedProviderClient struct {
+	server pulumirpc.ResourceProviderServer
+}
+
+func (c *embeddedProviderClient) GetSchema(ctx context.Context, in *pulumirpc.GetSchemaRequest,
+	_ ...grpc.CallOption) (*pulumirpc.GetSchemaResponse, error) {
+	return c.server.GetSchema(ctx, in)
+}
+
+func (c *embeddedProviderClient) CheckConfig(ctx context.Context, in *pulumirpc.CheckRequest,
+	_ ...grpc.CallOption) (*pulumirpc.CheckResponse, error) {
+	return c.server.CheckConfig(ctx, in)
+}
+
+func (c *embeddedProviderClient) DiffConfig(ctx context.Context, in *pulumirpc.DiffRequest,
+	_ ...grpc.CallOption) (*pulumirpc.DiffResponse, error) {
+	return c.server.DiffConfig(ctx, in)
+}
+
+func (c *embeddedProviderClient) Configure(ctx context.Context, in *pulumirpc.ConfigureRequest,
+	_ ...grpc.CallOption) (*pulumirpc.ConfigureResponse, error) {
+	return c.server.Configure(ctx, in)
+}
+
+func (c *embeddedProviderClient) Invoke(ctx context.Context, in *pulumirpc.InvokeRequest,
+	_ ...grpc.CallOption) (*pulumirpc.InvokeResponse, error) {
+	return c.server.Invoke(ctx, in)
+}
+
+func (c *embeddedProviderClient) StreamInvoke(ctx context.Context, in *pulumirpc.InvokeRequest,
+	_ ...grpc.CallOption) (pulumirpc.ResourceProvider_StreamInvokeClient, error) {
+	s := newEmbeddedStream(ctx)
+	go func() { s.finish(c.server.StreamInvoke(in, &embeddedStreamInvokeServer{s})) }()
+	return &embeddedStreamInvokeClient{s}, nil
+}
+
+func (c *embeddedProviderClient) Call(ctx context.Context, in *pulumirpc.CallRequest,
+	_ ...grpc.CallOption) (*pulumirpc.CallResponse, error) {
+	return c.server.Call(ctx, in)
+}
+
+func (c *embeddedProviderClient) Check(ctx context.Context, in *pulumirpc.CheckRequest,
+	_ ...grpc.CallOption) (*pulumirpc.CheckResponse, error) {
+	return c.server.Check(ctx, in)
+}
+
+func (c *embeddedProviderClient) Diff(ctx context.Context, in *pulumirpc.DiffRequest,
+	_ ...grpc.CallOption) (*pulumirpc.DiffResponse, error) {
+	return c.server.Diff(ctx, in)
+}
+
+func (c *embeddedProviderClient) Create(ctx context.Context, in *pulumirpc.CreateRequest,
+	_ ...grpc.CallOption) (*pulumirpc.CreateResponse, error) {
+	return c.server.Create(ctx, in)
+}
+
+func (c *embeddedProviderClient) Read(ctx context.Context, in *pulumirpc.ReadRequest,
+	_ ...grpc.CallOption) (*pulumirpc.ReadResponse, error) {
+	return c.server.Read(ctx, in)
+}
+
+func (c *embeddedProviderClient) Update(ctx context.Context, in *pulumirpc.UpdateRequest,
+	_ ...grpc.CallOption) (*pulumirpc.UpdateResponse, error) {
+	return c.server.Update(ctx, in)
+}
+
+func (c *embeddedProviderClient) Delete(ctx context.Context, in *pulumirpc.DeleteRequest,
+	_ ...grpc.CallOption) (*pbempty.Empty, error) {
+	return c.server.Delete(ctx, in)
+}
+
+func (c *embeddedProviderClient) Construct(ctx context.Context, in *pulumirpc.ConstructRequest,
+	_ ...grpc.CallOption) (*pulumirpc.ConstructResponse, error) {
+	return c.server.Construct(ctx, in)
+}
+
+func (c *embeddedProviderClient) Cancel(ctx context.Context, in *pbempty.Empty,
+	_ ...grpc.CallOption) (*pbempty.Empty, error) {
+	return c.server.Cancel(ctx, in)
+}
+
+func (c *embeddedProviderClient) GetPluginInfo(ctx context.Context, in *pbempty.Empty,
+	_ ...grpc.CallOption) (*pulumirpc.PluginInfo, error) {
+	return c.server.GetPluginInfo(ctx, in)
+}
+
+func (c *embeddedProviderClient) Attach(ctx context.Context, in *pulumirpc.PluginAttach,
+	_ ...grpc.CallOption) (*pbempty.Empty, error) {
+	return c.server.Attach(ctx, in)
+}
+
+func (c *embeddedProviderClient) GetMapping(ctx context.Context, in *pulumirpc.GetMappingRequest,
+	_ ...grpc.CallOption) (*pulumirpc.GetMappingResponse, error) {
+	return c.server.GetMapping(ctx, in)
+}
+
+func (c *embeddedProviderClient) SupportsFeature(ctx context.Context, in *pulumirpc.ProviderSupportsFeatureRequest,
+	_ ...grpc.CallOption) (*pulumirpc.ProviderSupportsFeatureResponse, error) {
+	return c.server.SupportsFeature(ctx, in)
+}
+
+func (c *embeddedProviderClient) ReadStream(ctx context.Context, in *pulumirpc.ReadRequest,
+	_ ...grpc.CallOption) (pulumirpc.ResourceProvider_ReadStreamClient, error) {
+	s := newEmbeddedStream(ctx)
+	go func() { s.finish(c.server.ReadStream(in, &embeddedReadStreamServer{s})) }()
+	return &embeddedReadStreamClient{s}, nil
+}
+
+func (c *embeddedProviderClient) Refresh(ctx context.Context, in *pulumirpc.ReadRequest,
+	_ ...grpc.CallOption) (*pulumirpc.ReadResponse, error) {
+	return c.server.Refresh(ctx, in)
+}
+
+func (c *embeddedProviderClient) MigrateState(ctx context.Context, in *pulumirpc.MigrateStateRequest,
+	_ ...grpc.CallOption) (*pulumirpc.MigrateStateResponse, error) {
+	return c.server.MigrateState(ctx, in)
+}
+
+func (c *embeddedProviderClient) WatchResourceChanges(ctx context.Context, in *pbempty.Empty,
+	_ ...grpc.CallOption) (pulumirpc.ResourceProvider_WatchResourceChangesClient, error) {
+	s := newEmbeddedStream(ctx)
+	go func() { s.finish(c.server.WatchResourceChanges(in, &embeddedWatchResourceChangesServer{s})) }()
+	return &embeddedWatchResourceChangesClient{s}, nil
+}
+
+func (c *embeddedProviderClient) StreamCreate(ctx context.Context, in *pulumirpc.CreateRequest,
+	_ ...grpc.CallOption) (pulumirpc.ResourceProvider_StreamCreateClient, error) {
+	s := newEmbeddedStream(ctx)
+	go func() { s.finish(c.server.StreamCreate(in, &embeddedStreamCreateServer{s})) }()
+	return &embeddedStreamCreateClient{s}, nil
+}
+
+func (c *embeddedProviderClient) EstimateCost(ctx context.Context, in *pulumirpc.EstimateCostRequest,
+	_ ...grpc.CallOption) (*pulumirpc.EstimateCostResponse, error) {
+	return c.server.EstimateCost(ctx, in)
+}
+
+func (c *embeddedProviderClient) GetSupportedVersions(ctx context.Context, in *pbempty.Empty,
+	_ ...grpc.CallOption) (*pulumirpc.GetSupportedVersionsResponse, error) {
+	return c.server.GetSupportedVersions(ctx, in)
+}
+
+func (c *embeddedProviderClient) ParameterizeByValue(ctx context.Context, in *pulumirpc.ParameterizeByValueRequest,
+	_ ...grpc.CallOption) (*pulumirpc.ParameterizeResponse, error) {
+	return c.server.ParameterizeByValue(ctx, in)
+}
+
+func (c *embeddedProviderClient) ParameterizeByReference(ctx context.Context,
+	in *pulumirpc.ParameterizeByReferenceRequest, _ ...grpc.CallOption) (*pulumirpc.ParameterizeResponse, error) {
+	return c.server.ParameterizeByReference(ctx, in)
+}
+
+func (c *embeddedProviderClient) PrepareImport(ctx context.Context, in *pulumirpc.PrepareImportRequest,
+	_ ...grpc.CallOption) (*pulumirpc.PrepareImportResponse, error) {
+	return c.server.PrepareImport(ctx, in)
+}
+
+func (c *embeddedProviderClient) ConfigChecksumMatch(ctx context.Context, in *pulumirpc.ConfigChecksumMatchRequest,
+	_ ...grpc.CallOption) (*pulumirpc.ConfigChecksumMatchResponse, error) {
+	return c.server.ConfigChecksumMatch(ctx, in)
+}
+
+func (c *embeddedProviderClient) WaitForResourceReady(ctx context.Context, in *pulumirpc.WaitForResourceReadyRequest,
+	_ ...grpc.CallOption) (*pbempty.Empty, error) {
+	return c.server.WaitForResourceReady(ctx, in)
+}
+
+func (c *embeddedProviderClient) GetResourceAliases(ctx context.Context, in *pulumirpc.GetResourceAliasesRequest,
+	_ ...grpc.CallOption) (*pulumirpc.GetResourceAliasesResponse, error) {
+	return c.server.GetResourceAliases(ctx, in)
+}
+
+func (c *embeddedProviderClient) ValidateProvider(ctx context.Context, in *pbempty.Empty,
+	_ ...grpc.CallOption) (*pbempty.Empty, error) {
+	return c.server.ValidateProvider(ctx, in)
+}
+
+func (c *embeddedProviderClient) WatchConfig(ctx context.Context, in *pbempty.Empty,
+	_ ...grpc.CallOption) (pulumirpc.ResourceProvider_WatchConfigClient, error) {
+	s := newEmbeddedStream(ctx)
+	go func() { s.finish(c.server.WatchConfig(in, &embeddedWatchConfigServer{s})) }()
+	return &embeddedWatchConfigClient{s}, nil
+}
+
+// embeddedStream carries the responses of a server-streaming RPC from a server method, which runs in its own
+// goroutine, to the client. It serves as both the grpc.ServerStream and the grpc.ClientStream of the RPC.
+type embeddedStream struct {
+	ctx  context.Context
+	msgs chan interface{} // unbuffered, so every response has been received by the time the server returns.
+	done chan struct{}    // closed when the server method returns.
+	err  error            // the error returned by the server method; only valid once done is closed.
+}
+
+func newEmbeddedStream(ctx context.Context) *embeddedStream {
+	return &embeddedStream{ctx: ctx, msgs: make(chan interface{}), done: make(chan struct{})}
+}
+
+// finish records the result of the server method and ends the stream.
+func (s *embeddedStream) finish(err error) {
+	s.err = err
+	close(s.done)
+}
+
+func (s *embeddedStream) send(m interface{}) error {
+	select {
+	case s.msgs <- m:
+		return nil
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
+
+// recv returns the next response, or io.EOF once the server method has returned successfully.
+func (s *embeddedStream) recv() (interface{}, error) {
+	select {
+	case m := <-s.msgs:
+		return m, nil
+	case <-s.done:
+		if s.err != nil {
+			return nil, s.err
+		}
+		return nil, io.EOF
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	}
+}
+
+func (s *embeddedStream) Context() context.Context     { return s.ctx }
+func (s *embeddedStream) Header() (metadata.MD, error) { return nil, nil }
+func (s *embeddedStream) Trailer() metadata.MD         { return nil }
+func (s *embeddedStream) CloseSend() error             { return nil }
+func (s *embeddedStream) SetHeader(metadata.MD) error  { return nil }
+func (s *embeddedStream) SendHeader(metadata.MD) error { return nil }
+func (s *embeddedStream) SetTrailer(metadata.MD)       {}
+func (s *embeddedStream) SendMsg(m interface{}) error  { return s.send(m) }
+
+func (s *embeddedStream) RecvMsg(m interface{}) error {
+	msg, err := s.recv()
+	if err != nil {
+		return err
+	}
+	dst, ok := m.(proto.Message)
+	if !ok {
+		return errors.New("embedded stream messages must be protobuf messages")
+	}
+	proto.Merge(dst, msg.(proto.Message))
+	return nil
+}
+
+type embeddedStreamInvokeServer struct{ *embeddedStream }
+
+func (s *embeddedStreamInvokeServer) Send(m *pulumirpc.InvokeResponse) error { return s.send(m) }
+
+type embeddedStreamInvokeClient struct{ *embeddedStream }
+
+func (s *embeddedStreamInvokeClient) Recv() (*pulumirpc.InvokeResponse, error) {
+	m, err := s.recv()
+	if err != nil {
+		return nil, err
+	}
+	return m.(*pulumirpc.InvokeResponse), nil
+}
+
+type embeddedReadStreamServer struct{ *embeddedStream }
+
+func (s *embeddedReadStreamServer) Send(m *pulumirpc.ReadResponse) error { return s.send(m) }
+
+type embeddedReadStreamClient struct{ *embeddedStream }
+
+func (s *embeddedReadStreamClient) Recv() (*pulumirpc.ReadResponse, error) {
+	m, err := s.recv()
+	if err != nil {
+		return nil, err
+	}
+	return m.(*pulumirpc.ReadResponse), nil
+}
+
+type embeddedWatchResourceChangesServer struct{ *embeddedStream }
+
+func (s *embeddedWatchResourceChangesServer) Send(m *pulumirpc.ResourceChangedEvent) error {
+	return s.send(m)
+}
+
+type embeddedWatchResourceChangesClient struct{ *embeddedStream }
+
+func (s *embeddedWatchResourceChangesClient) Recv() (*pulumirpc.ResourceChangedEvent, error) {
+	m, err := s.recv()
+	if err != nil {
+		return nil, err
+	}
+	return m.(*pulumirpc.ResourceChangedEvent), nil
+}
+
+type embeddedStreamCreateServer struct{ *embeddedStream }
+
+func (s *embeddedStreamCreateServer) Send(m *pulumirpc.CreateResponse) error { return s.send(m) }
+
+type embeddedStreamCreateClient struct{ *embeddedStream }
+
+func (s *embeddedStreamCreateClient) Recv() (*pulumirpc.CreateResponse, error) {
+	m, err := s.recv()
+	if err != nil {
+		return nil, err
+	}
+	return m.(*pulumirpc.CreateResponse), nil
+}
+
+type embeddedWatchConfigServer struct{ *embeddedStream }
+
+func (s *embeddedWatchConfigServer) Send(m *pulumirpc.ConfigChangedEvent) error {
+	return s.send(m)
+}
+
+type embeddedWatchConfigClient struct{ *embeddedStream }
+
+func (s *embeddedWatchConfigClient) Recv() (*pulumirpc.ConfigChangedEvent, error) {
+	m, err := s.recv()
+	if err != nil {
+		return nil, err
+	}
+	return m.(*pulumirpc.ConfigChangedEvent), nil
+}
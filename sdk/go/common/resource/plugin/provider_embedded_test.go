@@ -0,0 +1,103 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+type embeddableProvider struct {
+	Provider
+
+	pages []resource.PropertyMap
+}
+
+func (p *embeddableProvider) Configure(ctx context.Context, cfg ProviderConfig) error {
+	return nil
+}
+
+func (p *embeddableProvider) SchemaVersion(ctx context.Context) (int, error) {
+	return 0, nil
+}
+
+func (p *embeddableProvider) Create(ctx context.Context, urn resource.URN, news resource.PropertyMap, timeout float64,
+	preview bool) (resource.ID, resource.PropertyMap, resource.Status, error) {
+	return "id", resource.PropertyMap{"out": news["in"]}, resource.StatusOK, nil
+}
+
+func (p *embeddableProvider) StreamInvoke(ctx context.Context, tok tokens.ModuleMember, args resource.PropertyMap,
+	onNext func(StreamInvokeEvent) error) ([]CheckFailure, error) {
+	for _, page := range p.pages {
+		if err := onNext(NewStreamInvokeEvent(page)); err != nil {
+			return nil, err
+		}
+	}
+	if tok == "test:index:fail" {
+		return nil, errors.New("boom")
+	}
+	return nil, nil
+}
+
+func TestEmbeddedProvider(t *testing.T) {
+	t.Parallel()
+
+	inner := &embeddableProvider{pages: []resource.PropertyMap{
+		{"page": resource.NewNumberProperty(1)},
+		{"page": resource.NewNumberProperty(2)},
+	}}
+	prov := NewEmbeddedProvider(nil, "test", NewProviderServer(inner))
+	require.NoError(t, prov.Configure(context.Background(), NewProviderConfigFromMap(resource.PropertyMap{})))
+
+	// Unary calls are marshaled and dispatched directly to the wrapped server.
+	id, outs, _, err := prov.Create(context.Background(), "urn:pulumi:stack::project::test:index:res::a",
+		resource.PropertyMap{"in": resource.NewStringProperty("value")}, 0, false)
+	require.NoError(t, err)
+	assert.Equal(t, resource.ID("id"), id)
+	assert.Equal(t, resource.PropertyMap{"out": resource.NewStringProperty("value")}, outs)
+
+	// Streamed responses arrive in order, followed by the server's result.
+	var pages []resource.PropertyMap
+	_, err = prov.StreamInvoke(context.Background(), "test:index:list", nil, func(e StreamInvokeEvent) error {
+		pages = append(pages, e.Payload)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, inner.pages, pages)
+
+	_, err = prov.StreamInvoke(context.Background(), "test:index:fail", nil, func(StreamInvokeEvent) error {
+		return nil
+	})
+	assert.EqualError(t, err, "boom")
+
+	// The server stops streaming once the client does.
+	stop := errors.New("stop")
+	_, err = prov.StreamInvoke(context.Background(), "test:index:list", nil, func(StreamInvokeEvent) error {
+		return stop
+	})
+	assert.Equal(t, stop, err)
+
+	// Neither of these reaches the wrapped provider, which would panic.
+	assert.NoError(t, prov.Attach(context.Background(), "127.0.0.1:0"))
+	assert.NoError(t, prov.SignalCancellation(context.Background()))
+	assert.NoError(t, prov.Close())
+}
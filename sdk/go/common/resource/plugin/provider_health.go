@@ -0,0 +1,89 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/logging"
+)
+
+// HealthChecker periodically probes a provider for liveness by calling GetPluginInfo, so that long-running hosts can
+// notice a provider that has crashed or disconnected before the next operation against it fails. The zero value is
+// ready to use. A HealthChecker may be restarted after it has been stopped.
+type HealthChecker struct {
+	m      sync.Mutex
+	cancel context.CancelFunc // cancels the running probe loop; nil if the checker is not running.
+	done   chan struct{}      // closed when the running probe loop exits.
+}
+
+// Start begins probing the given provider every interval. Each failed probe calls onUnhealthy with the error that the
+// probe returned; probing continues afterwards until Stop is called. Start must not be called on a HealthChecker that
+// is already running.
+func (h *HealthChecker) Start(p Provider, interval time.Duration, onUnhealthy func(error)) {
+	contract.Requiref(p != nil, "p", "must not be nil")
+	contract.Requiref(interval > 0, "interval", "must be positive, not %v", interval)
+	contract.Requiref(onUnhealthy != nil, "onUnhealthy", "must not be nil")
+
+	h.m.Lock()
+	defer h.m.Unlock()
+	contract.Assertf(h.cancel == nil, "HealthChecker is already running")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	h.cancel, h.done = cancel, done
+
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			// Bound each probe by the interval so that a hung provider is reported rather than blocking the loop.
+			probeCtx, probeCancel := context.WithTimeout(ctx, interval)
+			_, err := p.GetPluginInfo(probeCtx)
+			probeCancel()
+
+			// A probe that was interrupted by Stop says nothing about the provider's health.
+			if err != nil && ctx.Err() == nil {
+				logging.V(7).Infof("HealthChecker: provider %v is unhealthy: %v", p.Pkg(), err)
+				onUnhealthy(err)
+			}
+		}
+	}()
+}
+
+// Stop stops probing and waits for any probe that is in progress to finish. Stop has no effect if the HealthChecker is
+// not running.
+func (h *HealthChecker) Stop() {
+	h.m.Lock()
+	cancel, done := h.cancel, h.done
+	h.cancel, h.done = nil, nil
+	h.m.Unlock()
+
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+}
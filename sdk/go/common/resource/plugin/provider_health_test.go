@@ -0,0 +1,84 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+type pluginInfoProvider struct {
+	Provider
+
+	getPluginInfoF func() error
+}
+
+func (p *pluginInfoProvider) Pkg() tokens.Package {
+	return "test"
+}
+
+func (p *pluginInfoProvider) GetPluginInfo(ctx context.Context) (workspace.PluginInfo, error) {
+	return workspace.PluginInfo{}, p.getPluginInfoF()
+}
+
+func TestHealthChecker(t *testing.T) {
+	t.Parallel()
+
+	var probes, healthy int32
+	atomic.StoreInt32(&healthy, 1)
+	prov := &pluginInfoProvider{getPluginInfoF: func() error {
+		atomic.AddInt32(&probes, 1)
+		if atomic.LoadInt32(&healthy) == 0 {
+			return errors.New("provider has exited")
+		}
+		return nil
+	}}
+
+	unhealthy := make(chan error, 100)
+	var h HealthChecker
+	h.Start(prov, time.Millisecond, func(err error) { unhealthy <- err })
+
+	// Healthy providers are probed without being reported.
+	assert.Eventually(t, func() bool { return atomic.LoadInt32(&probes) >= 3 }, 5*time.Second, time.Millisecond)
+	assert.Empty(t, unhealthy)
+
+	// Failed probes are reported.
+	atomic.StoreInt32(&healthy, 0)
+	select {
+	case err := <-unhealthy:
+		assert.EqualError(t, err, "provider has exited")
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "the unhealthy provider was not reported")
+	}
+
+	// Once stopped, the provider is no longer probed.
+	h.Stop()
+	stopped := atomic.LoadInt32(&probes)
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, stopped, atomic.LoadInt32(&probes))
+
+	// Stopping again is harmless, and the checker may be restarted.
+	h.Stop()
+	h.Start(prov, time.Millisecond, func(err error) {})
+	h.Stop()
+}
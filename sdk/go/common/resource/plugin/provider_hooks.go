@@ -0,0 +1,589 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+// OperationType identifies the Provider method that an OperationHook is observing.
+type OperationType string
+
+const (
+	OperationGetSchema               OperationType = "GetSchema"
+	OperationGetSupportedVersions    OperationType = "GetSupportedVersions"
+	OperationCheckConfig             OperationType = "CheckConfig"
+	OperationConfigChecksumMatch     OperationType = "ConfigChecksumMatch"
+	OperationDiffConfig              OperationType = "DiffConfig"
+	OperationConfigure               OperationType = "Configure"
+	OperationValidate                OperationType = "Validate"
+	OperationParameterizeByValue     OperationType = "ParameterizeByValue"
+	OperationParameterizeByReference OperationType = "ParameterizeByReference"
+	OperationCheck                   OperationType = "Check"
+	OperationDiff                    OperationType = "Diff"
+	OperationCreate                  OperationType = "Create"
+	OperationStreamCreate            OperationType = "StreamCreate"
+	OperationWaitForResourceReady    OperationType = "WaitForResourceReady"
+	OperationRead                    OperationType = "Read"
+	OperationPrepareImport           OperationType = "PrepareImport"
+	OperationRefresh                 OperationType = "Refresh"
+	OperationReadStream              OperationType = "ReadStream"
+	OperationBatchRead               OperationType = "BatchRead"
+	OperationMigrateState            OperationType = "MigrateState"
+	OperationGetResourceAliases      OperationType = "GetResourceAliases"
+	OperationUpdate                  OperationType = "Update"
+	OperationDelete                  OperationType = "Delete"
+	OperationConstruct               OperationType = "Construct"
+	OperationInvoke                  OperationType = "Invoke"
+	OperationStreamInvoke            OperationType = "StreamInvoke"
+	OperationCall                    OperationType = "Call"
+	OperationGetPluginInfo           OperationType = "GetPluginInfo"
+	OperationGetMapping              OperationType = "GetMapping"
+	OperationSupportsFeature         OperationType = "SupportsFeature"
+	OperationRegisterEventEmitter    OperationType = "RegisterEventEmitter"
+	OperationWatchConfig             OperationType = "WatchConfig"
+	OperationEstimateCost            OperationType = "EstimateCost"
+	OperationSignalCancellation      OperationType = "SignalCancellation"
+)
+
+// OperationHook observes the calls made to a provider wrapped by WithHooks. The URN passed to each callback is that of
+// the resource being operated on, and is empty for operations that do not target a single resource.
+type OperationHook interface {
+	// BeforeOperation is called before the provider method runs. Returning an error aborts the operation without
+	// calling the provider; the error is returned to the caller.
+	BeforeOperation(ctx context.Context, op OperationType, urn resource.URN) error
+	// AfterOperation is called once the operation has finished with the error, if any, that it returned.
+	AfterOperation(ctx context.Context, op OperationType, urn resource.URN, err error)
+}
+
+// hookProvider is a provider decorator that runs a list of OperationHooks around every provider call.
+type hookProvider struct {
+	ProviderBase
+
+	hooks []OperationHook
+}
+
+// WithHooks wraps the given provider so that the given hooks observe every call made to it. BeforeOperation callbacks
+// run in the order the hooks are given and AfterOperation callbacks run in the reverse order, so the first hook is
+// outermost. If a BeforeOperation callback fails, only the hooks that have already run see the AfterOperation
+// callback. BatchRead runs the hooks once for each resource in the batch.
+func WithHooks(provider Provider, hooks ...OperationHook) Provider {
+	if len(hooks) == 0 {
+		return provider
+	}
+	return &hookProvider{ProviderBase: NewProviderBase(provider), hooks: hooks}
+}
+
+// operationData records the property maps that a single provider call consumed and produced. It is passed to the
+// hooks through the context so that hooks in this package, such as ProviderAuditLog, can observe them.
+type operationData struct {
+	start   time.Time            // the time at which the call started.
+	inputs  resource.PropertyMap // the properties passed to the call, if any.
+	outputs resource.PropertyMap // the properties returned by the call, if any.
+}
+
+type operationDataKey struct{}
+
+// operationDataFromContext returns the operationData of the call that the given hook context belongs to, if any.
+func operationDataFromContext(ctx context.Context) *operationData {
+	data, _ := ctx.Value(operationDataKey{}).(*operationData)
+	return data
+}
+
+// OperationStartTime returns the time at which the provider call that a hook is observing started. It returns false if
+// the given context was not passed to a hook by WithHooks.
+func OperationStartTime(ctx context.Context) (time.Time, bool) {
+	data := operationDataFromContext(ctx)
+	if data == nil {
+		return time.Time{}, false
+	}
+	return data.start, true
+}
+
+// run calls f between the BeforeOperation and AfterOperation callbacks of each hook.
+func (p *hookProvider) run(ctx context.Context, op OperationType, urn resource.URN, f func() error) error {
+	return p.runData(ctx, op, urn, &operationData{}, f)
+}
+
+// runData is like run, but makes the given data available to the hooks. f should record the outputs of the call in
+// data before it returns.
+func (p *hookProvider) runData(ctx context.Context, op OperationType, urn resource.URN, data *operationData,
+	f func() error) error {
+
+	return p.runAll(ctx, op, []resource.URN{urn}, data, f)
+}
+
+// runAll is like runData, but runs the hooks for each of a list of URNs.
+func (p *hookProvider) runAll(ctx context.Context, op OperationType, urns []resource.URN, data *operationData,
+	f func() error) error {
+
+	data.start = time.Now()
+	ctx = context.WithValue(ctx, operationDataKey{}, data)
+	for i, h := range p.hooks {
+		for j, urn := range urns {
+			if err := h.BeforeOperation(ctx, op, urn); err != nil {
+				// The current hook has only seen the URNs before this one.
+				for k := j - 1; k >= 0; k-- {
+					h.AfterOperation(ctx, op, urns[k], err)
+				}
+				p.after(ctx, op, urns, i, err)
+				return err
+			}
+		}
+	}
+
+	err := f()
+	p.after(ctx, op, urns, len(p.hooks), err)
+	return err
+}
+
+// after calls the AfterOperation callbacks of the first n hooks in reverse order.
+func (p *hookProvider) after(ctx context.Context, op OperationType, urns []resource.URN, n int, err error) {
+	for i := n - 1; i >= 0; i-- {
+		for j := len(urns) - 1; j >= 0; j-- {
+			p.hooks[i].AfterOperation(ctx, op, urns[j], err)
+		}
+	}
+}
+
+func (p *hookProvider) GetSchema(ctx context.Context, version int) (GetSchemaResponse, error) {
+	var resp GetSchemaResponse
+	err := p.run(ctx, OperationGetSchema, "", func() (err error) {
+		resp, err = p.ProviderBase.GetSchema(ctx, version)
+		return err
+	})
+	return resp, err
+}
+
+func (p *hookProvider) GetSupportedVersions(ctx context.Context) ([]int, error) {
+	var versions []int
+	err := p.run(ctx, OperationGetSupportedVersions, "", func() (err error) {
+		versions, err = p.ProviderBase.GetSupportedVersions(ctx)
+		return err
+	})
+	return versions, err
+}
+
+func (p *hookProvider) CheckConfig(ctx context.Context, urn resource.URN, olds, news resource.PropertyMap,
+	allowUnknowns bool) (resource.PropertyMap, []CheckFailure, error) {
+
+	var inputs resource.PropertyMap
+	var failures []CheckFailure
+	data := &operationData{inputs: news}
+	err := p.runData(ctx, OperationCheckConfig, urn, data, func() (err error) {
+		inputs, failures, err = p.ProviderBase.CheckConfig(ctx, urn, olds, news, allowUnknowns)
+		data.outputs = inputs
+		return err
+	})
+	return inputs, failures, err
+}
+
+func (p *hookProvider) ConfigChecksumMatch(ctx context.Context, urn resource.URN,
+	checksum string) (bool, error) {
+
+	var match bool
+	err := p.run(ctx, OperationConfigChecksumMatch, urn, func() (err error) {
+		match, err = p.ProviderBase.ConfigChecksumMatch(ctx, urn, checksum)
+		return err
+	})
+	return match, err
+}
+
+func (p *hookProvider) DiffConfig(ctx context.Context, urn resource.URN, olds, news resource.PropertyMap,
+	allowUnknowns bool, ignoreChanges []string) (DiffResult, error) {
+
+	var diff DiffResult
+	data := &operationData{inputs: news}
+	err := p.runData(ctx, OperationDiffConfig, urn, data, func() (err error) {
+		diff, err = p.ProviderBase.DiffConfig(ctx, urn, olds, news, allowUnknowns, ignoreChanges)
+		return err
+	})
+	return diff, err
+}
+
+func (p *hookProvider) Configure(ctx context.Context, cfg ProviderConfig) error {
+	data := &operationData{inputs: cfg.PropertyMap}
+	return p.runData(ctx, OperationConfigure, "", data, func() error {
+		return p.ProviderBase.Configure(ctx, cfg)
+	})
+}
+
+func (p *hookProvider) Validate(ctx context.Context) error {
+	return p.run(ctx, OperationValidate, "", func() error {
+		return p.ProviderBase.Validate(ctx)
+	})
+}
+
+func (p *hookProvider) ParameterizeByValue(ctx context.Context, value []byte) (ParameterizeResult, error) {
+	var result ParameterizeResult
+	err := p.run(ctx, OperationParameterizeByValue, "", func() (err error) {
+		result, err = p.ProviderBase.ParameterizeByValue(ctx, value)
+		return err
+	})
+	return result, err
+}
+
+func (p *hookProvider) ParameterizeByReference(ctx context.Context,
+	ref ParameterizeReference) (ParameterizeResult, error) {
+
+	var result ParameterizeResult
+	err := p.run(ctx, OperationParameterizeByReference, "", func() (err error) {
+		result, err = p.ProviderBase.ParameterizeByReference(ctx, ref)
+		return err
+	})
+	return result, err
+}
+
+func (p *hookProvider) Check(ctx context.Context, urn resource.URN, olds, news resource.PropertyMap,
+	allowUnknowns bool, randomSeed []byte) (resource.PropertyMap, []CheckFailure, error) {
+
+	var inputs resource.PropertyMap
+	var failures []CheckFailure
+	data := &operationData{inputs: news}
+	err := p.runData(ctx, OperationCheck, urn, data, func() (err error) {
+		inputs, failures, err = p.ProviderBase.Check(ctx, urn, olds, news, allowUnknowns, randomSeed)
+		data.outputs = inputs
+		return err
+	})
+	return inputs, failures, err
+}
+
+func (p *hookProvider) Diff(ctx context.Context, urn resource.URN, id resource.ID, olds resource.PropertyMap,
+	news resource.PropertyMap, allowUnknowns bool, ignoreChanges []string) (DiffResult, error) {
+
+	var diff DiffResult
+	data := &operationData{inputs: news}
+	err := p.runData(ctx, OperationDiff, urn, data, func() (err error) {
+		diff, err = p.ProviderBase.Diff(ctx, urn, id, olds, news, allowUnknowns, ignoreChanges)
+		return err
+	})
+	return diff, err
+}
+
+func (p *hookProvider) Create(ctx context.Context, urn resource.URN, news resource.PropertyMap, timeout float64,
+	preview bool) (resource.ID, resource.PropertyMap, resource.Status, error) {
+
+	var id resource.ID
+	var outs resource.PropertyMap
+	var status resource.Status
+	data := &operationData{inputs: news}
+	err := p.runData(ctx, OperationCreate, urn, data, func() (err error) {
+		id, outs, status, err = p.ProviderBase.Create(ctx, urn, news, timeout, preview)
+		data.outputs = outs
+		return err
+	})
+	return id, outs, status, err
+}
+
+func (p *hookProvider) StreamCreate(ctx context.Context, urn resource.URN, news resource.PropertyMap,
+	timeout float64, preview bool,
+	onNext func(resource.PropertyMap) error) (resource.ID, resource.PropertyMap, resource.Status, error) {
+
+	var id resource.ID
+	var outs resource.PropertyMap
+	var status resource.Status
+	data := &operationData{inputs: news}
+	err := p.runData(ctx, OperationStreamCreate, urn, data, func() (err error) {
+		id, outs, status, err = p.ProviderBase.StreamCreate(ctx, urn, news, timeout, preview, onNext)
+		data.outputs = outs
+		return err
+	})
+	return id, outs, status, err
+}
+
+func (p *hookProvider) WaitForResourceReady(ctx context.Context, urn resource.URN, id resource.ID,
+	timeout float64) error {
+
+	return p.run(ctx, OperationWaitForResourceReady, urn, func() error {
+		return p.ProviderBase.WaitForResourceReady(ctx, urn, id, timeout)
+	})
+}
+
+func (p *hookProvider) Read(ctx context.Context, urn resource.URN, id resource.ID,
+	inputs, state resource.PropertyMap) (ReadResult, resource.Status, error) {
+
+	var result ReadResult
+	var status resource.Status
+	data := &operationData{inputs: inputs}
+	err := p.runData(ctx, OperationRead, urn, data, func() (err error) {
+		result, status, err = p.ProviderBase.Read(ctx, urn, id, inputs, state)
+		data.outputs = result.Outputs
+		return err
+	})
+	return result, status, err
+}
+
+func (p *hookProvider) PrepareImport(ctx context.Context, urn resource.URN,
+	id resource.ID) (resource.PropertyMap, error) {
+
+	var inputs resource.PropertyMap
+	data := &operationData{}
+	err := p.runData(ctx, OperationPrepareImport, urn, data, func() (err error) {
+		inputs, err = p.ProviderBase.PrepareImport(ctx, urn, id)
+		data.outputs = inputs
+		return err
+	})
+	return inputs, err
+}
+
+func (p *hookProvider) Refresh(ctx context.Context, urn resource.URN, id resource.ID,
+	inputs, state resource.PropertyMap) (ReadResult, resource.Status, error) {
+
+	var result ReadResult
+	var status resource.Status
+	data := &operationData{inputs: inputs}
+	err := p.runData(ctx, OperationRefresh, urn, data, func() (err error) {
+		result, status, err = p.ProviderBase.Refresh(ctx, urn, id, inputs, state)
+		data.outputs = result.Outputs
+		return err
+	})
+	return result, status, err
+}
+
+func (p *hookProvider) MigrateState(ctx context.Context, urn resource.URN, stateVersion int,
+	state resource.PropertyMap) (resource.PropertyMap, error) {
+
+	var migrated resource.PropertyMap
+	data := &operationData{inputs: state}
+	err := p.runData(ctx, OperationMigrateState, urn, data, func() (err error) {
+		migrated, err = p.ProviderBase.MigrateState(ctx, urn, stateVersion, state)
+		data.outputs = migrated
+		return err
+	})
+	return migrated, err
+}
+
+func (p *hookProvider) GetResourceAliases(ctx context.Context, urn resource.URN) ([]resource.Alias, error) {
+	var aliases []resource.Alias
+	err := p.run(ctx, OperationGetResourceAliases, urn, func() (err error) {
+		aliases, err = p.ProviderBase.GetResourceAliases(ctx, urn)
+		return err
+	})
+	return aliases, err
+}
+
+func (p *hookProvider) ReadStream(ctx context.Context, urn resource.URN, id resource.ID,
+	inputs, state resource.PropertyMap, onNext func(ReadResult) error) (resource.Status, error) {
+
+	var status resource.Status
+	err := p.run(ctx, OperationReadStream, urn, func() (err error) {
+		status, err = p.ProviderBase.ReadStream(ctx, urn, id, inputs, state, onNext)
+		return err
+	})
+	return status, err
+}
+
+func (p *hookProvider) BatchRead(ctx context.Context, requests []BatchReadRequest) ([]BatchReadResponse, error) {
+	urns := make([]resource.URN, len(requests))
+	for i, req := range requests {
+		urns[i] = req.URN
+	}
+
+	var responses []BatchReadResponse
+	err := p.runAll(ctx, OperationBatchRead, urns, &operationData{}, func() (err error) {
+		responses, err = p.ProviderBase.BatchRead(ctx, requests)
+		return err
+	})
+	return responses, err
+}
+
+func (p *hookProvider) Update(ctx context.Context, urn resource.URN, id resource.ID,
+	olds resource.PropertyMap, news resource.PropertyMap, timeout float64,
+	ignoreChanges []string, preview bool) (resource.PropertyMap, resource.Status, error) {
+
+	var outs resource.PropertyMap
+	var status resource.Status
+	data := &operationData{inputs: news}
+	err := p.runData(ctx, OperationUpdate, urn, data, func() (err error) {
+		outs, status, err = p.ProviderBase.Update(ctx, urn, id, olds, news, timeout, ignoreChanges, preview)
+		data.outputs = outs
+		return err
+	})
+	return outs, status, err
+}
+
+func (p *hookProvider) Delete(ctx context.Context, urn resource.URN, id resource.ID, props resource.PropertyMap,
+	timeout float64) (resource.Status, error) {
+
+	var status resource.Status
+	data := &operationData{inputs: props}
+	err := p.runData(ctx, OperationDelete, urn, data, func() (err error) {
+		status, err = p.ProviderBase.Delete(ctx, urn, id, props, timeout)
+		return err
+	})
+	return status, err
+}
+
+func (p *hookProvider) Construct(ctx context.Context, info ConstructInfo, typ tokens.Type, name tokens.QName,
+	parent resource.URN, inputs resource.PropertyMap, options ConstructOptions) (ConstructResult, error) {
+
+	var result ConstructResult
+	data := &operationData{inputs: inputs}
+	err := p.runData(ctx, OperationConstruct, "", data, func() (err error) {
+		result, err = p.ProviderBase.Construct(ctx, info, typ, name, parent, inputs, options)
+		data.outputs = result.Outputs
+		return err
+	})
+	return result, err
+}
+
+func (p *hookProvider) Invoke(ctx context.Context, tok tokens.ModuleMember,
+	args resource.PropertyMap) (resource.PropertyMap, []CheckFailure, error) {
+
+	var outs resource.PropertyMap
+	var failures []CheckFailure
+	data := &operationData{inputs: args}
+	err := p.runData(ctx, OperationInvoke, "", data, func() (err error) {
+		outs, failures, err = p.ProviderBase.Invoke(ctx, tok, args)
+		data.outputs = outs
+		return err
+	})
+	return outs, failures, err
+}
+
+func (p *hookProvider) StreamInvoke(ctx context.Context, tok tokens.ModuleMember, args resource.PropertyMap,
+	onNext func(StreamInvokeEvent) error) ([]CheckFailure, error) {
+
+	var failures []CheckFailure
+	data := &operationData{inputs: args}
+	err := p.runData(ctx, OperationStreamInvoke, "", data, func() (err error) {
+		failures, err = p.ProviderBase.StreamInvoke(ctx, tok, args, onNext)
+		return err
+	})
+	return failures, err
+}
+
+func (p *hookProvider) Call(ctx context.Context, tok tokens.ModuleMember, args resource.PropertyMap, info CallInfo,
+	options CallOptions) (CallResult, error) {
+
+	var result CallResult
+	data := &operationData{inputs: args}
+	err := p.runData(ctx, OperationCall, "", data, func() (err error) {
+		result, err = p.ProviderBase.Call(ctx, tok, args, info, options)
+		data.outputs = result.Return
+		return err
+	})
+	return result, err
+}
+
+func (p *hookProvider) GetPluginInfo(ctx context.Context) (workspace.PluginInfo, error) {
+	var info workspace.PluginInfo
+	err := p.run(ctx, OperationGetPluginInfo, "", func() (err error) {
+		info, err = p.ProviderBase.GetPluginInfo(ctx)
+		return err
+	})
+	return info, err
+}
+
+func (p *hookProvider) GetMapping(ctx context.Context, key string) ([]byte, string, error) {
+	var data []byte
+	var provider string
+	err := p.run(ctx, OperationGetMapping, "", func() (err error) {
+		data, provider, err = p.ProviderBase.GetMapping(ctx, key)
+		return err
+	})
+	return data, provider, err
+}
+
+func (p *hookProvider) SupportsFeature(ctx context.Context, feature string) (bool, error) {
+	var has bool
+	err := p.run(ctx, OperationSupportsFeature, "", func() (err error) {
+		has, err = p.ProviderBase.SupportsFeature(ctx, feature)
+		return err
+	})
+	return has, err
+}
+
+func (p *hookProvider) RegisterEventEmitter(e EventEmitter) error {
+	return p.run(context.Background(), OperationRegisterEventEmitter, "", func() error {
+		return p.ProviderBase.RegisterEventEmitter(e)
+	})
+}
+
+func (p *hookProvider) WatchConfig(ctx context.Context, onChange func(resource.PropertyMap)) error {
+	return p.run(ctx, OperationWatchConfig, "", func() error {
+		return p.ProviderBase.WatchConfig(ctx, onChange)
+	})
+}
+
+func (p *hookProvider) EstimateCost(ctx context.Context, urn resource.URN,
+	news resource.PropertyMap) (CostEstimate, error) {
+
+	var estimate CostEstimate
+	err := p.run(ctx, OperationEstimateCost, urn, func() (err error) {
+		estimate, err = p.ProviderBase.EstimateCost(ctx, urn, news)
+		return err
+	})
+	return estimate, err
+}
+
+func (p *hookProvider) SignalCancellation(ctx context.Context) error {
+	return p.run(ctx, OperationSignalCancellation, "", func() error {
+		return p.ProviderBase.SignalCancellation(ctx)
+	})
+}
+
+// jsonOperationLogger is an OperationHook that writes a JSON object to an io.Writer for each hook callback.
+type jsonOperationLogger struct {
+	m sync.Mutex
+	e *json.Encoder
+}
+
+// operationLogEntry is a single line written by a jsonOperationLogger.
+type operationLogEntry struct {
+	Time      time.Time     `json:"time"`
+	Phase     string        `json:"phase"`
+	Operation OperationType `json:"operation"`
+	URN       resource.URN  `json:"urn,omitempty"`
+	Error     string        `json:"error,omitempty"`
+}
+
+// NewJSONOperationLogger returns an OperationHook that writes one JSON object per line to w before and after each
+// provider operation. Each object records the time, the phase ("before" or "after"), the operation, the URN of the
+// resource, if any, and the error message, if the operation failed. Writes are serialized, so the hook may be shared
+// by providers that are called concurrently.
+func NewJSONOperationLogger(w io.Writer) OperationHook {
+	return &jsonOperationLogger{e: json.NewEncoder(w)}
+}
+
+func (l *jsonOperationLogger) BeforeOperation(ctx context.Context, op OperationType, urn resource.URN) error {
+	l.log(operationLogEntry{Phase: "before", Operation: op, URN: urn})
+	return nil
+}
+
+func (l *jsonOperationLogger) AfterOperation(ctx context.Context, op OperationType, urn resource.URN, err error) {
+	entry := operationLogEntry{Phase: "after", Operation: op, URN: urn}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	l.log(entry)
+}
+
+func (l *jsonOperationLogger) log(entry operationLogEntry) {
+	l.m.Lock()
+	defer l.m.Unlock()
+
+	entry.Time = time.Now().UTC()
+	// Logging is best-effort: a failure to write the log must not fail the operation being observed.
+	_ = l.e.Encode(entry)
+}
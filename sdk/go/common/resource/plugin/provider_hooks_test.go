@@ -0,0 +1,128 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+type recordingHook struct {
+	name      string
+	trace     *[]string
+	beforeErr error
+}
+
+func (h *recordingHook) BeforeOperation(ctx context.Context, op OperationType, urn resource.URN) error {
+	*h.trace = append(*h.trace, fmt.Sprintf("%s before %s %s", h.name, op, urn))
+	return h.beforeErr
+}
+
+func (h *recordingHook) AfterOperation(ctx context.Context, op OperationType, urn resource.URN, err error) {
+	*h.trace = append(*h.trace, fmt.Sprintf("%s after %s %s: %v", h.name, op, urn, err))
+}
+
+func TestWithHooks(t *testing.T) {
+	t.Parallel()
+
+	var trace []string
+	inner := &mutatingProvider{
+		deleteF: func(id resource.ID) (resource.Status, error) {
+			trace = append(trace, "delete")
+			return resource.StatusOK, errors.New("boom")
+		},
+	}
+
+	prov := WithHooks(inner,
+		&recordingHook{name: "outer", trace: &trace},
+		&recordingHook{name: "inner", trace: &trace})
+	_, err := prov.Delete(context.Background(), "urn:a", "id", nil, 0)
+	assert.EqualError(t, err, "boom")
+	assert.Equal(t, []string{
+		"outer before Delete urn:a",
+		"inner before Delete urn:a",
+		"delete",
+		"inner after Delete urn:a: boom",
+		"outer after Delete urn:a: boom",
+	}, trace)
+}
+
+func TestWithHooksBeforeOperationAborts(t *testing.T) {
+	t.Parallel()
+
+	var trace []string
+	inner := &mutatingProvider{
+		deleteF: func(id resource.ID) (resource.Status, error) {
+			trace = append(trace, "delete")
+			return resource.StatusOK, nil
+		},
+	}
+
+	denied := errors.New("denied")
+	prov := WithHooks(inner,
+		&recordingHook{name: "outer", trace: &trace},
+		&recordingHook{name: "policy", trace: &trace, beforeErr: denied},
+		&recordingHook{name: "inner", trace: &trace})
+	_, err := prov.Delete(context.Background(), "urn:a", "id", nil, 0)
+	assert.ErrorIs(t, err, denied)
+
+	// The provider and the hooks after the failing one are never called.
+	assert.Equal(t, []string{
+		"outer before Delete urn:a",
+		"policy before Delete urn:a",
+		"outer after Delete urn:a: denied",
+	}, trace)
+}
+
+func TestJSONOperationLogger(t *testing.T) {
+	t.Parallel()
+
+	inner := &mutatingProvider{
+		createF: func(news resource.PropertyMap) (resource.ID, resource.PropertyMap, resource.Status, error) {
+			return "", nil, resource.StatusOK, errors.New("quota exceeded")
+		},
+	}
+
+	var buf bytes.Buffer
+	prov := WithHooks(inner, NewJSONOperationLogger(&buf))
+	_, _, _, err := prov.Create(context.Background(), "urn:a", nil, 0, false)
+	assert.Error(t, err)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+
+	var before, after map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &before))
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &after))
+
+	assert.Equal(t, "before", before["phase"])
+	assert.Equal(t, "Create", before["operation"])
+	assert.Equal(t, "urn:a", before["urn"])
+	assert.NotContains(t, before, "error")
+	assert.Contains(t, before, "time")
+
+	assert.Equal(t, "after", after["phase"])
+	assert.Equal(t, "quota exceeded", after["error"])
+}
@@ -0,0 +1,181 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+// limitingProvider is a provider decorator that bounds the number of resource operations and invokes that may be in
+// flight at once. Calls beyond the limit wait for an earlier call to finish, or for their context to be canceled.
+//
+// Construct and Call are not limited: a component may register resources that are managed by the same provider, so
+// holding a slot for the duration of the component could deadlock.
+type limitingProvider struct {
+	ProviderBase
+
+	sem chan struct{}
+}
+
+// newLimitingProvider wraps the given provider in a limitingProvider that allows at most n concurrent calls.
+func newLimitingProvider(provider Provider, n int) *limitingProvider {
+	return &limitingProvider{ProviderBase: NewProviderBase(provider), sem: make(chan struct{}, n)}
+}
+
+// do calls f once a slot is available, or returns the context's error if it is canceled first.
+func (p *limitingProvider) do(ctx context.Context, f func() error) error {
+	select {
+	case p.sem <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	defer func() { <-p.sem }()
+	return f()
+}
+
+func (p *limitingProvider) Check(ctx context.Context, urn resource.URN, olds, news resource.PropertyMap,
+	allowUnknowns bool, randomSeed []byte) (inputs resource.PropertyMap, failures []CheckFailure, err error) {
+
+	err = p.do(ctx, func() error {
+		inputs, failures, err = p.ProviderBase.Check(ctx, urn, olds, news, allowUnknowns, randomSeed)
+		return err
+	})
+	return inputs, failures, err
+}
+
+func (p *limitingProvider) Diff(ctx context.Context, urn resource.URN, id resource.ID, olds resource.PropertyMap,
+	news resource.PropertyMap, allowUnknowns bool, ignoreChanges []string) (diff DiffResult, err error) {
+
+	err = p.do(ctx, func() error {
+		diff, err = p.ProviderBase.Diff(ctx, urn, id, olds, news, allowUnknowns, ignoreChanges)
+		return err
+	})
+	return diff, err
+}
+
+func (p *limitingProvider) Create(ctx context.Context, urn resource.URN, news resource.PropertyMap,
+	timeout float64, preview bool) (id resource.ID, outs resource.PropertyMap, status resource.Status, err error) {
+
+	err = p.do(ctx, func() error {
+		id, outs, status, err = p.ProviderBase.Create(ctx, urn, news, timeout, preview)
+		return err
+	})
+	return id, outs, status, err
+}
+
+func (p *limitingProvider) StreamCreate(ctx context.Context, urn resource.URN, news resource.PropertyMap,
+	timeout float64, preview bool, onNext func(resource.PropertyMap) error) (id resource.ID,
+	outs resource.PropertyMap, status resource.Status, err error) {
+
+	err = p.do(ctx, func() error {
+		id, outs, status, err = p.ProviderBase.StreamCreate(ctx, urn, news, timeout, preview, onNext)
+		return err
+	})
+	return id, outs, status, err
+}
+
+func (p *limitingProvider) Read(ctx context.Context, urn resource.URN, id resource.ID,
+	inputs, state resource.PropertyMap) (result ReadResult, status resource.Status, err error) {
+
+	err = p.do(ctx, func() error {
+		result, status, err = p.ProviderBase.Read(ctx, urn, id, inputs, state)
+		return err
+	})
+	return result, status, err
+}
+
+func (p *limitingProvider) PrepareImport(ctx context.Context, urn resource.URN,
+	id resource.ID) (inputs resource.PropertyMap, err error) {
+
+	err = p.do(ctx, func() error {
+		inputs, err = p.ProviderBase.PrepareImport(ctx, urn, id)
+		return err
+	})
+	return inputs, err
+}
+
+func (p *limitingProvider) Refresh(ctx context.Context, urn resource.URN, id resource.ID,
+	inputs, state resource.PropertyMap) (result ReadResult, status resource.Status, err error) {
+
+	err = p.do(ctx, func() error {
+		result, status, err = p.ProviderBase.Refresh(ctx, urn, id, inputs, state)
+		return err
+	})
+	return result, status, err
+}
+
+func (p *limitingProvider) ReadStream(ctx context.Context, urn resource.URN, id resource.ID,
+	inputs, state resource.PropertyMap, onNext func(ReadResult) error) (status resource.Status, err error) {
+
+	err = p.do(ctx, func() error {
+		status, err = p.ProviderBase.ReadStream(ctx, urn, id, inputs, state, onNext)
+		return err
+	})
+	return status, err
+}
+
+func (p *limitingProvider) BatchRead(ctx context.Context,
+	requests []BatchReadRequest) (responses []BatchReadResponse, err error) {
+
+	err = p.do(ctx, func() error {
+		responses, err = p.ProviderBase.BatchRead(ctx, requests)
+		return err
+	})
+	return responses, err
+}
+
+func (p *limitingProvider) Update(ctx context.Context, urn resource.URN, id resource.ID,
+	olds resource.PropertyMap, news resource.PropertyMap, timeout float64,
+	ignoreChanges []string, preview bool) (outs resource.PropertyMap, status resource.Status, err error) {
+
+	err = p.do(ctx, func() error {
+		outs, status, err = p.ProviderBase.Update(ctx, urn, id, olds, news, timeout, ignoreChanges, preview)
+		return err
+	})
+	return outs, status, err
+}
+
+func (p *limitingProvider) Delete(ctx context.Context, urn resource.URN, id resource.ID,
+	props resource.PropertyMap, timeout float64) (status resource.Status, err error) {
+
+	err = p.do(ctx, func() error {
+		status, err = p.ProviderBase.Delete(ctx, urn, id, props, timeout)
+		return err
+	})
+	return status, err
+}
+
+func (p *limitingProvider) Invoke(ctx context.Context, tok tokens.ModuleMember,
+	args resource.PropertyMap) (outs resource.PropertyMap, failures []CheckFailure, err error) {
+
+	err = p.do(ctx, func() error {
+		outs, failures, err = p.ProviderBase.Invoke(ctx, tok, args)
+		return err
+	})
+	return outs, failures, err
+}
+
+func (p *limitingProvider) StreamInvoke(ctx context.Context, tok tokens.ModuleMember, args resource.PropertyMap,
+	onNext func(StreamInvokeEvent) error) (failures []CheckFailure, err error) {
+
+	err = p.do(ctx, func() error {
+		failures, err = p.ProviderBase.StreamInvoke(ctx, tok, args, onNext)
+		return err
+	})
+	return failures, err
+}
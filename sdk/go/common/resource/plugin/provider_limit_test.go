@@ -0,0 +1,130 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+// slowProvider is a provider whose Create takes a fixed time and records the greatest number of concurrent calls.
+type slowProvider struct {
+	Provider
+
+	delay    time.Duration
+	inFlight int32
+	peak     int32
+}
+
+func (p *slowProvider) Create(ctx context.Context, urn resource.URN, news resource.PropertyMap,
+	timeout float64, preview bool) (resource.ID, resource.PropertyMap, resource.Status, error) {
+
+	n := atomic.AddInt32(&p.inFlight, 1)
+	defer atomic.AddInt32(&p.inFlight, -1)
+	for {
+		peak := atomic.LoadInt32(&p.peak)
+		if n <= peak || atomic.CompareAndSwapInt32(&p.peak, peak, n) {
+			break
+		}
+	}
+	time.Sleep(p.delay)
+	return "id", news, resource.StatusOK, nil
+}
+
+func createConcurrently(prov Provider, n int) {
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _, _, _ = prov.Create(context.Background(), "urn:pulumi:stack::project::pkgA:index:t::r", nil, 0, false)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestLimitingProvider(t *testing.T) {
+	t.Parallel()
+
+	inner := &slowProvider{delay: 10 * time.Millisecond}
+	prov := newLimitingProvider(inner, 3)
+	createConcurrently(prov, 20)
+	assert.Equal(t, int32(3), inner.peak)
+
+	// A call that is waiting for a slot returns when its context is canceled.
+	for i := 0; i < 3; i++ {
+		prov.sem <- struct{}{}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, _, _, err := prov.Create(ctx, "urn:pulumi:stack::project::pkgA:index:t::r", nil, 0, false)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestHostConcurrencyLimit(t *testing.T) {
+	t.Parallel()
+
+	ctx, err := NewContext(nil, nil, nil, nil, t.TempDir(), nil, false, nil)
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, ctx.Close()) }()
+
+	host, err := NewDefaultHost(ctx, nil, false, nil,
+		WithConcurrencyLimit(2), WithPerProviderConcurrencyLimit("pkgB", 4), WithPerProviderConcurrencyLimit("pkgC", 0))
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, host.Close()) }()
+
+	providers := map[tokens.Package]*slowProvider{}
+	for _, pkg := range []tokens.Package{"pkgA", "pkgB", "pkgC"} {
+		inner := &slowProvider{Provider: &factoryProvider{pkg: pkg}, delay: 10 * time.Millisecond}
+		providers[pkg] = inner
+		require.NoError(t, host.RegisterFactory(pkg, "", func(tokens.Package, string) (Provider, error) {
+			return inner, nil
+		}))
+	}
+	for pkg := range providers {
+		prov, err := host.Provider(pkg, nil)
+		require.NoError(t, err)
+		createConcurrently(prov, 10)
+	}
+
+	assert.Equal(t, int32(2), providers["pkgA"].peak)
+	assert.Equal(t, int32(4), providers["pkgB"].peak)
+	assert.Equal(t, int32(10), providers["pkgC"].peak)
+}
+
+// BenchmarkLimitingProvider measures the throughput of a provider whose calls each take a millisecond when 64 calls
+// are issued at once under various limits.
+func BenchmarkLimitingProvider(b *testing.B) {
+	for _, limit := range []int{1, 4, 16, 64} {
+		limit := limit
+		b.Run(fmt.Sprintf("limit=%d", limit), func(b *testing.B) {
+			prov := newLimitingProvider(&slowProvider{delay: time.Millisecond}, limit)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				createConcurrently(prov, 64)
+			}
+		})
+	}
+}
@@ -0,0 +1,237 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/golang/protobuf/jsonpb"
+	structpb "github.com/golang/protobuf/ptypes/struct"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/display"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// PlannedOperation is the change that a preview planned to make to a single resource.
+type PlannedOperation struct {
+	URN       resource.URN         // the URN of the resource.
+	Operation display.StepOp       // the operation planned for the resource, e.g. "create" or "update".
+	Inputs    resource.PropertyMap // the inputs that the resource was planned to have.
+	Diff      DiffResult           // the provider's diff of the resource's old state against its planned inputs.
+}
+
+// ProviderPlan captures the changes that a preview planned to make to resources, so that they can be saved and
+// compared against the changes that a later update makes.
+type ProviderPlan struct {
+	Operations []PlannedOperation // the planned operations, in the order in which they were planned.
+}
+
+// Operation returns the operation planned for the resource with the given URN, if any.
+func (plan ProviderPlan) Operation(urn resource.URN) (PlannedOperation, bool) {
+	for _, op := range plan.Operations {
+		if op.URN == urn {
+			return op, true
+		}
+	}
+	return PlannedOperation{}, false
+}
+
+// The JSON forms of a ProviderPlan. Inputs are stored in the same form as they are sent to providers, so that
+// secrets, unknowns, and resource references survive a round trip.
+type providerPlanJSON struct {
+	Operations []plannedOperationJSON `json:"operations"`
+}
+
+type plannedOperationJSON struct {
+	URN       resource.URN    `json:"urn"`
+	Operation display.StepOp  `json:"operation"`
+	Inputs    json.RawMessage `json:"inputs,omitempty"`
+	Diff      diffResultJSON  `json:"diff"`
+}
+
+type diffResultJSON struct {
+	Changes             DiffChanges                 `json:"changes"`
+	ReplaceKeys         []resource.PropertyKey      `json:"replaceKeys,omitempty"`
+	StableKeys          []resource.PropertyKey      `json:"stableKeys,omitempty"`
+	ChangedKeys         []resource.PropertyKey      `json:"changedKeys,omitempty"`
+	DetailedDiff        map[string]propertyDiffJSON `json:"detailedDiff,omitempty"`
+	DeleteBeforeReplace bool                        `json:"deleteBeforeReplace,omitempty"`
+	AffectedResources   []resource.URN              `json:"affectedResources,omitempty"`
+}
+
+type propertyDiffJSON struct {
+	Kind      DiffKind        `json:"kind"`
+	InputDiff bool            `json:"inputDiff,omitempty"`
+	Reason    string          `json:"reason,omitempty"`
+	Ignored   bool            `json:"ignored,omitempty"`
+	OldValue  json.RawMessage `json:"oldValue,omitempty"`
+	NewValue  json.RawMessage `json:"newValue,omitempty"`
+}
+
+var planMarshalOptions = MarshalOptions{
+	KeepUnknowns:     true,
+	KeepSecrets:      true,
+	KeepResources:    true,
+	KeepOutputValues: true,
+}
+
+// SavePlan writes the given plan to w as JSON.
+func SavePlan(plan ProviderPlan, w io.Writer) error {
+	var jsonPlan providerPlanJSON
+	for _, op := range plan.Operations {
+		jsonOp := plannedOperationJSON{
+			URN:       op.URN,
+			Operation: op.Operation,
+			Diff: diffResultJSON{
+				Changes:             op.Diff.Changes,
+				ReplaceKeys:         op.Diff.ReplaceKeys,
+				StableKeys:          op.Diff.StableKeys,
+				ChangedKeys:         op.Diff.ChangedKeys,
+				DeleteBeforeReplace: op.Diff.DeleteBeforeReplace,
+				AffectedResources:   op.Diff.AffectedResources,
+			},
+		}
+		if op.Inputs != nil {
+			inputs, err := MarshalProperties(op.Inputs, planMarshalOptions)
+			if err != nil {
+				return fmt.Errorf("marshaling inputs for %v: %w", op.URN, err)
+			}
+			var m jsonpb.Marshaler
+			s, err := m.MarshalToString(inputs)
+			if err != nil {
+				return fmt.Errorf("marshaling inputs for %v: %w", op.URN, err)
+			}
+			jsonOp.Inputs = json.RawMessage(s)
+		}
+		if op.Diff.DetailedDiff != nil {
+			jsonOp.Diff.DetailedDiff = make(map[string]propertyDiffJSON, len(op.Diff.DetailedDiff))
+			for k, d := range op.Diff.DetailedDiff {
+				old, err := marshalPlanValue(d.OldValue)
+				if err != nil {
+					return fmt.Errorf("marshaling the old value of %v for %v: %w", k, op.URN, err)
+				}
+				new, err := marshalPlanValue(d.NewValue)
+				if err != nil {
+					return fmt.Errorf("marshaling the new value of %v for %v: %w", k, op.URN, err)
+				}
+				jsonOp.Diff.DetailedDiff[k] = propertyDiffJSON{
+					Kind:      d.Kind,
+					InputDiff: d.InputDiff,
+					Reason:    d.Reason,
+					Ignored:   d.Ignored,
+					OldValue:  old,
+					NewValue:  new,
+				}
+			}
+		}
+		jsonPlan.Operations = append(jsonPlan.Operations, jsonOp)
+	}
+	return json.NewEncoder(w).Encode(jsonPlan)
+}
+
+// LoadPlan reads a plan written by SavePlan from r.
+func LoadPlan(r io.Reader) (ProviderPlan, error) {
+	var jsonPlan providerPlanJSON
+	if err := json.NewDecoder(r).Decode(&jsonPlan); err != nil {
+		return ProviderPlan{}, fmt.Errorf("decoding plan: %w", err)
+	}
+
+	var plan ProviderPlan
+	for _, jsonOp := range jsonPlan.Operations {
+		op := PlannedOperation{
+			URN:       jsonOp.URN,
+			Operation: jsonOp.Operation,
+			Diff: DiffResult{
+				Changes:             jsonOp.Diff.Changes,
+				ReplaceKeys:         jsonOp.Diff.ReplaceKeys,
+				StableKeys:          jsonOp.Diff.StableKeys,
+				ChangedKeys:         jsonOp.Diff.ChangedKeys,
+				DeleteBeforeReplace: jsonOp.Diff.DeleteBeforeReplace,
+				AffectedResources:   jsonOp.Diff.AffectedResources,
+			},
+		}
+		if len(jsonOp.Inputs) != 0 {
+			var inputs structpb.Struct
+			if err := jsonpb.Unmarshal(strings.NewReader(string(jsonOp.Inputs)), &inputs); err != nil {
+				return ProviderPlan{}, fmt.Errorf("decoding inputs for %v: %w", jsonOp.URN, err)
+			}
+			props, err := UnmarshalProperties(&inputs, planMarshalOptions)
+			if err != nil {
+				return ProviderPlan{}, fmt.Errorf("decoding inputs for %v: %w", jsonOp.URN, err)
+			}
+			op.Inputs = props
+		}
+		if jsonOp.Diff.DetailedDiff != nil {
+			op.Diff.DetailedDiff = make(map[string]PropertyDiff, len(jsonOp.Diff.DetailedDiff))
+			for k, d := range jsonOp.Diff.DetailedDiff {
+				old, err := unmarshalPlanValue(d.OldValue)
+				if err != nil {
+					return ProviderPlan{}, fmt.Errorf("decoding the old value of %v for %v: %w", k, jsonOp.URN, err)
+				}
+				new, err := unmarshalPlanValue(d.NewValue)
+				if err != nil {
+					return ProviderPlan{}, fmt.Errorf("decoding the new value of %v for %v: %w", k, jsonOp.URN, err)
+				}
+				op.Diff.DetailedDiff[k] = PropertyDiff{
+					Kind:      d.Kind,
+					InputDiff: d.InputDiff,
+					Reason:    d.Reason,
+					Ignored:   d.Ignored,
+					OldValue:  old,
+					NewValue:  new,
+				}
+			}
+		}
+		plan.Operations = append(plan.Operations, op)
+	}
+	return plan, nil
+}
+
+// marshalPlanValue encodes the old or new value of a property diff as JSON. Null values, which mean that the provider
+// did not report a value, are omitted.
+func marshalPlanValue(v resource.PropertyValue) (json.RawMessage, error) {
+	if v.IsNull() {
+		return nil, nil
+	}
+	pv, err := MarshalPropertyValue("", v, planMarshalOptions)
+	if err != nil {
+		return nil, err
+	}
+	var m jsonpb.Marshaler
+	s, err := m.MarshalToString(pv)
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(s), nil
+}
+
+// unmarshalPlanValue decodes the old or new value of a property diff that was encoded by marshalPlanValue.
+func unmarshalPlanValue(raw json.RawMessage) (resource.PropertyValue, error) {
+	if len(raw) == 0 {
+		return resource.PropertyValue{}, nil
+	}
+	var pv structpb.Value
+	if err := jsonpb.Unmarshal(strings.NewReader(string(raw)), &pv); err != nil {
+		return resource.PropertyValue{}, err
+	}
+	v, err := UnmarshalPropertyValue("", &pv, planMarshalOptions)
+	if err != nil {
+		return resource.PropertyValue{}, err
+	}
+	return *v, nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/display"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+func TestProviderPlanRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	urnA := resource.URN("urn:pulumi:stack::project::pkg:index:type::a")
+	urnB := resource.URN("urn:pulumi:stack::project::pkg:index:type::b")
+	plan := ProviderPlan{
+		Operations: []PlannedOperation{
+			{
+				URN:       urnA,
+				Operation: display.StepOp("create"),
+				Inputs: resource.PropertyMap{
+					"name":     resource.NewStringProperty("a"),
+					"password": resource.MakeSecret(resource.NewStringProperty("hunter2")),
+					"id":       resource.MakeComputed(resource.NewStringProperty("")),
+				},
+				Diff: DiffResult{Changes: DiffSome},
+			},
+			{
+				URN:       urnB,
+				Operation: display.StepOp("replace"),
+				Inputs:    resource.PropertyMap{"size": resource.NewNumberProperty(3)},
+				Diff: DiffResult{
+					Changes:             DiffSome,
+					ReplaceKeys:         []resource.PropertyKey{"size"},
+					ChangedKeys:         []resource.PropertyKey{"size"},
+					DeleteBeforeReplace: true,
+					DetailedDiff: map[string]PropertyDiff{
+						"size": {
+							Kind:      DiffUpdateReplace,
+							InputDiff: true,
+							Reason:    "size is immutable",
+							OldValue:  resource.NewNumberProperty(2),
+							NewValue:  resource.NewNumberProperty(3),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, SavePlan(plan, &buf))
+
+	loaded, err := LoadPlan(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, plan, loaded)
+
+	op, ok := loaded.Operation(urnB)
+	assert.True(t, ok)
+	assert.Equal(t, display.StepOp("replace"), op.Operation)
+
+	_, ok = loaded.Operation("urn:pulumi:stack::project::pkg:index:type::c")
+	assert.False(t, ok)
+}
+
+func TestLoadPlanInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := LoadPlan(bytes.NewBufferString("{"))
+	assert.Error(t, err)
+}
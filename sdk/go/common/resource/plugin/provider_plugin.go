@@ -21,6 +21,7 @@ import (
 	"io"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/blang/semver"
 	pbempty "github.com/golang/protobuf/ptypes/empty"
@@ -68,6 +69,8 @@ type provider struct {
 	acceptResources        bool                             // true if this plugin accepts strongly-typed resource refs.
 	acceptOutputs          bool                             // true if this plugin accepts output values.
 	supportsPreview        bool                             // true if this plugin supports previews for Create and Update.
+	schemaVersion          int                              // the version of the state schema written by this plugin.
+	binaryProperties       bool                             // true if this plugin exchanges property maps as MessagePack.
 	disableProviderPreview bool                             // true if previews for Create and Update are disabled.
 	legacyPreview          bool                             // enables legacy behavior for unconfigured provider previews.
 }
@@ -137,7 +140,7 @@ func NewProvider(host Host, ctx *Context, pkg tokens.Package, version *semver.Ve
 		ctx:                    ctx,
 		pkg:                    pkg,
 		plug:                   plug,
-		clientRaw:              pulumirpc.NewResourceProviderClient(plug.Conn),
+		clientRaw:              pulumirpc.NewResourceProviderClient(newTracingClientConn(plug.Conn, pkg)),
 		cfgdone:                make(chan bool),
 		disableProviderPreview: disableProviderPreview,
 		legacyPreview:          legacyPreview,
@@ -145,7 +148,7 @@ func NewProvider(host Host, ctx *Context, pkg tokens.Package, version *semver.Ve
 
 	// If we just attached (i.e. plugin bin is nil) we need to call attach
 	if plug.Bin == "" {
-		err := p.Attach(host.ServerAddr())
+		err := p.Attach(context.Background(), host.ServerAddr())
 		if err != nil {
 			return nil, err
 		}
@@ -169,14 +172,14 @@ func NewProviderFromPath(host Host, ctx *Context, path string) (Provider, error)
 	p := &provider{
 		ctx:           ctx,
 		plug:          plug,
-		clientRaw:     pulumirpc.NewResourceProviderClient(plug.Conn),
+		clientRaw:     pulumirpc.NewResourceProviderClient(newTracingClientConn(plug.Conn, "")),
 		cfgdone:       make(chan bool),
 		legacyPreview: legacyPreview,
 	}
 
 	// If we just attached (i.e. plugin bin is nil) we need to call attach
 	if plug.Bin == "" {
-		err := p.Attach(host.ServerAddr())
+		err := p.Attach(context.Background(), host.ServerAddr())
 		if err != nil {
 			return nil, err
 		}
@@ -203,11 +206,49 @@ func (p *provider) label() string {
 	return fmt.Sprintf("Provider[%s, %p]", p.pkg, p)
 }
 
-func (p *provider) requestContext() context.Context {
+// requestContext returns the context to use for an RPC issued on behalf of the caller's context. The result is
+// canceled when either the caller's context is done or the plugin context is closed.
+func (p *provider) requestContext(ctx context.Context) context.Context {
 	if p.ctx == nil {
-		return context.Background()
+		return ctx
 	}
-	return p.ctx.Request()
+	return p.ctx.RequestWithContext(ctx)
+}
+
+// contextError returns the caller context's error if that context has been canceled or its deadline has expired, and
+// err otherwise. This lets callers observe context.Canceled and context.DeadlineExceeded rather than the gRPC status
+// errors that a canceled RPC produces.
+func contextError(ctx context.Context, err error) error {
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return ctxErr
+	}
+	return err
+}
+
+// ConfigChecksumMatch reports whether the given checksum matches the configuration that the provider last validated
+// for the given provider resource. Providers that do not implement the ConfigChecksumMatch RPC return
+// ErrNotYetImplemented.
+func (p *provider) ConfigChecksumMatch(ctx context.Context, urn resource.URN, checksum string) (bool, error) {
+	label := fmt.Sprintf("%s.ConfigChecksumMatch(%s)", p.label(), urn)
+	logging.V(7).Infof("%s executing (checksum=%s)", label, checksum)
+
+	// Like CheckConfig, this is called before the provider is configured, so use the raw client.
+	resp, err := p.clientRaw.ConfigChecksumMatch(p.requestContext(ctx), &pulumirpc.ConfigChecksumMatchRequest{
+		Urn:      string(urn),
+		Checksum: checksum,
+	})
+	if err != nil {
+		rpcError := rpcerror.Convert(err)
+		if rpcError.Code() == codes.Unimplemented {
+			logging.V(7).Infof("%s unimplemented rpc", label)
+			return false, ErrNotYetImplemented
+		}
+		logging.V(7).Infof("%s failed: %v", label, rpcError.Message())
+		return false, contextError(ctx, rpcError)
+	}
+
+	logging.V(7).Infof("%s success: match=%v", label, resp.GetMatch())
+	return resp.GetMatch(), nil
 }
 
 // isDiffCheckConfigLogicallyUnimplemented returns true when an rpcerror.Error should be treated as if it was an error
@@ -236,18 +277,51 @@ func isDiffCheckConfigLogicallyUnimplemented(err *rpcerror.Error, providerType t
 }
 
 // GetSchema fetches the schema for this resource provider, if any.
-func (p *provider) GetSchema(version int) ([]byte, error) {
-	resp, err := p.clientRaw.GetSchema(p.requestContext(), &pulumirpc.GetSchemaRequest{
+func (p *provider) GetSchema(ctx context.Context, version int) (GetSchemaResponse, error) {
+	resp, err := p.clientRaw.GetSchema(p.requestContext(ctx), &pulumirpc.GetSchemaRequest{
 		Version: int32(version),
 	})
 	if err != nil {
-		return nil, err
+		return GetSchemaResponse{}, contextError(ctx, err)
+	}
+
+	// Older providers do not send a checksum, so compute it ourselves if necessary.
+	schema := []byte(resp.GetSchema())
+	if checksum := resp.GetChecksum(); checksum != "" {
+		return GetSchemaResponse{Bytes: schema, Checksum: checksum}, nil
 	}
-	return []byte(resp.GetSchema()), nil
+	return NewGetSchemaResponse(schema), nil
+}
+
+// GetSupportedVersions fetches the schema versions that this resource provider can serve. Providers that do not
+// implement the GetSupportedVersions RPC return ErrNotYetImplemented.
+func (p *provider) GetSupportedVersions(ctx context.Context) ([]int, error) {
+	label := fmt.Sprintf("%s.GetSupportedVersions", p.label())
+	logging.V(7).Infof("%s executing", label)
+
+	// Like the schema itself, the supported versions do not depend on configuration, so we access the clientRaw
+	// property rather than calling getClient.
+	resp, err := p.clientRaw.GetSupportedVersions(p.requestContext(ctx), &pbempty.Empty{})
+	if err != nil {
+		rpcError := rpcerror.Convert(err)
+		if rpcError.Code() == codes.Unimplemented {
+			logging.V(7).Infof("%s unimplemented rpc", label)
+			return nil, ErrNotYetImplemented
+		}
+		logging.V(7).Infof("%s failed: err=%v", label, rpcError.Message())
+		return nil, contextError(ctx, rpcError)
+	}
+
+	versions := make([]int, len(resp.GetVersions()))
+	for i, v := range resp.GetVersions() {
+		versions[i] = int(v)
+	}
+	logging.V(7).Infof("%s success: versions=%v", label, versions)
+	return versions, nil
 }
 
 // CheckConfig validates the configuration for this resource provider.
-func (p *provider) CheckConfig(urn resource.URN, olds,
+func (p *provider) CheckConfig(ctx context.Context, urn resource.URN, olds,
 	news resource.PropertyMap, allowUnknowns bool) (resource.PropertyMap, []CheckFailure, error) {
 	label := fmt.Sprintf("%s.CheckConfig(%s)", p.label(), urn)
 	logging.V(7).Infof("%s executing (#olds=%d,#news=%d)", label, len(olds), len(news))
@@ -272,12 +346,15 @@ func (p *provider) CheckConfig(urn resource.URN, olds,
 		return nil, nil, err
 	}
 
-	resp, err := p.clientRaw.CheckConfig(p.requestContext(), &pulumirpc.CheckRequest{
+	resp, err := p.clientRaw.CheckConfig(p.requestContext(ctx), &pulumirpc.CheckRequest{
 		Urn:  string(urn),
 		Olds: molds,
 		News: mnews,
 	})
 	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, nil, ctxErr
+		}
 		rpcError := rpcerror.Convert(err)
 		code := rpcError.Code()
 		if code == codes.Unimplemented || isDiffCheckConfigLogicallyUnimplemented(rpcError, urn.Type()) {
@@ -306,10 +383,7 @@ func (p *provider) CheckConfig(urn resource.URN, olds,
 	}
 
 	// And now any properties that failed verification.
-	var failures []CheckFailure
-	for _, failure := range resp.GetFailures() {
-		failures = append(failures, CheckFailure{resource.PropertyKey(failure.Property), failure.Reason})
-	}
+	failures := UnmarshalCheckFailures(resp.GetFailures())
 
 	// Copy over any secret annotations, since we could not pass any to the provider, and return.
 	annotateSecrets(inputs, news)
@@ -317,9 +391,9 @@ func (p *provider) CheckConfig(urn resource.URN, olds,
 	return inputs, failures, nil
 }
 
-func decodeDetailedDiff(resp *pulumirpc.DiffResponse) map[string]PropertyDiff {
+func decodeDetailedDiff(label string, resp *pulumirpc.DiffResponse) (map[string]PropertyDiff, error) {
 	if !resp.GetHasDetailedDiff() {
-		return nil
+		return nil, nil
 	}
 
 	detailedDiff := make(map[string]PropertyDiff)
@@ -342,17 +416,43 @@ func decodeDetailedDiff(resp *pulumirpc.DiffResponse) map[string]PropertyDiff {
 			// Consider unknown diff kinds to be simple updates.
 			d = DiffUpdate
 		}
-		detailedDiff[k] = PropertyDiff{
+		pdiff := PropertyDiff{
 			Kind:      d,
 			InputDiff: v.GetInputDiff(),
+			Reason:    v.GetReason(),
+		}
+		if old := v.GetOldValue(); old != nil {
+			value, err := UnmarshalPropertyValue("", old, detailedDiffValueOptions(label, k+".oldValue"))
+			if err != nil {
+				return nil, err
+			}
+			pdiff.OldValue = *value
+		}
+		if new := v.GetNewValue(); new != nil {
+			value, err := UnmarshalPropertyValue("", new, detailedDiffValueOptions(label, k+".newValue"))
+			if err != nil {
+				return nil, err
+			}
+			pdiff.NewValue = *value
 		}
+		detailedDiff[k] = pdiff
 	}
 
-	return detailedDiff
+	return detailedDiff, nil
+}
+
+// detailedDiffValueOptions returns the options used to unmarshal the old and new values in a detailed diff.
+func detailedDiffValueOptions(label, path string) MarshalOptions {
+	return MarshalOptions{
+		Label:         fmt.Sprintf("%s.detailedDiff.%s", label, path),
+		KeepUnknowns:  true,
+		KeepSecrets:   true,
+		KeepResources: true,
+	}
 }
 
 // DiffConfig checks what impacts a hypothetical change to this provider's configuration will have on the provider.
-func (p *provider) DiffConfig(urn resource.URN, olds, news resource.PropertyMap,
+func (p *provider) DiffConfig(ctx context.Context, urn resource.URN, olds, news resource.PropertyMap,
 	allowUnknowns bool, ignoreChanges []string) (DiffResult, error) {
 	label := fmt.Sprintf("%s.DiffConfig(%s)", p.label(), urn)
 	logging.V(7).Infof("%s executing (#olds=%d,#news=%d)", label, len(olds), len(news))
@@ -376,13 +476,16 @@ func (p *provider) DiffConfig(urn resource.URN, olds, news resource.PropertyMap,
 		return DiffResult{}, err
 	}
 
-	resp, err := p.clientRaw.DiffConfig(p.requestContext(), &pulumirpc.DiffRequest{
+	resp, err := p.clientRaw.DiffConfig(p.requestContext(ctx), &pulumirpc.DiffRequest{
 		Urn:           string(urn),
 		Olds:          molds,
 		News:          mnews,
 		IgnoreChanges: ignoreChanges,
 	})
 	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return DiffResult{}, ctxErr
+		}
 		rpcError := rpcerror.Convert(err)
 		code := rpcError.Code()
 		if code == codes.Unimplemented || isDiffCheckConfigLogicallyUnimplemented(rpcError, urn.Type()) {
@@ -420,25 +523,36 @@ func (p *provider) DiffConfig(urn resource.URN, olds, news resource.PropertyMap,
 		diffs = append(diffs, resource.PropertyKey(diff))
 	}
 
+	var affected []resource.URN
+	for _, urn := range resp.GetAffectedResources() {
+		affected = append(affected, resource.URN(urn))
+	}
+
 	changes := resp.GetChanges()
 	deleteBeforeReplace := resp.GetDeleteBeforeReplace()
-	logging.V(7).Infof("%s success: changes=%d #replaces=%v #stables=%v delbefrepl=%v, diffs=#%v",
-		label, changes, replaces, stables, deleteBeforeReplace, diffs)
+	logging.V(7).Infof("%s success: changes=%d #replaces=%v #stables=%v delbefrepl=%v, diffs=#%v, #affected=%d",
+		label, changes, replaces, stables, deleteBeforeReplace, diffs, len(affected))
+
+	detailedDiff, err := decodeDetailedDiff(label, resp)
+	if err != nil {
+		return DiffResult{}, err
+	}
 
 	return DiffResult{
 		Changes:             DiffChanges(changes),
 		ReplaceKeys:         replaces,
 		StableKeys:          stables,
 		ChangedKeys:         diffs,
-		DetailedDiff:        decodeDetailedDiff(resp),
+		DetailedDiff:        detailedDiff,
 		DeleteBeforeReplace: deleteBeforeReplace,
+		AffectedResources:   affected,
 	}, nil
 }
 
 // getClient returns the client, and ensures that the target provider has been configured.  This just makes it safer
 // to use without forgetting to call ensureConfigured manually.
-func (p *provider) getClient() (pulumirpc.ResourceProviderClient, error) {
-	if err := p.ensureConfigured(); err != nil {
+func (p *provider) getClient(ctx context.Context) (pulumirpc.ResourceProviderClient, error) {
+	if err := p.ensureConfigured(ctx); err != nil {
 		return nil, err
 	}
 	return p.clientRaw, nil
@@ -447,9 +561,14 @@ func (p *provider) getClient() (pulumirpc.ResourceProviderClient, error) {
 // ensureConfigured blocks waiting for the plugin to be configured.  To improve parallelism, all Configure RPCs
 // occur in parallel, and we await the completion of them at the last possible moment.  This does mean, however, that
 // we might discover failures later than we would have otherwise, but the caller of ensureConfigured will get them.
-func (p *provider) ensureConfigured() error {
-	<-p.cfgdone
-	return p.cfgerr
+// If ctx is done before configuration completes, its error is returned instead.
+func (p *provider) ensureConfigured(ctx context.Context) error {
+	select {
+	case <-p.cfgdone:
+		return p.cfgerr
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
 // annotateSecrets copies the "secretness" from the ins to the outs. If there are values with the same keys for the
@@ -520,10 +639,15 @@ func removeSecrets(v resource.PropertyValue) interface{} {
 }
 
 // Configure configures the resource provider with "globals" that control its behavior.
-func (p *provider) Configure(inputs resource.PropertyMap) error {
+func (p *provider) Configure(ctx context.Context, cfg ProviderConfig) error {
+	inputs := cfg.PropertyMap
 	label := fmt.Sprintf("%s.Configure()", p.label())
 	logging.V(7).Infof("%s executing (#vars=%d)", label, len(inputs))
 
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	// Convert the inputs to a config map. If any are unknown, do not configure the underlying plugin: instead, leave
 	// the cfgknown bit unset and carry on.
 	config := make(map[string]string)
@@ -566,14 +690,21 @@ func (p *provider) Configure(inputs resource.PropertyMap) error {
 		return p.cfgerr
 	}
 
+	var configSecretKeys []string
+	for _, k := range cfg.SecretKeys() {
+		configSecretKeys = append(configSecretKeys, k.String())
+	}
+
 	// Spawn the configure to happen in parallel.  This ensures that we remain responsive elsewhere that might
-	// want to make forward progress, even as the configure call is happening.
+	// want to make forward progress, even as the configure call is happening. Because the call outlives this
+	// method, it is scoped to the plugin context rather than to ctx.
 	go func() {
-		resp, err := p.clientRaw.Configure(p.requestContext(), &pulumirpc.ConfigureRequest{
-			AcceptSecrets:   true,
-			AcceptResources: true,
-			Variables:       config,
-			Args:            minputs,
+		resp, err := p.clientRaw.Configure(p.requestContext(context.Background()), &pulumirpc.ConfigureRequest{
+			AcceptSecrets:    true,
+			AcceptResources:  true,
+			Variables:        config,
+			Args:             minputs,
+			ConfigSecretKeys: configSecretKeys,
 		})
 		if err != nil {
 			rpcError := rpcerror.Convert(err)
@@ -585,6 +716,12 @@ func (p *provider) Configure(inputs resource.PropertyMap) error {
 		p.acceptResources = resp.GetAcceptResources()
 		p.supportsPreview = resp.GetSupportsPreview()
 		p.acceptOutputs = resp.GetAcceptOutputs()
+		p.schemaVersion = int(resp.GetSchemaVersion())
+		if err == nil {
+			// Check, Create and Update exchange property maps in the more compact MessagePack encoding with providers
+			// that support it.
+			p.binaryProperties, _ = p.SupportsFeature(context.Background(), FeatureBinaryPropertyMap)
+		}
 
 		p.cfgknown, p.cfgerr = true, err
 		close(p.cfgdone)
@@ -593,15 +730,113 @@ func (p *provider) Configure(inputs resource.PropertyMap) error {
 	return nil
 }
 
+// Validate runs the provider's pre-flight checks once its configuration has completed. Providers that do not
+// implement the ValidateProvider RPC are only checked for liveness, by calling GetPluginInfo.
+func (p *provider) Validate(ctx context.Context) error {
+	label := fmt.Sprintf("%s.Validate()", p.label())
+	logging.V(7).Infof("%s executing", label)
+
+	// Get the RPC client and ensure it's configured.
+	client, err := p.getClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.ValidateProvider(p.requestContext(ctx), &pbempty.Empty{})
+	if err != nil {
+		rpcError := rpcerror.Convert(err)
+		if rpcError.Code() != codes.Unimplemented {
+			logging.V(7).Infof("%s failed: %v", label, rpcError.Message())
+			return contextError(ctx, rpcError)
+		}
+
+		logging.V(7).Infof("%s unimplemented rpc; checking liveness", label)
+		if _, err := p.GetPluginInfo(ctx); err != nil {
+			logging.V(7).Infof("%s failed: err=%v", label, err)
+			return err
+		}
+	}
+
+	logging.V(7).Infof("%s success", label)
+	return nil
+}
+
+// ParameterizeByValue parameterizes the provider with the given opaque value. Providers that do not implement the
+// ParameterizeByValue RPC return ErrNotYetImplemented.
+func (p *provider) ParameterizeByValue(ctx context.Context, value []byte) (ParameterizeResult, error) {
+	label := fmt.Sprintf("%s.ParameterizeByValue()", p.label())
+	logging.V(7).Infof("%s executing (#value=%d)", label, len(value))
+
+	// Get the RPC client and ensure it's configured.
+	client, err := p.getClient(ctx)
+	if err != nil {
+		return ParameterizeResult{}, err
+	}
+
+	resp, err := client.ParameterizeByValue(p.requestContext(ctx), &pulumirpc.ParameterizeByValueRequest{
+		Value: value,
+	})
+	return p.parameterizeResult(ctx, label, resp, err)
+}
+
+// ParameterizeByReference parameterizes the provider with the referenced package. Providers that do not implement
+// the ParameterizeByReference RPC return ErrNotYetImplemented.
+func (p *provider) ParameterizeByReference(ctx context.Context,
+	ref ParameterizeReference) (ParameterizeResult, error) {
+
+	label := fmt.Sprintf("%s.ParameterizeByReference(%s)", p.label(), ref.Name)
+	logging.V(7).Infof("%s executing (version=%v)", label, ref.Version)
+
+	// Get the RPC client and ensure it's configured.
+	client, err := p.getClient(ctx)
+	if err != nil {
+		return ParameterizeResult{}, err
+	}
+
+	req := &pulumirpc.ParameterizeByReferenceRequest{Name: ref.Name}
+	if ref.Version != nil {
+		req.Version = ref.Version.String()
+	}
+	resp, err := client.ParameterizeByReference(p.requestContext(ctx), req)
+	return p.parameterizeResult(ctx, label, resp, err)
+}
+
+// parameterizeResult converts the response to a Parameterize RPC into a ParameterizeResult.
+func (p *provider) parameterizeResult(ctx context.Context, label string, resp *pulumirpc.ParameterizeResponse,
+	err error) (ParameterizeResult, error) {
+
+	if err != nil {
+		rpcError := rpcerror.Convert(err)
+		if rpcError.Code() == codes.Unimplemented {
+			logging.V(7).Infof("%s unimplemented rpc", label)
+			return ParameterizeResult{}, ErrNotYetImplemented
+		}
+		logging.V(7).Infof("%s failed: err=%v", label, rpcError.Message())
+		return ParameterizeResult{}, contextError(ctx, rpcError)
+	}
+
+	var version *semver.Version
+	if v := resp.GetVersion(); v != "" {
+		sv, err := semver.ParseTolerant(v)
+		if err != nil {
+			return ParameterizeResult{}, fmt.Errorf("%s returned an invalid version: %w", label, err)
+		}
+		version = &sv
+	}
+
+	logging.V(7).Infof("%s success: name=%s, version=%v", label, resp.GetName(), version)
+	return ParameterizeResult{Name: resp.GetName(), Version: version}, nil
+}
+
 // Check validates that the given property bag is valid for a resource of the given type.
-func (p *provider) Check(urn resource.URN,
+func (p *provider) Check(ctx context.Context, urn resource.URN,
 	olds, news resource.PropertyMap,
 	allowUnknowns bool, randomSeed []byte) (resource.PropertyMap, []CheckFailure, error) {
 	label := fmt.Sprintf("%s.Check(%s)", p.label(), urn)
 	logging.V(7).Infof("%s executing (#olds=%d,#news=%d", label, len(olds), len(news))
 
 	// Get the RPC client and ensure it's configured.
-	client, err := p.getClient()
+	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -631,21 +866,31 @@ func (p *provider) Check(urn resource.URN,
 		return nil, nil, err
 	}
 
-	resp, err := client.Check(p.requestContext(), &pulumirpc.CheckRequest{
+	req := &pulumirpc.CheckRequest{
 		Urn:        string(urn),
 		Olds:       molds,
 		News:       mnews,
 		RandomSeed: randomSeed,
-	})
+	}
+	if p.binaryProperties {
+		req.Olds, req.BinaryOlds = nil, encodeBinaryStruct(molds)
+		req.News, req.BinaryNews = nil, encodeBinaryStruct(mnews)
+	}
+	resp, err := client.Check(p.requestContext(ctx), req)
 	if err != nil {
 		rpcError := rpcerror.Convert(err)
 		logging.V(7).Infof("%s failed: err=%v", label, rpcError.Message())
-		return nil, nil, rpcError
+		return nil, nil, contextError(ctx, rpcError)
 	}
+	reportDiagnostics(ctx, urn, resp.GetDiagnostics())
 
 	// Unmarshal the provider inputs.
+	ins, err := decodeBinaryStruct(resp.GetInputs(), resp.GetBinaryInputs(), fmt.Sprintf("%s.inputs", label))
+	if err != nil {
+		return nil, nil, err
+	}
 	var inputs resource.PropertyMap
-	if ins := resp.GetInputs(); ins != nil {
+	if ins != nil {
 		inputs, err = UnmarshalProperties(ins, MarshalOptions{
 			Label:          fmt.Sprintf("%s.inputs", label),
 			KeepUnknowns:   allowUnknowns,
@@ -666,17 +911,14 @@ func (p *provider) Check(urn resource.URN,
 	}
 
 	// And now any properties that failed verification.
-	var failures []CheckFailure
-	for _, failure := range resp.GetFailures() {
-		failures = append(failures, CheckFailure{resource.PropertyKey(failure.Property), failure.Reason})
-	}
+	failures := UnmarshalCheckFailures(resp.GetFailures())
 
 	logging.V(7).Infof("%s success: inputs=#%d failures=#%d", label, len(inputs), len(failures))
 	return inputs, failures, nil
 }
 
 // Diff checks what impacts a hypothetical update will have on the resource's properties.
-func (p *provider) Diff(urn resource.URN, id resource.ID,
+func (p *provider) Diff(ctx context.Context, urn resource.URN, id resource.ID,
 	olds resource.PropertyMap, news resource.PropertyMap, allowUnknowns bool,
 	ignoreChanges []string) (DiffResult, error) {
 
@@ -689,7 +931,7 @@ func (p *provider) Diff(urn resource.URN, id resource.ID,
 	logging.V(7).Infof("%s: executing (#olds=%d,#news=%d)", label, len(olds), len(news))
 
 	// Get the RPC client and ensure it's configured.
-	client, err := p.getClient()
+	client, err := p.getClient(ctx)
 	if err != nil {
 		return DiffResult{}, err
 	}
@@ -724,7 +966,7 @@ func (p *provider) Diff(urn resource.URN, id resource.ID,
 		return DiffResult{}, err
 	}
 
-	resp, err := client.Diff(p.requestContext(), &pulumirpc.DiffRequest{
+	resp, err := client.Diff(p.requestContext(ctx), &pulumirpc.DiffRequest{
 		Id:            string(id),
 		Urn:           string(urn),
 		Olds:          molds,
@@ -734,8 +976,9 @@ func (p *provider) Diff(urn resource.URN, id resource.ID,
 	if err != nil {
 		rpcError := rpcerror.Convert(err)
 		logging.V(7).Infof("%s failed: %v", label, rpcError.Message())
-		return DiffResult{}, rpcError
+		return DiffResult{}, contextError(ctx, rpcError)
 	}
+	reportDiagnostics(ctx, urn, resp.GetDiagnostics())
 
 	var replaces []resource.PropertyKey
 	for _, replace := range resp.GetReplaces() {
@@ -755,19 +998,24 @@ func (p *provider) Diff(urn resource.URN, id resource.ID,
 	logging.V(7).Infof("%s success: changes=%d #replaces=%v #stables=%v delbefrepl=%v, diffs=#%v, detaileddiff=%v",
 		label, changes, replaces, stables, deleteBeforeReplace, diffs, resp.GetDetailedDiff())
 
+	detailedDiff, err := decodeDetailedDiff(label, resp)
+	if err != nil {
+		return DiffResult{}, err
+	}
+
 	return DiffResult{
 		Changes:             DiffChanges(changes),
 		ReplaceKeys:         replaces,
 		StableKeys:          stables,
 		ChangedKeys:         diffs,
-		DetailedDiff:        decodeDetailedDiff(resp),
+		DetailedDiff:        detailedDiff,
 		DeleteBeforeReplace: deleteBeforeReplace,
 	}, nil
 }
 
 // Create allocates a new instance of the provided resource and assigns its unique resource.ID and outputs afterwards.
-func (p *provider) Create(urn resource.URN, props resource.PropertyMap, timeout float64, preview bool) (resource.ID,
-	resource.PropertyMap, resource.Status, error) {
+func (p *provider) Create(ctx context.Context, urn resource.URN, props resource.PropertyMap, timeout float64,
+	preview bool) (resource.ID, resource.PropertyMap, resource.Status, error) {
 	contract.Assert(urn != "")
 	contract.Assert(props != nil)
 
@@ -775,7 +1023,7 @@ func (p *provider) Create(urn resource.URN, props resource.PropertyMap, timeout
 	logging.V(7).Infof("%s executing (#props=%v)", label, len(props))
 
 	// Get the RPC client and ensure it's configured.
-	client, err := p.getClient()
+	client, err := p.getClient(ctx)
 	if err != nil {
 		return "", nil, resource.StatusOK, err
 	}
@@ -820,23 +1068,22 @@ func (p *provider) Create(urn resource.URN, props resource.PropertyMap, timeout
 	var liveObject *_struct.Struct
 	var resourceError error
 	var resourceStatus = resource.StatusOK
-	resp, err := client.Create(p.requestContext(), &pulumirpc.CreateRequest{
-		Urn:        string(urn),
-		Properties: mprops,
-		Timeout:    timeout,
-		Preview:    preview,
-	})
+	resp, err := client.Create(p.requestContext(ctx), p.createRequest(urn, mprops, timeout, preview))
 	if err != nil {
 		resourceStatus, id, liveObject, _, resourceError = parseError(err)
 		logging.V(7).Infof("%s failed: %v", label, resourceError)
 
 		if resourceStatus != resource.StatusPartialFailure {
-			return "", nil, resourceStatus, resourceError
+			return "", nil, resourceStatus, contextError(ctx, resourceError)
 		}
 		// Else it's a `StatusPartialFailure`.
 	} else {
+		reportDiagnostics(ctx, urn, resp.GetDiagnostics())
 		id = resource.ID(resp.GetId())
-		liveObject = resp.GetProperties()
+		liveObject, err = decodeBinaryStruct(resp.GetProperties(), resp.GetBinaryProperties(), label)
+		if err != nil {
+			return "", nil, resource.StatusUnknown, err
+		}
 	}
 
 	if id == "" && !preview {
@@ -844,6 +1091,191 @@ func (p *provider) Create(urn resource.URN, props resource.PropertyMap, timeout
 			errors.Errorf("plugin for package '%v' returned empty resource.ID from create '%v'", p.pkg, urn)
 	}
 
+	outs, err := p.unmarshalCreateOutputs(label, liveObject, props, preview)
+	if err != nil {
+		return "", nil, resourceStatus, err
+	}
+
+	logging.V(7).Infof("%s success: id=%s; #outs=%d", label, id, len(outs))
+	if resourceError == nil {
+		return id, outs, resourceStatus, nil
+	}
+	return id, outs, resourceStatus, resourceError
+}
+
+// StreamCreate allocates a new instance of the provided resource, passing each interim state that the provider streams
+// back to onNext. Previews, and providers that do not implement StreamCreate, are handled by Create.
+func (p *provider) StreamCreate(ctx context.Context, urn resource.URN, props resource.PropertyMap, timeout float64,
+	preview bool, onNext func(resource.PropertyMap) error) (resource.ID, resource.PropertyMap, resource.Status, error) {
+
+	contract.Assert(urn != "")
+	contract.Assert(props != nil)
+
+	label := fmt.Sprintf("%s.StreamCreate(%s)", p.label(), urn)
+	logging.V(7).Infof("%s executing (#props=%v)", label, len(props))
+
+	// Get the RPC client and ensure it's configured.
+	client, err := p.getClient(ctx)
+	if err != nil {
+		return "", nil, resource.StatusOK, err
+	}
+
+	// Previews do not take long enough to be worth streaming, and Create already knows how to handle previews for
+	// providers that are not fully configured.
+	if preview || !p.cfgknown {
+		return p.Create(ctx, urn, props, timeout, preview)
+	}
+
+	mprops, err := MarshalProperties(props, MarshalOptions{
+		Label:         fmt.Sprintf("%s.inputs", label),
+		KeepSecrets:   p.acceptSecrets,
+		KeepResources: p.acceptResources,
+	})
+	if err != nil {
+		return "", nil, resource.StatusOK, err
+	}
+
+	// Cancel the stream if we stop reading from it early.
+	streamCtx, cancel := context.WithCancel(p.requestContext(ctx))
+	defer cancel()
+
+	streamClient, err := client.StreamCreate(streamCtx, p.createRequest(urn, mprops, timeout, false))
+	var final *pulumirpc.CreateResponse
+	received := false
+	for err == nil {
+		var resp *pulumirpc.CreateResponse
+		if resp, err = streamClient.Recv(); err != nil {
+			break
+		}
+		received = true
+
+		// The final response is the only one that carries the resource's ID.
+		if resp.GetId() != "" {
+			final = resp
+			continue
+		}
+
+		liveObject, unmarshalErr := decodeBinaryStruct(resp.GetProperties(), resp.GetBinaryProperties(), label)
+		if unmarshalErr != nil {
+			return "", nil, resource.StatusUnknown, unmarshalErr
+		}
+		state, unmarshalErr := p.unmarshalCreateOutputs(label, liveObject, props, false)
+		if unmarshalErr != nil {
+			return "", nil, resource.StatusUnknown, unmarshalErr
+		}
+		if nextErr := onNext(state); nextErr != nil {
+			return "", nil, resource.StatusUnknown, nextErr
+		}
+	}
+
+	if err != io.EOF {
+		if !received && rpcerror.Convert(err).Code() == codes.Unimplemented {
+			logging.V(7).Infof("%s unimplemented rpc: falling back to Create", label)
+			return p.Create(ctx, urn, props, timeout, preview)
+		}
+
+		resourceStatus, id, liveObject, _, resourceError := parseError(err)
+		logging.V(7).Infof("%s failed: %v", label, resourceError)
+		if resourceStatus != resource.StatusPartialFailure {
+			return "", nil, resourceStatus, contextError(ctx, resourceError)
+		}
+
+		outs, err := p.unmarshalCreateOutputs(label, liveObject, props, false)
+		if err != nil {
+			return "", nil, resourceStatus, err
+		}
+		return id, outs, resourceStatus, resourceError
+	}
+
+	if final == nil {
+		return "", nil, resource.StatusUnknown,
+			errors.Errorf("plugin for package '%v' returned empty resource.ID from create '%v'", p.pkg, urn)
+	}
+
+	liveObject, err := decodeBinaryStruct(final.GetProperties(), final.GetBinaryProperties(), label)
+	if err != nil {
+		return "", nil, resource.StatusUnknown, err
+	}
+	outs, err := p.unmarshalCreateOutputs(label, liveObject, props, false)
+	if err != nil {
+		return "", nil, resource.StatusOK, err
+	}
+
+	id := resource.ID(final.GetId())
+	logging.V(7).Infof("%s success: id=%s; #outs=%d", label, id, len(outs))
+	return id, outs, resource.StatusOK, nil
+}
+
+// createRequest returns the request for a Create or StreamCreate call, encoding the properties as MessagePack if the
+// provider supports it.
+func (p *provider) createRequest(urn resource.URN, props *_struct.Struct, timeout float64,
+	preview bool) *pulumirpc.CreateRequest {
+
+	req := &pulumirpc.CreateRequest{
+		Urn:        string(urn),
+		Properties: props,
+		Timeout:    timeout,
+		Preview:    preview,
+	}
+	if p.binaryProperties {
+		req.Properties, req.BinaryProperties = nil, encodeBinaryStruct(props)
+	}
+	return req
+}
+
+// SingleStreamCreate implements StreamCreate for providers that cannot report interim states by issuing a single
+// Create. onNext is never called.
+func SingleStreamCreate(ctx context.Context, p Provider, urn resource.URN, news resource.PropertyMap, timeout float64,
+	preview bool, onNext func(resource.PropertyMap) error) (resource.ID, resource.PropertyMap, resource.Status, error) {
+	return p.Create(ctx, urn, news, timeout, preview)
+}
+
+// WaitForResourceReady blocks until the given resource is ready to be used. A DEADLINE_EXCEEDED status from the
+// provider is reported as a *WaitTimeoutError, and providers that do not implement the WaitForResourceReady RPC return
+// ErrNotYetImplemented.
+func (p *provider) WaitForResourceReady(ctx context.Context, urn resource.URN, id resource.ID,
+	timeout float64) error {
+
+	contract.Assert(urn != "")
+	contract.Assert(id != "")
+
+	label := fmt.Sprintf("%s.WaitForResourceReady(%s,%s)", p.label(), urn, id)
+	logging.V(7).Infof("%s executing (timeout=%v)", label, timeout)
+
+	// Get the RPC client and ensure it's configured.
+	client, err := p.getClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.WaitForResourceReady(p.requestContext(ctx), &pulumirpc.WaitForResourceReadyRequest{
+		Urn:     string(urn),
+		Id:      string(id),
+		Timeout: timeout,
+	})
+	if err != nil {
+		rpcError := rpcerror.Convert(err)
+		switch {
+		case rpcError.Code() == codes.Unimplemented:
+			logging.V(7).Infof("%s unimplemented rpc", label)
+			return ErrNotYetImplemented
+		case rpcError.Code() == codes.DeadlineExceeded && ctx.Err() == nil:
+			logging.V(7).Infof("%s timed out: %v", label, rpcError.Message())
+			return &WaitTimeoutError{URN: urn, Timeout: time.Duration(timeout * float64(time.Second))}
+		}
+		logging.V(7).Infof("%s failed: %v", label, rpcError.Message())
+		return contextError(ctx, rpcError)
+	}
+
+	logging.V(7).Infof("%s success", label)
+	return nil
+}
+
+// unmarshalCreateOutputs unmarshals the state returned by Create or StreamCreate. If we could not pass secrets to the
+// provider, the secret bit is retained on any property with the same name as a secret input.
+func (p *provider) unmarshalCreateOutputs(label string, liveObject *_struct.Struct, props resource.PropertyMap,
+	preview bool) (resource.PropertyMap, error) {
+
 	outs, err := UnmarshalProperties(liveObject, MarshalOptions{
 		Label:          fmt.Sprintf("%s.outputs", label),
 		RejectUnknowns: !preview,
@@ -852,26 +1284,20 @@ func (p *provider) Create(urn resource.URN, props resource.PropertyMap, timeout
 		KeepResources:  true,
 	})
 	if err != nil {
-		return "", nil, resourceStatus, err
+		return nil, err
 	}
 
-	// If we could not pass secrets to the provider, retain the secret bit on any property with the same name. This
-	// allows us to retain metadata about secrets in many cases, even for providers that do not understand secrets
-	// natively.
+	// This allows us to retain metadata about secrets in many cases, even for providers that do not understand
+	// secrets natively.
 	if !p.acceptSecrets {
 		annotateSecrets(outs, props)
 	}
-
-	logging.V(7).Infof("%s success: id=%s; #outs=%d", label, id, len(outs))
-	if resourceError == nil {
-		return id, outs, resourceStatus, nil
-	}
-	return id, outs, resourceStatus, resourceError
+	return outs, nil
 }
 
 // read the current live state associated with a resource.  enough state must be include in the inputs to uniquely
 // identify the resource; this is typically just the resource id, but may also include some properties.
-func (p *provider) Read(urn resource.URN, id resource.ID,
+func (p *provider) Read(ctx context.Context, urn resource.URN, id resource.ID,
 	inputs, state resource.PropertyMap) (ReadResult, resource.Status, error) {
 
 	contract.Assertf(urn != "", "Read URN was empty")
@@ -881,7 +1307,7 @@ func (p *provider) Read(urn resource.URN, id resource.ID,
 	logging.V(7).Infof("%s executing (#inputs=%v, #state=%v)", label, len(inputs), len(state))
 
 	// Get the RPC client and ensure it's configured.
-	client, err := p.getClient()
+	client, err := p.getClient(ctx)
 	if err != nil {
 		return ReadResult{}, resource.StatusUnknown, err
 	}
@@ -895,73 +1321,450 @@ func (p *provider) Read(urn resource.URN, id resource.ID,
 	}
 
 	// Marshal the resource inputs and state so we can perform the RPC.
-	var minputs *_struct.Struct
-	if inputs != nil {
-		m, err := MarshalProperties(inputs, MarshalOptions{
-			Label:              label,
-			ElideAssetContents: true,
-			KeepSecrets:        p.acceptSecrets,
-			KeepResources:      p.acceptResources,
-		})
-		if err != nil {
-			return ReadResult{}, resource.StatusUnknown, err
-		}
-		minputs = m
+	req, err := p.marshalReadRequest(label, urn, id, inputs, state)
+	if err != nil {
+		return ReadResult{}, resource.StatusUnknown, err
 	}
-	mstate, err := MarshalProperties(state, MarshalOptions{
-		Label:              label,
-		ElideAssetContents: true,
-		KeepSecrets:        p.acceptSecrets,
-		KeepResources:      p.acceptResources,
+
+	// Now issue the read request over RPC, blocking until it finished.
+	resp, err := client.Read(p.requestContext(ctx), req)
+	if err != nil {
+		logging.V(7).Infof("%s failed: %v", label, err)
+		return p.unmarshalReadError(ctx, label, err, inputs, state)
+	}
+	reportDiagnostics(ctx, urn, resp.GetDiagnostics())
+
+	result, err := p.unmarshalReadResponse(label, resp, inputs, state)
+	if err != nil {
+		return ReadResult{}, resource.StatusOK, err
+	}
+	logging.V(7).Infof("%s success; #outs=%d, #inputs=%d", label, len(result.Outputs), len(result.Inputs))
+	return result, resource.StatusOK, nil
+}
+
+// PrepareImport returns the minimal inputs that are required to import the given resource. Providers that do not
+// implement the PrepareImport RPC return ErrNotYetImplemented.
+func (p *provider) PrepareImport(ctx context.Context, urn resource.URN, id resource.ID) (resource.PropertyMap, error) {
+	contract.Assertf(urn != "", "PrepareImport URN was empty")
+	contract.Assertf(id != "", "PrepareImport resource ID was empty")
+
+	label := fmt.Sprintf("%s.PrepareImport(%s,%s)", p.label(), id, urn)
+	logging.V(7).Infof("%s executing", label)
+
+	// Get the RPC client and ensure it's configured.
+	client, err := p.getClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.PrepareImport(p.requestContext(ctx), &pulumirpc.PrepareImportRequest{
+		Urn: string(urn),
+		Id:  string(id),
 	})
+	if err != nil {
+		rpcError := rpcerror.Convert(err)
+		if rpcError.Code() == codes.Unimplemented {
+			logging.V(7).Infof("%s unimplemented rpc", label)
+			return nil, ErrNotYetImplemented
+		}
+		logging.V(7).Infof("%s failed: %v", label, rpcError.Message())
+		return nil, contextError(ctx, rpcError)
+	}
+
+	inputs, err := UnmarshalProperties(resp.GetInputs(), MarshalOptions{
+		Label:          fmt.Sprintf("%s.inputs", label),
+		RejectUnknowns: true,
+		KeepSecrets:    true,
+		KeepResources:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logging.V(7).Infof("%s success; #inputs=%d", label, len(inputs))
+	return inputs, nil
+}
+
+// Refresh reads the current live state associated with a resource as part of a refresh. Providers that do not
+// implement the Refresh RPC are read with ReadStream instead.
+func (p *provider) Refresh(ctx context.Context, urn resource.URN, id resource.ID,
+	inputs, state resource.PropertyMap) (ReadResult, resource.Status, error) {
+
+	contract.Assertf(urn != "", "Refresh URN was empty")
+	contract.Assertf(id != "", "Refresh ID was empty")
+
+	label := fmt.Sprintf("%s.Refresh(%s,%s)", p.label(), id, urn)
+	logging.V(7).Infof("%s executing (#inputs=%v, #state=%v)", label, len(inputs), len(state))
+
+	// Get the RPC client and ensure it's configured.
+	client, err := p.getClient(ctx)
 	if err != nil {
 		return ReadResult{}, resource.StatusUnknown, err
 	}
 
-	// Now issue the read request over RPC, blocking until it finished.
-	var readID resource.ID
-	var liveObject *_struct.Struct
-	var liveInputs *_struct.Struct
-	var resourceError error
-	var resourceStatus = resource.StatusOK
-	resp, err := client.Read(p.requestContext(), &pulumirpc.ReadRequest{
+	// If the provider is not fully configured, return an empty bag.
+	if !p.cfgknown {
+		return ReadResult{
+			Outputs: resource.PropertyMap{},
+			Inputs:  resource.PropertyMap{},
+		}, resource.StatusUnknown, nil
+	}
+
+	req, err := p.marshalReadRequest(label, urn, id, inputs, state)
+	if err != nil {
+		return ReadResult{}, resource.StatusUnknown, err
+	}
+
+	resp, err := client.Refresh(p.requestContext(ctx), req)
+	if err != nil {
+		if rpcerror.Convert(err).Code() == codes.Unimplemented {
+			logging.V(7).Infof("%s unimplemented rpc: falling back to ReadStream", label)
+			return RefreshWithReadStream(ctx, p, urn, id, inputs, state)
+		}
+		logging.V(7).Infof("%s failed: %v", label, err)
+		return p.unmarshalReadError(ctx, label, err, inputs, state)
+	}
+	reportDiagnostics(ctx, urn, resp.GetDiagnostics())
+
+	result, err := p.unmarshalReadResponse(label, resp, inputs, state)
+	if err != nil {
+		return ReadResult{}, resource.StatusOK, err
+	}
+	logging.V(7).Infof("%s success; #outs=%d, #inputs=%d", label, len(result.Outputs), len(result.Inputs))
+	return result, resource.StatusOK, nil
+}
+
+// SchemaVersion returns the version of the resource state schema that the provider reported from Configure.
+func (p *provider) SchemaVersion(ctx context.Context) (int, error) {
+	if err := p.ensureConfigured(ctx); err != nil {
+		return 0, err
+	}
+	return p.schemaVersion, nil
+}
+
+// MigrateState upgrades the stored state of a resource from the given state schema version to the provider's current
+// schema version. Providers that do not implement the MigrateState RPC return ErrNotYetImplemented.
+func (p *provider) MigrateState(ctx context.Context, urn resource.URN, stateVersion int,
+	state resource.PropertyMap) (resource.PropertyMap, error) {
+
+	contract.Assertf(urn != "", "MigrateState URN was empty")
+
+	label := fmt.Sprintf("%s.MigrateState(%s)", p.label(), urn)
+	logging.V(7).Infof("%s executing (stateVersion=%d, #state=%d)", label, stateVersion, len(state))
+
+	// Get the RPC client and ensure it's configured.
+	client, err := p.getClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	mstate, err := MarshalProperties(state, MarshalOptions{
+		Label:              fmt.Sprintf("%s.state", label),
+		ElideAssetContents: true,
+		KeepSecrets:        p.acceptSecrets,
+		KeepResources:      p.acceptResources,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MigrateState(p.requestContext(ctx), &pulumirpc.MigrateStateRequest{
+		Urn:          string(urn),
+		StateVersion: int32(stateVersion),
+		State:        mstate,
+	})
+	if err != nil {
+		rpcError := rpcerror.Convert(err)
+		if rpcError.Code() == codes.Unimplemented {
+			logging.V(7).Infof("%s unimplemented rpc", label)
+			return nil, ErrNotYetImplemented
+		}
+		logging.V(7).Infof("%s failed: %v", label, rpcError.Message())
+		return nil, contextError(ctx, rpcError)
+	}
+
+	migrated, err := UnmarshalProperties(resp.GetState(), MarshalOptions{
+		Label:          fmt.Sprintf("%s.state", label),
+		RejectUnknowns: true,
+		KeepSecrets:    true,
+		KeepResources:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// If we could not pass secrets to the provider, retain the secret bit on any property with the same name.
+	if !p.acceptSecrets {
+		annotateSecrets(migrated, state)
+	}
+
+	logging.V(7).Infof("%s success; #state=%d", label, len(migrated))
+	return migrated, nil
+}
+
+// GetResourceAliases returns the URNs under which the given resource may have been stored by earlier versions of the
+// provider. Providers that do not implement the GetResourceAliases RPC return ErrNotYetImplemented.
+func (p *provider) GetResourceAliases(ctx context.Context, urn resource.URN) ([]resource.Alias, error) {
+	contract.Assertf(urn != "", "GetResourceAliases URN was empty")
+
+	label := fmt.Sprintf("%s.GetResourceAliases(%s)", p.label(), urn)
+	logging.V(7).Infof("%s executing", label)
+
+	// Get the RPC client and ensure it's configured.
+	client, err := p.getClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetResourceAliases(p.requestContext(ctx), &pulumirpc.GetResourceAliasesRequest{
+		Urn: string(urn),
+	})
+	if err != nil {
+		rpcError := rpcerror.Convert(err)
+		if rpcError.Code() == codes.Unimplemented {
+			logging.V(7).Infof("%s unimplemented rpc", label)
+			return nil, ErrNotYetImplemented
+		}
+		logging.V(7).Infof("%s failed: %v", label, rpcError.Message())
+		return nil, contextError(ctx, rpcError)
+	}
+
+	var aliases []resource.Alias
+	for _, alias := range resp.GetAliases() {
+		aliases = append(aliases, resource.Alias{URN: resource.URN(alias)})
+	}
+
+	logging.V(7).Infof("%s success; #aliases=%d", label, len(aliases))
+	return aliases, nil
+}
+
+// EstimateCost estimates the monthly cost of running the given resource. Providers that do not implement the
+// EstimateCost RPC, and providers whose configuration is not yet known, return ErrNotYetImplemented.
+func (p *provider) EstimateCost(ctx context.Context, urn resource.URN,
+	news resource.PropertyMap) (CostEstimate, error) {
+
+	contract.Assertf(urn != "", "EstimateCost URN was empty")
+
+	label := fmt.Sprintf("%s.EstimateCost(%s)", p.label(), urn)
+	logging.V(7).Infof("%s executing (#news=%d)", label, len(news))
+
+	// Get the RPC client and ensure it's configured.
+	client, err := p.getClient(ctx)
+	if err != nil {
+		return CostEstimate{}, err
+	}
+
+	// If the provider is not fully configured, it cannot price the resource.
+	if !p.cfgknown {
+		logging.V(7).Infof("%s skipped: provider configuration is unknown", label)
+		return CostEstimate{}, ErrNotYetImplemented
+	}
+
+	mnews, err := MarshalProperties(news, MarshalOptions{
+		Label:              fmt.Sprintf("%s.news", label),
+		ElideAssetContents: true,
+		KeepUnknowns:       true,
+		KeepSecrets:        p.acceptSecrets,
+		KeepResources:      p.acceptResources,
+	})
+	if err != nil {
+		return CostEstimate{}, err
+	}
+
+	resp, err := client.EstimateCost(p.requestContext(ctx), &pulumirpc.EstimateCostRequest{
+		Urn:  string(urn),
+		News: mnews,
+	})
+	if err != nil {
+		rpcError := rpcerror.Convert(err)
+		if rpcError.Code() == codes.Unimplemented {
+			logging.V(7).Infof("%s unimplemented rpc", label)
+			return CostEstimate{}, ErrNotYetImplemented
+		}
+		logging.V(7).Infof("%s failed: %v", label, rpcError.Message())
+		return CostEstimate{}, contextError(ctx, rpcError)
+	}
+
+	estimate := CostEstimate{
+		MonthlyCostUSD: resp.GetMonthlyCostUSD(),
+		Currency:       resp.GetCurrency(),
+		Confidence:     resp.GetConfidence(),
+	}
+	logging.V(7).Infof("%s success; monthlyCostUSD=%v", label, estimate.MonthlyCostUSD)
+	return estimate, nil
+}
+
+// ReadStream reads the current live state associated with a resource, passing each interim state that the provider
+// reports to onNext. Providers that do not implement the ReadStream RPC are read with a single call to Read.
+func (p *provider) ReadStream(ctx context.Context, urn resource.URN, id resource.ID,
+	inputs, state resource.PropertyMap, onNext func(ReadResult) error) (resource.Status, error) {
+
+	contract.Assertf(urn != "", "ReadStream URN was empty")
+	contract.Assertf(id != "", "ReadStream ID was empty")
+
+	label := fmt.Sprintf("%s.ReadStream(%s,%s)", p.label(), id, urn)
+	logging.V(7).Infof("%s executing (#inputs=%v, #state=%v)", label, len(inputs), len(state))
+
+	// Get the RPC client and ensure it's configured.
+	client, err := p.getClient(ctx)
+	if err != nil {
+		return resource.StatusUnknown, err
+	}
+
+	// If the provider is not fully configured, return an empty bag.
+	if !p.cfgknown {
+		return resource.StatusUnknown, onNext(ReadResult{
+			Outputs: resource.PropertyMap{},
+			Inputs:  resource.PropertyMap{},
+		})
+	}
+
+	req, err := p.marshalReadRequest(label, urn, id, inputs, state)
+	if err != nil {
+		return resource.StatusUnknown, err
+	}
+
+	// Cancel the stream if we stop reading from it early.
+	streamCtx, cancel := context.WithCancel(p.requestContext(ctx))
+	defer cancel()
+
+	streamClient, err := client.ReadStream(streamCtx, req)
+	received := false
+	for err == nil {
+		var resp *pulumirpc.ReadResponse
+		if resp, err = streamClient.Recv(); err != nil {
+			break
+		}
+		received = true
+
+		result, unmarshalErr := p.unmarshalReadResponse(label, resp, inputs, state)
+		if unmarshalErr != nil {
+			return resource.StatusOK, unmarshalErr
+		}
+		if nextErr := onNext(result); nextErr != nil {
+			return resource.StatusOK, nextErr
+		}
+	}
+	if err == io.EOF {
+		logging.V(7).Infof("%s success", label)
+		return resource.StatusOK, nil
+	}
+
+	if !received && rpcerror.Convert(err).Code() == codes.Unimplemented {
+		logging.V(7).Infof("%s unimplemented rpc: falling back to Read", label)
+		return SingleReadStream(ctx, p, urn, id, inputs, state, onNext)
+	}
+
+	logging.V(7).Infof("%s failed: %v", label, err)
+	result, status, err := p.unmarshalReadError(ctx, label, err, inputs, state)
+	if status == resource.StatusPartialFailure {
+		if nextErr := onNext(result); nextErr != nil {
+			return status, nextErr
+		}
+	}
+	return status, err
+}
+
+// SingleReadStream implements ReadStream for providers that cannot report interim states by issuing a single Read
+// and passing its result to onNext. If the read partially fails, its result is passed to onNext before the error is
+// returned.
+func SingleReadStream(ctx context.Context, p Provider, urn resource.URN, id resource.ID,
+	inputs, state resource.PropertyMap, onNext func(ReadResult) error) (resource.Status, error) {
+
+	result, status, err := p.Read(ctx, urn, id, inputs, state)
+	if err != nil && status != resource.StatusPartialFailure {
+		return status, err
+	}
+	if nextErr := onNext(result); nextErr != nil {
+		return status, nextErr
+	}
+	return status, err
+}
+
+// marshalReadRequest marshals the arguments to Read or ReadStream into a ReadRequest.
+func (p *provider) marshalReadRequest(label string, urn resource.URN, id resource.ID,
+	inputs, state resource.PropertyMap) (*pulumirpc.ReadRequest, error) {
+
+	var minputs *_struct.Struct
+	if inputs != nil {
+		m, err := MarshalProperties(inputs, MarshalOptions{
+			Label:              label,
+			ElideAssetContents: true,
+			KeepSecrets:        p.acceptSecrets,
+			KeepResources:      p.acceptResources,
+		})
+		if err != nil {
+			return nil, err
+		}
+		minputs = m
+	}
+	mstate, err := MarshalProperties(state, MarshalOptions{
+		Label:              label,
+		ElideAssetContents: true,
+		KeepSecrets:        p.acceptSecrets,
+		KeepResources:      p.acceptResources,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pulumirpc.ReadRequest{
 		Id:         string(id),
 		Urn:        string(urn),
 		Properties: mstate,
 		Inputs:     minputs,
-	})
-	if err != nil {
-		resourceStatus, readID, liveObject, liveInputs, resourceError = parseError(err)
-		logging.V(7).Infof("%s failed: %v", label, err)
+	}, nil
+}
 
-		if resourceStatus != resource.StatusPartialFailure {
-			return ReadResult{}, resourceStatus, resourceError
-		}
-		// Else it's a `StatusPartialFailure`.
-	} else {
-		readID = resource.ID(resp.GetId())
-		liveObject = resp.GetProperties()
-		liveInputs = resp.GetInputs()
+// unmarshalReadError converts an error returned by the Read or ReadStream RPCs into a status and, for partial
+// failures, the state of the resource carried by the error.
+func (p *provider) unmarshalReadError(ctx context.Context, label string, err error,
+	inputs, state resource.PropertyMap) (ReadResult, resource.Status, error) {
+
+	resourceStatus, readID, liveObject, liveInputs, resourceError := parseError(err)
+	if resourceStatus != resource.StatusPartialFailure {
+		return ReadResult{}, resourceStatus, contextError(ctx, resourceError)
 	}
 
 	// If the resource was missing, simply return a nil property map.
-	if string(readID) == "" {
+	if readID == "" {
 		return ReadResult{}, resourceStatus, nil
 	}
 
+	result, err := p.unmarshalReadResponse(label, &pulumirpc.ReadResponse{
+		Id:         string(readID),
+		Properties: liveObject,
+		Inputs:     liveInputs,
+	}, inputs, state)
+	if err != nil {
+		return ReadResult{}, resourceStatus, err
+	}
+	return result, resourceStatus, resourceError
+}
+
+// unmarshalReadResponse unmarshals the state of a resource returned by the Read or ReadStream RPCs.
+func (p *provider) unmarshalReadResponse(label string, resp *pulumirpc.ReadResponse,
+	inputs, state resource.PropertyMap) (ReadResult, error) {
+
+	// If the resource was missing, simply return a nil property map.
+	readID := resource.ID(resp.GetId())
+	if readID == "" {
+		return ReadResult{}, nil
+	}
+
 	// Finally, unmarshal the resulting state properties and return them.
-	newState, err := UnmarshalProperties(liveObject, MarshalOptions{
+	newState, err := UnmarshalProperties(resp.GetProperties(), MarshalOptions{
 		Label:          fmt.Sprintf("%s.outputs", label),
 		RejectUnknowns: true,
 		KeepSecrets:    true,
 		KeepResources:  true,
 	})
 	if err != nil {
-		return ReadResult{}, resourceStatus, err
+		return ReadResult{}, err
 	}
 
 	var newInputs resource.PropertyMap
-	if liveInputs != nil {
+	if liveInputs := resp.GetInputs(); liveInputs != nil {
 		newInputs, err = UnmarshalProperties(liveInputs, MarshalOptions{
 			Label:          label + ".inputs",
 			RejectUnknowns: true,
@@ -969,7 +1772,7 @@ func (p *provider) Read(urn resource.URN, id resource.ID,
 			KeepResources:  true,
 		})
 		if err != nil {
-			return ReadResult{}, resourceStatus, err
+			return ReadResult{}, err
 		}
 	}
 
@@ -981,16 +1784,21 @@ func (p *provider) Read(urn resource.URN, id resource.ID,
 		annotateSecrets(newState, state)
 	}
 
-	logging.V(7).Infof("%s success; #outs=%d, #inputs=%d", label, len(newState), len(newInputs))
 	return ReadResult{
 		ID:      readID,
 		Outputs: newState,
 		Inputs:  newInputs,
-	}, resourceStatus, resourceError
+	}, nil
+}
+
+// BatchRead reads the current live state of several resources. The provider protocol has no batched read RPC, so
+// each request is issued as an individual Read.
+func (p *provider) BatchRead(ctx context.Context, requests []BatchReadRequest) ([]BatchReadResponse, error) {
+	return SequentialBatchRead(ctx, p, requests)
 }
 
 // Update updates an existing resource with new values.
-func (p *provider) Update(urn resource.URN, id resource.ID,
+func (p *provider) Update(ctx context.Context, urn resource.URN, id resource.ID,
 	olds resource.PropertyMap, news resource.PropertyMap, timeout float64,
 	ignoreChanges []string, preview bool) (resource.PropertyMap, resource.Status, error) {
 
@@ -1003,7 +1811,7 @@ func (p *provider) Update(urn resource.URN, id resource.ID,
 	logging.V(7).Infof("%s executing (#olds=%v,#news=%v)", label, len(olds), len(news))
 
 	// Get the RPC client and ensure it's configured.
-	client, err := p.getClient()
+	client, err := p.getClient(ctx)
 	if err != nil {
 		return news, resource.StatusOK, err
 	}
@@ -1056,7 +1864,7 @@ func (p *provider) Update(urn resource.URN, id resource.ID,
 	var liveObject *_struct.Struct
 	var resourceError error
 	var resourceStatus = resource.StatusOK
-	resp, err := client.Update(p.requestContext(), &pulumirpc.UpdateRequest{
+	req := &pulumirpc.UpdateRequest{
 		Id:            string(id),
 		Urn:           string(urn),
 		Olds:          molds,
@@ -1064,17 +1872,26 @@ func (p *provider) Update(urn resource.URN, id resource.ID,
 		Timeout:       timeout,
 		IgnoreChanges: ignoreChanges,
 		Preview:       preview,
-	})
+	}
+	if p.binaryProperties {
+		req.Olds, req.BinaryOlds = nil, encodeBinaryStruct(molds)
+		req.News, req.BinaryNews = nil, encodeBinaryStruct(mnews)
+	}
+	resp, err := client.Update(p.requestContext(ctx), req)
 	if err != nil {
 		resourceStatus, _, liveObject, _, resourceError = parseError(err)
 		logging.V(7).Infof("%s failed: %v", label, resourceError)
 
 		if resourceStatus != resource.StatusPartialFailure {
-			return nil, resourceStatus, resourceError
+			return nil, resourceStatus, contextError(ctx, resourceError)
 		}
 		// Else it's a `StatusPartialFailure`.
 	} else {
-		liveObject = resp.GetProperties()
+		reportDiagnostics(ctx, urn, resp.GetDiagnostics())
+		liveObject, err = decodeBinaryStruct(resp.GetProperties(), resp.GetBinaryProperties(), label)
+		if err != nil {
+			return nil, resource.StatusUnknown, err
+		}
 	}
 
 	outs, err := UnmarshalProperties(liveObject, MarshalOptions{
@@ -1103,7 +1920,7 @@ func (p *provider) Update(urn resource.URN, id resource.ID,
 }
 
 // Delete tears down an existing resource.
-func (p *provider) Delete(urn resource.URN, id resource.ID, props resource.PropertyMap,
+func (p *provider) Delete(ctx context.Context, urn resource.URN, id resource.ID, props resource.PropertyMap,
 	timeout float64) (resource.Status, error) {
 	contract.Assert(urn != "")
 	contract.Assert(id != "")
@@ -1122,7 +1939,7 @@ func (p *provider) Delete(urn resource.URN, id resource.ID, props resource.Prope
 	}
 
 	// Get the RPC client and ensure it's configured.
-	client, err := p.getClient()
+	client, err := p.getClient(ctx)
 	if err != nil {
 		return resource.StatusOK, err
 	}
@@ -1130,7 +1947,7 @@ func (p *provider) Delete(urn resource.URN, id resource.ID, props resource.Prope
 	// We should only be calling {Create,Update,Delete} if the provider is fully configured.
 	contract.Assert(p.cfgknown)
 
-	if _, err := client.Delete(p.requestContext(), &pulumirpc.DeleteRequest{
+	if _, err := client.Delete(p.requestContext(ctx), &pulumirpc.DeleteRequest{
 		Id:         string(id),
 		Urn:        string(urn),
 		Properties: mprops,
@@ -1138,7 +1955,7 @@ func (p *provider) Delete(urn resource.URN, id resource.ID, props resource.Prope
 	}); err != nil {
 		resourceStatus, rpcErr := resourceStateAndError(err)
 		logging.V(7).Infof("%s failed: %v", label, rpcErr)
-		return resourceStatus, rpcErr
+		return resourceStatus, contextError(ctx, rpcErr)
 	}
 
 	logging.V(7).Infof("%s success", label)
@@ -1147,8 +1964,8 @@ func (p *provider) Delete(urn resource.URN, id resource.ID, props resource.Prope
 
 // Construct creates a new component resource from the given type, name, parent, options, and inputs, and returns
 // its URN and outputs.
-func (p *provider) Construct(info ConstructInfo, typ tokens.Type, name tokens.QName, parent resource.URN,
-	inputs resource.PropertyMap, options ConstructOptions) (ConstructResult, error) {
+func (p *provider) Construct(ctx context.Context, info ConstructInfo, typ tokens.Type, name tokens.QName,
+	parent resource.URN, inputs resource.PropertyMap, options ConstructOptions) (ConstructResult, error) {
 
 	contract.Assert(typ != "")
 	contract.Assert(name != "")
@@ -1158,7 +1975,7 @@ func (p *provider) Construct(info ConstructInfo, typ tokens.Type, name tokens.QN
 	logging.V(7).Infof("%s executing (#inputs=%v)", label, len(inputs))
 
 	// Get the RPC client and ensure it's configured.
-	client, err := p.getClient()
+	client, err := p.getClient(ctx)
 	if err != nil {
 		return ConstructResult{}, err
 	}
@@ -1170,6 +1987,9 @@ func (p *provider) Construct(info ConstructInfo, typ tokens.Type, name tokens.QN
 		return ConstructResult{}, fmt.Errorf("plugins that can construct components must support secrets")
 	}
 
+	// Transforms cannot be sent to the plugin, so apply them to the inputs here.
+	inputs = ApplyResourceTransforms(options.Transforms, inputs)
+
 	// Marshal the input properties.
 	minputs, err := MarshalProperties(inputs, MarshalOptions{
 		Label:         fmt.Sprintf("%s.inputs", label),
@@ -1216,7 +2036,7 @@ func (p *provider) Construct(info ConstructInfo, typ tokens.Type, name tokens.QN
 		configSecretKeys = append(configSecretKeys, k.String())
 	}
 
-	resp, err := client.Construct(p.requestContext(), &pulumirpc.ConstructRequest{
+	resp, err := client.Construct(p.requestContext(ctx), &pulumirpc.ConstructRequest{
 		Project:           info.Project,
 		Stack:             info.Stack,
 		Config:            config,
@@ -1233,9 +2053,16 @@ func (p *provider) Construct(info ConstructInfo, typ tokens.Type, name tokens.QN
 		InputDependencies: inputDependencies,
 		Aliases:           aliasURNs,
 		Dependencies:      dependencies,
+		CustomTimeouts:    marshalOperationTimeouts(options.Timeouts),
+		IgnoreChanges:     options.IgnoreChanges,
+		SdkVersion:        info.SDKVersion,
+		EngineVersion:     info.EngineVersion,
+		TraceId:           info.TraceID,
+		SpanId:            info.SpanID,
+		Namespace:         info.Namespace,
 	})
 	if err != nil {
-		return ConstructResult{}, err
+		return ConstructResult{}, contextError(ctx, err)
 	}
 
 	outputs, err := UnmarshalProperties(resp.GetState(), MarshalOptions{
@@ -1248,33 +2075,85 @@ func (p *provider) Construct(info ConstructInfo, typ tokens.Type, name tokens.QN
 		return ConstructResult{}, err
 	}
 
-	outputDependencies := map[resource.PropertyKey][]resource.URN{}
-	for k, rpcDeps := range resp.GetStateDependencies() {
-		urns := make([]resource.URN, len(rpcDeps.Urns))
-		for i, d := range rpcDeps.Urns {
-			urns[i] = resource.URN(d)
+	outputDependencies := unmarshalConstructDependencies(resp.GetStateDependencies())
+
+	// Providers that do not normalize their inputs leave them unset, in which case the result carries no inputs.
+	var normalizedInputs resource.PropertyMap
+	if resp.GetInputs() != nil {
+		normalizedInputs, err = UnmarshalProperties(resp.GetInputs(), MarshalOptions{
+			Label:         fmt.Sprintf("%s.inputs", label),
+			KeepUnknowns:  info.DryRun,
+			KeepSecrets:   true,
+			KeepResources: true,
+		})
+		if err != nil {
+			return ConstructResult{}, err
 		}
-		outputDependencies[resource.PropertyKey(k)] = urns
 	}
+	normalizedInputDependencies := unmarshalConstructDependencies(resp.GetInputDependencies())
 
-	logging.V(7).Infof("%s success: #outputs=%d", label, len(outputs))
+	var childResources []resource.URN
+	for _, child := range resp.GetChildResources() {
+		childResources = append(childResources, resource.URN(child))
+	}
+
+	logging.V(7).Infof("%s success: #outputs=%d, #inputs=%d", label, len(outputs), len(normalizedInputs))
 	return ConstructResult{
 		URN:                resource.URN(resp.GetUrn()),
 		Outputs:            outputs,
 		OutputDependencies: outputDependencies,
+		Inputs:             normalizedInputs,
+		InputDependencies:  normalizedInputDependencies,
+		ChildResources:     childResources,
 	}, nil
 }
 
+// unmarshalConstructDependencies converts the property dependencies returned by Construct into a map from property
+// keys to URNs.
+func unmarshalConstructDependencies(
+	deps map[string]*pulumirpc.ConstructResponse_PropertyDependencies) map[resource.PropertyKey][]resource.URN {
+
+	result := map[resource.PropertyKey][]resource.URN{}
+	for k, rpcDeps := range deps {
+		urns := make([]resource.URN, len(rpcDeps.Urns))
+		for i, d := range rpcDeps.Urns {
+			urns[i] = resource.URN(d)
+		}
+		result[resource.PropertyKey(k)] = urns
+	}
+	return result
+}
+
+// marshalOperationTimeouts converts the given timeouts into the duration strings sent with a Construct request. If no
+// timeouts are set, nil is returned.
+func marshalOperationTimeouts(timeouts OperationTimeouts) *pulumirpc.ConstructRequest_CustomTimeouts {
+	if timeouts.IsZero() {
+		return nil
+	}
+
+	format := func(seconds float64) string {
+		if seconds == 0 {
+			return ""
+		}
+		return time.Duration(seconds * float64(time.Second)).String()
+	}
+	return &pulumirpc.ConstructRequest_CustomTimeouts{
+		Create: format(timeouts.Create),
+		Update: format(timeouts.Update),
+		Delete: format(timeouts.Delete),
+	}
+}
+
 // Invoke dynamically executes a built-in function in the provider.
-func (p *provider) Invoke(tok tokens.ModuleMember, args resource.PropertyMap) (resource.PropertyMap,
-	[]CheckFailure, error) {
+func (p *provider) Invoke(ctx context.Context, tok tokens.ModuleMember,
+	args resource.PropertyMap) (resource.PropertyMap, []CheckFailure, error) {
 	contract.Assert(tok != "")
 
 	label := fmt.Sprintf("%s.Invoke(%s)", p.label(), tok)
 	logging.V(7).Infof("%s executing (#args=%d)", label, len(args))
 
 	// Get the RPC client and ensure it's configured.
-	client, err := p.getClient()
+	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1293,14 +2172,14 @@ func (p *provider) Invoke(tok tokens.ModuleMember, args resource.PropertyMap) (r
 		return nil, nil, err
 	}
 
-	resp, err := client.Invoke(p.requestContext(), &pulumirpc.InvokeRequest{
+	resp, err := client.Invoke(p.requestContext(ctx), &pulumirpc.InvokeRequest{
 		Tok:  string(tok),
 		Args: margs,
 	})
 	if err != nil {
 		rpcError := rpcerror.Convert(err)
 		logging.V(7).Infof("%s failed: %v", label, rpcError.Message())
-		return nil, nil, rpcError
+		return nil, nil, contextError(ctx, rpcError)
 	}
 
 	// Unmarshal any return values.
@@ -1315,10 +2194,7 @@ func (p *provider) Invoke(tok tokens.ModuleMember, args resource.PropertyMap) (r
 	}
 
 	// And now any properties that failed verification.
-	var failures []CheckFailure
-	for _, failure := range resp.GetFailures() {
-		failures = append(failures, CheckFailure{resource.PropertyKey(failure.Property), failure.Reason})
-	}
+	failures := UnmarshalCheckFailures(resp.GetFailures())
 
 	logging.V(7).Infof("%s success (#ret=%d,#failures=%d) success", label, len(ret), len(failures))
 	return ret, failures, nil
@@ -1327,9 +2203,10 @@ func (p *provider) Invoke(tok tokens.ModuleMember, args resource.PropertyMap) (r
 // StreamInvoke dynamically executes a built-in function in the provider, which returns a stream of
 // responses.
 func (p *provider) StreamInvoke(
+	ctx context.Context,
 	tok tokens.ModuleMember,
 	args resource.PropertyMap,
-	onNext func(resource.PropertyMap) error) ([]CheckFailure, error) {
+	onNext func(StreamInvokeEvent) error) ([]CheckFailure, error) {
 
 	contract.Assert(tok != "")
 
@@ -1337,14 +2214,14 @@ func (p *provider) StreamInvoke(
 	logging.V(7).Infof("%s executing (#args=%d)", label, len(args))
 
 	// Get the RPC client and ensure it's configured.
-	client, err := p.getClient()
+	client, err := p.getClient(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	// If the provider is not fully configured, return an empty property map.
 	if !p.cfgknown {
-		return nil, onNext(resource.PropertyMap{})
+		return nil, onNext(NewStreamInvokeEvent(resource.PropertyMap{}))
 	}
 
 	margs, err := MarshalProperties(args, MarshalOptions{
@@ -1357,14 +2234,14 @@ func (p *provider) StreamInvoke(
 	}
 
 	streamClient, err := client.StreamInvoke(
-		p.requestContext(), &pulumirpc.InvokeRequest{
+		p.requestContext(ctx), &pulumirpc.InvokeRequest{
 			Tok:  string(tok),
 			Args: margs,
 		})
 	if err != nil {
 		rpcError := rpcerror.Convert(err)
 		logging.V(7).Infof("%s failed: %v", label, rpcError.Message())
-		return nil, rpcError
+		return nil, contextError(ctx, rpcError)
 	}
 
 	for {
@@ -1373,7 +2250,7 @@ func (p *provider) StreamInvoke(
 			return nil, nil
 		}
 		if err != nil {
-			return nil, err
+			return nil, contextError(ctx, err)
 		}
 
 		// Unmarshal response.
@@ -1388,24 +2265,26 @@ func (p *provider) StreamInvoke(
 		}
 
 		// Check properties that failed verification.
-		var failures []CheckFailure
-		for _, failure := range in.GetFailures() {
-			failures = append(failures, CheckFailure{resource.PropertyKey(failure.Property), failure.Reason})
-		}
+		failures := UnmarshalCheckFailures(in.GetFailures())
 
 		if len(failures) > 0 {
 			return failures, nil
 		}
 
-		// Send stream message back to whoever is consuming the stream.
-		if err := onNext(ret); err != nil {
+		// Send stream message back to whoever is consuming the stream. Responses from providers that do not paginate
+		// their results carry no page number.
+		event := NewStreamInvokeEvent(ret)
+		if page := in.GetPageNumber(); page != 0 {
+			event.PageNumber, event.IsLastPage = int(page), in.GetIsLastPage()
+		}
+		if err := onNext(event); err != nil {
 			return nil, err
 		}
 	}
 }
 
 // Call dynamically executes a method in the provider associated with a component resource.
-func (p *provider) Call(tok tokens.ModuleMember, args resource.PropertyMap, info CallInfo,
+func (p *provider) Call(ctx context.Context, tok tokens.ModuleMember, args resource.PropertyMap, info CallInfo,
 	options CallOptions) (CallResult, error) {
 	contract.Assert(tok != "")
 
@@ -1413,7 +2292,7 @@ func (p *provider) Call(tok tokens.ModuleMember, args resource.PropertyMap, info
 	logging.V(7).Infof("%s executing (#args=%d)", label, len(args))
 
 	// Get the RPC client and ensure it's configured.
-	client, err := p.getClient()
+	client, err := p.getClient(ctx)
 	if err != nil {
 		return CallResult{}, err
 	}
@@ -1452,7 +2331,7 @@ func (p *provider) Call(tok tokens.ModuleMember, args resource.PropertyMap, info
 		config[k.String()] = v
 	}
 
-	resp, err := client.Call(p.requestContext(), &pulumirpc.CallRequest{
+	resp, err := client.Call(p.requestContext(ctx), &pulumirpc.CallRequest{
 		Tok:             string(tok),
 		Args:            margs,
 		ArgDependencies: argDependencies,
@@ -1462,11 +2341,14 @@ func (p *provider) Call(tok tokens.ModuleMember, args resource.PropertyMap, info
 		DryRun:          info.DryRun,
 		Parallel:        int32(info.Parallel),
 		MonitorEndpoint: info.MonitorAddress,
+		Timeout:         options.Timeout,
+		TraceId:         info.TraceID,
+		SpanId:          info.SpanID,
 	})
 	if err != nil {
 		rpcError := rpcerror.Convert(err)
 		logging.V(7).Infof("%s failed: %v", label, rpcError.Message())
-		return CallResult{}, rpcError
+		return CallResult{}, contextError(ctx, rpcError)
 	}
 
 	// Unmarshal any return values.
@@ -1489,28 +2371,38 @@ func (p *provider) Call(tok tokens.ModuleMember, args resource.PropertyMap, info
 		returnDependencies[resource.PropertyKey(k)] = urns
 	}
 
-	// And now any properties that failed verification.
-	var failures []CheckFailure
-	for _, failure := range resp.GetFailures() {
-		failures = append(failures, CheckFailure{resource.PropertyKey(failure.Property), failure.Reason})
+	// And now any properties that failed verification, and anything else the provider had to say about the call.
+	failures := UnmarshalCheckFailures(resp.GetFailures())
+	diags := UnmarshalProviderDiagnostics(resp.GetDiagnostics())
+
+	// If the call only partially succeeded, return the values it computed along with the reason it failed.
+	if resp.GetPartialFailure() {
+		logging.V(7).Infof("%s partially failed (#ret=%d): %v", label, len(ret), resp.GetPartialFailureReason())
+		return CallResult{
+			Return:             ret,
+			ReturnDependencies: returnDependencies,
+			Failures:           failures,
+			Diagnostics:        diags,
+			Status:             resource.StatusPartialFailure,
+		}, errors.New(resp.GetPartialFailureReason())
 	}
 
-	logging.V(7).Infof("%s success (#ret=%d,#failures=%d) success", label, len(ret), len(failures))
-	return CallResult{Return: ret, ReturnDependencies: returnDependencies, Failures: failures}, nil
+	logging.V(7).Infof("%s success (#ret=%d,#failures=%d,#diags=%d) success", label, len(ret), len(failures), len(diags))
+	return CallResult{Return: ret, ReturnDependencies: returnDependencies, Failures: failures, Diagnostics: diags}, nil
 }
 
 // GetPluginInfo returns this plugin's information.
-func (p *provider) GetPluginInfo() (workspace.PluginInfo, error) {
+func (p *provider) GetPluginInfo(ctx context.Context) (workspace.PluginInfo, error) {
 	label := fmt.Sprintf("%s.GetPluginInfo()", p.label())
 	logging.V(7).Infof("%s executing", label)
 
 	// Calling GetPluginInfo happens immediately after loading, and does not require configuration to proceed.
 	// Thus, we access the clientRaw property, rather than calling getClient.
-	resp, err := p.clientRaw.GetPluginInfo(p.requestContext(), &pbempty.Empty{})
+	resp, err := p.clientRaw.GetPluginInfo(p.requestContext(ctx), &pbempty.Empty{})
 	if err != nil {
 		rpcError := rpcerror.Convert(err)
 		logging.V(7).Infof("%s failed: err=%v", label, rpcError.Message())
-		return workspace.PluginInfo{}, rpcError
+		return workspace.PluginInfo{}, contextError(ctx, rpcError)
 	}
 
 	var version *semver.Version
@@ -1536,24 +2428,178 @@ func (p *provider) GetPluginInfo() (workspace.PluginInfo, error) {
 }
 
 // Attach attaches this plugin to the engine
-func (p *provider) Attach(address string) error {
+func (p *provider) Attach(ctx context.Context, address string) error {
 	label := fmt.Sprintf("%s.Attach()", p.label())
 	logging.V(7).Infof("%s executing", label)
 
 	// Calling Attach happens immediately after loading, and does not require configuration to proceed.
 	// Thus, we access the clientRaw property, rather than calling getClient.
-	_, err := p.clientRaw.Attach(p.requestContext(), &pulumirpc.PluginAttach{Address: address})
+	_, err := p.clientRaw.Attach(p.requestContext(ctx), &pulumirpc.PluginAttach{Address: address})
 	if err != nil {
 		rpcError := rpcerror.Convert(err)
 		logging.V(7).Infof("%s failed: err=%v", label, rpcError.Message())
-		return rpcError
+		return contextError(ctx, rpcError)
 	}
 
 	return nil
 }
 
-func (p *provider) SignalCancellation() error {
-	_, err := p.clientRaw.Cancel(p.requestContext(), &pbempty.Empty{})
+// SetLogSink redirects the provider plugin's stderr to the given writer. It has no effect for providers that the engine
+// attached to rather than launched, as their output is not captured.
+func (p *provider) SetLogSink(sink io.Writer) {
+	if p.plug != nil {
+		p.plug.setLogSink(sink)
+	}
+}
+
+// GetMapping fetches the conversion mapping (if any) for this resource provider.
+func (p *provider) GetMapping(ctx context.Context, key string) ([]byte, string, error) {
+	label := fmt.Sprintf("%s.GetMapping", p.label())
+	logging.V(7).Infof("%s executing: key=%s", label, key)
+
+	// Fetching a mapping does not require configuration, so we access the clientRaw property rather than calling
+	// getClient.
+	resp, err := p.clientRaw.GetMapping(p.requestContext(ctx), &pulumirpc.GetMappingRequest{
+		Key: key,
+	})
+	if err != nil {
+		rpcError := rpcerror.Convert(err)
+		code := rpcError.Code()
+		if code == codes.Unimplemented {
+			// For backwards compatibility, just return nothing as if the provider didn't have a mapping.
+			logging.V(7).Infof("%s unimplemented rpc: returning empty mapping", label)
+			return nil, "", nil
+		}
+		logging.V(7).Infof("%s failed: err=%v", label, rpcError.Message())
+		return nil, "", contextError(ctx, rpcError)
+	}
+
+	logging.V(7).Infof("%s success: data=#%d provider=%s", label, len(resp.Data), resp.Provider)
+	return resp.Data, resp.Provider, nil
+}
+
+// SupportsFeature returns true if the provider supports the given feature. Providers that predate SupportsFeature are
+// treated as not supporting any features.
+func (p *provider) SupportsFeature(ctx context.Context, feature string) (bool, error) {
+	label := fmt.Sprintf("%s.SupportsFeature", p.label())
+	logging.V(7).Infof("%s executing: feature=%s", label, feature)
+
+	// Feature negotiation does not require configuration, so we access the clientRaw property rather than calling
+	// getClient.
+	resp, err := p.clientRaw.SupportsFeature(p.requestContext(ctx), &pulumirpc.ProviderSupportsFeatureRequest{
+		Id: feature,
+	})
+	if err != nil {
+		rpcError := rpcerror.Convert(err)
+		if rpcError.Code() == codes.Unimplemented {
+			logging.V(7).Infof("%s unimplemented rpc: feature is not supported", label)
+			return false, nil
+		}
+		logging.V(7).Infof("%s failed: err=%v", label, rpcError.Message())
+		return false, contextError(ctx, rpcError)
+	}
+
+	logging.V(7).Infof("%s success: hasSupport=%v", label, resp.GetHasSupport())
+	return resp.GetHasSupport(), nil
+}
+
+// RegisterEventEmitter opens a WatchResourceChanges stream to the provider and passes each event that it receives to
+// the given emitter. The stream is watched in the background until the provider closes it or the plugin exits, so
+// providers that do not implement the RPC are only detected, and ignored, once the stream fails.
+func (p *provider) RegisterEventEmitter(e EventEmitter) error {
+	contract.Requiref(e != nil, "e", "must not be nil")
+
+	label := fmt.Sprintf("%s.WatchResourceChanges()", p.label())
+	logging.V(7).Infof("%s executing", label)
+
+	go func() {
+		// Get the RPC client and ensure it's configured.
+		ctx := p.requestContext(context.Background())
+		client, err := p.getClient(ctx)
+		if err != nil {
+			logging.V(7).Infof("%s failed: %v", label, err)
+			return
+		}
+
+		stream, err := client.WatchResourceChanges(ctx, &pbempty.Empty{})
+		for err == nil {
+			var event *pulumirpc.ResourceChangedEvent
+			if event, err = stream.Recv(); err != nil {
+				break
+			}
+
+			urn, id := resource.URN(event.GetUrn()), resource.ID(event.GetId())
+			logging.V(7).Infof("%s received change to %s (%s)", label, urn, id)
+			if emitErr := e.EmitResourceChanged(urn, id); emitErr != nil {
+				logging.V(7).Infof("%s failed to emit change to %s: %v", label, urn, emitErr)
+			}
+		}
+
+		switch {
+		case err == io.EOF:
+			logging.V(7).Infof("%s success", label)
+		case rpcerror.Convert(err).Code() == codes.Unimplemented:
+			logging.V(7).Infof("%s unimplemented rpc: ignoring", label)
+		default:
+			logging.V(7).Infof("%s failed: %v", label, err)
+		}
+	}()
+	return nil
+}
+
+// WatchConfig opens a WatchConfig stream to the provider and passes the configuration in each event that it receives to
+// onChange. The stream is watched in the background until ctx is done, the provider closes it, or the plugin exits, so
+// providers that do not implement the RPC are only detected, and ignored, once the stream fails.
+func (p *provider) WatchConfig(ctx context.Context, onChange func(resource.PropertyMap)) error {
+	contract.Requiref(onChange != nil, "onChange", "must not be nil")
+
+	label := fmt.Sprintf("%s.WatchConfig()", p.label())
+	logging.V(7).Infof("%s executing", label)
+
+	go func() {
+		// Get the RPC client and ensure it's configured.
+		ctx := p.requestContext(ctx)
+		client, err := p.getClient(ctx)
+		if err != nil {
+			logging.V(7).Infof("%s failed: %v", label, err)
+			return
+		}
+
+		stream, err := client.WatchConfig(ctx, &pbempty.Empty{})
+		for err == nil {
+			var event *pulumirpc.ConfigChangedEvent
+			if event, err = stream.Recv(); err != nil {
+				break
+			}
+
+			changes, unmarshalErr := UnmarshalProperties(event.GetConfig(), MarshalOptions{
+				Label:         fmt.Sprintf("%s.config", label),
+				KeepUnknowns:  true,
+				KeepSecrets:   true,
+				KeepResources: true,
+			})
+			if unmarshalErr != nil {
+				logging.V(7).Infof("%s failed to unmarshal changed configuration: %v", label, unmarshalErr)
+				continue
+			}
+			logging.V(7).Infof("%s received change to configuration (#changes=%d)", label, len(changes))
+			onChange(changes)
+		}
+
+		switch {
+		case err == io.EOF || ctx.Err() != nil:
+			logging.V(7).Infof("%s success", label)
+		case rpcerror.Convert(err).Code() == codes.Unimplemented:
+			logging.V(7).Infof("%s unimplemented rpc: ignoring", label)
+		default:
+			logging.V(7).Infof("%s failed: %v", label, err)
+		}
+	}()
+	return nil
+}
+
+func (p *provider) SignalCancellation(ctx context.Context) error {
+	_, err := p.clientRaw.Cancel(p.requestContext(ctx), &pbempty.Empty{})
 	if err != nil {
 		rpcError := rpcerror.Convert(err)
 		logging.V(8).Infof("provider received rpc error `%s`: `%s`", rpcError.Code(),
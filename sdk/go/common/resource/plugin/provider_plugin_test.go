@@ -1,14 +1,339 @@
 package plugin
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/blang/semver"
+	pbempty "github.com/golang/protobuf/ptypes/empty"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag/colors"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
 )
 
+// stubProviderClient is a ResourceProviderClient that answers Configure successfully and defers the handful of other
+// RPCs these tests care about to optional callbacks. Any other RPC panics on the nil embedded client.
+type stubProviderClient struct {
+	pulumirpc.ResourceProviderClient
+
+	CheckF         func(ctx context.Context, req *pulumirpc.CheckRequest) (*pulumirpc.CheckResponse, error)
+	ConstructF     func(ctx context.Context, req *pulumirpc.ConstructRequest) (*pulumirpc.ConstructResponse, error)
+	CreateF        func(ctx context.Context, req *pulumirpc.CreateRequest) (*pulumirpc.CreateResponse, error)
+	DiffConfigF    func(ctx context.Context, req *pulumirpc.DiffRequest) (*pulumirpc.DiffResponse, error)
+	GetPluginInfoF func(ctx context.Context) (*pulumirpc.PluginInfo, error)
+	GetMappingF    func(ctx context.Context, req *pulumirpc.GetMappingRequest) (*pulumirpc.GetMappingResponse, error)
+	GetSchemaF     func(ctx context.Context, req *pulumirpc.GetSchemaRequest) (*pulumirpc.GetSchemaResponse, error)
+
+	SupportsFeatureF func(ctx context.Context,
+		req *pulumirpc.ProviderSupportsFeatureRequest) (*pulumirpc.ProviderSupportsFeatureResponse, error)
+	ReadF       func(ctx context.Context, req *pulumirpc.ReadRequest) (*pulumirpc.ReadResponse, error)
+	RefreshF    func(ctx context.Context, req *pulumirpc.ReadRequest) (*pulumirpc.ReadResponse, error)
+	ReadStreamF func(ctx context.Context, req *pulumirpc.ReadRequest) ([]*pulumirpc.ReadResponse, error)
+
+	StreamCreateF func(ctx context.Context, req *pulumirpc.CreateRequest) ([]*pulumirpc.CreateResponse, error)
+	StreamInvokeF func(ctx context.Context, req *pulumirpc.InvokeRequest) ([]*pulumirpc.InvokeResponse, error)
+
+	MigrateStateF func(ctx context.Context,
+		req *pulumirpc.MigrateStateRequest) (*pulumirpc.MigrateStateResponse, error)
+
+	WatchResourceChangesF func(ctx context.Context) ([]*pulumirpc.ResourceChangedEvent, error)
+	WatchConfigF          func(ctx context.Context) ([]*pulumirpc.ConfigChangedEvent, error)
+
+	EstimateCostF func(ctx context.Context,
+		req *pulumirpc.EstimateCostRequest) (*pulumirpc.EstimateCostResponse, error)
+
+	GetSupportedVersionsF func(ctx context.Context) (*pulumirpc.GetSupportedVersionsResponse, error)
+	ValidateProviderF     func(ctx context.Context) error
+
+	ParameterizeByValueF func(ctx context.Context,
+		req *pulumirpc.ParameterizeByValueRequest) (*pulumirpc.ParameterizeResponse, error)
+	ParameterizeByReferenceF func(ctx context.Context,
+		req *pulumirpc.ParameterizeByReferenceRequest) (*pulumirpc.ParameterizeResponse, error)
+
+	PrepareImportF func(ctx context.Context,
+		req *pulumirpc.PrepareImportRequest) (*pulumirpc.PrepareImportResponse, error)
+
+	CallF func(ctx context.Context, req *pulumirpc.CallRequest) (*pulumirpc.CallResponse, error)
+
+	ConfigChecksumMatchF func(ctx context.Context,
+		req *pulumirpc.ConfigChecksumMatchRequest) (*pulumirpc.ConfigChecksumMatchResponse, error)
+
+	WaitForResourceReadyF func(ctx context.Context, req *pulumirpc.WaitForResourceReadyRequest) (*pbempty.Empty, error)
+
+	GetResourceAliasesF func(ctx context.Context,
+		req *pulumirpc.GetResourceAliasesRequest) (*pulumirpc.GetResourceAliasesResponse, error)
+
+	// SchemaVersion is the state schema version reported by Configure.
+	SchemaVersion int32
+}
+
+func (c *stubProviderClient) EstimateCost(ctx context.Context, req *pulumirpc.EstimateCostRequest,
+	opts ...grpc.CallOption) (*pulumirpc.EstimateCostResponse, error) {
+	return c.EstimateCostF(ctx, req)
+}
+
+func (c *stubProviderClient) GetSupportedVersions(ctx context.Context, req *pbempty.Empty,
+	opts ...grpc.CallOption) (*pulumirpc.GetSupportedVersionsResponse, error) {
+	return c.GetSupportedVersionsF(ctx)
+}
+
+func (c *stubProviderClient) ParameterizeByValue(ctx context.Context, req *pulumirpc.ParameterizeByValueRequest,
+	opts ...grpc.CallOption) (*pulumirpc.ParameterizeResponse, error) {
+	return c.ParameterizeByValueF(ctx, req)
+}
+
+func (c *stubProviderClient) ParameterizeByReference(ctx context.Context,
+	req *pulumirpc.ParameterizeByReferenceRequest, opts ...grpc.CallOption) (*pulumirpc.ParameterizeResponse, error) {
+	return c.ParameterizeByReferenceF(ctx, req)
+}
+
+func (c *stubProviderClient) PrepareImport(ctx context.Context, req *pulumirpc.PrepareImportRequest,
+	opts ...grpc.CallOption) (*pulumirpc.PrepareImportResponse, error) {
+	return c.PrepareImportF(ctx, req)
+}
+
+func (c *stubProviderClient) Call(ctx context.Context, req *pulumirpc.CallRequest,
+	opts ...grpc.CallOption) (*pulumirpc.CallResponse, error) {
+	return c.CallF(ctx, req)
+}
+
+func (c *stubProviderClient) ConfigChecksumMatch(ctx context.Context, req *pulumirpc.ConfigChecksumMatchRequest,
+	opts ...grpc.CallOption) (*pulumirpc.ConfigChecksumMatchResponse, error) {
+	return c.ConfigChecksumMatchF(ctx, req)
+}
+
+func (c *stubProviderClient) WaitForResourceReady(ctx context.Context, req *pulumirpc.WaitForResourceReadyRequest,
+	opts ...grpc.CallOption) (*pbempty.Empty, error) {
+	return c.WaitForResourceReadyF(ctx, req)
+}
+
+func (c *stubProviderClient) GetResourceAliases(ctx context.Context, req *pulumirpc.GetResourceAliasesRequest,
+	opts ...grpc.CallOption) (*pulumirpc.GetResourceAliasesResponse, error) {
+	return c.GetResourceAliasesF(ctx, req)
+}
+
+func (c *stubProviderClient) MigrateState(ctx context.Context, req *pulumirpc.MigrateStateRequest,
+	opts ...grpc.CallOption) (*pulumirpc.MigrateStateResponse, error) {
+	return c.MigrateStateF(ctx, req)
+}
+
+func (c *stubProviderClient) Read(ctx context.Context, req *pulumirpc.ReadRequest,
+	opts ...grpc.CallOption) (*pulumirpc.ReadResponse, error) {
+	return c.ReadF(ctx, req)
+}
+
+func (c *stubProviderClient) Refresh(ctx context.Context, req *pulumirpc.ReadRequest,
+	opts ...grpc.CallOption) (*pulumirpc.ReadResponse, error) {
+	return c.RefreshF(ctx, req)
+}
+
+// ReadStream returns a stream that yields the responses returned by ReadStreamF followed by its error, if any.
+func (c *stubProviderClient) ReadStream(ctx context.Context, req *pulumirpc.ReadRequest,
+	opts ...grpc.CallOption) (pulumirpc.ResourceProvider_ReadStreamClient, error) {
+	responses, err := c.ReadStreamF(ctx, req)
+	return &stubReadStreamClient{responses: responses, err: err}, nil
+}
+
+type stubReadStreamClient struct {
+	grpc.ClientStream
+
+	responses []*pulumirpc.ReadResponse
+	err       error
+}
+
+func (c *stubReadStreamClient) Recv() (*pulumirpc.ReadResponse, error) {
+	if len(c.responses) == 0 {
+		if c.err != nil {
+			return nil, c.err
+		}
+		return nil, io.EOF
+	}
+	resp := c.responses[0]
+	c.responses = c.responses[1:]
+	return resp, nil
+}
+
+// WatchResourceChanges returns a stream that yields the events returned by WatchResourceChangesF followed by its error,
+// if any.
+func (c *stubProviderClient) WatchResourceChanges(ctx context.Context, req *pbempty.Empty,
+	opts ...grpc.CallOption) (pulumirpc.ResourceProvider_WatchResourceChangesClient, error) {
+	events, err := c.WatchResourceChangesF(ctx)
+	return &stubWatchResourceChangesClient{events: events, err: err}, nil
+}
+
+type stubWatchResourceChangesClient struct {
+	grpc.ClientStream
+
+	events []*pulumirpc.ResourceChangedEvent
+	err    error
+}
+
+func (c *stubWatchResourceChangesClient) Recv() (*pulumirpc.ResourceChangedEvent, error) {
+	if len(c.events) == 0 {
+		if c.err != nil {
+			return nil, c.err
+		}
+		return nil, io.EOF
+	}
+	event := c.events[0]
+	c.events = c.events[1:]
+	return event, nil
+}
+
+// WatchConfig returns a stream that yields the events returned by WatchConfigF followed by its error, if any.
+func (c *stubProviderClient) WatchConfig(ctx context.Context, req *pbempty.Empty,
+	opts ...grpc.CallOption) (pulumirpc.ResourceProvider_WatchConfigClient, error) {
+	events, err := c.WatchConfigF(ctx)
+	return &stubWatchConfigClient{events: events, err: err}, nil
+}
+
+type stubWatchConfigClient struct {
+	grpc.ClientStream
+
+	events []*pulumirpc.ConfigChangedEvent
+	err    error
+}
+
+func (c *stubWatchConfigClient) Recv() (*pulumirpc.ConfigChangedEvent, error) {
+	if len(c.events) == 0 {
+		if c.err != nil {
+			return nil, c.err
+		}
+		return nil, io.EOF
+	}
+	event := c.events[0]
+	c.events = c.events[1:]
+	return event, nil
+}
+
+// StreamCreate returns a stream that yields the responses returned by StreamCreateF followed by its error, if any.
+func (c *stubProviderClient) StreamCreate(ctx context.Context, req *pulumirpc.CreateRequest,
+	opts ...grpc.CallOption) (pulumirpc.ResourceProvider_StreamCreateClient, error) {
+	responses, err := c.StreamCreateF(ctx, req)
+	return &stubStreamCreateClient{responses: responses, err: err}, nil
+}
+
+type stubStreamCreateClient struct {
+	grpc.ClientStream
+
+	responses []*pulumirpc.CreateResponse
+	err       error
+}
+
+func (c *stubStreamCreateClient) Recv() (*pulumirpc.CreateResponse, error) {
+	if len(c.responses) == 0 {
+		if c.err != nil {
+			return nil, c.err
+		}
+		return nil, io.EOF
+	}
+	resp := c.responses[0]
+	c.responses = c.responses[1:]
+	return resp, nil
+}
+
+// StreamInvoke returns a stream that yields the responses returned by StreamInvokeF followed by its error, if any.
+func (c *stubProviderClient) StreamInvoke(ctx context.Context, req *pulumirpc.InvokeRequest,
+	opts ...grpc.CallOption) (pulumirpc.ResourceProvider_StreamInvokeClient, error) {
+	responses, err := c.StreamInvokeF(ctx, req)
+	return &stubStreamInvokeClient{responses: responses, err: err}, nil
+}
+
+type stubStreamInvokeClient struct {
+	grpc.ClientStream
+
+	responses []*pulumirpc.InvokeResponse
+	err       error
+}
+
+func (c *stubStreamInvokeClient) Recv() (*pulumirpc.InvokeResponse, error) {
+	if len(c.responses) == 0 {
+		if c.err != nil {
+			return nil, c.err
+		}
+		return nil, io.EOF
+	}
+	resp := c.responses[0]
+	c.responses = c.responses[1:]
+	return resp, nil
+}
+
+func (c *stubProviderClient) SupportsFeature(ctx context.Context, req *pulumirpc.ProviderSupportsFeatureRequest,
+	opts ...grpc.CallOption) (*pulumirpc.ProviderSupportsFeatureResponse, error) {
+	// Configure asks about optional features, so this is unimplemented by default rather than panicking.
+	if c.SupportsFeatureF == nil {
+		return nil, status.Error(codes.Unimplemented, "SupportsFeature is not yet implemented")
+	}
+	return c.SupportsFeatureF(ctx, req)
+}
+
+func (c *stubProviderClient) GetSchema(ctx context.Context, req *pulumirpc.GetSchemaRequest,
+	opts ...grpc.CallOption) (*pulumirpc.GetSchemaResponse, error) {
+	return c.GetSchemaF(ctx, req)
+}
+
+func (c *stubProviderClient) Configure(ctx context.Context, req *pulumirpc.ConfigureRequest,
+	opts ...grpc.CallOption) (*pulumirpc.ConfigureResponse, error) {
+	return &pulumirpc.ConfigureResponse{AcceptSecrets: true, AcceptResources: true, SchemaVersion: c.SchemaVersion}, nil
+}
+
+func (c *stubProviderClient) Check(ctx context.Context, req *pulumirpc.CheckRequest,
+	opts ...grpc.CallOption) (*pulumirpc.CheckResponse, error) {
+	return c.CheckF(ctx, req)
+}
+
+func (c *stubProviderClient) DiffConfig(ctx context.Context, req *pulumirpc.DiffRequest,
+	opts ...grpc.CallOption) (*pulumirpc.DiffResponse, error) {
+	return c.DiffConfigF(ctx, req)
+}
+
+func (c *stubProviderClient) Construct(ctx context.Context, req *pulumirpc.ConstructRequest,
+	opts ...grpc.CallOption) (*pulumirpc.ConstructResponse, error) {
+	return c.ConstructF(ctx, req)
+}
+
+func (c *stubProviderClient) Create(ctx context.Context, req *pulumirpc.CreateRequest,
+	opts ...grpc.CallOption) (*pulumirpc.CreateResponse, error) {
+	return c.CreateF(ctx, req)
+}
+
+func (c *stubProviderClient) GetPluginInfo(ctx context.Context, req *pbempty.Empty,
+	opts ...grpc.CallOption) (*pulumirpc.PluginInfo, error) {
+	return c.GetPluginInfoF(ctx)
+}
+
+// ValidateProvider calls ValidateProviderF, or reports the RPC as unimplemented if it is not set.
+func (c *stubProviderClient) ValidateProvider(ctx context.Context, req *pbempty.Empty,
+	opts ...grpc.CallOption) (*pbempty.Empty, error) {
+	if c.ValidateProviderF == nil {
+		return nil, status.Error(codes.Unimplemented, "ValidateProvider is not yet implemented")
+	}
+	if err := c.ValidateProviderF(ctx); err != nil {
+		return nil, err
+	}
+	return &pbempty.Empty{}, nil
+}
+
+func (c *stubProviderClient) GetMapping(ctx context.Context, req *pulumirpc.GetMappingRequest,
+	opts ...grpc.CallOption) (*pulumirpc.GetMappingResponse, error) {
+	return c.GetMappingF(ctx, req)
+}
+
 func TestAnnotateSecrets(t *testing.T) {
 	t.Parallel()
 
@@ -225,3 +550,1518 @@ func TestNestedSecret(t *testing.T) {
 
 	assert.Truef(t, reflect.DeepEqual(to, expected), "did not match expected after annotation")
 }
+
+func TestProviderCreateRespectsContextDeadline(t *testing.T) {
+	t.Parallel()
+
+	client := &stubProviderClient{
+		CreateF: func(ctx context.Context, req *pulumirpc.CreateRequest) (*pulumirpc.CreateResponse, error) {
+			<-ctx.Done()
+			return nil, status.FromContextError(ctx.Err()).Err()
+		},
+	}
+	prov := NewProviderWithClient(nil, "test", client, false)
+	require.NoError(t, prov.Configure(context.Background(), NewProviderConfigFromMap(resource.PropertyMap{})))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, _, _, err := prov.Create(ctx, "urn:pulumi:stack::project::test:index:res::name", resource.PropertyMap{}, 0, false)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestProviderCheckCanceledBeforeConfigure(t *testing.T) {
+	t.Parallel()
+
+	// The provider is never configured, so Check would block forever without a cancelable context.
+	prov := NewProviderWithClient(nil, "test", &stubProviderClient{}, false)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, _, err := prov.Check(ctx, "urn:pulumi:stack::project::test:index:res::name",
+		resource.PropertyMap{}, resource.PropertyMap{}, false, nil)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestProviderValidateChecksLiveness(t *testing.T) {
+	t.Parallel()
+
+	alive := true
+	client := &stubProviderClient{
+		GetPluginInfoF: func(ctx context.Context) (*pulumirpc.PluginInfo, error) {
+			if !alive {
+				return nil, status.Error(codes.Unavailable, "connection refused")
+			}
+			return &pulumirpc.PluginInfo{Version: "1.0.0"}, nil
+		},
+	}
+	prov := NewProviderWithClient(nil, "test", client, false)
+	require.NoError(t, prov.Configure(context.Background(), NewProviderConfigFromMap(resource.PropertyMap{})))
+
+	assert.NoError(t, prov.Validate(context.Background()))
+
+	alive = false
+	err := prov.Validate(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "connection refused")
+}
+
+func TestProviderValidateProvider(t *testing.T) {
+	t.Parallel()
+
+	var validateErr error
+	client := &stubProviderClient{
+		ValidateProviderF: func(ctx context.Context) error { return validateErr },
+		GetPluginInfoF: func(ctx context.Context) (*pulumirpc.PluginInfo, error) {
+			t.Fatal("GetPluginInfo must not be called by providers that implement ValidateProvider")
+			return nil, nil
+		},
+	}
+	prov := NewProviderWithClient(nil, "test", client, false)
+
+	// Validate waits for configuration to finish before running the provider's checks.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, prov.Validate(ctx), context.Canceled)
+
+	require.NoError(t, prov.Configure(context.Background(), NewProviderConfigFromMap(resource.PropertyMap{})))
+	assert.NoError(t, prov.Validate(context.Background()))
+
+	validateErr = status.Error(codes.PermissionDenied, "invalid credentials")
+	err := prov.Validate(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid credentials")
+}
+
+func TestProviderCheckFailureSeverity(t *testing.T) {
+	t.Parallel()
+
+	client := &stubProviderClient{
+		CheckF: func(ctx context.Context, req *pulumirpc.CheckRequest) (*pulumirpc.CheckResponse, error) {
+			return &pulumirpc.CheckResponse{
+				Inputs: req.GetNews(),
+				Failures: []*pulumirpc.CheckFailure{
+					{Property: "a", Reason: "a is invalid"},
+					{Property: "b", Reason: "b will be corrected", Severity: pulumirpc.CheckFailure_WARNING, Code: "fixup"},
+				},
+			}, nil
+		},
+	}
+	prov := NewProviderWithClient(nil, "test", client, false)
+	require.NoError(t, prov.Configure(context.Background(), NewProviderConfigFromMap(resource.PropertyMap{})))
+
+	_, failures, err := prov.Check(context.Background(), "urn:pulumi:stack::project::test:index:res::name",
+		resource.PropertyMap{}, resource.PropertyMap{}, false, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []CheckFailure{
+		{Property: "a", Reason: "a is invalid", Severity: CheckSeverityError},
+		{Property: "b", Reason: "b will be corrected", Severity: CheckSeverityWarning, Code: "fixup"},
+	}, failures)
+	assert.True(t, HasCheckErrors(failures))
+	assert.False(t, HasCheckErrors(failures[1:]))
+
+	// Round-tripping through the gRPC representation preserves the severity and code.
+	assert.Equal(t, failures, UnmarshalCheckFailures(MarshalCheckFailures(failures)))
+}
+
+func TestCheckFailureSourceRange(t *testing.T) {
+	t.Parallel()
+
+	failures := []CheckFailure{
+		{Property: "a", Reason: "a is invalid", Range: &SourceRange{File: "index.ts", Line: 12, Column: 5}},
+		{Property: "b", Reason: "b is invalid"},
+	}
+
+	rpcFailures := MarshalCheckFailures(failures)
+	assert.Equal(t, "index.ts", rpcFailures[0].GetRange().GetFile())
+	assert.Equal(t, int32(12), rpcFailures[0].GetRange().GetLine())
+	assert.Equal(t, int32(5), rpcFailures[0].GetRange().GetColumn())
+	assert.Nil(t, rpcFailures[1].GetRange())
+
+	assert.Equal(t, failures, UnmarshalCheckFailures(rpcFailures))
+}
+
+func TestCheckSeverityUnknown(t *testing.T) {
+	t.Parallel()
+
+	// Severities added by newer providers are treated as errors, and printing them does not panic.
+	failures := UnmarshalCheckFailures([]*pulumirpc.CheckFailure{
+		{Property: "a", Reason: "a is invalid", Severity: pulumirpc.CheckFailure_Severity(7)},
+	})
+	assert.Equal(t, CheckSeverityError, failures[0].Severity)
+	assert.Equal(t, "CheckSeverity(7)", CheckSeverity(7).String())
+}
+
+func TestProviderGetMapping(t *testing.T) {
+	t.Parallel()
+
+	client := &stubProviderClient{
+		GetMappingF: func(ctx context.Context, req *pulumirpc.GetMappingRequest) (*pulumirpc.GetMappingResponse, error) {
+			if req.GetKey() != "terraform" {
+				return &pulumirpc.GetMappingResponse{}, nil
+			}
+			return &pulumirpc.GetMappingResponse{Provider: "random", Data: []byte(`{"resources":{}}`)}, nil
+		},
+	}
+	prov := NewProviderWithClient(nil, "random", client, false)
+
+	data, provider, err := prov.GetMapping(context.Background(), "terraform")
+	require.NoError(t, err)
+	assert.Equal(t, "random", provider)
+	assert.Equal(t, []byte(`{"resources":{}}`), data)
+
+	data, provider, err = prov.GetMapping(context.Background(), "unknown")
+	require.NoError(t, err)
+	assert.Empty(t, provider)
+	assert.Empty(t, data)
+}
+
+func TestProviderGetMappingUnimplemented(t *testing.T) {
+	t.Parallel()
+
+	client := &stubProviderClient{
+		GetMappingF: func(ctx context.Context, req *pulumirpc.GetMappingRequest) (*pulumirpc.GetMappingResponse, error) {
+			return nil, status.Error(codes.Unimplemented, "GetMapping is not yet implemented")
+		},
+	}
+	prov := NewProviderWithClient(nil, "random", client, false)
+
+	// Providers that predate GetMapping are treated as having no mappings.
+	data, provider, err := prov.GetMapping(context.Background(), "terraform")
+	require.NoError(t, err)
+	assert.Empty(t, provider)
+	assert.Nil(t, data)
+}
+
+func TestOperationTimeoutsRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	// Unset timeouts are not sent at all.
+	assert.Nil(t, marshalOperationTimeouts(OperationTimeouts{}))
+
+	timeouts := OperationTimeouts{Create: 90, Delete: 0.5}
+	rpcTimeouts := marshalOperationTimeouts(timeouts)
+	require.NotNil(t, rpcTimeouts)
+	assert.Equal(t, "1m30s", rpcTimeouts.GetCreate())
+	assert.Empty(t, rpcTimeouts.GetUpdate())
+	assert.Equal(t, "500ms", rpcTimeouts.GetDelete())
+
+	actual, err := unmarshalOperationTimeouts(rpcTimeouts)
+	require.NoError(t, err)
+	assert.Equal(t, timeouts, actual)
+
+	_, err = unmarshalOperationTimeouts(&pulumirpc.ConstructRequest_CustomTimeouts{Update: "soon"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid update timeout")
+}
+
+func TestProviderGetSchemaChecksum(t *testing.T) {
+	t.Parallel()
+
+	const schema = `{"name":"random"}`
+
+	// Providers that send a checksum have it passed through unchanged.
+	client := &stubProviderClient{
+		GetSchemaF: func(ctx context.Context, req *pulumirpc.GetSchemaRequest) (*pulumirpc.GetSchemaResponse, error) {
+			return &pulumirpc.GetSchemaResponse{Schema: schema, Checksum: "abc123"}, nil
+		},
+	}
+	prov := NewProviderWithClient(nil, "random", client, false)
+	resp, err := prov.GetSchema(context.Background(), 0)
+	require.NoError(t, err)
+	assert.Equal(t, []byte(schema), resp.Bytes)
+	assert.Equal(t, "abc123", resp.Checksum)
+
+	// Older providers do not send a checksum, so it is computed from the schema.
+	client.GetSchemaF = func(ctx context.Context, req *pulumirpc.GetSchemaRequest) (*pulumirpc.GetSchemaResponse, error) {
+		return &pulumirpc.GetSchemaResponse{Schema: schema}, nil
+	}
+	resp, err = prov.GetSchema(context.Background(), 0)
+	require.NoError(t, err)
+	assert.Equal(t, []byte(schema), resp.Bytes)
+	assert.Equal(t, SchemaChecksum([]byte(schema)), resp.Checksum)
+	assert.Len(t, resp.Checksum, 64)
+}
+
+func TestProviderSupportsFeature(t *testing.T) {
+	t.Parallel()
+
+	client := &stubProviderClient{
+		SupportsFeatureF: func(ctx context.Context,
+			req *pulumirpc.ProviderSupportsFeatureRequest) (*pulumirpc.ProviderSupportsFeatureResponse, error) {
+			return &pulumirpc.ProviderSupportsFeatureResponse{HasSupport: req.GetId() == FeatureBatchRead}, nil
+		},
+	}
+	prov := NewProviderWithClient(nil, "test", client, false)
+
+	has, err := prov.SupportsFeature(context.Background(), FeatureBatchRead)
+	require.NoError(t, err)
+	assert.True(t, has)
+
+	has, err = prov.SupportsFeature(context.Background(), FeatureDetailedDiff)
+	require.NoError(t, err)
+	assert.False(t, has)
+}
+
+func TestProviderSupportsFeatureUnimplemented(t *testing.T) {
+	t.Parallel()
+
+	// Providers that predate SupportsFeature do not support any optional features.
+	client := &stubProviderClient{
+		SupportsFeatureF: func(ctx context.Context,
+			req *pulumirpc.ProviderSupportsFeatureRequest) (*pulumirpc.ProviderSupportsFeatureResponse, error) {
+			return nil, status.Error(codes.Unimplemented, "SupportsFeature is not yet implemented")
+		},
+	}
+	prov := NewProviderWithClient(nil, "test", client, false)
+
+	has, err := prov.SupportsFeature(context.Background(), FeatureGetMapping)
+	require.NoError(t, err)
+	assert.False(t, has)
+}
+
+func TestProviderBinaryPropertyMap(t *testing.T) {
+	t.Parallel()
+
+	inputs := resource.PropertyMap{"size": resource.NewNumberProperty(3)}
+	outputs := resource.PropertyMap{"size": resource.NewNumberProperty(3), "arn": resource.NewStringProperty("a")}
+	encode := func(props resource.PropertyMap) []byte {
+		data, err := MarshalBinaryProperties(props, MarshalOptions{})
+		require.NoError(t, err)
+		return data
+	}
+
+	// Providers that support the binary encoding exchange property maps as MessagePack rather than as structs.
+	client := &stubProviderClient{
+		SupportsFeatureF: func(ctx context.Context,
+			req *pulumirpc.ProviderSupportsFeatureRequest) (*pulumirpc.ProviderSupportsFeatureResponse, error) {
+			return &pulumirpc.ProviderSupportsFeatureResponse{HasSupport: req.GetId() == FeatureBinaryPropertyMap}, nil
+		},
+		CheckF: func(ctx context.Context, req *pulumirpc.CheckRequest) (*pulumirpc.CheckResponse, error) {
+			assert.Nil(t, req.GetNews())
+			news, err := UnmarshalBinaryProperties(req.GetBinaryNews(), MarshalOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, inputs, news)
+			return &pulumirpc.CheckResponse{BinaryInputs: req.GetBinaryNews()}, nil
+		},
+		CreateF: func(ctx context.Context, req *pulumirpc.CreateRequest) (*pulumirpc.CreateResponse, error) {
+			assert.Nil(t, req.GetProperties())
+			assert.Equal(t, encode(inputs), req.GetBinaryProperties())
+			return &pulumirpc.CreateResponse{Id: "id", BinaryProperties: encode(outputs)}, nil
+		},
+	}
+	prov := NewProviderWithClient(nil, "test", client, false)
+	require.NoError(t, prov.Configure(context.Background(), NewProviderConfigFromMap(resource.PropertyMap{})))
+
+	urn := resource.URN("urn:pulumi:stack::project::test:index:res::a")
+	checked, _, err := prov.Check(context.Background(), urn, nil, inputs, false, nil)
+	require.NoError(t, err)
+	assert.Equal(t, inputs, checked)
+
+	_, outs, _, err := prov.Create(context.Background(), urn, inputs, 0, false)
+	require.NoError(t, err)
+	assert.Equal(t, outputs, outs)
+}
+
+func TestProviderServerBinaryPropertyMap(t *testing.T) {
+	t.Parallel()
+
+	server := NewProviderServer(&embeddableProvider{})
+	has, err := server.SupportsFeature(context.Background(),
+		&pulumirpc.ProviderSupportsFeatureRequest{Id: FeatureBinaryPropertyMap})
+	require.NoError(t, err)
+	assert.True(t, has.GetHasSupport())
+
+	data, err := MarshalBinaryProperties(resource.PropertyMap{"in": resource.NewStringProperty("value")},
+		MarshalOptions{})
+	require.NoError(t, err)
+
+	// Responses use the binary encoding exactly when their request did.
+	resp, err := server.Create(context.Background(), &pulumirpc.CreateRequest{BinaryProperties: data})
+	require.NoError(t, err)
+	assert.Nil(t, resp.GetProperties())
+	outs, err := UnmarshalBinaryProperties(resp.GetBinaryProperties(), MarshalOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, resource.PropertyMap{"out": resource.NewStringProperty("value")}, outs)
+
+	props, err := MarshalProperties(resource.PropertyMap{"in": resource.NewStringProperty("value")}, MarshalOptions{})
+	require.NoError(t, err)
+	resp, err = server.Create(context.Background(), &pulumirpc.CreateRequest{Properties: props})
+	require.NoError(t, err)
+	assert.Empty(t, resp.GetBinaryProperties())
+	assert.NotNil(t, resp.GetProperties())
+}
+
+func TestProviderReadStream(t *testing.T) {
+	t.Parallel()
+
+	response := func(status string) *pulumirpc.ReadResponse {
+		props, err := MarshalProperties(resource.PropertyMap{"status": resource.NewStringProperty(status)},
+			MarshalOptions{})
+		require.NoError(t, err)
+		return &pulumirpc.ReadResponse{Id: "job-1", Properties: props}
+	}
+
+	client := &stubProviderClient{
+		ReadStreamF: func(ctx context.Context, req *pulumirpc.ReadRequest) ([]*pulumirpc.ReadResponse, error) {
+			return []*pulumirpc.ReadResponse{response("running"), response("succeeded")}, nil
+		},
+	}
+	prov := NewProviderWithClient(nil, "test", client, false)
+	require.NoError(t, prov.Configure(context.Background(), NewProviderConfigFromMap(resource.PropertyMap{})))
+
+	var states []string
+	rst, err := prov.ReadStream(context.Background(), "urn:pulumi:stack::project::test:index:res::name", "job-1",
+		nil, resource.PropertyMap{}, func(result ReadResult) error {
+			assert.Equal(t, resource.ID("job-1"), result.ID)
+			states = append(states, result.Outputs["status"].StringValue())
+			return nil
+		})
+	require.NoError(t, err)
+	assert.Equal(t, resource.StatusOK, rst)
+	assert.Equal(t, []string{"running", "succeeded"}, states)
+
+	// An error returned by onNext stops the read.
+	stop := errors.New("stop")
+	states = nil
+	_, err = prov.ReadStream(context.Background(), "urn:pulumi:stack::project::test:index:res::name", "job-1",
+		nil, resource.PropertyMap{}, func(result ReadResult) error {
+			states = append(states, result.Outputs["status"].StringValue())
+			return stop
+		})
+	assert.ErrorIs(t, err, stop)
+	assert.Equal(t, []string{"running"}, states)
+}
+
+func TestProviderReadStreamUnimplemented(t *testing.T) {
+	t.Parallel()
+
+	// Providers that predate ReadStream are read with a single call to Read.
+	client := &stubProviderClient{
+		ReadStreamF: func(ctx context.Context, req *pulumirpc.ReadRequest) ([]*pulumirpc.ReadResponse, error) {
+			return nil, status.Error(codes.Unimplemented, "ReadStream is not yet implemented")
+		},
+		ReadF: func(ctx context.Context, req *pulumirpc.ReadRequest) (*pulumirpc.ReadResponse, error) {
+			return &pulumirpc.ReadResponse{Id: req.GetId(), Properties: req.GetProperties()}, nil
+		},
+	}
+	prov := NewProviderWithClient(nil, "test", client, false)
+	require.NoError(t, prov.Configure(context.Background(), NewProviderConfigFromMap(resource.PropertyMap{})))
+
+	var results []ReadResult
+	state := resource.PropertyMap{"status": resource.NewStringProperty("succeeded")}
+	rst, err := prov.ReadStream(context.Background(), "urn:pulumi:stack::project::test:index:res::name", "job-1",
+		nil, state, func(result ReadResult) error {
+			results = append(results, result)
+			return nil
+		})
+	require.NoError(t, err)
+	assert.Equal(t, resource.StatusOK, rst)
+	require.Len(t, results, 1)
+	assert.Equal(t, resource.ID("job-1"), results[0].ID)
+	assert.Equal(t, state, results[0].Outputs)
+}
+
+func TestProviderRefresh(t *testing.T) {
+	t.Parallel()
+
+	// A resource that no longer exists is reported by Refresh with empty properties rather than an error.
+	client := &stubProviderClient{
+		RefreshF: func(ctx context.Context, req *pulumirpc.ReadRequest) (*pulumirpc.ReadResponse, error) {
+			return &pulumirpc.ReadResponse{}, nil
+		},
+		ReadF: func(ctx context.Context, req *pulumirpc.ReadRequest) (*pulumirpc.ReadResponse, error) {
+			return nil, status.Error(codes.NotFound, "resource not found")
+		},
+	}
+	prov := NewProviderWithClient(nil, "test", client, false)
+	require.NoError(t, prov.Configure(context.Background(), NewProviderConfigFromMap(resource.PropertyMap{})))
+
+	result, rst, err := prov.Refresh(context.Background(), "urn:pulumi:stack::project::test:index:res::name", "id-1",
+		nil, resource.PropertyMap{"foo": resource.NewStringProperty("bar")})
+	require.NoError(t, err)
+	assert.Equal(t, resource.StatusOK, rst)
+	assert.Nil(t, result.Outputs)
+}
+
+func TestProviderRefreshUnimplemented(t *testing.T) {
+	t.Parallel()
+
+	// Providers that predate Refresh are refreshed using ReadStream, and failing that, Read.
+	client := &stubProviderClient{
+		RefreshF: func(ctx context.Context, req *pulumirpc.ReadRequest) (*pulumirpc.ReadResponse, error) {
+			return nil, status.Error(codes.Unimplemented, "Refresh is not yet implemented")
+		},
+		ReadStreamF: func(ctx context.Context, req *pulumirpc.ReadRequest) ([]*pulumirpc.ReadResponse, error) {
+			return nil, status.Error(codes.Unimplemented, "ReadStream is not yet implemented")
+		},
+		ReadF: func(ctx context.Context, req *pulumirpc.ReadRequest) (*pulumirpc.ReadResponse, error) {
+			return &pulumirpc.ReadResponse{Id: req.GetId(), Properties: req.GetProperties()}, nil
+		},
+	}
+	prov := NewProviderWithClient(nil, "test", client, false)
+	require.NoError(t, prov.Configure(context.Background(), NewProviderConfigFromMap(resource.PropertyMap{})))
+
+	state := resource.PropertyMap{"foo": resource.NewStringProperty("bar")}
+	result, rst, err := prov.Refresh(context.Background(), "urn:pulumi:stack::project::test:index:res::name", "id-1",
+		nil, state)
+	require.NoError(t, err)
+	assert.Equal(t, resource.StatusOK, rst)
+	assert.Equal(t, resource.ID("id-1"), result.ID)
+	assert.Equal(t, state, result.Outputs)
+}
+
+type diagnosticProvider struct {
+	Provider
+}
+
+func (p *diagnosticProvider) Check(ctx context.Context, urn resource.URN, olds, news resource.PropertyMap,
+	allowUnknowns bool, randomSeed []byte) (resource.PropertyMap, []CheckFailure, error) {
+	ReportDiagnostic(ctx, urn, ProviderDiagnostic{
+		Severity: diag.Warning,
+		Summary:  "bucket name is deprecated",
+		Detail:   "use bucketPrefix instead",
+		URL:      "https://example.com/buckets",
+	})
+	return news, nil, nil
+}
+
+func (p *diagnosticProvider) Create(ctx context.Context, urn resource.URN, news resource.PropertyMap, timeout float64,
+	preview bool) (resource.ID, resource.PropertyMap, resource.Status, error) {
+	ReportDiagnostic(ctx, "", ProviderDiagnostic{Severity: diag.Info, Summary: "using default region"})
+	return "id", news, resource.StatusOK, nil
+}
+
+func TestProviderDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	// Connect the client directly to a provider server so that the diagnostics make a round trip through gRPC.
+	server := NewProviderServer(&diagnosticProvider{})
+	client := &stubProviderClient{CheckF: server.Check, CreateF: server.Create}
+	prov := NewProviderWithClient(nil, "test", client, false)
+	require.NoError(t, prov.Configure(context.Background(), NewProviderConfigFromMap(resource.PropertyMap{})))
+
+	type reported struct {
+		urn resource.URN
+		d   ProviderDiagnostic
+	}
+	var diags []reported
+	ctx := ContextWithDiagnosticReporter(context.Background(), func(urn resource.URN, d ProviderDiagnostic) {
+		diags = append(diags, reported{urn, d})
+	})
+
+	urn := resource.URN("urn:pulumi:stack::project::test:index:res::name")
+	inputs := resource.PropertyMap{"foo": resource.NewStringProperty("bar")}
+	_, _, err := prov.Check(ctx, urn, nil, inputs, false, nil)
+	require.NoError(t, err)
+	_, _, _, err = prov.Create(ctx, urn, inputs, 0, false)
+	require.NoError(t, err)
+
+	// Diagnostics are attributed to the resource that the operation was about.
+	assert.Equal(t, []reported{
+		{urn, ProviderDiagnostic{
+			Severity: diag.Warning,
+			Summary:  "bucket name is deprecated",
+			Detail:   "use bucketPrefix instead",
+			URL:      "https://example.com/buckets",
+		}},
+		{urn, ProviderDiagnostic{Severity: diag.Info, Summary: "using default region"}},
+	}, diags)
+
+	// Diagnostics are dropped if the caller did not ask for them.
+	_, _, err = prov.Check(context.Background(), urn, nil, inputs, false, nil)
+	require.NoError(t, err)
+	assert.Len(t, diags, 2)
+}
+
+func TestProviderDiagnosticString(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "summary", ProviderDiagnostic{Summary: "summary"}.String())
+	assert.Equal(t, "summary\ndetail", ProviderDiagnostic{Summary: "summary", Detail: "detail"}.String())
+	assert.Equal(t, "summary\nSee https://example.com for more information.",
+		ProviderDiagnostic{Summary: "summary", URL: "https://example.com"}.String())
+}
+
+func TestProviderMigrateState(t *testing.T) {
+	t.Parallel()
+
+	client := &stubProviderClient{
+		SchemaVersion: 2,
+		MigrateStateF: func(ctx context.Context,
+			req *pulumirpc.MigrateStateRequest) (*pulumirpc.MigrateStateResponse, error) {
+
+			assert.Equal(t, int32(1), req.GetStateVersion())
+			state, err := UnmarshalProperties(req.GetState(), MarshalOptions{KeepSecrets: true})
+			require.NoError(t, err)
+			// Version 2 renamed "size" to "name".
+			mstate, err := MarshalProperties(resource.PropertyMap{"name": state["size"]}, MarshalOptions{KeepSecrets: true})
+			require.NoError(t, err)
+			return &pulumirpc.MigrateStateResponse{State: mstate}, nil
+		},
+	}
+	prov := NewProviderWithClient(nil, "test", client, false)
+	require.NoError(t, prov.Configure(context.Background(), NewProviderConfigFromMap(resource.PropertyMap{})))
+
+	version, err := prov.SchemaVersion(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, version)
+
+	migrated, err := prov.MigrateState(context.Background(), "urn:pulumi:stack::project::test:index:res::name", 1,
+		resource.PropertyMap{"size": resource.NewStringProperty("large")})
+	require.NoError(t, err)
+	assert.Equal(t, resource.PropertyMap{"name": resource.NewStringProperty("large")}, migrated)
+
+	// Providers that do not implement MigrateState report ErrNotYetImplemented.
+	client.MigrateStateF = func(ctx context.Context,
+		req *pulumirpc.MigrateStateRequest) (*pulumirpc.MigrateStateResponse, error) {
+		return nil, status.Error(codes.Unimplemented, "MigrateState is not yet implemented")
+	}
+	_, err = prov.MigrateState(context.Background(), "urn:pulumi:stack::project::test:index:res::name", 1,
+		resource.PropertyMap{})
+	assert.Equal(t, ErrNotYetImplemented, err)
+}
+
+func TestProviderPrepareImport(t *testing.T) {
+	t.Parallel()
+
+	urn := resource.URN("urn:pulumi:stack::project::test:index:res::name")
+	client := &stubProviderClient{
+		PrepareImportF: func(ctx context.Context,
+			req *pulumirpc.PrepareImportRequest) (*pulumirpc.PrepareImportResponse, error) {
+
+			assert.Equal(t, string(urn), req.GetUrn())
+			inputs, err := MarshalProperties(resource.PropertyMap{
+				"name": resource.NewStringProperty(strings.ToLower(req.GetId())),
+			}, MarshalOptions{})
+			require.NoError(t, err)
+			return &pulumirpc.PrepareImportResponse{Inputs: inputs}, nil
+		},
+	}
+	prov := NewProviderWithClient(nil, "test", client, false)
+	require.NoError(t, prov.Configure(context.Background(), NewProviderConfigFromMap(resource.PropertyMap{})))
+
+	inputs, err := prov.PrepareImport(context.Background(), urn, "MyBucket")
+	require.NoError(t, err)
+	assert.Equal(t, resource.PropertyMap{"name": resource.NewStringProperty("mybucket")}, inputs)
+
+	// Providers that do not implement PrepareImport report ErrNotYetImplemented.
+	client.PrepareImportF = func(ctx context.Context,
+		req *pulumirpc.PrepareImportRequest) (*pulumirpc.PrepareImportResponse, error) {
+		return nil, status.Error(codes.Unimplemented, "PrepareImport is not yet implemented")
+	}
+	_, err = prov.PrepareImport(context.Background(), urn, "MyBucket")
+	assert.Equal(t, ErrNotYetImplemented, err)
+}
+
+func TestProviderConfigChecksumMatch(t *testing.T) {
+	t.Parallel()
+
+	urn := resource.URN("urn:pulumi:stack::project::pulumi:providers:test::default")
+	checksum, err := ConfigChecksum(resource.PropertyMap{"region": resource.NewStringProperty("us-west-2")})
+	require.NoError(t, err)
+
+	client := &stubProviderClient{
+		ConfigChecksumMatchF: func(ctx context.Context,
+			req *pulumirpc.ConfigChecksumMatchRequest) (*pulumirpc.ConfigChecksumMatchResponse, error) {
+
+			assert.Equal(t, string(urn), req.GetUrn())
+			return &pulumirpc.ConfigChecksumMatchResponse{Match: req.GetChecksum() == checksum}, nil
+		},
+	}
+	prov := NewProviderWithClient(nil, "test", client, false)
+
+	// The checksum is checked before the provider is configured.
+	match, err := prov.ConfigChecksumMatch(context.Background(), urn, checksum)
+	require.NoError(t, err)
+	assert.True(t, match)
+
+	match, err = prov.ConfigChecksumMatch(context.Background(), urn, "other")
+	require.NoError(t, err)
+	assert.False(t, match)
+
+	// Providers that do not implement ConfigChecksumMatch report ErrNotYetImplemented.
+	client.ConfigChecksumMatchF = func(ctx context.Context,
+		req *pulumirpc.ConfigChecksumMatchRequest) (*pulumirpc.ConfigChecksumMatchResponse, error) {
+		return nil, status.Error(codes.Unimplemented, "ConfigChecksumMatch is not yet implemented")
+	}
+	_, err = prov.ConfigChecksumMatch(context.Background(), urn, checksum)
+	assert.Equal(t, ErrNotYetImplemented, err)
+}
+
+func TestProviderCallTimeout(t *testing.T) {
+	t.Parallel()
+
+	var timeout float64
+	client := &stubProviderClient{
+		CallF: func(ctx context.Context, req *pulumirpc.CallRequest) (*pulumirpc.CallResponse, error) {
+			timeout = req.GetTimeout()
+			return &pulumirpc.CallResponse{}, nil
+		},
+	}
+	prov := NewProviderWithClient(nil, "test", client, false)
+	require.NoError(t, prov.Configure(context.Background(), NewProviderConfigFromMap(resource.PropertyMap{})))
+
+	_, err := prov.Call(context.Background(), "test:index:func", resource.PropertyMap{}, CallInfo{},
+		CallOptions{Timeout: 90})
+	require.NoError(t, err)
+	assert.Equal(t, float64(90), timeout)
+}
+
+func TestProviderCallDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	client := &stubProviderClient{
+		CallF: func(ctx context.Context, req *pulumirpc.CallRequest) (*pulumirpc.CallResponse, error) {
+			return &pulumirpc.CallResponse{
+				Diagnostics: []*pulumirpc.ProviderDiagnostic{
+					{Severity: pulumirpc.ProviderDiagnostic_WARNING, Summary: "deprecated", Url: "https://example.com"},
+					{Severity: pulumirpc.ProviderDiagnostic_INFO, Summary: "took a while", Detail: "3 retries"},
+				},
+			}, nil
+		},
+	}
+	prov := NewProviderWithClient(nil, "test", client, false)
+	require.NoError(t, prov.Configure(context.Background(), NewProviderConfigFromMap(resource.PropertyMap{})))
+
+	result, err := prov.Call(context.Background(), "test:index:func", resource.PropertyMap{}, CallInfo{},
+		CallOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []ProviderDiagnostic{
+		{Severity: diag.Warning, Summary: "deprecated", URL: "https://example.com"},
+		{Severity: diag.Info, Summary: "took a while", Detail: "3 retries"},
+	}, result.Diagnostics)
+}
+
+func TestMarshalProviderDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, MarshalProviderDiagnostics(nil))
+	assert.Nil(t, UnmarshalProviderDiagnostics(nil))
+
+	diags := []ProviderDiagnostic{
+		{Severity: diag.Info, Summary: "info"},
+		{Severity: diag.Warning, Summary: "warning", Detail: "detail"},
+		{Severity: diag.Error, Summary: "error", URL: "https://example.com"},
+	}
+	assert.Equal(t, diags, UnmarshalProviderDiagnostics(MarshalProviderDiagnostics(diags)))
+
+	// Severities without a wire representation are sent as informational messages.
+	rpcDiags := MarshalProviderDiagnostics([]ProviderDiagnostic{{Severity: diag.Debug, Summary: "debug"}})
+	assert.Equal(t, pulumirpc.ProviderDiagnostic_INFO, rpcDiags[0].GetSeverity())
+
+	// Unrecognized severities are treated as errors.
+	diags = UnmarshalProviderDiagnostics([]*pulumirpc.ProviderDiagnostic{{Severity: 42, Summary: "unknown"}})
+	assert.Equal(t, diag.Error, diags[0].Severity)
+}
+
+func TestConfigChecksum(t *testing.T) {
+	t.Parallel()
+
+	checksum := func(config resource.PropertyMap) string {
+		sum, err := ConfigChecksum(config)
+		require.NoError(t, err)
+		return sum
+	}
+
+	config := resource.PropertyMap{
+		"region":  resource.NewStringProperty("us-west-2"),
+		"profile": resource.NewStringProperty("default"),
+		"tags": resource.NewObjectProperty(resource.PropertyMap{
+			"a": resource.NewStringProperty("1"),
+			"b": resource.NewStringProperty("2"),
+		}),
+	}
+	assert.Equal(t, checksum(config), checksum(config.Copy()))
+	assert.NotEqual(t, checksum(config), checksum(resource.PropertyMap{
+		"region": resource.NewStringProperty("us-east-1"),
+	}))
+
+	// Secret and unknown values have different checksums from their plain counterparts.
+	plain := resource.PropertyMap{"token": resource.NewStringProperty("abc")}
+	secret := resource.PropertyMap{"token": resource.MakeSecret(resource.NewStringProperty("abc"))}
+	unknown := resource.PropertyMap{"token": resource.MakeComputed(resource.NewStringProperty(""))}
+	assert.NotEqual(t, checksum(plain), checksum(secret))
+	assert.NotEqual(t, checksum(plain), checksum(unknown))
+}
+
+func TestProviderEstimateCost(t *testing.T) {
+	t.Parallel()
+
+	client := &stubProviderClient{
+		EstimateCostF: func(ctx context.Context,
+			req *pulumirpc.EstimateCostRequest) (*pulumirpc.EstimateCostResponse, error) {
+
+			news, err := UnmarshalProperties(req.GetNews(), MarshalOptions{KeepUnknowns: true})
+			require.NoError(t, err)
+			assert.Equal(t, resource.NewStringProperty("t3.micro"), news["instanceType"])
+			return &pulumirpc.EstimateCostResponse{MonthlyCostUSD: 7.59, Currency: "USD", Confidence: 0.9}, nil
+		},
+	}
+	prov := NewProviderWithClient(nil, "test", client, false)
+	require.NoError(t, prov.Configure(context.Background(), NewProviderConfigFromMap(resource.PropertyMap{})))
+
+	estimate, err := prov.EstimateCost(context.Background(), "urn:pulumi:stack::project::test:index:res::name",
+		resource.PropertyMap{
+			"instanceType": resource.NewStringProperty("t3.micro"),
+			"ami":          resource.MakeComputed(resource.NewStringProperty("")),
+		})
+	require.NoError(t, err)
+	assert.Equal(t, CostEstimate{MonthlyCostUSD: 7.59, Currency: "USD", Confidence: 0.9}, estimate)
+
+	// Providers that do not implement EstimateCost report ErrNotYetImplemented.
+	client.EstimateCostF = func(ctx context.Context,
+		req *pulumirpc.EstimateCostRequest) (*pulumirpc.EstimateCostResponse, error) {
+		return nil, status.Error(codes.Unimplemented, "EstimateCost is not yet implemented")
+	}
+	_, err = prov.EstimateCost(context.Background(), "urn:pulumi:stack::project::test:index:res::name",
+		resource.PropertyMap{})
+	assert.Equal(t, ErrNotYetImplemented, err)
+}
+
+func TestProviderGetSupportedVersions(t *testing.T) {
+	t.Parallel()
+
+	client := &stubProviderClient{
+		GetSupportedVersionsF: func(ctx context.Context) (*pulumirpc.GetSupportedVersionsResponse, error) {
+			return &pulumirpc.GetSupportedVersionsResponse{Versions: []int32{0, 1}}, nil
+		},
+	}
+	prov := NewProviderWithClient(nil, "test", client, false)
+
+	// The supported versions do not depend on configuration.
+	versions, err := prov.GetSupportedVersions(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []int{0, 1}, versions)
+
+	// Providers that do not implement GetSupportedVersions report ErrNotYetImplemented.
+	client.GetSupportedVersionsF = func(ctx context.Context) (*pulumirpc.GetSupportedVersionsResponse, error) {
+		return nil, status.Error(codes.Unimplemented, "GetSupportedVersions is not yet implemented")
+	}
+	_, err = prov.GetSupportedVersions(context.Background())
+	assert.Equal(t, ErrNotYetImplemented, err)
+}
+
+func TestProviderParameterize(t *testing.T) {
+	t.Parallel()
+
+	client := &stubProviderClient{
+		ParameterizeByValueF: func(ctx context.Context,
+			req *pulumirpc.ParameterizeByValueRequest) (*pulumirpc.ParameterizeResponse, error) {
+			return &pulumirpc.ParameterizeResponse{Name: string(req.GetValue()), Version: "1.2.3"}, nil
+		},
+		ParameterizeByReferenceF: func(ctx context.Context,
+			req *pulumirpc.ParameterizeByReferenceRequest) (*pulumirpc.ParameterizeResponse, error) {
+			return &pulumirpc.ParameterizeResponse{Name: req.GetName(), Version: req.GetVersion()}, nil
+		},
+	}
+	prov := NewProviderWithClient(nil, "test", client, false)
+	require.NoError(t, prov.Configure(context.Background(), NewProviderConfigFromMap(resource.PropertyMap{})))
+
+	version := semver.MustParse("1.2.3")
+	result, err := prov.ParameterizeByValue(context.Background(), []byte("sub"))
+	require.NoError(t, err)
+	assert.Equal(t, ParameterizeResult{Name: "sub", Version: &version}, result)
+
+	result, err = prov.ParameterizeByReference(context.Background(),
+		ParameterizeReference{Name: "sub", Version: &version})
+	require.NoError(t, err)
+	assert.Equal(t, ParameterizeResult{Name: "sub", Version: &version}, result)
+
+	// A package without a version has a nil version.
+	result, err = prov.ParameterizeByReference(context.Background(), ParameterizeReference{Name: "sub"})
+	require.NoError(t, err)
+	assert.Equal(t, ParameterizeResult{Name: "sub"}, result)
+
+	// Providers that do not implement parameterization report ErrNotYetImplemented.
+	client.ParameterizeByValueF = func(ctx context.Context,
+		req *pulumirpc.ParameterizeByValueRequest) (*pulumirpc.ParameterizeResponse, error) {
+		return nil, status.Error(codes.Unimplemented, "ParameterizeByValue is not yet implemented")
+	}
+	_, err = prov.ParameterizeByValue(context.Background(), []byte("sub"))
+	assert.Equal(t, ErrNotYetImplemented, err)
+}
+
+type constructProvider struct {
+	Provider
+
+	constructF func(info ConstructInfo, options ConstructOptions) (ConstructResult, error)
+}
+
+func (p *constructProvider) Construct(ctx context.Context, info ConstructInfo, typ tokens.Type, name tokens.QName,
+	parent resource.URN, inputs resource.PropertyMap, options ConstructOptions) (ConstructResult, error) {
+	return p.constructF(info, options)
+}
+
+func TestProviderConstructVersions(t *testing.T) {
+	t.Parallel()
+
+	// Connect the client directly to a provider server so that the versions make a round trip through gRPC.
+	var actual ConstructInfo
+	server := NewProviderServer(&constructProvider{constructF: func(info ConstructInfo, options ConstructOptions) (ConstructResult, error) {
+		actual = info
+		return ConstructResult{URN: "urn:pulumi:stack::project::test:index:component::name"}, nil
+	}})
+	client := &stubProviderClient{ConstructF: server.Construct}
+	prov := NewProviderWithClient(nil, "test", client, false)
+	require.NoError(t, prov.Configure(context.Background(), NewProviderConfigFromMap(resource.PropertyMap{})))
+
+	_, err := prov.Construct(context.Background(), ConstructInfo{
+		Project:       "project",
+		Stack:         "stack",
+		SDKVersion:    "3.40.0",
+		EngineVersion: "3.41.0",
+	}, "test:index:component", "name", "", resource.PropertyMap{}, ConstructOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "3.40.0", actual.SDKVersion)
+	assert.Equal(t, "3.41.0", actual.EngineVersion)
+}
+
+func TestProviderConstructNamespace(t *testing.T) {
+	t.Parallel()
+
+	// Connect the client directly to a provider server so that the namespace makes a round trip through gRPC.
+	var actual ConstructInfo
+	server := NewProviderServer(&constructProvider{constructF: func(info ConstructInfo,
+		options ConstructOptions) (ConstructResult, error) {
+		actual = info
+		return ConstructResult{URN: "urn:pulumi:stack::project::test:index:component::name"}, nil
+	}})
+	client := &stubProviderClient{ConstructF: server.Construct}
+	prov := NewProviderWithClient(nil, "test", client, false)
+	require.NoError(t, prov.Configure(context.Background(), NewProviderConfigFromMap(resource.PropertyMap{})))
+
+	_, err := prov.Construct(context.Background(), ConstructInfo{
+		Project:   "project",
+		Stack:     "stack",
+		Namespace: "tenant-a",
+	}, "test:index:component", "name", "", resource.PropertyMap{}, ConstructOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "tenant-a", actual.Namespace)
+}
+
+func TestProviderConstructIgnoreChanges(t *testing.T) {
+	t.Parallel()
+
+	// Connect the client directly to a provider server so that the options make a round trip through gRPC.
+	var actual ConstructOptions
+	server := NewProviderServer(&constructProvider{constructF: func(info ConstructInfo,
+		options ConstructOptions) (ConstructResult, error) {
+		actual = options
+		return ConstructResult{URN: "urn:pulumi:stack::project::test:index:component::name"}, nil
+	}})
+	client := &stubProviderClient{ConstructF: server.Construct}
+	prov := NewProviderWithClient(nil, "test", client, false)
+	require.NoError(t, prov.Configure(context.Background(), NewProviderConfigFromMap(resource.PropertyMap{})))
+
+	_, err := prov.Construct(context.Background(), ConstructInfo{}, "test:index:component", "name", "",
+		resource.PropertyMap{}, ConstructOptions{IgnoreChanges: []string{"foo", "bar.baz"}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"foo", "bar.baz"}, actual.IgnoreChanges)
+}
+
+func TestProviderConstructInputs(t *testing.T) {
+	t.Parallel()
+
+	dep := resource.URN("urn:pulumi:stack::project::test:index:res::dep")
+	inputs := resource.PropertyMap{"name": resource.NewStringProperty("normalized")}
+	var result ConstructResult
+	server := NewProviderServer(&constructProvider{constructF: func(info ConstructInfo, options ConstructOptions) (ConstructResult, error) {
+		return result, nil
+	}})
+	client := &stubProviderClient{ConstructF: server.Construct}
+	prov := NewProviderWithClient(nil, "test", client, false)
+	require.NoError(t, prov.Configure(context.Background(), NewProviderConfigFromMap(resource.PropertyMap{})))
+
+	// Normalized inputs and their dependencies make a round trip through gRPC.
+	result = ConstructResult{
+		URN:               "urn:pulumi:stack::project::test:index:component::name",
+		Inputs:            inputs,
+		InputDependencies: map[resource.PropertyKey][]resource.URN{"name": {dep}},
+	}
+	actual, err := prov.Construct(context.Background(), ConstructInfo{}, "test:index:component", "name", "",
+		resource.PropertyMap{}, ConstructOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, inputs, actual.Inputs)
+	assert.Equal(t, map[resource.PropertyKey][]resource.URN{"name": {dep}}, actual.InputDependencies)
+
+	// Providers that do not report normalized inputs leave them unset.
+	result = ConstructResult{URN: "urn:pulumi:stack::project::test:index:component::name"}
+	actual, err = prov.Construct(context.Background(), ConstructInfo{}, "test:index:component", "name", "",
+		resource.PropertyMap{}, ConstructOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, actual.Inputs)
+	assert.Empty(t, actual.InputDependencies)
+}
+
+func TestProviderServerValidatesTokens(t *testing.T) {
+	t.Parallel()
+
+	server := NewProviderServer(&constructProvider{constructF: func(info ConstructInfo,
+		options ConstructOptions) (ConstructResult, error) {
+		return ConstructResult{URN: "urn:pulumi:stack::project::test:index:component::name"}, nil
+	}})
+	ctx := context.Background()
+
+	// Malformed tokens are rejected before they reach the provider.
+	_, err := server.Construct(ctx, &pulumirpc.ConstructRequest{Type: "component"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = server.Invoke(ctx, &pulumirpc.InvokeRequest{Tok: "test:getThing"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = server.Call(ctx, &pulumirpc.CallRequest{Tok: "test::component/method"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = server.Construct(ctx, &pulumirpc.ConstructRequest{Type: "test:index:component"})
+	assert.NoError(t, err)
+}
+
+func TestProviderConstructChildResources(t *testing.T) {
+	t.Parallel()
+
+	children := []resource.URN{
+		"urn:pulumi:stack::project::test:index:component$test:index:res::a",
+		"urn:pulumi:stack::project::test:index:res::b",
+	}
+	server := NewProviderServer(&constructProvider{constructF: func(info ConstructInfo,
+		options ConstructOptions) (ConstructResult, error) {
+		return ConstructResult{
+			URN:            "urn:pulumi:stack::project::test:index:component::name",
+			ChildResources: children,
+		}, nil
+	}})
+	client := &stubProviderClient{ConstructF: server.Construct}
+	prov := NewProviderWithClient(nil, "test", client, false)
+	require.NoError(t, prov.Configure(context.Background(), NewProviderConfigFromMap(resource.PropertyMap{})))
+
+	actual, err := prov.Construct(context.Background(), ConstructInfo{}, "test:index:component", "name", "",
+		resource.PropertyMap{}, ConstructOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, children, actual.ChildResources)
+}
+
+// chanEventEmitter is an EventEmitter that sends the URN of each changed resource to a channel.
+type chanEventEmitter chan resource.URN
+
+func (e chanEventEmitter) EmitResourceChanged(urn resource.URN, id resource.ID) error {
+	e <- urn
+	return nil
+}
+
+func TestProviderRegisterEventEmitter(t *testing.T) {
+	t.Parallel()
+
+	client := &stubProviderClient{
+		WatchResourceChangesF: func(ctx context.Context) ([]*pulumirpc.ResourceChangedEvent, error) {
+			return []*pulumirpc.ResourceChangedEvent{
+				{Urn: "urn:pulumi:stack::project::test:index:res::a", Id: "a"},
+				{Urn: "urn:pulumi:stack::project::test:index:res::b", Id: "b"},
+			}, nil
+		},
+	}
+	prov := NewProviderWithClient(nil, "test", client, false)
+	require.NoError(t, prov.Configure(context.Background(), NewProviderConfigFromMap(resource.PropertyMap{})))
+
+	// Events are passed to the emitter in the order that the provider sends them.
+	emitter := make(chanEventEmitter, 2)
+	require.NoError(t, prov.RegisterEventEmitter(emitter))
+	assert.Equal(t, resource.URN("urn:pulumi:stack::project::test:index:res::a"), <-emitter)
+	assert.Equal(t, resource.URN("urn:pulumi:stack::project::test:index:res::b"), <-emitter)
+}
+
+// eventProvider is a provider that emits the given URNs as soon as an emitter is registered.
+type eventProvider struct {
+	Provider
+
+	urns []resource.URN
+}
+
+func (p *eventProvider) RegisterEventEmitter(e EventEmitter) error {
+	if p.urns == nil {
+		return ErrNotYetImplemented
+	}
+	for _, urn := range p.urns {
+		if err := e.EmitResourceChanged(urn, "id"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type stubWatchResourceChangesServer struct {
+	grpc.ServerStream
+
+	ctx    context.Context
+	events chan *pulumirpc.ResourceChangedEvent
+}
+
+func (s *stubWatchResourceChangesServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *stubWatchResourceChangesServer) Send(event *pulumirpc.ResourceChangedEvent) error {
+	s.events <- event
+	return nil
+}
+
+func TestProviderServerWatchResourceChanges(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &stubWatchResourceChangesServer{ctx: ctx, events: make(chan *pulumirpc.ResourceChangedEvent, 1)}
+	server := NewProviderServer(&eventProvider{urns: []resource.URN{"urn:pulumi:stack::project::test:index:res::a"}})
+
+	// The stream stays open until the caller cancels it.
+	done := make(chan error)
+	go func() {
+		done <- server.WatchResourceChanges(&pbempty.Empty{}, stream)
+	}()
+	event := <-stream.events
+	assert.Equal(t, "urn:pulumi:stack::project::test:index:res::a", event.GetUrn())
+	assert.Equal(t, "id", event.GetId())
+	cancel()
+	assert.NoError(t, <-done)
+
+	// Providers that cannot observe changes are reported as unimplemented.
+	err := NewProviderServer(&eventProvider{}).WatchResourceChanges(&pbempty.Empty{}, stream)
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestProviderWatchConfig(t *testing.T) {
+	t.Parallel()
+
+	changes := resource.PropertyMap{
+		"region": resource.NewStringProperty("us-east-1"),
+		"token":  resource.MakeSecret(resource.NewStringProperty("rotated")),
+	}
+	config, err := MarshalProperties(changes, MarshalOptions{KeepSecrets: true})
+	require.NoError(t, err)
+
+	client := &stubProviderClient{
+		WatchConfigF: func(ctx context.Context) ([]*pulumirpc.ConfigChangedEvent, error) {
+			return []*pulumirpc.ConfigChangedEvent{{Config: config}}, nil
+		},
+	}
+	prov := NewProviderWithClient(nil, "test", client, false)
+	require.NoError(t, prov.Configure(context.Background(), NewProviderConfigFromMap(resource.PropertyMap{})))
+
+	// The changed configuration is passed to onChange, secrets included.
+	received := make(chan resource.PropertyMap, 1)
+	require.NoError(t, prov.WatchConfig(context.Background(), func(changes resource.PropertyMap) {
+		received <- changes
+	}))
+	assert.Equal(t, changes, <-received)
+}
+
+// configWatchProvider is a provider that reports the given configuration change as soon as it is watched.
+type configWatchProvider struct {
+	Provider
+
+	changes resource.PropertyMap
+}
+
+func (p *configWatchProvider) WatchConfig(ctx context.Context, onChange func(resource.PropertyMap)) error {
+	if p.changes == nil {
+		return ErrNotYetImplemented
+	}
+	onChange(p.changes)
+	return nil
+}
+
+type stubWatchConfigServer struct {
+	grpc.ServerStream
+
+	ctx    context.Context
+	events chan *pulumirpc.ConfigChangedEvent
+}
+
+func (s *stubWatchConfigServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *stubWatchConfigServer) Send(event *pulumirpc.ConfigChangedEvent) error {
+	s.events <- event
+	return nil
+}
+
+func TestProviderServerWatchConfig(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &stubWatchConfigServer{ctx: ctx, events: make(chan *pulumirpc.ConfigChangedEvent, 1)}
+	server := NewProviderServer(&configWatchProvider{changes: resource.PropertyMap{
+		"region": resource.NewStringProperty("us-east-1"),
+	}})
+
+	// The stream stays open until the caller cancels it.
+	done := make(chan error)
+	go func() {
+		done <- server.WatchConfig(&pbempty.Empty{}, stream)
+	}()
+	event := <-stream.events
+	assert.Equal(t, "us-east-1", event.GetConfig().GetFields()["region"].GetStringValue())
+	cancel()
+	assert.NoError(t, <-done)
+
+	// Providers that cannot observe changes are reported as unimplemented.
+	err := NewProviderServer(&configWatchProvider{}).WatchConfig(&pbempty.Empty{}, stream)
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+type diffConfigProvider struct {
+	Provider
+
+	diff DiffResult
+}
+
+func (p *diffConfigProvider) DiffConfig(ctx context.Context, urn resource.URN, olds, news resource.PropertyMap,
+	allowUnknowns bool, ignoreChanges []string) (DiffResult, error) {
+	return p.diff, nil
+}
+
+type secretKeysProvider struct {
+	Provider
+
+	secretKeys []config.Key
+}
+
+func (p *secretKeysProvider) Configure(ctx context.Context, cfg ProviderConfig) error {
+	p.secretKeys = cfg.SecretKeys()
+	return nil
+}
+
+func (p *secretKeysProvider) SchemaVersion(ctx context.Context) (int, error) {
+	return 0, nil
+}
+
+func TestProviderServerConfigureSecretKeys(t *testing.T) {
+	t.Parallel()
+
+	inner := &secretKeysProvider{}
+	args, err := MarshalProperties(resource.PropertyMap{
+		"region": resource.NewStringProperty("us-west-2"),
+		"token":  resource.MakeSecret(resource.NewStringProperty("s3cr3t")),
+	}, MarshalOptions{KeepSecrets: true})
+	require.NoError(t, err)
+
+	_, err = NewProviderServer(inner).Configure(context.Background(), &pulumirpc.ConfigureRequest{
+		Args:             args,
+		ConfigSecretKeys: []string{"test:token"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []config.Key{config.MustMakeKey("test", "token")}, inner.secretKeys)
+
+	_, err = NewProviderServer(inner).Configure(context.Background(), &pulumirpc.ConfigureRequest{
+		Args:             args,
+		ConfigSecretKeys: []string{"not a key"},
+	})
+	assert.Error(t, err)
+}
+
+func TestProviderDiffConfigAffectedResources(t *testing.T) {
+	t.Parallel()
+
+	affected := []resource.URN{"urn:pulumi:stack::project::test:index:res::a"}
+	server := NewProviderServer(&diffConfigProvider{diff: DiffResult{
+		Changes:           DiffSome,
+		ReplaceKeys:       []resource.PropertyKey{"region"},
+		AffectedResources: affected,
+	}})
+	client := &stubProviderClient{DiffConfigF: server.DiffConfig}
+	prov := NewProviderWithClient(nil, "test", client, false)
+
+	// The affected resources make a round trip through gRPC.
+	diff, err := prov.DiffConfig(context.Background(), "urn:pulumi:stack::project::pulumi:providers:test::default",
+		resource.PropertyMap{}, resource.PropertyMap{}, true, nil)
+	require.NoError(t, err)
+	assert.True(t, diff.RequiresReplacement())
+	assert.Equal(t, affected, diff.AffectedResources)
+}
+
+func TestProviderDiffReportedValues(t *testing.T) {
+	t.Parallel()
+
+	reported := PropertyDiff{
+		Kind:     DiffUpdate,
+		OldValue: resource.NewStringProperty("us-east-1"),
+		NewValue: resource.NewObjectProperty(resource.PropertyMap{"name": resource.NewStringProperty("us-west-2")}),
+	}
+	server := NewProviderServer(&diffConfigProvider{diff: DiffResult{
+		Changes:      DiffSome,
+		DetailedDiff: map[string]PropertyDiff{"region": reported, "tags": {Kind: DiffAdd}},
+	}})
+	client := &stubProviderClient{DiffConfigF: server.DiffConfig}
+	prov := NewProviderWithClient(nil, "test", client, false)
+
+	// The reported old and new values make a round trip through gRPC. Diffs without values are unchanged.
+	diff, err := prov.DiffConfig(context.Background(), "urn:pulumi:stack::project::pulumi:providers:test::default",
+		resource.PropertyMap{}, resource.PropertyMap{}, true, nil)
+	require.NoError(t, err)
+	assert.Equal(t, reported, diff.DetailedDiff["region"])
+	assert.Equal(t, PropertyDiff{Kind: DiffAdd}, diff.DetailedDiff["tags"])
+}
+
+func TestProviderStreamCreate(t *testing.T) {
+	t.Parallel()
+
+	response := func(id, status string) *pulumirpc.CreateResponse {
+		props, err := MarshalProperties(resource.PropertyMap{"status": resource.NewStringProperty(status)},
+			MarshalOptions{})
+		require.NoError(t, err)
+		return &pulumirpc.CreateResponse{Id: id, Properties: props}
+	}
+
+	client := &stubProviderClient{
+		StreamCreateF: func(ctx context.Context, req *pulumirpc.CreateRequest) ([]*pulumirpc.CreateResponse, error) {
+			return []*pulumirpc.CreateResponse{
+				response("", "pending"), response("", "running"), response("job-1", "succeeded"),
+			}, nil
+		},
+	}
+	prov := NewProviderWithClient(nil, "test", client, false)
+	require.NoError(t, prov.Configure(context.Background(), NewProviderConfigFromMap(resource.PropertyMap{})))
+
+	// Interim states are passed to onNext, and the final state is returned.
+	var states []string
+	id, outs, rst, err := prov.StreamCreate(context.Background(), "urn:pulumi:stack::project::test:index:res::name",
+		resource.PropertyMap{}, 0, false, func(state resource.PropertyMap) error {
+			states = append(states, state["status"].StringValue())
+			return nil
+		})
+	require.NoError(t, err)
+	assert.Equal(t, resource.StatusOK, rst)
+	assert.Equal(t, resource.ID("job-1"), id)
+	assert.Equal(t, "succeeded", outs["status"].StringValue())
+	assert.Equal(t, []string{"pending", "running"}, states)
+
+	// An error returned by onNext stops the creation.
+	stop := errors.New("stop")
+	states = nil
+	_, _, _, err = prov.StreamCreate(context.Background(), "urn:pulumi:stack::project::test:index:res::name",
+		resource.PropertyMap{}, 0, false, func(state resource.PropertyMap) error {
+			states = append(states, state["status"].StringValue())
+			return stop
+		})
+	assert.ErrorIs(t, err, stop)
+	assert.Equal(t, []string{"pending"}, states)
+}
+
+func TestProviderStreamInvokePages(t *testing.T) {
+	t.Parallel()
+
+	response := func(name string, page int32, last bool) *pulumirpc.InvokeResponse {
+		ret, err := MarshalProperties(resource.PropertyMap{"name": resource.NewStringProperty(name)}, MarshalOptions{})
+		require.NoError(t, err)
+		return &pulumirpc.InvokeResponse{Return: ret, PageNumber: page, IsLastPage: last}
+	}
+
+	client := &stubProviderClient{}
+	prov := NewProviderWithClient(nil, "test", client, false)
+	require.NoError(t, prov.Configure(context.Background(), NewProviderConfigFromMap(resource.PropertyMap{})))
+
+	invoke := func() []StreamInvokeEvent {
+		var events []StreamInvokeEvent
+		failures, err := prov.StreamInvoke(context.Background(), "test:index:listBuckets", resource.PropertyMap{},
+			func(event StreamInvokeEvent) error {
+				events = append(events, event)
+				return nil
+			})
+		require.NoError(t, err)
+		assert.Empty(t, failures)
+		return events
+	}
+	event := func(name string, page int, last bool) StreamInvokeEvent {
+		return StreamInvokeEvent{
+			Payload:    resource.PropertyMap{"name": resource.NewStringProperty(name)},
+			PageNumber: page,
+			IsLastPage: last,
+		}
+	}
+
+	// Paginated responses report the page that they belong to.
+	client.StreamInvokeF = func(ctx context.Context,
+		req *pulumirpc.InvokeRequest) ([]*pulumirpc.InvokeResponse, error) {
+		return []*pulumirpc.InvokeResponse{
+			response("a", 1, false), response("b", 1, false), response("c", 2, true),
+		}, nil
+	}
+	assert.Equal(t, []StreamInvokeEvent{event("a", 1, false), event("b", 1, false), event("c", 2, true)}, invoke())
+
+	// Responses from providers that do not paginate are each treated as their own last page.
+	client.StreamInvokeF = func(ctx context.Context,
+		req *pulumirpc.InvokeRequest) ([]*pulumirpc.InvokeResponse, error) {
+		return []*pulumirpc.InvokeResponse{response("a", 0, false), response("b", 0, false)}, nil
+	}
+	assert.Equal(t, []StreamInvokeEvent{event("a", 0, true), event("b", 0, true)}, invoke())
+}
+
+func TestProviderStreamCreateUnimplemented(t *testing.T) {
+	t.Parallel()
+
+	// Providers that predate StreamCreate are created with a single call to Create.
+	client := &stubProviderClient{
+		StreamCreateF: func(ctx context.Context, req *pulumirpc.CreateRequest) ([]*pulumirpc.CreateResponse, error) {
+			return nil, status.Error(codes.Unimplemented, "StreamCreate is not yet implemented")
+		},
+		CreateF: func(ctx context.Context, req *pulumirpc.CreateRequest) (*pulumirpc.CreateResponse, error) {
+			return &pulumirpc.CreateResponse{Id: "job-1", Properties: req.GetProperties()}, nil
+		},
+	}
+	prov := NewProviderWithClient(nil, "test", client, false)
+	require.NoError(t, prov.Configure(context.Background(), NewProviderConfigFromMap(resource.PropertyMap{})))
+
+	id, _, rst, err := prov.StreamCreate(context.Background(), "urn:pulumi:stack::project::test:index:res::name",
+		resource.PropertyMap{}, 0, false, func(state resource.PropertyMap) error {
+			assert.Fail(t, "onNext should not be called")
+			return nil
+		})
+	require.NoError(t, err)
+	assert.Equal(t, resource.StatusOK, rst)
+	assert.Equal(t, resource.ID("job-1"), id)
+}
+
+func TestProviderWaitForResourceReady(t *testing.T) {
+	t.Parallel()
+
+	urn := resource.URN("urn:pulumi:stack::project::test:index:db::db")
+	client := &stubProviderClient{
+		WaitForResourceReadyF: func(ctx context.Context,
+			req *pulumirpc.WaitForResourceReadyRequest) (*pbempty.Empty, error) {
+
+			assert.Equal(t, string(urn), req.GetUrn())
+			assert.Equal(t, "db-1", req.GetId())
+			assert.Equal(t, float64(30), req.GetTimeout())
+			return &pbempty.Empty{}, nil
+		},
+	}
+	prov := NewProviderWithClient(nil, "test", client, false)
+	require.NoError(t, prov.Configure(context.Background(), NewProviderConfigFromMap(resource.PropertyMap{})))
+
+	require.NoError(t, prov.WaitForResourceReady(context.Background(), urn, "db-1", 30))
+
+	// A DEADLINE_EXCEEDED status is reported as a WaitTimeoutError.
+	client.WaitForResourceReadyF = func(ctx context.Context,
+		req *pulumirpc.WaitForResourceReadyRequest) (*pbempty.Empty, error) {
+		return nil, status.Error(codes.DeadlineExceeded, "db-1 is still starting")
+	}
+	err := prov.WaitForResourceReady(context.Background(), urn, "db-1", 30)
+	var timeoutErr *WaitTimeoutError
+	require.True(t, errors.As(err, &timeoutErr))
+	assert.Equal(t, urn, timeoutErr.URN)
+	assert.Equal(t, 30*time.Second, timeoutErr.Timeout)
+
+	// Providers that do not implement WaitForResourceReady report ErrNotYetImplemented.
+	client.WaitForResourceReadyF = func(ctx context.Context,
+		req *pulumirpc.WaitForResourceReadyRequest) (*pbempty.Empty, error) {
+		return nil, status.Error(codes.Unimplemented, "WaitForResourceReady is not yet implemented")
+	}
+	err = prov.WaitForResourceReady(context.Background(), urn, "db-1", 30)
+	assert.Equal(t, ErrNotYetImplemented, err)
+}
+
+type aliasProvider struct {
+	Provider
+
+	aliases []resource.Alias
+}
+
+func (p *aliasProvider) GetResourceAliases(ctx context.Context, urn resource.URN) ([]resource.Alias, error) {
+	if p.aliases == nil {
+		return nil, ErrNotYetImplemented
+	}
+	return p.aliases, nil
+}
+
+func TestProviderGetResourceAliases(t *testing.T) {
+	t.Parallel()
+
+	urn := resource.URN("urn:pulumi:stack::project::my:index:Component$aws:s3/bucket:Bucket::logs")
+	stack := resource.URN("urn:pulumi:stack::project::pulumi:pulumi:Stack::project-stack")
+	inner := &aliasProvider{aliases: []resource.Alias{
+		{Type: "aws:s3:Bucket"},
+		{Name: "old-logs", Parent: stack},
+		{URN: "urn:pulumi:stack::project::aws:s3:Bucket::legacy"},
+	}}
+	server := NewProviderServer(inner)
+	client := &stubProviderClient{GetResourceAliasesF: server.GetResourceAliases}
+	prov := NewProviderWithClient(nil, "test", client, false)
+	require.NoError(t, prov.Configure(context.Background(), NewProviderConfigFromMap(resource.PropertyMap{})))
+
+	// Aliases are resolved against the resource's URN before they are sent to the engine.
+	aliases, err := prov.GetResourceAliases(context.Background(), urn)
+	require.NoError(t, err)
+	assert.Equal(t, []resource.Alias{
+		{URN: "urn:pulumi:stack::project::my:index:Component$aws:s3:Bucket::logs"},
+		{URN: "urn:pulumi:stack::project::aws:s3/bucket:Bucket::old-logs"},
+		{URN: "urn:pulumi:stack::project::aws:s3:Bucket::legacy"},
+	}, aliases)
+
+	// Providers that do not declare aliases report ErrNotYetImplemented.
+	inner.aliases = nil
+	_, err = prov.GetResourceAliases(context.Background(), urn)
+	assert.Equal(t, ErrNotYetImplemented, err)
+}
+
+func TestProviderSetLogSink(t *testing.T) {
+	t.Parallel()
+
+	var stdout, stderr bytes.Buffer
+	ctx := &Context{Diag: diag.DefaultSink(&stdout, &stderr, diag.FormatOptions{Color: colors.Never})}
+	prov := &provider{ctx: ctx, pkg: "test", plug: &plugin{}}
+
+	// By default the plugin's stderr is reported as diagnostics.
+	prov.plug.logStderr(ctx, "before\n", 1)
+	assert.Contains(t, stderr.String(), "before")
+
+	// Once a log sink is set, stderr is written there instead.
+	var logs bytes.Buffer
+	prov.SetLogSink(&logs)
+	prov.plug.logStderr(ctx, "after\n", 1)
+	assert.Equal(t, "after\n", logs.String())
+	assert.NotContains(t, stderr.String(), "after")
+
+	// Clearing the sink restores the default.
+	prov.SetLogSink(nil)
+	prov.plug.logStderr(ctx, "cleared\n", 1)
+	assert.Equal(t, "after\n", logs.String())
+	assert.Contains(t, stderr.String(), "cleared")
+
+	// Providers without a plugin process ignore the sink.
+	NewProviderWithClient(ctx, "test", &stubProviderClient{}, false).(GrpcProvider).SetLogSink(&logs)
+}
+
+func TestProviderReadNotFound(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{
+			name: "nil outputs",
+		},
+		{
+			name: "ErrResourceNotFound",
+			err:  ErrResourceNotFound,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Both forms of "not found" reach the engine as nil outputs after a round trip through gRPC.
+			server := NewProviderServer(&readProvider{readF: func(urn resource.URN,
+				id resource.ID) (ReadResult, resource.Status, error) {
+				return ReadResult{}, resource.StatusOK, tt.err
+			}})
+			prov := NewProviderWithClient(nil, "test", &stubProviderClient{ReadF: server.Read}, false)
+			require.NoError(t, prov.Configure(context.Background(), NewProviderConfigFromMap(resource.PropertyMap{})))
+
+			result, _, err := prov.Read(context.Background(), "urn:pulumi:stack::project::test:index:res::name", "id",
+				nil, resource.PropertyMap{})
+			require.NoError(t, err)
+			assert.Nil(t, result.Outputs)
+		})
+	}
+}
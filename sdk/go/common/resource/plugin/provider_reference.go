@@ -0,0 +1,122 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/blang/semver"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+// ProviderReference refers to a provider. A reference to a provider instance, i.e. a provider resource, has a URN and
+// an ID, and its string form is <URN> "::" <ID>, as recorded in the Provider field of resource states. A reference to
+// a provider plugin has no URN, and its string form is <package> or <package> "@" <version>.
+type ProviderReference struct {
+	Package tokens.Package // the package that the provider implements.
+	Version string         // the version of the provider, or empty for the newest available version.
+	ID      resource.ID    // the ID of the provider resource, if this refers to a provider instance.
+	URN     resource.URN   // the URN of the provider resource, if this refers to a provider instance.
+}
+
+// ParseProviderReference parses the string form of a provider reference. References to provider instances must have a
+// URN whose type is "pulumi:providers:<package>"; the package of the reference is taken from that type.
+func ParseProviderReference(s string) (ProviderReference, error) {
+	// References to provider plugins do not contain the URN delimiter.
+	lastSep := strings.LastIndex(s, resource.URNNameDelimiter)
+	if lastSep == -1 {
+		pkg, version := s, ""
+		if at := strings.LastIndex(s, "@"); at != -1 {
+			pkg, version = s[:at], s[at+1:]
+			if _, err := semver.ParseTolerant(version); err != nil {
+				return ProviderReference{}, fmt.Errorf("invalid version in provider reference '%v': %w", s, err)
+			}
+		}
+		if pkg == "" {
+			return ProviderReference{}, fmt.Errorf("provider reference '%v' must specify a package", s)
+		}
+		return ProviderReference{Package: tokens.Package(pkg), Version: version}, nil
+	}
+
+	urn, id := resource.URN(s[:lastSep]), resource.ID(s[lastSep+len(resource.URNNameDelimiter):])
+	if !urn.IsValid() {
+		return ProviderReference{}, fmt.Errorf("%s is not a valid URN", urn)
+	}
+	typ := urn.Type()
+	if typ.Module() != "pulumi:providers" {
+		return ProviderReference{}, fmt.Errorf("invalid module in type: expected 'pulumi:providers', got '%v'",
+			typ.Module())
+	}
+	if typ.Name() == "" {
+		return ProviderReference{}, errors.New("provider URNs must specify a type name")
+	}
+	return ProviderReference{Package: tokens.Package(typ.Name()), ID: id, URN: urn}, nil
+}
+
+// String returns the string form of the reference, which ParseProviderReference accepts.
+func (r ProviderReference) String() string {
+	if r.URN != "" {
+		return string(r.URN) + resource.URNNameDelimiter + string(r.ID)
+	}
+	if r.Version == "" {
+		return string(r.Package)
+	}
+	return fmt.Sprintf("%s@%s", r.Package, r.Version)
+}
+
+// Equal returns true if the two references refer to the same provider. Versions are compared semantically, so e.g.
+// "v1.2" and "1.2.0" are equal.
+func (r ProviderReference) Equal(other ProviderReference) bool {
+	return r.Package == other.Package && r.ID == other.ID && r.URN == other.URN &&
+		normalizeProviderVersion(r.Version) == normalizeProviderVersion(other.Version)
+}
+
+// pluginKey returns a key that identifies the provider plugin, i.e. the package and version, that the reference
+// refers to. References with semantically equal versions have the same key.
+func (r ProviderReference) pluginKey() string {
+	if r.Version == "" {
+		return string(r.Package)
+	}
+	return fmt.Sprintf("%s@%s", r.Package, normalizeProviderVersion(r.Version))
+}
+
+// parsedVersion returns the parsed version of the reference, or nil if the reference has no version.
+func (r ProviderReference) parsedVersion() (*semver.Version, error) {
+	if r.Version == "" {
+		return nil, nil
+	}
+	v, err := semver.ParseTolerant(r.Version)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version for provider %v: %w", r.Package, err)
+	}
+	return &v, nil
+}
+
+// normalizeProviderVersion returns the canonical form of the given version. Versions that cannot be parsed are
+// returned unchanged.
+func normalizeProviderVersion(version string) string {
+	if version == "" {
+		return ""
+	}
+	v, err := semver.ParseTolerant(version)
+	if err != nil {
+		return version
+	}
+	return v.String()
+}
@@ -0,0 +1,94 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+func TestParseProviderReference(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		s        string
+		expected ProviderReference
+	}{
+		{
+			s: "urn:pulumi:stack::project::pulumi:providers:aws::default_4_35_0::0b5ba6b2-6cb5-4a8b-9d1f-b3c0b1e4d9e2",
+			expected: ProviderReference{
+				Package: "aws",
+				ID:      "0b5ba6b2-6cb5-4a8b-9d1f-b3c0b1e4d9e2",
+				URN:     "urn:pulumi:stack::project::pulumi:providers:aws::default_4_35_0",
+			},
+		},
+		{
+			s: "urn:pulumi:stack::project::pulumi:providers:aws::prov::" + UnknownStringValue,
+			expected: ProviderReference{
+				Package: "aws",
+				ID:      UnknownStringValue,
+				URN:     "urn:pulumi:stack::project::pulumi:providers:aws::prov",
+			},
+		},
+		{s: "aws", expected: ProviderReference{Package: "aws"}},
+		{s: "aws@4.35.0", expected: ProviderReference{Package: "aws", Version: "4.35.0"}},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.s, func(t *testing.T) {
+			t.Parallel()
+
+			ref, err := ParseProviderReference(c.s)
+			require.NoError(t, err)
+			assert.Equal(t, c.expected, ref)
+			assert.Equal(t, c.s, ref.String())
+		})
+	}
+}
+
+func TestParseProviderReferenceErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range []string{
+		"",
+		"@1.0.0",
+		"aws@not-a-version",
+		"not-a-urn::id",
+		"urn:pulumi:stack::project::aws:s3/bucket:Bucket::bucket::id",
+	} {
+		_, err := ParseProviderReference(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestProviderReferenceEqual(t *testing.T) {
+	t.Parallel()
+
+	aws := ProviderReference{Package: "aws", Version: "4.35.0"}
+	assert.True(t, aws.Equal(ProviderReference{Package: "aws", Version: "v4.35"}))
+	assert.False(t, aws.Equal(ProviderReference{Package: "aws", Version: "4.36.0"}))
+	assert.False(t, aws.Equal(ProviderReference{Package: "aws"}))
+	assert.False(t, aws.Equal(ProviderReference{Package: "gcp", Version: "4.35.0"}))
+
+	urn := resource.URN("urn:pulumi:stack::project::pulumi:providers:aws::prov")
+	instance := ProviderReference{Package: "aws", ID: "id", URN: urn}
+	assert.True(t, instance.Equal(instance))
+	assert.False(t, instance.Equal(ProviderReference{Package: "aws", ID: "other", URN: urn}))
+	assert.False(t, instance.Equal(aws))
+}
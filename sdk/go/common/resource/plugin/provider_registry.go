@@ -0,0 +1,112 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// ProviderRegistry loads provider plugins from a host and shares them between callers. All loads of the same package
+// and version share a single provider instance, which is reference counted and closed once each load has been
+// unloaded; the ID and URN of the references passed to a ProviderRegistry are ignored. Because the instance is shared,
+// callers must not configure it differently from one another. A ProviderRegistry is safe to use concurrently.
+type ProviderRegistry struct {
+	host Host
+
+	m       sync.Mutex
+	entries map[string]*providerRegistryEntry // the loaded providers, keyed by package and version.
+}
+
+// providerRegistryEntry is a provider that has been loaded by a ProviderRegistry.
+type providerRegistryEntry struct {
+	ref      ProviderReference
+	provider Provider
+	refs     int // the number of loads that have not yet been unloaded.
+}
+
+// NewProviderRegistry creates a ProviderRegistry that loads providers from the given host.
+func NewProviderRegistry(host Host) *ProviderRegistry {
+	return &ProviderRegistry{
+		host:    host,
+		entries: make(map[string]*providerRegistryEntry),
+	}
+}
+
+// Load returns the provider for the given reference, loading it from the host if it is not already loaded. Each
+// successful call must be paired with a call to Unload.
+func (r *ProviderRegistry) Load(ref ProviderReference) (Provider, error) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	key := ref.pluginKey()
+	if entry, ok := r.entries[key]; ok {
+		entry.refs++
+		return entry.provider, nil
+	}
+
+	version, err := ref.parsedVersion()
+	if err != nil {
+		return nil, err
+	}
+	provider, err := r.host.Provider(ref.Package, version)
+	if err != nil {
+		return nil, err
+	}
+	if provider == nil {
+		return nil, fmt.Errorf("could not find plugin for provider %v", ref)
+	}
+	r.entries[key] = &providerRegistryEntry{ref: ref, provider: provider, refs: 1}
+	return provider, nil
+}
+
+// Unload releases a reference that was returned by Load. The provider is closed once every load of it has been
+// unloaded. It is an error to unload a reference that is not loaded.
+func (r *ProviderRegistry) Unload(ref ProviderReference) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	key := ref.pluginKey()
+	entry, ok := r.entries[key]
+	if !ok {
+		return fmt.Errorf("provider %v is not loaded", ref)
+	}
+
+	entry.refs--
+	if entry.refs > 0 {
+		return nil
+	}
+	delete(r.entries, key)
+	return r.host.CloseProvider(entry.provider)
+}
+
+// ListLoaded returns the references of the providers that are currently loaded, sorted by package and version.
+func (r *ProviderRegistry) ListLoaded() []ProviderReference {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	keys := make([]string, 0, len(r.entries))
+	for key := range r.entries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	refs := make([]ProviderReference, len(keys))
+	for i, key := range keys {
+		refs[i] = r.entries[key].ref
+	}
+	return refs
+}
@@ -0,0 +1,82 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"testing"
+
+	"github.com/blang/semver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+// countingHost is a Host that creates a new provider for each call to Provider and records the providers that are
+// closed. Any other method panics on the nil embedded host.
+type countingHost struct {
+	Host
+
+	loaded int
+	closed []Provider
+}
+
+func (h *countingHost) Provider(pkg tokens.Package, version *semver.Version) (Provider, error) {
+	h.loaded++
+	return &ProviderBase{}, nil
+}
+
+func (h *countingHost) CloseProvider(provider Provider) error {
+	h.closed = append(h.closed, provider)
+	return nil
+}
+
+func TestProviderRegistry(t *testing.T) {
+	t.Parallel()
+
+	a1 := ProviderReference{Package: "a", Version: "1.0.0"}
+	a2 := ProviderReference{Package: "a", Version: "2.0.0"}
+
+	host := &countingHost{}
+	r := NewProviderRegistry(host)
+
+	// Loads of the same package and version share a provider.
+	p1, err := r.Load(a1)
+	require.NoError(t, err)
+	p1Again, err := r.Load(ProviderReference{Package: "a", Version: "v1.0"})
+	require.NoError(t, err)
+	assert.Same(t, p1, p1Again)
+
+	p2, err := r.Load(a2)
+	require.NoError(t, err)
+	assert.NotSame(t, p1, p2)
+	assert.Equal(t, 2, host.loaded)
+	assert.Equal(t, []ProviderReference{a1, a2}, r.ListLoaded())
+
+	// The provider is only closed once every load has been unloaded.
+	require.NoError(t, r.Unload(a1))
+	assert.Empty(t, host.closed)
+	require.NoError(t, r.Unload(a1))
+	assert.Equal(t, []Provider{p1}, host.closed)
+	assert.Equal(t, []ProviderReference{a2}, r.ListLoaded())
+
+	assert.EqualError(t, r.Unload(a1), "provider a@1.0.0 is not loaded")
+
+	// Loading a closed provider loads a new instance.
+	p1, err = r.Load(a1)
+	require.NoError(t, err)
+	assert.NotSame(t, host.closed[0], p1)
+	assert.Equal(t, 3, host.loaded)
+}
@@ -0,0 +1,517 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/logging"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+// RetryPolicy controls how a plugin host recovers from provider plugins that crash or otherwise drop their connection.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts made for each operation, including the first. Defaults to 3.
+	MaxAttempts int
+	// BackoffBase is the delay before the first retry. Each subsequent retry doubles the delay. Defaults to 1s.
+	BackoffBase time.Duration
+	// BackoffMax caps the delay between attempts. Defaults to 30s.
+	BackoffMax time.Duration
+}
+
+// restartingProvider is a provider decorator that relaunches the underlying provider plugin when a call fails with a
+// connection error. Calls without side effects are then retried, up to the policy's maximum number of attempts; calls
+// that may have changed a resource, such as Create, Update, Delete, Construct and Call, are not, and their connection
+// errors are returned to the caller. The configuration, event emitter, and config watch last passed to the provider
+// are passed to each relaunched provider before it is used.
+type restartingProvider struct {
+	pkg    tokens.Package
+	policy RetryPolicy
+	launch func() (Provider, error)
+	sleep  func(ctx context.Context, d time.Duration) error
+
+	m          sync.Mutex
+	current    Provider       // the provider that calls are forwarded to.
+	configured bool           // true if the provider has been configured.
+	config     ProviderConfig // the configuration last passed to Configure.
+	emitter    EventEmitter   // the emitter last passed to RegisterEventEmitter, if any.
+
+	watchCtx      context.Context            // the context last passed to WatchConfig, if any.
+	watchOnChange func(resource.PropertyMap) // the callback last passed to WatchConfig, if any.
+}
+
+var _ Provider = (*restartingProvider)(nil)
+
+// newRestartingProvider wraps the given provider in a restartingProvider that uses launch to start a replacement.
+func newRestartingProvider(provider Provider, policy RetryPolicy,
+	launch func() (Provider, error)) *restartingProvider {
+
+	if policy.MaxAttempts <= 0 {
+		policy.MaxAttempts = 3
+	}
+	if policy.BackoffBase <= 0 {
+		policy.BackoffBase = time.Second
+	}
+	if policy.BackoffMax <= 0 {
+		policy.BackoffMax = 30 * time.Second
+	}
+	return &restartingProvider{
+		pkg:     provider.Pkg(),
+		policy:  policy,
+		launch:  launch,
+		sleep:   sleepContext,
+		current: provider,
+	}
+}
+
+// isConnectionError returns true if the given error indicates that the connection to a plugin was lost.
+func isConnectionError(err error) bool {
+	var s interface{ GRPCStatus() *status.Status }
+	return errors.As(err, &s) && s.GRPCStatus().Code() == codes.Unavailable
+}
+
+// provider returns the provider that calls are currently forwarded to.
+func (p *restartingProvider) provider() Provider {
+	p.m.Lock()
+	defer p.m.Unlock()
+	return p.current
+}
+
+// restart replaces the given failed provider with a newly launched one and returns the replacement. If the failed
+// provider has already been replaced, e.g. by a concurrent call, the existing replacement is returned instead.
+func (p *restartingProvider) restart(ctx context.Context, failed Provider) (Provider, error) {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	if p.current != failed {
+		return p.current, nil
+	}
+
+	if err := failed.Close(); err != nil {
+		logging.V(7).Infof("Provider[%s]: error closing failed provider; ignoring: %v", p.pkg, err)
+	}
+	provider, err := p.launch()
+	if err != nil {
+		return nil, fmt.Errorf("relaunching provider %s: %w", p.pkg, err)
+	}
+	if p.configured {
+		if err := provider.Configure(ctx, p.config); err != nil {
+			contract.IgnoreClose(provider)
+			return nil, fmt.Errorf("configuring relaunched provider %s: %w", p.pkg, err)
+		}
+	}
+	if p.emitter != nil {
+		if err := provider.RegisterEventEmitter(p.emitter); err != nil && !errors.Is(err, ErrNotYetImplemented) {
+			logging.V(7).Infof("Provider[%s]: error registering event emitter; ignoring: %v", p.pkg, err)
+		}
+	}
+	if p.watchCtx != nil && p.watchCtx.Err() == nil {
+		err := provider.WatchConfig(p.watchCtx, p.watchOnChange)
+		if err != nil && !errors.Is(err, ErrNotYetImplemented) {
+			logging.V(7).Infof("Provider[%s]: error watching config; ignoring: %v", p.pkg, err)
+		}
+	}
+	p.current = provider
+	return provider, nil
+}
+
+// do calls f with the current provider. If f fails with a connection error, the provider is relaunched so that later
+// calls may succeed, but f is not called again, as it may have taken effect before the connection was lost.
+func (p *restartingProvider) do(ctx context.Context, op string, f func(Provider) error) error {
+	provider := p.provider()
+	err := f(provider)
+	if !isConnectionError(err) {
+		return err
+	}
+
+	logging.V(7).Infof("Provider[%s]: %s lost its connection, relaunching without retrying: %v", p.pkg, op, err)
+	if _, rerr := p.restart(ctx, provider); rerr != nil {
+		logging.V(7).Infof("Provider[%s]: %v", p.pkg, rerr)
+	}
+	return err
+}
+
+// retry calls f with the current provider, relaunching the provider and calling f again while f fails with a
+// connection error and attempts remain. It must only be used for calls that have no side effects.
+func (p *restartingProvider) retry(ctx context.Context, op string, f func(Provider) error) error {
+	provider := p.provider()
+	err := f(provider)
+	for attempt := 1; isConnectionError(err) && attempt < p.policy.MaxAttempts; attempt++ {
+		delay := backoffDelay(p.policy.BackoffBase, p.policy.BackoffMax, attempt)
+		logging.V(7).Infof("Provider[%s]: %s lost its connection on attempt %d, relaunching in %v: %v",
+			p.pkg, op, attempt, delay, err)
+		if p.sleep(ctx, delay) != nil {
+			break
+		}
+
+		provider, err = p.restart(ctx, provider)
+		if err != nil {
+			return err
+		}
+		err = f(provider)
+	}
+	return err
+}
+
+func (p *restartingProvider) Close() error {
+	return p.provider().Close()
+}
+
+func (p *restartingProvider) Pkg() tokens.Package {
+	return p.pkg
+}
+
+func (p *restartingProvider) GetSchema(ctx context.Context, version int) (resp GetSchemaResponse, err error) {
+	err = p.retry(ctx, "GetSchema", func(prov Provider) error {
+		resp, err = prov.GetSchema(ctx, version)
+		return err
+	})
+	return resp, err
+}
+
+func (p *restartingProvider) GetSupportedVersions(ctx context.Context) (versions []int, err error) {
+	err = p.do(ctx, "GetSupportedVersions", func(prov Provider) error {
+		versions, err = prov.GetSupportedVersions(ctx)
+		return err
+	})
+	return versions, err
+}
+
+func (p *restartingProvider) CheckConfig(ctx context.Context, urn resource.URN, olds, news resource.PropertyMap,
+	allowUnknowns bool) (inputs resource.PropertyMap, failures []CheckFailure, err error) {
+
+	err = p.retry(ctx, "CheckConfig", func(prov Provider) error {
+		inputs, failures, err = prov.CheckConfig(ctx, urn, olds, news, allowUnknowns)
+		return err
+	})
+	return inputs, failures, err
+}
+
+func (p *restartingProvider) ConfigChecksumMatch(ctx context.Context, urn resource.URN,
+	checksum string) (match bool, err error) {
+
+	err = p.do(ctx, "ConfigChecksumMatch", func(prov Provider) error {
+		match, err = prov.ConfigChecksumMatch(ctx, urn, checksum)
+		return err
+	})
+	return match, err
+}
+
+func (p *restartingProvider) WaitForResourceReady(ctx context.Context, urn resource.URN, id resource.ID,
+	timeout float64) error {
+
+	return p.do(ctx, "WaitForResourceReady", func(prov Provider) error {
+		return prov.WaitForResourceReady(ctx, urn, id, timeout)
+	})
+}
+
+func (p *restartingProvider) DiffConfig(ctx context.Context, urn resource.URN, olds, news resource.PropertyMap,
+	allowUnknowns bool, ignoreChanges []string) (diff DiffResult, err error) {
+
+	err = p.retry(ctx, "DiffConfig", func(prov Provider) error {
+		diff, err = prov.DiffConfig(ctx, urn, olds, news, allowUnknowns, ignoreChanges)
+		return err
+	})
+	return diff, err
+}
+
+func (p *restartingProvider) Configure(ctx context.Context, cfg ProviderConfig) error {
+	err := p.do(ctx, "Configure", func(prov Provider) error {
+		return prov.Configure(ctx, cfg)
+	})
+	if err == nil {
+		p.m.Lock()
+		p.configured, p.config = true, cfg
+		p.m.Unlock()
+	}
+	return err
+}
+
+func (p *restartingProvider) Validate(ctx context.Context) error {
+	return p.do(ctx, "Validate", func(prov Provider) error {
+		return prov.Validate(ctx)
+	})
+}
+
+func (p *restartingProvider) ParameterizeByValue(ctx context.Context,
+	value []byte) (result ParameterizeResult, err error) {
+
+	err = p.do(ctx, "ParameterizeByValue", func(prov Provider) error {
+		result, err = prov.ParameterizeByValue(ctx, value)
+		return err
+	})
+	return result, err
+}
+
+func (p *restartingProvider) ParameterizeByReference(ctx context.Context,
+	ref ParameterizeReference) (result ParameterizeResult, err error) {
+
+	err = p.do(ctx, "ParameterizeByReference", func(prov Provider) error {
+		result, err = prov.ParameterizeByReference(ctx, ref)
+		return err
+	})
+	return result, err
+}
+
+func (p *restartingProvider) Check(ctx context.Context, urn resource.URN, olds, news resource.PropertyMap,
+	allowUnknowns bool, randomSeed []byte) (inputs resource.PropertyMap, failures []CheckFailure, err error) {
+
+	err = p.retry(ctx, "Check", func(prov Provider) error {
+		inputs, failures, err = prov.Check(ctx, urn, olds, news, allowUnknowns, randomSeed)
+		return err
+	})
+	return inputs, failures, err
+}
+
+func (p *restartingProvider) Diff(ctx context.Context, urn resource.URN, id resource.ID, olds resource.PropertyMap,
+	news resource.PropertyMap, allowUnknowns bool, ignoreChanges []string) (diff DiffResult, err error) {
+
+	err = p.retry(ctx, "Diff", func(prov Provider) error {
+		diff, err = prov.Diff(ctx, urn, id, olds, news, allowUnknowns, ignoreChanges)
+		return err
+	})
+	return diff, err
+}
+
+func (p *restartingProvider) Create(ctx context.Context, urn resource.URN, news resource.PropertyMap,
+	timeout float64, preview bool) (id resource.ID, outs resource.PropertyMap, status resource.Status, err error) {
+
+	err = p.do(ctx, "Create", func(prov Provider) error {
+		id, outs, status, err = prov.Create(ctx, urn, news, timeout, preview)
+		return err
+	})
+	return id, outs, status, err
+}
+
+func (p *restartingProvider) StreamCreate(ctx context.Context, urn resource.URN, news resource.PropertyMap,
+	timeout float64, preview bool, onNext func(resource.PropertyMap) error) (id resource.ID,
+	outs resource.PropertyMap, status resource.Status, err error) {
+
+	err = p.do(ctx, "StreamCreate", func(prov Provider) error {
+		id, outs, status, err = prov.StreamCreate(ctx, urn, news, timeout, preview, onNext)
+		return err
+	})
+	return id, outs, status, err
+}
+
+func (p *restartingProvider) Read(ctx context.Context, urn resource.URN, id resource.ID,
+	inputs, state resource.PropertyMap) (result ReadResult, status resource.Status, err error) {
+
+	err = p.retry(ctx, "Read", func(prov Provider) error {
+		result, status, err = prov.Read(ctx, urn, id, inputs, state)
+		return err
+	})
+	return result, status, err
+}
+
+func (p *restartingProvider) PrepareImport(ctx context.Context, urn resource.URN,
+	id resource.ID) (inputs resource.PropertyMap, err error) {
+
+	err = p.do(ctx, "PrepareImport", func(prov Provider) error {
+		inputs, err = prov.PrepareImport(ctx, urn, id)
+		return err
+	})
+	return inputs, err
+}
+
+func (p *restartingProvider) Refresh(ctx context.Context, urn resource.URN, id resource.ID,
+	inputs, state resource.PropertyMap) (result ReadResult, status resource.Status, err error) {
+
+	err = p.do(ctx, "Refresh", func(prov Provider) error {
+		result, status, err = prov.Refresh(ctx, urn, id, inputs, state)
+		return err
+	})
+	return result, status, err
+}
+
+func (p *restartingProvider) ReadStream(ctx context.Context, urn resource.URN, id resource.ID,
+	inputs, state resource.PropertyMap, onNext func(ReadResult) error) (status resource.Status, err error) {
+
+	err = p.do(ctx, "ReadStream", func(prov Provider) error {
+		status, err = prov.ReadStream(ctx, urn, id, inputs, state, onNext)
+		return err
+	})
+	return status, err
+}
+
+func (p *restartingProvider) BatchRead(ctx context.Context,
+	requests []BatchReadRequest) (responses []BatchReadResponse, err error) {
+
+	err = p.do(ctx, "BatchRead", func(prov Provider) error {
+		responses, err = prov.BatchRead(ctx, requests)
+		return err
+	})
+	return responses, err
+}
+
+func (p *restartingProvider) SchemaVersion(ctx context.Context) (version int, err error) {
+	err = p.do(ctx, "SchemaVersion", func(prov Provider) error {
+		version, err = prov.SchemaVersion(ctx)
+		return err
+	})
+	return version, err
+}
+
+func (p *restartingProvider) MigrateState(ctx context.Context, urn resource.URN, stateVersion int,
+	state resource.PropertyMap) (migrated resource.PropertyMap, err error) {
+
+	err = p.do(ctx, "MigrateState", func(prov Provider) error {
+		migrated, err = prov.MigrateState(ctx, urn, stateVersion, state)
+		return err
+	})
+	return migrated, err
+}
+
+func (p *restartingProvider) GetResourceAliases(ctx context.Context,
+	urn resource.URN) (aliases []resource.Alias, err error) {
+
+	err = p.do(ctx, "GetResourceAliases", func(prov Provider) error {
+		aliases, err = prov.GetResourceAliases(ctx, urn)
+		return err
+	})
+	return aliases, err
+}
+
+func (p *restartingProvider) Update(ctx context.Context, urn resource.URN, id resource.ID,
+	olds resource.PropertyMap, news resource.PropertyMap, timeout float64,
+	ignoreChanges []string, preview bool) (outs resource.PropertyMap, status resource.Status, err error) {
+
+	err = p.do(ctx, "Update", func(prov Provider) error {
+		outs, status, err = prov.Update(ctx, urn, id, olds, news, timeout, ignoreChanges, preview)
+		return err
+	})
+	return outs, status, err
+}
+
+func (p *restartingProvider) Delete(ctx context.Context, urn resource.URN, id resource.ID,
+	props resource.PropertyMap, timeout float64) (status resource.Status, err error) {
+
+	err = p.do(ctx, "Delete", func(prov Provider) error {
+		status, err = prov.Delete(ctx, urn, id, props, timeout)
+		return err
+	})
+	return status, err
+}
+
+func (p *restartingProvider) Construct(ctx context.Context, info ConstructInfo, typ tokens.Type, name tokens.QName,
+	parent resource.URN, inputs resource.PropertyMap, options ConstructOptions) (result ConstructResult, err error) {
+
+	err = p.do(ctx, "Construct", func(prov Provider) error {
+		result, err = prov.Construct(ctx, info, typ, name, parent, inputs, options)
+		return err
+	})
+	return result, err
+}
+
+func (p *restartingProvider) Invoke(ctx context.Context, tok tokens.ModuleMember,
+	args resource.PropertyMap) (outs resource.PropertyMap, failures []CheckFailure, err error) {
+
+	err = p.retry(ctx, "Invoke", func(prov Provider) error {
+		outs, failures, err = prov.Invoke(ctx, tok, args)
+		return err
+	})
+	return outs, failures, err
+}
+
+func (p *restartingProvider) StreamInvoke(ctx context.Context, tok tokens.ModuleMember, args resource.PropertyMap,
+	onNext func(StreamInvokeEvent) error) (failures []CheckFailure, err error) {
+
+	err = p.do(ctx, "StreamInvoke", func(prov Provider) error {
+		failures, err = prov.StreamInvoke(ctx, tok, args, onNext)
+		return err
+	})
+	return failures, err
+}
+
+func (p *restartingProvider) Call(ctx context.Context, tok tokens.ModuleMember, args resource.PropertyMap,
+	info CallInfo, options CallOptions) (result CallResult, err error) {
+
+	err = p.do(ctx, "Call", func(prov Provider) error {
+		result, err = prov.Call(ctx, tok, args, info, options)
+		return err
+	})
+	return result, err
+}
+
+func (p *restartingProvider) GetPluginInfo(ctx context.Context) (info workspace.PluginInfo, err error) {
+	err = p.retry(ctx, "GetPluginInfo", func(prov Provider) error {
+		info, err = prov.GetPluginInfo(ctx)
+		return err
+	})
+	return info, err
+}
+
+func (p *restartingProvider) GetMapping(ctx context.Context, key string) (data []byte, provider string, err error) {
+	err = p.do(ctx, "GetMapping", func(prov Provider) error {
+		data, provider, err = prov.GetMapping(ctx, key)
+		return err
+	})
+	return data, provider, err
+}
+
+func (p *restartingProvider) SupportsFeature(ctx context.Context, feature string) (supported bool, err error) {
+	err = p.do(ctx, "SupportsFeature", func(prov Provider) error {
+		supported, err = prov.SupportsFeature(ctx, feature)
+		return err
+	})
+	return supported, err
+}
+
+func (p *restartingProvider) RegisterEventEmitter(e EventEmitter) error {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	if err := p.current.RegisterEventEmitter(e); err != nil {
+		return err
+	}
+	p.emitter = e
+	return nil
+}
+
+func (p *restartingProvider) WatchConfig(ctx context.Context, onChange func(resource.PropertyMap)) error {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	if err := p.current.WatchConfig(ctx, onChange); err != nil {
+		return err
+	}
+	p.watchCtx, p.watchOnChange = ctx, onChange
+	return nil
+}
+
+func (p *restartingProvider) EstimateCost(ctx context.Context, urn resource.URN,
+	news resource.PropertyMap) (estimate CostEstimate, err error) {
+
+	err = p.do(ctx, "EstimateCost", func(prov Provider) error {
+		estimate, err = prov.EstimateCost(ctx, urn, news)
+		return err
+	})
+	return estimate, err
+}
+
+// SignalCancellation is forwarded without retries, as a provider that has lost its connection has nothing to cancel.
+func (p *restartingProvider) SignalCancellation(ctx context.Context) error {
+	return p.provider().SignalCancellation(ctx)
+}
@@ -0,0 +1,152 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+// crashingProvider is a provider whose Check and Create fail with a connection error until it has crashed the given
+// number of times. Any other method panics on the nil embedded provider.
+type crashingProvider struct {
+	Provider
+
+	crashes *int
+	config  *ProviderConfig
+	closed  bool
+}
+
+func (p *crashingProvider) Pkg() tokens.Package {
+	return "test"
+}
+
+func (p *crashingProvider) Configure(ctx context.Context, cfg ProviderConfig) error {
+	p.config = &cfg
+	return nil
+}
+
+func (p *crashingProvider) Check(ctx context.Context, urn resource.URN, olds, news resource.PropertyMap,
+	allowUnknowns bool, randomSeed []byte) (resource.PropertyMap, []CheckFailure, error) {
+
+	if *p.crashes > 0 {
+		*p.crashes--
+		return nil, nil, status.Error(codes.Unavailable, "transport is closing")
+	}
+	return news, nil, nil
+}
+
+func (p *crashingProvider) Create(ctx context.Context, urn resource.URN, news resource.PropertyMap, timeout float64,
+	preview bool) (resource.ID, resource.PropertyMap, resource.Status, error) {
+
+	if *p.crashes > 0 {
+		*p.crashes--
+		return "", nil, resource.StatusUnknown, status.Error(codes.Unavailable, "transport is closing")
+	}
+	return "id", news, resource.StatusOK, nil
+}
+
+func (p *crashingProvider) Close() error {
+	p.closed = true
+	return nil
+}
+
+func newTestRestartingProvider(crashes int, policy RetryPolicy) (*restartingProvider, *[]*crashingProvider) {
+	providers := []*crashingProvider{{crashes: &crashes}}
+	p := newRestartingProvider(providers[0], policy, func() (Provider, error) {
+		prov := &crashingProvider{crashes: &crashes}
+		providers = append(providers, prov)
+		return prov, nil
+	})
+	p.sleep = func(ctx context.Context, d time.Duration) error {
+		return ctx.Err()
+	}
+	return p, &providers
+}
+
+func TestRestartingProvider(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	cfg := NewProviderConfigFromMap(resource.PropertyMap{"region": resource.NewStringProperty("us-west-2")})
+	news := resource.PropertyMap{"foo": resource.NewStringProperty("bar")}
+
+	t.Run("relaunches and reconfigures", func(t *testing.T) {
+		t.Parallel()
+
+		prov, providers := newTestRestartingProvider(2, RetryPolicy{MaxAttempts: 3})
+		require.NoError(t, prov.Configure(ctx, cfg))
+
+		inputs, _, err := prov.Check(ctx, "urn:pulumi:stack::project::test:index:Resource::r", nil, news, false, nil)
+		require.NoError(t, err)
+		assert.Equal(t, news, inputs)
+
+		require.Len(t, *providers, 3)
+		for i, p := range *providers {
+			assert.Equal(t, &cfg, p.config)
+			assert.Equal(t, i < 2, p.closed)
+		}
+	})
+
+	t.Run("gives up after max attempts", func(t *testing.T) {
+		t.Parallel()
+
+		prov, providers := newTestRestartingProvider(3, RetryPolicy{MaxAttempts: 3})
+		_, _, err := prov.Check(ctx, "urn:pulumi:stack::project::test:index:Resource::r", nil, news, false, nil)
+		assert.True(t, isConnectionError(err))
+		assert.Len(t, *providers, 3)
+	})
+
+	t.Run("relaunches without retrying side effects", func(t *testing.T) {
+		t.Parallel()
+
+		prov, providers := newTestRestartingProvider(1, RetryPolicy{MaxAttempts: 3})
+		require.NoError(t, prov.Configure(ctx, cfg))
+
+		// The create may have happened before the connection was lost, so it is not retried.
+		_, _, _, err := prov.Create(ctx, "urn:pulumi:stack::project::test:index:Resource::r", news, 0, false)
+		assert.True(t, isConnectionError(err))
+		require.Len(t, *providers, 2)
+		assert.True(t, (*providers)[0].closed)
+		assert.Equal(t, &cfg, (*providers)[1].config)
+
+		// Later calls use the relaunched provider.
+		id, outs, _, err := prov.Create(ctx, "urn:pulumi:stack::project::test:index:Resource::r", news, 0, false)
+		require.NoError(t, err)
+		assert.Equal(t, resource.ID("id"), id)
+		assert.Equal(t, news, outs)
+		assert.Len(t, *providers, 2)
+	})
+
+	t.Run("detects connection errors", func(t *testing.T) {
+		t.Parallel()
+
+		failure := errors.New("failure")
+		assert.False(t, isConnectionError(failure))
+		assert.False(t, isConnectionError(status.Error(codes.Unknown, "failure")))
+		assert.True(t, isConnectionError(
+			&PartialFailureError{Cause: status.Error(codes.Unavailable, "transport is closing")}))
+	})
+}
@@ -0,0 +1,181 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/logging"
+)
+
+// RetryOptions controls the behavior of a RetryProvider.
+type RetryOptions struct {
+	// MaxAttempts is the maximum number of attempts made for each operation, including the first. Defaults to 3.
+	MaxAttempts int
+	// InitialDelay is the delay before the first retry. Each subsequent retry doubles the delay. Defaults to 1s.
+	InitialDelay time.Duration
+	// MaxDelay caps the delay between attempts. Defaults to 30s.
+	MaxDelay time.Duration
+}
+
+// RetryProvider is a provider decorator that retries the mutating operations Create, Update, and Delete with
+// exponential back-off when they fail with resource.StatusPartialFailure or a PartialFailureError. All other methods
+// are forwarded unchanged.
+//
+// A partially failed Create has already created the resource, so rather than creating it again, the retry issues an
+// Update against the resource that was created. Likewise, a retried Update uses the state returned by the failed
+// attempt as its old state.
+type RetryProvider struct {
+	ProviderBase
+
+	opts  RetryOptions
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+var _ Provider = (*RetryProvider)(nil)
+
+// NewRetryProvider wraps the given provider in a RetryProvider.
+func NewRetryProvider(provider Provider, opts RetryOptions) *RetryProvider {
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = 3
+	}
+	if opts.InitialDelay <= 0 {
+		opts.InitialDelay = time.Second
+	}
+	if opts.MaxDelay <= 0 {
+		opts.MaxDelay = 30 * time.Second
+	}
+	return &RetryProvider{
+		ProviderBase: NewProviderBase(provider),
+		opts:         opts,
+		sleep:        sleepContext,
+	}
+}
+
+// WithRetries returns a ProviderMiddleware that wraps providers in a RetryProvider.
+func WithRetries(opts RetryOptions) ProviderMiddleware {
+	return func(provider Provider) Provider {
+		return NewRetryProvider(provider, opts)
+	}
+}
+
+// Create allocates a new instance of the provided resource, retrying partial failures.
+func (p *RetryProvider) Create(ctx context.Context, urn resource.URN, news resource.PropertyMap, timeout float64,
+	preview bool) (resource.ID, resource.PropertyMap, resource.Status, error) {
+
+	id, outs, status, err := p.ProviderBase.Create(ctx, urn, news, timeout, preview)
+	for attempt := 1; p.shouldRetry(status, err, attempt); attempt++ {
+		if !p.backoff(ctx, urn, "Create", attempt, err) {
+			break
+		}
+		id, outs = partialState(id, outs, err)
+		if id == "" {
+			id, outs, status, err = p.ProviderBase.Create(ctx, urn, news, timeout, preview)
+		} else {
+			outs, status, err = p.ProviderBase.Update(ctx, urn, id, outs, news, timeout, nil, preview)
+		}
+	}
+	return id, outs, status, err
+}
+
+// Update updates an existing resource with new values, retrying partial failures.
+func (p *RetryProvider) Update(ctx context.Context, urn resource.URN, id resource.ID,
+	olds resource.PropertyMap, news resource.PropertyMap, timeout float64,
+	ignoreChanges []string, preview bool) (resource.PropertyMap, resource.Status, error) {
+
+	outs, status, err := p.ProviderBase.Update(ctx, urn, id, olds, news, timeout, ignoreChanges, preview)
+	for attempt := 1; p.shouldRetry(status, err, attempt); attempt++ {
+		if !p.backoff(ctx, urn, "Update", attempt, err) {
+			break
+		}
+		_, outs = partialState(id, outs, err)
+		if outs != nil {
+			olds = outs
+		}
+		outs, status, err = p.ProviderBase.Update(ctx, urn, id, olds, news, timeout, ignoreChanges, preview)
+	}
+	return outs, status, err
+}
+
+// Delete tears down an existing resource, retrying partial failures.
+func (p *RetryProvider) Delete(ctx context.Context, urn resource.URN, id resource.ID, props resource.PropertyMap,
+	timeout float64) (resource.Status, error) {
+
+	status, err := p.ProviderBase.Delete(ctx, urn, id, props, timeout)
+	for attempt := 1; p.shouldRetry(status, err, attempt); attempt++ {
+		if !p.backoff(ctx, urn, "Delete", attempt, err) {
+			break
+		}
+		status, err = p.ProviderBase.Delete(ctx, urn, id, props, timeout)
+	}
+	return status, err
+}
+
+// shouldRetry returns true if an operation that has been attempted the given number of times should be retried.
+func (p *RetryProvider) shouldRetry(status resource.Status, err error, attempts int) bool {
+	return err != nil && (status == resource.StatusPartialFailure || IsPartialFailure(err)) &&
+		attempts < p.opts.MaxAttempts
+}
+
+// partialState returns the ID and state carried by a PartialFailureError, falling back to the given values if err
+// does not carry them.
+func partialState(id resource.ID, outs resource.PropertyMap, err error) (resource.ID, resource.PropertyMap) {
+	var partial *PartialFailureError
+	if errors.As(err, &partial) {
+		if partial.ID != "" {
+			id = partial.ID
+		}
+		if partial.Properties != nil {
+			outs = partial.Properties
+		}
+	}
+	return id, outs
+}
+
+// backoff waits before the given retry attempt. It returns false if the context was canceled while waiting.
+func (p *RetryProvider) backoff(ctx context.Context, urn resource.URN, op string, attempt int, err error) bool {
+	delay := backoffDelay(p.opts.InitialDelay, p.opts.MaxDelay, attempt)
+	logging.V(7).Infof("RetryProvider: %s(%s) failed on attempt %d, retrying in %v: %v", op, urn, attempt, delay, err)
+	return p.sleep(ctx, delay) == nil
+}
+
+// backoffDelay returns the delay before the given retry attempt: the initial delay, doubled for each previous retry
+// and capped at max.
+func backoffDelay(initial, max time.Duration, attempt int) time.Duration {
+	delay := initial
+	for i := 1; i < attempt && delay < max; i++ {
+		delay *= 2
+	}
+	if delay > max {
+		delay = max
+	}
+	return delay
+}
+
+// sleepContext waits for the given duration or until the context is canceled, whichever comes first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
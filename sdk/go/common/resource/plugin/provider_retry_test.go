@@ -0,0 +1,224 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+type mutatingProvider struct {
+	Provider
+
+	createF func(news resource.PropertyMap) (resource.ID, resource.PropertyMap, resource.Status, error)
+	updateF func(id resource.ID, olds, news resource.PropertyMap) (resource.PropertyMap, resource.Status, error)
+	deleteF func(id resource.ID) (resource.Status, error)
+}
+
+func (p *mutatingProvider) Create(ctx context.Context, urn resource.URN, news resource.PropertyMap, timeout float64,
+	preview bool) (resource.ID, resource.PropertyMap, resource.Status, error) {
+	return p.createF(news)
+}
+
+func (p *mutatingProvider) Update(ctx context.Context, urn resource.URN, id resource.ID,
+	olds resource.PropertyMap, news resource.PropertyMap, timeout float64,
+	ignoreChanges []string, preview bool) (resource.PropertyMap, resource.Status, error) {
+	return p.updateF(id, olds, news)
+}
+
+func (p *mutatingProvider) Delete(ctx context.Context, urn resource.URN, id resource.ID, props resource.PropertyMap,
+	timeout float64) (resource.Status, error) {
+	return p.deleteF(id)
+}
+
+func newTestRetryProvider(provider Provider, opts RetryOptions) (*RetryProvider, *[]time.Duration) {
+	var delays []time.Duration
+	p := NewRetryProvider(provider, opts)
+	p.sleep = func(ctx context.Context, d time.Duration) error {
+		delays = append(delays, d)
+		return ctx.Err()
+	}
+	return p, &delays
+}
+
+func TestRetryProviderDelete(t *testing.T) {
+	t.Parallel()
+
+	failure := errors.New("partial failure")
+
+	t.Run("retries partial failures", func(t *testing.T) {
+		t.Parallel()
+
+		calls := 0
+		prov, delays := newTestRetryProvider(&mutatingProvider{
+			deleteF: func(id resource.ID) (resource.Status, error) {
+				calls++
+				if calls < 3 {
+					return resource.StatusPartialFailure, failure
+				}
+				return resource.StatusOK, nil
+			},
+		}, RetryOptions{MaxAttempts: 5, InitialDelay: time.Second})
+
+		status, err := prov.Delete(context.Background(), "", "id", nil, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, resource.StatusOK, status)
+		assert.Equal(t, 3, calls)
+		assert.Equal(t, []time.Duration{time.Second, 2 * time.Second}, *delays)
+	})
+
+	t.Run("gives up after max attempts", func(t *testing.T) {
+		t.Parallel()
+
+		calls := 0
+		prov, delays := newTestRetryProvider(&mutatingProvider{
+			deleteF: func(id resource.ID) (resource.Status, error) {
+				calls++
+				return resource.StatusPartialFailure, failure
+			},
+		}, RetryOptions{MaxAttempts: 4, InitialDelay: time.Second, MaxDelay: 3 * time.Second})
+
+		status, err := prov.Delete(context.Background(), "", "id", nil, 0)
+		assert.Equal(t, failure, err)
+		assert.Equal(t, resource.StatusPartialFailure, status)
+		assert.Equal(t, 4, calls)
+		assert.Equal(t, []time.Duration{time.Second, 2 * time.Second, 3 * time.Second}, *delays)
+	})
+
+	t.Run("does not retry other failures", func(t *testing.T) {
+		t.Parallel()
+
+		calls := 0
+		prov, _ := newTestRetryProvider(&mutatingProvider{
+			deleteF: func(id resource.ID) (resource.Status, error) {
+				calls++
+				return resource.StatusUnknown, failure
+			},
+		}, RetryOptions{})
+
+		status, err := prov.Delete(context.Background(), "", "id", nil, 0)
+		assert.Equal(t, failure, err)
+		assert.Equal(t, resource.StatusUnknown, status)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("stops when canceled", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		calls := 0
+		prov, _ := newTestRetryProvider(&mutatingProvider{
+			deleteF: func(id resource.ID) (resource.Status, error) {
+				calls++
+				cancel()
+				return resource.StatusPartialFailure, failure
+			},
+		}, RetryOptions{})
+
+		_, err := prov.Delete(ctx, "", "id", nil, 0)
+		assert.Equal(t, failure, err)
+		assert.Equal(t, 1, calls)
+	})
+}
+
+func TestRetryProviderCreate(t *testing.T) {
+	t.Parallel()
+
+	news := resource.PropertyMap{"foo": resource.NewStringProperty("bar")}
+	partial := resource.PropertyMap{"foo": resource.NewStringProperty("bar"), "ready": resource.NewBoolProperty(false)}
+	ready := resource.PropertyMap{"foo": resource.NewStringProperty("bar"), "ready": resource.NewBoolProperty(true)}
+
+	creates, updates := 0, 0
+	prov, _ := newTestRetryProvider(&mutatingProvider{
+		createF: func(news resource.PropertyMap) (resource.ID, resource.PropertyMap, resource.Status, error) {
+			creates++
+			return "id", partial, resource.StatusPartialFailure, &InitError{Reasons: []string{"not ready"}}
+		},
+		updateF: func(id resource.ID, olds, news resource.PropertyMap) (resource.PropertyMap, resource.Status, error) {
+			updates++
+			assert.Equal(t, resource.ID("id"), id)
+			assert.Equal(t, partial, olds)
+			return ready, resource.StatusOK, nil
+		},
+	}, RetryOptions{})
+
+	id, outs, status, err := prov.Create(context.Background(), "", news, 0, false)
+	assert.NoError(t, err)
+	assert.Equal(t, resource.StatusOK, status)
+	assert.Equal(t, resource.ID("id"), id)
+	assert.Equal(t, ready, outs)
+	assert.Equal(t, 1, creates)
+	assert.Equal(t, 1, updates)
+}
+
+func TestRetryProviderCreatePartialFailureError(t *testing.T) {
+	t.Parallel()
+
+	news := resource.PropertyMap{"foo": resource.NewStringProperty("bar")}
+	partial := resource.PropertyMap{"foo": resource.NewStringProperty("bar"), "ready": resource.NewBoolProperty(false)}
+
+	updates := 0
+	prov, _ := newTestRetryProvider(&mutatingProvider{
+		createF: func(news resource.PropertyMap) (resource.ID, resource.PropertyMap, resource.Status, error) {
+			// The partial state is only reported through the error.
+			return "", nil, resource.StatusUnknown, &PartialFailureError{
+				ID:         "id",
+				Properties: partial,
+				Cause:      errors.New("not ready"),
+			}
+		},
+		updateF: func(id resource.ID, olds, news resource.PropertyMap) (resource.PropertyMap, resource.Status, error) {
+			updates++
+			assert.Equal(t, resource.ID("id"), id)
+			assert.Equal(t, partial, olds)
+			return news, resource.StatusOK, nil
+		},
+	}, RetryOptions{})
+
+	id, _, status, err := prov.Create(context.Background(), "", news, 0, false)
+	assert.NoError(t, err)
+	assert.Equal(t, resource.StatusOK, status)
+	assert.Equal(t, resource.ID("id"), id)
+	assert.Equal(t, 1, updates)
+}
+
+func TestRetryProviderUpdate(t *testing.T) {
+	t.Parallel()
+
+	olds := resource.PropertyMap{"foo": resource.NewStringProperty("bar")}
+	partial := resource.PropertyMap{"foo": resource.NewStringProperty("baz")}
+
+	var seen []resource.PropertyMap
+	prov, _ := newTestRetryProvider(&mutatingProvider{
+		updateF: func(id resource.ID, olds, news resource.PropertyMap) (resource.PropertyMap, resource.Status, error) {
+			seen = append(seen, olds)
+			if len(seen) == 1 {
+				return partial, resource.StatusPartialFailure, errors.New("partial failure")
+			}
+			return news, resource.StatusOK, nil
+		},
+	}, RetryOptions{})
+
+	_, status, err := prov.Update(context.Background(), "", "id", olds, partial, 0, nil, false)
+	assert.NoError(t, err)
+	assert.Equal(t, resource.StatusOK, status)
+	assert.Equal(t, []resource.PropertyMap{olds, partial}, seen)
+}
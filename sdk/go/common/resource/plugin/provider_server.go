@@ -17,15 +17,21 @@ package plugin
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"sync"
+	"time"
 
+	"github.com/blang/semver"
 	pbempty "github.com/golang/protobuf/ptypes/empty"
+	structpb "github.com/golang/protobuf/ptypes/struct"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/logging"
 	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
 )
 
@@ -35,6 +41,11 @@ type providerServer struct {
 	keepResources bool
 }
 
+// NewProviderServer wraps the given provider in a gRPC ResourceProviderServer, handling the marshaling of requests and
+// responses. Providers written in Go can implement Provider and serve the result with
+// pulumirpc.RegisterResourceProviderServer rather than implementing the gRPC interface by hand; methods that return
+// ErrNotYetImplemented are reported as unimplemented. If the provider also implements GrpcProvider, Attach requests
+// are forwarded to it.
 func NewProviderServer(provider Provider) pulumirpc.ResourceProviderServer {
 	return &providerServer{provider: provider}
 }
@@ -57,6 +68,30 @@ func (p *providerServer) marshalOptions(label string) MarshalOptions {
 	}
 }
 
+// unmarshalProperties unmarshals a property map that the engine sent either as a struct or, if data is not empty, in
+// the MessagePack encoding.
+func (p *providerServer) unmarshalProperties(s *structpb.Struct, data []byte, label string) (resource.PropertyMap,
+	error) {
+
+	s, err := decodeBinaryStruct(s, data, label)
+	if err != nil {
+		return nil, err
+	}
+	return UnmarshalProperties(s, p.unmarshalOptions(label))
+}
+
+// marshalProperties marshals a property map for a response, returning it in the MessagePack encoding rather than as a
+// struct if binary is true. Responses are binary exactly when their request was.
+func (p *providerServer) marshalProperties(props resource.PropertyMap, binary bool,
+	label string) (*structpb.Struct, []byte, error) {
+
+	s, err := MarshalProperties(props, p.marshalOptions(label))
+	if err != nil || !binary {
+		return s, nil, err
+	}
+	return nil, encodeBinaryStruct(s), nil
+}
+
 func (p *providerServer) checkNYI(method string, err error) error {
 	if err == ErrNotYetImplemented {
 		return status.Error(codes.Unimplemented, fmt.Sprintf("%v is not yet implemented", method))
@@ -108,19 +143,42 @@ func (p *providerServer) marshalDiff(diff DiffResult) (*pulumirpc.DiffResponse,
 				kind, replaces = pulumirpc.PropertyDiff_UPDATE_REPLACE, append(replaces, path)
 			}
 
-			detailedDiff[path] = &pulumirpc.PropertyDiff{
+			pdiff := &pulumirpc.PropertyDiff{
 				Kind:      kind,
 				InputDiff: diff.InputDiff,
+				Reason:    diff.Reason,
+			}
+			if !diff.OldValue.IsNull() {
+				v, err := MarshalPropertyValue("", diff.OldValue, p.marshalOptions(path+".oldValue"))
+				if err != nil {
+					return nil, err
+				}
+				pdiff.OldValue = v
+			}
+			if !diff.NewValue.IsNull() {
+				v, err := MarshalPropertyValue("", diff.NewValue, p.marshalOptions(path+".newValue"))
+				if err != nil {
+					return nil, err
+				}
+				pdiff.NewValue = v
 			}
+			detailedDiff[path] = pdiff
 		}
 	}
 
+	var affected []string
+	for _, urn := range diff.AffectedResources {
+		affected = append(affected, string(urn))
+	}
+
 	return &pulumirpc.DiffResponse{
 		Replaces:            replaces,
 		DeleteBeforeReplace: diff.DeleteBeforeReplace,
 		Changes:             changes,
 		Diffs:               diffs,
 		DetailedDiff:        detailedDiff,
+		HasDetailedDiff:     detailedDiff != nil,
+		AffectedResources:   affected,
 	}, nil
 
 }
@@ -128,15 +186,71 @@ func (p *providerServer) marshalDiff(diff DiffResult) (*pulumirpc.DiffResponse,
 func (p *providerServer) GetSchema(ctx context.Context,
 	req *pulumirpc.GetSchemaRequest) (*pulumirpc.GetSchemaResponse, error) {
 
-	schema, err := p.provider.GetSchema(int(req.GetVersion()))
+	schema, err := p.provider.GetSchema(ctx, int(req.GetVersion()))
 	if err != nil {
 		return nil, err
 	}
-	return &pulumirpc.GetSchemaResponse{Schema: string(schema)}, nil
+	checksum := schema.Checksum
+	if checksum == "" {
+		checksum = SchemaChecksum(schema.Bytes)
+	}
+	return &pulumirpc.GetSchemaResponse{Schema: string(schema.Bytes), Checksum: checksum}, nil
+}
+
+func (p *providerServer) GetSupportedVersions(ctx context.Context,
+	req *pbempty.Empty) (*pulumirpc.GetSupportedVersionsResponse, error) {
+
+	versions, err := p.provider.GetSupportedVersions(ctx)
+	if err != nil {
+		return nil, p.checkNYI("GetSupportedVersions", err)
+	}
+
+	rpcVersions := make([]int32, len(versions))
+	for i, v := range versions {
+		rpcVersions[i] = int32(v)
+	}
+	return &pulumirpc.GetSupportedVersionsResponse{Versions: rpcVersions}, nil
+}
+
+func (p *providerServer) ParameterizeByValue(ctx context.Context,
+	req *pulumirpc.ParameterizeByValueRequest) (*pulumirpc.ParameterizeResponse, error) {
+
+	result, err := p.provider.ParameterizeByValue(ctx, req.GetValue())
+	if err != nil {
+		return nil, p.checkNYI("ParameterizeByValue", err)
+	}
+	return parameterizeResponse(result), nil
+}
+
+func (p *providerServer) ParameterizeByReference(ctx context.Context,
+	req *pulumirpc.ParameterizeByReferenceRequest) (*pulumirpc.ParameterizeResponse, error) {
+
+	ref := ParameterizeReference{Name: req.GetName()}
+	if v := req.GetVersion(); v != "" {
+		sv, err := semver.ParseTolerant(v)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid version %q: %v", v, err)
+		}
+		ref.Version = &sv
+	}
+
+	result, err := p.provider.ParameterizeByReference(ctx, ref)
+	if err != nil {
+		return nil, p.checkNYI("ParameterizeByReference", err)
+	}
+	return parameterizeResponse(result), nil
+}
+
+func parameterizeResponse(result ParameterizeResult) *pulumirpc.ParameterizeResponse {
+	resp := &pulumirpc.ParameterizeResponse{Name: result.Name}
+	if result.Version != nil {
+		resp.Version = result.Version.String()
+	}
+	return resp
 }
 
 func (p *providerServer) GetPluginInfo(ctx context.Context, req *pbempty.Empty) (*pulumirpc.PluginInfo, error) {
-	info, err := p.provider.GetPluginInfo()
+	info, err := p.provider.GetPluginInfo(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -147,7 +261,7 @@ func (p *providerServer) Attach(ctx context.Context, req *pulumirpc.PluginAttach
 	// NewProviderServer should take a GrpcProvider instead of Provider, but that's a breaking change
 	// so for now we type test here
 	if grpcProvider, ok := p.provider.(GrpcProvider); ok {
-		err := grpcProvider.Attach(req.GetAddress())
+		err := grpcProvider.Attach(ctx, req.GetAddress())
 		if err != nil {
 			return nil, err
 		}
@@ -157,8 +271,33 @@ func (p *providerServer) Attach(ctx context.Context, req *pulumirpc.PluginAttach
 	return nil, status.Error(codes.Unimplemented, "Attach is not yet implemented")
 }
 
+func (p *providerServer) GetMapping(ctx context.Context,
+	req *pulumirpc.GetMappingRequest) (*pulumirpc.GetMappingResponse, error) {
+
+	data, provider, err := p.provider.GetMapping(ctx, req.GetKey())
+	if err != nil {
+		return nil, err
+	}
+	return &pulumirpc.GetMappingResponse{Data: data, Provider: provider}, nil
+}
+
+func (p *providerServer) SupportsFeature(ctx context.Context,
+	req *pulumirpc.ProviderSupportsFeatureRequest) (*pulumirpc.ProviderSupportsFeatureResponse, error) {
+
+	// The server, not the provider, encodes property maps, so it supports the binary encoding for every provider.
+	if req.GetId() == FeatureBinaryPropertyMap {
+		return &pulumirpc.ProviderSupportsFeatureResponse{HasSupport: true}, nil
+	}
+
+	hasSupport, err := p.provider.SupportsFeature(ctx, req.GetId())
+	if err != nil {
+		return nil, p.checkNYI("SupportsFeature", err)
+	}
+	return &pulumirpc.ProviderSupportsFeatureResponse{HasSupport: hasSupport}, nil
+}
+
 func (p *providerServer) Cancel(ctx context.Context, req *pbempty.Empty) (*pbempty.Empty, error) {
-	if err := p.provider.SignalCancellation(); err != nil {
+	if err := p.provider.SignalCancellation(ctx); err != nil {
 		return nil, err
 	}
 	return &pbempty.Empty{}, nil
@@ -179,7 +318,7 @@ func (p *providerServer) CheckConfig(ctx context.Context,
 		return nil, err
 	}
 
-	newInputs, failures, err := p.provider.CheckConfig(urn, state, inputs, true)
+	newInputs, failures, err := p.provider.CheckConfig(ctx, urn, state, inputs, true)
 	if err != nil {
 		return nil, p.checkNYI("CheckConfig", err)
 	}
@@ -189,14 +328,35 @@ func (p *providerServer) CheckConfig(ctx context.Context,
 		return nil, err
 	}
 
-	rpcFailures := make([]*pulumirpc.CheckFailure, len(failures))
-	for i, f := range failures {
-		rpcFailures[i] = &pulumirpc.CheckFailure{Property: string(f.Property), Reason: f.Reason}
-	}
+	rpcFailures := MarshalCheckFailures(failures)
 
 	return &pulumirpc.CheckResponse{Inputs: rpcInputs, Failures: rpcFailures}, nil
 }
 
+func (p *providerServer) ConfigChecksumMatch(ctx context.Context,
+	req *pulumirpc.ConfigChecksumMatchRequest) (*pulumirpc.ConfigChecksumMatchResponse, error) {
+
+	match, err := p.provider.ConfigChecksumMatch(ctx, resource.URN(req.GetUrn()), req.GetChecksum())
+	if err != nil {
+		return nil, p.checkNYI("ConfigChecksumMatch", err)
+	}
+	return &pulumirpc.ConfigChecksumMatchResponse{Match: match}, nil
+}
+
+func (p *providerServer) WaitForResourceReady(ctx context.Context,
+	req *pulumirpc.WaitForResourceReadyRequest) (*pbempty.Empty, error) {
+
+	err := p.provider.WaitForResourceReady(ctx, resource.URN(req.GetUrn()), resource.ID(req.GetId()), req.GetTimeout())
+	if err != nil {
+		var timeoutErr *WaitTimeoutError
+		if errors.As(err, &timeoutErr) {
+			return nil, status.Error(codes.DeadlineExceeded, timeoutErr.Error())
+		}
+		return nil, p.checkNYI("WaitForResourceReady", err)
+	}
+	return &pbempty.Empty{}, nil
+}
+
 func (p *providerServer) DiffConfig(ctx context.Context, req *pulumirpc.DiffRequest) (*pulumirpc.DiffResponse, error) {
 	urn := resource.URN(req.GetUrn())
 
@@ -210,7 +370,7 @@ func (p *providerServer) DiffConfig(ctx context.Context, req *pulumirpc.DiffRequ
 		return nil, err
 	}
 
-	diff, err := p.provider.DiffConfig(urn, state, inputs, true, req.GetIgnoreChanges())
+	diff, err := p.provider.DiffConfig(ctx, urn, state, inputs, true, req.GetIgnoreChanges())
 	if err != nil {
 		return nil, p.checkNYI("DiffConfig", err)
 	}
@@ -220,7 +380,7 @@ func (p *providerServer) DiffConfig(ctx context.Context, req *pulumirpc.DiffRequ
 func (p *providerServer) Configure(ctx context.Context,
 	req *pulumirpc.ConfigureRequest) (*pulumirpc.ConfigureResponse, error) {
 
-	var inputs resource.PropertyMap
+	inputs := resource.PropertyMap{}
 	if req.GetArgs() != nil {
 		args, err := UnmarshalProperties(req.GetArgs(), p.unmarshalOptions("args"))
 		if err != nil {
@@ -244,44 +404,73 @@ func (p *providerServer) Configure(ctx context.Context,
 		}
 	}
 
-	if err := p.provider.Configure(inputs); err != nil {
+	var secretKeys []config.Key
+	for _, k := range req.GetConfigSecretKeys() {
+		key, err := config.ParseKey(k)
+		if err != nil {
+			return nil, err
+		}
+		secretKeys = append(secretKeys, key)
+	}
+
+	if err := p.provider.Configure(ctx, NewProviderConfig(inputs, secretKeys)); err != nil {
+		return nil, err
+	}
+
+	schemaVersion, err := p.provider.SchemaVersion(ctx)
+	if err != nil {
 		return nil, err
 	}
 
 	p.keepSecrets = req.GetAcceptSecrets()
 	p.keepResources = req.GetAcceptResources()
-	return &pulumirpc.ConfigureResponse{AcceptSecrets: true, SupportsPreview: true, AcceptResources: true}, nil
+	return &pulumirpc.ConfigureResponse{
+		AcceptSecrets:   true,
+		SupportsPreview: true,
+		AcceptResources: true,
+		SchemaVersion:   int32(schemaVersion),
+	}, nil
+}
+
+func (p *providerServer) ValidateProvider(ctx context.Context, req *pbempty.Empty) (*pbempty.Empty, error) {
+	if err := p.provider.Validate(ctx); err != nil {
+		return nil, p.checkNYI("ValidateProvider", err)
+	}
+	return &pbempty.Empty{}, nil
 }
 
 func (p *providerServer) Check(ctx context.Context, req *pulumirpc.CheckRequest) (*pulumirpc.CheckResponse, error) {
 	urn := resource.URN(req.GetUrn())
 
-	state, err := UnmarshalProperties(req.GetOlds(), p.unmarshalOptions("state"))
+	state, err := p.unmarshalProperties(req.GetOlds(), req.GetBinaryOlds(), "state")
 	if err != nil {
 		return nil, err
 	}
 
-	inputs, err := UnmarshalProperties(req.GetNews(), p.unmarshalOptions("inputs"))
+	inputs, err := p.unmarshalProperties(req.GetNews(), req.GetBinaryNews(), "inputs")
 	if err != nil {
 		return nil, err
 	}
 
-	newInputs, failures, err := p.provider.Check(urn, state, inputs, true, req.RandomSeed)
+	ctx, diags := collectDiagnostics(ctx)
+	newInputs, failures, err := p.provider.Check(ctx, urn, state, inputs, true, req.RandomSeed)
 	if err != nil {
 		return nil, err
 	}
 
-	rpcInputs, err := MarshalProperties(newInputs, p.marshalOptions("newInputs"))
+	rpcInputs, binaryInputs, err := p.marshalProperties(newInputs, len(req.GetBinaryNews()) != 0, "newInputs")
 	if err != nil {
 		return nil, err
 	}
 
-	rpcFailures := make([]*pulumirpc.CheckFailure, len(failures))
-	for i, f := range failures {
-		rpcFailures[i] = &pulumirpc.CheckFailure{Property: string(f.Property), Reason: f.Reason}
-	}
+	rpcFailures := MarshalCheckFailures(failures)
 
-	return &pulumirpc.CheckResponse{Inputs: rpcInputs, Failures: rpcFailures}, nil
+	return &pulumirpc.CheckResponse{
+		Inputs:       rpcInputs,
+		BinaryInputs: binaryInputs,
+		Failures:     rpcFailures,
+		Diagnostics:  diags.marshal(),
+	}, nil
 }
 
 func (p *providerServer) Diff(ctx context.Context, req *pulumirpc.DiffRequest) (*pulumirpc.DiffResponse, error) {
@@ -297,37 +486,82 @@ func (p *providerServer) Diff(ctx context.Context, req *pulumirpc.DiffRequest) (
 		return nil, err
 	}
 
-	diff, err := p.provider.Diff(urn, id, state, inputs, true, req.GetIgnoreChanges())
+	ctx, diags := collectDiagnostics(ctx)
+	diff, err := p.provider.Diff(ctx, urn, id, state, inputs, true, req.GetIgnoreChanges())
 	if err != nil {
 		return nil, err
 	}
-	return p.marshalDiff(diff)
+
+	resp, err := p.marshalDiff(diff)
+	if err != nil {
+		return nil, err
+	}
+	resp.Diagnostics = diags.marshal()
+	return resp, nil
 }
 
 func (p *providerServer) Create(ctx context.Context, req *pulumirpc.CreateRequest) (*pulumirpc.CreateResponse, error) {
 	urn := resource.URN(req.GetUrn())
 
-	inputs, err := UnmarshalProperties(req.GetProperties(), p.unmarshalOptions("inputs"))
+	inputs, err := p.unmarshalProperties(req.GetProperties(), req.GetBinaryProperties(), "inputs")
 	if err != nil {
 		return nil, err
 	}
 
-	id, state, _, err := p.provider.Create(urn, inputs, req.GetTimeout(), req.GetPreview())
+	ctx, diags := collectDiagnostics(ctx)
+	id, state, _, err := p.provider.Create(ctx, urn, inputs, req.GetTimeout(), req.GetPreview())
 	if err != nil {
 		return nil, err
 	}
 
-	rpcState, err := MarshalProperties(state, p.marshalOptions("newState"))
+	rpcState, binaryState, err := p.marshalProperties(state, len(req.GetBinaryProperties()) != 0, "newState")
 	if err != nil {
 		return nil, err
 	}
 
 	return &pulumirpc.CreateResponse{
-		Id:         string(id),
-		Properties: rpcState,
+		Id:               string(id),
+		Properties:       rpcState,
+		BinaryProperties: binaryState,
+		Diagnostics:      diags.marshal(),
 	}, nil
 }
 
+func (p *providerServer) StreamCreate(req *pulumirpc.CreateRequest,
+	server pulumirpc.ResourceProvider_StreamCreateServer) error {
+
+	urn := resource.URN(req.GetUrn())
+	binary := len(req.GetBinaryProperties()) != 0
+
+	inputs, err := p.unmarshalProperties(req.GetProperties(), req.GetBinaryProperties(), "inputs")
+	if err != nil {
+		return err
+	}
+
+	id, state, _, err := p.provider.StreamCreate(server.Context(), urn, inputs, req.GetTimeout(), req.GetPreview(),
+		func(state resource.PropertyMap) error {
+			rpcState, binaryState, err := p.marshalProperties(state, binary, "interimState")
+			if err != nil {
+				return err
+			}
+			return server.Send(&pulumirpc.CreateResponse{Properties: rpcState, BinaryProperties: binaryState})
+		})
+	if err != nil {
+		return p.checkNYI("StreamCreate", err)
+	}
+
+	rpcState, binaryState, err := p.marshalProperties(state, binary, "newState")
+	if err != nil {
+		return err
+	}
+
+	return server.Send(&pulumirpc.CreateResponse{
+		Id:               string(id),
+		Properties:       rpcState,
+		BinaryProperties: binaryState,
+	})
+}
+
 func (p *providerServer) Read(ctx context.Context, req *pulumirpc.ReadRequest) (*pulumirpc.ReadResponse, error) {
 	urn, id := resource.URN(req.GetUrn()), resource.ID(req.GetId())
 
@@ -341,7 +575,71 @@ func (p *providerServer) Read(ctx context.Context, req *pulumirpc.ReadRequest) (
 		return nil, err
 	}
 
-	result, _, err := p.provider.Read(urn, id, inputs, state)
+	ctx, diags := collectDiagnostics(ctx)
+	result, _, err := p.provider.Read(ctx, urn, id, inputs, state)
+	if errors.Is(err, ErrResourceNotFound) {
+		result, err = ReadResult{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// A missing resource is reported with an empty ID.
+	if result.Outputs == nil {
+		return &pulumirpc.ReadResponse{Diagnostics: diags.marshal()}, nil
+	}
+
+	rpcState, err := MarshalProperties(result.Outputs, p.marshalOptions("newState"))
+	if err != nil {
+		return nil, err
+	}
+
+	rpcInputs, err := MarshalProperties(result.Inputs, p.marshalOptions("newInputs"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pulumirpc.ReadResponse{
+		Id:          string(id),
+		Properties:  rpcState,
+		Inputs:      rpcInputs,
+		Diagnostics: diags.marshal(),
+	}, nil
+}
+
+func (p *providerServer) PrepareImport(ctx context.Context,
+	req *pulumirpc.PrepareImportRequest) (*pulumirpc.PrepareImportResponse, error) {
+
+	urn, id := resource.URN(req.GetUrn()), resource.ID(req.GetId())
+
+	inputs, err := p.provider.PrepareImport(ctx, urn, id)
+	if err != nil {
+		return nil, p.checkNYI("PrepareImport", err)
+	}
+
+	rpcInputs, err := MarshalProperties(inputs, p.marshalOptions("inputs"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pulumirpc.PrepareImportResponse{Inputs: rpcInputs}, nil
+}
+
+func (p *providerServer) Refresh(ctx context.Context, req *pulumirpc.ReadRequest) (*pulumirpc.ReadResponse, error) {
+	urn, id := resource.URN(req.GetUrn()), resource.ID(req.GetId())
+
+	state, err := UnmarshalProperties(req.GetProperties(), p.unmarshalOptions("state"))
+	if err != nil {
+		return nil, err
+	}
+
+	inputs, err := UnmarshalProperties(req.GetInputs(), p.unmarshalOptions("inputs"))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, diags := collectDiagnostics(ctx)
+	result, _, err := p.provider.Refresh(ctx, urn, id, inputs, state)
 	if err != nil {
 		return nil, err
 	}
@@ -357,37 +655,196 @@ func (p *providerServer) Read(ctx context.Context, req *pulumirpc.ReadRequest) (
 	}
 
 	return &pulumirpc.ReadResponse{
-		Id:         string(id),
-		Properties: rpcState,
-		Inputs:     rpcInputs,
+		Id:          string(id),
+		Properties:  rpcState,
+		Inputs:      rpcInputs,
+		Diagnostics: diags.marshal(),
+	}, nil
+}
+
+func (p *providerServer) MigrateState(ctx context.Context,
+	req *pulumirpc.MigrateStateRequest) (*pulumirpc.MigrateStateResponse, error) {
+
+	urn := resource.URN(req.GetUrn())
+
+	state, err := UnmarshalProperties(req.GetState(), p.unmarshalOptions("state"))
+	if err != nil {
+		return nil, err
+	}
+
+	migrated, err := p.provider.MigrateState(ctx, urn, int(req.GetStateVersion()), state)
+	if err != nil {
+		return nil, p.checkNYI("MigrateState", err)
+	}
+
+	rpcState, err := MarshalProperties(migrated, p.marshalOptions("newState"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pulumirpc.MigrateStateResponse{State: rpcState}, nil
+}
+
+func (p *providerServer) GetResourceAliases(ctx context.Context,
+	req *pulumirpc.GetResourceAliasesRequest) (*pulumirpc.GetResourceAliasesResponse, error) {
+
+	urn := resource.URN(req.GetUrn())
+
+	aliases, err := p.provider.GetResourceAliases(ctx, urn)
+	if err != nil {
+		return nil, p.checkNYI("GetResourceAliases", err)
+	}
+
+	// Aliases are sent as URNs, so resolve any fields that default to those of the resource here.
+	urns := make([]string, len(aliases))
+	for i, alias := range aliases {
+		urns[i] = string(ResolveResourceAlias(urn, alias))
+	}
+	return &pulumirpc.GetResourceAliasesResponse{Aliases: urns}, nil
+}
+
+func (p *providerServer) EstimateCost(ctx context.Context,
+	req *pulumirpc.EstimateCostRequest) (*pulumirpc.EstimateCostResponse, error) {
+
+	urn := resource.URN(req.GetUrn())
+
+	news, err := UnmarshalProperties(req.GetNews(), p.unmarshalOptions("news"))
+	if err != nil {
+		return nil, err
+	}
+
+	estimate, err := p.provider.EstimateCost(ctx, urn, news)
+	if err != nil {
+		return nil, p.checkNYI("EstimateCost", err)
+	}
+
+	return &pulumirpc.EstimateCostResponse{
+		MonthlyCostUSD: estimate.MonthlyCostUSD,
+		Currency:       estimate.Currency,
+		Confidence:     estimate.Confidence,
 	}, nil
 }
 
+func (p *providerServer) WatchResourceChanges(_ *pbempty.Empty,
+	server pulumirpc.ResourceProvider_WatchResourceChangesServer) error {
+
+	emitter := &streamEventEmitter{server: server}
+	if err := p.provider.RegisterEventEmitter(emitter); err != nil {
+		return p.checkNYI("WatchResourceChanges", err)
+	}
+
+	// Keep the stream open until the caller cancels it.
+	<-server.Context().Done()
+	return nil
+}
+
+// streamEventEmitter is an EventEmitter that sends events to the caller of WatchResourceChanges.
+type streamEventEmitter struct {
+	m      sync.Mutex
+	server pulumirpc.ResourceProvider_WatchResourceChangesServer
+}
+
+func (e *streamEventEmitter) EmitResourceChanged(urn resource.URN, id resource.ID) error {
+	if err := e.server.Context().Err(); err != nil {
+		return err
+	}
+
+	// gRPC streams may not be sent to concurrently.
+	e.m.Lock()
+	defer e.m.Unlock()
+	return e.server.Send(&pulumirpc.ResourceChangedEvent{Urn: string(urn), Id: string(id)})
+}
+
+func (p *providerServer) WatchConfig(_ *pbempty.Empty, server pulumirpc.ResourceProvider_WatchConfigServer) error {
+	// gRPC streams may not be sent to concurrently.
+	var m sync.Mutex
+	onChange := func(changes resource.PropertyMap) {
+		config, err := MarshalProperties(changes, p.marshalOptions("config"))
+		if err != nil {
+			logging.V(7).Infof("WatchConfig: failed to marshal changed configuration: %v", err)
+			return
+		}
+
+		m.Lock()
+		defer m.Unlock()
+		if err := server.Send(&pulumirpc.ConfigChangedEvent{Config: config}); err != nil {
+			logging.V(7).Infof("WatchConfig: failed to send changed configuration: %v", err)
+		}
+	}
+	if err := p.provider.WatchConfig(server.Context(), onChange); err != nil {
+		return p.checkNYI("WatchConfig", err)
+	}
+
+	// Keep the stream open until the caller cancels it.
+	<-server.Context().Done()
+	return nil
+}
+
+func (p *providerServer) ReadStream(req *pulumirpc.ReadRequest,
+	server pulumirpc.ResourceProvider_ReadStreamServer) error {
+
+	urn, id := resource.URN(req.GetUrn()), resource.ID(req.GetId())
+
+	state, err := UnmarshalProperties(req.GetProperties(), p.unmarshalOptions("state"))
+	if err != nil {
+		return err
+	}
+
+	inputs, err := UnmarshalProperties(req.GetInputs(), p.unmarshalOptions("inputs"))
+	if err != nil {
+		return err
+	}
+
+	_, err = p.provider.ReadStream(server.Context(), urn, id, inputs, state, func(result ReadResult) error {
+		rpcState, err := MarshalProperties(result.Outputs, p.marshalOptions("newState"))
+		if err != nil {
+			return err
+		}
+
+		rpcInputs, err := MarshalProperties(result.Inputs, p.marshalOptions("newInputs"))
+		if err != nil {
+			return err
+		}
+
+		return server.Send(&pulumirpc.ReadResponse{
+			Id:         string(id),
+			Properties: rpcState,
+			Inputs:     rpcInputs,
+		})
+	})
+	return err
+}
+
 func (p *providerServer) Update(ctx context.Context, req *pulumirpc.UpdateRequest) (*pulumirpc.UpdateResponse, error) {
 	urn, id := resource.URN(req.GetUrn()), resource.ID(req.GetId())
 
-	state, err := UnmarshalProperties(req.GetOlds(), p.unmarshalOptions("state"))
+	state, err := p.unmarshalProperties(req.GetOlds(), req.GetBinaryOlds(), "state")
 	if err != nil {
 		return nil, err
 	}
 
-	inputs, err := UnmarshalProperties(req.GetNews(), p.unmarshalOptions("inputs"))
+	inputs, err := p.unmarshalProperties(req.GetNews(), req.GetBinaryNews(), "inputs")
 	if err != nil {
 		return nil, err
 	}
 
-	newState, _, err := p.provider.Update(urn, id, state, inputs, req.GetTimeout(), req.GetIgnoreChanges(),
+	ctx, diags := collectDiagnostics(ctx)
+	newState, _, err := p.provider.Update(ctx, urn, id, state, inputs, req.GetTimeout(), req.GetIgnoreChanges(),
 		req.GetPreview())
 	if err != nil {
 		return nil, err
 	}
 
-	rpcState, err := MarshalProperties(newState, p.marshalOptions("newState"))
+	rpcState, binaryState, err := p.marshalProperties(newState, len(req.GetBinaryNews()) != 0, "newState")
 	if err != nil {
 		return nil, err
 	}
 
-	return &pulumirpc.UpdateResponse{Properties: rpcState}, nil
+	return &pulumirpc.UpdateResponse{
+		Properties:       rpcState,
+		BinaryProperties: binaryState,
+		Diagnostics:      diags.marshal(),
+	}, nil
 }
 
 func (p *providerServer) Delete(ctx context.Context, req *pulumirpc.DeleteRequest) (*pbempty.Empty, error) {
@@ -398,7 +855,7 @@ func (p *providerServer) Delete(ctx context.Context, req *pulumirpc.DeleteReques
 		return nil, err
 	}
 
-	if _, err = p.provider.Delete(urn, id, state, req.GetTimeout()); err != nil {
+	if _, err = p.provider.Delete(ctx, urn, id, state, req.GetTimeout()); err != nil {
 		return nil, err
 	}
 
@@ -409,6 +866,9 @@ func (p *providerServer) Construct(ctx context.Context,
 	req *pulumirpc.ConstructRequest) (*pulumirpc.ConstructResponse, error) {
 
 	typ, name, parent := tokens.Type(req.GetType()), tokens.QName(req.GetName()), resource.URN(req.GetParent())
+	if err := tokens.ValidateType(typ); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
 	inputs, err := UnmarshalProperties(req.GetInputs(), p.unmarshalOptions("inputs"))
 	if err != nil {
@@ -441,6 +901,11 @@ func (p *providerServer) Construct(ctx context.Context,
 		DryRun:           req.GetDryRun(),
 		Parallel:         int(req.GetParallel()),
 		MonitorAddress:   req.GetMonitorEndpoint(),
+		SDKVersion:       req.GetSdkVersion(),
+		EngineVersion:    req.GetEngineVersion(),
+		TraceID:          req.GetTraceId(),
+		SpanID:           req.GetSpanId(),
+		Namespace:        req.GetNamespace(),
 	}
 
 	aliases := make([]resource.Alias, len(req.GetAliases()))
@@ -459,15 +924,21 @@ func (p *providerServer) Construct(ctx context.Context,
 		}
 		propertyDependencies[resource.PropertyKey(name)] = urns
 	}
+	timeouts, err := unmarshalOperationTimeouts(req.GetCustomTimeouts())
+	if err != nil {
+		return nil, err
+	}
 	options := ConstructOptions{
 		Aliases:              aliases,
 		Dependencies:         dependencies,
 		Protect:              req.GetProtect(),
 		Providers:            req.GetProviders(),
 		PropertyDependencies: propertyDependencies,
+		Timeouts:             timeouts,
+		IgnoreChanges:        req.GetIgnoreChanges(),
 	}
 
-	result, err := p.provider.Construct(info, typ, name, parent, inputs, options)
+	result, err := p.provider.Construct(ctx, info, typ, name, parent, inputs, options)
 	if err != nil {
 		return nil, err
 	}
@@ -477,29 +948,88 @@ func (p *providerServer) Construct(ctx context.Context,
 		return nil, err
 	}
 
-	outputDependencies := map[string]*pulumirpc.ConstructResponse_PropertyDependencies{}
-	for name, deps := range result.OutputDependencies {
-		urns := make([]string, len(deps))
-		for i, urn := range deps {
-			urns[i] = string(urn)
+	var normalizedInputs *structpb.Struct
+	if result.Inputs != nil {
+		normalizedInputs, err = MarshalProperties(result.Inputs, p.marshalOptions("inputs"))
+		if err != nil {
+			return nil, err
 		}
-		outputDependencies[string(name)] = &pulumirpc.ConstructResponse_PropertyDependencies{Urns: urns}
+	}
+
+	var childResources []string
+	for _, child := range result.ChildResources {
+		childResources = append(childResources, string(child))
 	}
 
 	return &pulumirpc.ConstructResponse{
 		Urn:               string(result.URN),
 		State:             outputs,
-		StateDependencies: outputDependencies,
+		StateDependencies: marshalConstructDependencies(result.OutputDependencies),
+		Inputs:            normalizedInputs,
+		InputDependencies: marshalConstructDependencies(result.InputDependencies),
+		ChildResources:    childResources,
 	}, nil
 }
 
+// marshalConstructDependencies converts a map from property keys to URNs into the property dependencies returned by
+// Construct.
+func marshalConstructDependencies(
+	deps map[resource.PropertyKey][]resource.URN) map[string]*pulumirpc.ConstructResponse_PropertyDependencies {
+
+	result := map[string]*pulumirpc.ConstructResponse_PropertyDependencies{}
+	for name, urns := range deps {
+		rpcURNs := make([]string, len(urns))
+		for i, urn := range urns {
+			rpcURNs[i] = string(urn)
+		}
+		result[string(name)] = &pulumirpc.ConstructResponse_PropertyDependencies{Urns: rpcURNs}
+	}
+	return result
+}
+
+// unmarshalOperationTimeouts converts the duration strings sent with a Construct request into timeouts in seconds.
+func unmarshalOperationTimeouts(timeouts *pulumirpc.ConstructRequest_CustomTimeouts) (OperationTimeouts, error) {
+	if timeouts == nil {
+		return OperationTimeouts{}, nil
+	}
+
+	parse := func(op, timeout string) (float64, error) {
+		if timeout == "" {
+			return 0, nil
+		}
+		duration, err := time.ParseDuration(timeout)
+		if err != nil {
+			return 0, fmt.Errorf("invalid %v timeout %q: %w", op, timeout, err)
+		}
+		return duration.Seconds(), nil
+	}
+
+	var result OperationTimeouts
+	var err error
+	if result.Create, err = parse("create", timeouts.GetCreate()); err != nil {
+		return OperationTimeouts{}, err
+	}
+	if result.Update, err = parse("update", timeouts.GetUpdate()); err != nil {
+		return OperationTimeouts{}, err
+	}
+	if result.Delete, err = parse("delete", timeouts.GetDelete()); err != nil {
+		return OperationTimeouts{}, err
+	}
+	return result, nil
+}
+
 func (p *providerServer) Invoke(ctx context.Context, req *pulumirpc.InvokeRequest) (*pulumirpc.InvokeResponse, error) {
+	tok := tokens.ModuleMember(req.GetTok())
+	if err := tokens.ValidateModuleMember(tok); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	args, err := UnmarshalProperties(req.GetArgs(), p.unmarshalOptions("args"))
 	if err != nil {
 		return nil, err
 	}
 
-	result, failures, err := p.provider.Invoke(tokens.ModuleMember(req.GetTok()), args)
+	result, failures, err := p.provider.Invoke(ctx, tok, args)
 	if err != nil {
 		return nil, err
 	}
@@ -509,10 +1039,7 @@ func (p *providerServer) Invoke(ctx context.Context, req *pulumirpc.InvokeReques
 		return nil, err
 	}
 
-	rpcFailures := make([]*pulumirpc.CheckFailure, len(failures))
-	for i, f := range failures {
-		rpcFailures[i] = &pulumirpc.CheckFailure{Property: string(f.Property), Reason: f.Reason}
-	}
+	rpcFailures := MarshalCheckFailures(failures)
 
 	return &pulumirpc.InvokeResponse{
 		Return:   rpcResult,
@@ -523,19 +1050,28 @@ func (p *providerServer) Invoke(ctx context.Context, req *pulumirpc.InvokeReques
 func (p *providerServer) StreamInvoke(req *pulumirpc.InvokeRequest,
 	server pulumirpc.ResourceProvider_StreamInvokeServer) error {
 
+	tok := tokens.ModuleMember(req.GetTok())
+	if err := tokens.ValidateModuleMember(tok); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	args, err := UnmarshalProperties(req.GetArgs(), p.unmarshalOptions("args"))
 	if err != nil {
 		return err
 	}
 
-	failures, err := p.provider.StreamInvoke(tokens.ModuleMember(req.GetTok()), args,
-		func(item resource.PropertyMap) error {
-			rpcItem, err := MarshalProperties(item, p.marshalOptions("item"))
+	failures, err := p.provider.StreamInvoke(server.Context(), tok, args,
+		func(item StreamInvokeEvent) error {
+			rpcItem, err := MarshalProperties(item.Payload, p.marshalOptions("item"))
 			if err != nil {
 				return err
 			}
 
-			return server.Send(&pulumirpc.InvokeResponse{Return: rpcItem})
+			resp := &pulumirpc.InvokeResponse{Return: rpcItem}
+			if item.PageNumber != 0 {
+				resp.PageNumber, resp.IsLastPage = int32(item.PageNumber), item.IsLastPage
+			}
+			return server.Send(resp)
 		})
 	if err != nil {
 		return err
@@ -544,15 +1080,17 @@ func (p *providerServer) StreamInvoke(req *pulumirpc.InvokeRequest,
 		return nil
 	}
 
-	rpcFailures := make([]*pulumirpc.CheckFailure, len(failures))
-	for i, f := range failures {
-		rpcFailures[i] = &pulumirpc.CheckFailure{Property: string(f.Property), Reason: f.Reason}
-	}
+	rpcFailures := MarshalCheckFailures(failures)
 
 	return server.Send(&pulumirpc.InvokeResponse{Failures: rpcFailures})
 }
 
 func (p *providerServer) Call(ctx context.Context, req *pulumirpc.CallRequest) (*pulumirpc.CallResponse, error) {
+	tok := tokens.ModuleMember(req.GetTok())
+	if err := tokens.ValidateModuleMember(tok); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	args, err := UnmarshalProperties(req.GetArgs(), p.unmarshalOptions("args"))
 	if err != nil {
 		return nil, err
@@ -573,6 +1111,8 @@ func (p *providerServer) Call(ctx context.Context, req *pulumirpc.CallRequest) (
 		DryRun:         req.GetDryRun(),
 		Parallel:       int(req.GetParallel()),
 		MonitorAddress: req.GetMonitorEndpoint(),
+		TraceID:        req.GetTraceId(),
+		SpanID:         req.GetSpanId(),
 	}
 	argDependencies := map[resource.PropertyKey][]resource.URN{}
 	for name, deps := range req.GetArgDependencies() {
@@ -584,11 +1124,13 @@ func (p *providerServer) Call(ctx context.Context, req *pulumirpc.CallRequest) (
 	}
 	options := CallOptions{
 		ArgDependencies: argDependencies,
+		Timeout:         req.GetTimeout(),
 	}
 
-	result, err := p.provider.Call(tokens.ModuleMember(req.GetTok()), args, info, options)
-	if err != nil {
-		return nil, err
+	// A partially failed call still returns the values that it computed.
+	result, callErr := p.provider.Call(ctx, tok, args, info, options)
+	if callErr != nil && result.Status != resource.StatusPartialFailure {
+		return nil, callErr
 	}
 
 	rpcResult, err := MarshalProperties(result.Return, MarshalOptions{
@@ -610,14 +1152,17 @@ func (p *providerServer) Call(ctx context.Context, req *pulumirpc.CallRequest) (
 		returnDependencies[string(name)] = &pulumirpc.CallResponse_ReturnDependencies{Urns: urns}
 	}
 
-	rpcFailures := make([]*pulumirpc.CheckFailure, len(result.Failures))
-	for i, f := range result.Failures {
-		rpcFailures[i] = &pulumirpc.CheckFailure{Property: string(f.Property), Reason: f.Reason}
-	}
+	rpcFailures := MarshalCheckFailures(result.Failures)
 
-	return &pulumirpc.CallResponse{
+	resp := &pulumirpc.CallResponse{
 		Return:             rpcResult,
 		ReturnDependencies: returnDependencies,
 		Failures:           rpcFailures,
-	}, nil
+		Diagnostics:        MarshalProviderDiagnostics(result.Diagnostics),
+	}
+	if callErr != nil {
+		resp.PartialFailure = true
+		resp.PartialFailureReason = callErr.Error()
+	}
+	return resp, nil
 }
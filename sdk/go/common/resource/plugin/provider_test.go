@@ -15,11 +15,15 @@
 package plugin
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewDetailedDiff(t *testing.T) {
@@ -128,3 +132,605 @@ func TestNewDetailedDiff(t *testing.T) {
 		})
 	}
 }
+
+func TestNewDetailedDiffFromObjectDiffMaxDepth(t *testing.T) {
+	t.Parallel()
+
+	nest := func(depth int, leaf interface{}) resource.PropertyMap {
+		v := leaf
+		for i := 0; i < depth; i++ {
+			v = map[string]interface{}{"a": v}
+		}
+		return resource.NewPropertyMapFromMap(v.(map[string]interface{}))
+	}
+
+	olds, news := nest(1000, "old"), nest(1000, "new")
+	diff := olds.Diff(news)
+
+	// Deeply nested changes are truncated at the default depth.
+	actual := NewDetailedDiffFromObjectDiff(diff)
+	require.Len(t, actual, 1)
+	for k, d := range actual {
+		assert.Equal(t, DefaultMaxDetailedDiffDepth, detailedDiffKeyDepth(k))
+		assert.Equal(t, DiffUpdate, d.Kind)
+		assert.Equal(t, "changes nested more than 128 levels deep are not shown", d.Reason)
+	}
+
+	actual = NewDetailedDiffFromObjectDiff(diff, WithMaxDetailedDiffDepth(2))
+	assert.Equal(t, map[string]PropertyDiff{
+		"a.a": {Kind: DiffUpdate, Reason: "changes nested more than 2 levels deep are not shown"},
+	}, actual)
+
+	// Changes at or above the limit are reported as usual.
+	olds, news = nest(2, "old"), nest(2, "new")
+	assert.Equal(t, map[string]PropertyDiff{"a.a": {Kind: DiffUpdate}},
+		NewDetailedDiffFromObjectDiff(olds.Diff(news), WithMaxDetailedDiffDepth(2)))
+
+	// A limit of zero disables truncation.
+	actual = NewDetailedDiffFromObjectDiff(diff, WithMaxDetailedDiffDepth(0))
+	require.Len(t, actual, 1)
+	for k, d := range actual {
+		assert.Equal(t, 1000, detailedDiffKeyDepth(k))
+		assert.Equal(t, PropertyDiff{Kind: DiffUpdate}, d)
+	}
+}
+
+func TestNewObjectDiffFromDetailedDiff(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name       string
+		olds, news map[string]interface{}
+	}{
+		{
+			name: "updates",
+			olds: map[string]interface{}{"a": 1, "b": map[string]interface{}{"c": 2, "d": 3}, "e": "same"},
+			news: map[string]interface{}{"a": -1, "b": map[string]interface{}{"c": -2, "d": 3}, "e": "same"},
+		},
+		{
+			name: "adds and deletes",
+			olds: map[string]interface{}{"b": map[string]interface{}{"c": 2, "d": 3}, "e": true},
+			news: map[string]interface{}{"a": 1, "b": map[string]interface{}{"d": 3}},
+		},
+		{
+			name: "arrays",
+			olds: map[string]interface{}{
+				"a": []interface{}{map[string]interface{}{"a": 1, "b": []interface{}{2, 3}}, "x"},
+			},
+			news: map[string]interface{}{
+				"a": []interface{}{map[string]interface{}{"a": -1, "b": []interface{}{2}}, "x", 4},
+			},
+		},
+		{
+			name: "type changes",
+			olds: map[string]interface{}{"a": map[string]interface{}{"b": 1}},
+			news: map[string]interface{}{"a": []interface{}{1}},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			olds := resource.NewPropertyMapFromMap(c.olds)
+			news := resource.NewPropertyMapFromMap(c.news)
+			expected := olds.Diff(news)
+
+			actual := NewObjectDiffFromDetailedDiff(NewDetailedDiffFromObjectDiff(expected), olds, news)
+			assert.Equal(t, expected, actual)
+		})
+	}
+
+	t.Run("best effort", func(t *testing.T) {
+		t.Parallel()
+
+		olds := resource.NewPropertyMapFromMap(map[string]interface{}{"a": "x", "b": "y"})
+		news := resource.NewPropertyMapFromMap(map[string]interface{}{"a": "z", "b": "y"})
+
+		assert.Nil(t, NewObjectDiffFromDetailedDiff(nil, olds, news))
+
+		// Unparseable paths are ignored, and paths that do not match the shape of the values update the value that
+		// they would have descended into.
+		actual := NewObjectDiffFromDetailedDiff(map[string]PropertyDiff{
+			"a[0]":   {Kind: DiffUpdate},
+			"[bad":   {Kind: DiffDelete},
+			"c.d[1]": {Kind: DiffAdd},
+		}, olds, news)
+		assert.Equal(t, resource.ValueDiff{Old: olds["a"], New: news["a"]}, actual.Updates["a"])
+		assert.Equal(t, resource.ValueDiff{Old: resource.PropertyValue{}, New: resource.PropertyValue{}},
+			actual.Updates["c"])
+		assert.Equal(t, resource.PropertyMap{"b": olds["b"]}, actual.Sames)
+		assert.Empty(t, actual.Deletes)
+	})
+}
+
+type readProvider struct {
+	Provider
+
+	readF func(urn resource.URN, id resource.ID) (ReadResult, resource.Status, error)
+}
+
+func (p *readProvider) Read(ctx context.Context, urn resource.URN, id resource.ID,
+	inputs, state resource.PropertyMap) (ReadResult, resource.Status, error) {
+	return p.readF(urn, id)
+}
+
+func (p *readProvider) Refresh(ctx context.Context, urn resource.URN, id resource.ID,
+	inputs, state resource.PropertyMap) (ReadResult, resource.Status, error) {
+	return p.readF(urn, id)
+}
+
+func TestSequentialBatchRead(t *testing.T) {
+	t.Parallel()
+
+	requests := []BatchReadRequest{
+		{URN: "urn:pulumi:test::test::pkgA:m:typA::resA", ID: "a"},
+		{URN: "urn:pulumi:test::test::pkgA:m:typA::resB", ID: "b"},
+		{URN: "urn:pulumi:test::test::pkgA:m:typA::resC", ID: "c"},
+	}
+
+	t.Run("in order", func(t *testing.T) {
+		t.Parallel()
+
+		readErr := errors.New("not found")
+		prov := &readProvider{readF: func(urn resource.URN, id resource.ID) (ReadResult, resource.Status, error) {
+			if id == "b" {
+				return ReadResult{}, resource.StatusUnknown, readErr
+			}
+			return ReadResult{ID: id}, resource.StatusOK, nil
+		}}
+
+		responses, err := SequentialBatchRead(context.Background(), prov, requests)
+		assert.NoError(t, err)
+		assert.Equal(t, []BatchReadResponse{
+			{ReadResult: ReadResult{ID: "a"}, Status: resource.StatusOK},
+			{Status: resource.StatusUnknown, Error: readErr},
+			{ReadResult: ReadResult{ID: "c"}, Status: resource.StatusOK},
+		}, responses)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		prov := &readProvider{readF: func(urn resource.URN, id resource.ID) (ReadResult, resource.Status, error) {
+			cancel()
+			return ReadResult{ID: id}, resource.StatusOK, nil
+		}}
+
+		responses, err := SequentialBatchRead(ctx, prov, requests)
+		assert.NoError(t, err)
+		assert.Equal(t, []BatchReadResponse{
+			{ReadResult: ReadResult{ID: "a"}, Status: resource.StatusOK},
+			{Status: resource.StatusOK, Error: context.Canceled},
+			{Status: resource.StatusOK, Error: context.Canceled},
+		}, responses)
+	})
+}
+
+func TestDiffResultReplacement(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		diff     DiffResult
+		detailed bool
+		legacy   bool
+	}{
+		{
+			name: "no replacement",
+			diff: DiffResult{
+				Changes:      DiffSome,
+				DetailedDiff: map[string]PropertyDiff{"a": {Kind: DiffUpdate}},
+			},
+		},
+		{
+			name: "detailed replacement",
+			diff: DiffResult{
+				Changes:      DiffSome,
+				DetailedDiff: map[string]PropertyDiff{"a": {Kind: DiffUpdateReplace}},
+			},
+			detailed: true,
+		},
+		{
+			name: "legacy replacement",
+			diff: DiffResult{
+				Changes:     DiffSome,
+				ReplaceKeys: []resource.PropertyKey{"a"},
+			},
+			legacy: true,
+		},
+		{
+			name: "both",
+			diff: DiffResult{
+				Changes:      DiffSome,
+				ReplaceKeys:  []resource.PropertyKey{"a"},
+				DetailedDiff: map[string]PropertyDiff{"a": {Kind: DiffAddReplace}},
+			},
+			detailed: true,
+			legacy:   true,
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, c.detailed, c.diff.HasDetailedReplacement())
+			assert.Equal(t, c.legacy, c.diff.HasLegacyReplacement())
+			assert.Equal(t, c.detailed || c.legacy, c.diff.RequiresReplacement())
+			assert.Equal(t, c.diff.RequiresReplacement(), c.diff.Replace())
+		})
+	}
+}
+
+func TestDiffResultStableAndChangedProperties(t *testing.T) {
+	t.Parallel()
+
+	old := resource.NewPropertyMapFromMap(map[string]interface{}{
+		"a": "foo",
+		"b": 42,
+		"c": true,
+	})
+
+	// "b" is listed as both stable and changed, and "d" does not exist in the old state.
+	diff := DiffResult{
+		StableKeys:  []resource.PropertyKey{"a", "b", "d"},
+		ChangedKeys: []resource.PropertyKey{"b", "c"},
+	}
+
+	assert.Equal(t, resource.NewPropertyMapFromMap(map[string]interface{}{
+		"a": "foo",
+		"b": 42,
+	}), diff.StableProperties(old))
+	assert.Equal(t, resource.NewPropertyMapFromMap(map[string]interface{}{
+		"b": 42,
+		"c": true,
+	}), diff.ChangedProperties(old))
+
+	// An empty diff filters out everything.
+	assert.Empty(t, DiffResult{}.StableProperties(old))
+	assert.Empty(t, DiffResult{}.ChangedProperties(old))
+}
+
+func TestDiffResultSummary(t *testing.T) {
+	t.Parallel()
+
+	keys := func(ks ...resource.PropertyKey) []resource.PropertyKey { return ks }
+
+	cases := []struct {
+		name    string
+		diff    DiffResult
+		summary string
+		verbose string
+	}{
+		{
+			name:    "no changes",
+			diff:    DiffResult{Changes: DiffNone},
+			summary: "no changes",
+			verbose: "no changes",
+		},
+		{
+			name:    "unknown",
+			diff:    DiffResult{Changes: DiffUnknown},
+			summary: "changes unknown",
+			verbose: "changes unknown",
+		},
+		{
+			name:    "changes without keys",
+			diff:    DiffResult{Changes: DiffSome},
+			summary: "changes detected",
+			verbose: "changes detected",
+		},
+		{
+			name:    "one change",
+			diff:    DiffResult{Changes: DiffSome, ChangedKeys: keys("a")},
+			summary: "1 property changed",
+			verbose: "1 property changed (changed: a)",
+		},
+		{
+			name:    "several changes",
+			diff:    DiffResult{Changes: DiffSome, ChangedKeys: keys("a", "b", "c")},
+			summary: "3 properties changed",
+			verbose: "3 properties changed (changed: a, b, c)",
+		},
+		{
+			name:    "one replacement",
+			diff:    DiffResult{Changes: DiffSome, ChangedKeys: keys("a", "b", "c"), ReplaceKeys: keys("b")},
+			summary: "3 properties changed, 1 requires replacement",
+			verbose: "3 properties changed, 1 requires replacement (changed: a, b, c; replace: b)",
+		},
+		{
+			name:    "several replacements",
+			diff:    DiffResult{Changes: DiffSome, ChangedKeys: keys("a", "b"), ReplaceKeys: keys("a", "b")},
+			summary: "2 properties changed, 2 require replacement",
+			verbose: "2 properties changed, 2 require replacement (changed: a, b; replace: a, b)",
+		},
+		{
+			name: "delete before replace",
+			diff: DiffResult{
+				Changes:             DiffSome,
+				ChangedKeys:         keys("a"),
+				ReplaceKeys:         keys("a"),
+				DeleteBeforeReplace: true,
+			},
+			summary: "1 property changed, 1 requires replacement, delete before replace",
+			verbose: "1 property changed, 1 requires replacement, delete before replace (changed: a; replace: a)",
+		},
+		{
+			name:    "legacy replacement with unknown changes",
+			diff:    DiffResult{Changes: DiffUnknown, ReplaceKeys: keys("a")},
+			summary: "changes detected, 1 requires replacement",
+			verbose: "changes detected, 1 requires replacement (replace: a)",
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, c.summary, c.diff.Summary())
+			assert.Equal(t, c.verbose, c.diff.Verbose())
+		})
+	}
+}
+
+func TestDiffResultChangedKeyDetails(t *testing.T) {
+	t.Parallel()
+
+	// Without a detailed diff, every changed key is reported as an update.
+	legacy := DiffResult{ChangedKeys: []resource.PropertyKey{"b", "a"}}
+	assert.Equal(t, []PropertyChangeSummary{
+		{PropertyKey: "a", Kind: DiffUpdate},
+		{PropertyKey: "b", Kind: DiffUpdate},
+	}, legacy.ChangedKeyDetails())
+
+	// A detailed diff takes precedence and reports the kind and secretness of each change.
+	detailed := DiffResult{
+		ChangedKeys: []resource.PropertyKey{"a", "b", "c"},
+		DetailedDiff: map[string]PropertyDiff{
+			"a.b":      {Kind: DiffAdd},
+			"password": {Kind: DiffUpdateReplace, NewValue: resource.MakeSecret(resource.NewStringProperty("x"))},
+			"ignored":  {Kind: DiffDelete, Ignored: true},
+		},
+	}
+	assert.Equal(t, []PropertyChangeSummary{
+		{PropertyKey: "a.b", Kind: DiffAdd},
+		{PropertyKey: "password", Kind: DiffUpdateReplace, IsSecret: true},
+	}, detailed.ChangedKeyDetails())
+
+	assert.Empty(t, DiffResult{}.ChangedKeyDetails())
+}
+
+func TestMergeDetailedDiff(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		a, b     map[string]PropertyDiff
+		expected map[string]PropertyDiff
+	}{
+		{
+			name:     "both nil",
+			expected: nil,
+		},
+		{
+			name:     "disjoint",
+			a:        map[string]PropertyDiff{"a": {Kind: DiffAdd}},
+			b:        map[string]PropertyDiff{"b": {Kind: DiffDelete, InputDiff: true}},
+			expected: map[string]PropertyDiff{"a": {Kind: DiffAdd}, "b": {Kind: DiffDelete, InputDiff: true}},
+		},
+		{
+			name:     "replace beats update",
+			a:        map[string]PropertyDiff{"a": {Kind: DiffUpdate}},
+			b:        map[string]PropertyDiff{"a": {Kind: DiffUpdateReplace}},
+			expected: map[string]PropertyDiff{"a": {Kind: DiffUpdateReplace}},
+		},
+		{
+			name:     "add beats update",
+			a:        map[string]PropertyDiff{"a": {Kind: DiffAdd, InputDiff: true}},
+			b:        map[string]PropertyDiff{"a": {Kind: DiffUpdate}},
+			expected: map[string]PropertyDiff{"a": {Kind: DiffAdd, InputDiff: true}},
+		},
+		{
+			name:     "replace is kept when add beats update",
+			a:        map[string]PropertyDiff{"a": {Kind: DiffAdd}},
+			b:        map[string]PropertyDiff{"a": {Kind: DiffUpdateReplace}},
+			expected: map[string]PropertyDiff{"a": {Kind: DiffAddReplace}},
+		},
+		{
+			name:     "delete beats update",
+			a:        map[string]PropertyDiff{"a": {Kind: DiffUpdate}},
+			b:        map[string]PropertyDiff{"a": {Kind: DiffDelete}},
+			expected: map[string]PropertyDiff{"a": {Kind: DiffDelete}},
+		},
+		{
+			name:     "add and delete is an update",
+			a:        map[string]PropertyDiff{"a": {Kind: DiffAdd}},
+			b:        map[string]PropertyDiff{"a": {Kind: DiffDeleteReplace}},
+			expected: map[string]PropertyDiff{"a": {Kind: DiffUpdateReplace}},
+		},
+		{
+			name:     "reason is kept with replacement",
+			a:        map[string]PropertyDiff{"a": {Kind: DiffUpdateReplace, Reason: "cannot be updated in-place"}},
+			b:        map[string]PropertyDiff{"a": {Kind: DiffUpdate}},
+			expected: map[string]PropertyDiff{"a": {Kind: DiffUpdateReplace, Reason: "cannot be updated in-place"}},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, c.expected, MergeDetailedDiff(c.a, c.b))
+		})
+	}
+}
+
+func TestFilterDetailedDiff(t *testing.T) {
+	t.Parallel()
+
+	diff := map[string]PropertyDiff{
+		"a":         {Kind: DiffUpdate},
+		"b.c":       {Kind: DiffAdd},
+		"b.d":       {Kind: DiffDelete},
+		"e[0]":      {Kind: DiffUpdate},
+		"e[1].f":    {Kind: DiffUpdate},
+		"tags.Name": {Kind: DiffUpdateReplace},
+	}
+
+	actual := FilterDetailedDiff(diff, []string{"b.c", "e[*]", `tags["Name"]`})
+	assert.Equal(t, map[string]PropertyDiff{
+		"a":   {Kind: DiffUpdate},
+		"b.d": {Kind: DiffDelete},
+	}, actual)
+
+	// The input must not be modified.
+	assert.Len(t, diff, 6)
+
+	assert.Nil(t, FilterDetailedDiff(nil, []string{"a"}))
+	assert.Equal(t, diff, FilterDetailedDiff(diff, nil))
+}
+
+func TestMarkIgnoredDetailedDiff(t *testing.T) {
+	t.Parallel()
+
+	diff := map[string]PropertyDiff{
+		"a":         {Kind: DiffUpdate},
+		"b.c":       {Kind: DiffAdd},
+		"tags.Name": {Kind: DiffUpdateReplace},
+	}
+
+	actual := MarkIgnoredDetailedDiff(diff, []string{"b", `tags["Name"]`})
+	assert.Equal(t, map[string]PropertyDiff{
+		"a":         {Kind: DiffUpdate},
+		"b.c":       {Kind: DiffAdd, Ignored: true},
+		"tags.Name": {Kind: DiffUpdateReplace, Ignored: true},
+	}, actual)
+
+	// The input must not be modified.
+	assert.False(t, diff["b.c"].Ignored)
+
+	// Ignored changes do not require replacement.
+	assert.True(t, DiffResult{DetailedDiff: diff}.RequiresReplacement())
+	assert.False(t, DiffResult{DetailedDiff: actual}.RequiresReplacement())
+
+	assert.Nil(t, MarkIgnoredDetailedDiff(nil, []string{"a"}))
+}
+
+func TestDetailedDiffMetrics(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 0, DiffResult{}.DetailedDiffDepth())
+	assert.Equal(t, 0, DiffResult{}.DetailedDiffCount())
+
+	diff := DiffResult{DetailedDiff: map[string]PropertyDiff{
+		"a":              {Kind: DiffUpdate},
+		"b.c":            {Kind: DiffAdd},
+		"e[1].f":         {Kind: DiffUpdate},
+		`tags["a.b.c"]`:  {Kind: DiffUpdate},
+		"nested[0][1].g": {Kind: DiffDelete},
+	}}
+	assert.Equal(t, 4, diff.DetailedDiffDepth())
+	assert.Equal(t, 5, diff.DetailedDiffCount())
+
+	// Keys that are not valid property paths fall back to counting separators.
+	diff = DiffResult{DetailedDiff: map[string]PropertyDiff{"a.b[": {Kind: DiffUpdate}}}
+	assert.Equal(t, 3, diff.DetailedDiffDepth())
+}
+
+func TestPartialFailureError(t *testing.T) {
+	t.Parallel()
+
+	cause := errors.New("resource is not ready")
+	err := fmt.Errorf("creating resource: %w", &PartialFailureError{
+		ID:    "id",
+		Cause: cause,
+	})
+
+	assert.True(t, IsPartialFailure(err))
+	assert.True(t, errors.Is(err, cause))
+	assert.Equal(t, "creating resource: resource is not ready", err.Error())
+
+	assert.False(t, IsPartialFailure(cause))
+	assert.False(t, IsPartialFailure(nil))
+	assert.Equal(t, "resource operation partially failed", (&PartialFailureError{}).Error())
+}
+
+func TestApplyResourceTransforms(t *testing.T) {
+	t.Parallel()
+
+	props := resource.PropertyMap{"foo": resource.NewStringProperty("bar")}
+
+	// With no transforms, the properties are returned unchanged.
+	assert.Equal(t, props, ApplyResourceTransforms(nil, props))
+
+	// Transforms are applied in order.
+	set := func(k, v string) ResourceTransform {
+		return func(m resource.PropertyMap) resource.PropertyMap {
+			res := m.Copy()
+			res[resource.PropertyKey(k)] = resource.NewStringProperty(v)
+			return res
+		}
+	}
+	actual := ApplyResourceTransforms([]ResourceTransform{set("foo", "baz"), set("foo", "qux"), set("x", "y")}, props)
+	assert.Equal(t, resource.PropertyMap{
+		"foo": resource.NewStringProperty("qux"),
+		"x":   resource.NewStringProperty("y"),
+	}, actual)
+	assert.Equal(t, "bar", props["foo"].StringValue())
+}
+
+func TestValidateConstructResult(t *testing.T) {
+	t.Parallel()
+
+	component := resource.URN("urn:pulumi:stack::project::pkg:index:Component::comp")
+	child := resource.URN("urn:pulumi:stack::project::pkg:index:Component$pkg:index:Resource::child")
+	missing := resource.URN("urn:pulumi:stack::project::pkg:index:Resource::missing")
+	registered := []resource.URN{component, child}
+
+	valid := ConstructResult{
+		URN: component,
+		Outputs: resource.PropertyMap{
+			"foo": resource.NewStringProperty("bar"),
+			"baz": resource.NewOutputProperty(resource.Output{
+				Known:        true,
+				Element:      resource.NewNumberProperty(42),
+				Dependencies: []resource.URN{child},
+			}),
+		},
+		OutputDependencies: map[resource.PropertyKey][]resource.URN{
+			"foo": {child},
+		},
+	}
+	assert.Empty(t, ValidateConstructResult(valid, registered))
+
+	invalid := ConstructResult{
+		URN: component,
+		Outputs: resource.PropertyMap{
+			"nested": resource.NewObjectProperty(resource.PropertyMap{
+				"qux": resource.MakeSecret(resource.NewOutputProperty(resource.Output{
+					Element:      resource.NewStringProperty("oops"),
+					Dependencies: []resource.URN{missing},
+				})),
+			}),
+		},
+		OutputDependencies: map[resource.PropertyKey][]resource.URN{
+			"foo": {child, missing},
+		},
+	}
+	errs := ValidateConstructResult(invalid, registered)
+	assert.Len(t, errs, 3)
+	assert.EqualError(t, errs[0], "output foo depends on unregistered resource "+string(missing))
+	assert.EqualError(t, errs[1], "output nested.qux is unknown but has a value")
+	assert.EqualError(t, errs[2], "output nested.qux depends on unregistered resource "+string(missing))
+
+	children := ConstructResult{URN: component, ChildResources: []resource.URN{child, missing}}
+	errs = ValidateConstructResult(children, registered)
+	assert.Len(t, errs, 1)
+	assert.EqualError(t, errs[0], "child resource "+string(missing)+" was not registered")
+}
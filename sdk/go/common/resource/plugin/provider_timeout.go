@@ -0,0 +1,162 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+// TimeoutError is returned by a TimeoutProvider when an operation does not finish within its timeout.
+type TimeoutError struct {
+	// Operation is the name of the operation that timed out, e.g. "Create".
+	Operation string
+	// URN is the URN of the resource that the operation targeted, if any.
+	URN resource.URN
+	// Timeout is the timeout that the operation exceeded.
+	Timeout time.Duration
+}
+
+// Error returns the error message for this TimeoutError.
+func (e *TimeoutError) Error() string {
+	if e.URN == "" {
+		return fmt.Sprintf("%s timed out after %v", e.Operation, e.Timeout)
+	}
+	return fmt.Sprintf("%s of %v timed out after %v", e.Operation, e.URN, e.Timeout)
+}
+
+// WaitTimeoutError is returned by WaitForResourceReady when a resource does not become ready within its timeout.
+type WaitTimeoutError struct {
+	// URN is the URN of the resource that was not ready.
+	URN resource.URN
+	// Timeout is the timeout that the wait exceeded.
+	Timeout time.Duration
+}
+
+// Error returns the error message for this WaitTimeoutError.
+func (e *WaitTimeoutError) Error() string {
+	return fmt.Sprintf("%v did not become ready within %v", e.URN, e.Timeout)
+}
+
+// TimeoutProvider is a provider decorator that enforces the timeouts passed to Create, Update, Delete, and Call rather
+// than relying on the wrapped provider to honor them. Once an operation's timeout elapses, the context passed to the
+// wrapped provider is canceled. When the operation then returns, any error it reports is replaced with a
+// TimeoutError. A timeout of zero or less means that the operation has no timeout. All other methods are forwarded
+// unchanged.
+type TimeoutProvider struct {
+	ProviderBase
+}
+
+var _ Provider = (*TimeoutProvider)(nil)
+
+// TimeoutEnforcingProvider wraps the given provider in a TimeoutProvider.
+func TimeoutEnforcingProvider(inner Provider) Provider {
+	return &TimeoutProvider{ProviderBase: NewProviderBase(inner)}
+}
+
+// WithTimeoutEnforcement returns a ProviderMiddleware that wraps providers in a TimeoutProvider.
+func WithTimeoutEnforcement() ProviderMiddleware {
+	return TimeoutEnforcingProvider
+}
+
+// Create allocates a new instance of the provided resource, failing if it does not finish within the timeout.
+func (p *TimeoutProvider) Create(ctx context.Context, urn resource.URN, news resource.PropertyMap, timeout float64,
+	preview bool) (resource.ID, resource.PropertyMap, resource.Status, error) {
+
+	var id resource.ID
+	var outs resource.PropertyMap
+	var status resource.Status
+	err := enforceTimeout(ctx, "Create", urn, timeout, func(ctx context.Context) (err error) {
+		id, outs, status, err = p.ProviderBase.Create(ctx, urn, news, timeout, preview)
+		return err
+	})
+	return id, outs, status, err
+}
+
+// Update updates an existing resource with new values, failing if it does not finish within the timeout.
+func (p *TimeoutProvider) Update(ctx context.Context, urn resource.URN, id resource.ID,
+	olds resource.PropertyMap, news resource.PropertyMap, timeout float64,
+	ignoreChanges []string, preview bool) (resource.PropertyMap, resource.Status, error) {
+
+	var outs resource.PropertyMap
+	var status resource.Status
+	err := enforceTimeout(ctx, "Update", urn, timeout, func(ctx context.Context) (err error) {
+		outs, status, err = p.ProviderBase.Update(ctx, urn, id, olds, news, timeout, ignoreChanges, preview)
+		return err
+	})
+	return outs, status, err
+}
+
+// Delete tears down an existing resource, failing if it does not finish within the timeout.
+func (p *TimeoutProvider) Delete(ctx context.Context, urn resource.URN, id resource.ID, props resource.PropertyMap,
+	timeout float64) (resource.Status, error) {
+
+	var status resource.Status
+	err := enforceTimeout(ctx, "Delete", urn, timeout, func(ctx context.Context) (err error) {
+		status, err = p.ProviderBase.Delete(ctx, urn, id, props, timeout)
+		return err
+	})
+	return status, err
+}
+
+// Call dynamically executes a method in the provider, failing if it does not finish within options.Timeout. If the
+// call is a method on a component resource, the resulting TimeoutError names that resource.
+func (p *TimeoutProvider) Call(ctx context.Context, tok tokens.ModuleMember, args resource.PropertyMap, info CallInfo,
+	options CallOptions) (CallResult, error) {
+
+	var urn resource.URN
+	if self, ok := args["__self__"]; ok && self.IsResourceReference() {
+		urn = self.ResourceReferenceValue().URN
+	}
+
+	var result CallResult
+	err := enforceTimeout(ctx, "Call", urn, options.Timeout, func(ctx context.Context) (err error) {
+		result, err = p.ProviderBase.Call(ctx, tok, args, info, options)
+		return err
+	})
+	return result, err
+}
+
+// enforceTimeout calls f with a context that is canceled once the given timeout, in seconds, elapses. If the timeout
+// elapsed and f failed, the failure is reported as a TimeoutError.
+func enforceTimeout(ctx context.Context, op string, urn resource.URN, timeout float64,
+	f func(ctx context.Context) error) error {
+
+	if timeout <= 0 {
+		return f(ctx)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	d := time.Duration(timeout * float64(time.Second))
+	var timedOut int32
+	timer := time.AfterFunc(d, func() {
+		atomic.StoreInt32(&timedOut, 1)
+		cancel()
+	})
+	defer timer.Stop()
+
+	err := f(ctx)
+	if err != nil && atomic.LoadInt32(&timedOut) == 1 {
+		return &TimeoutError{Operation: op, URN: urn, Timeout: d}
+	}
+	return err
+}
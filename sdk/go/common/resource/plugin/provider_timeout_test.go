@@ -0,0 +1,126 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+// blockingProvider is a provider whose mutating operations block until their context is canceled, as a provider that
+// loses its connection mid-operation would, unless done is set.
+type blockingProvider struct {
+	Provider
+
+	done bool
+}
+
+func (p *blockingProvider) wait(ctx context.Context) error {
+	if p.done {
+		return nil
+	}
+	<-ctx.Done()
+	return errors.New("transport is closing")
+}
+
+func (p *blockingProvider) Create(ctx context.Context, urn resource.URN, news resource.PropertyMap, timeout float64,
+	preview bool) (resource.ID, resource.PropertyMap, resource.Status, error) {
+	if err := p.wait(ctx); err != nil {
+		return "", nil, resource.StatusUnknown, err
+	}
+	return "id", news, resource.StatusOK, nil
+}
+
+func (p *blockingProvider) Update(ctx context.Context, urn resource.URN, id resource.ID,
+	olds resource.PropertyMap, news resource.PropertyMap, timeout float64,
+	ignoreChanges []string, preview bool) (resource.PropertyMap, resource.Status, error) {
+	if err := p.wait(ctx); err != nil {
+		return nil, resource.StatusUnknown, err
+	}
+	return news, resource.StatusOK, nil
+}
+
+func (p *blockingProvider) Delete(ctx context.Context, urn resource.URN, id resource.ID, props resource.PropertyMap,
+	timeout float64) (resource.Status, error) {
+	if err := p.wait(ctx); err != nil {
+		return resource.StatusUnknown, err
+	}
+	return resource.StatusOK, nil
+}
+
+func (p *blockingProvider) Call(ctx context.Context, tok tokens.ModuleMember, args resource.PropertyMap,
+	info CallInfo, options CallOptions) (CallResult, error) {
+	if err := p.wait(ctx); err != nil {
+		return CallResult{}, err
+	}
+	return CallResult{Return: args}, nil
+}
+
+func TestTimeoutEnforcingProvider(t *testing.T) {
+	t.Parallel()
+
+	const urn = resource.URN("urn:pulumi:stack::project::test:index:res::name")
+	news := resource.PropertyMap{"foo": resource.NewStringProperty("bar")}
+
+	prov := TimeoutEnforcingProvider(&blockingProvider{})
+
+	_, _, _, err := prov.Create(context.Background(), urn, news, 0.01, false)
+	var timeoutErr *TimeoutError
+	require.True(t, errors.As(err, &timeoutErr))
+	assert.Equal(t, "Create", timeoutErr.Operation)
+	assert.Equal(t, urn, timeoutErr.URN)
+	assert.Equal(t, "Create of "+string(urn)+" timed out after 10ms", err.Error())
+
+	_, _, err = prov.Update(context.Background(), urn, "id", news, news, 0.01, nil, false)
+	require.True(t, errors.As(err, &timeoutErr))
+	assert.Equal(t, "Update", timeoutErr.Operation)
+
+	_, err = prov.Delete(context.Background(), urn, "id", news, 0.01)
+	require.True(t, errors.As(err, &timeoutErr))
+	assert.Equal(t, "Delete", timeoutErr.Operation)
+
+	// A call's timeout is taken from its options, and a method call names the component resource that it was made on.
+	self := resource.MakeComponentResourceReference(urn, "")
+	_, err = prov.Call(context.Background(), "test:index:res/method", resource.PropertyMap{"__self__": self},
+		CallInfo{}, CallOptions{Timeout: 0.01})
+	require.True(t, errors.As(err, &timeoutErr))
+	assert.Equal(t, "Call", timeoutErr.Operation)
+	assert.Equal(t, urn, timeoutErr.URN)
+
+	_, err = prov.Call(context.Background(), "test:index:func", nil, CallInfo{}, CallOptions{Timeout: 0.01})
+	assert.EqualError(t, err, "Call timed out after 10ms")
+
+	// Operations that finish in time are unaffected.
+	prov = TimeoutEnforcingProvider(&blockingProvider{done: true})
+	id, outs, status, err := prov.Create(context.Background(), urn, news, 60, false)
+	assert.NoError(t, err)
+	assert.Equal(t, resource.ID("id"), id)
+	assert.Equal(t, news, outs)
+	assert.Equal(t, resource.StatusOK, status)
+
+	// Failures that are not caused by a timeout are returned as-is, and a zero timeout is not enforced.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	prov = TimeoutEnforcingProvider(&blockingProvider{})
+	_, err = prov.Delete(ctx, urn, "id", news, 0)
+	assert.EqualError(t, err, "transport is closing")
+}
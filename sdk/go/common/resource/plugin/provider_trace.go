@@ -0,0 +1,221 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/opentracing/basictracer-go"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+	"github.com/uber/jaeger-client-go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+// ErrNoTraceContext is returned by ExtractTraceContext and ExtractCallTraceContext when the request carries no trace
+// context, e.g. because the engine is not tracing.
+var ErrNoTraceContext = errors.New("no trace context")
+
+// TraceContext identifies the engine span that made a Construct or Call request. The IDs have the sizes used by the
+// W3C Trace Context specification, so they can be used directly as OpenTelemetry trace and span IDs.
+type TraceContext struct {
+	TraceID [16]byte // the ID of the engine's trace.
+	SpanID  [8]byte  // the ID of the engine's span for the request.
+}
+
+// SpanIDs returns the hex-encoded trace and span IDs of the given span, in the form expected by ConstructInfo and
+// CallInfo. It returns empty strings if the span is nil or was created by a tracer whose IDs are not known.
+func SpanIDs(span opentracing.Span) (traceID, spanID string) {
+	if span == nil {
+		return "", ""
+	}
+	switch sc := span.Context().(type) {
+	case jaeger.SpanContext:
+		traceID := sc.TraceID()
+		return fmt.Sprintf("%016x%016x", traceID.High, traceID.Low), fmt.Sprintf("%016x", uint64(sc.SpanID()))
+	case basictracer.SpanContext:
+		return fmt.Sprintf("%032x", sc.TraceID), fmt.Sprintf("%016x", sc.SpanID)
+	default:
+		return "", ""
+	}
+}
+
+// ExtractTraceContext parses the trace and span IDs in the given ConstructInfo. It returns ErrNoTraceContext if the
+// info carries no IDs.
+func ExtractTraceContext(info ConstructInfo) (TraceContext, error) {
+	return parseTraceContext(info.TraceID, info.SpanID)
+}
+
+// ExtractCallTraceContext parses the trace and span IDs in the given CallInfo. It returns ErrNoTraceContext if the
+// info carries no IDs.
+func ExtractCallTraceContext(info CallInfo) (TraceContext, error) {
+	return parseTraceContext(info.TraceID, info.SpanID)
+}
+
+// parseTraceContext parses hex-encoded trace and span IDs. IDs that are shorter than the full size are left-padded
+// with zeros, as 64-bit trace IDs are.
+func parseTraceContext(traceID, spanID string) (TraceContext, error) {
+	if traceID == "" && spanID == "" {
+		return TraceContext{}, ErrNoTraceContext
+	}
+
+	var tc TraceContext
+	if err := parseTraceID(tc.TraceID[:], traceID); err != nil {
+		return TraceContext{}, fmt.Errorf("invalid trace ID %q: %w", traceID, err)
+	}
+	if err := parseTraceID(tc.SpanID[:], spanID); err != nil {
+		return TraceContext{}, fmt.Errorf("invalid span ID %q: %w", spanID, err)
+	}
+	return tc, nil
+}
+
+// parseTraceID decodes the hex-encoded ID s into dest, left-padding it with zeros.
+func parseTraceID(dest []byte, s string) error {
+	if s == "" || len(s) > 2*len(dest) {
+		return fmt.Errorf("expected 1 to %d hex digits", 2*len(dest))
+	}
+	s = strings.Repeat("0", 2*len(dest)-len(s)) + s
+	_, err := hex.Decode(dest, []byte(s))
+	return err
+}
+
+type providerTracerKey struct{}
+
+// ContextWithProviderTracer returns a context that makes provider RPCs issued with it record their spans with the
+// given tracer rather than the global tracer.
+func ContextWithProviderTracer(ctx context.Context, tracer opentracing.Tracer) context.Context {
+	return context.WithValue(ctx, providerTracerKey{}, tracer)
+}
+
+// providerTracer returns the tracer for provider RPCs issued with the given context.
+func providerTracer(ctx context.Context) opentracing.Tracer {
+	if tracer, ok := ctx.Value(providerTracerKey{}).(opentracing.Tracer); ok {
+		return tracer
+	}
+	return opentracing.GlobalTracer()
+}
+
+// newTracingClientConn wraps the connection to the provider for the given package so that each RPC issued on it is
+// recorded as a span. The span is tagged with the method name, the package, and the URN of the resource that the
+// request refers to, if any, and records the gRPC status code with which the RPC completed.
+func newTracingClientConn(conn grpc.ClientConnInterface, pkg tokens.Package) grpc.ClientConnInterface {
+	return &tracingClientConn{conn: conn, pkg: pkg}
+}
+
+type tracingClientConn struct {
+	conn grpc.ClientConnInterface
+	pkg  tokens.Package
+}
+
+// startSpan starts the span for a call to the given method, e.g. "/pulumirpc.ResourceProvider/Create", and returns
+// it along with a context that carries it.
+func (c *tracingClientConn) startSpan(ctx context.Context, method string) (opentracing.Span, context.Context) {
+	name := method[strings.LastIndex(method, "/")+1:]
+
+	var opts []opentracing.StartSpanOption
+	if parent := opentracing.SpanFromContext(ctx); parent != nil {
+		opts = append(opts, opentracing.ChildOf(parent.Context()))
+	}
+	span := providerTracer(ctx).StartSpan("Provider."+name, opts...)
+	span.SetTag("provider.method", name)
+	span.SetTag("provider.package", string(c.pkg))
+	ext.SpanKindRPCClient.Set(span)
+	return span, opentracing.ContextWithSpan(ctx, span)
+}
+
+// tagRequest tags the span with the URN of the resource that the given request refers to, if any.
+func tagRequest(span opentracing.Span, req interface{}) {
+	if r, ok := req.(interface{ GetUrn() string }); ok && r.GetUrn() != "" {
+		span.SetTag("resource.urn", r.GetUrn())
+	}
+}
+
+// finishSpan records the outcome of an RPC and finishes its span.
+func finishSpan(span opentracing.Span, err error) {
+	span.SetTag("rpc.grpc.status_code", status.Code(err).String())
+	if err != nil {
+		ext.Error.Set(span, true)
+		span.LogKV("event", "error", "message", err.Error())
+	}
+	span.Finish()
+}
+
+func (c *tracingClientConn) Invoke(ctx context.Context, method string, args, reply interface{},
+	opts ...grpc.CallOption) error {
+	span, ctx := c.startSpan(ctx, method)
+	tagRequest(span, args)
+	err := c.conn.Invoke(ctx, method, args, reply, opts...)
+	finishSpan(span, err)
+	return err
+}
+
+func (c *tracingClientConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string,
+	opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	span, ctx := c.startSpan(ctx, method)
+	stream, err := c.conn.NewStream(ctx, desc, method, opts...)
+	if err != nil {
+		finishSpan(span, err)
+		return nil, err
+	}
+
+	s := &tracingClientStream{ClientStream: stream, span: span}
+	// The caller may stop reading before the stream ends, in which case it cancels the context.
+	go func() {
+		<-ctx.Done()
+		s.finish(ctx.Err())
+	}()
+	return s, nil
+}
+
+// tracingClientStream finishes the span of a streaming RPC once the stream ends.
+type tracingClientStream struct {
+	grpc.ClientStream
+
+	span opentracing.Span
+	once sync.Once
+}
+
+func (s *tracingClientStream) finish(err error) {
+	s.once.Do(func() { finishSpan(s.span, err) })
+}
+
+func (s *tracingClientStream) SendMsg(m interface{}) error {
+	tagRequest(s.span, m)
+	err := s.ClientStream.SendMsg(m)
+	if err != nil && err != io.EOF {
+		s.finish(err)
+	}
+	return err
+}
+
+func (s *tracingClientStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	switch {
+	case err == io.EOF:
+		s.finish(nil)
+	case err != nil:
+		s.finish(err)
+	}
+	return err
+}
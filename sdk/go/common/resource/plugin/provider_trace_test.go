@@ -0,0 +1,172 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/opentracing/basictracer-go"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/mocktracer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber/jaeger-client-go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
+)
+
+func TestSpanIDsRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	jaegerTracer, closer := jaeger.NewTracer("test", jaeger.NewConstSampler(true), jaeger.NewNullReporter())
+	t.Cleanup(func() { assert.NoError(t, closer.Close()) })
+
+	tracers := map[string]opentracing.Tracer{
+		"basictracer": basictracer.New(basictracer.NewInMemoryRecorder()),
+		"jaeger":      jaegerTracer,
+	}
+	for name, tracer := range tracers {
+		tracer := tracer
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			span := tracer.StartSpan("test")
+			defer span.Finish()
+
+			traceID, spanID := SpanIDs(span)
+			assert.Len(t, traceID, 32)
+			assert.Len(t, spanID, 16)
+
+			tc, err := ExtractTraceContext(ConstructInfo{TraceID: traceID, SpanID: spanID})
+			require.NoError(t, err)
+			assert.NotEqual(t, [16]byte{}, tc.TraceID)
+			assert.NotEqual(t, [8]byte{}, tc.SpanID)
+
+			callTC, err := ExtractCallTraceContext(CallInfo{TraceID: traceID, SpanID: spanID})
+			require.NoError(t, err)
+			assert.Equal(t, tc, callTC)
+		})
+	}
+
+	// Spans from tracers whose IDs are not known have no IDs.
+	traceID, spanID := SpanIDs(opentracing.NoopTracer{}.StartSpan("test"))
+	assert.Empty(t, traceID)
+	assert.Empty(t, spanID)
+	traceID, spanID = SpanIDs(nil)
+	assert.Empty(t, traceID)
+	assert.Empty(t, spanID)
+}
+
+func TestExtractTraceContext(t *testing.T) {
+	t.Parallel()
+
+	// Short IDs are left-padded.
+	tc, err := ExtractTraceContext(ConstructInfo{TraceID: "abc", SpanID: "1f"})
+	require.NoError(t, err)
+	assert.Equal(t, TraceContext{
+		TraceID: [16]byte{14: 0x0a, 15: 0xbc},
+		SpanID:  [8]byte{7: 0x1f},
+	}, tc)
+
+	_, err = ExtractTraceContext(ConstructInfo{})
+	assert.Equal(t, ErrNoTraceContext, err)
+
+	_, err = ExtractTraceContext(ConstructInfo{TraceID: "xyz", SpanID: "1"})
+	assert.Error(t, err)
+	_, err = ExtractTraceContext(ConstructInfo{TraceID: "1", SpanID: "00112233445566778899"})
+	assert.EqualError(t, err, `invalid span ID "00112233445566778899": expected 1 to 16 hex digits`)
+	_, err = ExtractCallTraceContext(CallInfo{TraceID: "1"})
+	assert.EqualError(t, err, `invalid span ID "": expected 1 to 16 hex digits`)
+}
+
+type stubClientConn struct {
+	err error
+}
+
+func (c *stubClientConn) Invoke(ctx context.Context, method string, args, reply interface{},
+	opts ...grpc.CallOption) error {
+	return c.err
+}
+
+func (c *stubClientConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string,
+	opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return &stubClientStream{ctx: ctx, err: c.err}, nil
+}
+
+type stubClientStream struct {
+	grpc.ClientStream
+
+	ctx context.Context
+	err error
+}
+
+func (s *stubClientStream) Context() context.Context    { return s.ctx }
+func (s *stubClientStream) SendMsg(m interface{}) error { return nil }
+func (s *stubClientStream) CloseSend() error            { return nil }
+
+func (s *stubClientStream) RecvMsg(m interface{}) error {
+	if s.err != nil {
+		return s.err
+	}
+	return io.EOF
+}
+
+func TestTracingClientConn(t *testing.T) {
+	t.Parallel()
+
+	tracer := mocktracer.New()
+	ctx := ContextWithProviderTracer(context.Background(), tracer)
+	parent := tracer.StartSpan("parent")
+	ctx = opentracing.ContextWithSpan(ctx, parent)
+
+	// Each RPC is recorded as a child of the caller's span.
+	urn := "urn:pulumi:stack::project::aws:s3/bucket:Bucket::logs"
+	failed := status.Error(codes.Unavailable, "connection reset")
+	client := pulumirpc.NewResourceProviderClient(newTracingClientConn(&stubClientConn{err: failed}, "aws"))
+	_, err := client.Create(ctx, &pulumirpc.CreateRequest{Urn: urn})
+	assert.Equal(t, failed, err)
+
+	spans := tracer.FinishedSpans()
+	require.Len(t, spans, 1)
+	span := spans[0]
+	assert.Equal(t, "Provider.Create", span.OperationName)
+	assert.Equal(t, parent.Context().(mocktracer.MockSpanContext).SpanID, span.ParentID)
+	assert.Equal(t, "Create", span.Tag("provider.method"))
+	assert.Equal(t, "aws", span.Tag("provider.package"))
+	assert.Equal(t, urn, span.Tag("resource.urn"))
+	assert.Equal(t, "Unavailable", span.Tag("rpc.grpc.status_code"))
+	assert.Equal(t, true, span.Tag("error"))
+
+	// Streaming RPCs are finished when the stream ends.
+	tracer.Reset()
+	client = pulumirpc.NewResourceProviderClient(newTracingClientConn(&stubClientConn{}, "aws"))
+	stream, err := client.ReadStream(ctx, &pulumirpc.ReadRequest{Urn: urn})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, io.EOF, err)
+
+	spans = tracer.FinishedSpans()
+	require.Len(t, spans, 1)
+	span = spans[0]
+	assert.Equal(t, "Provider.ReadStream", span.OperationName)
+	assert.Equal(t, urn, span.Tag("resource.urn"))
+	assert.Equal(t, "OK", span.Tag("rpc.grpc.status_code"))
+	assert.Nil(t, span.Tag("error"))
+}
@@ -0,0 +1,74 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/logging"
+)
+
+// ProviderWaiter polls a provider's Read method until a resource reaches a desired state, such as a cloud resource
+// that moves from CREATING to ACTIVE after it has been created. The zero value is ready to use.
+type ProviderWaiter struct{}
+
+// Wait reads the given resource every poll interval until condition returns true for the result of a Read, and then
+// returns that result. The first Read is issued immediately. Each Read is passed the inputs and state returned by the
+// previous one, starting from the given inputs and initialState.
+//
+// A resource that no longer exists is reported to condition as a result with nil Outputs, so Wait can also be used to
+// wait for a resource to be deleted. Wait returns the error from any Read that fails. If the context is canceled or
+// its deadline passes first, Wait returns the most recent result along with the context's error.
+func (ProviderWaiter) Wait(ctx context.Context, p Provider, urn resource.URN, id resource.ID,
+	inputs, initialState resource.PropertyMap, condition func(ReadResult) bool,
+	poll time.Duration) (ReadResult, error) {
+
+	contract.Requiref(p != nil, "p", "must not be nil")
+	contract.Requiref(condition != nil, "condition", "must not be nil")
+	contract.Requiref(poll > 0, "poll", "must be positive, not %v", poll)
+
+	state := initialState
+	for attempt := 1; ; attempt++ {
+		result, _, err := p.Read(ctx, urn, id, inputs, state)
+		if errors.Is(err, ErrResourceNotFound) {
+			result, err = ReadResult{ID: id}, nil
+		}
+		if err != nil {
+			return ReadResult{}, err
+		}
+		if condition(result) {
+			return result, nil
+		}
+
+		// Later reads start from the latest known state of the resource.
+		if result.ID != "" {
+			id = result.ID
+		}
+		if result.Inputs != nil {
+			inputs = result.Inputs
+		}
+		state = result.Outputs
+
+		logging.V(7).Infof("ProviderWaiter: %s is not ready after %d reads, polling again in %v", urn, attempt, poll)
+		if err := sleepContext(ctx, poll); err != nil {
+			return result, fmt.Errorf("waiting for %s: %w", urn, err)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+func TestProviderWaiter(t *testing.T) {
+	t.Parallel()
+
+	urn := resource.URN("urn:pulumi:test::test::pkgA:m:typA::resA")
+	isActive := func(res ReadResult) bool {
+		return res.Outputs["status"].IsString() && res.Outputs["status"].StringValue() == "ACTIVE"
+	}
+
+	t.Run("waits for condition", func(t *testing.T) {
+		t.Parallel()
+
+		states := []string{"CREATING", "CREATING", "ACTIVE"}
+		reads := 0
+		prov := &readProvider{readF: func(urn resource.URN, id resource.ID) (ReadResult, resource.Status, error) {
+			state := states[reads]
+			reads++
+			return ReadResult{ID: id, Outputs: resource.PropertyMap{"status": resource.NewStringProperty(state)}},
+				resource.StatusOK, nil
+		}}
+
+		res, err := ProviderWaiter{}.Wait(context.Background(), prov, urn, "a", nil, nil, isActive, time.Millisecond)
+		require.NoError(t, err)
+		assert.Equal(t, 3, reads)
+		assert.Equal(t, resource.NewStringProperty("ACTIVE"), res.Outputs["status"])
+	})
+
+	t.Run("waits for deletion", func(t *testing.T) {
+		t.Parallel()
+
+		reads := 0
+		prov := &readProvider{readF: func(urn resource.URN, id resource.ID) (ReadResult, resource.Status, error) {
+			reads++
+			if reads < 2 {
+				return ReadResult{ID: id, Outputs: resource.PropertyMap{}}, resource.StatusOK, nil
+			}
+			return ReadResult{}, resource.StatusOK, ErrResourceNotFound
+		}}
+
+		res, err := ProviderWaiter{}.Wait(context.Background(), prov, urn, "a", nil, nil, func(res ReadResult) bool {
+			return res.Outputs == nil
+		}, time.Millisecond)
+		require.NoError(t, err)
+		assert.Equal(t, ReadResult{ID: "a"}, res)
+	})
+
+	t.Run("returns read errors", func(t *testing.T) {
+		t.Parallel()
+
+		failure := errors.New("read failed")
+		prov := &readProvider{readF: func(urn resource.URN, id resource.ID) (ReadResult, resource.Status, error) {
+			return ReadResult{}, resource.StatusUnknown, failure
+		}}
+
+		_, err := ProviderWaiter{}.Wait(context.Background(), prov, urn, "a", nil, nil, isActive, time.Millisecond)
+		assert.ErrorIs(t, err, failure)
+	})
+
+	t.Run("times out", func(t *testing.T) {
+		t.Parallel()
+
+		prov := &readProvider{readF: func(urn resource.URN, id resource.ID) (ReadResult, resource.Status, error) {
+			return ReadResult{ID: id, Outputs: resource.PropertyMap{"status": resource.NewStringProperty("CREATING")}},
+				resource.StatusOK, nil
+		}}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		res, err := ProviderWaiter{}.Wait(ctx, prov, urn, "a", nil, nil, isActive, time.Millisecond)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, resource.NewStringProperty("CREATING"), res.Outputs["status"])
+	})
+}
@@ -0,0 +1,81 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/mapper"
+)
+
+// ReadResourceState decodes the given resource state into the struct that out points to, much like json.Unmarshal.
+// Properties are matched to fields by their `pulumi` struct tags, e.g. `pulumi:"name"` or `pulumi:"name,optional"`,
+// and nested objects and arrays are decoded into nested structs, maps, and slices. Secret values are decoded as their
+// plaintext. Unknown values and properties that the struct does not declare are ignored, and fields for which the
+// state has no property are left unchanged.
+func ReadResourceState(props resource.PropertyMap, out interface{}) error {
+	obj := props.MapRepl(nil, unwrapStateValue)
+	if err := mapper.MapI(obj, out); err != nil {
+		return err
+	}
+	return nil
+}
+
+// WriteResourceState encodes the given struct, or pointer to a struct, as resource state. It is the inverse of
+// ReadResourceState: fields are mapped to properties by their `pulumi` struct tags, the fields of embedded structs are
+// encoded as if they were declared by the outer struct, and nested structs, maps, and slices are encoded as objects
+// and arrays. Nil pointers, maps, and slices are encoded as null values.
+func WriteResourceState(in interface{}) (resource.PropertyMap, error) {
+	obj, err := mapper.New(&mapper.Opts{EncodeNil: true}).Encode(in)
+	if err != nil {
+		return nil, err
+	}
+	return resource.NewPropertyMapFromMap(obj), nil
+}
+
+// TypedInvoke calls the given function in the provider with arguments encoded from the struct in by
+// WriteResourceState, and decodes the function's result into the struct that out points to using ReadResourceState.
+// If the provider reports that the arguments failed validation, the failures are returned and out is left unchanged.
+func TypedInvoke(ctx context.Context, p Provider, tok tokens.ModuleMember,
+	in, out interface{}) ([]CheckFailure, error) {
+
+	args, err := WriteResourceState(in)
+	if err != nil {
+		return nil, err
+	}
+	ret, failures, err := p.Invoke(ctx, tok, args)
+	if err != nil || len(failures) > 0 {
+		return failures, err
+	}
+	return nil, ReadResourceState(ret, out)
+}
+
+// unwrapStateValue maps secrets to their plaintext and unknowns to nil for ReadResourceState.
+func unwrapStateValue(v resource.PropertyValue) (interface{}, bool) {
+	switch {
+	case v.IsSecret():
+		return v.SecretValue().Element.MapRepl(nil, unwrapStateValue), true
+	case v.IsComputed():
+		return nil, true
+	case v.IsOutput():
+		if !v.OutputValue().Known {
+			return nil, true
+		}
+		return v.OutputValue().Element.MapRepl(nil, unwrapStateValue), true
+	}
+	return nil, false
+}
@@ -0,0 +1,176 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+type testRule struct {
+	Port     int    `pulumi:"port"`
+	Protocol string `pulumi:"protocol"`
+}
+
+type testState struct {
+	Name     string            `pulumi:"name"`
+	Size     float64           `pulumi:"size"`
+	Enabled  bool              `pulumi:"enabled"`
+	Password string            `pulumi:"password"`
+	Rules    []testRule        `pulumi:"rules"`
+	Primary  *testRule         `pulumi:"primary,optional"`
+	Tags     map[string]string `pulumi:"tags,optional"`
+	Endpoint string            `pulumi:"endpoint,optional"`
+}
+
+func TestReadResourceState(t *testing.T) {
+	t.Parallel()
+
+	rule := func(port float64, protocol string) resource.PropertyValue {
+		return resource.NewObjectProperty(resource.PropertyMap{
+			"port":     resource.NewNumberProperty(port),
+			"protocol": resource.NewStringProperty(protocol),
+		})
+	}
+	props := resource.PropertyMap{
+		"name":     resource.NewStringProperty("db"),
+		"size":     resource.NewNumberProperty(2.5),
+		"enabled":  resource.NewBoolProperty(true),
+		"password": resource.MakeSecret(resource.NewStringProperty("hunter2")),
+		"rules": resource.NewArrayProperty([]resource.PropertyValue{
+			rule(80, "tcp"),
+			resource.MakeSecret(rule(53, "udp")),
+		}),
+		"primary":  rule(443, "tcp"),
+		"tags":     resource.NewObjectProperty(resource.PropertyMap{"env": resource.NewStringProperty("prod")}),
+		"endpoint": resource.MakeComputed(resource.NewStringProperty("")),
+		"extra":    resource.NewStringProperty("ignored"),
+	}
+
+	var state testState
+	require.NoError(t, ReadResourceState(props, &state))
+	assert.Equal(t, testState{
+		Name:     "db",
+		Size:     2.5,
+		Enabled:  true,
+		Password: "hunter2",
+		Rules:    []testRule{{Port: 80, Protocol: "tcp"}, {Port: 53, Protocol: "udp"}},
+		Primary:  &testRule{Port: 443, Protocol: "tcp"},
+		Tags:     map[string]string{"env": "prod"},
+	}, state)
+
+	// Properties of the wrong type are reported.
+	err := ReadResourceState(resource.PropertyMap{"name": resource.NewNumberProperty(1)}, &state)
+	assert.Error(t, err)
+}
+
+type testMetadata struct {
+	Owner string `pulumi:"owner"`
+}
+
+type testWrittenState struct {
+	testMetadata
+
+	Name    string     `pulumi:"name"`
+	Rules   []testRule `pulumi:"rules"`
+	Primary *testRule  `pulumi:"primary"`
+}
+
+func TestWriteResourceState(t *testing.T) {
+	t.Parallel()
+
+	in := testWrittenState{
+		testMetadata: testMetadata{Owner: "ops"},
+		Name:         "db",
+		Rules:        []testRule{{Port: 80, Protocol: "tcp"}},
+	}
+	props, err := WriteResourceState(in)
+	require.NoError(t, err)
+	assert.Equal(t, resource.PropertyMap{
+		"owner": resource.NewStringProperty("ops"),
+		"name":  resource.NewStringProperty("db"),
+		"rules": resource.NewArrayProperty([]resource.PropertyValue{
+			resource.NewObjectProperty(resource.PropertyMap{
+				"port":     resource.NewNumberProperty(80),
+				"protocol": resource.NewStringProperty("tcp"),
+			}),
+		}),
+		"primary": resource.NewNullProperty(),
+	}, props)
+
+	// Written state reads back as the same struct.
+	var out testWrittenState
+	require.NoError(t, ReadResourceState(props, &out))
+	assert.Equal(t, in, out)
+}
+
+// upperProvider implements an invoke that upper-cases its "name" argument.
+type upperProvider struct {
+	Provider
+}
+
+func (p *upperProvider) Invoke(ctx context.Context, tok tokens.ModuleMember,
+	args resource.PropertyMap) (resource.PropertyMap, []CheckFailure, error) {
+
+	switch {
+	case tok != "test:index:upper":
+		return nil, nil, errors.New("unknown function")
+	case !args["name"].IsString():
+		return nil, []CheckFailure{{Property: "name", Reason: "name must be a string"}}, nil
+	}
+	return resource.PropertyMap{
+		"name": resource.NewStringProperty(strings.ToUpper(args["name"].StringValue())),
+		"size": resource.NewNumberProperty(float64(len(args["name"].StringValue()))),
+	}, nil, nil
+}
+
+func TestTypedInvoke(t *testing.T) {
+	t.Parallel()
+
+	type upperArgs struct {
+		Name *string `pulumi:"name"`
+	}
+	type upperResult struct {
+		Name string  `pulumi:"name"`
+		Size float64 `pulumi:"size"`
+	}
+
+	ctx, prov := context.Background(), &upperProvider{}
+
+	name := "bucket"
+	var result upperResult
+	failures, err := TypedInvoke(ctx, prov, "test:index:upper", upperArgs{Name: &name}, &result)
+	require.NoError(t, err)
+	assert.Empty(t, failures)
+	assert.Equal(t, upperResult{Name: "BUCKET", Size: 6}, result)
+
+	// Check failures are returned without decoding the result.
+	result = upperResult{}
+	failures, err = TypedInvoke(ctx, prov, "test:index:upper", upperArgs{}, &result)
+	require.NoError(t, err)
+	assert.Equal(t, []CheckFailure{{Property: "name", Reason: "name must be a string"}}, failures)
+	assert.Equal(t, upperResult{}, result)
+
+	_, err = TypedInvoke(ctx, prov, "test:index:lower", upperArgs{Name: &name}, &result)
+	assert.EqualError(t, err, "unknown function")
+}
@@ -69,7 +69,7 @@ const (
 // MarshalProperties marshals a resource's property map as a "JSON-like" protobuf structure.
 func MarshalProperties(props resource.PropertyMap, opts MarshalOptions) (*structpb.Struct, error) {
 	fields := make(map[string]*structpb.Value)
-	for _, key := range props.StableKeys() {
+	for _, key := range props.Keys() {
 		v := props[key]
 		logging.V(9).Infof("Marshaling property for RPC[%s]: %s=%v", opts.Label, key, v)
 		if opts.SkipNulls && v.IsNull() {
@@ -0,0 +1,376 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	structpb "github.com/golang/protobuf/ptypes/struct"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// MarshalBinaryProperties marshals a resource's property map into a compact MessagePack encoding of the structure
+// that MarshalProperties would produce. Secrets, unknowns, assets and other special values are encoded in the same
+// way as they are in the "JSON-like" representation, so the two encodings carry exactly the same information. The
+// engine uses the binary encoding for the property maps that it exchanges with providers that report
+// FeatureBinaryPropertyMap in Check, Create and Update calls.
+func MarshalBinaryProperties(props resource.PropertyMap, opts MarshalOptions) ([]byte, error) {
+	s, err := MarshalProperties(props, opts)
+	if err != nil {
+		return nil, err
+	}
+	return encodeBinaryStruct(s), nil
+}
+
+// UnmarshalBinaryProperties unmarshals a property map that was encoded by MarshalBinaryProperties.
+func UnmarshalBinaryProperties(data []byte, opts MarshalOptions) (resource.PropertyMap, error) {
+	s, err := unmarshalBinaryStruct(data, opts.Label)
+	if err != nil {
+		return nil, err
+	}
+	return UnmarshalProperties(s, opts)
+}
+
+// encodeBinaryStruct returns the MessagePack encoding of a property map that has already been marshaled.
+func encodeBinaryStruct(s *structpb.Struct) []byte {
+	var e msgpackEncoder
+	e.encodeStruct(s)
+	return e.buf
+}
+
+// decodeBinaryStruct returns the marshaled property map that an RPC message carries either as a struct or, if data is
+// not empty, in the MessagePack encoding.
+func decodeBinaryStruct(s *structpb.Struct, data []byte, label string) (*structpb.Struct, error) {
+	if len(data) == 0 {
+		return s, nil
+	}
+	return unmarshalBinaryStruct(data, label)
+}
+
+// unmarshalBinaryStruct decodes the "JSON-like" struct from which MarshalBinaryProperties produced the given data.
+func unmarshalBinaryStruct(data []byte, label string) (*structpb.Struct, error) {
+	d := msgpackDecoder{buf: data}
+	v, err := d.decodeValue()
+	if err != nil {
+		return nil, fmt.Errorf("decoding binary properties for RPC[%s]: %w", label, err)
+	}
+	if len(d.buf) != 0 {
+		return nil, fmt.Errorf("decoding binary properties for RPC[%s]: %d trailing bytes", label, len(d.buf))
+	}
+	s, ok := v.Kind.(*structpb.Value_StructValue)
+	if !ok {
+		return nil, fmt.Errorf("decoding binary properties for RPC[%s]: expected a map", label)
+	}
+	return s.StructValue, nil
+}
+
+// msgpackEncoder encodes "JSON-like" protobuf values as MessagePack. Numbers that are integers are encoded using
+// MessagePack's integer formats, which are much more compact than doubles for small values; all other numbers are
+// encoded as doubles.
+type msgpackEncoder struct {
+	buf []byte
+}
+
+func (e *msgpackEncoder) encodeValue(v *structpb.Value) {
+	switch k := v.GetKind().(type) {
+	case *structpb.Value_BoolValue:
+		if k.BoolValue {
+			e.buf = append(e.buf, 0xc3)
+		} else {
+			e.buf = append(e.buf, 0xc2)
+		}
+	case *structpb.Value_NumberValue:
+		e.encodeNumber(k.NumberValue)
+	case *structpb.Value_StringValue:
+		e.encodeString(k.StringValue)
+	case *structpb.Value_ListValue:
+		values := k.ListValue.GetValues()
+		e.encodeLength(len(values), 0x90, 0xdc, 0xdd)
+		for _, elem := range values {
+			e.encodeValue(elem)
+		}
+	case *structpb.Value_StructValue:
+		e.encodeStruct(k.StructValue)
+	default:
+		e.buf = append(e.buf, 0xc0)
+	}
+}
+
+func (e *msgpackEncoder) encodeStruct(s *structpb.Struct) {
+	keys := make([]string, 0, len(s.GetFields()))
+	for k := range s.GetFields() {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	e.encodeLength(len(keys), 0x80, 0xde, 0xdf)
+	for _, k := range keys {
+		e.encodeString(k)
+		e.encodeValue(s.Fields[k])
+	}
+}
+
+func (e *msgpackEncoder) encodeNumber(f float64) {
+	const maxSafeInteger = 1 << 53
+	if f != math.Trunc(f) || f < -maxSafeInteger || f > maxSafeInteger || (f == 0 && math.Signbit(f)) {
+		e.buf = append(e.buf, 0xcb)
+		e.appendUint(math.Float64bits(f), 8)
+		return
+	}
+
+	switch i := int64(f); {
+	case i >= -32 && i <= math.MaxInt8:
+		// Positive and negative fixints are stored in the tag byte itself.
+		e.buf = append(e.buf, byte(i))
+	case i >= math.MinInt8 && i <= math.MaxInt8:
+		e.buf = append(e.buf, 0xd0, byte(i))
+	case i >= math.MinInt16 && i <= math.MaxInt16:
+		e.buf = append(e.buf, 0xd1)
+		e.appendUint(uint64(i), 2)
+	case i >= math.MinInt32 && i <= math.MaxInt32:
+		e.buf = append(e.buf, 0xd2)
+		e.appendUint(uint64(i), 4)
+	default:
+		e.buf = append(e.buf, 0xd3)
+		e.appendUint(uint64(i), 8)
+	}
+}
+
+func (e *msgpackEncoder) encodeString(s string) {
+	switch n := len(s); {
+	case n < 32:
+		e.buf = append(e.buf, 0xa0|byte(n))
+	case n <= math.MaxUint8:
+		e.buf = append(e.buf, 0xd9, byte(n))
+	case n <= math.MaxUint16:
+		e.buf = append(e.buf, 0xda)
+		e.appendUint(uint64(n), 2)
+	default:
+		e.buf = append(e.buf, 0xdb)
+		e.appendUint(uint64(n), 4)
+	}
+	e.buf = append(e.buf, s...)
+}
+
+// encodeLength encodes the header of an array or map of the given length, using the fix, 16-bit and 32-bit formats
+// with the given tags.
+func (e *msgpackEncoder) encodeLength(n int, fix, tag16, tag32 byte) {
+	switch {
+	case n < 16:
+		e.buf = append(e.buf, fix|byte(n))
+	case n <= math.MaxUint16:
+		e.buf = append(e.buf, tag16)
+		e.appendUint(uint64(n), 2)
+	default:
+		e.buf = append(e.buf, tag32)
+		e.appendUint(uint64(n), 4)
+	}
+}
+
+// appendUint appends the low n bytes of u in big-endian order.
+func (e *msgpackEncoder) appendUint(u uint64, n int) {
+	for shift := 8 * (n - 1); shift >= 0; shift -= 8 {
+		e.buf = append(e.buf, byte(u>>shift))
+	}
+}
+
+// maxMsgpackDepth is the deepest nesting of arrays and maps that msgpackDecoder accepts. This is far deeper than any
+// real property value, but shallow enough that corrupt input cannot exhaust the stack.
+const maxMsgpackDepth = 1000
+
+// msgpackDecoder decodes the subset of MessagePack that has a "JSON-like" protobuf equivalent: nil, booleans,
+// integers, floats, strings, arrays, and maps with string keys.
+type msgpackDecoder struct {
+	buf   []byte
+	depth int // the number of arrays and maps that enclose the value being decoded.
+}
+
+var (
+	errMsgpackTruncated = errors.New("unexpected end of input")
+	errMsgpackTooDeep   = fmt.Errorf("arrays and maps nested more than %d deep", maxMsgpackDepth)
+)
+
+func (d *msgpackDecoder) next(n int) ([]byte, error) {
+	if len(d.buf) < n {
+		return nil, errMsgpackTruncated
+	}
+	b := d.buf[:n]
+	d.buf = d.buf[n:]
+	return b, nil
+}
+
+func (d *msgpackDecoder) uint(n int) (uint64, error) {
+	b, err := d.next(n)
+	if err != nil {
+		return 0, err
+	}
+	var u uint64
+	for _, c := range b {
+		u = u<<8 | uint64(c)
+	}
+	return u, nil
+}
+
+// nest records that the decoder is entering an array or map, failing if that exceeds maxMsgpackDepth. The returned
+// function records that the decoder has left it.
+func (d *msgpackDecoder) nest() (func(), error) {
+	if d.depth == maxMsgpackDepth {
+		return nil, errMsgpackTooDeep
+	}
+	d.depth++
+	return func() { d.depth-- }, nil
+}
+
+func (d *msgpackDecoder) decodeValue() (*structpb.Value, error) {
+	b, err := d.next(1)
+	if err != nil {
+		return nil, err
+	}
+
+	number := func(f float64) (*structpb.Value, error) {
+		return &structpb.Value{Kind: &structpb.Value_NumberValue{NumberValue: f}}, nil
+	}
+	sized := func(n int, decode func(int) (*structpb.Value, error)) (*structpb.Value, error) {
+		length, err := d.uint(n)
+		if err != nil {
+			return nil, err
+		}
+		return decode(int(length))
+	}
+
+	switch tag := b[0]; {
+	case tag <= 0x7f:
+		return number(float64(tag))
+	case tag >= 0xe0:
+		return number(float64(int8(tag)))
+	case tag&0xf0 == 0x80:
+		return d.decodeMap(int(tag & 0x0f))
+	case tag&0xf0 == 0x90:
+		return d.decodeArray(int(tag & 0x0f))
+	case tag&0xe0 == 0xa0:
+		return d.decodeString(int(tag & 0x1f))
+	case tag == 0xc0:
+		return &structpb.Value{Kind: &structpb.Value_NullValue{}}, nil
+	case tag == 0xc2 || tag == 0xc3:
+		return &structpb.Value{Kind: &structpb.Value_BoolValue{BoolValue: tag == 0xc3}}, nil
+	case tag == 0xca:
+		u, err := d.uint(4)
+		if err != nil {
+			return nil, err
+		}
+		return number(float64(math.Float32frombits(uint32(u))))
+	case tag == 0xcb:
+		u, err := d.uint(8)
+		if err != nil {
+			return nil, err
+		}
+		return number(math.Float64frombits(u))
+	case tag >= 0xcc && tag <= 0xcf:
+		u, err := d.uint(1 << (tag - 0xcc))
+		if err != nil {
+			return nil, err
+		}
+		return number(float64(u))
+	case tag >= 0xd0 && tag <= 0xd3:
+		n := 1 << (tag - 0xd0)
+		u, err := d.uint(n)
+		if err != nil {
+			return nil, err
+		}
+		// Sign-extend the n-byte value.
+		shift := 64 - 8*n
+		return number(float64(int64(u<<shift) >> shift))
+	case tag == 0xd9:
+		return sized(1, d.decodeString)
+	case tag == 0xda:
+		return sized(2, d.decodeString)
+	case tag == 0xdb:
+		return sized(4, d.decodeString)
+	case tag == 0xdc:
+		return sized(2, d.decodeArray)
+	case tag == 0xdd:
+		return sized(4, d.decodeArray)
+	case tag == 0xde:
+		return sized(2, d.decodeMap)
+	case tag == 0xdf:
+		return sized(4, d.decodeMap)
+	default:
+		return nil, fmt.Errorf("unsupported MessagePack type 0x%02x", tag)
+	}
+}
+
+func (d *msgpackDecoder) decodeString(n int) (*structpb.Value, error) {
+	b, err := d.next(n)
+	if err != nil {
+		return nil, err
+	}
+	return &structpb.Value{Kind: &structpb.Value_StringValue{StringValue: string(b)}}, nil
+}
+
+func (d *msgpackDecoder) decodeArray(n int) (*structpb.Value, error) {
+	// Each element takes at least one byte, so a length greater than the remaining input is invalid. Checking this
+	// up front avoids large allocations for corrupt input.
+	if n > len(d.buf) {
+		return nil, errMsgpackTruncated
+	}
+	leave, err := d.nest()
+	if err != nil {
+		return nil, err
+	}
+	defer leave()
+
+	values := make([]*structpb.Value, n)
+	for i := range values {
+		v, err := d.decodeValue()
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+	return &structpb.Value{Kind: &structpb.Value_ListValue{ListValue: &structpb.ListValue{Values: values}}}, nil
+}
+
+func (d *msgpackDecoder) decodeMap(n int) (*structpb.Value, error) {
+	if 2*n > len(d.buf) {
+		return nil, errMsgpackTruncated
+	}
+	leave, err := d.nest()
+	if err != nil {
+		return nil, err
+	}
+	defer leave()
+
+	fields := make(map[string]*structpb.Value, n)
+	for i := 0; i < n; i++ {
+		k, err := d.decodeValue()
+		if err != nil {
+			return nil, err
+		}
+		key, ok := k.Kind.(*structpb.Value_StringValue)
+		if !ok {
+			return nil, errors.New("map keys must be strings")
+		}
+		v, err := d.decodeValue()
+		if err != nil {
+			return nil, err
+		}
+		fields[key.StringValue] = v
+	}
+	return &structpb.Value{Kind: &structpb.Value_StructValue{StructValue: &structpb.Struct{Fields: fields}}}, nil
+}
@@ -0,0 +1,239 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	structpb "github.com/golang/protobuf/ptypes/struct"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+func TestMsgpackEncoding(t *testing.T) {
+	t.Parallel()
+
+	number := func(f float64) *structpb.Value {
+		return &structpb.Value{Kind: &structpb.Value_NumberValue{NumberValue: f}}
+	}
+
+	// The expected encodings are taken from the MessagePack specification.
+	cases := []struct {
+		value    *structpb.Value
+		expected []byte
+	}{
+		{&structpb.Value{Kind: &structpb.Value_NullValue{}}, []byte{0xc0}},
+		{&structpb.Value{Kind: &structpb.Value_BoolValue{BoolValue: true}}, []byte{0xc3}},
+		{number(0), []byte{0x00}},
+		{number(127), []byte{0x7f}},
+		{number(-32), []byte{0xe0}},
+		{number(-33), []byte{0xd0, 0xdf}},
+		{number(128), []byte{0xd1, 0x00, 0x80}},
+		{number(-40000), []byte{0xd2, 0xff, 0xff, 0x63, 0xc0}},
+		{number(1 << 40), []byte{0xd3, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00}},
+		{number(1.5), []byte{0xcb, 0x3f, 0xf8, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}},
+		{number(math.Copysign(0, -1)), []byte{0xcb, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}},
+		{stringValue("abc"), []byte{0xa3, 'a', 'b', 'c'}},
+		{
+			&structpb.Value{Kind: &structpb.Value_ListValue{ListValue: &structpb.ListValue{
+				Values: []*structpb.Value{number(1), stringValue("")},
+			}}},
+			[]byte{0x92, 0x01, 0xa0},
+		},
+		{
+			MarshalStruct(&structpb.Struct{Fields: map[string]*structpb.Value{"b": number(2), "a": number(1)}},
+				MarshalOptions{}),
+			[]byte{0x82, 0xa1, 'a', 0x01, 0xa1, 'b', 0x02},
+		},
+	}
+	for _, c := range cases {
+		var e msgpackEncoder
+		e.encodeValue(c.value)
+		assert.Equal(t, c.expected, e.buf, "%v", c.value)
+
+		d := msgpackDecoder{buf: e.buf}
+		decoded, err := d.decodeValue()
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(c.value, decoded), "%v != %v", c.value, decoded)
+	}
+
+	// Formats that the encoder never produces can still be decoded.
+	d := msgpackDecoder{buf: []byte{0xcd, 0x01, 0x00, 0xca, 0x3f, 0xc0, 0x00, 0x00}}
+	for _, expected := range []float64{256, 1.5} {
+		v, err := d.decodeValue()
+		require.NoError(t, err)
+		assert.Equal(t, expected, v.GetNumberValue())
+	}
+
+	// Values may nest up to maxMsgpackDepth arrays deep, but no deeper.
+	nested := func(depth int) []byte {
+		return append(bytes.Repeat([]byte{0x91}, depth), 0xc0)
+	}
+	d = msgpackDecoder{buf: nested(maxMsgpackDepth)}
+	_, err := d.decodeValue()
+	assert.NoError(t, err)
+	d = msgpackDecoder{buf: nested(maxMsgpackDepth + 1)}
+	_, err = d.decodeValue()
+	assert.ErrorIs(t, err, errMsgpackTooDeep)
+
+	// Truncated and unsupported input is rejected.
+	for _, data := range [][]byte{{}, {0xa3, 'a'}, {0x92, 0x01}, {0xdd, 0xff, 0xff, 0xff, 0xff}, {0xc4, 0x00}} {
+		d := msgpackDecoder{buf: data}
+		_, err := d.decodeValue()
+		assert.Error(t, err, "%x", data)
+	}
+}
+
+func TestMsgpackTurnaround(t *testing.T) {
+	t.Parallel()
+
+	rapid.Check(t, func(t *rapid.T) {
+		v := ValueGenerator(6).Draw(t, "wire value").(*structpb.Value)
+
+		var e msgpackEncoder
+		e.encodeValue(v)
+		d := msgpackDecoder{buf: e.buf}
+		decoded, err := d.decodeValue()
+		require.NoError(t, err)
+		require.Empty(t, d.buf)
+		require.True(t, proto.Equal(v, decoded), "%v != %v", v, decoded)
+	})
+}
+
+func TestBinaryProperties(t *testing.T) {
+	t.Parallel()
+
+	props := resource.PropertyMap{
+		"name":     resource.NewStringProperty("bucket"),
+		"count":    resource.NewNumberProperty(3),
+		"ratio":    resource.NewNumberProperty(0.25),
+		"enabled":  resource.NewBoolProperty(true),
+		"password": resource.MakeSecret(resource.NewStringProperty("hunter2")),
+		"arn":      resource.MakeComputed(resource.NewStringProperty("")),
+		"tags": resource.NewObjectProperty(resource.PropertyMap{
+			"env": resource.NewStringProperty("prod"),
+		}),
+		"zones": resource.NewArrayProperty([]resource.PropertyValue{
+			resource.NewStringProperty("a"),
+			resource.NewNullProperty(),
+		}),
+	}
+
+	data, err := MarshalBinaryProperties(props, marshalOpts)
+	require.NoError(t, err)
+	actual, err := UnmarshalBinaryProperties(data, marshalOpts)
+	require.NoError(t, err)
+	assert.Equal(t, props, actual)
+
+	// The binary encoding honors the same options as the "JSON-like" encoding.
+	data, err = MarshalBinaryProperties(props, MarshalOptions{})
+	require.NoError(t, err)
+	actual, err = UnmarshalBinaryProperties(data, MarshalOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, resource.NewStringProperty("hunter2"), actual["password"])
+	assert.NotContains(t, actual, "arn")
+
+	_, err = UnmarshalBinaryProperties(append(data, 0xc0), marshalOpts)
+	assert.Error(t, err)
+	_, err = UnmarshalBinaryProperties([]byte{0x90}, marshalOpts)
+	assert.Error(t, err)
+}
+
+// benchmarkProperties returns a map with 1000 properties of assorted types.
+func benchmarkProperties() resource.PropertyMap {
+	props := resource.PropertyMap{}
+	for i := 0; i < 1000; i++ {
+		key := resource.PropertyKey(fmt.Sprintf("property%d", i))
+		switch i % 4 {
+		case 0:
+			props[key] = resource.NewStringProperty(fmt.Sprintf("value-%d", i))
+		case 1:
+			props[key] = resource.NewNumberProperty(float64(i))
+		case 2:
+			props[key] = resource.NewBoolProperty(i%3 == 0)
+		case 3:
+			props[key] = resource.NewObjectProperty(resource.PropertyMap{
+				"id":   resource.NewNumberProperty(float64(i)),
+				"tags": resource.NewArrayProperty([]resource.PropertyValue{resource.NewStringProperty("a")}),
+			})
+		}
+	}
+	return props
+}
+
+func BenchmarkMarshalProperties(b *testing.B) {
+	props := benchmarkProperties()
+
+	b.Run("struct", func(b *testing.B) {
+		b.ReportAllocs()
+		var size int
+		for i := 0; i < b.N; i++ {
+			s, err := MarshalProperties(props, marshalOpts)
+			require.NoError(b, err)
+			data, err := proto.Marshal(s)
+			require.NoError(b, err)
+			size = len(data)
+		}
+		b.ReportMetric(float64(size), "bytes")
+	})
+	b.Run("binary", func(b *testing.B) {
+		b.ReportAllocs()
+		var size int
+		for i := 0; i < b.N; i++ {
+			data, err := MarshalBinaryProperties(props, marshalOpts)
+			require.NoError(b, err)
+			size = len(data)
+		}
+		b.ReportMetric(float64(size), "bytes")
+	})
+}
+
+func BenchmarkUnmarshalProperties(b *testing.B) {
+	props := benchmarkProperties()
+
+	b.Run("struct", func(b *testing.B) {
+		s, err := MarshalProperties(props, marshalOpts)
+		require.NoError(b, err)
+		data, err := proto.Marshal(s)
+		require.NoError(b, err)
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			var s structpb.Struct
+			require.NoError(b, proto.Unmarshal(data, &s))
+			_, err := UnmarshalProperties(&s, marshalOpts)
+			require.NoError(b, err)
+		}
+	})
+	b.Run("binary", func(b *testing.B) {
+		data, err := MarshalBinaryProperties(props, marshalOpts)
+		require.NoError(b, err)
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_, err := UnmarshalBinaryProperties(data, marshalOpts)
+			require.NoError(b, err)
+		}
+	})
+}
@@ -0,0 +1,94 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package testing contains helpers for building plugin values in tests.
+package testing
+
+import (
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+)
+
+// NewDetailedDiff returns a detailed diff built from alternating property paths and diffs, e.g.
+//
+//	NewDetailedDiff("foo", plugin.DiffUpdate, "bar[0]", plugin.DiffDelete)
+//
+// Each path must be a string and each diff must be either a plugin.DiffKind or a plugin.PropertyDiff. Malformed
+// arguments cause a panic.
+func NewDetailedDiff(pairs ...interface{}) map[string]plugin.PropertyDiff {
+	contract.Requiref(len(pairs)%2 == 0, "pairs", "must have an even number of elements, not %d", len(pairs))
+
+	diff := make(map[string]plugin.PropertyDiff, len(pairs)/2)
+	for i := 0; i < len(pairs); i += 2 {
+		path, ok := pairs[i].(string)
+		contract.Requiref(ok, "pairs", "element %d must be a string, not %T", i, pairs[i])
+
+		switch d := pairs[i+1].(type) {
+		case plugin.DiffKind:
+			diff[path] = plugin.PropertyDiff{Kind: d}
+		case plugin.PropertyDiff:
+			diff[path] = d
+		default:
+			contract.Failf("element %d of pairs must be a plugin.DiffKind or plugin.PropertyDiff, not %T", i+1, d)
+		}
+	}
+	return diff
+}
+
+// DetailedDiffBuilder builds a detailed diff one property at a time, e.g.
+//
+//	NewDetailedDiffBuilder().WithUpdate("foo").WithDelete("bar[0]").Build()
+type DetailedDiffBuilder struct {
+	diff map[string]plugin.PropertyDiff
+}
+
+// NewDetailedDiffBuilder returns an empty DetailedDiffBuilder.
+func NewDetailedDiffBuilder() *DetailedDiffBuilder {
+	return &DetailedDiffBuilder{diff: map[string]plugin.PropertyDiff{}}
+}
+
+// With records a diff of the given kind for the given property path.
+func (b *DetailedDiffBuilder) With(path string, kind plugin.DiffKind) *DetailedDiffBuilder {
+	b.diff[path] = plugin.PropertyDiff{Kind: kind}
+	return b
+}
+
+// WithAdd records that the given property was added.
+func (b *DetailedDiffBuilder) WithAdd(path string) *DetailedDiffBuilder {
+	return b.With(path, plugin.DiffAdd)
+}
+
+// WithUpdate records that the given property was updated.
+func (b *DetailedDiffBuilder) WithUpdate(path string) *DetailedDiffBuilder {
+	return b.With(path, plugin.DiffUpdate)
+}
+
+// WithDelete records that the given property was deleted.
+func (b *DetailedDiffBuilder) WithDelete(path string) *DetailedDiffBuilder {
+	return b.With(path, plugin.DiffDelete)
+}
+
+// WithUpdateReplace records that the given property was updated and that the update requires a replacement.
+func (b *DetailedDiffBuilder) WithUpdateReplace(path string) *DetailedDiffBuilder {
+	return b.With(path, plugin.DiffUpdateReplace)
+}
+
+// Build returns the detailed diff. The builder may continue to be used afterwards without affecting the result.
+func (b *DetailedDiffBuilder) Build() map[string]plugin.PropertyDiff {
+	diff := make(map[string]plugin.PropertyDiff, len(b.diff))
+	for k, v := range b.diff {
+		diff[k] = v
+	}
+	return diff
+}
@@ -0,0 +1,63 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+)
+
+func TestNewDetailedDiff(t *testing.T) {
+	t.Parallel()
+
+	expected := map[string]plugin.PropertyDiff{
+		"foo":    {Kind: plugin.DiffUpdate},
+		"bar[0]": {Kind: plugin.DiffDelete},
+		"baz":    {Kind: plugin.DiffAdd, InputDiff: true},
+	}
+
+	assert.Equal(t, expected, NewDetailedDiff(
+		"foo", plugin.DiffUpdate,
+		"bar[0]", plugin.DiffDelete,
+		"baz", plugin.PropertyDiff{Kind: plugin.DiffAdd, InputDiff: true}))
+
+	assert.Panics(t, func() { NewDetailedDiff("foo") })
+	assert.Panics(t, func() { NewDetailedDiff(1, plugin.DiffAdd) })
+	assert.Panics(t, func() { NewDetailedDiff("foo", "update") })
+}
+
+func TestDetailedDiffBuilder(t *testing.T) {
+	t.Parallel()
+
+	b := NewDetailedDiffBuilder().
+		WithAdd("a").
+		WithUpdate("b").
+		WithDelete("c").
+		WithUpdateReplace("d")
+	diff := b.Build()
+	assert.Equal(t, NewDetailedDiff(
+		"a", plugin.DiffAdd,
+		"b", plugin.DiffUpdate,
+		"c", plugin.DiffDelete,
+		"d", plugin.DiffUpdateReplace), diff)
+
+	// Later changes to the builder do not affect diffs that have already been built.
+	b.WithAdd("e")
+	assert.Len(t, diff, 4)
+	assert.Len(t, b.Build(), 5)
+}
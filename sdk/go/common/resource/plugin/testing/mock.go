@@ -0,0 +1,616 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing
+
+import (
+	"context"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+// MockProvider is a plugin.Provider whose methods are supplied by the test that builds it, e.g.
+//
+//	NewMockProvider(WithCreate(func(...) {...}), WithCheck(func(...) {...}))
+//
+// Calling a method that was not registered panics, unless the provider was built with WithDefaultNYI, in which case
+// the method returns plugin.ErrNotYetImplemented. Pkg and Close never panic: Pkg returns the package set by WithPkg
+// (or "mock"), and Close returns nil unless WithClose was used.
+type MockProvider struct {
+	pkg        tokens.Package
+	defaultNYI bool
+
+	closeF                func() error
+	getSchemaF            func(ctx context.Context, version int) (plugin.GetSchemaResponse, error)
+	getSupportedVersionsF func(ctx context.Context) ([]int, error)
+	checkConfigF          func(ctx context.Context, urn resource.URN, olds, news resource.PropertyMap,
+		allowUnknowns bool) (resource.PropertyMap, []plugin.CheckFailure, error)
+	configChecksumMatchF func(ctx context.Context, urn resource.URN, checksum string) (bool, error)
+	diffConfigF          func(ctx context.Context, urn resource.URN, olds, news resource.PropertyMap,
+		allowUnknowns bool, ignoreChanges []string) (plugin.DiffResult, error)
+	configureF               func(ctx context.Context, cfg plugin.ProviderConfig) error
+	validateF                func(ctx context.Context) error
+	parameterizeByValueF     func(ctx context.Context, value []byte) (plugin.ParameterizeResult, error)
+	parameterizeByReferenceF func(ctx context.Context,
+		ref plugin.ParameterizeReference) (plugin.ParameterizeResult, error)
+	checkF func(ctx context.Context, urn resource.URN, olds, news resource.PropertyMap, allowUnknowns bool,
+		randomSeed []byte) (resource.PropertyMap, []plugin.CheckFailure, error)
+	diffF func(ctx context.Context, urn resource.URN, id resource.ID, olds, news resource.PropertyMap,
+		allowUnknowns bool, ignoreChanges []string) (plugin.DiffResult, error)
+	createF func(ctx context.Context, urn resource.URN, news resource.PropertyMap, timeout float64,
+		preview bool) (resource.ID, resource.PropertyMap, resource.Status, error)
+	streamCreateF func(ctx context.Context, urn resource.URN, news resource.PropertyMap, timeout float64,
+		preview bool, onNext func(resource.PropertyMap) error) (resource.ID, resource.PropertyMap, resource.Status,
+		error)
+	waitForResourceReadyF func(ctx context.Context, urn resource.URN, id resource.ID,
+		timeout float64) error
+	readF func(ctx context.Context, urn resource.URN, id resource.ID,
+		inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error)
+	prepareImportF func(ctx context.Context, urn resource.URN, id resource.ID) (resource.PropertyMap, error)
+	refreshF       func(ctx context.Context, urn resource.URN, id resource.ID,
+		inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error)
+	readStreamF func(ctx context.Context, urn resource.URN, id resource.ID, inputs, state resource.PropertyMap,
+		onNext func(plugin.ReadResult) error) (resource.Status, error)
+	batchReadF     func(ctx context.Context, requests []plugin.BatchReadRequest) ([]plugin.BatchReadResponse, error)
+	schemaVersionF func(ctx context.Context) (int, error)
+	migrateStateF  func(ctx context.Context, urn resource.URN, stateVersion int,
+		state resource.PropertyMap) (resource.PropertyMap, error)
+	getResourceAliasesF func(ctx context.Context,
+		urn resource.URN) ([]resource.Alias, error)
+	updateF func(ctx context.Context, urn resource.URN, id resource.ID, olds, news resource.PropertyMap,
+		timeout float64, ignoreChanges []string, preview bool) (resource.PropertyMap, resource.Status, error)
+	deleteF func(ctx context.Context, urn resource.URN, id resource.ID, props resource.PropertyMap,
+		timeout float64) (resource.Status, error)
+	constructF func(ctx context.Context, info plugin.ConstructInfo, typ tokens.Type, name tokens.QName,
+		parent resource.URN, inputs resource.PropertyMap, options plugin.ConstructOptions) (plugin.ConstructResult, error)
+	invokeF func(ctx context.Context, tok tokens.ModuleMember,
+		args resource.PropertyMap) (resource.PropertyMap, []plugin.CheckFailure, error)
+	streamInvokeF func(ctx context.Context, tok tokens.ModuleMember, args resource.PropertyMap,
+		onNext func(plugin.StreamInvokeEvent) error) ([]plugin.CheckFailure, error)
+	callF func(ctx context.Context, tok tokens.ModuleMember, args resource.PropertyMap, info plugin.CallInfo,
+		options plugin.CallOptions) (plugin.CallResult, error)
+	estimateCostF func(ctx context.Context, urn resource.URN,
+		news resource.PropertyMap) (plugin.CostEstimate, error)
+	getPluginInfoF      func(ctx context.Context) (workspace.PluginInfo, error)
+	getMappingF         func(ctx context.Context, key string) ([]byte, string, error)
+	supportsFeatureF    func(ctx context.Context, feature string) (bool, error)
+	registerEmitterF    func(e plugin.EventEmitter) error
+	watchConfigF        func(ctx context.Context, onChange func(resource.PropertyMap)) error
+	signalCancellationF func(ctx context.Context) error
+}
+
+var _ plugin.Provider = (*MockProvider)(nil)
+
+// MockProviderOption configures a MockProvider built by NewMockProvider.
+type MockProviderOption func(p *MockProvider)
+
+// NewMockProvider returns a MockProvider configured by the given options.
+func NewMockProvider(opts ...MockProviderOption) *MockProvider {
+	p := &MockProvider{pkg: "mock"}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// WithPkg sets the package returned by the provider's Pkg method.
+func WithPkg(pkg tokens.Package) MockProviderOption {
+	return func(p *MockProvider) { p.pkg = pkg }
+}
+
+// WithDefaultNYI makes methods that were not registered return plugin.ErrNotYetImplemented rather than panic.
+func WithDefaultNYI() MockProviderOption {
+	return func(p *MockProvider) { p.defaultNYI = true }
+}
+
+// WithClose registers the provider's Close method.
+func WithClose(f func() error) MockProviderOption {
+	return func(p *MockProvider) { p.closeF = f }
+}
+
+// WithGetSchema registers the provider's GetSchema method.
+func WithGetSchema(f func(ctx context.Context, version int) (plugin.GetSchemaResponse, error)) MockProviderOption {
+	return func(p *MockProvider) { p.getSchemaF = f }
+}
+
+// WithGetSupportedVersions registers the provider's GetSupportedVersions method.
+func WithGetSupportedVersions(f func(ctx context.Context) ([]int, error)) MockProviderOption {
+	return func(p *MockProvider) { p.getSupportedVersionsF = f }
+}
+
+// WithCheckConfig registers the provider's CheckConfig method.
+func WithCheckConfig(f func(ctx context.Context, urn resource.URN, olds, news resource.PropertyMap,
+	allowUnknowns bool) (resource.PropertyMap, []plugin.CheckFailure, error)) MockProviderOption {
+	return func(p *MockProvider) { p.checkConfigF = f }
+}
+
+// WithConfigChecksumMatch registers the provider's ConfigChecksumMatch method.
+func WithConfigChecksumMatch(
+	f func(ctx context.Context, urn resource.URN, checksum string) (bool, error)) MockProviderOption {
+	return func(p *MockProvider) { p.configChecksumMatchF = f }
+}
+
+// WithDiffConfig registers the provider's DiffConfig method.
+func WithDiffConfig(f func(ctx context.Context, urn resource.URN, olds, news resource.PropertyMap, allowUnknowns bool,
+	ignoreChanges []string) (plugin.DiffResult, error)) MockProviderOption {
+	return func(p *MockProvider) { p.diffConfigF = f }
+}
+
+// WithConfigure registers the provider's Configure method.
+func WithConfigure(f func(ctx context.Context, cfg plugin.ProviderConfig) error) MockProviderOption {
+	return func(p *MockProvider) { p.configureF = f }
+}
+
+// WithValidate registers the provider's Validate method.
+func WithValidate(f func(ctx context.Context) error) MockProviderOption {
+	return func(p *MockProvider) { p.validateF = f }
+}
+
+// WithParameterizeByValue registers the provider's ParameterizeByValue method.
+func WithParameterizeByValue(
+	f func(ctx context.Context, value []byte) (plugin.ParameterizeResult, error)) MockProviderOption {
+	return func(p *MockProvider) { p.parameterizeByValueF = f }
+}
+
+// WithParameterizeByReference registers the provider's ParameterizeByReference method.
+func WithParameterizeByReference(f func(ctx context.Context,
+	ref plugin.ParameterizeReference) (plugin.ParameterizeResult, error)) MockProviderOption {
+	return func(p *MockProvider) { p.parameterizeByReferenceF = f }
+}
+
+// WithCheck registers the provider's Check method.
+func WithCheck(f func(ctx context.Context, urn resource.URN, olds, news resource.PropertyMap, allowUnknowns bool,
+	randomSeed []byte) (resource.PropertyMap, []plugin.CheckFailure, error)) MockProviderOption {
+	return func(p *MockProvider) { p.checkF = f }
+}
+
+// WithDiff registers the provider's Diff method.
+func WithDiff(f func(ctx context.Context, urn resource.URN, id resource.ID, olds, news resource.PropertyMap,
+	allowUnknowns bool, ignoreChanges []string) (plugin.DiffResult, error)) MockProviderOption {
+	return func(p *MockProvider) { p.diffF = f }
+}
+
+// WithCreate registers the provider's Create method.
+func WithCreate(f func(ctx context.Context, urn resource.URN, news resource.PropertyMap, timeout float64,
+	preview bool) (resource.ID, resource.PropertyMap, resource.Status, error)) MockProviderOption {
+	return func(p *MockProvider) { p.createF = f }
+}
+
+// WithStreamCreate registers the provider's StreamCreate method.
+func WithStreamCreate(f func(ctx context.Context, urn resource.URN, news resource.PropertyMap, timeout float64,
+	preview bool, onNext func(resource.PropertyMap) error) (resource.ID, resource.PropertyMap, resource.Status,
+	error)) MockProviderOption {
+	return func(p *MockProvider) { p.streamCreateF = f }
+}
+
+// WithWaitForResourceReady registers the provider's WaitForResourceReady method.
+func WithWaitForResourceReady(
+	f func(ctx context.Context, urn resource.URN, id resource.ID, timeout float64) error) MockProviderOption {
+	return func(p *MockProvider) { p.waitForResourceReadyF = f }
+}
+
+// WithRead registers the provider's Read method.
+func WithRead(f func(ctx context.Context, urn resource.URN, id resource.ID,
+	inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error)) MockProviderOption {
+	return func(p *MockProvider) { p.readF = f }
+}
+
+// WithPrepareImport registers the provider's PrepareImport method.
+func WithPrepareImport(
+	f func(ctx context.Context, urn resource.URN, id resource.ID) (resource.PropertyMap, error)) MockProviderOption {
+	return func(p *MockProvider) { p.prepareImportF = f }
+}
+
+// WithRefresh registers the provider's Refresh method.
+func WithRefresh(f func(ctx context.Context, urn resource.URN, id resource.ID,
+	inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error)) MockProviderOption {
+	return func(p *MockProvider) { p.refreshF = f }
+}
+
+// WithReadStream registers the provider's ReadStream method.
+func WithReadStream(f func(ctx context.Context, urn resource.URN, id resource.ID, inputs, state resource.PropertyMap,
+	onNext func(plugin.ReadResult) error) (resource.Status, error)) MockProviderOption {
+	return func(p *MockProvider) { p.readStreamF = f }
+}
+
+// WithBatchRead registers the provider's BatchRead method.
+func WithBatchRead(f func(ctx context.Context,
+	requests []plugin.BatchReadRequest) ([]plugin.BatchReadResponse, error)) MockProviderOption {
+	return func(p *MockProvider) { p.batchReadF = f }
+}
+
+// WithSchemaVersion registers the provider's SchemaVersion method.
+func WithSchemaVersion(f func(ctx context.Context) (int, error)) MockProviderOption {
+	return func(p *MockProvider) { p.schemaVersionF = f }
+}
+
+// WithMigrateState registers the provider's MigrateState method.
+func WithMigrateState(f func(ctx context.Context, urn resource.URN, stateVersion int,
+	state resource.PropertyMap) (resource.PropertyMap, error)) MockProviderOption {
+	return func(p *MockProvider) { p.migrateStateF = f }
+}
+
+// WithGetResourceAliases registers the provider's GetResourceAliases method.
+func WithGetResourceAliases(
+	f func(ctx context.Context, urn resource.URN) ([]resource.Alias, error),
+) MockProviderOption {
+	return func(p *MockProvider) { p.getResourceAliasesF = f }
+}
+
+// WithUpdate registers the provider's Update method.
+func WithUpdate(f func(ctx context.Context, urn resource.URN, id resource.ID, olds, news resource.PropertyMap,
+	timeout float64, ignoreChanges []string, preview bool) (resource.PropertyMap, resource.Status, error),
+) MockProviderOption {
+	return func(p *MockProvider) { p.updateF = f }
+}
+
+// WithDelete registers the provider's Delete method.
+func WithDelete(f func(ctx context.Context, urn resource.URN, id resource.ID, props resource.PropertyMap,
+	timeout float64) (resource.Status, error)) MockProviderOption {
+	return func(p *MockProvider) { p.deleteF = f }
+}
+
+// WithConstruct registers the provider's Construct method.
+func WithConstruct(f func(ctx context.Context, info plugin.ConstructInfo, typ tokens.Type, name tokens.QName,
+	parent resource.URN, inputs resource.PropertyMap, options plugin.ConstructOptions) (plugin.ConstructResult, error),
+) MockProviderOption {
+	return func(p *MockProvider) { p.constructF = f }
+}
+
+// WithInvoke registers the provider's Invoke method.
+func WithInvoke(f func(ctx context.Context, tok tokens.ModuleMember,
+	args resource.PropertyMap) (resource.PropertyMap, []plugin.CheckFailure, error)) MockProviderOption {
+	return func(p *MockProvider) { p.invokeF = f }
+}
+
+// WithStreamInvoke registers the provider's StreamInvoke method.
+func WithStreamInvoke(f func(ctx context.Context, tok tokens.ModuleMember, args resource.PropertyMap,
+	onNext func(plugin.StreamInvokeEvent) error) ([]plugin.CheckFailure, error)) MockProviderOption {
+	return func(p *MockProvider) { p.streamInvokeF = f }
+}
+
+// WithCall registers the provider's Call method.
+func WithCall(f func(ctx context.Context, tok tokens.ModuleMember, args resource.PropertyMap, info plugin.CallInfo,
+	options plugin.CallOptions) (plugin.CallResult, error)) MockProviderOption {
+	return func(p *MockProvider) { p.callF = f }
+}
+
+// WithGetPluginInfo registers the provider's GetPluginInfo method.
+func WithGetPluginInfo(f func(ctx context.Context) (workspace.PluginInfo, error)) MockProviderOption {
+	return func(p *MockProvider) { p.getPluginInfoF = f }
+}
+
+// WithGetMapping registers the provider's GetMapping method.
+func WithGetMapping(f func(ctx context.Context, key string) ([]byte, string, error)) MockProviderOption {
+	return func(p *MockProvider) { p.getMappingF = f }
+}
+
+// WithSupportsFeature registers the provider's SupportsFeature method.
+func WithSupportsFeature(f func(ctx context.Context, feature string) (bool, error)) MockProviderOption {
+	return func(p *MockProvider) { p.supportsFeatureF = f }
+}
+
+// WithRegisterEventEmitter registers the provider's RegisterEventEmitter method.
+func WithRegisterEventEmitter(f func(e plugin.EventEmitter) error) MockProviderOption {
+	return func(p *MockProvider) { p.registerEmitterF = f }
+}
+
+// WithWatchConfig registers the provider's WatchConfig method.
+func WithWatchConfig(f func(ctx context.Context, onChange func(resource.PropertyMap)) error) MockProviderOption {
+	return func(p *MockProvider) { p.watchConfigF = f }
+}
+
+// WithEstimateCost registers the provider's EstimateCost method.
+func WithEstimateCost(f func(ctx context.Context, urn resource.URN,
+	news resource.PropertyMap) (plugin.CostEstimate, error)) MockProviderOption {
+	return func(p *MockProvider) { p.estimateCostF = f }
+}
+
+// WithSignalCancellation registers the provider's SignalCancellation method.
+func WithSignalCancellation(f func(ctx context.Context) error) MockProviderOption {
+	return func(p *MockProvider) { p.signalCancellationF = f }
+}
+
+// unregistered is called when a method that was not registered is invoked. It returns plugin.ErrNotYetImplemented if
+// the provider was built with WithDefaultNYI, and panics otherwise.
+func (p *MockProvider) unregistered(method string) error {
+	if !p.defaultNYI {
+		contract.Failf("MockProvider.%s was called but not registered", method)
+	}
+	return plugin.ErrNotYetImplemented
+}
+
+func (p *MockProvider) Close() error {
+	if p.closeF == nil {
+		return nil
+	}
+	return p.closeF()
+}
+
+func (p *MockProvider) Pkg() tokens.Package {
+	return p.pkg
+}
+
+func (p *MockProvider) GetSchema(ctx context.Context, version int) (plugin.GetSchemaResponse, error) {
+	if p.getSchemaF == nil {
+		return plugin.GetSchemaResponse{}, p.unregistered("GetSchema")
+	}
+	return p.getSchemaF(ctx, version)
+}
+
+func (p *MockProvider) GetSupportedVersions(ctx context.Context) ([]int, error) {
+	if p.getSupportedVersionsF == nil {
+		return nil, p.unregistered("GetSupportedVersions")
+	}
+	return p.getSupportedVersionsF(ctx)
+}
+
+func (p *MockProvider) CheckConfig(ctx context.Context, urn resource.URN, olds, news resource.PropertyMap,
+	allowUnknowns bool) (resource.PropertyMap, []plugin.CheckFailure, error) {
+	if p.checkConfigF == nil {
+		return nil, nil, p.unregistered("CheckConfig")
+	}
+	return p.checkConfigF(ctx, urn, olds, news, allowUnknowns)
+}
+
+func (p *MockProvider) ConfigChecksumMatch(ctx context.Context, urn resource.URN,
+	checksum string) (bool, error) {
+	if p.configChecksumMatchF == nil {
+		return false, p.unregistered("ConfigChecksumMatch")
+	}
+	return p.configChecksumMatchF(ctx, urn, checksum)
+}
+
+func (p *MockProvider) DiffConfig(ctx context.Context, urn resource.URN, olds, news resource.PropertyMap,
+	allowUnknowns bool, ignoreChanges []string) (plugin.DiffResult, error) {
+	if p.diffConfigF == nil {
+		return plugin.DiffResult{}, p.unregistered("DiffConfig")
+	}
+	return p.diffConfigF(ctx, urn, olds, news, allowUnknowns, ignoreChanges)
+}
+
+func (p *MockProvider) Configure(ctx context.Context, cfg plugin.ProviderConfig) error {
+	if p.configureF == nil {
+		return p.unregistered("Configure")
+	}
+	return p.configureF(ctx, cfg)
+}
+
+func (p *MockProvider) Validate(ctx context.Context) error {
+	if p.validateF == nil {
+		return p.unregistered("Validate")
+	}
+	return p.validateF(ctx)
+}
+
+func (p *MockProvider) ParameterizeByValue(ctx context.Context, value []byte) (plugin.ParameterizeResult, error) {
+	if p.parameterizeByValueF == nil {
+		return plugin.ParameterizeResult{}, p.unregistered("ParameterizeByValue")
+	}
+	return p.parameterizeByValueF(ctx, value)
+}
+
+func (p *MockProvider) ParameterizeByReference(ctx context.Context,
+	ref plugin.ParameterizeReference) (plugin.ParameterizeResult, error) {
+	if p.parameterizeByReferenceF == nil {
+		return plugin.ParameterizeResult{}, p.unregistered("ParameterizeByReference")
+	}
+	return p.parameterizeByReferenceF(ctx, ref)
+}
+
+func (p *MockProvider) Check(ctx context.Context, urn resource.URN, olds, news resource.PropertyMap,
+	allowUnknowns bool, randomSeed []byte) (resource.PropertyMap, []plugin.CheckFailure, error) {
+	if p.checkF == nil {
+		return nil, nil, p.unregistered("Check")
+	}
+	return p.checkF(ctx, urn, olds, news, allowUnknowns, randomSeed)
+}
+
+func (p *MockProvider) Diff(ctx context.Context, urn resource.URN, id resource.ID, olds, news resource.PropertyMap,
+	allowUnknowns bool, ignoreChanges []string) (plugin.DiffResult, error) {
+	if p.diffF == nil {
+		return plugin.DiffResult{}, p.unregistered("Diff")
+	}
+	return p.diffF(ctx, urn, id, olds, news, allowUnknowns, ignoreChanges)
+}
+
+func (p *MockProvider) Create(ctx context.Context, urn resource.URN, news resource.PropertyMap, timeout float64,
+	preview bool) (resource.ID, resource.PropertyMap, resource.Status, error) {
+	if p.createF == nil {
+		return "", nil, resource.StatusUnknown, p.unregistered("Create")
+	}
+	return p.createF(ctx, urn, news, timeout, preview)
+}
+
+func (p *MockProvider) StreamCreate(ctx context.Context, urn resource.URN, news resource.PropertyMap,
+	timeout float64, preview bool,
+	onNext func(resource.PropertyMap) error) (resource.ID, resource.PropertyMap, resource.Status, error) {
+	if p.streamCreateF == nil {
+		return "", nil, resource.StatusUnknown, p.unregistered("StreamCreate")
+	}
+	return p.streamCreateF(ctx, urn, news, timeout, preview, onNext)
+}
+
+func (p *MockProvider) WaitForResourceReady(ctx context.Context, urn resource.URN, id resource.ID,
+	timeout float64) error {
+	if p.waitForResourceReadyF == nil {
+		return p.unregistered("WaitForResourceReady")
+	}
+	return p.waitForResourceReadyF(ctx, urn, id, timeout)
+}
+
+func (p *MockProvider) Read(ctx context.Context, urn resource.URN, id resource.ID,
+	inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error) {
+	if p.readF == nil {
+		return plugin.ReadResult{}, resource.StatusUnknown, p.unregistered("Read")
+	}
+	return p.readF(ctx, urn, id, inputs, state)
+}
+
+func (p *MockProvider) PrepareImport(ctx context.Context, urn resource.URN,
+	id resource.ID) (resource.PropertyMap, error) {
+	if p.prepareImportF == nil {
+		return nil, p.unregistered("PrepareImport")
+	}
+	return p.prepareImportF(ctx, urn, id)
+}
+
+func (p *MockProvider) Refresh(ctx context.Context, urn resource.URN, id resource.ID,
+	inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error) {
+	if p.refreshF == nil {
+		return plugin.ReadResult{}, resource.StatusUnknown, p.unregistered("Refresh")
+	}
+	return p.refreshF(ctx, urn, id, inputs, state)
+}
+
+func (p *MockProvider) ReadStream(ctx context.Context, urn resource.URN, id resource.ID,
+	inputs, state resource.PropertyMap, onNext func(plugin.ReadResult) error) (resource.Status, error) {
+	if p.readStreamF == nil {
+		return resource.StatusUnknown, p.unregistered("ReadStream")
+	}
+	return p.readStreamF(ctx, urn, id, inputs, state, onNext)
+}
+
+func (p *MockProvider) BatchRead(ctx context.Context,
+	requests []plugin.BatchReadRequest) ([]plugin.BatchReadResponse, error) {
+	if p.batchReadF == nil {
+		return nil, p.unregistered("BatchRead")
+	}
+	return p.batchReadF(ctx, requests)
+}
+
+func (p *MockProvider) SchemaVersion(ctx context.Context) (int, error) {
+	if p.schemaVersionF == nil {
+		return 0, p.unregistered("SchemaVersion")
+	}
+	return p.schemaVersionF(ctx)
+}
+
+func (p *MockProvider) MigrateState(ctx context.Context, urn resource.URN, stateVersion int,
+	state resource.PropertyMap) (resource.PropertyMap, error) {
+	if p.migrateStateF == nil {
+		return nil, p.unregistered("MigrateState")
+	}
+	return p.migrateStateF(ctx, urn, stateVersion, state)
+}
+
+func (p *MockProvider) GetResourceAliases(ctx context.Context, urn resource.URN) ([]resource.Alias, error) {
+	if p.getResourceAliasesF == nil {
+		return nil, p.unregistered("GetResourceAliases")
+	}
+	return p.getResourceAliasesF(ctx, urn)
+}
+
+func (p *MockProvider) Update(ctx context.Context, urn resource.URN, id resource.ID,
+	olds, news resource.PropertyMap, timeout float64,
+	ignoreChanges []string, preview bool) (resource.PropertyMap, resource.Status, error) {
+	if p.updateF == nil {
+		return nil, resource.StatusUnknown, p.unregistered("Update")
+	}
+	return p.updateF(ctx, urn, id, olds, news, timeout, ignoreChanges, preview)
+}
+
+func (p *MockProvider) Delete(ctx context.Context, urn resource.URN, id resource.ID, props resource.PropertyMap,
+	timeout float64) (resource.Status, error) {
+	if p.deleteF == nil {
+		return resource.StatusUnknown, p.unregistered("Delete")
+	}
+	return p.deleteF(ctx, urn, id, props, timeout)
+}
+
+func (p *MockProvider) Construct(ctx context.Context, info plugin.ConstructInfo, typ tokens.Type, name tokens.QName,
+	parent resource.URN, inputs resource.PropertyMap, options plugin.ConstructOptions) (plugin.ConstructResult, error) {
+	if p.constructF == nil {
+		return plugin.ConstructResult{}, p.unregistered("Construct")
+	}
+	return p.constructF(ctx, info, typ, name, parent, inputs, options)
+}
+
+func (p *MockProvider) Invoke(ctx context.Context, tok tokens.ModuleMember,
+	args resource.PropertyMap) (resource.PropertyMap, []plugin.CheckFailure, error) {
+	if p.invokeF == nil {
+		return nil, nil, p.unregistered("Invoke")
+	}
+	return p.invokeF(ctx, tok, args)
+}
+
+func (p *MockProvider) StreamInvoke(ctx context.Context, tok tokens.ModuleMember, args resource.PropertyMap,
+	onNext func(plugin.StreamInvokeEvent) error) ([]plugin.CheckFailure, error) {
+	if p.streamInvokeF == nil {
+		return nil, p.unregistered("StreamInvoke")
+	}
+	return p.streamInvokeF(ctx, tok, args, onNext)
+}
+
+func (p *MockProvider) Call(ctx context.Context, tok tokens.ModuleMember, args resource.PropertyMap,
+	info plugin.CallInfo, options plugin.CallOptions) (plugin.CallResult, error) {
+	if p.callF == nil {
+		return plugin.CallResult{}, p.unregistered("Call")
+	}
+	return p.callF(ctx, tok, args, info, options)
+}
+
+func (p *MockProvider) GetPluginInfo(ctx context.Context) (workspace.PluginInfo, error) {
+	if p.getPluginInfoF == nil {
+		return workspace.PluginInfo{}, p.unregistered("GetPluginInfo")
+	}
+	return p.getPluginInfoF(ctx)
+}
+
+func (p *MockProvider) GetMapping(ctx context.Context, key string) ([]byte, string, error) {
+	if p.getMappingF == nil {
+		return nil, "", p.unregistered("GetMapping")
+	}
+	return p.getMappingF(ctx, key)
+}
+
+func (p *MockProvider) SupportsFeature(ctx context.Context, feature string) (bool, error) {
+	if p.supportsFeatureF == nil {
+		return false, p.unregistered("SupportsFeature")
+	}
+	return p.supportsFeatureF(ctx, feature)
+}
+
+func (p *MockProvider) RegisterEventEmitter(e plugin.EventEmitter) error {
+	if p.registerEmitterF == nil {
+		return p.unregistered("RegisterEventEmitter")
+	}
+	return p.registerEmitterF(e)
+}
+
+func (p *MockProvider) WatchConfig(ctx context.Context, onChange func(resource.PropertyMap)) error {
+	if p.watchConfigF == nil {
+		return p.unregistered("WatchConfig")
+	}
+	return p.watchConfigF(ctx, onChange)
+}
+
+func (p *MockProvider) EstimateCost(ctx context.Context, urn resource.URN,
+	news resource.PropertyMap) (plugin.CostEstimate, error) {
+	if p.estimateCostF == nil {
+		return plugin.CostEstimate{}, p.unregistered("EstimateCost")
+	}
+	return p.estimateCostF(ctx, urn, news)
+}
+
+func (p *MockProvider) SignalCancellation(ctx context.Context) error {
+	if p.signalCancellationF == nil {
+		return p.unregistered("SignalCancellation")
+	}
+	return p.signalCancellationF(ctx)
+}
@@ -0,0 +1,290 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+const mockURN = resource.URN("urn:pulumi:stack::project::mock:index:res::name")
+
+// callAll calls every method of the given provider other than Pkg and Close with zero-valued arguments and returns
+// the error returned by each call, keyed by method name.
+func callAll(p plugin.Provider) map[string]error {
+	ctx := context.Background()
+	errs := map[string]error{}
+	_, errs["GetSchema"] = p.GetSchema(ctx, 0)
+	_, errs["GetSupportedVersions"] = p.GetSupportedVersions(ctx)
+	_, _, errs["CheckConfig"] = p.CheckConfig(ctx, mockURN, nil, nil, false)
+	_, errs["ConfigChecksumMatch"] = p.ConfigChecksumMatch(ctx, mockURN, "checksum")
+	_, errs["DiffConfig"] = p.DiffConfig(ctx, mockURN, nil, nil, false, nil)
+	errs["Configure"] = p.Configure(ctx, plugin.ProviderConfig{})
+	errs["Validate"] = p.Validate(ctx)
+	_, errs["ParameterizeByValue"] = p.ParameterizeByValue(ctx, nil)
+	_, errs["ParameterizeByReference"] = p.ParameterizeByReference(ctx, plugin.ParameterizeReference{})
+	_, _, errs["Check"] = p.Check(ctx, mockURN, nil, nil, false, nil)
+	_, errs["Diff"] = p.Diff(ctx, mockURN, "id", nil, nil, false, nil)
+	_, _, _, errs["Create"] = p.Create(ctx, mockURN, nil, 0, false)
+	_, _, _, errs["StreamCreate"] = p.StreamCreate(ctx, mockURN, nil, 0, false, nil)
+	errs["WaitForResourceReady"] = p.WaitForResourceReady(ctx, mockURN, "id", 0)
+	_, _, errs["Read"] = p.Read(ctx, mockURN, "id", nil, nil)
+	_, errs["PrepareImport"] = p.PrepareImport(ctx, mockURN, "id")
+	_, _, errs["Refresh"] = p.Refresh(ctx, mockURN, "id", nil, nil)
+	_, errs["ReadStream"] = p.ReadStream(ctx, mockURN, "id", nil, nil, nil)
+	_, errs["BatchRead"] = p.BatchRead(ctx, nil)
+	_, errs["SchemaVersion"] = p.SchemaVersion(ctx)
+	_, errs["MigrateState"] = p.MigrateState(ctx, mockURN, 0, nil)
+	_, errs["GetResourceAliases"] = p.GetResourceAliases(ctx, mockURN)
+	_, _, errs["Update"] = p.Update(ctx, mockURN, "id", nil, nil, 0, nil, false)
+	_, errs["Delete"] = p.Delete(ctx, mockURN, "id", nil, 0)
+	_, errs["Construct"] = p.Construct(ctx, plugin.ConstructInfo{}, "mock:index:component", "name", "", nil,
+		plugin.ConstructOptions{})
+	_, _, errs["Invoke"] = p.Invoke(ctx, "mock:index:fn", nil)
+	_, errs["StreamInvoke"] = p.StreamInvoke(ctx, "mock:index:fn", nil, nil)
+	_, errs["Call"] = p.Call(ctx, "mock:index:fn", nil, plugin.CallInfo{}, plugin.CallOptions{})
+	_, errs["GetPluginInfo"] = p.GetPluginInfo(ctx)
+	_, _, errs["GetMapping"] = p.GetMapping(ctx, "key")
+	_, errs["SupportsFeature"] = p.SupportsFeature(ctx, "feature")
+	errs["RegisterEventEmitter"] = p.RegisterEventEmitter(nil)
+	errs["WatchConfig"] = p.WatchConfig(ctx, nil)
+	_, errs["EstimateCost"] = p.EstimateCost(ctx, mockURN, nil)
+	errs["SignalCancellation"] = p.SignalCancellation(ctx)
+	return errs
+}
+
+func TestMockProviderDefaults(t *testing.T) {
+	t.Parallel()
+
+	p := NewMockProvider()
+	assert.Equal(t, tokens.Package("mock"), p.Pkg())
+	assert.NoError(t, p.Close())
+
+	assert.Equal(t, tokens.Package("aws"), NewMockProvider(WithPkg("aws")).Pkg())
+	closeErr := errors.New("close failed")
+	assert.Equal(t, closeErr, NewMockProvider(WithClose(func() error { return closeErr })).Close())
+}
+
+func TestMockProviderPanicsOnUnregisteredMethods(t *testing.T) {
+	t.Parallel()
+
+	p := NewMockProvider()
+	assert.PanicsWithValue(t, "fatal: A failure has occurred: MockProvider.Create was called but not registered",
+		func() { _, _, _, _ = p.Create(context.Background(), mockURN, nil, 0, false) })
+	assert.Panics(t, func() { _ = p.Configure(context.Background(), plugin.ProviderConfig{}) })
+	assert.Panics(t, func() { _, _ = p.GetPluginInfo(context.Background()) })
+	assert.Panics(t, func() { callAll(p) })
+}
+
+func TestMockProviderDefaultNYI(t *testing.T) {
+	t.Parallel()
+
+	errs := callAll(NewMockProvider(WithDefaultNYI()))
+	assert.Len(t, errs, 35)
+	for method, err := range errs {
+		assert.Equal(t, plugin.ErrNotYetImplemented, err, method)
+	}
+}
+
+func TestMockProviderRegisteredMethods(t *testing.T) {
+	t.Parallel()
+
+	called := map[string]bool{}
+	record := func(method string) error {
+		called[method] = true
+		return errors.New(method)
+	}
+
+	p := NewMockProvider(
+		WithGetSchema(func(ctx context.Context, version int) (plugin.GetSchemaResponse, error) {
+			return plugin.GetSchemaResponse{}, record("GetSchema")
+		}),
+		WithGetSupportedVersions(func(ctx context.Context) ([]int, error) {
+			return nil, record("GetSupportedVersions")
+		}),
+		WithCheckConfig(func(ctx context.Context, urn resource.URN, olds, news resource.PropertyMap,
+			allowUnknowns bool) (resource.PropertyMap, []plugin.CheckFailure, error) {
+			return nil, nil, record("CheckConfig")
+		}),
+		WithConfigChecksumMatch(func(ctx context.Context, urn resource.URN, checksum string) (bool, error) {
+			return false, record("ConfigChecksumMatch")
+		}),
+		WithDiffConfig(func(ctx context.Context, urn resource.URN, olds, news resource.PropertyMap, allowUnknowns bool,
+			ignoreChanges []string) (plugin.DiffResult, error) {
+			return plugin.DiffResult{}, record("DiffConfig")
+		}),
+		WithConfigure(func(ctx context.Context, cfg plugin.ProviderConfig) error {
+			return record("Configure")
+		}),
+		WithValidate(func(ctx context.Context) error {
+			return record("Validate")
+		}),
+		WithParameterizeByValue(func(ctx context.Context, value []byte) (plugin.ParameterizeResult, error) {
+			return plugin.ParameterizeResult{}, record("ParameterizeByValue")
+		}),
+		WithParameterizeByReference(func(ctx context.Context,
+			ref plugin.ParameterizeReference) (plugin.ParameterizeResult, error) {
+			return plugin.ParameterizeResult{}, record("ParameterizeByReference")
+		}),
+		WithCheck(func(ctx context.Context, urn resource.URN, olds, news resource.PropertyMap, allowUnknowns bool,
+			randomSeed []byte) (resource.PropertyMap, []plugin.CheckFailure, error) {
+			return nil, nil, record("Check")
+		}),
+		WithDiff(func(ctx context.Context, urn resource.URN, id resource.ID, olds, news resource.PropertyMap,
+			allowUnknowns bool, ignoreChanges []string) (plugin.DiffResult, error) {
+			return plugin.DiffResult{}, record("Diff")
+		}),
+		WithCreate(func(ctx context.Context, urn resource.URN, news resource.PropertyMap, timeout float64,
+			preview bool) (resource.ID, resource.PropertyMap, resource.Status, error) {
+			return "", nil, resource.StatusOK, record("Create")
+		}),
+		WithStreamCreate(func(ctx context.Context, urn resource.URN, news resource.PropertyMap, timeout float64,
+			preview bool, onNext func(resource.PropertyMap) error) (resource.ID, resource.PropertyMap, resource.Status,
+			error) {
+			return "", nil, resource.StatusOK, record("StreamCreate")
+		}),
+		WithWaitForResourceReady(func(ctx context.Context, urn resource.URN, id resource.ID, timeout float64) error {
+			return record("WaitForResourceReady")
+		}),
+		WithRead(func(ctx context.Context, urn resource.URN, id resource.ID,
+			inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error) {
+			return plugin.ReadResult{}, resource.StatusOK, record("Read")
+		}),
+		WithPrepareImport(func(ctx context.Context, urn resource.URN, id resource.ID) (resource.PropertyMap, error) {
+			return nil, record("PrepareImport")
+		}),
+		WithRefresh(func(ctx context.Context, urn resource.URN, id resource.ID,
+			inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error) {
+			return plugin.ReadResult{}, resource.StatusOK, record("Refresh")
+		}),
+		WithReadStream(func(ctx context.Context, urn resource.URN, id resource.ID, inputs, state resource.PropertyMap,
+			onNext func(plugin.ReadResult) error) (resource.Status, error) {
+			return resource.StatusOK, record("ReadStream")
+		}),
+		WithBatchRead(func(ctx context.Context,
+			requests []plugin.BatchReadRequest) ([]plugin.BatchReadResponse, error) {
+			return nil, record("BatchRead")
+		}),
+		WithSchemaVersion(func(ctx context.Context) (int, error) {
+			return 0, record("SchemaVersion")
+		}),
+		WithMigrateState(func(ctx context.Context, urn resource.URN, stateVersion int,
+			state resource.PropertyMap) (resource.PropertyMap, error) {
+			return nil, record("MigrateState")
+		}),
+		WithGetResourceAliases(func(ctx context.Context, urn resource.URN) ([]resource.Alias, error) {
+			return nil, record("GetResourceAliases")
+		}),
+		WithUpdate(func(ctx context.Context, urn resource.URN, id resource.ID, olds, news resource.PropertyMap,
+			timeout float64, ignoreChanges []string, preview bool) (resource.PropertyMap, resource.Status, error) {
+			return nil, resource.StatusOK, record("Update")
+		}),
+		WithDelete(func(ctx context.Context, urn resource.URN, id resource.ID, props resource.PropertyMap,
+			timeout float64) (resource.Status, error) {
+			return resource.StatusOK, record("Delete")
+		}),
+		WithConstruct(func(ctx context.Context, info plugin.ConstructInfo, typ tokens.Type, name tokens.QName,
+			parent resource.URN, inputs resource.PropertyMap,
+			options plugin.ConstructOptions) (plugin.ConstructResult, error) {
+			return plugin.ConstructResult{}, record("Construct")
+		}),
+		WithInvoke(func(ctx context.Context, tok tokens.ModuleMember,
+			args resource.PropertyMap) (resource.PropertyMap, []plugin.CheckFailure, error) {
+			return nil, nil, record("Invoke")
+		}),
+		WithStreamInvoke(func(ctx context.Context, tok tokens.ModuleMember, args resource.PropertyMap,
+			onNext func(plugin.StreamInvokeEvent) error) ([]plugin.CheckFailure, error) {
+			return nil, record("StreamInvoke")
+		}),
+		WithCall(func(ctx context.Context, tok tokens.ModuleMember, args resource.PropertyMap, info plugin.CallInfo,
+			options plugin.CallOptions) (plugin.CallResult, error) {
+			return plugin.CallResult{}, record("Call")
+		}),
+		WithGetPluginInfo(func(ctx context.Context) (workspace.PluginInfo, error) {
+			return workspace.PluginInfo{}, record("GetPluginInfo")
+		}),
+		WithGetMapping(func(ctx context.Context, key string) ([]byte, string, error) {
+			return nil, "", record("GetMapping")
+		}),
+		WithSupportsFeature(func(ctx context.Context, feature string) (bool, error) {
+			return false, record("SupportsFeature")
+		}),
+		WithRegisterEventEmitter(func(e plugin.EventEmitter) error {
+			return record("RegisterEventEmitter")
+		}),
+		WithWatchConfig(func(ctx context.Context, onChange func(resource.PropertyMap)) error {
+			return record("WatchConfig")
+		}),
+		WithEstimateCost(func(ctx context.Context, urn resource.URN,
+			news resource.PropertyMap) (plugin.CostEstimate, error) {
+			return plugin.CostEstimate{}, record("EstimateCost")
+		}),
+		WithSignalCancellation(func(ctx context.Context) error {
+			return record("SignalCancellation")
+		}),
+	)
+
+	// Each method calls the function that was registered for it.
+	errs := callAll(p)
+	require.Len(t, errs, 35)
+	for method, err := range errs {
+		assert.EqualError(t, err, method)
+		assert.True(t, called[method], method)
+	}
+}
+
+func TestMockProviderArguments(t *testing.T) {
+	t.Parallel()
+
+	news := resource.PropertyMap{"foo": resource.NewStringProperty("bar")}
+	p := NewMockProvider(
+		WithCreate(func(ctx context.Context, urn resource.URN, props resource.PropertyMap, timeout float64,
+			preview bool) (resource.ID, resource.PropertyMap, resource.Status, error) {
+			assert.Equal(t, mockURN, urn)
+			assert.Equal(t, 30.0, timeout)
+			assert.True(t, preview)
+			return "created", props, resource.StatusOK, nil
+		}),
+		// Later options replace earlier ones.
+		WithCheck(func(ctx context.Context, urn resource.URN, olds, news resource.PropertyMap, allowUnknowns bool,
+			randomSeed []byte) (resource.PropertyMap, []plugin.CheckFailure, error) {
+			return nil, nil, errors.New("replaced")
+		}),
+		WithCheck(func(ctx context.Context, urn resource.URN, olds, news resource.PropertyMap, allowUnknowns bool,
+			randomSeed []byte) (resource.PropertyMap, []plugin.CheckFailure, error) {
+			return news, nil, nil
+		}),
+	)
+
+	id, outs, status, err := p.Create(context.Background(), mockURN, news, 30, true)
+	require.NoError(t, err)
+	assert.Equal(t, resource.ID("created"), id)
+	assert.Equal(t, news, outs)
+	assert.Equal(t, resource.StatusOK, status)
+
+	checked, failures, err := p.Check(context.Background(), mockURN, nil, news, false, nil)
+	require.NoError(t, err)
+	assert.Equal(t, news, checked)
+	assert.Empty(t, failures)
+}
@@ -0,0 +1,44 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/blang/semver"
+)
+
+// CheckVersionConstraint returns an error if the installed version does not satisfy the given semver constraint.
+// Constraints are ranges such as ">=3.0.0 <4.0.0", in which conditions may also be separated by commas and
+// alternatives are separated by "||".
+func CheckVersionConstraint(installed string, constraint string) error {
+	version, err := semver.ParseTolerant(installed)
+	if err != nil {
+		return fmt.Errorf("invalid version %q: %w", installed, err)
+	}
+
+	// semver ranges separate conditions with spaces, so accept commas as well.
+	normalized := strings.Join(strings.Fields(strings.ReplaceAll(constraint, ",", " ")), " ")
+	satisfies, err := semver.ParseRange(normalized)
+	if err != nil {
+		return fmt.Errorf("invalid version constraint %q: %w", constraint, err)
+	}
+
+	if !satisfies(version) {
+		return fmt.Errorf("version %v does not satisfy constraint %q", version, constraint)
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckVersionConstraint(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, CheckVersionConstraint("3.2.1", ">=3.0.0, <4.0.0"))
+	assert.NoError(t, CheckVersionConstraint("v3.2.1", ">=3.0.0 <4.0.0"))
+	assert.NoError(t, CheckVersionConstraint("5.0.0", "<2.0.0 || >=5.0.0"))
+	assert.EqualError(t, CheckVersionConstraint("4.0.0", ">=3.0.0, <4.0.0"),
+		`version 4.0.0 does not satisfy constraint ">=3.0.0, <4.0.0"`)
+	assert.Error(t, CheckVersionConstraint("latest", ">=3.0.0"))
+	assert.Error(t, CheckVersionConstraint("3.0.0", "about three"))
+}
@@ -177,7 +177,7 @@ func (m PropertyMap) Mappable() map[string]interface{} {
 func (m PropertyMap) MapRepl(replk func(string) (string, bool),
 	replv func(PropertyValue) (interface{}, bool)) map[string]interface{} {
 	obj := make(map[string]interface{})
-	for _, k := range m.StableKeys() {
+	for _, k := range m.Keys() {
 		key := string(k)
 		if replk != nil {
 			if rk, repk := replk(key); repk {
@@ -189,6 +189,44 @@ func (m PropertyMap) MapRepl(replk func(string) (string, bool),
 	return obj
 }
 
+// Flatten returns the map as nested plain Go values that can be passed to APIs expecting JSON-like data: objects
+// become map[string]interface{}, arrays become []interface{}, and primitives become bool, float64, or string.
+//
+// Flattening is lossy. Secrets are unwrapped, so the result contains their plaintext values and must be handled with
+// care. Computed values and unknown outputs become nil, while known outputs are replaced by their elements. Assets and
+// archives become their serialized maps, and resource references become their URN strings.
+func (m PropertyMap) Flatten() map[string]interface{} {
+	return m.MapRepl(nil, flattenValue)
+}
+
+// flattenValue is the value replacement function used by Flatten for values that have no plain Go equivalent.
+func flattenValue(v PropertyValue) (interface{}, bool) {
+	switch {
+	case v.IsSecret():
+		return v.SecretValue().Element.MapRepl(nil, flattenValue), true
+	case v.IsComputed():
+		return nil, true
+	case v.IsOutput():
+		if !v.OutputValue().Known {
+			return nil, true
+		}
+		return v.OutputValue().Element.MapRepl(nil, flattenValue), true
+	case v.IsAsset():
+		return v.AssetValue().Serialize(), true
+	case v.IsArchive():
+		return v.ArchiveValue().Serialize(), true
+	case v.IsResourceReference():
+		return string(v.ResourceReferenceValue().URN), true
+	}
+	return nil, false
+}
+
+// Unflatten is the inverse of Flatten: it creates a resource map from nested plain Go values. Because flattening
+// discards secretness and unknowns, the result contains neither; use MakeSecret to mark values as secret again.
+func Unflatten(m map[string]interface{}) PropertyMap {
+	return NewPropertyMapFromMap(m)
+}
+
 // Copy makes a shallow copy of the map.
 func (m PropertyMap) Copy() PropertyMap {
 	new := make(PropertyMap)
@@ -198,14 +236,358 @@ func (m PropertyMap) Copy() PropertyMap {
 	return new
 }
 
+// DeepClone makes a deep copy of the map, so that the clone can be modified without affecting the original. Nested
+// objects, arrays, secrets, computed and output values, resource references, assets, and archives are all copied.
+// Objects, arrays, and secrets that appear more than once, including those that contain themselves, are shared in the
+// same way by the clone; cyclic maps are therefore cloned without looping forever.
+func (m PropertyMap) DeepClone() PropertyMap {
+	if m == nil {
+		return nil
+	}
+	c := &propertyCloner{
+		maps:    map[uintptr]PropertyMap{},
+		arrays:  map[propertyArrayID][]PropertyValue{},
+		secrets: map[*Secret]*Secret{},
+	}
+	return c.cloneMap(m)
+}
+
+// propertyArrayID identifies an array by its backing storage and length.
+type propertyArrayID struct {
+	data uintptr
+	len  int
+}
+
+// propertyCloner records the clones of the objects, arrays, and secrets that it has copied so far.
+type propertyCloner struct {
+	maps    map[uintptr]PropertyMap
+	arrays  map[propertyArrayID][]PropertyValue
+	secrets map[*Secret]*Secret
+}
+
+func (c *propertyCloner) cloneMap(m PropertyMap) PropertyMap {
+	if m == nil {
+		return nil
+	}
+	id := reflect.ValueOf(m).Pointer()
+	if clone, has := c.maps[id]; has {
+		return clone
+	}
+	clone := make(PropertyMap, len(m))
+	c.maps[id] = clone
+	for k, v := range m {
+		clone[k] = c.cloneValue(v)
+	}
+	return clone
+}
+
+func (c *propertyCloner) cloneArray(a []PropertyValue) []PropertyValue {
+	if len(a) == 0 {
+		if a == nil {
+			return nil
+		}
+		return []PropertyValue{}
+	}
+	id := propertyArrayID{data: reflect.ValueOf(a).Pointer(), len: len(a)}
+	if clone, has := c.arrays[id]; has {
+		return clone
+	}
+	clone := make([]PropertyValue, len(a))
+	c.arrays[id] = clone
+	for i, v := range a {
+		clone[i] = c.cloneValue(v)
+	}
+	return clone
+}
+
+func (c *propertyCloner) cloneValue(v PropertyValue) PropertyValue {
+	switch e := v.V.(type) {
+	case PropertyMap:
+		return NewObjectProperty(c.cloneMap(e))
+	case []PropertyValue:
+		return NewArrayProperty(c.cloneArray(e))
+	case *Secret:
+		if e == nil {
+			return v
+		}
+		if clone, has := c.secrets[e]; has {
+			return NewSecretProperty(clone)
+		}
+		clone := &Secret{}
+		c.secrets[e] = clone
+		clone.Element = c.cloneValue(e.Element)
+		return NewSecretProperty(clone)
+	case Computed:
+		return NewComputedProperty(Computed{Element: c.cloneValue(e.Element)})
+	case Output:
+		var deps []URN
+		if e.Dependencies != nil {
+			deps = append([]URN{}, e.Dependencies...)
+		}
+		return NewOutputProperty(Output{
+			Element:      c.cloneValue(e.Element),
+			Known:        e.Known,
+			Secret:       e.Secret,
+			Dependencies: deps,
+		})
+	case ResourceReference:
+		e.ID = c.cloneValue(e.ID)
+		return NewResourceReferenceProperty(e)
+	case *Asset:
+		return NewAssetProperty(cloneAsset(e))
+	case *Archive:
+		return NewArchiveProperty(cloneArchive(e))
+	default:
+		// Nulls, bools, numbers, and strings are immutable.
+		return v
+	}
+}
+
+func cloneAsset(a *Asset) *Asset {
+	if a == nil {
+		return nil
+	}
+	clone := *a
+	return &clone
+}
+
+func cloneArchive(a *Archive) *Archive {
+	if a == nil {
+		return nil
+	}
+	clone := *a
+	if a.Assets != nil {
+		clone.Assets = make(map[string]interface{}, len(a.Assets))
+		for name, elem := range a.Assets {
+			switch elem := elem.(type) {
+			case *Asset:
+				clone.Assets[name] = cloneAsset(elem)
+			case *Archive:
+				clone.Assets[name] = cloneArchive(elem)
+			default:
+				clone.Assets[name] = elem
+			}
+		}
+	}
+	return &clone
+}
+
+// MergeStrategy determines how PropertyMap.Merge resolves keys that are present in both maps.
+type MergeStrategy int
+
+const (
+	// MergePreferLeft keeps the value from the map being merged into.
+	MergePreferLeft MergeStrategy = iota
+	// MergePreferRight takes the value from the map being merged.
+	MergePreferRight
+	// MergeError fails the merge with a MergeConflictError if the maps have different values for the same key.
+	MergeError
+)
+
+// MergeConflictError is returned by PropertyMap.Merge when the MergeError strategy finds keys that have different
+// values in each map.
+type MergeConflictError struct {
+	Keys []PropertyKey // the conflicting keys, in sorted order.
+}
+
+func (e *MergeConflictError) Error() string {
+	keys := make([]string, len(e.Keys))
+	for i, k := range e.Keys {
+		keys[i] = string(k)
+	}
+	return fmt.Sprintf("conflicting values for properties: %s", strings.Join(keys, ", "))
+}
+
+// Merge returns a new map that contains the properties of both this map and other. Keys that are present in both maps
+// are resolved according to the given strategy. The merge is shallow: object values are not merged recursively, and
+// keys whose values are deeply equal in both maps are never treated as conflicts.
+func (m PropertyMap) Merge(other PropertyMap, strategy MergeStrategy) (PropertyMap, error) {
+	result := m.Copy()
+	var conflicts []PropertyKey
+	for _, k := range other.Keys() {
+		v := other[k]
+		existing, has := result[k]
+		switch {
+		case !has:
+			result[k] = v
+		case strategy == MergePreferRight:
+			result[k] = v
+		case strategy == MergeError && !existing.DeepEquals(v):
+			conflicts = append(conflicts, k)
+		}
+	}
+	if len(conflicts) > 0 {
+		return nil, &MergeConflictError{Keys: conflicts}
+	}
+	return result, nil
+}
+
+// FilterKeys returns a new map that contains only the properties whose keys satisfy the given predicate. Values are
+// not copied, so secret values remain secret.
+func (m PropertyMap) FilterKeys(predicate func(PropertyKey) bool) PropertyMap {
+	result := make(PropertyMap)
+	for k, v := range m {
+		if predicate(k) {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// MapKeys returns a new map in which each property's key has been replaced with the result of calling rename on it.
+// Values are not copied, so secret values remain secret. If rename maps several keys to the same key, the value of the
+// last of those keys in sorted order wins.
+func (m PropertyMap) MapKeys(rename func(PropertyKey) PropertyKey) PropertyMap {
+	result := make(PropertyMap, len(m))
+	for _, k := range m.Keys() {
+		result[rename(k)] = m[k]
+	}
+	return result
+}
+
+// RedactedValue is the string that PropertyMap.Redact and PropertyMap.RedactSecrets substitute for redacted values.
+const RedactedValue = "[redacted]"
+
+// Redact returns a copy of the map in which the values at the given keys are replaced with the string
+// RedactedValue. A key that is not a property of the map is parsed as a PropertyPath, so nested values may be redacted
+// using keys such as "a.b" or "a[0].b". Keys that do not refer to an existing value are ignored. The map itself is
+// not modified.
+func (m PropertyMap) Redact(keys []PropertyKey) PropertyMap {
+	result := copyPropertyMap(m, nil)
+	redacted := NewStringProperty(RedactedValue)
+	for _, k := range keys {
+		if _, has := result[k]; has {
+			result[k] = redacted
+			continue
+		}
+		path, err := ParsePropertyPath(string(k))
+		if err != nil {
+			continue
+		}
+		if _, has := path.Get(NewObjectProperty(result)); has {
+			path.Set(NewObjectProperty(result), redacted)
+		}
+	}
+	return result
+}
+
+// RedactSecrets returns a copy of the map in which every secret value, however deeply nested, is replaced with the
+// string RedactedValue. The map itself is not modified.
+func (m PropertyMap) RedactSecrets() PropertyMap {
+	return copyPropertyMap(m, func(v PropertyValue) bool {
+		return v.IsSecret() || v.IsOutput() && v.OutputValue().Secret
+	})
+}
+
+// copyPropertyMap returns a deep copy of the objects and arrays in the given map. If redact is non-nil, any value for
+// which it returns true is replaced with RedactedValue rather than copied.
+func copyPropertyMap(m PropertyMap, redact func(PropertyValue) bool) PropertyMap {
+	if m == nil {
+		return nil
+	}
+	result := make(PropertyMap, len(m))
+	for k, v := range m {
+		result[k] = copyPropertyValue(v, redact)
+	}
+	return result
+}
+
+func copyPropertyValue(v PropertyValue, redact func(PropertyValue) bool) PropertyValue {
+	switch {
+	case redact != nil && redact(v):
+		return NewStringProperty(RedactedValue)
+	case v.IsArray():
+		arr := make([]PropertyValue, len(v.ArrayValue()))
+		for i, e := range v.ArrayValue() {
+			arr[i] = copyPropertyValue(e, redact)
+		}
+		return NewArrayProperty(arr)
+	case v.IsObject():
+		return NewObjectProperty(copyPropertyMap(v.ObjectValue(), redact))
+	case v.IsSecret():
+		return MakeSecret(copyPropertyValue(v.SecretValue().Element, redact))
+	case v.IsComputed():
+		return MakeComputed(copyPropertyValue(v.Input().Element, redact))
+	case v.IsOutput():
+		out := v.OutputValue()
+		out.Element = copyPropertyValue(out.Element, redact)
+		return NewOutputProperty(out)
+	default:
+		return v
+	}
+}
+
+// Keys returns all of the map's keys in lexicographic order.
+func (m PropertyMap) Keys() []PropertyKey {
+	keys := make([]PropertyKey, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
+
 // StableKeys returns all of the map's keys in a stable order.
+//
+// Deprecated: Use Keys, which returns the same keys in the same order.
 func (m PropertyMap) StableKeys() []PropertyKey {
-	sorted := make([]PropertyKey, 0, len(m))
-	for k := range m {
-		sorted = append(sorted, k)
+	return m.Keys()
+}
+
+// SortedKeys returns all of the map's keys in sorted order.
+//
+// Deprecated: Use Keys, which returns the same keys in the same order.
+func (m PropertyMap) SortedKeys() []PropertyKey {
+	return m.Keys()
+}
+
+// Each calls f with each of the map's keys and values in sorted key order. Iteration stops as soon as f returns false.
+func (m PropertyMap) Each(f func(PropertyKey, PropertyValue) bool) {
+	for _, k := range m.Keys() {
+		if !f(k, m[k]) {
+			return
+		}
+	}
+}
+
+// GetString returns the string value of the given key. It returns "" and false if the key is missing or its value is
+// not a string. Like the other typed accessors, it does not look inside secret, computed, or output values.
+func (m PropertyMap) GetString(key PropertyKey) (string, bool) {
+	v, ok := m[key]
+	if !ok || !v.IsString() {
+		return "", false
+	}
+	return v.StringValue(), true
+}
+
+// GetBool returns the boolean value of the given key. It returns false and false if the key is missing or its value is
+// not a boolean.
+func (m PropertyMap) GetBool(key PropertyKey) (bool, bool) {
+	v, ok := m[key]
+	if !ok || !v.IsBool() {
+		return false, false
 	}
-	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
-	return sorted
+	return v.BoolValue(), true
+}
+
+// GetNumber returns the numeric value of the given key. It returns 0 and false if the key is missing or its value is
+// not a number.
+func (m PropertyMap) GetNumber(key PropertyKey) (float64, bool) {
+	v, ok := m[key]
+	if !ok || !v.IsNumber() {
+		return 0, false
+	}
+	return v.NumberValue(), true
+}
+
+// GetObject returns the object value of the given key. It returns nil and false if the key is missing or its value is
+// not an object.
+func (m PropertyMap) GetObject(key PropertyKey) (PropertyMap, bool) {
+	v, ok := m[key]
+	if !ok || !v.IsObject() {
+		return nil, false
+	}
+	return v.ObjectValue(), true
 }
 
 func NewNullProperty() PropertyValue                                 { return PropertyValue{nil} }
@@ -409,6 +791,12 @@ func (v PropertyValue) ContainsSecrets() bool {
 	return false
 }
 
+// IsSensitive returns true if the property value is a secret or contains any secret values, however deeply nested.
+// Callers that must not reveal a value should check IsSensitive rather than IsSecret, which only inspects the top level.
+func (v PropertyValue) IsSensitive() bool {
+	return v.ContainsSecrets()
+}
+
 // BoolValue fetches the underlying bool value (panicking if it isn't a bool).
 func (v PropertyValue) BoolValue() bool { return v.V.(bool) }
 
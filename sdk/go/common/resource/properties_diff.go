@@ -267,7 +267,7 @@ func (v PropertyValue) Diff(other PropertyValue, ignoreKeys ...IgnoreKeyFunc) *V
 // DeepEquals returns true if this property map is deeply equal to the other property map; and false otherwise.
 func (props PropertyMap) DeepEquals(other PropertyMap) bool {
 	// If any in props either doesn't exist, or is of a different value, return false.
-	for _, k := range props.StableKeys() {
+	for _, k := range props.Keys() {
 		v := props[k]
 		if p, has := other[k]; has {
 			if !v.DeepEquals(p) {
@@ -279,7 +279,7 @@ func (props PropertyMap) DeepEquals(other PropertyMap) bool {
 	}
 
 	// If the other map has properties that this map doesn't have, return false.
-	for _, k := range other.StableKeys() {
+	for _, k := range other.Keys() {
 		if _, has := props[k]; !has && other[k].HasValue() {
 			return false
 		}
@@ -526,7 +526,7 @@ func (v PropertyValue) DiffIncludeUnknowns(other PropertyValue, ignoreKeys ...Ig
 
 func (props PropertyMap) DeepEqualsIncludeUnknowns(other PropertyMap) bool {
 	// If any in props either doesn't exist, or is of a different value, return false.
-	for _, k := range props.StableKeys() {
+	for _, k := range props.Keys() {
 		v := props[k]
 		if p, has := other[k]; has {
 			if !v.DeepEqualsIncludeUnknowns(p) {
@@ -538,7 +538,7 @@ func (props PropertyMap) DeepEqualsIncludeUnknowns(other PropertyMap) bool {
 	}
 
 	// If the other map has properties that this map doesn't have, return false.
-	for _, k := range other.StableKeys() {
+	for _, k := range other.Keys() {
 		if _, has := props[k]; !has && other[k].HasValue() {
 			return false
 		}
@@ -0,0 +1,160 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+// EqualOption relaxes the comparison made by PropertyMapEqual.
+type EqualOption func(opts *equalOptions)
+
+type equalOptions struct {
+	ignoreSecrets  bool
+	ignoreUnknowns bool
+	ignoreOrder    bool
+}
+
+// IgnoreSecrets makes PropertyMapEqual consider a secret value equal to the value that it wraps. The secret flag of
+// outputs is likewise ignored.
+func IgnoreSecrets() EqualOption {
+	return func(opts *equalOptions) {
+		opts.ignoreSecrets = true
+	}
+}
+
+// IgnoreUnknowns makes PropertyMapEqual consider computed values and unknown outputs equal to any other value.
+func IgnoreUnknowns() EqualOption {
+	return func(opts *equalOptions) {
+		opts.ignoreUnknowns = true
+	}
+}
+
+// IgnoreOrder makes PropertyMapEqual consider arrays equal if they hold the same elements in any order.
+func IgnoreOrder() EqualOption {
+	return func(opts *equalOptions) {
+		opts.ignoreOrder = true
+	}
+}
+
+// PropertyMapEqual returns true if the two property maps are deeply equal. Without options this is the same
+// comparison as PropertyMap.DeepEquals; options such as IgnoreSecrets relax it, which is useful when comparing the
+// state returned by a provider with the inputs supplied by a user.
+func PropertyMapEqual(a, b PropertyMap, opts ...EqualOption) bool {
+	var options equalOptions
+	for _, o := range opts {
+		o(&options)
+	}
+	return options.mapsEqual(a, b)
+}
+
+func (opts *equalOptions) mapsEqual(a, b PropertyMap) bool {
+	for k, v := range a {
+		if !opts.entriesEqual(v, b, k) {
+			return false
+		}
+	}
+	for k, v := range b {
+		if _, has := a[k]; !has && !opts.entriesEqual(v, a, k) {
+			return false
+		}
+	}
+	return true
+}
+
+// entriesEqual returns true if the value v is equal to the value at key k in the map other. A missing value is equal
+// to any value that does not have a value, such as null.
+func (opts *equalOptions) entriesEqual(v PropertyValue, other PropertyMap, k PropertyKey) bool {
+	ov, has := other[k]
+	if !has {
+		v = opts.unwrap(v)
+		return !v.HasValue() || opts.ignoreUnknowns && opts.isUnknown(v)
+	}
+	return opts.valuesEqual(v, ov)
+}
+
+func (opts *equalOptions) valuesEqual(v, other PropertyValue) bool {
+	v, other = opts.unwrap(v), opts.unwrap(other)
+	if opts.ignoreUnknowns && (opts.isUnknown(v) || opts.isUnknown(other)) {
+		return true
+	}
+
+	switch {
+	case v.IsArray():
+		return other.IsArray() && opts.arraysEqual(v.ArrayValue(), other.ArrayValue())
+	case v.IsObject():
+		return other.IsObject() && opts.mapsEqual(v.ObjectValue(), other.ObjectValue())
+	case v.IsSecret():
+		return other.IsSecret() && opts.valuesEqual(v.SecretValue().Element, other.SecretValue().Element)
+	case v.IsOutput():
+		if !other.IsOutput() {
+			return false
+		}
+		vo, oo := v.OutputValue(), other.OutputValue()
+		if vo.Known != oo.Known || !opts.ignoreSecrets && vo.Secret != oo.Secret {
+			return false
+		}
+		if len(vo.Dependencies) != len(oo.Dependencies) {
+			return false
+		}
+		for i, dep := range vo.Dependencies {
+			if dep != oo.Dependencies[i] {
+				return false
+			}
+		}
+		return opts.valuesEqual(vo.Element, oo.Element)
+	}
+
+	// Everything else compares the same way regardless of the options.
+	return v.DeepEquals(other)
+}
+
+func (opts *equalOptions) arraysEqual(a, b []PropertyValue) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	if !opts.ignoreOrder {
+		for i, v := range a {
+			if !opts.valuesEqual(v, b[i]) {
+				return false
+			}
+		}
+		return true
+	}
+
+	// Match each element of a with a distinct element of b.
+	matched := make([]bool, len(b))
+	for _, v := range a {
+		found := false
+		for i, ov := range b {
+			if !matched[i] && opts.valuesEqual(v, ov) {
+				matched[i], found = true, true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// unwrap returns the value wrapped by v if v is a secret and secrets are ignored, and v otherwise.
+func (opts *equalOptions) unwrap(v PropertyValue) PropertyValue {
+	for opts.ignoreSecrets && v.IsSecret() {
+		v = v.SecretValue().Element
+	}
+	return v
+}
+
+func (opts *equalOptions) isUnknown(v PropertyValue) bool {
+	return v.IsComputed() || v.IsOutput() && !v.OutputValue().Known
+}
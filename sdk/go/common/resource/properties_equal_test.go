@@ -0,0 +1,80 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPropertyMapEqual(t *testing.T) {
+	t.Parallel()
+
+	str := NewStringProperty
+	arr := func(vs ...PropertyValue) PropertyValue { return NewArrayProperty(vs) }
+	unknown := MakeComputed(str(""))
+
+	cases := []struct {
+		name  string
+		a, b  PropertyMap
+		opts  []EqualOption
+		equal bool
+	}{
+		{"equal", PropertyMap{"a": str("x")}, PropertyMap{"a": str("x")}, nil, true},
+		{"different", PropertyMap{"a": str("x")}, PropertyMap{"a": str("y")}, nil, false},
+		{"missing null", PropertyMap{"a": NewNullProperty()}, PropertyMap{}, nil, true},
+		{"missing value", PropertyMap{}, PropertyMap{"a": str("x")}, nil, false},
+
+		{"secret", PropertyMap{"a": MakeSecret(str("x"))}, PropertyMap{"a": str("x")}, nil, false},
+		{"ignore secret", PropertyMap{"a": MakeSecret(str("x"))}, PropertyMap{"a": str("x")},
+			[]EqualOption{IgnoreSecrets()}, true},
+		{"ignore nested secret", PropertyMap{"a": arr(MakeSecret(str("x")))}, PropertyMap{"a": arr(str("x"))},
+			[]EqualOption{IgnoreSecrets()}, true},
+		{"ignore secret different", PropertyMap{"a": MakeSecret(str("x"))}, PropertyMap{"a": str("y")},
+			[]EqualOption{IgnoreSecrets()}, false},
+		{"ignore secret output", PropertyMap{"a": NewOutputProperty(Output{Element: str("x"), Known: true, Secret: true})},
+			PropertyMap{"a": NewOutputProperty(Output{Element: str("x"), Known: true})},
+			[]EqualOption{IgnoreSecrets()}, true},
+
+		{"unknown", PropertyMap{"a": unknown}, PropertyMap{"a": str("x")}, nil, false},
+		{"ignore unknown", PropertyMap{"a": unknown}, PropertyMap{"a": str("x")},
+			[]EqualOption{IgnoreUnknowns()}, true},
+		{"ignore missing unknown", PropertyMap{}, PropertyMap{"a": unknown}, []EqualOption{IgnoreUnknowns()}, true},
+		{"ignore unknown output", PropertyMap{"a": arr(NewOutputProperty(Output{Element: str("")}))},
+			PropertyMap{"a": arr(str("x"))}, []EqualOption{IgnoreUnknowns()}, true},
+
+		{"order", PropertyMap{"a": arr(str("x"), str("y"))}, PropertyMap{"a": arr(str("y"), str("x"))}, nil, false},
+		{"ignore order", PropertyMap{"a": arr(str("x"), str("y"))}, PropertyMap{"a": arr(str("y"), str("x"))},
+			[]EqualOption{IgnoreOrder()}, true},
+		{"ignore order duplicates", PropertyMap{"a": arr(str("x"), str("x"))},
+			PropertyMap{"a": arr(str("x"), str("y"))}, []EqualOption{IgnoreOrder()}, false},
+
+		{"all options", PropertyMap{"a": arr(MakeSecret(str("x")), unknown)}, PropertyMap{"a": arr(str("z"), str("x"))},
+			[]EqualOption{IgnoreSecrets(), IgnoreUnknowns(), IgnoreOrder()}, true},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, c.equal, PropertyMapEqual(c.a, c.b, c.opts...))
+			assert.Equal(t, c.equal, PropertyMapEqual(c.b, c.a, c.opts...))
+			if len(c.opts) == 0 {
+				assert.Equal(t, c.a.DeepEquals(c.b), PropertyMapEqual(c.a, c.b))
+			}
+		})
+	}
+}
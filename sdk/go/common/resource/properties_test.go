@@ -170,6 +170,174 @@ func TestCopy(t *testing.T) {
 	assert.Equal(t, 2, len(dst))
 }
 
+func TestDeepClone(t *testing.T) {
+	t.Parallel()
+
+	shared := PropertyMap{"x": NewStringProperty("shared")}
+	src := PropertyMap{
+		"obj":    NewObjectProperty(PropertyMap{"nested": NewArrayProperty([]PropertyValue{NewNumberProperty(1)})}),
+		"secret": MakeSecret(NewObjectProperty(PropertyMap{"pw": NewStringProperty("hunter2")})),
+		"output": NewOutputProperty(Output{
+			Element:      NewArrayProperty([]PropertyValue{NewStringProperty("a")}),
+			Known:        true,
+			Dependencies: []URN{"urn:pulumi:stack::project::test:index:res::a"},
+		}),
+		"asset":   NewAssetProperty(&Asset{Sig: AssetSig, Text: "text"}),
+		"archive": NewArchiveProperty(&Archive{Sig: ArchiveSig, Assets: map[string]interface{}{"f": &Asset{Text: "f"}}}),
+		"left":    NewObjectProperty(shared),
+		"right":   NewObjectProperty(shared),
+	}
+	dst := src.DeepClone()
+	assert.Equal(t, src, dst)
+
+	// Mutating the clone at any depth leaves the original untouched.
+	dst["obj"].ObjectValue()["nested"].ArrayValue()[0] = NewNumberProperty(2)
+	dst["secret"].SecretValue().Element.ObjectValue()["pw"] = NewStringProperty("changed")
+	dst["output"].OutputValue().Element.ArrayValue()[0] = NewStringProperty("b")
+	dst["output"].OutputValue().Dependencies[0] = "changed"
+	dst["asset"].AssetValue().Text = "changed"
+	dst["archive"].ArchiveValue().Assets["f"].(*Asset).Text = "changed"
+	assert.Equal(t, NewNumberProperty(1), src["obj"].ObjectValue()["nested"].ArrayValue()[0])
+	assert.Equal(t, NewStringProperty("hunter2"), src["secret"].SecretValue().Element.ObjectValue()["pw"])
+	assert.Equal(t, NewStringProperty("a"), src["output"].OutputValue().Element.ArrayValue()[0])
+	assert.Equal(t, URN("urn:pulumi:stack::project::test:index:res::a"), src["output"].OutputValue().Dependencies[0])
+	assert.Equal(t, "text", src["asset"].AssetValue().Text)
+	assert.Equal(t, "f", src["archive"].ArchiveValue().Assets["f"].(*Asset).Text)
+
+	// Values that are shared in the original are shared in the clone.
+	dst["left"].ObjectValue()["x"] = NewStringProperty("changed")
+	assert.Equal(t, NewStringProperty("changed"), dst["right"].ObjectValue()["x"])
+	assert.Equal(t, NewStringProperty("shared"), shared["x"])
+
+	// Cyclic maps are cloned without looping forever.
+	cyclic := PropertyMap{}
+	cyclic["self"] = NewObjectProperty(cyclic)
+	clone := cyclic.DeepClone()
+	clone["other"] = NewNullProperty()
+	assert.Len(t, cyclic, 1)
+	assert.Len(t, clone["self"].ObjectValue(), 2)
+}
+
+func TestFlatten(t *testing.T) {
+	t.Parallel()
+
+	urn := URN("urn:pulumi:stack::project::test:index:res::a")
+	m := PropertyMap{
+		"null":     NewNullProperty(),
+		"string":   NewStringProperty("str"),
+		"array":    NewArrayProperty([]PropertyValue{NewNumberProperty(1), MakeSecret(NewBoolProperty(true))}),
+		"secret":   MakeSecret(NewObjectProperty(PropertyMap{"password": NewStringProperty("s3cr3t")})),
+		"computed": MakeComputed(NewStringProperty("")),
+		"known":    NewOutputProperty(Output{Element: NewStringProperty("out"), Known: true, Secret: true}),
+		"unknown":  NewOutputProperty(Output{Element: NewStringProperty("out")}),
+		"asset":    NewAssetProperty(&Asset{Sig: AssetSig, Text: "text"}),
+		"ref":      MakeComponentResourceReference(urn, ""),
+	}
+
+	assert.Equal(t, map[string]interface{}{
+		"null":     nil,
+		"string":   "str",
+		"array":    []interface{}{1.0, true},
+		"secret":   map[string]interface{}{"password": "s3cr3t"},
+		"computed": nil,
+		"known":    "out",
+		"unknown":  nil,
+		"asset":    map[string]interface{}{SigKey: AssetSig, "text": "text"},
+		"ref":      string(urn),
+	}, m.Flatten())
+
+	// Plain values survive a round trip.
+	plain := PropertyMap{
+		"string": NewStringProperty("str"),
+		"array":  NewArrayProperty([]PropertyValue{NewNumberProperty(1), NewBoolProperty(true)}),
+		"object": NewObjectProperty(PropertyMap{"null": NewNullProperty()}),
+	}
+	assert.Equal(t, plain, Unflatten(plain.Flatten()))
+}
+
+func TestSortedKeys(t *testing.T) {
+	t.Parallel()
+
+	m := NewPropertyMapFromMap(map[string]interface{}{"c": 3, "a": 1, "b": 2})
+	assert.Equal(t, []PropertyKey{"a", "b", "c"}, m.SortedKeys())
+	assert.Empty(t, PropertyMap{}.SortedKeys())
+}
+
+func TestKeys(t *testing.T) {
+	t.Parallel()
+
+	m := PropertyMap{"b": NewNullProperty(), "B": NewNullProperty(), "a2": NewNullProperty(), "a10": NewNullProperty()}
+	assert.Equal(t, []PropertyKey{"B", "a10", "a2", "b"}, m.Keys())
+	assert.Equal(t, m.Keys(), m.StableKeys())
+	assert.NotNil(t, PropertyMap(nil).Keys())
+	assert.Empty(t, PropertyMap(nil).Keys())
+}
+
+func TestEach(t *testing.T) {
+	t.Parallel()
+
+	m := NewPropertyMapFromMap(map[string]interface{}{"c": 3, "a": 1, "b": 2})
+
+	var keys []PropertyKey
+	var values []PropertyValue
+	m.Each(func(k PropertyKey, v PropertyValue) bool {
+		keys, values = append(keys, k), append(values, v)
+		return true
+	})
+	assert.Equal(t, []PropertyKey{"a", "b", "c"}, keys)
+	assert.Equal(t, []PropertyValue{NewNumberProperty(1), NewNumberProperty(2), NewNumberProperty(3)}, values)
+
+	// Returning false stops the iteration.
+	keys = nil
+	m.Each(func(k PropertyKey, v PropertyValue) bool {
+		keys = append(keys, k)
+		return k != "b"
+	})
+	assert.Equal(t, []PropertyKey{"a", "b"}, keys)
+}
+
+func TestTypedAccessors(t *testing.T) {
+	t.Parallel()
+
+	m := PropertyMap{
+		"str":    NewStringProperty("hello"),
+		"bool":   NewBoolProperty(true),
+		"num":    NewNumberProperty(42),
+		"obj":    NewObjectProperty(PropertyMap{"a": NewStringProperty("b")}),
+		"secret": MakeSecret(NewStringProperty("hunter2")),
+	}
+
+	s, ok := m.GetString("str")
+	assert.True(t, ok)
+	assert.Equal(t, "hello", s)
+	b, ok := m.GetBool("bool")
+	assert.True(t, ok)
+	assert.True(t, b)
+	n, ok := m.GetNumber("num")
+	assert.True(t, ok)
+	assert.Equal(t, 42.0, n)
+	o, ok := m.GetObject("obj")
+	assert.True(t, ok)
+	assert.Equal(t, PropertyMap{"a": NewStringProperty("b")}, o)
+
+	// Missing keys and values of the wrong type return the zero value.
+	s, ok = m.GetString("missing")
+	assert.False(t, ok)
+	assert.Equal(t, "", s)
+	s, ok = m.GetString("secret")
+	assert.False(t, ok)
+	assert.Equal(t, "", s)
+	b, ok = m.GetBool("str")
+	assert.False(t, ok)
+	assert.False(t, b)
+	n, ok = m.GetNumber("bool")
+	assert.False(t, ok)
+	assert.Equal(t, 0.0, n)
+	o, ok = m.GetObject("num")
+	assert.False(t, ok)
+	assert.Nil(t, o)
+}
+
 func TestSecretUnknown(t *testing.T) {
 	t.Parallel()
 
@@ -361,6 +529,56 @@ func TestContainsSecrets(t *testing.T) {
 	}
 }
 
+func TestIsSensitive(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		prop     PropertyValue
+		expected bool
+	}{
+		{
+			name:     "string",
+			prop:     NewStringProperty(""),
+			expected: false,
+		},
+		{
+			name:     "secret",
+			prop:     MakeSecret(NewStringProperty("")),
+			expected: true,
+		},
+		{
+			name: "object containing secret",
+			prop: NewObjectProperty(PropertyMap{
+				"foo": MakeSecret(NewStringProperty("")),
+			}),
+			expected: true,
+		},
+		{
+			name:     "array containing secret",
+			prop:     NewArrayProperty([]PropertyValue{NewStringProperty(""), MakeSecret(NewStringProperty(""))}),
+			expected: true,
+		},
+		{
+			name: "output secret",
+			prop: NewOutputProperty(Output{
+				Element: NewStringProperty(""),
+				Known:   true,
+				Secret:  true,
+			}),
+			expected: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, tt.prop.IsSensitive())
+		})
+	}
+}
+
 func TestHasValue(t *testing.T) {
 	t.Parallel()
 
@@ -402,3 +620,160 @@ func TestHasValue(t *testing.T) {
 		})
 	}
 }
+
+func TestPropertyMapMerge(t *testing.T) {
+	t.Parallel()
+
+	left := PropertyMap{
+		"a": NewStringProperty("left"),
+		"b": NewNumberProperty(1),
+		"c": NewBoolProperty(true),
+	}
+	right := PropertyMap{
+		"a": NewStringProperty("right"),
+		"b": NewNumberProperty(2),
+		"c": NewBoolProperty(true),
+		"d": NewStringProperty("new"),
+	}
+
+	merged, err := left.Merge(right, MergePreferLeft)
+	assert.NoError(t, err)
+	assert.Equal(t, PropertyMap{
+		"a": NewStringProperty("left"),
+		"b": NewNumberProperty(1),
+		"c": NewBoolProperty(true),
+		"d": NewStringProperty("new"),
+	}, merged)
+
+	merged, err = left.Merge(right, MergePreferRight)
+	assert.NoError(t, err)
+	assert.Equal(t, right, merged)
+
+	// Neither input is modified by a merge.
+	assert.Len(t, left, 3)
+	assert.Equal(t, NewStringProperty("left"), left["a"])
+
+	// Keys with equal values do not conflict.
+	_, err = left.Merge(right, MergeError)
+	var conflict *MergeConflictError
+	if assert.ErrorAs(t, err, &conflict) {
+		assert.Equal(t, []PropertyKey{"a", "b"}, conflict.Keys)
+		assert.EqualError(t, err, "conflicting values for properties: a, b")
+	}
+
+	merged, err = left.Merge(PropertyMap{"c": NewBoolProperty(true), "e": NewNullProperty()}, MergeError)
+	assert.NoError(t, err)
+	assert.Len(t, merged, 4)
+}
+
+func TestPropertyMapFilterKeys(t *testing.T) {
+	t.Parallel()
+
+	m := PropertyMap{
+		"keep":     NewStringProperty("a"),
+		"keepAlso": MakeSecret(NewStringProperty("b")),
+		"drop":     NewNumberProperty(1),
+	}
+
+	filtered := m.FilterKeys(func(k PropertyKey) bool { return strings.HasPrefix(string(k), "keep") })
+	assert.Equal(t, PropertyMap{
+		"keep":     NewStringProperty("a"),
+		"keepAlso": MakeSecret(NewStringProperty("b")),
+	}, filtered)
+	assert.True(t, filtered["keepAlso"].IsSecret())
+
+	// The original map is not modified.
+	assert.Len(t, m, 3)
+
+	assert.Empty(t, m.FilterKeys(func(PropertyKey) bool { return false }))
+}
+
+func TestPropertyMapMapKeys(t *testing.T) {
+	t.Parallel()
+
+	m := PropertyMap{
+		"a": NewStringProperty("a"),
+		"b": MakeSecret(NewStringProperty("b")),
+	}
+
+	renamed := m.MapKeys(func(k PropertyKey) PropertyKey { return "prefix_" + k })
+	assert.Equal(t, PropertyMap{
+		"prefix_a": NewStringProperty("a"),
+		"prefix_b": MakeSecret(NewStringProperty("b")),
+	}, renamed)
+	assert.True(t, renamed["prefix_b"].IsSecret())
+
+	// The original map is not modified.
+	assert.Equal(t, NewStringProperty("a"), m["a"])
+
+	// When keys collide, the last key in sorted order wins.
+	collapsed := m.MapKeys(func(PropertyKey) PropertyKey { return "k" })
+	assert.Equal(t, PropertyMap{"k": MakeSecret(NewStringProperty("b"))}, collapsed)
+}
+
+func TestPropertyMapRedact(t *testing.T) {
+	t.Parallel()
+
+	m := PropertyMap{
+		"user":     NewStringProperty("admin"),
+		"password": NewStringProperty("hunter2"),
+		"db": NewObjectProperty(PropertyMap{
+			"host":  NewStringProperty("localhost"),
+			"token": NewStringProperty("abc"),
+		}),
+		"keys": NewArrayProperty([]PropertyValue{
+			NewObjectProperty(PropertyMap{"value": NewStringProperty("k1")}),
+		}),
+		"a.b": NewStringProperty("literal"),
+	}
+
+	redacted := m.Redact([]PropertyKey{"password", "db.token", "keys[0].value", "a.b", "missing", "db.missing"})
+	assert.Equal(t, PropertyMap{
+		"user":     NewStringProperty("admin"),
+		"password": NewStringProperty(RedactedValue),
+		"db": NewObjectProperty(PropertyMap{
+			"host":  NewStringProperty("localhost"),
+			"token": NewStringProperty(RedactedValue),
+		}),
+		"keys": NewArrayProperty([]PropertyValue{
+			NewObjectProperty(PropertyMap{"value": NewStringProperty(RedactedValue)}),
+		}),
+		"a.b": NewStringProperty(RedactedValue),
+	}, redacted)
+
+	// The original map is not modified.
+	assert.Equal(t, NewStringProperty("hunter2"), m["password"])
+	assert.Equal(t, NewStringProperty("abc"), m["db"].ObjectValue()["token"])
+	assert.Equal(t, NewStringProperty("k1"), m["keys"].ArrayValue()[0].ObjectValue()["value"])
+}
+
+func TestPropertyMapRedactSecrets(t *testing.T) {
+	t.Parallel()
+
+	m := PropertyMap{
+		"user":     NewStringProperty("admin"),
+		"password": MakeSecret(NewStringProperty("hunter2")),
+		"db": NewObjectProperty(PropertyMap{
+			"host":  NewStringProperty("localhost"),
+			"token": MakeSecret(NewStringProperty("abc")),
+		}),
+		"keys":   NewArrayProperty([]PropertyValue{MakeSecret(NewStringProperty("k1")), NewStringProperty("k2")}),
+		"output": NewOutputProperty(Output{Element: NewStringProperty("out"), Known: true, Secret: true}),
+	}
+
+	redacted := m.RedactSecrets()
+	assert.Equal(t, PropertyMap{
+		"user":     NewStringProperty("admin"),
+		"password": NewStringProperty(RedactedValue),
+		"db": NewObjectProperty(PropertyMap{
+			"host":  NewStringProperty("localhost"),
+			"token": NewStringProperty(RedactedValue),
+		}),
+		"keys":   NewArrayProperty([]PropertyValue{NewStringProperty(RedactedValue), NewStringProperty("k2")}),
+		"output": NewStringProperty(RedactedValue),
+	}, redacted)
+	assert.False(t, redacted.ContainsSecrets())
+
+	// The original map is not modified.
+	assert.True(t, m["db"].ObjectValue()["token"].IsSecret())
+}
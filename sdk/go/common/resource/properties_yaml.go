@@ -0,0 +1,479 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	yaml "gopkg.in/yaml.v3"
+)
+
+// The local YAML tags used to represent property values that have no plain YAML equivalent. A secret whose element is
+// itself tagged combines the two tags, e.g. "!secret/asset" for a secret asset.
+const (
+	yamlSecretTag   = "!secret"
+	yamlUnknownTag  = "!unknown"
+	yamlAssetTag    = "!asset"
+	yamlArchiveTag  = "!archive"
+	yamlOutputTag   = "!output"
+	yamlResourceTag = "!resource"
+)
+
+// MarshalPropertyMapYAML serializes the given property map as a YAML document. Plain values are written as ordinary
+// YAML, with object keys in sorted order. Secrets, unknowns, assets, archives, outputs, and resource references are
+// written using the local tags !secret, !unknown, !asset, !archive, !output, and !resource, so that the result can be
+// read back with UnmarshalPropertyMapYAML.
+func MarshalPropertyMapYAML(props PropertyMap) ([]byte, error) {
+	node, err := marshalPropertyMapYAML(props)
+	if err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(node)
+}
+
+// UnmarshalPropertyMapYAML deserializes a property map from a YAML document written by MarshalPropertyMapYAML or by
+// hand. The document must contain a mapping; an empty document produces an empty property map.
+func UnmarshalPropertyMapYAML(b []byte) (PropertyMap, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(b, &doc); err != nil {
+		return nil, err
+	}
+	if len(doc.Content) == 0 {
+		return PropertyMap{}, nil
+	}
+
+	v, err := unmarshalPropertyValueYAML(doc.Content[0])
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case v.IsNull():
+		return PropertyMap{}, nil
+	case v.IsObject():
+		return v.ObjectValue(), nil
+	default:
+		return nil, errors.Errorf("expected a YAML mapping at line %d", doc.Content[0].Line)
+	}
+}
+
+func marshalPropertyMapYAML(props PropertyMap) (*yaml.Node, error) {
+	node := &yaml.Node{Kind: yaml.MappingNode}
+	for _, k := range props.Keys() {
+		v, err := marshalPropertyValueYAML(props[k])
+		if err != nil {
+			return nil, errors.Wrapf(err, "marshaling property %v", k)
+		}
+		node.Content = append(node.Content, yamlString(string(k)), v)
+	}
+	return node, nil
+}
+
+func marshalPropertyValueYAML(v PropertyValue) (*yaml.Node, error) {
+	switch {
+	case v.IsNull():
+		return &yaml.Node{Kind: yaml.ScalarNode, Value: "null"}, nil
+	case v.IsBool():
+		return &yaml.Node{Kind: yaml.ScalarNode, Value: strconv.FormatBool(v.BoolValue())}, nil
+	case v.IsNumber():
+		return &yaml.Node{Kind: yaml.ScalarNode, Value: formatYAMLNumber(v.NumberValue())}, nil
+	case v.IsString():
+		return yamlString(v.StringValue()), nil
+	case v.IsArray():
+		node := &yaml.Node{Kind: yaml.SequenceNode}
+		for i, e := range v.ArrayValue() {
+			en, err := marshalPropertyValueYAML(e)
+			if err != nil {
+				return nil, errors.Wrapf(err, "marshaling element %d", i)
+			}
+			node.Content = append(node.Content, en)
+		}
+		return node, nil
+	case v.IsObject():
+		return marshalPropertyMapYAML(v.ObjectValue())
+	case v.IsAsset():
+		return marshalAssetYAML(v.AssetValue()), nil
+	case v.IsArchive():
+		return marshalArchiveYAML(v.ArchiveValue())
+	case v.IsComputed():
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: yamlUnknownTag}, nil
+	case v.IsSecret():
+		node, err := marshalPropertyValueYAML(v.SecretValue().Element)
+		if err != nil {
+			return nil, err
+		}
+		if strings.HasPrefix(node.Tag, "!") && !strings.HasPrefix(node.Tag, "!!") {
+			node.Tag = yamlSecretTag + "/" + node.Tag[1:]
+		} else {
+			// Quote secret strings so that they are not mistaken for other scalars when the tag is stripped.
+			if node.Tag == "!!str" && node.Style == 0 {
+				node.Style = yaml.DoubleQuotedStyle
+			}
+			node.Tag = yamlSecretTag
+		}
+		return node, nil
+	case v.IsOutput():
+		return marshalOutputYAML(v.OutputValue())
+	case v.IsResourceReference():
+		return marshalResourceReferenceYAML(v.ResourceReferenceValue())
+	default:
+		return nil, errors.Errorf("unsupported property value of type %v", v.TypeString())
+	}
+}
+
+func marshalAssetYAML(a *Asset) *yaml.Node {
+	node := &yaml.Node{Kind: yaml.MappingNode, Tag: yamlAssetTag}
+	appendYAMLField(node, AssetHashProperty, a.Hash)
+	appendYAMLField(node, AssetTextProperty, a.Text)
+	appendYAMLField(node, AssetPathProperty, a.Path)
+	appendYAMLField(node, AssetURIProperty, a.URI)
+	return node
+}
+
+func marshalArchiveYAML(a *Archive) (*yaml.Node, error) {
+	node := &yaml.Node{Kind: yaml.MappingNode, Tag: yamlArchiveTag}
+	appendYAMLField(node, ArchiveHashProperty, a.Hash)
+	if a.Assets != nil {
+		assets := &yaml.Node{Kind: yaml.MappingNode}
+		for _, name := range sortedAssetNames(a.Assets) {
+			var an *yaml.Node
+			switch t := a.Assets[name].(type) {
+			case *Asset:
+				an = marshalAssetYAML(t)
+			case *Archive:
+				var err error
+				if an, err = marshalArchiveYAML(t); err != nil {
+					return nil, err
+				}
+			default:
+				return nil, errors.Errorf("archive element %v has unsupported type %T", name, t)
+			}
+			assets.Content = append(assets.Content, yamlString(name), an)
+		}
+		node.Content = append(node.Content, yamlString(ArchiveAssetsProperty), assets)
+	}
+	appendYAMLField(node, ArchivePathProperty, a.Path)
+	appendYAMLField(node, ArchiveURIProperty, a.URI)
+	return node, nil
+}
+
+func marshalOutputYAML(o Output) (*yaml.Node, error) {
+	node := &yaml.Node{Kind: yaml.MappingNode, Tag: yamlOutputTag}
+	if o.Known {
+		node.Content = append(node.Content, yamlString("known"), &yaml.Node{Kind: yaml.ScalarNode, Value: "true"})
+	}
+	if o.Secret {
+		node.Content = append(node.Content, yamlString("secret"), &yaml.Node{Kind: yaml.ScalarNode, Value: "true"})
+	}
+	if !o.Element.IsNull() {
+		element, err := marshalPropertyValueYAML(o.Element)
+		if err != nil {
+			return nil, err
+		}
+		node.Content = append(node.Content, yamlString("value"), element)
+	}
+	if len(o.Dependencies) > 0 {
+		deps := &yaml.Node{Kind: yaml.SequenceNode}
+		for _, dep := range o.Dependencies {
+			deps.Content = append(deps.Content, yamlString(string(dep)))
+		}
+		node.Content = append(node.Content, yamlString("dependencies"), deps)
+	}
+	return node, nil
+}
+
+func marshalResourceReferenceYAML(ref ResourceReference) (*yaml.Node, error) {
+	node := &yaml.Node{Kind: yaml.MappingNode, Tag: yamlResourceTag}
+	appendYAMLField(node, "urn", string(ref.URN))
+	if !ref.ID.IsNull() {
+		id, err := marshalPropertyValueYAML(ref.ID)
+		if err != nil {
+			return nil, err
+		}
+		node.Content = append(node.Content, yamlString("id"), id)
+	}
+	appendYAMLField(node, "packageVersion", ref.PackageVersion)
+	return node, nil
+}
+
+func unmarshalPropertyValueYAML(node *yaml.Node) (PropertyValue, error) {
+	if node.Kind == yaml.AliasNode {
+		return unmarshalPropertyValueYAML(node.Alias)
+	}
+
+	switch {
+	case node.Tag == yamlSecretTag || strings.HasPrefix(node.Tag, yamlSecretTag+"/"):
+		element := *node
+		element.Tag = ""
+		if node.Tag != yamlSecretTag {
+			element.Tag = "!" + node.Tag[len(yamlSecretTag)+1:]
+		}
+		v, err := unmarshalPropertyValueYAML(&element)
+		if err != nil {
+			return PropertyValue{}, err
+		}
+		return MakeSecret(v), nil
+	case node.Tag == yamlUnknownTag:
+		return MakeComputed(NewStringProperty("")), nil
+	case node.Tag == yamlAssetTag:
+		asset, err := unmarshalAssetYAML(node)
+		if err != nil {
+			return PropertyValue{}, err
+		}
+		return NewAssetProperty(asset), nil
+	case node.Tag == yamlArchiveTag:
+		archive, err := unmarshalArchiveYAML(node)
+		if err != nil {
+			return PropertyValue{}, err
+		}
+		return NewArchiveProperty(archive), nil
+	case node.Tag == yamlOutputTag:
+		return unmarshalOutputYAML(node)
+	case node.Tag == yamlResourceTag:
+		return unmarshalResourceReferenceYAML(node)
+	}
+
+	switch node.Kind {
+	case yaml.ScalarNode:
+		return unmarshalScalarYAML(node)
+	case yaml.SequenceNode:
+		arr := make([]PropertyValue, len(node.Content))
+		for i, e := range node.Content {
+			v, err := unmarshalPropertyValueYAML(e)
+			if err != nil {
+				return PropertyValue{}, errors.Wrapf(err, "unmarshaling element %d", i)
+			}
+			arr[i] = v
+		}
+		return NewArrayProperty(arr), nil
+	case yaml.MappingNode:
+		obj := PropertyMap{}
+		err := forEachYAMLField(node, func(key string, value *yaml.Node) error {
+			v, err := unmarshalPropertyValueYAML(value)
+			if err != nil {
+				return errors.Wrapf(err, "unmarshaling property %v", key)
+			}
+			obj[PropertyKey(key)] = v
+			return nil
+		})
+		if err != nil {
+			return PropertyValue{}, err
+		}
+		return NewObjectProperty(obj), nil
+	default:
+		return PropertyValue{}, errors.Errorf("unsupported YAML node at line %d", node.Line)
+	}
+}
+
+func unmarshalScalarYAML(node *yaml.Node) (PropertyValue, error) {
+	if strings.HasPrefix(node.Tag, "!") && !strings.HasPrefix(node.Tag, "!!") {
+		return PropertyValue{}, errors.Errorf("unsupported YAML tag %v at line %d", node.Tag, node.Line)
+	}
+
+	switch node.ShortTag() {
+	case "!!null":
+		return NewNullProperty(), nil
+	case "!!bool":
+		var b bool
+		if err := node.Decode(&b); err != nil {
+			return PropertyValue{}, err
+		}
+		return NewBoolProperty(b), nil
+	case "!!int", "!!float":
+		var f float64
+		if err := node.Decode(&f); err != nil {
+			return PropertyValue{}, err
+		}
+		return NewNumberProperty(f), nil
+	case "!!str", "!!timestamp":
+		return NewStringProperty(node.Value), nil
+	default:
+		return PropertyValue{}, errors.Errorf("unsupported YAML tag %v at line %d", node.ShortTag(), node.Line)
+	}
+}
+
+func unmarshalAssetYAML(node *yaml.Node) (*Asset, error) {
+	asset := &Asset{Sig: AssetSig}
+	err := forEachYAMLField(node, func(key string, value *yaml.Node) error {
+		switch key {
+		case AssetHashProperty:
+			return value.Decode(&asset.Hash)
+		case AssetTextProperty:
+			return value.Decode(&asset.Text)
+		case AssetPathProperty:
+			return value.Decode(&asset.Path)
+		case AssetURIProperty:
+			return value.Decode(&asset.URI)
+		default:
+			return errors.Errorf("unexpected asset field %v", key)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return asset, nil
+}
+
+func unmarshalArchiveYAML(node *yaml.Node) (*Archive, error) {
+	archive := &Archive{Sig: ArchiveSig}
+	err := forEachYAMLField(node, func(key string, value *yaml.Node) error {
+		switch key {
+		case ArchiveHashProperty:
+			return value.Decode(&archive.Hash)
+		case ArchivePathProperty:
+			return value.Decode(&archive.Path)
+		case ArchiveURIProperty:
+			return value.Decode(&archive.URI)
+		case ArchiveAssetsProperty:
+			archive.Assets = map[string]interface{}{}
+			return forEachYAMLField(value, func(name string, element *yaml.Node) error {
+				switch element.Tag {
+				case yamlAssetTag:
+					asset, err := unmarshalAssetYAML(element)
+					if err != nil {
+						return err
+					}
+					archive.Assets[name] = asset
+				case yamlArchiveTag:
+					child, err := unmarshalArchiveYAML(element)
+					if err != nil {
+						return err
+					}
+					archive.Assets[name] = child
+				default:
+					return errors.Errorf("archive element %v must be an asset or archive", name)
+				}
+				return nil
+			})
+		default:
+			return errors.Errorf("unexpected archive field %v", key)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return archive, nil
+}
+
+func unmarshalOutputYAML(node *yaml.Node) (PropertyValue, error) {
+	var output Output
+	err := forEachYAMLField(node, func(key string, value *yaml.Node) error {
+		switch key {
+		case "known":
+			return value.Decode(&output.Known)
+		case "secret":
+			return value.Decode(&output.Secret)
+		case "value":
+			element, err := unmarshalPropertyValueYAML(value)
+			output.Element = element
+			return err
+		case "dependencies":
+			var deps []string
+			if err := value.Decode(&deps); err != nil {
+				return err
+			}
+			for _, dep := range deps {
+				output.Dependencies = append(output.Dependencies, URN(dep))
+			}
+			return nil
+		default:
+			return errors.Errorf("unexpected output field %v", key)
+		}
+	})
+	if err != nil {
+		return PropertyValue{}, err
+	}
+	return NewOutputProperty(output), nil
+}
+
+func unmarshalResourceReferenceYAML(node *yaml.Node) (PropertyValue, error) {
+	var ref ResourceReference
+	err := forEachYAMLField(node, func(key string, value *yaml.Node) error {
+		switch key {
+		case "urn":
+			var urn string
+			err := value.Decode(&urn)
+			ref.URN = URN(urn)
+			return err
+		case "id":
+			id, err := unmarshalPropertyValueYAML(value)
+			ref.ID = id
+			return err
+		case "packageVersion":
+			return value.Decode(&ref.PackageVersion)
+		default:
+			return errors.Errorf("unexpected resource reference field %v", key)
+		}
+	})
+	if err != nil {
+		return PropertyValue{}, err
+	}
+	return NewResourceReferenceProperty(ref), nil
+}
+
+// forEachYAMLField calls f with each key and value of the given mapping node, in order.
+func forEachYAMLField(node *yaml.Node, f func(key string, value *yaml.Node) error) error {
+	if node.Kind != yaml.MappingNode {
+		return errors.Errorf("expected a YAML mapping at line %d", node.Line)
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if err := f(node.Content[i].Value, node.Content[i+1]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendYAMLField appends the given key and string value to a mapping node if the value is not empty.
+func appendYAMLField(node *yaml.Node, key, value string) {
+	if value != "" {
+		node.Content = append(node.Content, yamlString(key), yamlString(value))
+	}
+}
+
+// yamlString returns a scalar node for the given string. The encoder quotes the value if it would otherwise be read
+// back as a different type.
+func yamlString(s string) *yaml.Node {
+	node := &yaml.Node{}
+	node.SetString(s)
+	return node
+}
+
+// formatYAMLNumber formats a number as a plain YAML scalar.
+func formatYAMLNumber(f float64) string {
+	switch {
+	case math.IsNaN(f):
+		return ".nan"
+	case math.IsInf(f, 1):
+		return ".inf"
+	case math.IsInf(f, -1):
+		return "-.inf"
+	default:
+		return strconv.FormatFloat(f, 'g', -1, 64)
+	}
+}
+
+// sortedAssetNames returns the names of the given archive assets in sorted order.
+func sortedAssetNames(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,101 @@
+// Copyright 2016-2022, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPropertyMapYAML(t *testing.T) {
+	t.Parallel()
+
+	archive := &Archive{Sig: ArchiveSig, Hash: "abc", Assets: map[string]interface{}{
+		"file.txt": &Asset{Sig: AssetSig, Text: "hello"},
+		"nested":   &Archive{Sig: ArchiveSig, Path: "dir"},
+	}}
+	props := PropertyMap{
+		"null":    NewNullProperty(),
+		"bool":    NewBoolProperty(true),
+		"int":     NewNumberProperty(42),
+		"float":   NewNumberProperty(3.5),
+		"inf":     NewNumberProperty(math.Inf(-1)),
+		"string":  NewStringProperty("hello"),
+		"numeric": NewStringProperty("42"),
+		"boolish": NewStringProperty("true"),
+		"empty":   NewStringProperty(""),
+		"lines":   NewStringProperty("a\nb\n"),
+		"array":   NewArrayProperty([]PropertyValue{NewNumberProperty(1), NewStringProperty("two")}),
+		"object":  NewObjectProperty(PropertyMap{"a": NewStringProperty("b")}),
+		"unknown": MakeComputed(NewStringProperty("")),
+		"secret":  MakeSecret(NewStringProperty("42")),
+		"secretN": MakeSecret(NewNumberProperty(42)),
+		"secretO": MakeSecret(NewObjectProperty(PropertyMap{"x": NewBoolProperty(false)})),
+		"secretU": MakeSecret(MakeComputed(NewStringProperty(""))),
+		"asset":   NewAssetProperty(&Asset{Sig: AssetSig, Hash: "123", Path: "file.txt"}),
+		"archive": NewArchiveProperty(archive),
+		"secretA": MakeSecret(NewAssetProperty(&Asset{Sig: AssetSig, URI: "https://example.com"})),
+		"output": NewOutputProperty(Output{
+			Known:        true,
+			Element:      NewStringProperty("x"),
+			Dependencies: []URN{"urn"},
+		}),
+		"unknownO":  NewOutputProperty(Output{Secret: true}),
+		"resource":  MakeCustomResourceReference("urn:pulumi:stack::project::pkg:index:Res::res", "id", "1.0.0"),
+		"unknownR":  MakeCustomResourceReference("urn:pulumi:stack::project::pkg:index:Res::res", "", ""),
+		"component": MakeComponentResourceReference("urn:pulumi:stack::project::pkg:index:Comp::comp", ""),
+	}
+
+	b, err := MarshalPropertyMapYAML(props)
+	require.NoError(t, err)
+	actual, err := UnmarshalPropertyMapYAML(b)
+	require.NoError(t, err)
+	assert.Equal(t, props, actual, string(b))
+}
+
+func TestUnmarshalPropertyMapYAML(t *testing.T) {
+	t.Parallel()
+
+	actual, err := UnmarshalPropertyMapYAML([]byte(`
+name: web
+port: 8080
+tags: [a, b]
+password: !secret hunter2
+token: !secret "1234"
+id: !unknown
+`))
+	require.NoError(t, err)
+	assert.Equal(t, PropertyMap{
+		"name":     NewStringProperty("web"),
+		"port":     NewNumberProperty(8080),
+		"tags":     NewArrayProperty([]PropertyValue{NewStringProperty("a"), NewStringProperty("b")}),
+		"password": MakeSecret(NewStringProperty("hunter2")),
+		"token":    MakeSecret(NewStringProperty("1234")),
+		"id":       MakeComputed(NewStringProperty("")),
+	}, actual)
+
+	actual, err = UnmarshalPropertyMapYAML(nil)
+	require.NoError(t, err)
+	assert.Equal(t, PropertyMap{}, actual)
+
+	_, err = UnmarshalPropertyMapYAML([]byte("[1, 2]"))
+	assert.EqualError(t, err, "expected a YAML mapping at line 1")
+
+	_, err = UnmarshalPropertyMapYAML([]byte("a: !custom b"))
+	assert.EqualError(t, err, "unmarshaling property a: unsupported YAML tag !custom at line 1")
+}
@@ -44,6 +44,7 @@ type State struct {
 	CustomTimeouts          CustomTimeouts        // A config block that will be used to configure timeouts for CRUD operations.
 	ImportID                ID                    // the resource's import id, if this was an imported resource.
 	RetainOnDelete          bool                  // if set to True, the providers Delete method will not be called for this resource.
+	SchemaVersion           int                   // the provider's state schema version for the outputs.
 }
 
 func (s *State) GetAliasURNs() []URN {
@@ -15,6 +15,7 @@
 package resource
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
@@ -68,12 +69,48 @@ func NewURN(stack tokens.QName, proj tokens.PackageName, parentType, baseType to
 	)
 }
 
-// IsValid returns true if the URN is well-formed.
-func (urn URN) IsValid() bool {
-	if !strings.HasPrefix(string(urn), URNPrefix) {
-		return false
+// URNComponents are the elements of a URN, as extracted by ParseURN.
+type URNComponents struct {
+	Stack         string // the stack being deployed into.
+	Project       string // the project being evaluated.
+	QualifiedType string // the resource's type token, including the type tokens of its parents.
+	Name          string // the resource's name.
+}
+
+// Type returns the resource's type token, excluding the type tokens of its parents.
+func (c URNComponents) Type() string {
+	return c.QualifiedType[strings.LastIndex(c.QualifiedType, URNTypeDelimiter)+1:]
+}
+
+// ParseURN parses the given string as a URN of the form urn:pulumi:<Stack>::<Project>::<Qualified$Type>::<Name> and
+// returns its elements. The name is everything after the third delimiter, so it may itself contain "::".
+func ParseURN(s string) (URNComponents, error) {
+	if !strings.HasPrefix(s, URNPrefix) {
+		return URNComponents{}, fmt.Errorf("invalid URN %q: missing %q prefix", s, URNPrefix)
+	}
+	parts := strings.SplitN(s[len(URNPrefix):], URNNameDelimiter, 4)
+	if len(parts) != 4 {
+		return URNComponents{}, fmt.Errorf("invalid URN %q: expected 4 elements separated by %q, got %d",
+			s, URNNameDelimiter, len(parts))
 	}
-	return len(strings.Split(string(urn), URNNameDelimiter)) == 4
+	return URNComponents{
+		Stack:         parts[0],
+		Project:       parts[1],
+		QualifiedType: parts[2],
+		Name:          parts[3],
+	}, nil
+}
+
+// IsValidURN returns true if the given string is a well-formed URN, i.e. if ParseURN accepts it.
+func IsValidURN(s string) bool {
+	_, err := ParseURN(s)
+	return err == nil
+}
+
+// IsValid returns true if the URN is well-formed. As with ParseURN, the name is everything after the third delimiter,
+// so a URN whose name contains "::" is valid.
+func (urn URN) IsValid() bool {
+	return IsValidURN(string(urn))
 }
 
 // URNName returns the URN name part of a URN (i.e., strips off the prefix).
@@ -83,32 +120,38 @@ func (urn URN) URNName() string {
 	return s[len(URNPrefix):]
 }
 
-// Stack returns the resource stack part of a URN.
+// components returns the elements of a URN, or the zero value if the URN is malformed.
+func (urn URN) components() URNComponents {
+	c, err := ParseURN(string(urn))
+	if err != nil {
+		return URNComponents{}
+	}
+	return c
+}
+
+// Stack returns the resource stack part of a URN, or "" if the URN is malformed.
 func (urn URN) Stack() tokens.QName {
-	return tokens.QName(strings.Split(urn.URNName(), URNNameDelimiter)[0])
+	return tokens.QName(urn.components().Stack)
 }
 
-// Project returns the project name part of a URN.
+// Project returns the project name part of a URN, or "" if the URN is malformed.
 func (urn URN) Project() tokens.PackageName {
-	return tokens.PackageName(strings.Split(urn.URNName(), URNNameDelimiter)[1])
+	return tokens.PackageName(urn.components().Project)
 }
 
-// QualifiedType returns the resource type part of a URN including the parent type
+// QualifiedType returns the resource type part of a URN including the parent type, or "" if the URN is malformed.
 func (urn URN) QualifiedType() tokens.Type {
-	return tokens.Type(strings.Split(urn.URNName(), URNNameDelimiter)[2])
+	return tokens.Type(urn.components().QualifiedType)
 }
 
-// Type returns the resource type part of a URN
+// Type returns the resource type part of a URN, or "" if the URN is malformed.
 func (urn URN) Type() tokens.Type {
-	qualifiedType := strings.Split(urn.URNName(), URNNameDelimiter)[2]
-	types := strings.Split(qualifiedType, URNTypeDelimiter)
-	lastType := types[len(types)-1]
-	return tokens.Type(lastType)
+	return tokens.Type(urn.components().Type())
 }
 
-// Name returns the resource name part of a URN.
+// Name returns the resource name part of a URN, or "" if the URN is malformed.
 func (urn URN) Name() tokens.QName {
-	return tokens.QName(strings.Split(urn.URNName(), URNNameDelimiter)[3])
+	return tokens.QName(urn.components().Name)
 }
 
 // Returns a new URN with an updated name part
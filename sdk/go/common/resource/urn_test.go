@@ -53,3 +53,57 @@ func TestURNRoundTripping2(t *testing.T) {
 	assert.Equal(t, typ, urn.Type())
 	assert.Equal(t, name, urn.Name())
 }
+
+func TestParseURN(t *testing.T) {
+	t.Parallel()
+
+	c, err := ParseURN("urn:pulumi:stck::foo/bar::parent$pkg:mod:Type::a::name")
+	assert.NoError(t, err)
+	assert.Equal(t, URNComponents{
+		Stack:         "stck",
+		Project:       "foo/bar",
+		QualifiedType: "parent$pkg:mod:Type",
+		Name:          "a::name",
+	}, c)
+	assert.Equal(t, "pkg:mod:Type", c.Type())
+
+	_, err = ParseURN("urn:other:stck::proj::pkg:mod:Type::name")
+	assert.EqualError(t, err, `invalid URN "urn:other:stck::proj::pkg:mod:Type::name": missing "urn:pulumi:" prefix`)
+	_, err = ParseURN("urn:pulumi:stck::proj::name")
+	assert.EqualError(t, err, `invalid URN "urn:pulumi:stck::proj::name": expected 4 elements separated by "::", got 3`)
+
+	assert.True(t, IsValidURN("urn:pulumi:stck::proj::pkg:mod:Type::name"))
+	assert.False(t, IsValidURN(""))
+	assert.False(t, URN("AnUrn::ASegment").IsValid())
+}
+
+func TestCreateURNParentNameWithDelimiter(t *testing.T) {
+	t.Parallel()
+
+	parent := URN("urn:pulumi:stck::proj::pkg:mod:Parent::a::b")
+	assert.Equal(t, URN("urn:pulumi:stck::proj::pkg:mod:Parent$pkg:mod:Child::child"),
+		CreateURN("child", "pkg:mod:Child", parent, "", ""))
+}
+
+func TestURNNameWithDelimiter(t *testing.T) {
+	t.Parallel()
+
+	urn := URN("urn:pulumi:stck::proj::pkg:mod:Type::a::b")
+	assert.True(t, urn.IsValid())
+	assert.Equal(t, tokens.QName("a::b"), urn.Name())
+	assert.Equal(t, tokens.Type("pkg:mod:Type"), urn.Type())
+	assert.Equal(t, URN("urn:pulumi:stck::proj::pkg:mod:Type::c"), urn.Rename("c"))
+}
+
+func TestMalformedURNAccessors(t *testing.T) {
+	t.Parallel()
+
+	for _, urn := range []URN{"", "AnUrn::ASegment", "urn:pulumi:stck::proj::name"} {
+		assert.False(t, urn.IsValid())
+		assert.Equal(t, tokens.QName(""), urn.Stack())
+		assert.Equal(t, tokens.PackageName(""), urn.Project())
+		assert.Equal(t, tokens.Type(""), urn.QualifiedType())
+		assert.Equal(t, tokens.Type(""), urn.Type())
+		assert.Equal(t, tokens.QName(""), urn.Name())
+	}
+}
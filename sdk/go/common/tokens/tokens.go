@@ -88,6 +88,16 @@ func (tok Token) delimiter(n int) int {
 	return ix
 }
 
+// splitQualifiedToken splits a token of the form "package:module:member" into its three parts. It returns false if
+// the token does not have exactly three parts.
+func splitQualifiedToken(tok Token) (string, string, string, bool) {
+	parts := strings.Split(string(tok), TokenDelimiter)
+	if len(parts) != 3 {
+		return "", "", "", false
+	}
+	return parts[0], parts[1], parts[2], true
+}
+
 // Name returns the Token as a Name (and assumes it is a legal one).
 func (tok Token) Name() Name {
 	contract.Requiref(tok.Simple(), "tok", "Simple")
@@ -188,6 +198,25 @@ func ParseModuleMember(s string) (ModuleMember, error) {
 	return ModuleMember(s), nil
 }
 
+// ValidateModuleMember returns an error if m does not have the form "package:module:member", where the package and
+// module are legal QNames and the member is a legal Name. The member may also be qualified by a single Name, as it is
+// in the tokens of resource methods (e.g. "pkg:index:Component/method").
+func ValidateModuleMember(m ModuleMember) error {
+	pkg, mod, name, ok := splitQualifiedToken(Token(m))
+	if !ok || !IsQName(pkg) || !IsQName(mod) || !isMemberName(name) {
+		return errors.Errorf("'%v' is not a valid module member token (must have format 'package:module:member')", m)
+	}
+	return nil
+}
+
+// isMemberName returns true if s is a legal Name, optionally qualified by another Name.
+func isMemberName(s string) bool {
+	if ix := strings.Index(s, QNameDelimiter); ix != -1 {
+		return IsName(s[:ix]) && IsName(s[ix+1:])
+	}
+	return IsName(s)
+}
+
 func (tok ModuleMember) Package() Package {
 	return tok.Module().Package()
 }
@@ -226,6 +255,17 @@ func ParseTypeToken(s string) (Type, error) {
 	return Type(tok), nil
 }
 
+// ValidateType returns an error if t does not have the form "package:module:name", where the package and module are
+// legal QNames and the name is a legal Name. Primitive and decorated types are not valid, since they do not refer to
+// a type defined by a package.
+func ValidateType(t Type) error {
+	pkg, mod, name, ok := splitQualifiedToken(Token(t))
+	if !ok || !IsQName(pkg) || !IsQName(mod) || !IsName(name) {
+		return errors.Errorf("'%v' is not a valid type token (must have format 'package:module:name')", t)
+	}
+	return nil
+}
+
 func (tok Type) Package() Package {
 	if tok.Primitive() {
 		return Package("")
@@ -56,3 +56,27 @@ func TestTokens(t *testing.T) {
 	assert.Equal(t, p, modm.Module().Package().Name().String())
 	assert.Equal(t, p+TokenDelimiter+m+TokenDelimiter+mm, modm.String())
 }
+
+func TestValidateType(t *testing.T) {
+	t.Parallel()
+
+	for _, tok := range []Type{"aws:s3/bucket:Bucket", "pulumi:providers:aws", "kubernetes:apps.k8s.io/v1:Deployment"} {
+		assert.NoError(t, ValidateType(tok), tok)
+	}
+	for _, tok := range []Type{"", "string", "aws:Bucket", "aws::Bucket", ":s3:Bucket", "aws:s3:", "aws:s3:Bucket:x",
+		"aws:s3:Bucket/method", "aws:s3:Bu cket"} {
+		assert.Error(t, ValidateType(tok), tok)
+	}
+}
+
+func TestValidateModuleMember(t *testing.T) {
+	t.Parallel()
+
+	for _, tok := range []ModuleMember{"aws:index/getAmi:getAmi", "pkg:index:Component/method"} {
+		assert.NoError(t, ValidateModuleMember(tok), tok)
+	}
+	for _, tok := range []ModuleMember{"", "getAmi", "aws:getAmi", "aws::getAmi", "aws:index:", "aws:index:a/b/c",
+		"aws:index:Component/", "aws:index:get:Ami"} {
+		assert.Error(t, ValidateModuleMember(tok), tok)
+	}
+}
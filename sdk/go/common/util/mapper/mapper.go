@@ -53,6 +53,7 @@ type Opts struct {
 	CustomDecoders     Decoders // custom decoders.
 	IgnoreMissing      bool     // ignore missing required fields.
 	IgnoreUnrecognized bool     // ignore unrecognized fields.
+	EncodeNil          bool     // encode nil fields as nil values rather than omitting them.
 }
 
 type mapper struct {
@@ -55,7 +55,9 @@ func (md *mapper) encode(vsrc reflect.Value) (map[string]interface{}, MappingErr
 			if err != nil {
 				errs = append(errs, err.Failures()...)
 			} else if v == nil {
-				if !fldtag.Optional && !md.opts.IgnoreMissing {
+				if md.opts.EncodeNil {
+					obj[key] = nil
+				} else if !fldtag.Optional && !md.opts.IgnoreMissing {
 					// The field doesn't exist and yet it is required; issue an error.
 					errs = append(errs, NewMissingError(vsrcType, key))
 				}
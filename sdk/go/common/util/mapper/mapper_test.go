@@ -134,6 +134,15 @@ func TestMapperEncode(t *testing.T) {
 	m, err = md.Encode((AnInterface)(nil))
 	require.Nil(t, err)
 	assert.Len(t, m, 0)
+
+	// Encode nil fields as nil values rather than omitting them.
+	type ptrtag struct {
+		String    string  `pulumi:"s"`
+		StringPtr *string `pulumi:"sp"`
+	}
+	m, err = New(&Opts{EncodeNil: true}).Encode(ptrtag{String: "something"})
+	require.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"s": "something", "sp": nil}, m)
 }
 
 func TestMapperDecode(t *testing.T) {
@@ -18,6 +18,8 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 )
 
 // Alias is a partial description of prior named used for a resource. It can be processed in the
@@ -119,11 +121,11 @@ func CreateURN(name, t, parent, project, stack StringInput) URNOutput {
 		if parent == "" {
 			parentPrefix = "urn:pulumi:" + stack + "::" + project + "::"
 		} else {
-			ix := strings.LastIndex(parent, "::")
-			if ix == -1 {
-				panic(fmt.Sprintf("Expected 'parent' string '%s' to contain '::'", parent))
+			c, err := resource.ParseURN(parent)
+			if err != nil {
+				panic(fmt.Sprintf("Expected 'parent' string '%s' to be a valid URN: %v", parent, err))
 			}
-			parentPrefix = parent[0:ix] + "$"
+			parentPrefix = "urn:pulumi:" + c.Stack + "::" + c.Project + "::" + c.QualifiedType + "$"
 		}
 		return URN(parentPrefix + t + "::" + name)
 	}
@@ -142,7 +144,7 @@ func inheritedChildAlias(childName, parentName, childType, project, stack string
 	aliasName := StringInput(String(childName))
 	if strings.HasPrefix(childName, parentName) {
 		aliasName = parentURN.ApplyT(func(urn URN) string {
-			parentPrefix := urn[strings.LastIndex(string(urn), "::")+2:]
+			parentPrefix := resource.URN(urn).Name()
 			return string(parentPrefix) + childName[len(parentName):]
 		}).(StringOutput)
 	}
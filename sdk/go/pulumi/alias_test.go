@@ -33,7 +33,7 @@ var aliasTestCases = []struct {
 				Type: String("kubernetes:storage.k8s.io/v1beta1:CSIDriver"),
 			}
 		},
-		"AnUrn$kubernetes:storage.k8s.io/v1beta1:CSIDriver::defName",
+		"urn:pulumi:defStack::defProject::pkg:mod:AnUrn$kubernetes:storage.k8s.io/v1beta1:CSIDriver::defName",
 	},
 	{
 		"noParent",
@@ -49,18 +49,27 @@ var aliasTestCases = []struct {
 		func(t *testing.T) Alias {
 			return Alias{
 				Type:   String("kubernetes:storage.k8s.io/v1beta1:CSIDriver"),
-				Parent: newResource(t, URN("AParent::AParent"), ID("theParent")),
+				Parent: newResource(t, URN("urn:pulumi:defStack::defProject::pkg:mod:AParent::AParent"), ID("theParent")),
 			}
-		}, "AParent$kubernetes:storage.k8s.io/v1beta1:CSIDriver::defName",
+		}, "urn:pulumi:defStack::defProject::pkg:mod:AParent$kubernetes:storage.k8s.io/v1beta1:CSIDriver::defName",
 	},
 	{
 		"parentURN",
 		func(*testing.T) Alias {
 			return Alias{
 				Type:      String("kubernetes:storage.k8s.io/v1beta1:CSIDriver"),
-				ParentURN: URN("AParent::AParent"),
+				ParentURN: URN("urn:pulumi:defStack::defProject::pkg:mod:AParent::AParent"),
 			}
-		}, "AParent$kubernetes:storage.k8s.io/v1beta1:CSIDriver::defName",
+		}, "urn:pulumi:defStack::defProject::pkg:mod:AParent$kubernetes:storage.k8s.io/v1beta1:CSIDriver::defName",
+	},
+	{
+		"parentNameWithDelimiter",
+		func(*testing.T) Alias {
+			return Alias{
+				Type:      String("kubernetes:storage.k8s.io/v1beta1:CSIDriver"),
+				ParentURN: URN("urn:pulumi:defStack::defProject::pkg:mod:AParent::a::b"),
+			}
+		}, "urn:pulumi:defStack::defProject::pkg:mod:AParent$kubernetes:storage.k8s.io/v1beta1:CSIDriver::defName",
 	},
 }
 
@@ -71,7 +80,7 @@ func TestAliasResolution(t *testing.T) {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			parent := newResource(t, URN("AnUrn::ASegment"), ID("hello"))
+			parent := newResource(t, URN("urn:pulumi:defStack::defProject::pkg:mod:AnUrn::ASegment"), ID("hello"))
 			out, err := tt.alias(t).collapseToURN("defName", "defType", parent, "defProject", "defStack")
 			assert.NoError(t, err)
 			urn, _, _, err := out.awaitURN(context.Background())
@@ -81,6 +90,16 @@ func TestAliasResolution(t *testing.T) {
 	}
 }
 
+func TestInheritedChildAlias(t *testing.T) {
+	t.Parallel()
+
+	parentURN := URN("urn:pulumi:defStack::defProject::pkg:mod:Parent::old::name").ToURNOutput()
+	out := inheritedChildAlias("new::name-child", "new::name", "pkg:mod:Child", "defProject", "defStack", parentURN)
+	urn, _, _, err := out.awaitURN(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, URN("urn:pulumi:defStack::defProject::pkg:mod:Parent$pkg:mod:Child::old::name-child"), urn)
+}
+
 func newResource(t *testing.T, urn URN, id ID) Resource {
 	ctx, err := NewContext(context.Background(), RunInfo{})
 	assert.NoError(t, err)
@@ -21,6 +21,9 @@ import (
 	"reflect"
 	"sort"
 	"strings"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
@@ -33,15 +36,47 @@ import (
 type constructFunc func(ctx *Context, typ, name string, inputs map[string]interface{},
 	options ResourceOption) (URNInput, Input, error)
 
+// NamespaceConfigKey is the well-known configuration key under which Construct exposes the namespace that the engine
+// was asked to prefix resource names with, if any. Components can read it with config.Get(ctx, NamespaceConfigKey).
+const NamespaceConfigKey = "pulumi:namespace"
+
+// startRequestSpan starts a span for a Construct or Call request. If the engine sent the IDs of its own span for the
+// request, they are recorded as tags so that the component's work can be correlated with the deployment's trace.
+func startRequestSpan(ctx context.Context, operationName, traceID, spanID string) (opentracing.Span, context.Context) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, operationName)
+	if traceID != "" {
+		span.SetTag("pulumi.engine.trace_id", traceID)
+		span.SetTag("pulumi.engine.span_id", spanID)
+	}
+	return span, ctx
+}
+
+// constructConfig returns the configuration for a Construct request, adding the request's namespace, if any, under
+// NamespaceConfigKey.
+func constructConfig(cfg map[string]string, namespace string) map[string]string {
+	if namespace == "" {
+		return cfg
+	}
+	result := make(map[string]string, len(cfg)+1)
+	for k, v := range cfg {
+		result[k] = v
+	}
+	result[NamespaceConfigKey] = namespace
+	return result
+}
+
 // construct adapts the gRPC ConstructRequest/ConstructResponse to/from the Pulumi Go SDK programming model.
 func construct(ctx context.Context, req *pulumirpc.ConstructRequest, engineConn *grpc.ClientConn,
 	constructF constructFunc) (*pulumirpc.ConstructResponse, error) {
 
+	span, ctx := startRequestSpan(ctx, "pulumi-construct", req.GetTraceId(), req.GetSpanId())
+	defer span.Finish()
+
 	// Configure the RunInfo.
 	runInfo := RunInfo{
 		Project:          req.GetProject(),
 		Stack:            req.GetStack(),
-		Config:           req.GetConfig(),
+		Config:           constructConfig(req.GetConfig(), req.GetNamespace()),
 		ConfigSecretKeys: req.GetConfigSecretKeys(),
 		Parallel:         int(req.GetParallel()),
 		DryRun:           req.GetDryRun(),
@@ -106,6 +141,14 @@ func construct(ctx context.Context, req *pulumirpc.ConstructRequest, engineConn
 	if req.GetParent() != "" {
 		parent = pulumiCtx.newDependencyResource(URN(req.GetParent()))
 	}
+	var transformations []ResourceTransformation
+	if timeouts := req.GetCustomTimeouts(); timeouts != nil {
+		transformations = append(transformations, timeoutsTransformation(&CustomTimeouts{
+			Create: timeouts.GetCreate(),
+			Update: timeouts.GetUpdate(),
+			Delete: timeouts.GetDelete(),
+		}))
+	}
 	opts := resourceOption(func(ro *resourceOptions) {
 		ro.Aliases = aliases
 		ro.DependsOn = []func(ctx context.Context) (urnSet, error){
@@ -116,6 +159,7 @@ func construct(ctx context.Context, req *pulumirpc.ConstructRequest, engineConn
 		ro.Protect = req.GetProtect()
 		ro.Providers = providers
 		ro.Parent = parent
+		ro.Transformations = append(ro.Transformations, transformations...)
 	})
 
 	urn, state, err := constructF(pulumiCtx, req.GetType(), req.GetName(), inputs, opts)
@@ -216,6 +260,24 @@ func (ci constructInput) Dependencies(ctx *Context) []Resource {
 	return result
 }
 
+// timeoutsTransformation returns a transformation that applies the given timeouts to the custom resources in a
+// component's tree that do not set their own timeouts. Component resources are skipped, as the engine ignores
+// timeouts on components.
+func timeoutsTransformation(timeouts *CustomTimeouts) ResourceTransformation {
+	return func(args *ResourceTransformationArgs) *ResourceTransformationResult {
+		if _, ok := args.Resource.(CustomResource); !ok {
+			return nil
+		}
+		if merge(args.Opts...).CustomTimeouts != nil {
+			return nil
+		}
+		return &ResourceTransformationResult{
+			Props: args.Props,
+			Opts:  append(args.Opts, Timeouts(timeouts)),
+		}
+	}
+}
+
 // constructInputsMap returns the inputs as a Map.
 func constructInputsMap(ctx *Context, inputs map[string]interface{}) (Map, error) {
 	result := make(Map, len(inputs))
@@ -728,6 +790,16 @@ type callFunc func(ctx *Context, tok string, args map[string]interface{}) (Input
 func call(ctx context.Context, req *pulumirpc.CallRequest, engineConn *grpc.ClientConn,
 	callF callFunc) (*pulumirpc.CallResponse, error) {
 
+	span, ctx := startRequestSpan(ctx, "pulumi-call", req.GetTraceId(), req.GetSpanId())
+	defer span.Finish()
+
+	// If the caller gave the call a timeout, cancel the call's context once it elapses.
+	if timeout := req.GetTimeout(); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(timeout*float64(time.Second)))
+		defer cancel()
+	}
+
 	// Configure the RunInfo.
 	runInfo := RunInfo{
 		Project:      req.GetProject(),
@@ -18,12 +18,14 @@ package pulumi
 import (
 	"context"
 	"reflect"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
 )
 
 type BoolEnumInput interface {
@@ -1736,3 +1738,63 @@ func TestSerdeNilNestedResource(t *testing.T) {
 	_, _, _, err = marshalInputs(state)
 	assert.NoError(t, err)
 }
+
+func TestConstructTimeoutsTransformation(t *testing.T) {
+	t.Parallel()
+
+	var m sync.Mutex
+	timeouts := map[string]*pulumirpc.RegisterResourceRequest_CustomTimeouts{}
+	mocks := &testMonitor{
+		NewResourceF: func(args MockResourceArgs) (string, resource.PropertyMap, error) {
+			m.Lock()
+			defer m.Unlock()
+			timeouts[args.Name] = args.RegisterRPC.GetCustomTimeouts()
+			return args.Name, resource.PropertyMap{}, nil
+		},
+	}
+
+	err := RunErr(func(ctx *Context) error {
+		// Register a component the same way that construct does, with a transformation that forwards the timeouts.
+		var comp ResourceState
+		err := ctx.RegisterComponentResource("pkg:index:Component", "comp", &comp,
+			Transformations([]ResourceTransformation{timeoutsTransformation(&CustomTimeouts{Create: "10m"})}))
+		if err != nil {
+			return err
+		}
+
+		var inherited testResource2
+		err = ctx.RegisterResource("test:resource:type", "inherited", &testResource2Inputs{}, &inherited,
+			Parent(&comp))
+		if err != nil {
+			return err
+		}
+
+		var explicit testResource2
+		return ctx.RegisterResource("test:resource:type", "explicit", &testResource2Inputs{}, &explicit,
+			Parent(&comp), Timeouts(&CustomTimeouts{Delete: "1m"}))
+	}, WithMocks("project", "stack", mocks))
+	require.NoError(t, err)
+
+	// The component itself should not receive the timeouts, but children without their own timeouts should.
+	assert.Empty(t, timeouts["comp"].GetCreate())
+	assert.Equal(t, "10m", timeouts["inherited"].GetCreate())
+	assert.Empty(t, timeouts["explicit"].GetCreate())
+	assert.Equal(t, "1m", timeouts["explicit"].GetDelete())
+}
+
+func TestConstructConfigNamespace(t *testing.T) {
+	t.Parallel()
+
+	cfg := map[string]string{"project:name": "value"}
+
+	// Without a namespace, the configuration is passed through unchanged.
+	assert.Equal(t, cfg, constructConfig(cfg, ""))
+
+	// A namespace is exposed under the well-known key without modifying the request's configuration.
+	actual := constructConfig(cfg, "tenant-a")
+	assert.Equal(t, map[string]string{
+		"project:name":     "value",
+		NamespaceConfigKey: "tenant-a",
+	}, actual)
+	assert.Len(t, cfg, 1)
+}
@@ -77,6 +77,39 @@ function deserialize_pulumirpc_CheckResponse(buffer_arg) {
   return pulumi_provider_pb.CheckResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_pulumirpc_ConfigChangedEvent(arg) {
+  if (!(arg instanceof pulumi_provider_pb.ConfigChangedEvent)) {
+    throw new Error('Expected argument of type pulumirpc.ConfigChangedEvent');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_ConfigChangedEvent(buffer_arg) {
+  return pulumi_provider_pb.ConfigChangedEvent.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pulumirpc_ConfigChecksumMatchRequest(arg) {
+  if (!(arg instanceof pulumi_provider_pb.ConfigChecksumMatchRequest)) {
+    throw new Error('Expected argument of type pulumirpc.ConfigChecksumMatchRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_ConfigChecksumMatchRequest(buffer_arg) {
+  return pulumi_provider_pb.ConfigChecksumMatchRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pulumirpc_ConfigChecksumMatchResponse(arg) {
+  if (!(arg instanceof pulumi_provider_pb.ConfigChecksumMatchResponse)) {
+    throw new Error('Expected argument of type pulumirpc.ConfigChecksumMatchResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_ConfigChecksumMatchResponse(buffer_arg) {
+  return pulumi_provider_pb.ConfigChecksumMatchResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_pulumirpc_ConfigureRequest(arg) {
   if (!(arg instanceof pulumi_provider_pb.ConfigureRequest)) {
     throw new Error('Expected argument of type pulumirpc.ConfigureRequest');
@@ -176,6 +209,72 @@ function deserialize_pulumirpc_DiffResponse(buffer_arg) {
   return pulumi_provider_pb.DiffResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_pulumirpc_EstimateCostRequest(arg) {
+  if (!(arg instanceof pulumi_provider_pb.EstimateCostRequest)) {
+    throw new Error('Expected argument of type pulumirpc.EstimateCostRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_EstimateCostRequest(buffer_arg) {
+  return pulumi_provider_pb.EstimateCostRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pulumirpc_EstimateCostResponse(arg) {
+  if (!(arg instanceof pulumi_provider_pb.EstimateCostResponse)) {
+    throw new Error('Expected argument of type pulumirpc.EstimateCostResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_EstimateCostResponse(buffer_arg) {
+  return pulumi_provider_pb.EstimateCostResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pulumirpc_GetMappingRequest(arg) {
+  if (!(arg instanceof pulumi_provider_pb.GetMappingRequest)) {
+    throw new Error('Expected argument of type pulumirpc.GetMappingRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_GetMappingRequest(buffer_arg) {
+  return pulumi_provider_pb.GetMappingRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pulumirpc_GetMappingResponse(arg) {
+  if (!(arg instanceof pulumi_provider_pb.GetMappingResponse)) {
+    throw new Error('Expected argument of type pulumirpc.GetMappingResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_GetMappingResponse(buffer_arg) {
+  return pulumi_provider_pb.GetMappingResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pulumirpc_GetResourceAliasesRequest(arg) {
+  if (!(arg instanceof pulumi_provider_pb.GetResourceAliasesRequest)) {
+    throw new Error('Expected argument of type pulumirpc.GetResourceAliasesRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_GetResourceAliasesRequest(buffer_arg) {
+  return pulumi_provider_pb.GetResourceAliasesRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pulumirpc_GetResourceAliasesResponse(arg) {
+  if (!(arg instanceof pulumi_provider_pb.GetResourceAliasesResponse)) {
+    throw new Error('Expected argument of type pulumirpc.GetResourceAliasesResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_GetResourceAliasesResponse(buffer_arg) {
+  return pulumi_provider_pb.GetResourceAliasesResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_pulumirpc_GetSchemaRequest(arg) {
   if (!(arg instanceof pulumi_provider_pb.GetSchemaRequest)) {
     throw new Error('Expected argument of type pulumirpc.GetSchemaRequest');
@@ -198,6 +297,17 @@ function deserialize_pulumirpc_GetSchemaResponse(buffer_arg) {
   return pulumi_provider_pb.GetSchemaResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_pulumirpc_GetSupportedVersionsResponse(arg) {
+  if (!(arg instanceof pulumi_provider_pb.GetSupportedVersionsResponse)) {
+    throw new Error('Expected argument of type pulumirpc.GetSupportedVersionsResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_GetSupportedVersionsResponse(buffer_arg) {
+  return pulumi_provider_pb.GetSupportedVersionsResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_pulumirpc_InvokeRequest(arg) {
   if (!(arg instanceof pulumi_provider_pb.InvokeRequest)) {
     throw new Error('Expected argument of type pulumirpc.InvokeRequest');
@@ -220,6 +330,61 @@ function deserialize_pulumirpc_InvokeResponse(buffer_arg) {
   return pulumi_provider_pb.InvokeResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_pulumirpc_MigrateStateRequest(arg) {
+  if (!(arg instanceof pulumi_provider_pb.MigrateStateRequest)) {
+    throw new Error('Expected argument of type pulumirpc.MigrateStateRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_MigrateStateRequest(buffer_arg) {
+  return pulumi_provider_pb.MigrateStateRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pulumirpc_MigrateStateResponse(arg) {
+  if (!(arg instanceof pulumi_provider_pb.MigrateStateResponse)) {
+    throw new Error('Expected argument of type pulumirpc.MigrateStateResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_MigrateStateResponse(buffer_arg) {
+  return pulumi_provider_pb.MigrateStateResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pulumirpc_ParameterizeByReferenceRequest(arg) {
+  if (!(arg instanceof pulumi_provider_pb.ParameterizeByReferenceRequest)) {
+    throw new Error('Expected argument of type pulumirpc.ParameterizeByReferenceRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_ParameterizeByReferenceRequest(buffer_arg) {
+  return pulumi_provider_pb.ParameterizeByReferenceRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pulumirpc_ParameterizeByValueRequest(arg) {
+  if (!(arg instanceof pulumi_provider_pb.ParameterizeByValueRequest)) {
+    throw new Error('Expected argument of type pulumirpc.ParameterizeByValueRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_ParameterizeByValueRequest(buffer_arg) {
+  return pulumi_provider_pb.ParameterizeByValueRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pulumirpc_ParameterizeResponse(arg) {
+  if (!(arg instanceof pulumi_provider_pb.ParameterizeResponse)) {
+    throw new Error('Expected argument of type pulumirpc.ParameterizeResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_ParameterizeResponse(buffer_arg) {
+  return pulumi_provider_pb.ParameterizeResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_pulumirpc_PluginAttach(arg) {
   if (!(arg instanceof pulumi_plugin_pb.PluginAttach)) {
     throw new Error('Expected argument of type pulumirpc.PluginAttach');
@@ -242,6 +407,50 @@ function deserialize_pulumirpc_PluginInfo(buffer_arg) {
   return pulumi_plugin_pb.PluginInfo.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_pulumirpc_PrepareImportRequest(arg) {
+  if (!(arg instanceof pulumi_provider_pb.PrepareImportRequest)) {
+    throw new Error('Expected argument of type pulumirpc.PrepareImportRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_PrepareImportRequest(buffer_arg) {
+  return pulumi_provider_pb.PrepareImportRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pulumirpc_PrepareImportResponse(arg) {
+  if (!(arg instanceof pulumi_provider_pb.PrepareImportResponse)) {
+    throw new Error('Expected argument of type pulumirpc.PrepareImportResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_PrepareImportResponse(buffer_arg) {
+  return pulumi_provider_pb.PrepareImportResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pulumirpc_ProviderSupportsFeatureRequest(arg) {
+  if (!(arg instanceof pulumi_provider_pb.ProviderSupportsFeatureRequest)) {
+    throw new Error('Expected argument of type pulumirpc.ProviderSupportsFeatureRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_ProviderSupportsFeatureRequest(buffer_arg) {
+  return pulumi_provider_pb.ProviderSupportsFeatureRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pulumirpc_ProviderSupportsFeatureResponse(arg) {
+  if (!(arg instanceof pulumi_provider_pb.ProviderSupportsFeatureResponse)) {
+    throw new Error('Expected argument of type pulumirpc.ProviderSupportsFeatureResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_ProviderSupportsFeatureResponse(buffer_arg) {
+  return pulumi_provider_pb.ProviderSupportsFeatureResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_pulumirpc_ReadRequest(arg) {
   if (!(arg instanceof pulumi_provider_pb.ReadRequest)) {
     throw new Error('Expected argument of type pulumirpc.ReadRequest');
@@ -264,6 +473,17 @@ function deserialize_pulumirpc_ReadResponse(buffer_arg) {
   return pulumi_provider_pb.ReadResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_pulumirpc_ResourceChangedEvent(arg) {
+  if (!(arg instanceof pulumi_provider_pb.ResourceChangedEvent)) {
+    throw new Error('Expected argument of type pulumirpc.ResourceChangedEvent');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_ResourceChangedEvent(buffer_arg) {
+  return pulumi_provider_pb.ResourceChangedEvent.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_pulumirpc_UpdateRequest(arg) {
   if (!(arg instanceof pulumi_provider_pb.UpdateRequest)) {
     throw new Error('Expected argument of type pulumirpc.UpdateRequest');
@@ -287,6 +507,17 @@ function deserialize_pulumirpc_UpdateResponse(buffer_arg) {
 }
 
 
+function serialize_pulumirpc_WaitForResourceReadyRequest(arg) {
+  if (!(arg instanceof pulumi_provider_pb.WaitForResourceReadyRequest)) {
+    throw new Error('Expected argument of type pulumirpc.WaitForResourceReadyRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_WaitForResourceReadyRequest(buffer_arg) {
+  return pulumi_provider_pb.WaitForResourceReadyRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 // ResourceProvider is a service that understands how to create, read, update, or delete resources for types defined
 // within a single package.  It is driven by the overall planning engine in response to resource diffs.
 var ResourceProviderService = exports.ResourceProviderService = {
@@ -505,6 +736,240 @@ attach: {
     responseSerialize: serialize_google_protobuf_Empty,
     responseDeserialize: deserialize_google_protobuf_Empty,
   },
+  // GetMapping fetches the mapping for this resource provider, if any. A provider should return an empty
+// response (not an error) if it doesn't have a mapping for the given key.
+getMapping: {
+    path: '/pulumirpc.ResourceProvider/GetMapping',
+    requestStream: false,
+    responseStream: false,
+    requestType: pulumi_provider_pb.GetMappingRequest,
+    responseType: pulumi_provider_pb.GetMappingResponse,
+    requestSerialize: serialize_pulumirpc_GetMappingRequest,
+    requestDeserialize: deserialize_pulumirpc_GetMappingRequest,
+    responseSerialize: serialize_pulumirpc_GetMappingResponse,
+    responseDeserialize: deserialize_pulumirpc_GetMappingResponse,
+  },
+  // SupportsFeature returns true if the provider supports the given feature. Providers should return false (not an
+// error) for features that they do not recognize.
+supportsFeature: {
+    path: '/pulumirpc.ResourceProvider/SupportsFeature',
+    requestStream: false,
+    responseStream: false,
+    requestType: pulumi_provider_pb.ProviderSupportsFeatureRequest,
+    responseType: pulumi_provider_pb.ProviderSupportsFeatureResponse,
+    requestSerialize: serialize_pulumirpc_ProviderSupportsFeatureRequest,
+    requestDeserialize: deserialize_pulumirpc_ProviderSupportsFeatureRequest,
+    responseSerialize: serialize_pulumirpc_ProviderSupportsFeatureResponse,
+    responseDeserialize: deserialize_pulumirpc_ProviderSupportsFeatureResponse,
+  },
+  // ReadStream reads the current live state associated with a resource, like Read, but streams interim states back
+// to the caller until the read is complete. The last response sent is the final state of the resource.
+readStream: {
+    path: '/pulumirpc.ResourceProvider/ReadStream',
+    requestStream: false,
+    responseStream: true,
+    requestType: pulumi_provider_pb.ReadRequest,
+    responseType: pulumi_provider_pb.ReadResponse,
+    requestSerialize: serialize_pulumirpc_ReadRequest,
+    requestDeserialize: deserialize_pulumirpc_ReadRequest,
+    responseSerialize: serialize_pulumirpc_ReadResponse,
+    responseDeserialize: deserialize_pulumirpc_ReadResponse,
+  },
+  // Refresh reads the current live state associated with a resource as part of a refresh. Unlike Read, which is
+// also used to import resources, Refresh should report a resource that no longer exists by returning empty
+// properties rather than an error. Callers fall back to Read if this method is unimplemented.
+refresh: {
+    path: '/pulumirpc.ResourceProvider/Refresh',
+    requestStream: false,
+    responseStream: false,
+    requestType: pulumi_provider_pb.ReadRequest,
+    responseType: pulumi_provider_pb.ReadResponse,
+    requestSerialize: serialize_pulumirpc_ReadRequest,
+    requestDeserialize: deserialize_pulumirpc_ReadRequest,
+    responseSerialize: serialize_pulumirpc_ReadResponse,
+    responseDeserialize: deserialize_pulumirpc_ReadResponse,
+  },
+  // MigrateState upgrades the stored state of a resource that was written by a version of the provider with a
+// different state schema version than the one the provider reported from Configure. Callers use the stored state
+// unchanged if this method is unimplemented.
+migrateState: {
+    path: '/pulumirpc.ResourceProvider/MigrateState',
+    requestStream: false,
+    responseStream: false,
+    requestType: pulumi_provider_pb.MigrateStateRequest,
+    responseType: pulumi_provider_pb.MigrateStateResponse,
+    requestSerialize: serialize_pulumirpc_MigrateStateRequest,
+    requestDeserialize: deserialize_pulumirpc_MigrateStateRequest,
+    responseSerialize: serialize_pulumirpc_MigrateStateResponse,
+    responseDeserialize: deserialize_pulumirpc_MigrateStateResponse,
+  },
+  // WatchResourceChanges streams notifications of changes that the provider observes in the live state of the
+// resources it manages, e.g. changes made outside of Pulumi. Callers refresh the state of a changed resource before
+// diffing it. The stream stays open until the caller cancels it; callers ignore the method if it is unimplemented.
+watchResourceChanges: {
+    path: '/pulumirpc.ResourceProvider/WatchResourceChanges',
+    requestStream: false,
+    responseStream: true,
+    requestType: google_protobuf_empty_pb.Empty,
+    responseType: pulumi_provider_pb.ResourceChangedEvent,
+    requestSerialize: serialize_google_protobuf_Empty,
+    requestDeserialize: deserialize_google_protobuf_Empty,
+    responseSerialize: serialize_pulumirpc_ResourceChangedEvent,
+    responseDeserialize: deserialize_pulumirpc_ResourceChangedEvent,
+  },
+  // StreamCreate allocates a new instance of the provided resource, like Create, but streams interim states back to
+// the caller while the resource is being created, e.g. while a long-running job makes progress. Interim responses
+// leave the ID empty; the final response carries the ID and state of the created resource. Callers fall back to
+// Create if this method is unimplemented.
+streamCreate: {
+    path: '/pulumirpc.ResourceProvider/StreamCreate',
+    requestStream: false,
+    responseStream: true,
+    requestType: pulumi_provider_pb.CreateRequest,
+    responseType: pulumi_provider_pb.CreateResponse,
+    requestSerialize: serialize_pulumirpc_CreateRequest,
+    requestDeserialize: deserialize_pulumirpc_CreateRequest,
+    responseSerialize: serialize_pulumirpc_CreateResponse,
+    responseDeserialize: deserialize_pulumirpc_CreateResponse,
+  },
+  // EstimateCost estimates the monthly cost of running a resource with the given inputs, e.g. so that a preview can
+// report the cost of the changes it proposes. Callers omit the resource from any estimate if this method is
+// unimplemented.
+estimateCost: {
+    path: '/pulumirpc.ResourceProvider/EstimateCost',
+    requestStream: false,
+    responseStream: false,
+    requestType: pulumi_provider_pb.EstimateCostRequest,
+    responseType: pulumi_provider_pb.EstimateCostResponse,
+    requestSerialize: serialize_pulumirpc_EstimateCostRequest,
+    requestDeserialize: deserialize_pulumirpc_EstimateCostRequest,
+    responseSerialize: serialize_pulumirpc_EstimateCostResponse,
+    responseDeserialize: deserialize_pulumirpc_EstimateCostResponse,
+  },
+  // GetSupportedVersions returns the schema versions that GetSchema can serve. Callers request version 0 if this
+// method is unimplemented.
+getSupportedVersions: {
+    path: '/pulumirpc.ResourceProvider/GetSupportedVersions',
+    requestStream: false,
+    responseStream: false,
+    requestType: google_protobuf_empty_pb.Empty,
+    responseType: pulumi_provider_pb.GetSupportedVersionsResponse,
+    requestSerialize: serialize_google_protobuf_Empty,
+    requestDeserialize: deserialize_google_protobuf_Empty,
+    responseSerialize: serialize_pulumirpc_GetSupportedVersionsResponse,
+    responseDeserialize: deserialize_pulumirpc_GetSupportedVersionsResponse,
+  },
+  // ParameterizeByValue parameterizes the provider with an opaque value, e.g. the description of a bridged
+// sub-provider, and returns the package that the parameterized provider implements. Callers invoke it after
+// Configure and before any resource operations.
+parameterizeByValue: {
+    path: '/pulumirpc.ResourceProvider/ParameterizeByValue',
+    requestStream: false,
+    responseStream: false,
+    requestType: pulumi_provider_pb.ParameterizeByValueRequest,
+    responseType: pulumi_provider_pb.ParameterizeResponse,
+    requestSerialize: serialize_pulumirpc_ParameterizeByValueRequest,
+    requestDeserialize: deserialize_pulumirpc_ParameterizeByValueRequest,
+    responseSerialize: serialize_pulumirpc_ParameterizeResponse,
+    responseDeserialize: deserialize_pulumirpc_ParameterizeResponse,
+  },
+  // ParameterizeByReference parameterizes the provider with a package that it knows how to resolve itself, e.g. from
+// a registry, and returns the package that the parameterized provider implements. Callers invoke it after
+// Configure and before any resource operations.
+parameterizeByReference: {
+    path: '/pulumirpc.ResourceProvider/ParameterizeByReference',
+    requestStream: false,
+    responseStream: false,
+    requestType: pulumi_provider_pb.ParameterizeByReferenceRequest,
+    responseType: pulumi_provider_pb.ParameterizeResponse,
+    requestSerialize: serialize_pulumirpc_ParameterizeByReferenceRequest,
+    requestDeserialize: deserialize_pulumirpc_ParameterizeByReferenceRequest,
+    responseSerialize: serialize_pulumirpc_ParameterizeResponse,
+    responseDeserialize: deserialize_pulumirpc_ParameterizeResponse,
+  },
+  // PrepareImport normalizes the ID of a resource that is about to be imported and returns the minimal inputs that
+// are required to import it. Callers pass these inputs to Read, and fall back to the inputs returned by Read if
+// this method is unimplemented.
+prepareImport: {
+    path: '/pulumirpc.ResourceProvider/PrepareImport',
+    requestStream: false,
+    responseStream: false,
+    requestType: pulumi_provider_pb.PrepareImportRequest,
+    responseType: pulumi_provider_pb.PrepareImportResponse,
+    requestSerialize: serialize_pulumirpc_PrepareImportRequest,
+    requestDeserialize: deserialize_pulumirpc_PrepareImportRequest,
+    responseSerialize: serialize_pulumirpc_PrepareImportResponse,
+    responseDeserialize: deserialize_pulumirpc_PrepareImportResponse,
+  },
+  // ConfigChecksumMatch reports whether the given checksum matches the checksum of the configuration that the
+// provider last validated for the given provider resource. Callers skip CheckConfig when it matches, and always
+// call CheckConfig if this method is unimplemented.
+configChecksumMatch: {
+    path: '/pulumirpc.ResourceProvider/ConfigChecksumMatch',
+    requestStream: false,
+    responseStream: false,
+    requestType: pulumi_provider_pb.ConfigChecksumMatchRequest,
+    responseType: pulumi_provider_pb.ConfigChecksumMatchResponse,
+    requestSerialize: serialize_pulumirpc_ConfigChecksumMatchRequest,
+    requestDeserialize: deserialize_pulumirpc_ConfigChecksumMatchRequest,
+    responseSerialize: serialize_pulumirpc_ConfigChecksumMatchResponse,
+    responseDeserialize: deserialize_pulumirpc_ConfigChecksumMatchResponse,
+  },
+  // WaitForResourceReady blocks until a resource that was just created is ready to be used, or until the timeout
+// expires, in which case it fails with DEADLINE_EXCEEDED. The engine calls it after Create for resources whose
+// schema sets waitForReady, if the provider supports the waitForResourceReady feature.
+waitForResourceReady: {
+    path: '/pulumirpc.ResourceProvider/WaitForResourceReady',
+    requestStream: false,
+    responseStream: false,
+    requestType: pulumi_provider_pb.WaitForResourceReadyRequest,
+    responseType: google_protobuf_empty_pb.Empty,
+    requestSerialize: serialize_pulumirpc_WaitForResourceReadyRequest,
+    requestDeserialize: deserialize_pulumirpc_WaitForResourceReadyRequest,
+    responseSerialize: serialize_google_protobuf_Empty,
+    responseDeserialize: deserialize_google_protobuf_Empty,
+  },
+  // GetResourceAliases returns the URNs under which a resource may have been stored by earlier versions of the
+// provider, e.g. because its type has since been renamed.
+getResourceAliases: {
+    path: '/pulumirpc.ResourceProvider/GetResourceAliases',
+    requestStream: false,
+    responseStream: false,
+    requestType: pulumi_provider_pb.GetResourceAliasesRequest,
+    responseType: pulumi_provider_pb.GetResourceAliasesResponse,
+    requestSerialize: serialize_pulumirpc_GetResourceAliasesRequest,
+    requestDeserialize: deserialize_pulumirpc_GetResourceAliasesRequest,
+    responseSerialize: serialize_pulumirpc_GetResourceAliasesResponse,
+    responseDeserialize: deserialize_pulumirpc_GetResourceAliasesResponse,
+  },
+  // WatchConfig streams the provider's configuration each time it changes outside of Pulumi, e.g. because a secret
+// that the provider reads has been rotated. Callers re-validate the new configuration and reconfigure the provider
+// with it. The stream stays open until the caller cancels it; callers ignore the method if it is unimplemented.
+watchConfig: {
+    path: '/pulumirpc.ResourceProvider/WatchConfig',
+    requestStream: false,
+    responseStream: true,
+    requestType: google_protobuf_empty_pb.Empty,
+    responseType: pulumi_provider_pb.ConfigChangedEvent,
+    requestSerialize: serialize_google_protobuf_Empty,
+    requestDeserialize: deserialize_google_protobuf_Empty,
+    responseSerialize: serialize_pulumirpc_ConfigChangedEvent,
+    responseDeserialize: deserialize_pulumirpc_ConfigChangedEvent,
+  },
+  // ValidateProvider runs provider-level pre-flight checks, such as verifying credentials or permissions, without
+// touching any resources. The engine calls it after Configure and before the first resource operation. An error
+// status fails the deployment; callers fall back to a liveness check if the method is unimplemented.
+validateProvider: {
+    path: '/pulumirpc.ResourceProvider/ValidateProvider',
+    requestStream: false,
+    responseStream: false,
+    requestType: google_protobuf_empty_pb.Empty,
+    responseType: google_protobuf_empty_pb.Empty,
+    requestSerialize: serialize_google_protobuf_Empty,
+    requestDeserialize: deserialize_google_protobuf_Empty,
+    responseSerialize: serialize_google_protobuf_Empty,
+    responseDeserialize: deserialize_google_protobuf_Empty,
+  },
 };
 
 exports.ResourceProviderClient = grpc.makeGenericClientConstructor(ResourceProviderService);
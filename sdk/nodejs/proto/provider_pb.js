@@ -26,13 +26,19 @@ goog.exportSymbol('proto.pulumirpc.CallRequest.ArgumentDependencies', null, glob
 goog.exportSymbol('proto.pulumirpc.CallResponse', null, global);
 goog.exportSymbol('proto.pulumirpc.CallResponse.ReturnDependencies', null, global);
 goog.exportSymbol('proto.pulumirpc.CheckFailure', null, global);
+goog.exportSymbol('proto.pulumirpc.CheckFailure.Severity', null, global);
+goog.exportSymbol('proto.pulumirpc.CheckFailure.SourceRange', null, global);
 goog.exportSymbol('proto.pulumirpc.CheckRequest', null, global);
 goog.exportSymbol('proto.pulumirpc.CheckResponse', null, global);
+goog.exportSymbol('proto.pulumirpc.ConfigChangedEvent', null, global);
+goog.exportSymbol('proto.pulumirpc.ConfigChecksumMatchRequest', null, global);
+goog.exportSymbol('proto.pulumirpc.ConfigChecksumMatchResponse', null, global);
 goog.exportSymbol('proto.pulumirpc.ConfigureErrorMissingKeys', null, global);
 goog.exportSymbol('proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey', null, global);
 goog.exportSymbol('proto.pulumirpc.ConfigureRequest', null, global);
 goog.exportSymbol('proto.pulumirpc.ConfigureResponse', null, global);
 goog.exportSymbol('proto.pulumirpc.ConstructRequest', null, global);
+goog.exportSymbol('proto.pulumirpc.ConstructRequest.CustomTimeouts', null, global);
 goog.exportSymbol('proto.pulumirpc.ConstructRequest.PropertyDependencies', null, global);
 goog.exportSymbol('proto.pulumirpc.ConstructResponse', null, global);
 goog.exportSymbol('proto.pulumirpc.ConstructResponse.PropertyDependencies', null, global);
@@ -43,16 +49,36 @@ goog.exportSymbol('proto.pulumirpc.DiffRequest', null, global);
 goog.exportSymbol('proto.pulumirpc.DiffResponse', null, global);
 goog.exportSymbol('proto.pulumirpc.DiffResponse.DiffChanges', null, global);
 goog.exportSymbol('proto.pulumirpc.ErrorResourceInitFailed', null, global);
+goog.exportSymbol('proto.pulumirpc.EstimateCostRequest', null, global);
+goog.exportSymbol('proto.pulumirpc.EstimateCostResponse', null, global);
+goog.exportSymbol('proto.pulumirpc.GetMappingRequest', null, global);
+goog.exportSymbol('proto.pulumirpc.GetMappingResponse', null, global);
+goog.exportSymbol('proto.pulumirpc.GetResourceAliasesRequest', null, global);
+goog.exportSymbol('proto.pulumirpc.GetResourceAliasesResponse', null, global);
 goog.exportSymbol('proto.pulumirpc.GetSchemaRequest', null, global);
 goog.exportSymbol('proto.pulumirpc.GetSchemaResponse', null, global);
+goog.exportSymbol('proto.pulumirpc.GetSupportedVersionsResponse', null, global);
 goog.exportSymbol('proto.pulumirpc.InvokeRequest', null, global);
 goog.exportSymbol('proto.pulumirpc.InvokeResponse', null, global);
+goog.exportSymbol('proto.pulumirpc.MigrateStateRequest', null, global);
+goog.exportSymbol('proto.pulumirpc.MigrateStateResponse', null, global);
+goog.exportSymbol('proto.pulumirpc.ParameterizeByReferenceRequest', null, global);
+goog.exportSymbol('proto.pulumirpc.ParameterizeByValueRequest', null, global);
+goog.exportSymbol('proto.pulumirpc.ParameterizeResponse', null, global);
+goog.exportSymbol('proto.pulumirpc.PrepareImportRequest', null, global);
+goog.exportSymbol('proto.pulumirpc.PrepareImportResponse', null, global);
 goog.exportSymbol('proto.pulumirpc.PropertyDiff', null, global);
 goog.exportSymbol('proto.pulumirpc.PropertyDiff.Kind', null, global);
+goog.exportSymbol('proto.pulumirpc.ProviderDiagnostic', null, global);
+goog.exportSymbol('proto.pulumirpc.ProviderDiagnostic.Severity', null, global);
+goog.exportSymbol('proto.pulumirpc.ProviderSupportsFeatureRequest', null, global);
+goog.exportSymbol('proto.pulumirpc.ProviderSupportsFeatureResponse', null, global);
 goog.exportSymbol('proto.pulumirpc.ReadRequest', null, global);
 goog.exportSymbol('proto.pulumirpc.ReadResponse', null, global);
+goog.exportSymbol('proto.pulumirpc.ResourceChangedEvent', null, global);
 goog.exportSymbol('proto.pulumirpc.UpdateRequest', null, global);
 goog.exportSymbol('proto.pulumirpc.UpdateResponse', null, global);
+goog.exportSymbol('proto.pulumirpc.WaitForResourceReadyRequest', null, global);
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -106,7 +132,7 @@ if (goog.DEBUG && !COMPILED) {
  * @constructor
  */
 proto.pulumirpc.ConfigureRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.pulumirpc.ConfigureRequest.repeatedFields_, null);
 };
 goog.inherits(proto.pulumirpc.ConfigureRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
@@ -368,6 +394,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.pulumirpc.CheckFailure.displayName = 'proto.pulumirpc.CheckFailure';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pulumirpc.CheckFailure.SourceRange = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pulumirpc.CheckFailure.SourceRange, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pulumirpc.CheckFailure.SourceRange.displayName = 'proto.pulumirpc.CheckFailure.SourceRange';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -463,7 +510,7 @@ if (goog.DEBUG && !COMPILED) {
  * @constructor
  */
 proto.pulumirpc.CreateResponse = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.pulumirpc.CreateResponse.repeatedFields_, null);
 };
 goog.inherits(proto.pulumirpc.CreateResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
@@ -505,7 +552,7 @@ if (goog.DEBUG && !COMPILED) {
  * @constructor
  */
 proto.pulumirpc.ReadResponse = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.pulumirpc.ReadResponse.repeatedFields_, null);
 };
 goog.inherits(proto.pulumirpc.ReadResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
@@ -525,16 +572,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.pulumirpc.UpdateRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.pulumirpc.UpdateRequest.repeatedFields_, null);
+proto.pulumirpc.MigrateStateRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.pulumirpc.UpdateRequest, jspb.Message);
+goog.inherits(proto.pulumirpc.MigrateStateRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.pulumirpc.UpdateRequest.displayName = 'proto.pulumirpc.UpdateRequest';
+  proto.pulumirpc.MigrateStateRequest.displayName = 'proto.pulumirpc.MigrateStateRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -546,16 +593,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.pulumirpc.UpdateResponse = function(opt_data) {
+proto.pulumirpc.MigrateStateResponse = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.pulumirpc.UpdateResponse, jspb.Message);
+goog.inherits(proto.pulumirpc.MigrateStateResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.pulumirpc.UpdateResponse.displayName = 'proto.pulumirpc.UpdateResponse';
+  proto.pulumirpc.MigrateStateResponse.displayName = 'proto.pulumirpc.MigrateStateResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -567,16 +614,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.pulumirpc.DeleteRequest = function(opt_data) {
+proto.pulumirpc.EstimateCostRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.pulumirpc.DeleteRequest, jspb.Message);
+goog.inherits(proto.pulumirpc.EstimateCostRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.pulumirpc.DeleteRequest.displayName = 'proto.pulumirpc.DeleteRequest';
+  proto.pulumirpc.EstimateCostRequest.displayName = 'proto.pulumirpc.EstimateCostRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -588,16 +635,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.pulumirpc.ConstructRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.pulumirpc.ConstructRequest.repeatedFields_, null);
+proto.pulumirpc.EstimateCostResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.pulumirpc.ConstructRequest, jspb.Message);
+goog.inherits(proto.pulumirpc.EstimateCostResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.pulumirpc.ConstructRequest.displayName = 'proto.pulumirpc.ConstructRequest';
+  proto.pulumirpc.EstimateCostResponse.displayName = 'proto.pulumirpc.EstimateCostResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -609,16 +656,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.pulumirpc.ConstructRequest.PropertyDependencies = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.pulumirpc.ConstructRequest.PropertyDependencies.repeatedFields_, null);
+proto.pulumirpc.GetSupportedVersionsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.pulumirpc.GetSupportedVersionsResponse.repeatedFields_, null);
 };
-goog.inherits(proto.pulumirpc.ConstructRequest.PropertyDependencies, jspb.Message);
+goog.inherits(proto.pulumirpc.GetSupportedVersionsResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.pulumirpc.ConstructRequest.PropertyDependencies.displayName = 'proto.pulumirpc.ConstructRequest.PropertyDependencies';
+  proto.pulumirpc.GetSupportedVersionsResponse.displayName = 'proto.pulumirpc.GetSupportedVersionsResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -630,16 +677,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.pulumirpc.ConstructResponse = function(opt_data) {
+proto.pulumirpc.ParameterizeByValueRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.pulumirpc.ConstructResponse, jspb.Message);
+goog.inherits(proto.pulumirpc.ParameterizeByValueRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.pulumirpc.ConstructResponse.displayName = 'proto.pulumirpc.ConstructResponse';
+  proto.pulumirpc.ParameterizeByValueRequest.displayName = 'proto.pulumirpc.ParameterizeByValueRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -651,16 +698,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.pulumirpc.ConstructResponse.PropertyDependencies = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.pulumirpc.ConstructResponse.PropertyDependencies.repeatedFields_, null);
+proto.pulumirpc.ParameterizeByReferenceRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.pulumirpc.ConstructResponse.PropertyDependencies, jspb.Message);
+goog.inherits(proto.pulumirpc.ParameterizeByReferenceRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.pulumirpc.ConstructResponse.PropertyDependencies.displayName = 'proto.pulumirpc.ConstructResponse.PropertyDependencies';
+  proto.pulumirpc.ParameterizeByReferenceRequest.displayName = 'proto.pulumirpc.ParameterizeByReferenceRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -672,144 +719,6324 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.pulumirpc.ErrorResourceInitFailed = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.pulumirpc.ErrorResourceInitFailed.repeatedFields_, null);
+proto.pulumirpc.ParameterizeResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.pulumirpc.ErrorResourceInitFailed, jspb.Message);
+goog.inherits(proto.pulumirpc.ParameterizeResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.pulumirpc.ErrorResourceInitFailed.displayName = 'proto.pulumirpc.ErrorResourceInitFailed';
+  proto.pulumirpc.ParameterizeResponse.displayName = 'proto.pulumirpc.ParameterizeResponse';
 }
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.pulumirpc.GetSchemaRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.GetSchemaRequest.toObject(opt_includeInstance, this);
+proto.pulumirpc.PrepareImportRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.pulumirpc.PrepareImportRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pulumirpc.PrepareImportRequest.displayName = 'proto.pulumirpc.PrepareImportRequest';
+}
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.GetSchemaRequest} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.pulumirpc.GetSchemaRequest.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    version: jspb.Message.getFieldWithDefault(msg, 1, 0)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.pulumirpc.PrepareImportResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
+goog.inherits(proto.pulumirpc.PrepareImportResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pulumirpc.PrepareImportResponse.displayName = 'proto.pulumirpc.PrepareImportResponse';
 }
-
-
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.GetSchemaRequest}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.pulumirpc.GetSchemaRequest.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.GetSchemaRequest;
-  return proto.pulumirpc.GetSchemaRequest.deserializeBinaryFromReader(msg, reader);
+proto.pulumirpc.ConfigChecksumMatchRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.pulumirpc.ConfigChecksumMatchRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pulumirpc.ConfigChecksumMatchRequest.displayName = 'proto.pulumirpc.ConfigChecksumMatchRequest';
+}
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.pulumirpc.GetSchemaRequest} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.GetSchemaRequest}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.pulumirpc.GetSchemaRequest.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setVersion(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.pulumirpc.ConfigChecksumMatchResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.pulumirpc.ConfigChecksumMatchResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pulumirpc.ConfigChecksumMatchResponse.displayName = 'proto.pulumirpc.ConfigChecksumMatchResponse';
+}
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.pulumirpc.GetSchemaRequest.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.GetSchemaRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.pulumirpc.WaitForResourceReadyRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.pulumirpc.WaitForResourceReadyRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pulumirpc.WaitForResourceReadyRequest.displayName = 'proto.pulumirpc.WaitForResourceReadyRequest';
+}
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.GetSchemaRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.pulumirpc.GetSchemaRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getVersion();
-  if (f !== 0) {
-    writer.writeInt32(
-      1,
-      f
-    );
-  }
+proto.pulumirpc.GetResourceAliasesRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.pulumirpc.GetResourceAliasesRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pulumirpc.GetResourceAliasesRequest.displayName = 'proto.pulumirpc.GetResourceAliasesRequest';
+}
 /**
- * optional int32 version = 1;
- * @return {number}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.pulumirpc.GetSchemaRequest.prototype.getVersion = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.pulumirpc.GetResourceAliasesResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.pulumirpc.GetResourceAliasesResponse.repeatedFields_, null);
 };
-
-
+goog.inherits(proto.pulumirpc.GetResourceAliasesResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pulumirpc.GetResourceAliasesResponse.displayName = 'proto.pulumirpc.GetResourceAliasesResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pulumirpc.ResourceChangedEvent = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pulumirpc.ResourceChangedEvent, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pulumirpc.ResourceChangedEvent.displayName = 'proto.pulumirpc.ResourceChangedEvent';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pulumirpc.UpdateRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.pulumirpc.UpdateRequest.repeatedFields_, null);
+};
+goog.inherits(proto.pulumirpc.UpdateRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pulumirpc.UpdateRequest.displayName = 'proto.pulumirpc.UpdateRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pulumirpc.UpdateResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.pulumirpc.UpdateResponse.repeatedFields_, null);
+};
+goog.inherits(proto.pulumirpc.UpdateResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pulumirpc.UpdateResponse.displayName = 'proto.pulumirpc.UpdateResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pulumirpc.DeleteRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pulumirpc.DeleteRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pulumirpc.DeleteRequest.displayName = 'proto.pulumirpc.DeleteRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pulumirpc.ConstructRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.pulumirpc.ConstructRequest.repeatedFields_, null);
+};
+goog.inherits(proto.pulumirpc.ConstructRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pulumirpc.ConstructRequest.displayName = 'proto.pulumirpc.ConstructRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pulumirpc.ConstructRequest.PropertyDependencies = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.pulumirpc.ConstructRequest.PropertyDependencies.repeatedFields_, null);
+};
+goog.inherits(proto.pulumirpc.ConstructRequest.PropertyDependencies, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pulumirpc.ConstructRequest.PropertyDependencies.displayName = 'proto.pulumirpc.ConstructRequest.PropertyDependencies';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pulumirpc.ConstructRequest.CustomTimeouts = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pulumirpc.ConstructRequest.CustomTimeouts, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pulumirpc.ConstructRequest.CustomTimeouts.displayName = 'proto.pulumirpc.ConstructRequest.CustomTimeouts';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pulumirpc.ConstructResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.pulumirpc.ConstructResponse.repeatedFields_, null);
+};
+goog.inherits(proto.pulumirpc.ConstructResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pulumirpc.ConstructResponse.displayName = 'proto.pulumirpc.ConstructResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pulumirpc.ConstructResponse.PropertyDependencies = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.pulumirpc.ConstructResponse.PropertyDependencies.repeatedFields_, null);
+};
+goog.inherits(proto.pulumirpc.ConstructResponse.PropertyDependencies, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pulumirpc.ConstructResponse.PropertyDependencies.displayName = 'proto.pulumirpc.ConstructResponse.PropertyDependencies';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pulumirpc.ErrorResourceInitFailed = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.pulumirpc.ErrorResourceInitFailed.repeatedFields_, null);
+};
+goog.inherits(proto.pulumirpc.ErrorResourceInitFailed, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pulumirpc.ErrorResourceInitFailed.displayName = 'proto.pulumirpc.ErrorResourceInitFailed';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pulumirpc.GetMappingRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pulumirpc.GetMappingRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pulumirpc.GetMappingRequest.displayName = 'proto.pulumirpc.GetMappingRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pulumirpc.GetMappingResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pulumirpc.GetMappingResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pulumirpc.GetMappingResponse.displayName = 'proto.pulumirpc.GetMappingResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pulumirpc.ProviderSupportsFeatureRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pulumirpc.ProviderSupportsFeatureRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pulumirpc.ProviderSupportsFeatureRequest.displayName = 'proto.pulumirpc.ProviderSupportsFeatureRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pulumirpc.ProviderSupportsFeatureResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pulumirpc.ProviderSupportsFeatureResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pulumirpc.ProviderSupportsFeatureResponse.displayName = 'proto.pulumirpc.ProviderSupportsFeatureResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pulumirpc.ProviderDiagnostic = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pulumirpc.ProviderDiagnostic, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pulumirpc.ProviderDiagnostic.displayName = 'proto.pulumirpc.ProviderDiagnostic';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pulumirpc.ConfigChangedEvent = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pulumirpc.ConfigChangedEvent, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pulumirpc.ConfigChangedEvent.displayName = 'proto.pulumirpc.ConfigChangedEvent';
+}
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pulumirpc.GetSchemaRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.GetSchemaRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.GetSchemaRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.GetSchemaRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    version: jspb.Message.getFieldWithDefault(msg, 1, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.GetSchemaRequest}
+ */
+proto.pulumirpc.GetSchemaRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.GetSchemaRequest;
+  return proto.pulumirpc.GetSchemaRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.GetSchemaRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.GetSchemaRequest}
+ */
+proto.pulumirpc.GetSchemaRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setVersion(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pulumirpc.GetSchemaRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.GetSchemaRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.GetSchemaRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.GetSchemaRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getVersion();
+  if (f !== 0) {
+    writer.writeInt32(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int32 version = 1;
+ * @return {number}
+ */
+proto.pulumirpc.GetSchemaRequest.prototype.getVersion = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pulumirpc.GetSchemaRequest} returns this
+ */
+proto.pulumirpc.GetSchemaRequest.prototype.setVersion = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pulumirpc.GetSchemaResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.GetSchemaResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.GetSchemaResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.GetSchemaResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    schema: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    checksum: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.GetSchemaResponse}
+ */
+proto.pulumirpc.GetSchemaResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.GetSchemaResponse;
+  return proto.pulumirpc.GetSchemaResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.GetSchemaResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.GetSchemaResponse}
+ */
+proto.pulumirpc.GetSchemaResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSchema(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setChecksum(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pulumirpc.GetSchemaResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.GetSchemaResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.GetSchemaResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.GetSchemaResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSchema();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getChecksum();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string schema = 1;
+ * @return {string}
+ */
+proto.pulumirpc.GetSchemaResponse.prototype.getSchema = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.GetSchemaResponse} returns this
+ */
+proto.pulumirpc.GetSchemaResponse.prototype.setSchema = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string checksum = 2;
+ * @return {string}
+ */
+proto.pulumirpc.GetSchemaResponse.prototype.getChecksum = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.GetSchemaResponse} returns this
+ */
+proto.pulumirpc.GetSchemaResponse.prototype.setChecksum = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.pulumirpc.ConfigureRequest.repeatedFields_ = [5];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pulumirpc.ConfigureRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.ConfigureRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.ConfigureRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.ConfigureRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    variablesMap: (f = msg.getVariablesMap()) ? f.toObject(includeInstance, undefined) : [],
+    args: (f = msg.getArgs()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
+    acceptsecrets: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    acceptresources: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
+    configsecretkeysList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.ConfigureRequest}
+ */
+proto.pulumirpc.ConfigureRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.ConfigureRequest;
+  return proto.pulumirpc.ConfigureRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.ConfigureRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.ConfigureRequest}
+ */
+proto.pulumirpc.ConfigureRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = msg.getVariablesMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    case 2:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setArgs(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setAcceptsecrets(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setAcceptresources(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addConfigsecretkeys(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pulumirpc.ConfigureRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.ConfigureRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.ConfigureRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.ConfigureRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getVariablesMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+  f = message.getArgs();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+  f = message.getAcceptsecrets();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = message.getAcceptresources();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+  f = message.getConfigsecretkeysList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * map<string, string> variables = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.pulumirpc.ConfigureRequest.prototype.getVariablesMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.pulumirpc.ConfigureRequest} returns this
+ */
+proto.pulumirpc.ConfigureRequest.prototype.clearVariablesMap = function() {
+  this.getVariablesMap().clear();
+  return this;};
+
+
+/**
+ * optional google.protobuf.Struct args = 2;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.pulumirpc.ConfigureRequest.prototype.getArgs = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 2));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.pulumirpc.ConfigureRequest} returns this
+*/
+proto.pulumirpc.ConfigureRequest.prototype.setArgs = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.ConfigureRequest} returns this
+ */
+proto.pulumirpc.ConfigureRequest.prototype.clearArgs = function() {
+  return this.setArgs(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.pulumirpc.ConfigureRequest.prototype.hasArgs = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional bool acceptSecrets = 3;
+ * @return {boolean}
+ */
+proto.pulumirpc.ConfigureRequest.prototype.getAcceptsecrets = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.pulumirpc.ConfigureRequest} returns this
+ */
+proto.pulumirpc.ConfigureRequest.prototype.setAcceptsecrets = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
+/**
+ * optional bool acceptResources = 4;
+ * @return {boolean}
+ */
+proto.pulumirpc.ConfigureRequest.prototype.getAcceptresources = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.pulumirpc.ConfigureRequest} returns this
+ */
+proto.pulumirpc.ConfigureRequest.prototype.setAcceptresources = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
+};
+
+
+/**
+ * repeated string configSecretKeys = 5;
+ * @return {!Array<string>}
+ */
+proto.pulumirpc.ConfigureRequest.prototype.getConfigsecretkeysList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 5));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.pulumirpc.ConfigureRequest} returns this
+ */
+proto.pulumirpc.ConfigureRequest.prototype.setConfigsecretkeysList = function(value) {
+  return jspb.Message.setField(this, 5, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.ConfigureRequest} returns this
+ */
+proto.pulumirpc.ConfigureRequest.prototype.addConfigsecretkeys = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 5, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.ConfigureRequest} returns this
+ */
+proto.pulumirpc.ConfigureRequest.prototype.clearConfigsecretkeysList = function() {
+  return this.setConfigsecretkeysList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pulumirpc.ConfigureResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.ConfigureResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.ConfigureResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.ConfigureResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    acceptsecrets: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
+    supportspreview: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    acceptresources: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    acceptoutputs: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
+    schemaversion: jspb.Message.getFieldWithDefault(msg, 5, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.ConfigureResponse}
+ */
+proto.pulumirpc.ConfigureResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.ConfigureResponse;
+  return proto.pulumirpc.ConfigureResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.ConfigureResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.ConfigureResponse}
+ */
+proto.pulumirpc.ConfigureResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setAcceptsecrets(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSupportspreview(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setAcceptresources(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setAcceptoutputs(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setSchemaversion(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pulumirpc.ConfigureResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.ConfigureResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.ConfigureResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.ConfigureResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAcceptsecrets();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+  f = message.getSupportspreview();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+  f = message.getAcceptresources();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = message.getAcceptoutputs();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+  f = message.getSchemaversion();
+  if (f !== 0) {
+    writer.writeInt32(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bool acceptSecrets = 1;
+ * @return {boolean}
+ */
+proto.pulumirpc.ConfigureResponse.prototype.getAcceptsecrets = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.pulumirpc.ConfigureResponse} returns this
+ */
+proto.pulumirpc.ConfigureResponse.prototype.setAcceptsecrets = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+/**
+ * optional bool supportsPreview = 2;
+ * @return {boolean}
+ */
+proto.pulumirpc.ConfigureResponse.prototype.getSupportspreview = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.pulumirpc.ConfigureResponse} returns this
+ */
+proto.pulumirpc.ConfigureResponse.prototype.setSupportspreview = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+/**
+ * optional bool acceptResources = 3;
+ * @return {boolean}
+ */
+proto.pulumirpc.ConfigureResponse.prototype.getAcceptresources = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.pulumirpc.ConfigureResponse} returns this
+ */
+proto.pulumirpc.ConfigureResponse.prototype.setAcceptresources = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
+/**
+ * optional bool acceptOutputs = 4;
+ * @return {boolean}
+ */
+proto.pulumirpc.ConfigureResponse.prototype.getAcceptoutputs = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.pulumirpc.ConfigureResponse} returns this
+ */
+proto.pulumirpc.ConfigureResponse.prototype.setAcceptoutputs = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
+};
+
+
+/**
+ * optional int32 schemaVersion = 5;
+ * @return {number}
+ */
+proto.pulumirpc.ConfigureResponse.prototype.getSchemaversion = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pulumirpc.ConfigureResponse} returns this
+ */
+proto.pulumirpc.ConfigureResponse.prototype.setSchemaversion = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.pulumirpc.ConfigureErrorMissingKeys.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pulumirpc.ConfigureErrorMissingKeys.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.ConfigureErrorMissingKeys.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.ConfigureErrorMissingKeys} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.ConfigureErrorMissingKeys.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    missingkeysList: jspb.Message.toObjectList(msg.getMissingkeysList(),
+    proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.ConfigureErrorMissingKeys}
+ */
+proto.pulumirpc.ConfigureErrorMissingKeys.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.ConfigureErrorMissingKeys;
+  return proto.pulumirpc.ConfigureErrorMissingKeys.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.ConfigureErrorMissingKeys} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.ConfigureErrorMissingKeys}
+ */
+proto.pulumirpc.ConfigureErrorMissingKeys.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey;
+      reader.readMessage(value,proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey.deserializeBinaryFromReader);
+      msg.addMissingkeys(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pulumirpc.ConfigureErrorMissingKeys.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.ConfigureErrorMissingKeys.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.ConfigureErrorMissingKeys} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.ConfigureErrorMissingKeys.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getMissingkeysList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey.serializeBinaryToWriter
+    );
+  }
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    description: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey}
+ */
+proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey;
+  return proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey}
+ */
+proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDescription(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getDescription();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey} returns this
+ */
+proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string description = 2;
+ * @return {string}
+ */
+proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey.prototype.getDescription = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey} returns this
+ */
+proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey.prototype.setDescription = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * repeated MissingKey missingKeys = 1;
+ * @return {!Array<!proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey>}
+ */
+proto.pulumirpc.ConfigureErrorMissingKeys.prototype.getMissingkeysList = function() {
+  return /** @type{!Array<!proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey>} value
+ * @return {!proto.pulumirpc.ConfigureErrorMissingKeys} returns this
+*/
+proto.pulumirpc.ConfigureErrorMissingKeys.prototype.setMissingkeysList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey}
+ */
+proto.pulumirpc.ConfigureErrorMissingKeys.prototype.addMissingkeys = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.ConfigureErrorMissingKeys} returns this
+ */
+proto.pulumirpc.ConfigureErrorMissingKeys.prototype.clearMissingkeysList = function() {
+  return this.setMissingkeysList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pulumirpc.InvokeRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.InvokeRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.InvokeRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.InvokeRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    tok: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    args: (f = msg.getArgs()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.InvokeRequest}
+ */
+proto.pulumirpc.InvokeRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.InvokeRequest;
+  return proto.pulumirpc.InvokeRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.InvokeRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.InvokeRequest}
+ */
+proto.pulumirpc.InvokeRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTok(value);
+      break;
+    case 2:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setArgs(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pulumirpc.InvokeRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.InvokeRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.InvokeRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.InvokeRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTok();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getArgs();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string tok = 1;
+ * @return {string}
+ */
+proto.pulumirpc.InvokeRequest.prototype.getTok = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.InvokeRequest} returns this
+ */
+proto.pulumirpc.InvokeRequest.prototype.setTok = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct args = 2;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.pulumirpc.InvokeRequest.prototype.getArgs = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 2));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.pulumirpc.InvokeRequest} returns this
+*/
+proto.pulumirpc.InvokeRequest.prototype.setArgs = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.InvokeRequest} returns this
+ */
+proto.pulumirpc.InvokeRequest.prototype.clearArgs = function() {
+  return this.setArgs(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.pulumirpc.InvokeRequest.prototype.hasArgs = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.pulumirpc.InvokeResponse.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pulumirpc.InvokeResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.InvokeResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.InvokeResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.InvokeResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    pb_return: (f = msg.getReturn()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
+    failuresList: jspb.Message.toObjectList(msg.getFailuresList(),
+    proto.pulumirpc.CheckFailure.toObject, includeInstance),
+    pagenumber: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    islastpage: jspb.Message.getBooleanFieldWithDefault(msg, 4, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.InvokeResponse}
+ */
+proto.pulumirpc.InvokeResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.InvokeResponse;
+  return proto.pulumirpc.InvokeResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.InvokeResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.InvokeResponse}
+ */
+proto.pulumirpc.InvokeResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setReturn(value);
+      break;
+    case 2:
+      var value = new proto.pulumirpc.CheckFailure;
+      reader.readMessage(value,proto.pulumirpc.CheckFailure.deserializeBinaryFromReader);
+      msg.addFailures(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPagenumber(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIslastpage(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pulumirpc.InvokeResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.InvokeResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.InvokeResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.InvokeResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getReturn();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+  f = message.getFailuresList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.pulumirpc.CheckFailure.serializeBinaryToWriter
+    );
+  }
+  f = message.getPagenumber();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getIslastpage();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional google.protobuf.Struct return = 1;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.pulumirpc.InvokeResponse.prototype.getReturn = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 1));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.pulumirpc.InvokeResponse} returns this
+*/
+proto.pulumirpc.InvokeResponse.prototype.setReturn = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.InvokeResponse} returns this
+ */
+proto.pulumirpc.InvokeResponse.prototype.clearReturn = function() {
+  return this.setReturn(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.pulumirpc.InvokeResponse.prototype.hasReturn = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * repeated CheckFailure failures = 2;
+ * @return {!Array<!proto.pulumirpc.CheckFailure>}
+ */
+proto.pulumirpc.InvokeResponse.prototype.getFailuresList = function() {
+  return /** @type{!Array<!proto.pulumirpc.CheckFailure>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.pulumirpc.CheckFailure, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.pulumirpc.CheckFailure>} value
+ * @return {!proto.pulumirpc.InvokeResponse} returns this
+*/
+proto.pulumirpc.InvokeResponse.prototype.setFailuresList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.pulumirpc.CheckFailure=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.CheckFailure}
+ */
+proto.pulumirpc.InvokeResponse.prototype.addFailures = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.pulumirpc.CheckFailure, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.InvokeResponse} returns this
+ */
+proto.pulumirpc.InvokeResponse.prototype.clearFailuresList = function() {
+  return this.setFailuresList([]);
+};
+
+
+/**
+ * optional int32 pageNumber = 3;
+ * @return {number}
+ */
+proto.pulumirpc.InvokeResponse.prototype.getPagenumber = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pulumirpc.InvokeResponse} returns this
+ */
+proto.pulumirpc.InvokeResponse.prototype.setPagenumber = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional bool isLastPage = 4;
+ * @return {boolean}
+ */
+proto.pulumirpc.InvokeResponse.prototype.getIslastpage = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.pulumirpc.InvokeResponse} returns this
+ */
+proto.pulumirpc.InvokeResponse.prototype.setIslastpage = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.pulumirpc.CallRequest.repeatedFields_ = [9];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pulumirpc.CallRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.CallRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.CallRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.CallRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    tok: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    args: (f = msg.getArgs()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
+    argdependenciesMap: (f = msg.getArgdependenciesMap()) ? f.toObject(includeInstance, proto.pulumirpc.CallRequest.ArgumentDependencies.toObject) : [],
+    provider: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    version: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    plugindownloadurl: jspb.Message.getFieldWithDefault(msg, 13, ""),
+    project: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    stack: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    configMap: (f = msg.getConfigMap()) ? f.toObject(includeInstance, undefined) : [],
+    configsecretkeysList: (f = jspb.Message.getRepeatedField(msg, 9)) == null ? undefined : f,
+    dryrun: jspb.Message.getBooleanFieldWithDefault(msg, 10, false),
+    parallel: jspb.Message.getFieldWithDefault(msg, 11, 0),
+    monitorendpoint: jspb.Message.getFieldWithDefault(msg, 12, ""),
+    organization: jspb.Message.getFieldWithDefault(msg, 14, ""),
+    timeout: jspb.Message.getFloatingPointFieldWithDefault(msg, 15, 0.0),
+    traceid: jspb.Message.getFieldWithDefault(msg, 16, ""),
+    spanid: jspb.Message.getFieldWithDefault(msg, 17, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.CallRequest}
+ */
+proto.pulumirpc.CallRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.CallRequest;
+  return proto.pulumirpc.CallRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.CallRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.CallRequest}
+ */
+proto.pulumirpc.CallRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTok(value);
+      break;
+    case 2:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setArgs(value);
+      break;
+    case 3:
+      var value = msg.getArgdependenciesMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.pulumirpc.CallRequest.ArgumentDependencies.deserializeBinaryFromReader, "", new proto.pulumirpc.CallRequest.ArgumentDependencies());
+         });
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setProvider(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setVersion(value);
+      break;
+    case 13:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPlugindownloadurl(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setProject(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStack(value);
+      break;
+    case 8:
+      var value = msg.getConfigMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addConfigsecretkeys(value);
+      break;
+    case 10:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setDryrun(value);
+      break;
+    case 11:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setParallel(value);
+      break;
+    case 12:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMonitorendpoint(value);
+      break;
+    case 14:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOrganization(value);
+      break;
+    case 15:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setTimeout(value);
+      break;
+    case 16:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTraceid(value);
+      break;
+    case 17:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSpanid(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pulumirpc.CallRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.CallRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.CallRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.CallRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTok();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getArgs();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+  f = message.getArgdependenciesMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(3, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.pulumirpc.CallRequest.ArgumentDependencies.serializeBinaryToWriter);
+  }
+  f = message.getProvider();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getVersion();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getPlugindownloadurl();
+  if (f.length > 0) {
+    writer.writeString(
+      13,
+      f
+    );
+  }
+  f = message.getProject();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getStack();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getConfigMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(8, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+  f = message.getConfigsecretkeysList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      9,
+      f
+    );
+  }
+  f = message.getDryrun();
+  if (f) {
+    writer.writeBool(
+      10,
+      f
+    );
+  }
+  f = message.getParallel();
+  if (f !== 0) {
+    writer.writeInt32(
+      11,
+      f
+    );
+  }
+  f = message.getMonitorendpoint();
+  if (f.length > 0) {
+    writer.writeString(
+      12,
+      f
+    );
+  }
+  f = message.getOrganization();
+  if (f.length > 0) {
+    writer.writeString(
+      14,
+      f
+    );
+  }
+  f = message.getTimeout();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      15,
+      f
+    );
+  }
+  f = message.getTraceid();
+  if (f.length > 0) {
+    writer.writeString(
+      16,
+      f
+    );
+  }
+  f = message.getSpanid();
+  if (f.length > 0) {
+    writer.writeString(
+      17,
+      f
+    );
+  }
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.pulumirpc.CallRequest.ArgumentDependencies.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pulumirpc.CallRequest.ArgumentDependencies.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.CallRequest.ArgumentDependencies.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.CallRequest.ArgumentDependencies} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.CallRequest.ArgumentDependencies.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    urnsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.CallRequest.ArgumentDependencies}
+ */
+proto.pulumirpc.CallRequest.ArgumentDependencies.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.CallRequest.ArgumentDependencies;
+  return proto.pulumirpc.CallRequest.ArgumentDependencies.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.CallRequest.ArgumentDependencies} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.CallRequest.ArgumentDependencies}
+ */
+proto.pulumirpc.CallRequest.ArgumentDependencies.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addUrns(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pulumirpc.CallRequest.ArgumentDependencies.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.CallRequest.ArgumentDependencies.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.CallRequest.ArgumentDependencies} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.CallRequest.ArgumentDependencies.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUrnsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated string urns = 1;
+ * @return {!Array<string>}
+ */
+proto.pulumirpc.CallRequest.ArgumentDependencies.prototype.getUrnsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.pulumirpc.CallRequest.ArgumentDependencies} returns this
+ */
+proto.pulumirpc.CallRequest.ArgumentDependencies.prototype.setUrnsList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.CallRequest.ArgumentDependencies} returns this
+ */
+proto.pulumirpc.CallRequest.ArgumentDependencies.prototype.addUrns = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.CallRequest.ArgumentDependencies} returns this
+ */
+proto.pulumirpc.CallRequest.ArgumentDependencies.prototype.clearUrnsList = function() {
+  return this.setUrnsList([]);
+};
+
+
+/**
+ * optional string tok = 1;
+ * @return {string}
+ */
+proto.pulumirpc.CallRequest.prototype.getTok = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.CallRequest} returns this
+ */
+proto.pulumirpc.CallRequest.prototype.setTok = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct args = 2;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.pulumirpc.CallRequest.prototype.getArgs = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 2));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.pulumirpc.CallRequest} returns this
+*/
+proto.pulumirpc.CallRequest.prototype.setArgs = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.CallRequest} returns this
+ */
+proto.pulumirpc.CallRequest.prototype.clearArgs = function() {
+  return this.setArgs(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.pulumirpc.CallRequest.prototype.hasArgs = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * map<string, ArgumentDependencies> argDependencies = 3;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.pulumirpc.CallRequest.ArgumentDependencies>}
+ */
+proto.pulumirpc.CallRequest.prototype.getArgdependenciesMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.pulumirpc.CallRequest.ArgumentDependencies>} */ (
+      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
+      proto.pulumirpc.CallRequest.ArgumentDependencies));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.pulumirpc.CallRequest} returns this
+ */
+proto.pulumirpc.CallRequest.prototype.clearArgdependenciesMap = function() {
+  this.getArgdependenciesMap().clear();
+  return this;};
+
+
+/**
+ * optional string provider = 4;
+ * @return {string}
+ */
+proto.pulumirpc.CallRequest.prototype.getProvider = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.CallRequest} returns this
+ */
+proto.pulumirpc.CallRequest.prototype.setProvider = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string version = 5;
+ * @return {string}
+ */
+proto.pulumirpc.CallRequest.prototype.getVersion = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.CallRequest} returns this
+ */
+proto.pulumirpc.CallRequest.prototype.setVersion = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional string pluginDownloadURL = 13;
+ * @return {string}
+ */
+proto.pulumirpc.CallRequest.prototype.getPlugindownloadurl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 13, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.CallRequest} returns this
+ */
+proto.pulumirpc.CallRequest.prototype.setPlugindownloadurl = function(value) {
+  return jspb.Message.setProto3StringField(this, 13, value);
+};
+
+
+/**
+ * optional string project = 6;
+ * @return {string}
+ */
+proto.pulumirpc.CallRequest.prototype.getProject = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.CallRequest} returns this
+ */
+proto.pulumirpc.CallRequest.prototype.setProject = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional string stack = 7;
+ * @return {string}
+ */
+proto.pulumirpc.CallRequest.prototype.getStack = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.CallRequest} returns this
+ */
+proto.pulumirpc.CallRequest.prototype.setStack = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * map<string, string> config = 8;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.pulumirpc.CallRequest.prototype.getConfigMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 8, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.pulumirpc.CallRequest} returns this
+ */
+proto.pulumirpc.CallRequest.prototype.clearConfigMap = function() {
+  this.getConfigMap().clear();
+  return this;};
+
+
+/**
+ * repeated string configSecretKeys = 9;
+ * @return {!Array<string>}
+ */
+proto.pulumirpc.CallRequest.prototype.getConfigsecretkeysList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 9));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.pulumirpc.CallRequest} returns this
+ */
+proto.pulumirpc.CallRequest.prototype.setConfigsecretkeysList = function(value) {
+  return jspb.Message.setField(this, 9, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.CallRequest} returns this
+ */
+proto.pulumirpc.CallRequest.prototype.addConfigsecretkeys = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 9, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.CallRequest} returns this
+ */
+proto.pulumirpc.CallRequest.prototype.clearConfigsecretkeysList = function() {
+  return this.setConfigsecretkeysList([]);
+};
+
+
+/**
+ * optional bool dryRun = 10;
+ * @return {boolean}
+ */
+proto.pulumirpc.CallRequest.prototype.getDryrun = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 10, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.pulumirpc.CallRequest} returns this
+ */
+proto.pulumirpc.CallRequest.prototype.setDryrun = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 10, value);
+};
+
+
+/**
+ * optional int32 parallel = 11;
+ * @return {number}
+ */
+proto.pulumirpc.CallRequest.prototype.getParallel = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pulumirpc.CallRequest} returns this
+ */
+proto.pulumirpc.CallRequest.prototype.setParallel = function(value) {
+  return jspb.Message.setProto3IntField(this, 11, value);
+};
+
+
+/**
+ * optional string monitorEndpoint = 12;
+ * @return {string}
+ */
+proto.pulumirpc.CallRequest.prototype.getMonitorendpoint = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 12, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.CallRequest} returns this
+ */
+proto.pulumirpc.CallRequest.prototype.setMonitorendpoint = function(value) {
+  return jspb.Message.setProto3StringField(this, 12, value);
+};
+
+
+/**
+ * optional string organization = 14;
+ * @return {string}
+ */
+proto.pulumirpc.CallRequest.prototype.getOrganization = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 14, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.CallRequest} returns this
+ */
+proto.pulumirpc.CallRequest.prototype.setOrganization = function(value) {
+  return jspb.Message.setProto3StringField(this, 14, value);
+};
+
+
+/**
+ * optional double timeout = 15;
+ * @return {number}
+ */
+proto.pulumirpc.CallRequest.prototype.getTimeout = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 15, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pulumirpc.CallRequest} returns this
+ */
+proto.pulumirpc.CallRequest.prototype.setTimeout = function(value) {
+  return jspb.Message.setProto3FloatField(this, 15, value);
+};
+
+
+/**
+ * optional string traceId = 16;
+ * @return {string}
+ */
+proto.pulumirpc.CallRequest.prototype.getTraceid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 16, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.CallRequest} returns this
+ */
+proto.pulumirpc.CallRequest.prototype.setTraceid = function(value) {
+  return jspb.Message.setProto3StringField(this, 16, value);
+};
+
+
+/**
+ * optional string spanId = 17;
+ * @return {string}
+ */
+proto.pulumirpc.CallRequest.prototype.getSpanid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 17, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.CallRequest} returns this
+ */
+proto.pulumirpc.CallRequest.prototype.setSpanid = function(value) {
+  return jspb.Message.setProto3StringField(this, 17, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.pulumirpc.CallResponse.repeatedFields_ = [3,6];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pulumirpc.CallResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.CallResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.CallResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.CallResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    pb_return: (f = msg.getReturn()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
+    returndependenciesMap: (f = msg.getReturndependenciesMap()) ? f.toObject(includeInstance, proto.pulumirpc.CallResponse.ReturnDependencies.toObject) : [],
+    failuresList: jspb.Message.toObjectList(msg.getFailuresList(),
+    proto.pulumirpc.CheckFailure.toObject, includeInstance),
+    partialfailure: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
+    partialfailurereason: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    diagnosticsList: jspb.Message.toObjectList(msg.getDiagnosticsList(),
+    proto.pulumirpc.ProviderDiagnostic.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.CallResponse}
+ */
+proto.pulumirpc.CallResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.CallResponse;
+  return proto.pulumirpc.CallResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.CallResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.CallResponse}
+ */
+proto.pulumirpc.CallResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setReturn(value);
+      break;
+    case 2:
+      var value = msg.getReturndependenciesMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.pulumirpc.CallResponse.ReturnDependencies.deserializeBinaryFromReader, "", new proto.pulumirpc.CallResponse.ReturnDependencies());
+         });
+      break;
+    case 3:
+      var value = new proto.pulumirpc.CheckFailure;
+      reader.readMessage(value,proto.pulumirpc.CheckFailure.deserializeBinaryFromReader);
+      msg.addFailures(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setPartialfailure(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPartialfailurereason(value);
+      break;
+    case 6:
+      var value = new proto.pulumirpc.ProviderDiagnostic;
+      reader.readMessage(value,proto.pulumirpc.ProviderDiagnostic.deserializeBinaryFromReader);
+      msg.addDiagnostics(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pulumirpc.CallResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.CallResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.CallResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.CallResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getReturn();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+  f = message.getReturndependenciesMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.pulumirpc.CallResponse.ReturnDependencies.serializeBinaryToWriter);
+  }
+  f = message.getFailuresList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      proto.pulumirpc.CheckFailure.serializeBinaryToWriter
+    );
+  }
+  f = message.getPartialfailure();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+  f = message.getPartialfailurereason();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getDiagnosticsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      6,
+      f,
+      proto.pulumirpc.ProviderDiagnostic.serializeBinaryToWriter
+    );
+  }
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.pulumirpc.CallResponse.ReturnDependencies.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pulumirpc.CallResponse.ReturnDependencies.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.CallResponse.ReturnDependencies.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.CallResponse.ReturnDependencies} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.CallResponse.ReturnDependencies.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    urnsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.CallResponse.ReturnDependencies}
+ */
+proto.pulumirpc.CallResponse.ReturnDependencies.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.CallResponse.ReturnDependencies;
+  return proto.pulumirpc.CallResponse.ReturnDependencies.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.CallResponse.ReturnDependencies} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.CallResponse.ReturnDependencies}
+ */
+proto.pulumirpc.CallResponse.ReturnDependencies.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addUrns(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pulumirpc.CallResponse.ReturnDependencies.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.CallResponse.ReturnDependencies.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.CallResponse.ReturnDependencies} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.CallResponse.ReturnDependencies.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUrnsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated string urns = 1;
+ * @return {!Array<string>}
+ */
+proto.pulumirpc.CallResponse.ReturnDependencies.prototype.getUrnsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.pulumirpc.CallResponse.ReturnDependencies} returns this
+ */
+proto.pulumirpc.CallResponse.ReturnDependencies.prototype.setUrnsList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.CallResponse.ReturnDependencies} returns this
+ */
+proto.pulumirpc.CallResponse.ReturnDependencies.prototype.addUrns = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.CallResponse.ReturnDependencies} returns this
+ */
+proto.pulumirpc.CallResponse.ReturnDependencies.prototype.clearUrnsList = function() {
+  return this.setUrnsList([]);
+};
+
+
+/**
+ * optional google.protobuf.Struct return = 1;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.pulumirpc.CallResponse.prototype.getReturn = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 1));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.pulumirpc.CallResponse} returns this
+*/
+proto.pulumirpc.CallResponse.prototype.setReturn = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.CallResponse} returns this
+ */
+proto.pulumirpc.CallResponse.prototype.clearReturn = function() {
+  return this.setReturn(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.pulumirpc.CallResponse.prototype.hasReturn = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * map<string, ReturnDependencies> returnDependencies = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.pulumirpc.CallResponse.ReturnDependencies>}
+ */
+proto.pulumirpc.CallResponse.prototype.getReturndependenciesMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.pulumirpc.CallResponse.ReturnDependencies>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      proto.pulumirpc.CallResponse.ReturnDependencies));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.pulumirpc.CallResponse} returns this
+ */
+proto.pulumirpc.CallResponse.prototype.clearReturndependenciesMap = function() {
+  this.getReturndependenciesMap().clear();
+  return this;};
+
+
+/**
+ * repeated CheckFailure failures = 3;
+ * @return {!Array<!proto.pulumirpc.CheckFailure>}
+ */
+proto.pulumirpc.CallResponse.prototype.getFailuresList = function() {
+  return /** @type{!Array<!proto.pulumirpc.CheckFailure>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.pulumirpc.CheckFailure, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.pulumirpc.CheckFailure>} value
+ * @return {!proto.pulumirpc.CallResponse} returns this
+*/
+proto.pulumirpc.CallResponse.prototype.setFailuresList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.pulumirpc.CheckFailure=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.CheckFailure}
+ */
+proto.pulumirpc.CallResponse.prototype.addFailures = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.pulumirpc.CheckFailure, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.CallResponse} returns this
+ */
+proto.pulumirpc.CallResponse.prototype.clearFailuresList = function() {
+  return this.setFailuresList([]);
+};
+
+
+/**
+ * optional bool partialFailure = 4;
+ * @return {boolean}
+ */
+proto.pulumirpc.CallResponse.prototype.getPartialfailure = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.pulumirpc.CallResponse} returns this
+ */
+proto.pulumirpc.CallResponse.prototype.setPartialfailure = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
+};
+
+
+/**
+ * optional string partialFailureReason = 5;
+ * @return {string}
+ */
+proto.pulumirpc.CallResponse.prototype.getPartialfailurereason = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.CallResponse} returns this
+ */
+proto.pulumirpc.CallResponse.prototype.setPartialfailurereason = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * repeated ProviderDiagnostic diagnostics = 6;
+ * @return {!Array<!proto.pulumirpc.ProviderDiagnostic>}
+ */
+proto.pulumirpc.CallResponse.prototype.getDiagnosticsList = function() {
+  return /** @type{!Array<!proto.pulumirpc.ProviderDiagnostic>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.pulumirpc.ProviderDiagnostic, 6));
+};
+
+
+/**
+ * @param {!Array<!proto.pulumirpc.ProviderDiagnostic>} value
+ * @return {!proto.pulumirpc.CallResponse} returns this
+*/
+proto.pulumirpc.CallResponse.prototype.setDiagnosticsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 6, value);
+};
+
+
+/**
+ * @param {!proto.pulumirpc.ProviderDiagnostic=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.ProviderDiagnostic}
+ */
+proto.pulumirpc.CallResponse.prototype.addDiagnostics = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 6, opt_value, proto.pulumirpc.ProviderDiagnostic, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.CallResponse} returns this
+ */
+proto.pulumirpc.CallResponse.prototype.clearDiagnosticsList = function() {
+  return this.setDiagnosticsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pulumirpc.CheckRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.CheckRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.CheckRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.CheckRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    urn: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    olds: (f = msg.getOlds()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
+    news: (f = msg.getNews()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
+    randomseed: msg.getRandomseed_asB64(),
+    binaryolds: msg.getBinaryolds_asB64(),
+    binarynews: msg.getBinarynews_asB64()
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.CheckRequest}
+ */
+proto.pulumirpc.CheckRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.CheckRequest;
+  return proto.pulumirpc.CheckRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.CheckRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.CheckRequest}
+ */
+proto.pulumirpc.CheckRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUrn(value);
+      break;
+    case 2:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setOlds(value);
+      break;
+    case 3:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setNews(value);
+      break;
+    case 5:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setRandomseed(value);
+      break;
+    case 6:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setBinaryolds(value);
+      break;
+    case 7:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setBinarynews(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pulumirpc.CheckRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.CheckRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.CheckRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.CheckRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUrn();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getOlds();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+  f = message.getNews();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+  f = message.getRandomseed_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      5,
+      f
+    );
+  }
+  f = message.getBinaryolds_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      6,
+      f
+    );
+  }
+  f = message.getBinarynews_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      7,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string urn = 1;
+ * @return {string}
+ */
+proto.pulumirpc.CheckRequest.prototype.getUrn = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.CheckRequest} returns this
+ */
+proto.pulumirpc.CheckRequest.prototype.setUrn = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct olds = 2;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.pulumirpc.CheckRequest.prototype.getOlds = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 2));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.pulumirpc.CheckRequest} returns this
+*/
+proto.pulumirpc.CheckRequest.prototype.setOlds = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.CheckRequest} returns this
+ */
+proto.pulumirpc.CheckRequest.prototype.clearOlds = function() {
+  return this.setOlds(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.pulumirpc.CheckRequest.prototype.hasOlds = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional google.protobuf.Struct news = 3;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.pulumirpc.CheckRequest.prototype.getNews = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 3));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.pulumirpc.CheckRequest} returns this
+*/
+proto.pulumirpc.CheckRequest.prototype.setNews = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.CheckRequest} returns this
+ */
+proto.pulumirpc.CheckRequest.prototype.clearNews = function() {
+  return this.setNews(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.pulumirpc.CheckRequest.prototype.hasNews = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional bytes randomSeed = 5;
+ * @return {!(string|Uint8Array)}
+ */
+proto.pulumirpc.CheckRequest.prototype.getRandomseed = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * optional bytes randomSeed = 5;
+ * This is a type-conversion wrapper around `getRandomseed()`
+ * @return {string}
+ */
+proto.pulumirpc.CheckRequest.prototype.getRandomseed_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getRandomseed()));
+};
+
+
+/**
+ * optional bytes randomSeed = 5;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getRandomseed()`
+ * @return {!Uint8Array}
+ */
+proto.pulumirpc.CheckRequest.prototype.getRandomseed_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getRandomseed()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.pulumirpc.CheckRequest} returns this
+ */
+proto.pulumirpc.CheckRequest.prototype.setRandomseed = function(value) {
+  return jspb.Message.setProto3BytesField(this, 5, value);
+};
+
+
+/**
+ * optional bytes binaryOlds = 6;
+ * @return {!(string|Uint8Array)}
+ */
+proto.pulumirpc.CheckRequest.prototype.getBinaryolds = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * optional bytes binaryOlds = 6;
+ * This is a type-conversion wrapper around `getBinaryolds()`
+ * @return {string}
+ */
+proto.pulumirpc.CheckRequest.prototype.getBinaryolds_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getBinaryolds()));
+};
+
+
+/**
+ * optional bytes binaryOlds = 6;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getBinaryolds()`
+ * @return {!Uint8Array}
+ */
+proto.pulumirpc.CheckRequest.prototype.getBinaryolds_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getBinaryolds()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.pulumirpc.CheckRequest} returns this
+ */
+proto.pulumirpc.CheckRequest.prototype.setBinaryolds = function(value) {
+  return jspb.Message.setProto3BytesField(this, 6, value);
+};
+
+
+/**
+ * optional bytes binaryNews = 7;
+ * @return {!(string|Uint8Array)}
+ */
+proto.pulumirpc.CheckRequest.prototype.getBinarynews = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * optional bytes binaryNews = 7;
+ * This is a type-conversion wrapper around `getBinarynews()`
+ * @return {string}
+ */
+proto.pulumirpc.CheckRequest.prototype.getBinarynews_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getBinarynews()));
+};
+
+
+/**
+ * optional bytes binaryNews = 7;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getBinarynews()`
+ * @return {!Uint8Array}
+ */
+proto.pulumirpc.CheckRequest.prototype.getBinarynews_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getBinarynews()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.pulumirpc.CheckRequest} returns this
+ */
+proto.pulumirpc.CheckRequest.prototype.setBinarynews = function(value) {
+  return jspb.Message.setProto3BytesField(this, 7, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.pulumirpc.CheckResponse.repeatedFields_ = [2,4];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pulumirpc.CheckResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.CheckResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.CheckResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.CheckResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    inputs: (f = msg.getInputs()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
+    failuresList: jspb.Message.toObjectList(msg.getFailuresList(),
+    proto.pulumirpc.CheckFailure.toObject, includeInstance),
+    binaryinputs: msg.getBinaryinputs_asB64(),
+    diagnosticsList: jspb.Message.toObjectList(msg.getDiagnosticsList(),
+    proto.pulumirpc.ProviderDiagnostic.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.CheckResponse}
+ */
+proto.pulumirpc.CheckResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.CheckResponse;
+  return proto.pulumirpc.CheckResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.CheckResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.CheckResponse}
+ */
+proto.pulumirpc.CheckResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setInputs(value);
+      break;
+    case 2:
+      var value = new proto.pulumirpc.CheckFailure;
+      reader.readMessage(value,proto.pulumirpc.CheckFailure.deserializeBinaryFromReader);
+      msg.addFailures(value);
+      break;
+    case 3:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setBinaryinputs(value);
+      break;
+    case 4:
+      var value = new proto.pulumirpc.ProviderDiagnostic;
+      reader.readMessage(value,proto.pulumirpc.ProviderDiagnostic.deserializeBinaryFromReader);
+      msg.addDiagnostics(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pulumirpc.CheckResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.CheckResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.CheckResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.CheckResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getInputs();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+  f = message.getFailuresList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.pulumirpc.CheckFailure.serializeBinaryToWriter
+    );
+  }
+  f = message.getBinaryinputs_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      3,
+      f
+    );
+  }
+  f = message.getDiagnosticsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      proto.pulumirpc.ProviderDiagnostic.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional google.protobuf.Struct inputs = 1;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.pulumirpc.CheckResponse.prototype.getInputs = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 1));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.pulumirpc.CheckResponse} returns this
+*/
+proto.pulumirpc.CheckResponse.prototype.setInputs = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.CheckResponse} returns this
+ */
+proto.pulumirpc.CheckResponse.prototype.clearInputs = function() {
+  return this.setInputs(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.pulumirpc.CheckResponse.prototype.hasInputs = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * repeated CheckFailure failures = 2;
+ * @return {!Array<!proto.pulumirpc.CheckFailure>}
+ */
+proto.pulumirpc.CheckResponse.prototype.getFailuresList = function() {
+  return /** @type{!Array<!proto.pulumirpc.CheckFailure>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.pulumirpc.CheckFailure, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.pulumirpc.CheckFailure>} value
+ * @return {!proto.pulumirpc.CheckResponse} returns this
+*/
+proto.pulumirpc.CheckResponse.prototype.setFailuresList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.pulumirpc.CheckFailure=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.CheckFailure}
+ */
+proto.pulumirpc.CheckResponse.prototype.addFailures = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.pulumirpc.CheckFailure, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.CheckResponse} returns this
+ */
+proto.pulumirpc.CheckResponse.prototype.clearFailuresList = function() {
+  return this.setFailuresList([]);
+};
+
+
+/**
+ * optional bytes binaryInputs = 3;
+ * @return {!(string|Uint8Array)}
+ */
+proto.pulumirpc.CheckResponse.prototype.getBinaryinputs = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * optional bytes binaryInputs = 3;
+ * This is a type-conversion wrapper around `getBinaryinputs()`
+ * @return {string}
+ */
+proto.pulumirpc.CheckResponse.prototype.getBinaryinputs_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getBinaryinputs()));
+};
+
+
+/**
+ * optional bytes binaryInputs = 3;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getBinaryinputs()`
+ * @return {!Uint8Array}
+ */
+proto.pulumirpc.CheckResponse.prototype.getBinaryinputs_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getBinaryinputs()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.pulumirpc.CheckResponse} returns this
+ */
+proto.pulumirpc.CheckResponse.prototype.setBinaryinputs = function(value) {
+  return jspb.Message.setProto3BytesField(this, 3, value);
+};
+
+
+/**
+ * repeated ProviderDiagnostic diagnostics = 4;
+ * @return {!Array<!proto.pulumirpc.ProviderDiagnostic>}
+ */
+proto.pulumirpc.CheckResponse.prototype.getDiagnosticsList = function() {
+  return /** @type{!Array<!proto.pulumirpc.ProviderDiagnostic>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.pulumirpc.ProviderDiagnostic, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.pulumirpc.ProviderDiagnostic>} value
+ * @return {!proto.pulumirpc.CheckResponse} returns this
+*/
+proto.pulumirpc.CheckResponse.prototype.setDiagnosticsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.pulumirpc.ProviderDiagnostic=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.ProviderDiagnostic}
+ */
+proto.pulumirpc.CheckResponse.prototype.addDiagnostics = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.pulumirpc.ProviderDiagnostic, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.CheckResponse} returns this
+ */
+proto.pulumirpc.CheckResponse.prototype.clearDiagnosticsList = function() {
+  return this.setDiagnosticsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pulumirpc.CheckFailure.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.CheckFailure.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.CheckFailure} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.CheckFailure.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    property: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    reason: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    severity: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    code: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    range: (f = msg.getRange()) && proto.pulumirpc.CheckFailure.SourceRange.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.CheckFailure}
+ */
+proto.pulumirpc.CheckFailure.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.CheckFailure;
+  return proto.pulumirpc.CheckFailure.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.CheckFailure} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.CheckFailure}
+ */
+proto.pulumirpc.CheckFailure.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setProperty(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setReason(value);
+      break;
+    case 3:
+      var value = /** @type {!proto.pulumirpc.CheckFailure.Severity} */ (reader.readEnum());
+      msg.setSeverity(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCode(value);
+      break;
+    case 5:
+      var value = new proto.pulumirpc.CheckFailure.SourceRange;
+      reader.readMessage(value,proto.pulumirpc.CheckFailure.SourceRange.deserializeBinaryFromReader);
+      msg.setRange(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pulumirpc.CheckFailure.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.CheckFailure.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.CheckFailure} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.CheckFailure.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getProperty();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getReason();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getSeverity();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      3,
+      f
+    );
+  }
+  f = message.getCode();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getRange();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      proto.pulumirpc.CheckFailure.SourceRange.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.pulumirpc.CheckFailure.Severity = {
+  ERROR: 0,
+  WARNING: 1
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pulumirpc.CheckFailure.SourceRange.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.CheckFailure.SourceRange.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.CheckFailure.SourceRange} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.CheckFailure.SourceRange.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    file: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    line: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    column: jspb.Message.getFieldWithDefault(msg, 3, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.CheckFailure.SourceRange}
+ */
+proto.pulumirpc.CheckFailure.SourceRange.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.CheckFailure.SourceRange;
+  return proto.pulumirpc.CheckFailure.SourceRange.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.CheckFailure.SourceRange} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.CheckFailure.SourceRange}
+ */
+proto.pulumirpc.CheckFailure.SourceRange.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFile(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setLine(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setColumn(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pulumirpc.CheckFailure.SourceRange.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.CheckFailure.SourceRange.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.CheckFailure.SourceRange} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.CheckFailure.SourceRange.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getFile();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getLine();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getColumn();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string file = 1;
+ * @return {string}
+ */
+proto.pulumirpc.CheckFailure.SourceRange.prototype.getFile = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.CheckFailure.SourceRange} returns this
+ */
+proto.pulumirpc.CheckFailure.SourceRange.prototype.setFile = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int32 line = 2;
+ * @return {number}
+ */
+proto.pulumirpc.CheckFailure.SourceRange.prototype.getLine = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pulumirpc.CheckFailure.SourceRange} returns this
+ */
+proto.pulumirpc.CheckFailure.SourceRange.prototype.setLine = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional int32 column = 3;
+ * @return {number}
+ */
+proto.pulumirpc.CheckFailure.SourceRange.prototype.getColumn = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pulumirpc.CheckFailure.SourceRange} returns this
+ */
+proto.pulumirpc.CheckFailure.SourceRange.prototype.setColumn = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional string property = 1;
+ * @return {string}
+ */
+proto.pulumirpc.CheckFailure.prototype.getProperty = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.CheckFailure} returns this
+ */
+proto.pulumirpc.CheckFailure.prototype.setProperty = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string reason = 2;
+ * @return {string}
+ */
+proto.pulumirpc.CheckFailure.prototype.getReason = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.CheckFailure} returns this
+ */
+proto.pulumirpc.CheckFailure.prototype.setReason = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional Severity severity = 3;
+ * @return {!proto.pulumirpc.CheckFailure.Severity}
+ */
+proto.pulumirpc.CheckFailure.prototype.getSeverity = function() {
+  return /** @type {!proto.pulumirpc.CheckFailure.Severity} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {!proto.pulumirpc.CheckFailure.Severity} value
+ * @return {!proto.pulumirpc.CheckFailure} returns this
+ */
+proto.pulumirpc.CheckFailure.prototype.setSeverity = function(value) {
+  return jspb.Message.setProto3EnumField(this, 3, value);
+};
+
+
+/**
+ * optional string code = 4;
+ * @return {string}
+ */
+proto.pulumirpc.CheckFailure.prototype.getCode = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.CheckFailure} returns this
+ */
+proto.pulumirpc.CheckFailure.prototype.setCode = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional SourceRange range = 5;
+ * @return {?proto.pulumirpc.CheckFailure.SourceRange}
+ */
+proto.pulumirpc.CheckFailure.prototype.getRange = function() {
+  return /** @type{?proto.pulumirpc.CheckFailure.SourceRange} */ (
+    jspb.Message.getWrapperField(this, proto.pulumirpc.CheckFailure.SourceRange, 5));
+};
+
+
+/**
+ * @param {?proto.pulumirpc.CheckFailure.SourceRange|undefined} value
+ * @return {!proto.pulumirpc.CheckFailure} returns this
+*/
+proto.pulumirpc.CheckFailure.prototype.setRange = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.CheckFailure} returns this
+ */
+proto.pulumirpc.CheckFailure.prototype.clearRange = function() {
+  return this.setRange(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.pulumirpc.CheckFailure.prototype.hasRange = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.pulumirpc.DiffRequest.repeatedFields_ = [5];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pulumirpc.DiffRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.DiffRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.DiffRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.DiffRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    urn: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    olds: (f = msg.getOlds()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
+    news: (f = msg.getNews()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
+    ignorechangesList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.DiffRequest}
+ */
+proto.pulumirpc.DiffRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.DiffRequest;
+  return proto.pulumirpc.DiffRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.DiffRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.DiffRequest}
+ */
+proto.pulumirpc.DiffRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUrn(value);
+      break;
+    case 3:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setOlds(value);
+      break;
+    case 4:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setNews(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addIgnorechanges(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pulumirpc.DiffRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.DiffRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.DiffRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.DiffRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getUrn();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getOlds();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+  f = message.getNews();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+  f = message.getIgnorechangesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.pulumirpc.DiffRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.DiffRequest} returns this
+ */
+proto.pulumirpc.DiffRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string urn = 2;
+ * @return {string}
+ */
+proto.pulumirpc.DiffRequest.prototype.getUrn = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.DiffRequest} returns this
+ */
+proto.pulumirpc.DiffRequest.prototype.setUrn = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct olds = 3;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.pulumirpc.DiffRequest.prototype.getOlds = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 3));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.pulumirpc.DiffRequest} returns this
+*/
+proto.pulumirpc.DiffRequest.prototype.setOlds = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.DiffRequest} returns this
+ */
+proto.pulumirpc.DiffRequest.prototype.clearOlds = function() {
+  return this.setOlds(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.pulumirpc.DiffRequest.prototype.hasOlds = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional google.protobuf.Struct news = 4;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.pulumirpc.DiffRequest.prototype.getNews = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 4));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.pulumirpc.DiffRequest} returns this
+*/
+proto.pulumirpc.DiffRequest.prototype.setNews = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.DiffRequest} returns this
+ */
+proto.pulumirpc.DiffRequest.prototype.clearNews = function() {
+  return this.setNews(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.pulumirpc.DiffRequest.prototype.hasNews = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * repeated string ignoreChanges = 5;
+ * @return {!Array<string>}
+ */
+proto.pulumirpc.DiffRequest.prototype.getIgnorechangesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 5));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.pulumirpc.DiffRequest} returns this
+ */
+proto.pulumirpc.DiffRequest.prototype.setIgnorechangesList = function(value) {
+  return jspb.Message.setField(this, 5, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.DiffRequest} returns this
+ */
+proto.pulumirpc.DiffRequest.prototype.addIgnorechanges = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 5, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.DiffRequest} returns this
+ */
+proto.pulumirpc.DiffRequest.prototype.clearIgnorechangesList = function() {
+  return this.setIgnorechangesList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pulumirpc.PropertyDiff.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.PropertyDiff.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.PropertyDiff} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.PropertyDiff.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    kind: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    inputdiff: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    reason: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    oldvalue: (f = msg.getOldvalue()) && google_protobuf_struct_pb.Value.toObject(includeInstance, f),
+    newvalue: (f = msg.getNewvalue()) && google_protobuf_struct_pb.Value.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.PropertyDiff}
+ */
+proto.pulumirpc.PropertyDiff.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.PropertyDiff;
+  return proto.pulumirpc.PropertyDiff.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.PropertyDiff} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.PropertyDiff}
+ */
+proto.pulumirpc.PropertyDiff.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.pulumirpc.PropertyDiff.Kind} */ (reader.readEnum());
+      msg.setKind(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setInputdiff(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setReason(value);
+      break;
+    case 4:
+      var value = new google_protobuf_struct_pb.Value;
+      reader.readMessage(value,google_protobuf_struct_pb.Value.deserializeBinaryFromReader);
+      msg.setOldvalue(value);
+      break;
+    case 5:
+      var value = new google_protobuf_struct_pb.Value;
+      reader.readMessage(value,google_protobuf_struct_pb.Value.deserializeBinaryFromReader);
+      msg.setNewvalue(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pulumirpc.PropertyDiff.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.PropertyDiff.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.PropertyDiff} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.PropertyDiff.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getKind();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+  f = message.getInputdiff();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+  f = message.getReason();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getOldvalue();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      google_protobuf_struct_pb.Value.serializeBinaryToWriter
+    );
+  }
+  f = message.getNewvalue();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      google_protobuf_struct_pb.Value.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.pulumirpc.PropertyDiff.Kind = {
+  ADD: 0,
+  ADD_REPLACE: 1,
+  DELETE: 2,
+  DELETE_REPLACE: 3,
+  UPDATE: 4,
+  UPDATE_REPLACE: 5
+};
+
+/**
+ * optional Kind kind = 1;
+ * @return {!proto.pulumirpc.PropertyDiff.Kind}
+ */
+proto.pulumirpc.PropertyDiff.prototype.getKind = function() {
+  return /** @type {!proto.pulumirpc.PropertyDiff.Kind} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.pulumirpc.PropertyDiff.Kind} value
+ * @return {!proto.pulumirpc.PropertyDiff} returns this
+ */
+proto.pulumirpc.PropertyDiff.prototype.setKind = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * optional bool inputDiff = 2;
+ * @return {boolean}
+ */
+proto.pulumirpc.PropertyDiff.prototype.getInputdiff = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.pulumirpc.PropertyDiff} returns this
+ */
+proto.pulumirpc.PropertyDiff.prototype.setInputdiff = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+/**
+ * optional string reason = 3;
+ * @return {string}
+ */
+proto.pulumirpc.PropertyDiff.prototype.getReason = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.PropertyDiff} returns this
+ */
+proto.pulumirpc.PropertyDiff.prototype.setReason = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional google.protobuf.Value oldValue = 4;
+ * @return {?google_protobuf_struct_pb.Value}
+ */
+proto.pulumirpc.PropertyDiff.prototype.getOldvalue = function() {
+  return /** @type{?google_protobuf_struct_pb.Value} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Value, 4));
+};
+
+
+/**
+ * @param {?google_protobuf_struct_pb.Value|undefined} value
+ * @return {!proto.pulumirpc.PropertyDiff} returns this
+*/
+proto.pulumirpc.PropertyDiff.prototype.setOldvalue = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.PropertyDiff} returns this
+ */
+proto.pulumirpc.PropertyDiff.prototype.clearOldvalue = function() {
+  return this.setOldvalue(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.pulumirpc.PropertyDiff.prototype.hasOldvalue = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional google.protobuf.Value newValue = 5;
+ * @return {?google_protobuf_struct_pb.Value}
+ */
+proto.pulumirpc.PropertyDiff.prototype.getNewvalue = function() {
+  return /** @type{?google_protobuf_struct_pb.Value} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Value, 5));
+};
+
+
+/**
+ * @param {?google_protobuf_struct_pb.Value|undefined} value
+ * @return {!proto.pulumirpc.PropertyDiff} returns this
+*/
+proto.pulumirpc.PropertyDiff.prototype.setNewvalue = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.PropertyDiff} returns this
+ */
+proto.pulumirpc.PropertyDiff.prototype.clearNewvalue = function() {
+  return this.setNewvalue(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.pulumirpc.PropertyDiff.prototype.hasNewvalue = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.pulumirpc.DiffResponse.repeatedFields_ = [1,2,5,8,9];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pulumirpc.DiffResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.DiffResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.DiffResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.DiffResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    replacesList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
+    stablesList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
+    deletebeforereplace: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    changes: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    diffsList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f,
+    detaileddiffMap: (f = msg.getDetaileddiffMap()) ? f.toObject(includeInstance, proto.pulumirpc.PropertyDiff.toObject) : [],
+    hasdetaileddiff: jspb.Message.getBooleanFieldWithDefault(msg, 7, false),
+    affectedresourcesList: (f = jspb.Message.getRepeatedField(msg, 8)) == null ? undefined : f,
+    diagnosticsList: jspb.Message.toObjectList(msg.getDiagnosticsList(),
+    proto.pulumirpc.ProviderDiagnostic.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.DiffResponse}
+ */
+proto.pulumirpc.DiffResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.DiffResponse;
+  return proto.pulumirpc.DiffResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.DiffResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.DiffResponse}
+ */
+proto.pulumirpc.DiffResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addReplaces(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addStables(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setDeletebeforereplace(value);
+      break;
+    case 4:
+      var value = /** @type {!proto.pulumirpc.DiffResponse.DiffChanges} */ (reader.readEnum());
+      msg.setChanges(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addDiffs(value);
+      break;
+    case 6:
+      var value = msg.getDetaileddiffMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.pulumirpc.PropertyDiff.deserializeBinaryFromReader, "", new proto.pulumirpc.PropertyDiff());
+         });
+      break;
+    case 7:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setHasdetaileddiff(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addAffectedresources(value);
+      break;
+    case 9:
+      var value = new proto.pulumirpc.ProviderDiagnostic;
+      reader.readMessage(value,proto.pulumirpc.ProviderDiagnostic.deserializeBinaryFromReader);
+      msg.addDiagnostics(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pulumirpc.DiffResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.DiffResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.DiffResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.DiffResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getReplacesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
+  f = message.getStablesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      2,
+      f
+    );
+  }
+  f = message.getDeletebeforereplace();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = message.getChanges();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      4,
+      f
+    );
+  }
+  f = message.getDiffsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      5,
+      f
+    );
+  }
+  f = message.getDetaileddiffMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(6, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.pulumirpc.PropertyDiff.serializeBinaryToWriter);
+  }
+  f = message.getHasdetaileddiff();
+  if (f) {
+    writer.writeBool(
+      7,
+      f
+    );
+  }
+  f = message.getAffectedresourcesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      8,
+      f
+    );
+  }
+  f = message.getDiagnosticsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      9,
+      f,
+      proto.pulumirpc.ProviderDiagnostic.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.pulumirpc.DiffResponse.DiffChanges = {
+  DIFF_UNKNOWN: 0,
+  DIFF_NONE: 1,
+  DIFF_SOME: 2
+};
+
+/**
+ * repeated string replaces = 1;
+ * @return {!Array<string>}
+ */
+proto.pulumirpc.DiffResponse.prototype.getReplacesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.pulumirpc.DiffResponse} returns this
+ */
+proto.pulumirpc.DiffResponse.prototype.setReplacesList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.DiffResponse} returns this
+ */
+proto.pulumirpc.DiffResponse.prototype.addReplaces = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.DiffResponse} returns this
+ */
+proto.pulumirpc.DiffResponse.prototype.clearReplacesList = function() {
+  return this.setReplacesList([]);
+};
+
+
+/**
+ * repeated string stables = 2;
+ * @return {!Array<string>}
+ */
+proto.pulumirpc.DiffResponse.prototype.getStablesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.pulumirpc.DiffResponse} returns this
+ */
+proto.pulumirpc.DiffResponse.prototype.setStablesList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.DiffResponse} returns this
+ */
+proto.pulumirpc.DiffResponse.prototype.addStables = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.DiffResponse} returns this
+ */
+proto.pulumirpc.DiffResponse.prototype.clearStablesList = function() {
+  return this.setStablesList([]);
+};
+
+
+/**
+ * optional bool deleteBeforeReplace = 3;
+ * @return {boolean}
+ */
+proto.pulumirpc.DiffResponse.prototype.getDeletebeforereplace = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.pulumirpc.DiffResponse} returns this
+ */
+proto.pulumirpc.DiffResponse.prototype.setDeletebeforereplace = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
+/**
+ * optional DiffChanges changes = 4;
+ * @return {!proto.pulumirpc.DiffResponse.DiffChanges}
+ */
+proto.pulumirpc.DiffResponse.prototype.getChanges = function() {
+  return /** @type {!proto.pulumirpc.DiffResponse.DiffChanges} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {!proto.pulumirpc.DiffResponse.DiffChanges} value
+ * @return {!proto.pulumirpc.DiffResponse} returns this
+ */
+proto.pulumirpc.DiffResponse.prototype.setChanges = function(value) {
+  return jspb.Message.setProto3EnumField(this, 4, value);
+};
+
+
+/**
+ * repeated string diffs = 5;
+ * @return {!Array<string>}
+ */
+proto.pulumirpc.DiffResponse.prototype.getDiffsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 5));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.pulumirpc.DiffResponse} returns this
+ */
+proto.pulumirpc.DiffResponse.prototype.setDiffsList = function(value) {
+  return jspb.Message.setField(this, 5, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.DiffResponse} returns this
+ */
+proto.pulumirpc.DiffResponse.prototype.addDiffs = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 5, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.DiffResponse} returns this
+ */
+proto.pulumirpc.DiffResponse.prototype.clearDiffsList = function() {
+  return this.setDiffsList([]);
+};
+
+
+/**
+ * map<string, PropertyDiff> detailedDiff = 6;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.pulumirpc.PropertyDiff>}
+ */
+proto.pulumirpc.DiffResponse.prototype.getDetaileddiffMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.pulumirpc.PropertyDiff>} */ (
+      jspb.Message.getMapField(this, 6, opt_noLazyCreate,
+      proto.pulumirpc.PropertyDiff));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.pulumirpc.DiffResponse} returns this
+ */
+proto.pulumirpc.DiffResponse.prototype.clearDetaileddiffMap = function() {
+  this.getDetaileddiffMap().clear();
+  return this;};
+
+
+/**
+ * optional bool hasDetailedDiff = 7;
+ * @return {boolean}
+ */
+proto.pulumirpc.DiffResponse.prototype.getHasdetaileddiff = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 7, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.pulumirpc.DiffResponse} returns this
+ */
+proto.pulumirpc.DiffResponse.prototype.setHasdetaileddiff = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 7, value);
+};
+
+
+/**
+ * repeated string affectedResources = 8;
+ * @return {!Array<string>}
+ */
+proto.pulumirpc.DiffResponse.prototype.getAffectedresourcesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 8));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.pulumirpc.DiffResponse} returns this
+ */
+proto.pulumirpc.DiffResponse.prototype.setAffectedresourcesList = function(value) {
+  return jspb.Message.setField(this, 8, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.DiffResponse} returns this
+ */
+proto.pulumirpc.DiffResponse.prototype.addAffectedresources = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 8, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.DiffResponse} returns this
+ */
+proto.pulumirpc.DiffResponse.prototype.clearAffectedresourcesList = function() {
+  return this.setAffectedresourcesList([]);
+};
+
+
+/**
+ * repeated ProviderDiagnostic diagnostics = 9;
+ * @return {!Array<!proto.pulumirpc.ProviderDiagnostic>}
+ */
+proto.pulumirpc.DiffResponse.prototype.getDiagnosticsList = function() {
+  return /** @type{!Array<!proto.pulumirpc.ProviderDiagnostic>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.pulumirpc.ProviderDiagnostic, 9));
+};
+
+
+/**
+ * @param {!Array<!proto.pulumirpc.ProviderDiagnostic>} value
+ * @return {!proto.pulumirpc.DiffResponse} returns this
+*/
+proto.pulumirpc.DiffResponse.prototype.setDiagnosticsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 9, value);
+};
+
+
+/**
+ * @param {!proto.pulumirpc.ProviderDiagnostic=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.ProviderDiagnostic}
+ */
+proto.pulumirpc.DiffResponse.prototype.addDiagnostics = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 9, opt_value, proto.pulumirpc.ProviderDiagnostic, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.DiffResponse} returns this
+ */
+proto.pulumirpc.DiffResponse.prototype.clearDiagnosticsList = function() {
+  return this.setDiagnosticsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pulumirpc.CreateRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.CreateRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.CreateRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.CreateRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    urn: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    properties: (f = msg.getProperties()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
+    timeout: jspb.Message.getFloatingPointFieldWithDefault(msg, 3, 0.0),
+    preview: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
+    binaryproperties: msg.getBinaryproperties_asB64()
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.CreateRequest}
+ */
+proto.pulumirpc.CreateRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.CreateRequest;
+  return proto.pulumirpc.CreateRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.CreateRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.CreateRequest}
+ */
+proto.pulumirpc.CreateRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUrn(value);
+      break;
+    case 2:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setProperties(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setTimeout(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setPreview(value);
+      break;
+    case 5:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setBinaryproperties(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pulumirpc.CreateRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.CreateRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.CreateRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.CreateRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUrn();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getProperties();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+  f = message.getTimeout();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      3,
+      f
+    );
+  }
+  f = message.getPreview();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+  f = message.getBinaryproperties_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string urn = 1;
+ * @return {string}
+ */
+proto.pulumirpc.CreateRequest.prototype.getUrn = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.CreateRequest} returns this
+ */
+proto.pulumirpc.CreateRequest.prototype.setUrn = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct properties = 2;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.pulumirpc.CreateRequest.prototype.getProperties = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 2));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.pulumirpc.CreateRequest} returns this
+*/
+proto.pulumirpc.CreateRequest.prototype.setProperties = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.CreateRequest} returns this
+ */
+proto.pulumirpc.CreateRequest.prototype.clearProperties = function() {
+  return this.setProperties(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.pulumirpc.CreateRequest.prototype.hasProperties = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional double timeout = 3;
+ * @return {number}
+ */
+proto.pulumirpc.CreateRequest.prototype.getTimeout = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 3, 0.0));
+};
+
+
 /**
  * @param {number} value
- * @return {!proto.pulumirpc.GetSchemaRequest} returns this
+ * @return {!proto.pulumirpc.CreateRequest} returns this
+ */
+proto.pulumirpc.CreateRequest.prototype.setTimeout = function(value) {
+  return jspb.Message.setProto3FloatField(this, 3, value);
+};
+
+
+/**
+ * optional bool preview = 4;
+ * @return {boolean}
+ */
+proto.pulumirpc.CreateRequest.prototype.getPreview = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.pulumirpc.CreateRequest} returns this
+ */
+proto.pulumirpc.CreateRequest.prototype.setPreview = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
+};
+
+
+/**
+ * optional bytes binaryProperties = 5;
+ * @return {!(string|Uint8Array)}
+ */
+proto.pulumirpc.CreateRequest.prototype.getBinaryproperties = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * optional bytes binaryProperties = 5;
+ * This is a type-conversion wrapper around `getBinaryproperties()`
+ * @return {string}
+ */
+proto.pulumirpc.CreateRequest.prototype.getBinaryproperties_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getBinaryproperties()));
+};
+
+
+/**
+ * optional bytes binaryProperties = 5;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getBinaryproperties()`
+ * @return {!Uint8Array}
+ */
+proto.pulumirpc.CreateRequest.prototype.getBinaryproperties_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getBinaryproperties()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.pulumirpc.CreateRequest} returns this
+ */
+proto.pulumirpc.CreateRequest.prototype.setBinaryproperties = function(value) {
+  return jspb.Message.setProto3BytesField(this, 5, value);
+};
+
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.pulumirpc.GetSchemaRequest.prototype.setVersion = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+proto.pulumirpc.CreateResponse.repeatedFields_ = [4];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pulumirpc.CreateResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.CreateResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.CreateResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.CreateResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    properties: (f = msg.getProperties()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
+    binaryproperties: msg.getBinaryproperties_asB64(),
+    diagnosticsList: jspb.Message.toObjectList(msg.getDiagnosticsList(),
+    proto.pulumirpc.ProviderDiagnostic.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.CreateResponse}
+ */
+proto.pulumirpc.CreateResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.CreateResponse;
+  return proto.pulumirpc.CreateResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.CreateResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.CreateResponse}
+ */
+proto.pulumirpc.CreateResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setProperties(value);
+      break;
+    case 3:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setBinaryproperties(value);
+      break;
+    case 4:
+      var value = new proto.pulumirpc.ProviderDiagnostic;
+      reader.readMessage(value,proto.pulumirpc.ProviderDiagnostic.deserializeBinaryFromReader);
+      msg.addDiagnostics(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pulumirpc.CreateResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.CreateResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.CreateResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.CreateResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getProperties();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+  f = message.getBinaryproperties_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      3,
+      f
+    );
+  }
+  f = message.getDiagnosticsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      proto.pulumirpc.ProviderDiagnostic.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.pulumirpc.CreateResponse.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.CreateResponse} returns this
+ */
+proto.pulumirpc.CreateResponse.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct properties = 2;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.pulumirpc.CreateResponse.prototype.getProperties = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 2));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.pulumirpc.CreateResponse} returns this
+*/
+proto.pulumirpc.CreateResponse.prototype.setProperties = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.CreateResponse} returns this
+ */
+proto.pulumirpc.CreateResponse.prototype.clearProperties = function() {
+  return this.setProperties(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.pulumirpc.CreateResponse.prototype.hasProperties = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional bytes binaryProperties = 3;
+ * @return {!(string|Uint8Array)}
+ */
+proto.pulumirpc.CreateResponse.prototype.getBinaryproperties = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * optional bytes binaryProperties = 3;
+ * This is a type-conversion wrapper around `getBinaryproperties()`
+ * @return {string}
+ */
+proto.pulumirpc.CreateResponse.prototype.getBinaryproperties_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getBinaryproperties()));
+};
+
+
+/**
+ * optional bytes binaryProperties = 3;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getBinaryproperties()`
+ * @return {!Uint8Array}
+ */
+proto.pulumirpc.CreateResponse.prototype.getBinaryproperties_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getBinaryproperties()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.pulumirpc.CreateResponse} returns this
+ */
+proto.pulumirpc.CreateResponse.prototype.setBinaryproperties = function(value) {
+  return jspb.Message.setProto3BytesField(this, 3, value);
+};
+
+
+/**
+ * repeated ProviderDiagnostic diagnostics = 4;
+ * @return {!Array<!proto.pulumirpc.ProviderDiagnostic>}
+ */
+proto.pulumirpc.CreateResponse.prototype.getDiagnosticsList = function() {
+  return /** @type{!Array<!proto.pulumirpc.ProviderDiagnostic>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.pulumirpc.ProviderDiagnostic, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.pulumirpc.ProviderDiagnostic>} value
+ * @return {!proto.pulumirpc.CreateResponse} returns this
+*/
+proto.pulumirpc.CreateResponse.prototype.setDiagnosticsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.pulumirpc.ProviderDiagnostic=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.ProviderDiagnostic}
+ */
+proto.pulumirpc.CreateResponse.prototype.addDiagnostics = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.pulumirpc.ProviderDiagnostic, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.CreateResponse} returns this
+ */
+proto.pulumirpc.CreateResponse.prototype.clearDiagnosticsList = function() {
+  return this.setDiagnosticsList([]);
 };
 
 
@@ -829,8 +7056,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pulumirpc.GetSchemaResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.GetSchemaResponse.toObject(opt_includeInstance, this);
+proto.pulumirpc.ReadRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.ReadRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -839,13 +7066,16 @@ proto.pulumirpc.GetSchemaResponse.prototype.toObject = function(opt_includeInsta
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.GetSchemaResponse} msg The msg instance to transform.
+ * @param {!proto.pulumirpc.ReadRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.GetSchemaResponse.toObject = function(includeInstance, msg) {
+proto.pulumirpc.ReadRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    schema: jspb.Message.getFieldWithDefault(msg, 1, "")
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    urn: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    properties: (f = msg.getProperties()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
+    inputs: (f = msg.getInputs()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -859,23 +7089,23 @@ proto.pulumirpc.GetSchemaResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.GetSchemaResponse}
+ * @return {!proto.pulumirpc.ReadRequest}
  */
-proto.pulumirpc.GetSchemaResponse.deserializeBinary = function(bytes) {
+proto.pulumirpc.ReadRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.GetSchemaResponse;
-  return proto.pulumirpc.GetSchemaResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pulumirpc.ReadRequest;
+  return proto.pulumirpc.ReadRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pulumirpc.GetSchemaResponse} msg The message object to deserialize into.
+ * @param {!proto.pulumirpc.ReadRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.GetSchemaResponse}
+ * @return {!proto.pulumirpc.ReadRequest}
  */
-proto.pulumirpc.GetSchemaResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.pulumirpc.ReadRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -884,7 +7114,21 @@ proto.pulumirpc.GetSchemaResponse.deserializeBinaryFromReader = function(msg, re
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setSchema(value);
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUrn(value);
+      break;
+    case 3:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setProperties(value);
+      break;
+    case 4:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setInputs(value);
       break;
     default:
       reader.skipField();
@@ -899,9 +7143,9 @@ proto.pulumirpc.GetSchemaResponse.deserializeBinaryFromReader = function(msg, re
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pulumirpc.GetSchemaResponse.prototype.serializeBinary = function() {
+proto.pulumirpc.ReadRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.GetSchemaResponse.serializeBinaryToWriter(this, writer);
+  proto.pulumirpc.ReadRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -909,40 +7153,163 @@ proto.pulumirpc.GetSchemaResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.GetSchemaResponse} message
+ * @param {!proto.pulumirpc.ReadRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.GetSchemaResponse.serializeBinaryToWriter = function(message, writer) {
+proto.pulumirpc.ReadRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSchema();
+  f = message.getId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
+  f = message.getUrn();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getProperties();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+  f = message.getInputs();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional string schema = 1;
+ * optional string id = 1;
  * @return {string}
  */
-proto.pulumirpc.GetSchemaResponse.prototype.getSchema = function() {
+proto.pulumirpc.ReadRequest.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.pulumirpc.GetSchemaResponse} returns this
+ * @return {!proto.pulumirpc.ReadRequest} returns this
  */
-proto.pulumirpc.GetSchemaResponse.prototype.setSchema = function(value) {
+proto.pulumirpc.ReadRequest.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * optional string urn = 2;
+ * @return {string}
+ */
+proto.pulumirpc.ReadRequest.prototype.getUrn = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.ReadRequest} returns this
+ */
+proto.pulumirpc.ReadRequest.prototype.setUrn = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct properties = 3;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.pulumirpc.ReadRequest.prototype.getProperties = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 3));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.pulumirpc.ReadRequest} returns this
+*/
+proto.pulumirpc.ReadRequest.prototype.setProperties = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.ReadRequest} returns this
+ */
+proto.pulumirpc.ReadRequest.prototype.clearProperties = function() {
+  return this.setProperties(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.pulumirpc.ReadRequest.prototype.hasProperties = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional google.protobuf.Struct inputs = 4;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.pulumirpc.ReadRequest.prototype.getInputs = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 4));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.pulumirpc.ReadRequest} returns this
+*/
+proto.pulumirpc.ReadRequest.prototype.setInputs = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.ReadRequest} returns this
+ */
+proto.pulumirpc.ReadRequest.prototype.clearInputs = function() {
+  return this.setInputs(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.pulumirpc.ReadRequest.prototype.hasInputs = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.pulumirpc.ReadResponse.repeatedFields_ = [4];
 
 
 
@@ -959,8 +7326,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pulumirpc.ConfigureRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.ConfigureRequest.toObject(opt_includeInstance, this);
+proto.pulumirpc.ReadResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.ReadResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -969,16 +7336,17 @@ proto.pulumirpc.ConfigureRequest.prototype.toObject = function(opt_includeInstan
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.ConfigureRequest} msg The msg instance to transform.
+ * @param {!proto.pulumirpc.ReadResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.ConfigureRequest.toObject = function(includeInstance, msg) {
+proto.pulumirpc.ReadResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    variablesMap: (f = msg.getVariablesMap()) ? f.toObject(includeInstance, undefined) : [],
-    args: (f = msg.getArgs()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
-    acceptsecrets: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
-    acceptresources: jspb.Message.getBooleanFieldWithDefault(msg, 4, false)
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    properties: (f = msg.getProperties()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
+    inputs: (f = msg.getInputs()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
+    diagnosticsList: jspb.Message.toObjectList(msg.getDiagnosticsList(),
+    proto.pulumirpc.ProviderDiagnostic.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -992,23 +7360,23 @@ proto.pulumirpc.ConfigureRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.ConfigureRequest}
+ * @return {!proto.pulumirpc.ReadResponse}
  */
-proto.pulumirpc.ConfigureRequest.deserializeBinary = function(bytes) {
+proto.pulumirpc.ReadResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.ConfigureRequest;
-  return proto.pulumirpc.ConfigureRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pulumirpc.ReadResponse;
+  return proto.pulumirpc.ReadResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pulumirpc.ConfigureRequest} msg The message object to deserialize into.
+ * @param {!proto.pulumirpc.ReadResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.ConfigureRequest}
+ * @return {!proto.pulumirpc.ReadResponse}
  */
-proto.pulumirpc.ConfigureRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.pulumirpc.ReadResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1016,23 +7384,23 @@ proto.pulumirpc.ConfigureRequest.deserializeBinaryFromReader = function(msg, rea
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = msg.getVariablesMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
-         });
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
       break;
     case 2:
       var value = new google_protobuf_struct_pb.Struct;
       reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setArgs(value);
+      msg.setProperties(value);
       break;
     case 3:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setAcceptsecrets(value);
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setInputs(value);
       break;
     case 4:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setAcceptresources(value);
+      var value = new proto.pulumirpc.ProviderDiagnostic;
+      reader.readMessage(value,proto.pulumirpc.ProviderDiagnostic.deserializeBinaryFromReader);
+      msg.addDiagnostics(value);
       break;
     default:
       reader.skipField();
@@ -1047,9 +7415,9 @@ proto.pulumirpc.ConfigureRequest.deserializeBinaryFromReader = function(msg, rea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pulumirpc.ConfigureRequest.prototype.serializeBinary = function() {
+proto.pulumirpc.ReadResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.ConfigureRequest.serializeBinaryToWriter(this, writer);
+  proto.pulumirpc.ReadResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1057,17 +7425,20 @@ proto.pulumirpc.ConfigureRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.ConfigureRequest} message
+ * @param {!proto.pulumirpc.ReadResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.ConfigureRequest.serializeBinaryToWriter = function(message, writer) {
+proto.pulumirpc.ReadResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getVariablesMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
   }
-  f = message.getArgs();
+  f = message.getProperties();
   if (f != null) {
     writer.writeMessage(
       2,
@@ -1075,50 +7446,48 @@ proto.pulumirpc.ConfigureRequest.serializeBinaryToWriter = function(message, wri
       google_protobuf_struct_pb.Struct.serializeBinaryToWriter
     );
   }
-  f = message.getAcceptsecrets();
-  if (f) {
-    writer.writeBool(
+  f = message.getInputs();
+  if (f != null) {
+    writer.writeMessage(
       3,
-      f
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
     );
   }
-  f = message.getAcceptresources();
-  if (f) {
-    writer.writeBool(
+  f = message.getDiagnosticsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
       4,
-      f
+      f,
+      proto.pulumirpc.ProviderDiagnostic.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * map<string, string> variables = 1;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,string>}
+ * optional string id = 1;
+ * @return {string}
  */
-proto.pulumirpc.ConfigureRequest.prototype.getVariablesMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,string>} */ (
-      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
-      null));
+proto.pulumirpc.ReadResponse.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.pulumirpc.ConfigureRequest} returns this
+ * @param {string} value
+ * @return {!proto.pulumirpc.ReadResponse} returns this
  */
-proto.pulumirpc.ConfigureRequest.prototype.clearVariablesMap = function() {
-  this.getVariablesMap().clear();
-  return this;};
+proto.pulumirpc.ReadResponse.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
 
 
 /**
- * optional google.protobuf.Struct args = 2;
+ * optional google.protobuf.Struct properties = 2;
  * @return {?proto.google.protobuf.Struct}
  */
-proto.pulumirpc.ConfigureRequest.prototype.getArgs = function() {
+proto.pulumirpc.ReadResponse.prototype.getProperties = function() {
   return /** @type{?proto.google.protobuf.Struct} */ (
     jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 2));
 };
@@ -1126,19 +7495,19 @@ proto.pulumirpc.ConfigureRequest.prototype.getArgs = function() {
 
 /**
  * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.pulumirpc.ConfigureRequest} returns this
+ * @return {!proto.pulumirpc.ReadResponse} returns this
 */
-proto.pulumirpc.ConfigureRequest.prototype.setArgs = function(value) {
+proto.pulumirpc.ReadResponse.prototype.setProperties = function(value) {
   return jspb.Message.setWrapperField(this, 2, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.pulumirpc.ConfigureRequest} returns this
+ * @return {!proto.pulumirpc.ReadResponse} returns this
  */
-proto.pulumirpc.ConfigureRequest.prototype.clearArgs = function() {
-  return this.setArgs(undefined);
+proto.pulumirpc.ReadResponse.prototype.clearProperties = function() {
+  return this.setProperties(undefined);
 };
 
 
@@ -1146,48 +7515,94 @@ proto.pulumirpc.ConfigureRequest.prototype.clearArgs = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.pulumirpc.ConfigureRequest.prototype.hasArgs = function() {
+proto.pulumirpc.ReadResponse.prototype.hasProperties = function() {
   return jspb.Message.getField(this, 2) != null;
 };
 
 
 /**
- * optional bool acceptSecrets = 3;
- * @return {boolean}
+ * optional google.protobuf.Struct inputs = 3;
+ * @return {?proto.google.protobuf.Struct}
  */
-proto.pulumirpc.ConfigureRequest.prototype.getAcceptsecrets = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+proto.pulumirpc.ReadResponse.prototype.getInputs = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 3));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.pulumirpc.ConfigureRequest} returns this
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.pulumirpc.ReadResponse} returns this
+*/
+proto.pulumirpc.ReadResponse.prototype.setInputs = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.ReadResponse} returns this
  */
-proto.pulumirpc.ConfigureRequest.prototype.setAcceptsecrets = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 3, value);
+proto.pulumirpc.ReadResponse.prototype.clearInputs = function() {
+  return this.setInputs(undefined);
 };
 
 
 /**
- * optional bool acceptResources = 4;
+ * Returns whether this field is set.
  * @return {boolean}
  */
-proto.pulumirpc.ConfigureRequest.prototype.getAcceptresources = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+proto.pulumirpc.ReadResponse.prototype.hasInputs = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.pulumirpc.ConfigureRequest} returns this
+ * repeated ProviderDiagnostic diagnostics = 4;
+ * @return {!Array<!proto.pulumirpc.ProviderDiagnostic>}
  */
-proto.pulumirpc.ConfigureRequest.prototype.setAcceptresources = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 4, value);
+proto.pulumirpc.ReadResponse.prototype.getDiagnosticsList = function() {
+  return /** @type{!Array<!proto.pulumirpc.ProviderDiagnostic>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.pulumirpc.ProviderDiagnostic, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.pulumirpc.ProviderDiagnostic>} value
+ * @return {!proto.pulumirpc.ReadResponse} returns this
+*/
+proto.pulumirpc.ReadResponse.prototype.setDiagnosticsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.pulumirpc.ProviderDiagnostic=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.ProviderDiagnostic}
+ */
+proto.pulumirpc.ReadResponse.prototype.addDiagnostics = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.pulumirpc.ProviderDiagnostic, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.ReadResponse} returns this
+ */
+proto.pulumirpc.ReadResponse.prototype.clearDiagnosticsList = function() {
+  return this.setDiagnosticsList([]);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.pulumirpc.UpdateRequest.repeatedFields_ = [6];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -1203,8 +7618,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pulumirpc.ConfigureResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.ConfigureResponse.toObject(opt_includeInstance, this);
+proto.pulumirpc.UpdateRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.UpdateRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -1213,16 +7628,21 @@ proto.pulumirpc.ConfigureResponse.prototype.toObject = function(opt_includeInsta
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.ConfigureResponse} msg The msg instance to transform.
+ * @param {!proto.pulumirpc.UpdateRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.ConfigureResponse.toObject = function(includeInstance, msg) {
+proto.pulumirpc.UpdateRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    acceptsecrets: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
-    supportspreview: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
-    acceptresources: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
-    acceptoutputs: jspb.Message.getBooleanFieldWithDefault(msg, 4, false)
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    urn: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    olds: (f = msg.getOlds()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
+    news: (f = msg.getNews()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
+    timeout: jspb.Message.getFloatingPointFieldWithDefault(msg, 5, 0.0),
+    ignorechangesList: (f = jspb.Message.getRepeatedField(msg, 6)) == null ? undefined : f,
+    preview: jspb.Message.getBooleanFieldWithDefault(msg, 7, false),
+    binaryolds: msg.getBinaryolds_asB64(),
+    binarynews: msg.getBinarynews_asB64()
   };
 
   if (includeInstance) {
@@ -1236,23 +7656,23 @@ proto.pulumirpc.ConfigureResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.ConfigureResponse}
+ * @return {!proto.pulumirpc.UpdateRequest}
  */
-proto.pulumirpc.ConfigureResponse.deserializeBinary = function(bytes) {
+proto.pulumirpc.UpdateRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.ConfigureResponse;
-  return proto.pulumirpc.ConfigureResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pulumirpc.UpdateRequest;
+  return proto.pulumirpc.UpdateRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pulumirpc.ConfigureResponse} msg The message object to deserialize into.
+ * @param {!proto.pulumirpc.UpdateRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.ConfigureResponse}
+ * @return {!proto.pulumirpc.UpdateRequest}
  */
-proto.pulumirpc.ConfigureResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.pulumirpc.UpdateRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1260,20 +7680,42 @@ proto.pulumirpc.ConfigureResponse.deserializeBinaryFromReader = function(msg, re
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setAcceptsecrets(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
       break;
     case 2:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setSupportspreview(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUrn(value);
       break;
     case 3:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setAcceptresources(value);
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setOlds(value);
       break;
     case 4:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setNews(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setTimeout(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addIgnorechanges(value);
+      break;
+    case 7:
       var value = /** @type {boolean} */ (reader.readBool());
-      msg.setAcceptoutputs(value);
+      msg.setPreview(value);
+      break;
+    case 8:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setBinaryolds(value);
+      break;
+    case 9:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setBinarynews(value);
       break;
     default:
       reader.skipField();
@@ -1288,9 +7730,9 @@ proto.pulumirpc.ConfigureResponse.deserializeBinaryFromReader = function(msg, re
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pulumirpc.ConfigureResponse.prototype.serializeBinary = function() {
+proto.pulumirpc.UpdateRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.ConfigureResponse.serializeBinaryToWriter(this, writer);
+  proto.pulumirpc.UpdateRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1298,37 +7740,74 @@ proto.pulumirpc.ConfigureResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.ConfigureResponse} message
+ * @param {!proto.pulumirpc.UpdateRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.ConfigureResponse.serializeBinaryToWriter = function(message, writer) {
+proto.pulumirpc.UpdateRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getAcceptsecrets();
-  if (f) {
-    writer.writeBool(
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getSupportspreview();
-  if (f) {
-    writer.writeBool(
+  f = message.getUrn();
+  if (f.length > 0) {
+    writer.writeString(
       2,
       f
     );
   }
-  f = message.getAcceptresources();
-  if (f) {
-    writer.writeBool(
+  f = message.getOlds();
+  if (f != null) {
+    writer.writeMessage(
       3,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+  f = message.getNews();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+  f = message.getTimeout();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      5,
       f
     );
   }
-  f = message.getAcceptoutputs();
+  f = message.getIgnorechangesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      6,
+      f
+    );
+  }
+  f = message.getPreview();
   if (f) {
     writer.writeBool(
-      4,
+      7,
+      f
+    );
+  }
+  f = message.getBinaryolds_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      8,
+      f
+    );
+  }
+  f = message.getBinarynews_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      9,
       f
     );
   }
@@ -1336,399 +7815,282 @@ proto.pulumirpc.ConfigureResponse.serializeBinaryToWriter = function(message, wr
 
 
 /**
- * optional bool acceptSecrets = 1;
- * @return {boolean}
- */
-proto.pulumirpc.ConfigureResponse.prototype.getAcceptsecrets = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.pulumirpc.ConfigureResponse} returns this
+ * optional string id = 1;
+ * @return {string}
  */
-proto.pulumirpc.ConfigureResponse.prototype.setAcceptsecrets = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
+proto.pulumirpc.UpdateRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * optional bool supportsPreview = 2;
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.pulumirpc.UpdateRequest} returns this
  */
-proto.pulumirpc.ConfigureResponse.prototype.getSupportspreview = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+proto.pulumirpc.UpdateRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.pulumirpc.ConfigureResponse} returns this
+ * optional string urn = 2;
+ * @return {string}
  */
-proto.pulumirpc.ConfigureResponse.prototype.setSupportspreview = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 2, value);
+proto.pulumirpc.UpdateRequest.prototype.getUrn = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * optional bool acceptResources = 3;
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.pulumirpc.UpdateRequest} returns this
  */
-proto.pulumirpc.ConfigureResponse.prototype.getAcceptresources = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+proto.pulumirpc.UpdateRequest.prototype.setUrn = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.pulumirpc.ConfigureResponse} returns this
+ * optional google.protobuf.Struct olds = 3;
+ * @return {?proto.google.protobuf.Struct}
  */
-proto.pulumirpc.ConfigureResponse.prototype.setAcceptresources = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 3, value);
+proto.pulumirpc.UpdateRequest.prototype.getOlds = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 3));
 };
 
 
 /**
- * optional bool acceptOutputs = 4;
- * @return {boolean}
- */
-proto.pulumirpc.ConfigureResponse.prototype.getAcceptoutputs = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.pulumirpc.UpdateRequest} returns this
+*/
+proto.pulumirpc.UpdateRequest.prototype.setOlds = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.pulumirpc.ConfigureResponse} returns this
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.UpdateRequest} returns this
  */
-proto.pulumirpc.ConfigureResponse.prototype.setAcceptoutputs = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 4, value);
+proto.pulumirpc.UpdateRequest.prototype.clearOlds = function() {
+  return this.setOlds(undefined);
 };
 
 
-
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.pulumirpc.ConfigureErrorMissingKeys.repeatedFields_ = [1];
-
+proto.pulumirpc.UpdateRequest.prototype.hasOlds = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
 
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * optional google.protobuf.Struct news = 4;
+ * @return {?proto.google.protobuf.Struct}
  */
-proto.pulumirpc.ConfigureErrorMissingKeys.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.ConfigureErrorMissingKeys.toObject(opt_includeInstance, this);
+proto.pulumirpc.UpdateRequest.prototype.getNews = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 4));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.ConfigureErrorMissingKeys} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.pulumirpc.ConfigureErrorMissingKeys.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    missingkeysList: jspb.Message.toObjectList(msg.getMissingkeysList(),
-    proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey.toObject, includeInstance)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.pulumirpc.UpdateRequest} returns this
+*/
+proto.pulumirpc.UpdateRequest.prototype.setNews = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.ConfigureErrorMissingKeys}
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.UpdateRequest} returns this
  */
-proto.pulumirpc.ConfigureErrorMissingKeys.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.ConfigureErrorMissingKeys;
-  return proto.pulumirpc.ConfigureErrorMissingKeys.deserializeBinaryFromReader(msg, reader);
+proto.pulumirpc.UpdateRequest.prototype.clearNews = function() {
+  return this.setNews(undefined);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.pulumirpc.ConfigureErrorMissingKeys} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.ConfigureErrorMissingKeys}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.pulumirpc.ConfigureErrorMissingKeys.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = new proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey;
-      reader.readMessage(value,proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey.deserializeBinaryFromReader);
-      msg.addMissingkeys(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.pulumirpc.UpdateRequest.prototype.hasNews = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * optional double timeout = 5;
+ * @return {number}
  */
-proto.pulumirpc.ConfigureErrorMissingKeys.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.ConfigureErrorMissingKeys.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.pulumirpc.UpdateRequest.prototype.getTimeout = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 5, 0.0));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.ConfigureErrorMissingKeys} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {number} value
+ * @return {!proto.pulumirpc.UpdateRequest} returns this
  */
-proto.pulumirpc.ConfigureErrorMissingKeys.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getMissingkeysList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      1,
-      f,
-      proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey.serializeBinaryToWriter
-    );
-  }
+proto.pulumirpc.UpdateRequest.prototype.setTimeout = function(value) {
+  return jspb.Message.setProto3FloatField(this, 5, value);
 };
 
 
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * repeated string ignoreChanges = 6;
+ * @return {!Array<string>}
  */
-proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey.toObject(opt_includeInstance, this);
+proto.pulumirpc.UpdateRequest.prototype.getIgnorechangesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 6));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {!Array<string>} value
+ * @return {!proto.pulumirpc.UpdateRequest} returns this
  */
-proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    description: jspb.Message.getFieldWithDefault(msg, 2, "")
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.pulumirpc.UpdateRequest.prototype.setIgnorechangesList = function(value) {
+  return jspb.Message.setField(this, 6, value || []);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey}
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.UpdateRequest} returns this
  */
-proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey;
-  return proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey.deserializeBinaryFromReader(msg, reader);
+proto.pulumirpc.UpdateRequest.prototype.addIgnorechanges = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 6, value, opt_index);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.UpdateRequest} returns this
  */
-proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setDescription(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.pulumirpc.UpdateRequest.prototype.clearIgnorechangesList = function() {
+  return this.setIgnorechangesList([]);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
- */
-proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+ * optional bool preview = 7;
+ * @return {boolean}
+ */
+proto.pulumirpc.UpdateRequest.prototype.getPreview = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 7, false));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {boolean} value
+ * @return {!proto.pulumirpc.UpdateRequest} returns this
  */
-proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getDescription();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
+proto.pulumirpc.UpdateRequest.prototype.setPreview = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 7, value);
 };
 
 
 /**
- * optional string name = 1;
- * @return {string}
+ * optional bytes binaryOlds = 8;
+ * @return {!(string|Uint8Array)}
  */
-proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.pulumirpc.UpdateRequest.prototype.getBinaryolds = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey} returns this
+ * optional bytes binaryOlds = 8;
+ * This is a type-conversion wrapper around `getBinaryolds()`
+ * @return {string}
  */
-proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.pulumirpc.UpdateRequest.prototype.getBinaryolds_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getBinaryolds()));
 };
 
 
 /**
- * optional string description = 2;
- * @return {string}
+ * optional bytes binaryOlds = 8;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getBinaryolds()`
+ * @return {!Uint8Array}
  */
-proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey.prototype.getDescription = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.pulumirpc.UpdateRequest.prototype.getBinaryolds_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getBinaryolds()));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey} returns this
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.pulumirpc.UpdateRequest} returns this
  */
-proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey.prototype.setDescription = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.pulumirpc.UpdateRequest.prototype.setBinaryolds = function(value) {
+  return jspb.Message.setProto3BytesField(this, 8, value);
 };
 
 
 /**
- * repeated MissingKey missingKeys = 1;
- * @return {!Array<!proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey>}
+ * optional bytes binaryNews = 9;
+ * @return {!(string|Uint8Array)}
  */
-proto.pulumirpc.ConfigureErrorMissingKeys.prototype.getMissingkeysList = function() {
-  return /** @type{!Array<!proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey, 1));
+proto.pulumirpc.UpdateRequest.prototype.getBinarynews = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
 };
 
 
 /**
- * @param {!Array<!proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey>} value
- * @return {!proto.pulumirpc.ConfigureErrorMissingKeys} returns this
-*/
-proto.pulumirpc.ConfigureErrorMissingKeys.prototype.setMissingkeysList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * optional bytes binaryNews = 9;
+ * This is a type-conversion wrapper around `getBinarynews()`
+ * @return {string}
+ */
+proto.pulumirpc.UpdateRequest.prototype.getBinarynews_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getBinarynews()));
 };
 
 
 /**
- * @param {!proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey=} opt_value
- * @param {number=} opt_index
- * @return {!proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey}
+ * optional bytes binaryNews = 9;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getBinarynews()`
+ * @return {!Uint8Array}
  */
-proto.pulumirpc.ConfigureErrorMissingKeys.prototype.addMissingkeys = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.pulumirpc.ConfigureErrorMissingKeys.MissingKey, opt_index);
+proto.pulumirpc.UpdateRequest.prototype.getBinarynews_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getBinarynews()));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.pulumirpc.ConfigureErrorMissingKeys} returns this
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.pulumirpc.UpdateRequest} returns this
  */
-proto.pulumirpc.ConfigureErrorMissingKeys.prototype.clearMissingkeysList = function() {
-  return this.setMissingkeysList([]);
+proto.pulumirpc.UpdateRequest.prototype.setBinarynews = function(value) {
+  return jspb.Message.setProto3BytesField(this, 9, value);
 };
 
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.pulumirpc.UpdateResponse.repeatedFields_ = [3];
+
+
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
@@ -1743,8 +8105,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pulumirpc.InvokeRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.InvokeRequest.toObject(opt_includeInstance, this);
+proto.pulumirpc.UpdateResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.UpdateResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -1753,14 +8115,16 @@ proto.pulumirpc.InvokeRequest.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.InvokeRequest} msg The msg instance to transform.
+ * @param {!proto.pulumirpc.UpdateResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.InvokeRequest.toObject = function(includeInstance, msg) {
+proto.pulumirpc.UpdateResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    tok: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    args: (f = msg.getArgs()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+    properties: (f = msg.getProperties()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
+    binaryproperties: msg.getBinaryproperties_asB64(),
+    diagnosticsList: jspb.Message.toObjectList(msg.getDiagnosticsList(),
+    proto.pulumirpc.ProviderDiagnostic.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -1774,23 +8138,23 @@ proto.pulumirpc.InvokeRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.InvokeRequest}
+ * @return {!proto.pulumirpc.UpdateResponse}
  */
-proto.pulumirpc.InvokeRequest.deserializeBinary = function(bytes) {
+proto.pulumirpc.UpdateResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.InvokeRequest;
-  return proto.pulumirpc.InvokeRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pulumirpc.UpdateResponse;
+  return proto.pulumirpc.UpdateResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pulumirpc.InvokeRequest} msg The message object to deserialize into.
+ * @param {!proto.pulumirpc.UpdateResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.InvokeRequest}
+ * @return {!proto.pulumirpc.UpdateResponse}
  */
-proto.pulumirpc.InvokeRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.pulumirpc.UpdateResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1798,13 +8162,18 @@ proto.pulumirpc.InvokeRequest.deserializeBinaryFromReader = function(msg, reader
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setTok(value);
-      break;
-    case 2:
       var value = new google_protobuf_struct_pb.Struct;
       reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setArgs(value);
+      msg.setProperties(value);
+      break;
+    case 2:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setBinaryproperties(value);
+      break;
+    case 3:
+      var value = new proto.pulumirpc.ProviderDiagnostic;
+      reader.readMessage(value,proto.pulumirpc.ProviderDiagnostic.deserializeBinaryFromReader);
+      msg.addDiagnostics(value);
       break;
     default:
       reader.skipField();
@@ -1819,9 +8188,9 @@ proto.pulumirpc.InvokeRequest.deserializeBinaryFromReader = function(msg, reader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pulumirpc.InvokeRequest.prototype.serializeBinary = function() {
+proto.pulumirpc.UpdateResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.InvokeRequest.serializeBinaryToWriter(this, writer);
+  proto.pulumirpc.UpdateResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1829,92 +8198,155 @@ proto.pulumirpc.InvokeRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.InvokeRequest} message
+ * @param {!proto.pulumirpc.UpdateResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.InvokeRequest.serializeBinaryToWriter = function(message, writer) {
+proto.pulumirpc.UpdateResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getTok();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getProperties();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
     );
   }
-  f = message.getArgs();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getBinaryproperties_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
       2,
+      f
+    );
+  }
+  f = message.getDiagnosticsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
       f,
-      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+      proto.pulumirpc.ProviderDiagnostic.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string tok = 1;
- * @return {string}
+ * optional google.protobuf.Struct properties = 1;
+ * @return {?proto.google.protobuf.Struct}
  */
-proto.pulumirpc.InvokeRequest.prototype.getTok = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.pulumirpc.UpdateResponse.prototype.getProperties = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.pulumirpc.InvokeRequest} returns this
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.pulumirpc.UpdateResponse} returns this
+*/
+proto.pulumirpc.UpdateResponse.prototype.setProperties = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.UpdateResponse} returns this
  */
-proto.pulumirpc.InvokeRequest.prototype.setTok = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.pulumirpc.UpdateResponse.prototype.clearProperties = function() {
+  return this.setProperties(undefined);
 };
 
 
 /**
- * optional google.protobuf.Struct args = 2;
- * @return {?proto.google.protobuf.Struct}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.pulumirpc.InvokeRequest.prototype.getArgs = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 2));
+proto.pulumirpc.UpdateResponse.prototype.hasProperties = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.pulumirpc.InvokeRequest} returns this
-*/
-proto.pulumirpc.InvokeRequest.prototype.setArgs = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
+ * optional bytes binaryProperties = 2;
+ * @return {!(string|Uint8Array)}
+ */
+proto.pulumirpc.UpdateResponse.prototype.getBinaryproperties = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.pulumirpc.InvokeRequest} returns this
+ * optional bytes binaryProperties = 2;
+ * This is a type-conversion wrapper around `getBinaryproperties()`
+ * @return {string}
  */
-proto.pulumirpc.InvokeRequest.prototype.clearArgs = function() {
-  return this.setArgs(undefined);
+proto.pulumirpc.UpdateResponse.prototype.getBinaryproperties_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getBinaryproperties()));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * optional bytes binaryProperties = 2;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getBinaryproperties()`
+ * @return {!Uint8Array}
  */
-proto.pulumirpc.InvokeRequest.prototype.hasArgs = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.pulumirpc.UpdateResponse.prototype.getBinaryproperties_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getBinaryproperties()));
 };
 
 
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.pulumirpc.UpdateResponse} returns this
+ */
+proto.pulumirpc.UpdateResponse.prototype.setBinaryproperties = function(value) {
+  return jspb.Message.setProto3BytesField(this, 2, value);
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * repeated ProviderDiagnostic diagnostics = 3;
+ * @return {!Array<!proto.pulumirpc.ProviderDiagnostic>}
  */
-proto.pulumirpc.InvokeResponse.repeatedFields_ = [2];
+proto.pulumirpc.UpdateResponse.prototype.getDiagnosticsList = function() {
+  return /** @type{!Array<!proto.pulumirpc.ProviderDiagnostic>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.pulumirpc.ProviderDiagnostic, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.pulumirpc.ProviderDiagnostic>} value
+ * @return {!proto.pulumirpc.UpdateResponse} returns this
+*/
+proto.pulumirpc.UpdateResponse.prototype.setDiagnosticsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.pulumirpc.ProviderDiagnostic=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.ProviderDiagnostic}
+ */
+proto.pulumirpc.UpdateResponse.prototype.addDiagnostics = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.pulumirpc.ProviderDiagnostic, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.UpdateResponse} returns this
+ */
+proto.pulumirpc.UpdateResponse.prototype.clearDiagnosticsList = function() {
+  return this.setDiagnosticsList([]);
+};
+
+
 
 
 
@@ -1931,8 +8363,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pulumirpc.InvokeResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.InvokeResponse.toObject(opt_includeInstance, this);
+proto.pulumirpc.DeleteRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.DeleteRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -1941,15 +8373,16 @@ proto.pulumirpc.InvokeResponse.prototype.toObject = function(opt_includeInstance
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.InvokeResponse} msg The msg instance to transform.
+ * @param {!proto.pulumirpc.DeleteRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.InvokeResponse.toObject = function(includeInstance, msg) {
+proto.pulumirpc.DeleteRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    pb_return: (f = msg.getReturn()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
-    failuresList: jspb.Message.toObjectList(msg.getFailuresList(),
-    proto.pulumirpc.CheckFailure.toObject, includeInstance)
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    urn: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    properties: (f = msg.getProperties()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
+    timeout: jspb.Message.getFloatingPointFieldWithDefault(msg, 4, 0.0)
   };
 
   if (includeInstance) {
@@ -1963,23 +8396,23 @@ proto.pulumirpc.InvokeResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.InvokeResponse}
+ * @return {!proto.pulumirpc.DeleteRequest}
  */
-proto.pulumirpc.InvokeResponse.deserializeBinary = function(bytes) {
+proto.pulumirpc.DeleteRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.InvokeResponse;
-  return proto.pulumirpc.InvokeResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pulumirpc.DeleteRequest;
+  return proto.pulumirpc.DeleteRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pulumirpc.InvokeResponse} msg The message object to deserialize into.
+ * @param {!proto.pulumirpc.DeleteRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.InvokeResponse}
+ * @return {!proto.pulumirpc.DeleteRequest}
  */
-proto.pulumirpc.InvokeResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.pulumirpc.DeleteRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1987,14 +8420,21 @@ proto.pulumirpc.InvokeResponse.deserializeBinaryFromReader = function(msg, reade
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUrn(value);
+      break;
+    case 3:
       var value = new google_protobuf_struct_pb.Struct;
       reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setReturn(value);
+      msg.setProperties(value);
       break;
-    case 2:
-      var value = new proto.pulumirpc.CheckFailure;
-      reader.readMessage(value,proto.pulumirpc.CheckFailure.deserializeBinaryFromReader);
-      msg.addFailures(value);
+    case 4:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setTimeout(value);
       break;
     default:
       reader.skipField();
@@ -2009,9 +8449,9 @@ proto.pulumirpc.InvokeResponse.deserializeBinaryFromReader = function(msg, reade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pulumirpc.InvokeResponse.prototype.serializeBinary = function() {
+proto.pulumirpc.DeleteRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.InvokeResponse.serializeBinaryToWriter(this, writer);
+  proto.pulumirpc.DeleteRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2019,103 +8459,132 @@ proto.pulumirpc.InvokeResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.InvokeResponse} message
+ * @param {!proto.pulumirpc.DeleteRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.InvokeResponse.serializeBinaryToWriter = function(message, writer) {
+proto.pulumirpc.DeleteRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getReturn();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
       1,
-      f,
-      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getFailuresList();
+  f = message.getUrn();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       2,
+      f
+    );
+  }
+  f = message.getProperties();
+  if (f != null) {
+    writer.writeMessage(
+      3,
       f,
-      proto.pulumirpc.CheckFailure.serializeBinaryToWriter
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+  f = message.getTimeout();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      4,
+      f
     );
   }
 };
 
 
 /**
- * optional google.protobuf.Struct return = 1;
- * @return {?proto.google.protobuf.Struct}
+ * optional string id = 1;
+ * @return {string}
  */
-proto.pulumirpc.InvokeResponse.prototype.getReturn = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 1));
+proto.pulumirpc.DeleteRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.pulumirpc.InvokeResponse} returns this
-*/
-proto.pulumirpc.InvokeResponse.prototype.setReturn = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+ * @param {string} value
+ * @return {!proto.pulumirpc.DeleteRequest} returns this
+ */
+proto.pulumirpc.DeleteRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.pulumirpc.InvokeResponse} returns this
+ * optional string urn = 2;
+ * @return {string}
  */
-proto.pulumirpc.InvokeResponse.prototype.clearReturn = function() {
-  return this.setReturn(undefined);
+proto.pulumirpc.DeleteRequest.prototype.getUrn = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.pulumirpc.DeleteRequest} returns this
  */
-proto.pulumirpc.InvokeResponse.prototype.hasReturn = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.pulumirpc.DeleteRequest.prototype.setUrn = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * repeated CheckFailure failures = 2;
- * @return {!Array<!proto.pulumirpc.CheckFailure>}
+ * optional google.protobuf.Struct properties = 3;
+ * @return {?proto.google.protobuf.Struct}
  */
-proto.pulumirpc.InvokeResponse.prototype.getFailuresList = function() {
-  return /** @type{!Array<!proto.pulumirpc.CheckFailure>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.pulumirpc.CheckFailure, 2));
+proto.pulumirpc.DeleteRequest.prototype.getProperties = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 3));
 };
 
 
 /**
- * @param {!Array<!proto.pulumirpc.CheckFailure>} value
- * @return {!proto.pulumirpc.InvokeResponse} returns this
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.pulumirpc.DeleteRequest} returns this
 */
-proto.pulumirpc.InvokeResponse.prototype.setFailuresList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+proto.pulumirpc.DeleteRequest.prototype.setProperties = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
 };
 
 
 /**
- * @param {!proto.pulumirpc.CheckFailure=} opt_value
- * @param {number=} opt_index
- * @return {!proto.pulumirpc.CheckFailure}
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.DeleteRequest} returns this
  */
-proto.pulumirpc.InvokeResponse.prototype.addFailures = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.pulumirpc.CheckFailure, opt_index);
+proto.pulumirpc.DeleteRequest.prototype.clearProperties = function() {
+  return this.setProperties(undefined);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.pulumirpc.InvokeResponse} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.pulumirpc.InvokeResponse.prototype.clearFailuresList = function() {
-  return this.setFailuresList([]);
+proto.pulumirpc.DeleteRequest.prototype.hasProperties = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional double timeout = 4;
+ * @return {number}
+ */
+proto.pulumirpc.DeleteRequest.prototype.getTimeout = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 4, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.pulumirpc.DeleteRequest} returns this
+ */
+proto.pulumirpc.DeleteRequest.prototype.setTimeout = function(value) {
+  return jspb.Message.setProto3FloatField(this, 4, value);
 };
 
 
@@ -2125,7 +8594,7 @@ proto.pulumirpc.InvokeResponse.prototype.clearFailuresList = function() {
  * @private {!Array<number>}
  * @const
  */
-proto.pulumirpc.CallRequest.repeatedFields_ = [9];
+proto.pulumirpc.ConstructRequest.repeatedFields_ = [14,15,16,21];
 
 
 
@@ -2142,8 +8611,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pulumirpc.CallRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.CallRequest.toObject(opt_includeInstance, this);
+proto.pulumirpc.ConstructRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.ConstructRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -2152,26 +8621,36 @@ proto.pulumirpc.CallRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.CallRequest} msg The msg instance to transform.
+ * @param {!proto.pulumirpc.ConstructRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.CallRequest.toObject = function(includeInstance, msg) {
+proto.pulumirpc.ConstructRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    tok: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    args: (f = msg.getArgs()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
-    argdependenciesMap: (f = msg.getArgdependenciesMap()) ? f.toObject(includeInstance, proto.pulumirpc.CallRequest.ArgumentDependencies.toObject) : [],
-    provider: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    version: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    plugindownloadurl: jspb.Message.getFieldWithDefault(msg, 13, ""),
-    project: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    stack: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    project: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    stack: jspb.Message.getFieldWithDefault(msg, 2, ""),
     configMap: (f = msg.getConfigMap()) ? f.toObject(includeInstance, undefined) : [],
-    configsecretkeysList: (f = jspb.Message.getRepeatedField(msg, 9)) == null ? undefined : f,
-    dryrun: jspb.Message.getBooleanFieldWithDefault(msg, 10, false),
-    parallel: jspb.Message.getFieldWithDefault(msg, 11, 0),
-    monitorendpoint: jspb.Message.getFieldWithDefault(msg, 12, ""),
-    organization: jspb.Message.getFieldWithDefault(msg, 14, "")
+    dryrun: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
+    parallel: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    monitorendpoint: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    type: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 8, ""),
+    parent: jspb.Message.getFieldWithDefault(msg, 9, ""),
+    inputs: (f = msg.getInputs()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
+    inputdependenciesMap: (f = msg.getInputdependenciesMap()) ? f.toObject(includeInstance, proto.pulumirpc.ConstructRequest.PropertyDependencies.toObject) : [],
+    protect: jspb.Message.getBooleanFieldWithDefault(msg, 12, false),
+    providersMap: (f = msg.getProvidersMap()) ? f.toObject(includeInstance, undefined) : [],
+    aliasesList: (f = jspb.Message.getRepeatedField(msg, 14)) == null ? undefined : f,
+    dependenciesList: (f = jspb.Message.getRepeatedField(msg, 15)) == null ? undefined : f,
+    configsecretkeysList: (f = jspb.Message.getRepeatedField(msg, 16)) == null ? undefined : f,
+    organization: jspb.Message.getFieldWithDefault(msg, 17, ""),
+    customtimeouts: (f = msg.getCustomtimeouts()) && proto.pulumirpc.ConstructRequest.CustomTimeouts.toObject(includeInstance, f),
+    sdkversion: jspb.Message.getFieldWithDefault(msg, 19, ""),
+    engineversion: jspb.Message.getFieldWithDefault(msg, 20, ""),
+    ignorechangesList: (f = jspb.Message.getRepeatedField(msg, 21)) == null ? undefined : f,
+    traceid: jspb.Message.getFieldWithDefault(msg, 22, ""),
+    spanid: jspb.Message.getFieldWithDefault(msg, 23, ""),
+    namespace: jspb.Message.getFieldWithDefault(msg, 24, "")
   };
 
   if (includeInstance) {
@@ -2185,23 +8664,23 @@ proto.pulumirpc.CallRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.CallRequest}
+ * @return {!proto.pulumirpc.ConstructRequest}
  */
-proto.pulumirpc.CallRequest.deserializeBinary = function(bytes) {
+proto.pulumirpc.ConstructRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.CallRequest;
-  return proto.pulumirpc.CallRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pulumirpc.ConstructRequest;
+  return proto.pulumirpc.ConstructRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pulumirpc.CallRequest} msg The message object to deserialize into.
+ * @param {!proto.pulumirpc.ConstructRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.CallRequest}
+ * @return {!proto.pulumirpc.ConstructRequest}
  */
-proto.pulumirpc.CallRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.pulumirpc.ConstructRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2210,64 +8689,107 @@ proto.pulumirpc.CallRequest.deserializeBinaryFromReader = function(msg, reader)
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setTok(value);
+      msg.setProject(value);
       break;
     case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStack(value);
+      break;
+    case 3:
+      var value = msg.getConfigMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setDryrun(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setParallel(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMonitorendpoint(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setType(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setParent(value);
+      break;
+    case 10:
       var value = new google_protobuf_struct_pb.Struct;
       reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setArgs(value);
+      msg.setInputs(value);
       break;
-    case 3:
-      var value = msg.getArgdependenciesMap();
+    case 11:
+      var value = msg.getInputdependenciesMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.pulumirpc.ConstructRequest.PropertyDependencies.deserializeBinaryFromReader, "", new proto.pulumirpc.ConstructRequest.PropertyDependencies());
+         });
+      break;
+    case 12:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setProtect(value);
+      break;
+    case 13:
+      var value = msg.getProvidersMap();
       reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.pulumirpc.CallRequest.ArgumentDependencies.deserializeBinaryFromReader, "", new proto.pulumirpc.CallRequest.ArgumentDependencies());
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
          });
       break;
-    case 4:
+    case 14:
       var value = /** @type {string} */ (reader.readString());
-      msg.setProvider(value);
+      msg.addAliases(value);
       break;
-    case 5:
+    case 15:
       var value = /** @type {string} */ (reader.readString());
-      msg.setVersion(value);
+      msg.addDependencies(value);
       break;
-    case 13:
+    case 16:
       var value = /** @type {string} */ (reader.readString());
-      msg.setPlugindownloadurl(value);
+      msg.addConfigsecretkeys(value);
       break;
-    case 6:
+    case 17:
       var value = /** @type {string} */ (reader.readString());
-      msg.setProject(value);
+      msg.setOrganization(value);
       break;
-    case 7:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setStack(value);
+    case 18:
+      var value = new proto.pulumirpc.ConstructRequest.CustomTimeouts;
+      reader.readMessage(value,proto.pulumirpc.ConstructRequest.CustomTimeouts.deserializeBinaryFromReader);
+      msg.setCustomtimeouts(value);
       break;
-    case 8:
-      var value = msg.getConfigMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
-         });
+    case 19:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSdkversion(value);
       break;
-    case 9:
+    case 20:
       var value = /** @type {string} */ (reader.readString());
-      msg.addConfigsecretkeys(value);
+      msg.setEngineversion(value);
       break;
-    case 10:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setDryrun(value);
+    case 21:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addIgnorechanges(value);
       break;
-    case 11:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setParallel(value);
+    case 22:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTraceid(value);
       break;
-    case 12:
+    case 23:
       var value = /** @type {string} */ (reader.readString());
-      msg.setMonitorendpoint(value);
+      msg.setSpanid(value);
       break;
-    case 14:
+    case 24:
       var value = /** @type {string} */ (reader.readString());
-      msg.setOrganization(value);
+      msg.setNamespace(value);
       break;
     default:
       reader.skipField();
@@ -2282,9 +8804,9 @@ proto.pulumirpc.CallRequest.deserializeBinaryFromReader = function(msg, reader)
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pulumirpc.CallRequest.prototype.serializeBinary = function() {
+proto.pulumirpc.ConstructRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.CallRequest.serializeBinaryToWriter(this, writer);
+  proto.pulumirpc.ConstructRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2292,102 +8814,170 @@ proto.pulumirpc.CallRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.CallRequest} message
+ * @param {!proto.pulumirpc.ConstructRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.CallRequest.serializeBinaryToWriter = function(message, writer) {
+proto.pulumirpc.ConstructRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getTok();
+  f = message.getProject();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getArgs();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getStack();
+  if (f.length > 0) {
+    writer.writeString(
       2,
-      f,
-      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getArgdependenciesMap(true);
+  f = message.getConfigMap(true);
   if (f && f.getLength() > 0) {
-    f.serializeBinary(3, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.pulumirpc.CallRequest.ArgumentDependencies.serializeBinaryToWriter);
+    f.serializeBinary(3, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
   }
-  f = message.getProvider();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getDryrun();
+  if (f) {
+    writer.writeBool(
       4,
       f
     );
   }
-  f = message.getVersion();
+  f = message.getParallel();
+  if (f !== 0) {
+    writer.writeInt32(
+      5,
+      f
+    );
+  }
+  f = message.getMonitorendpoint();
   if (f.length > 0) {
     writer.writeString(
-      5,
+      6,
       f
     );
   }
-  f = message.getPlugindownloadurl();
+  f = message.getType();
   if (f.length > 0) {
     writer.writeString(
-      13,
+      7,
       f
     );
   }
-  f = message.getProject();
+  f = message.getName();
   if (f.length > 0) {
     writer.writeString(
-      6,
+      8,
       f
     );
   }
-  f = message.getStack();
+  f = message.getParent();
   if (f.length > 0) {
     writer.writeString(
-      7,
+      9,
       f
     );
   }
-  f = message.getConfigMap(true);
+  f = message.getInputs();
+  if (f != null) {
+    writer.writeMessage(
+      10,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+  f = message.getInputdependenciesMap(true);
   if (f && f.getLength() > 0) {
-    f.serializeBinary(8, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+    f.serializeBinary(11, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.pulumirpc.ConstructRequest.PropertyDependencies.serializeBinaryToWriter);
+  }
+  f = message.getProtect();
+  if (f) {
+    writer.writeBool(
+      12,
+      f
+    );
+  }
+  f = message.getProvidersMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(13, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+  f = message.getAliasesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      14,
+      f
+    );
+  }
+  f = message.getDependenciesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      15,
+      f
+    );
   }
   f = message.getConfigsecretkeysList();
   if (f.length > 0) {
     writer.writeRepeatedString(
-      9,
+      16,
       f
     );
   }
-  f = message.getDryrun();
-  if (f) {
-    writer.writeBool(
-      10,
+  f = message.getOrganization();
+  if (f.length > 0) {
+    writer.writeString(
+      17,
       f
     );
   }
-  f = message.getParallel();
-  if (f !== 0) {
-    writer.writeInt32(
-      11,
+  f = message.getCustomtimeouts();
+  if (f != null) {
+    writer.writeMessage(
+      18,
+      f,
+      proto.pulumirpc.ConstructRequest.CustomTimeouts.serializeBinaryToWriter
+    );
+  }
+  f = message.getSdkversion();
+  if (f.length > 0) {
+    writer.writeString(
+      19,
       f
     );
   }
-  f = message.getMonitorendpoint();
+  f = message.getEngineversion();
   if (f.length > 0) {
     writer.writeString(
-      12,
+      20,
       f
     );
   }
-  f = message.getOrganization();
+  f = message.getIgnorechangesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      21,
+      f
+    );
+  }
+  f = message.getTraceid();
   if (f.length > 0) {
     writer.writeString(
-      14,
+      22,
+      f
+    );
+  }
+  f = message.getSpanid();
+  if (f.length > 0) {
+    writer.writeString(
+      23,
+      f
+    );
+  }
+  f = message.getNamespace();
+  if (f.length > 0) {
+    writer.writeString(
+      24,
       f
     );
   }
@@ -2400,7 +8990,7 @@ proto.pulumirpc.CallRequest.serializeBinaryToWriter = function(message, writer)
  * @private {!Array<number>}
  * @const
  */
-proto.pulumirpc.CallRequest.ArgumentDependencies.repeatedFields_ = [1];
+proto.pulumirpc.ConstructRequest.PropertyDependencies.repeatedFields_ = [1];
 
 
 
@@ -2417,8 +9007,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pulumirpc.CallRequest.ArgumentDependencies.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.CallRequest.ArgumentDependencies.toObject(opt_includeInstance, this);
+proto.pulumirpc.ConstructRequest.PropertyDependencies.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.ConstructRequest.PropertyDependencies.toObject(opt_includeInstance, this);
 };
 
 
@@ -2427,11 +9017,11 @@ proto.pulumirpc.CallRequest.ArgumentDependencies.prototype.toObject = function(o
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.CallRequest.ArgumentDependencies} msg The msg instance to transform.
+ * @param {!proto.pulumirpc.ConstructRequest.PropertyDependencies} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.CallRequest.ArgumentDependencies.toObject = function(includeInstance, msg) {
+proto.pulumirpc.ConstructRequest.PropertyDependencies.toObject = function(includeInstance, msg) {
   var f, obj = {
     urnsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
   };
@@ -2447,23 +9037,23 @@ proto.pulumirpc.CallRequest.ArgumentDependencies.toObject = function(includeInst
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.CallRequest.ArgumentDependencies}
+ * @return {!proto.pulumirpc.ConstructRequest.PropertyDependencies}
  */
-proto.pulumirpc.CallRequest.ArgumentDependencies.deserializeBinary = function(bytes) {
+proto.pulumirpc.ConstructRequest.PropertyDependencies.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.CallRequest.ArgumentDependencies;
-  return proto.pulumirpc.CallRequest.ArgumentDependencies.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pulumirpc.ConstructRequest.PropertyDependencies;
+  return proto.pulumirpc.ConstructRequest.PropertyDependencies.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pulumirpc.CallRequest.ArgumentDependencies} msg The message object to deserialize into.
+ * @param {!proto.pulumirpc.ConstructRequest.PropertyDependencies} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.CallRequest.ArgumentDependencies}
+ * @return {!proto.pulumirpc.ConstructRequest.PropertyDependencies}
  */
-proto.pulumirpc.CallRequest.ArgumentDependencies.deserializeBinaryFromReader = function(msg, reader) {
+proto.pulumirpc.ConstructRequest.PropertyDependencies.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2487,9 +9077,9 @@ proto.pulumirpc.CallRequest.ArgumentDependencies.deserializeBinaryFromReader = f
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pulumirpc.CallRequest.ArgumentDependencies.prototype.serializeBinary = function() {
+proto.pulumirpc.ConstructRequest.PropertyDependencies.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.CallRequest.ArgumentDependencies.serializeBinaryToWriter(this, writer);
+  proto.pulumirpc.ConstructRequest.PropertyDependencies.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2497,11 +9087,11 @@ proto.pulumirpc.CallRequest.ArgumentDependencies.prototype.serializeBinary = fun
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.CallRequest.ArgumentDependencies} message
+ * @param {!proto.pulumirpc.ConstructRequest.PropertyDependencies} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.CallRequest.ArgumentDependencies.serializeBinaryToWriter = function(message, writer) {
+proto.pulumirpc.ConstructRequest.PropertyDependencies.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getUrnsList();
   if (f.length > 0) {
@@ -2517,16 +9107,16 @@ proto.pulumirpc.CallRequest.ArgumentDependencies.serializeBinaryToWriter = funct
  * repeated string urns = 1;
  * @return {!Array<string>}
  */
-proto.pulumirpc.CallRequest.ArgumentDependencies.prototype.getUrnsList = function() {
+proto.pulumirpc.ConstructRequest.PropertyDependencies.prototype.getUrnsList = function() {
   return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
 
 /**
  * @param {!Array<string>} value
- * @return {!proto.pulumirpc.CallRequest.ArgumentDependencies} returns this
+ * @return {!proto.pulumirpc.ConstructRequest.PropertyDependencies} returns this
  */
-proto.pulumirpc.CallRequest.ArgumentDependencies.prototype.setUrnsList = function(value) {
+proto.pulumirpc.ConstructRequest.PropertyDependencies.prototype.setUrnsList = function(value) {
   return jspb.Message.setField(this, 1, value || []);
 };
 
@@ -2534,1003 +9124,767 @@ proto.pulumirpc.CallRequest.ArgumentDependencies.prototype.setUrnsList = functio
 /**
  * @param {string} value
  * @param {number=} opt_index
- * @return {!proto.pulumirpc.CallRequest.ArgumentDependencies} returns this
+ * @return {!proto.pulumirpc.ConstructRequest.PropertyDependencies} returns this
  */
-proto.pulumirpc.CallRequest.ArgumentDependencies.prototype.addUrns = function(value, opt_index) {
+proto.pulumirpc.ConstructRequest.PropertyDependencies.prototype.addUrns = function(value, opt_index) {
   return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.pulumirpc.CallRequest.ArgumentDependencies} returns this
+ * @return {!proto.pulumirpc.ConstructRequest.PropertyDependencies} returns this
  */
-proto.pulumirpc.CallRequest.ArgumentDependencies.prototype.clearUrnsList = function() {
+proto.pulumirpc.ConstructRequest.PropertyDependencies.prototype.clearUrnsList = function() {
   return this.setUrnsList([]);
 };
 
 
-/**
- * optional string tok = 1;
- * @return {string}
- */
-proto.pulumirpc.CallRequest.prototype.getTok = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
 
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * @param {string} value
- * @return {!proto.pulumirpc.CallRequest} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.pulumirpc.CallRequest.prototype.setTok = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.pulumirpc.ConstructRequest.CustomTimeouts.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.ConstructRequest.CustomTimeouts.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * optional google.protobuf.Struct args = 2;
- * @return {?proto.google.protobuf.Struct}
- */
-proto.pulumirpc.CallRequest.prototype.getArgs = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 2));
-};
-
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.ConstructRequest.CustomTimeouts} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.ConstructRequest.CustomTimeouts.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    create: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    update: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    delete: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
 
-/**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.pulumirpc.CallRequest} returns this
-*/
-proto.pulumirpc.CallRequest.prototype.setArgs = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.pulumirpc.CallRequest} returns this
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.ConstructRequest.CustomTimeouts}
  */
-proto.pulumirpc.CallRequest.prototype.clearArgs = function() {
-  return this.setArgs(undefined);
+proto.pulumirpc.ConstructRequest.CustomTimeouts.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.ConstructRequest.CustomTimeouts;
+  return proto.pulumirpc.ConstructRequest.CustomTimeouts.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.ConstructRequest.CustomTimeouts} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.ConstructRequest.CustomTimeouts}
  */
-proto.pulumirpc.CallRequest.prototype.hasArgs = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.pulumirpc.ConstructRequest.CustomTimeouts.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCreate(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUpdate(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDelete(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * map<string, ArgumentDependencies> argDependencies = 3;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.pulumirpc.CallRequest.ArgumentDependencies>}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.pulumirpc.CallRequest.prototype.getArgdependenciesMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.pulumirpc.CallRequest.ArgumentDependencies>} */ (
-      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
-      proto.pulumirpc.CallRequest.ArgumentDependencies));
+proto.pulumirpc.ConstructRequest.CustomTimeouts.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.ConstructRequest.CustomTimeouts.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.pulumirpc.CallRequest} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.ConstructRequest.CustomTimeouts} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.CallRequest.prototype.clearArgdependenciesMap = function() {
-  this.getArgdependenciesMap().clear();
-  return this;};
+proto.pulumirpc.ConstructRequest.CustomTimeouts.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCreate();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getUpdate();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getDelete();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+};
 
 
 /**
- * optional string provider = 4;
+ * optional string create = 1;
  * @return {string}
  */
-proto.pulumirpc.CallRequest.prototype.getProvider = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.pulumirpc.ConstructRequest.CustomTimeouts.prototype.getCreate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.pulumirpc.CallRequest} returns this
+ * @return {!proto.pulumirpc.ConstructRequest.CustomTimeouts} returns this
  */
-proto.pulumirpc.CallRequest.prototype.setProvider = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+proto.pulumirpc.ConstructRequest.CustomTimeouts.prototype.setCreate = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string version = 5;
+ * optional string update = 2;
  * @return {string}
  */
-proto.pulumirpc.CallRequest.prototype.getVersion = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+proto.pulumirpc.ConstructRequest.CustomTimeouts.prototype.getUpdate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.pulumirpc.CallRequest} returns this
+ * @return {!proto.pulumirpc.ConstructRequest.CustomTimeouts} returns this
  */
-proto.pulumirpc.CallRequest.prototype.setVersion = function(value) {
-  return jspb.Message.setProto3StringField(this, 5, value);
+proto.pulumirpc.ConstructRequest.CustomTimeouts.prototype.setUpdate = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional string pluginDownloadURL = 13;
+ * optional string delete = 3;
  * @return {string}
  */
-proto.pulumirpc.CallRequest.prototype.getPlugindownloadurl = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 13, ""));
+proto.pulumirpc.ConstructRequest.CustomTimeouts.prototype.getDelete = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.pulumirpc.CallRequest} returns this
+ * @return {!proto.pulumirpc.ConstructRequest.CustomTimeouts} returns this
  */
-proto.pulumirpc.CallRequest.prototype.setPlugindownloadurl = function(value) {
-  return jspb.Message.setProto3StringField(this, 13, value);
+proto.pulumirpc.ConstructRequest.CustomTimeouts.prototype.setDelete = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * optional string project = 6;
+ * optional string project = 1;
  * @return {string}
  */
-proto.pulumirpc.CallRequest.prototype.getProject = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+proto.pulumirpc.ConstructRequest.prototype.getProject = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.pulumirpc.CallRequest} returns this
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
  */
-proto.pulumirpc.CallRequest.prototype.setProject = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
+proto.pulumirpc.ConstructRequest.prototype.setProject = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string stack = 7;
+ * optional string stack = 2;
  * @return {string}
  */
-proto.pulumirpc.CallRequest.prototype.getStack = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+proto.pulumirpc.ConstructRequest.prototype.getStack = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.pulumirpc.CallRequest} returns this
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
  */
-proto.pulumirpc.CallRequest.prototype.setStack = function(value) {
-  return jspb.Message.setProto3StringField(this, 7, value);
+proto.pulumirpc.ConstructRequest.prototype.setStack = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * map<string, string> config = 8;
+ * map<string, string> config = 3;
  * @param {boolean=} opt_noLazyCreate Do not create the map if
  * empty, instead returning `undefined`
  * @return {!jspb.Map<string,string>}
  */
-proto.pulumirpc.CallRequest.prototype.getConfigMap = function(opt_noLazyCreate) {
+proto.pulumirpc.ConstructRequest.prototype.getConfigMap = function(opt_noLazyCreate) {
   return /** @type {!jspb.Map<string,string>} */ (
-      jspb.Message.getMapField(this, 8, opt_noLazyCreate,
+      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
       null));
 };
 
 
 /**
  * Clears values from the map. The map will be non-null.
- * @return {!proto.pulumirpc.CallRequest} returns this
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
  */
-proto.pulumirpc.CallRequest.prototype.clearConfigMap = function() {
+proto.pulumirpc.ConstructRequest.prototype.clearConfigMap = function() {
   this.getConfigMap().clear();
   return this;};
 
 
 /**
- * repeated string configSecretKeys = 9;
- * @return {!Array<string>}
- */
-proto.pulumirpc.CallRequest.prototype.getConfigsecretkeysList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 9));
-};
-
-
-/**
- * @param {!Array<string>} value
- * @return {!proto.pulumirpc.CallRequest} returns this
- */
-proto.pulumirpc.CallRequest.prototype.setConfigsecretkeysList = function(value) {
-  return jspb.Message.setField(this, 9, value || []);
-};
-
-
-/**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.pulumirpc.CallRequest} returns this
- */
-proto.pulumirpc.CallRequest.prototype.addConfigsecretkeys = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 9, value, opt_index);
-};
-
-
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.pulumirpc.CallRequest} returns this
- */
-proto.pulumirpc.CallRequest.prototype.clearConfigsecretkeysList = function() {
-  return this.setConfigsecretkeysList([]);
-};
-
-
-/**
- * optional bool dryRun = 10;
+ * optional bool dryRun = 4;
  * @return {boolean}
  */
-proto.pulumirpc.CallRequest.prototype.getDryrun = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 10, false));
+proto.pulumirpc.ConstructRequest.prototype.getDryrun = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
 };
 
 
 /**
  * @param {boolean} value
- * @return {!proto.pulumirpc.CallRequest} returns this
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
  */
-proto.pulumirpc.CallRequest.prototype.setDryrun = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 10, value);
+proto.pulumirpc.ConstructRequest.prototype.setDryrun = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
 };
 
 
 /**
- * optional int32 parallel = 11;
+ * optional int32 parallel = 5;
  * @return {number}
  */
-proto.pulumirpc.CallRequest.prototype.getParallel = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
+proto.pulumirpc.ConstructRequest.prototype.getParallel = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.pulumirpc.CallRequest} returns this
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
  */
-proto.pulumirpc.CallRequest.prototype.setParallel = function(value) {
-  return jspb.Message.setProto3IntField(this, 11, value);
+proto.pulumirpc.ConstructRequest.prototype.setParallel = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
 };
 
 
 /**
- * optional string monitorEndpoint = 12;
+ * optional string monitorEndpoint = 6;
  * @return {string}
  */
-proto.pulumirpc.CallRequest.prototype.getMonitorendpoint = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 12, ""));
+proto.pulumirpc.ConstructRequest.prototype.getMonitorendpoint = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.pulumirpc.CallRequest} returns this
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
  */
-proto.pulumirpc.CallRequest.prototype.setMonitorendpoint = function(value) {
-  return jspb.Message.setProto3StringField(this, 12, value);
+proto.pulumirpc.ConstructRequest.prototype.setMonitorendpoint = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
 };
 
 
 /**
- * optional string organization = 14;
+ * optional string type = 7;
  * @return {string}
  */
-proto.pulumirpc.CallRequest.prototype.getOrganization = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 14, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.pulumirpc.CallRequest} returns this
- */
-proto.pulumirpc.CallRequest.prototype.setOrganization = function(value) {
-  return jspb.Message.setProto3StringField(this, 14, value);
+proto.pulumirpc.ConstructRequest.prototype.getType = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
 };
 
 
-
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.pulumirpc.CallResponse.repeatedFields_ = [3];
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * @param {string} value
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
  */
-proto.pulumirpc.CallResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.CallResponse.toObject(opt_includeInstance, this);
+proto.pulumirpc.ConstructRequest.prototype.setType = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.CallResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional string name = 8;
+ * @return {string}
  */
-proto.pulumirpc.CallResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    pb_return: (f = msg.getReturn()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
-    returndependenciesMap: (f = msg.getReturndependenciesMap()) ? f.toObject(includeInstance, proto.pulumirpc.CallResponse.ReturnDependencies.toObject) : [],
-    failuresList: jspb.Message.toObjectList(msg.getFailuresList(),
-    proto.pulumirpc.CheckFailure.toObject, includeInstance)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.pulumirpc.ConstructRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.CallResponse}
+ * @param {string} value
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
  */
-proto.pulumirpc.CallResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.CallResponse;
-  return proto.pulumirpc.CallResponse.deserializeBinaryFromReader(msg, reader);
+proto.pulumirpc.ConstructRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 8, value);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.pulumirpc.CallResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.CallResponse}
+ * optional string parent = 9;
+ * @return {string}
  */
-proto.pulumirpc.CallResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = new google_protobuf_struct_pb.Struct;
-      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setReturn(value);
-      break;
-    case 2:
-      var value = msg.getReturndependenciesMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.pulumirpc.CallResponse.ReturnDependencies.deserializeBinaryFromReader, "", new proto.pulumirpc.CallResponse.ReturnDependencies());
-         });
-      break;
-    case 3:
-      var value = new proto.pulumirpc.CheckFailure;
-      reader.readMessage(value,proto.pulumirpc.CheckFailure.deserializeBinaryFromReader);
-      msg.addFailures(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.pulumirpc.ConstructRequest.prototype.getParent = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * @param {string} value
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
  */
-proto.pulumirpc.CallResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.CallResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.pulumirpc.ConstructRequest.prototype.setParent = function(value) {
+  return jspb.Message.setProto3StringField(this, 9, value);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.CallResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional google.protobuf.Struct inputs = 10;
+ * @return {?proto.google.protobuf.Struct}
  */
-proto.pulumirpc.CallResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getReturn();
-  if (f != null) {
-    writer.writeMessage(
-      1,
-      f,
-      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
-    );
-  }
-  f = message.getReturndependenciesMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.pulumirpc.CallResponse.ReturnDependencies.serializeBinaryToWriter);
-  }
-  f = message.getFailuresList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      3,
-      f,
-      proto.pulumirpc.CheckFailure.serializeBinaryToWriter
-    );
-  }
+proto.pulumirpc.ConstructRequest.prototype.getInputs = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 10));
 };
 
 
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
+*/
+proto.pulumirpc.ConstructRequest.prototype.setInputs = function(value) {
+  return jspb.Message.setWrapperField(this, 10, value);
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
  */
-proto.pulumirpc.CallResponse.ReturnDependencies.repeatedFields_ = [1];
-
+proto.pulumirpc.ConstructRequest.prototype.clearInputs = function() {
+  return this.setInputs(undefined);
+};
 
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.pulumirpc.CallResponse.ReturnDependencies.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.CallResponse.ReturnDependencies.toObject(opt_includeInstance, this);
+proto.pulumirpc.ConstructRequest.prototype.hasInputs = function() {
+  return jspb.Message.getField(this, 10) != null;
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.CallResponse.ReturnDependencies} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * map<string, PropertyDependencies> inputDependencies = 11;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.pulumirpc.ConstructRequest.PropertyDependencies>}
  */
-proto.pulumirpc.CallResponse.ReturnDependencies.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    urnsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.pulumirpc.ConstructRequest.prototype.getInputdependenciesMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.pulumirpc.ConstructRequest.PropertyDependencies>} */ (
+      jspb.Message.getMapField(this, 11, opt_noLazyCreate,
+      proto.pulumirpc.ConstructRequest.PropertyDependencies));
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.CallResponse.ReturnDependencies}
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
  */
-proto.pulumirpc.CallResponse.ReturnDependencies.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.CallResponse.ReturnDependencies;
-  return proto.pulumirpc.CallResponse.ReturnDependencies.deserializeBinaryFromReader(msg, reader);
-};
+proto.pulumirpc.ConstructRequest.prototype.clearInputdependenciesMap = function() {
+  this.getInputdependenciesMap().clear();
+  return this;};
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.pulumirpc.CallResponse.ReturnDependencies} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.CallResponse.ReturnDependencies}
+ * optional bool protect = 12;
+ * @return {boolean}
  */
-proto.pulumirpc.CallResponse.ReturnDependencies.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addUrns(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.pulumirpc.ConstructRequest.prototype.getProtect = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 12, false));
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * @param {boolean} value
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
  */
-proto.pulumirpc.CallResponse.ReturnDependencies.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.CallResponse.ReturnDependencies.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.pulumirpc.ConstructRequest.prototype.setProtect = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 12, value);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.CallResponse.ReturnDependencies} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * map<string, string> providers = 13;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
  */
-proto.pulumirpc.CallResponse.ReturnDependencies.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getUrnsList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      1,
-      f
-    );
-  }
+proto.pulumirpc.ConstructRequest.prototype.getProvidersMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 13, opt_noLazyCreate,
+      null));
 };
 
 
 /**
- * repeated string urns = 1;
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
+ */
+proto.pulumirpc.ConstructRequest.prototype.clearProvidersMap = function() {
+  this.getProvidersMap().clear();
+  return this;};
+
+
+/**
+ * repeated string aliases = 14;
  * @return {!Array<string>}
  */
-proto.pulumirpc.CallResponse.ReturnDependencies.prototype.getUrnsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+proto.pulumirpc.ConstructRequest.prototype.getAliasesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 14));
 };
 
 
 /**
  * @param {!Array<string>} value
- * @return {!proto.pulumirpc.CallResponse.ReturnDependencies} returns this
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
  */
-proto.pulumirpc.CallResponse.ReturnDependencies.prototype.setUrnsList = function(value) {
-  return jspb.Message.setField(this, 1, value || []);
+proto.pulumirpc.ConstructRequest.prototype.setAliasesList = function(value) {
+  return jspb.Message.setField(this, 14, value || []);
 };
 
 
 /**
  * @param {string} value
  * @param {number=} opt_index
- * @return {!proto.pulumirpc.CallResponse.ReturnDependencies} returns this
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
  */
-proto.pulumirpc.CallResponse.ReturnDependencies.prototype.addUrns = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+proto.pulumirpc.ConstructRequest.prototype.addAliases = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 14, value, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.pulumirpc.CallResponse.ReturnDependencies} returns this
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
  */
-proto.pulumirpc.CallResponse.ReturnDependencies.prototype.clearUrnsList = function() {
-  return this.setUrnsList([]);
+proto.pulumirpc.ConstructRequest.prototype.clearAliasesList = function() {
+  return this.setAliasesList([]);
 };
 
 
 /**
- * optional google.protobuf.Struct return = 1;
- * @return {?proto.google.protobuf.Struct}
+ * repeated string dependencies = 15;
+ * @return {!Array<string>}
  */
-proto.pulumirpc.CallResponse.prototype.getReturn = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 1));
-};
-
-
-/**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.pulumirpc.CallResponse} returns this
-*/
-proto.pulumirpc.CallResponse.prototype.setReturn = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+proto.pulumirpc.ConstructRequest.prototype.getDependenciesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 15));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.pulumirpc.CallResponse} returns this
+ * @param {!Array<string>} value
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
  */
-proto.pulumirpc.CallResponse.prototype.clearReturn = function() {
-  return this.setReturn(undefined);
+proto.pulumirpc.ConstructRequest.prototype.setDependenciesList = function(value) {
+  return jspb.Message.setField(this, 15, value || []);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
  */
-proto.pulumirpc.CallResponse.prototype.hasReturn = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.pulumirpc.ConstructRequest.prototype.addDependencies = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 15, value, opt_index);
 };
 
 
 /**
- * map<string, ReturnDependencies> returnDependencies = 2;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.pulumirpc.CallResponse.ReturnDependencies>}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
  */
-proto.pulumirpc.CallResponse.prototype.getReturndependenciesMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.pulumirpc.CallResponse.ReturnDependencies>} */ (
-      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
-      proto.pulumirpc.CallResponse.ReturnDependencies));
+proto.pulumirpc.ConstructRequest.prototype.clearDependenciesList = function() {
+  return this.setDependenciesList([]);
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.pulumirpc.CallResponse} returns this
- */
-proto.pulumirpc.CallResponse.prototype.clearReturndependenciesMap = function() {
-  this.getReturndependenciesMap().clear();
-  return this;};
-
-
-/**
- * repeated CheckFailure failures = 3;
- * @return {!Array<!proto.pulumirpc.CheckFailure>}
+ * repeated string configSecretKeys = 16;
+ * @return {!Array<string>}
  */
-proto.pulumirpc.CallResponse.prototype.getFailuresList = function() {
-  return /** @type{!Array<!proto.pulumirpc.CheckFailure>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.pulumirpc.CheckFailure, 3));
+proto.pulumirpc.ConstructRequest.prototype.getConfigsecretkeysList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 16));
 };
 
 
 /**
- * @param {!Array<!proto.pulumirpc.CheckFailure>} value
- * @return {!proto.pulumirpc.CallResponse} returns this
-*/
-proto.pulumirpc.CallResponse.prototype.setFailuresList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+ * @param {!Array<string>} value
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
+ */
+proto.pulumirpc.ConstructRequest.prototype.setConfigsecretkeysList = function(value) {
+  return jspb.Message.setField(this, 16, value || []);
 };
 
 
 /**
- * @param {!proto.pulumirpc.CheckFailure=} opt_value
+ * @param {string} value
  * @param {number=} opt_index
- * @return {!proto.pulumirpc.CheckFailure}
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
  */
-proto.pulumirpc.CallResponse.prototype.addFailures = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.pulumirpc.CheckFailure, opt_index);
+proto.pulumirpc.ConstructRequest.prototype.addConfigsecretkeys = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 16, value, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.pulumirpc.CallResponse} returns this
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
  */
-proto.pulumirpc.CallResponse.prototype.clearFailuresList = function() {
-  return this.setFailuresList([]);
+proto.pulumirpc.ConstructRequest.prototype.clearConfigsecretkeysList = function() {
+  return this.setConfigsecretkeysList([]);
 };
 
 
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * optional string organization = 17;
+ * @return {string}
  */
-proto.pulumirpc.CheckRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.CheckRequest.toObject(opt_includeInstance, this);
+proto.pulumirpc.ConstructRequest.prototype.getOrganization = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 17, ""));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.CheckRequest} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {string} value
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
  */
-proto.pulumirpc.CheckRequest.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    urn: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    olds: (f = msg.getOlds()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
-    news: (f = msg.getNews()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
-    randomseed: msg.getRandomseed_asB64()
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.pulumirpc.ConstructRequest.prototype.setOrganization = function(value) {
+  return jspb.Message.setProto3StringField(this, 17, value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.CheckRequest}
+ * optional CustomTimeouts customTimeouts = 18;
+ * @return {?proto.pulumirpc.ConstructRequest.CustomTimeouts}
  */
-proto.pulumirpc.CheckRequest.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.CheckRequest;
-  return proto.pulumirpc.CheckRequest.deserializeBinaryFromReader(msg, reader);
+proto.pulumirpc.ConstructRequest.prototype.getCustomtimeouts = function() {
+  return /** @type{?proto.pulumirpc.ConstructRequest.CustomTimeouts} */ (
+    jspb.Message.getWrapperField(this, proto.pulumirpc.ConstructRequest.CustomTimeouts, 18));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.pulumirpc.CheckRequest} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.CheckRequest}
- */
-proto.pulumirpc.CheckRequest.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUrn(value);
-      break;
-    case 2:
-      var value = new google_protobuf_struct_pb.Struct;
-      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setOlds(value);
-      break;
-    case 3:
-      var value = new google_protobuf_struct_pb.Struct;
-      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setNews(value);
-      break;
-    case 5:
-      var value = /** @type {!Uint8Array} */ (reader.readBytes());
-      msg.setRandomseed(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+ * @param {?proto.pulumirpc.ConstructRequest.CustomTimeouts|undefined} value
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
+*/
+proto.pulumirpc.ConstructRequest.prototype.setCustomtimeouts = function(value) {
+  return jspb.Message.setWrapperField(this, 18, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
  */
-proto.pulumirpc.CheckRequest.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.CheckRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.pulumirpc.ConstructRequest.prototype.clearCustomtimeouts = function() {
+  return this.setCustomtimeouts(undefined);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.CheckRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.pulumirpc.CheckRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getUrn();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getOlds();
-  if (f != null) {
-    writer.writeMessage(
-      2,
-      f,
-      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
-    );
-  }
-  f = message.getNews();
-  if (f != null) {
-    writer.writeMessage(
-      3,
-      f,
-      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
-    );
-  }
-  f = message.getRandomseed_asU8();
-  if (f.length > 0) {
-    writer.writeBytes(
-      5,
-      f
-    );
-  }
+proto.pulumirpc.ConstructRequest.prototype.hasCustomtimeouts = function() {
+  return jspb.Message.getField(this, 18) != null;
 };
 
 
 /**
- * optional string urn = 1;
+ * optional string sdkVersion = 19;
  * @return {string}
  */
-proto.pulumirpc.CheckRequest.prototype.getUrn = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.pulumirpc.ConstructRequest.prototype.getSdkversion = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 19, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.pulumirpc.CheckRequest} returns this
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
  */
-proto.pulumirpc.CheckRequest.prototype.setUrn = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.pulumirpc.ConstructRequest.prototype.setSdkversion = function(value) {
+  return jspb.Message.setProto3StringField(this, 19, value);
 };
 
 
 /**
- * optional google.protobuf.Struct olds = 2;
- * @return {?proto.google.protobuf.Struct}
+ * optional string engineVersion = 20;
+ * @return {string}
  */
-proto.pulumirpc.CheckRequest.prototype.getOlds = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 2));
+proto.pulumirpc.ConstructRequest.prototype.getEngineversion = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 20, ""));
 };
 
 
 /**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.pulumirpc.CheckRequest} returns this
-*/
-proto.pulumirpc.CheckRequest.prototype.setOlds = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
+ * @param {string} value
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
+ */
+proto.pulumirpc.ConstructRequest.prototype.setEngineversion = function(value) {
+  return jspb.Message.setProto3StringField(this, 20, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.pulumirpc.CheckRequest} returns this
+ * repeated string ignoreChanges = 21;
+ * @return {!Array<string>}
  */
-proto.pulumirpc.CheckRequest.prototype.clearOlds = function() {
-  return this.setOlds(undefined);
+proto.pulumirpc.ConstructRequest.prototype.getIgnorechangesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 21));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {!Array<string>} value
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
  */
-proto.pulumirpc.CheckRequest.prototype.hasOlds = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.pulumirpc.ConstructRequest.prototype.setIgnorechangesList = function(value) {
+  return jspb.Message.setField(this, 21, value || []);
 };
 
 
 /**
- * optional google.protobuf.Struct news = 3;
- * @return {?proto.google.protobuf.Struct}
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
  */
-proto.pulumirpc.CheckRequest.prototype.getNews = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 3));
+proto.pulumirpc.ConstructRequest.prototype.addIgnorechanges = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 21, value, opt_index);
 };
 
 
 /**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.pulumirpc.CheckRequest} returns this
-*/
-proto.pulumirpc.CheckRequest.prototype.setNews = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
+ */
+proto.pulumirpc.ConstructRequest.prototype.clearIgnorechangesList = function() {
+  return this.setIgnorechangesList([]);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.pulumirpc.CheckRequest} returns this
+ * optional string traceId = 22;
+ * @return {string}
  */
-proto.pulumirpc.CheckRequest.prototype.clearNews = function() {
-  return this.setNews(undefined);
+proto.pulumirpc.ConstructRequest.prototype.getTraceid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 22, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
  */
-proto.pulumirpc.CheckRequest.prototype.hasNews = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.pulumirpc.ConstructRequest.prototype.setTraceid = function(value) {
+  return jspb.Message.setProto3StringField(this, 22, value);
 };
 
 
 /**
- * optional bytes randomSeed = 5;
- * @return {!(string|Uint8Array)}
+ * optional string spanId = 23;
+ * @return {string}
  */
-proto.pulumirpc.CheckRequest.prototype.getRandomseed = function() {
-  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+proto.pulumirpc.ConstructRequest.prototype.getSpanid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 23, ""));
 };
 
 
 /**
- * optional bytes randomSeed = 5;
- * This is a type-conversion wrapper around `getRandomseed()`
- * @return {string}
+ * @param {string} value
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
  */
-proto.pulumirpc.CheckRequest.prototype.getRandomseed_asB64 = function() {
-  return /** @type {string} */ (jspb.Message.bytesAsB64(
-      this.getRandomseed()));
+proto.pulumirpc.ConstructRequest.prototype.setSpanid = function(value) {
+  return jspb.Message.setProto3StringField(this, 23, value);
 };
 
 
 /**
- * optional bytes randomSeed = 5;
- * Note that Uint8Array is not supported on all browsers.
- * @see http://caniuse.com/Uint8Array
- * This is a type-conversion wrapper around `getRandomseed()`
- * @return {!Uint8Array}
+ * optional string namespace = 24;
+ * @return {string}
  */
-proto.pulumirpc.CheckRequest.prototype.getRandomseed_asU8 = function() {
-  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
-      this.getRandomseed()));
+proto.pulumirpc.ConstructRequest.prototype.getNamespace = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 24, ""));
 };
 
 
 /**
- * @param {!(string|Uint8Array)} value
- * @return {!proto.pulumirpc.CheckRequest} returns this
+ * @param {string} value
+ * @return {!proto.pulumirpc.ConstructRequest} returns this
  */
-proto.pulumirpc.CheckRequest.prototype.setRandomseed = function(value) {
-  return jspb.Message.setProto3BytesField(this, 5, value);
+proto.pulumirpc.ConstructRequest.prototype.setNamespace = function(value) {
+  return jspb.Message.setProto3StringField(this, 24, value);
 };
 
 
@@ -3540,7 +9894,7 @@ proto.pulumirpc.CheckRequest.prototype.setRandomseed = function(value) {
  * @private {!Array<number>}
  * @const
  */
-proto.pulumirpc.CheckResponse.repeatedFields_ = [2];
+proto.pulumirpc.ConstructResponse.repeatedFields_ = [6];
 
 
 
@@ -3557,8 +9911,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pulumirpc.CheckResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.CheckResponse.toObject(opt_includeInstance, this);
+proto.pulumirpc.ConstructResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.ConstructResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -3567,15 +9921,18 @@ proto.pulumirpc.CheckResponse.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.CheckResponse} msg The msg instance to transform.
+ * @param {!proto.pulumirpc.ConstructResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.CheckResponse.toObject = function(includeInstance, msg) {
+proto.pulumirpc.ConstructResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
+    urn: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    state: (f = msg.getState()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
+    statedependenciesMap: (f = msg.getStatedependenciesMap()) ? f.toObject(includeInstance, proto.pulumirpc.ConstructResponse.PropertyDependencies.toObject) : [],
     inputs: (f = msg.getInputs()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
-    failuresList: jspb.Message.toObjectList(msg.getFailuresList(),
-    proto.pulumirpc.CheckFailure.toObject, includeInstance)
+    inputdependenciesMap: (f = msg.getInputdependenciesMap()) ? f.toObject(includeInstance, proto.pulumirpc.ConstructResponse.PropertyDependencies.toObject) : [],
+    childresourcesList: (f = jspb.Message.getRepeatedField(msg, 6)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -3589,23 +9946,23 @@ proto.pulumirpc.CheckResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.CheckResponse}
+ * @return {!proto.pulumirpc.ConstructResponse}
  */
-proto.pulumirpc.CheckResponse.deserializeBinary = function(bytes) {
+proto.pulumirpc.ConstructResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.CheckResponse;
-  return proto.pulumirpc.CheckResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pulumirpc.ConstructResponse;
+  return proto.pulumirpc.ConstructResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pulumirpc.CheckResponse} msg The message object to deserialize into.
+ * @param {!proto.pulumirpc.ConstructResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.CheckResponse}
+ * @return {!proto.pulumirpc.ConstructResponse}
  */
-proto.pulumirpc.CheckResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.pulumirpc.ConstructResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3613,14 +9970,34 @@ proto.pulumirpc.CheckResponse.deserializeBinaryFromReader = function(msg, reader
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUrn(value);
+      break;
+    case 2:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setState(value);
+      break;
+    case 3:
+      var value = msg.getStatedependenciesMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.pulumirpc.ConstructResponse.PropertyDependencies.deserializeBinaryFromReader, "", new proto.pulumirpc.ConstructResponse.PropertyDependencies());
+         });
+      break;
+    case 4:
       var value = new google_protobuf_struct_pb.Struct;
       reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
       msg.setInputs(value);
       break;
-    case 2:
-      var value = new proto.pulumirpc.CheckFailure;
-      reader.readMessage(value,proto.pulumirpc.CheckFailure.deserializeBinaryFromReader);
-      msg.addFailures(value);
+    case 5:
+      var value = msg.getInputdependenciesMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.pulumirpc.ConstructResponse.PropertyDependencies.deserializeBinaryFromReader, "", new proto.pulumirpc.ConstructResponse.PropertyDependencies());
+         });
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addChildresources(value);
       break;
     default:
       reader.skipField();
@@ -3635,9 +10012,9 @@ proto.pulumirpc.CheckResponse.deserializeBinaryFromReader = function(msg, reader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pulumirpc.CheckResponse.prototype.serializeBinary = function() {
+proto.pulumirpc.ConstructResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.CheckResponse.serializeBinaryToWriter(this, writer);
+  proto.pulumirpc.ConstructResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3645,106 +10022,60 @@ proto.pulumirpc.CheckResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.CheckResponse} message
+ * @param {!proto.pulumirpc.ConstructResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.CheckResponse.serializeBinaryToWriter = function(message, writer) {
+proto.pulumirpc.ConstructResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getUrn();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getState();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+  f = message.getStatedependenciesMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(3, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.pulumirpc.ConstructResponse.PropertyDependencies.serializeBinaryToWriter);
+  }
   f = message.getInputs();
   if (f != null) {
     writer.writeMessage(
-      1,
+      4,
       f,
       google_protobuf_struct_pb.Struct.serializeBinaryToWriter
     );
   }
-  f = message.getFailuresList();
+  f = message.getInputdependenciesMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(5, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.pulumirpc.ConstructResponse.PropertyDependencies.serializeBinaryToWriter);
+  }
+  f = message.getChildresourcesList();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      2,
-      f,
-      proto.pulumirpc.CheckFailure.serializeBinaryToWriter
+    writer.writeRepeatedString(
+      6,
+      f
     );
   }
 };
 
 
-/**
- * optional google.protobuf.Struct inputs = 1;
- * @return {?proto.google.protobuf.Struct}
- */
-proto.pulumirpc.CheckResponse.prototype.getInputs = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 1));
-};
-
-
-/**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.pulumirpc.CheckResponse} returns this
-*/
-proto.pulumirpc.CheckResponse.prototype.setInputs = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.pulumirpc.CheckResponse} returns this
- */
-proto.pulumirpc.CheckResponse.prototype.clearInputs = function() {
-  return this.setInputs(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.pulumirpc.CheckResponse.prototype.hasInputs = function() {
-  return jspb.Message.getField(this, 1) != null;
-};
-
-
-/**
- * repeated CheckFailure failures = 2;
- * @return {!Array<!proto.pulumirpc.CheckFailure>}
- */
-proto.pulumirpc.CheckResponse.prototype.getFailuresList = function() {
-  return /** @type{!Array<!proto.pulumirpc.CheckFailure>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.pulumirpc.CheckFailure, 2));
-};
-
-
-/**
- * @param {!Array<!proto.pulumirpc.CheckFailure>} value
- * @return {!proto.pulumirpc.CheckResponse} returns this
-*/
-proto.pulumirpc.CheckResponse.prototype.setFailuresList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 2, value);
-};
-
-
-/**
- * @param {!proto.pulumirpc.CheckFailure=} opt_value
- * @param {number=} opt_index
- * @return {!proto.pulumirpc.CheckFailure}
- */
-proto.pulumirpc.CheckResponse.prototype.addFailures = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.pulumirpc.CheckFailure, opt_index);
-};
-
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.pulumirpc.CheckResponse} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.pulumirpc.CheckResponse.prototype.clearFailuresList = function() {
-  return this.setFailuresList([]);
-};
-
-
+proto.pulumirpc.ConstructResponse.PropertyDependencies.repeatedFields_ = [1];
 
 
 
@@ -3761,8 +10092,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pulumirpc.CheckFailure.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.CheckFailure.toObject(opt_includeInstance, this);
+proto.pulumirpc.ConstructResponse.PropertyDependencies.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.ConstructResponse.PropertyDependencies.toObject(opt_includeInstance, this);
 };
 
 
@@ -3771,14 +10102,13 @@ proto.pulumirpc.CheckFailure.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.CheckFailure} msg The msg instance to transform.
+ * @param {!proto.pulumirpc.ConstructResponse.PropertyDependencies} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.CheckFailure.toObject = function(includeInstance, msg) {
+proto.pulumirpc.ConstructResponse.PropertyDependencies.toObject = function(includeInstance, msg) {
   var f, obj = {
-    property: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    reason: jspb.Message.getFieldWithDefault(msg, 2, "")
+    urnsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -3792,23 +10122,23 @@ proto.pulumirpc.CheckFailure.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.CheckFailure}
+ * @return {!proto.pulumirpc.ConstructResponse.PropertyDependencies}
  */
-proto.pulumirpc.CheckFailure.deserializeBinary = function(bytes) {
+proto.pulumirpc.ConstructResponse.PropertyDependencies.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.CheckFailure;
-  return proto.pulumirpc.CheckFailure.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pulumirpc.ConstructResponse.PropertyDependencies;
+  return proto.pulumirpc.ConstructResponse.PropertyDependencies.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pulumirpc.CheckFailure} msg The message object to deserialize into.
+ * @param {!proto.pulumirpc.ConstructResponse.PropertyDependencies} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.CheckFailure}
+ * @return {!proto.pulumirpc.ConstructResponse.PropertyDependencies}
  */
-proto.pulumirpc.CheckFailure.deserializeBinaryFromReader = function(msg, reader) {
+proto.pulumirpc.ConstructResponse.PropertyDependencies.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3817,11 +10147,7 @@ proto.pulumirpc.CheckFailure.deserializeBinaryFromReader = function(msg, reader)
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setProperty(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setReason(value);
+      msg.addUrns(value);
       break;
     default:
       reader.skipField();
@@ -3836,9 +10162,9 @@ proto.pulumirpc.CheckFailure.deserializeBinaryFromReader = function(msg, reader)
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pulumirpc.CheckFailure.prototype.serializeBinary = function() {
+proto.pulumirpc.ConstructResponse.PropertyDependencies.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.CheckFailure.serializeBinaryToWriter(this, writer);
+  proto.pulumirpc.ConstructResponse.PropertyDependencies.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3846,72 +10172,143 @@ proto.pulumirpc.CheckFailure.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.CheckFailure} message
+ * @param {!proto.pulumirpc.ConstructResponse.PropertyDependencies} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.CheckFailure.serializeBinaryToWriter = function(message, writer) {
+proto.pulumirpc.ConstructResponse.PropertyDependencies.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getProperty();
+  f = message.getUrnsList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedString(
       1,
       f
     );
   }
-  f = message.getReason();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
 };
 
 
 /**
- * optional string property = 1;
+ * repeated string urns = 1;
+ * @return {!Array<string>}
+ */
+proto.pulumirpc.ConstructResponse.PropertyDependencies.prototype.getUrnsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.pulumirpc.ConstructResponse.PropertyDependencies} returns this
+ */
+proto.pulumirpc.ConstructResponse.PropertyDependencies.prototype.setUrnsList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.ConstructResponse.PropertyDependencies} returns this
+ */
+proto.pulumirpc.ConstructResponse.PropertyDependencies.prototype.addUrns = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.ConstructResponse.PropertyDependencies} returns this
+ */
+proto.pulumirpc.ConstructResponse.PropertyDependencies.prototype.clearUrnsList = function() {
+  return this.setUrnsList([]);
+};
+
+
+/**
+ * optional string urn = 1;
  * @return {string}
  */
-proto.pulumirpc.CheckFailure.prototype.getProperty = function() {
+proto.pulumirpc.ConstructResponse.prototype.getUrn = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.pulumirpc.CheckFailure} returns this
+ * @return {!proto.pulumirpc.ConstructResponse} returns this
  */
-proto.pulumirpc.CheckFailure.prototype.setProperty = function(value) {
+proto.pulumirpc.ConstructResponse.prototype.setUrn = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string reason = 2;
- * @return {string}
+ * optional google.protobuf.Struct state = 2;
+ * @return {?proto.google.protobuf.Struct}
  */
-proto.pulumirpc.CheckFailure.prototype.getReason = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.pulumirpc.ConstructResponse.prototype.getState = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 2));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.pulumirpc.CheckFailure} returns this
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.pulumirpc.ConstructResponse} returns this
+*/
+proto.pulumirpc.ConstructResponse.prototype.setState = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.ConstructResponse} returns this
  */
-proto.pulumirpc.CheckFailure.prototype.setReason = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.pulumirpc.ConstructResponse.prototype.clearState = function() {
+  return this.setState(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.pulumirpc.ConstructResponse.prototype.hasState = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * map<string, PropertyDependencies> stateDependencies = 3;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.pulumirpc.ConstructResponse.PropertyDependencies>}
+ */
+proto.pulumirpc.ConstructResponse.prototype.getStatedependenciesMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.pulumirpc.ConstructResponse.PropertyDependencies>} */ (
+      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
+      proto.pulumirpc.ConstructResponse.PropertyDependencies));
 };
 
 
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.pulumirpc.ConstructResponse} returns this
+ */
+proto.pulumirpc.ConstructResponse.prototype.clearStatedependenciesMap = function() {
+  this.getStatedependenciesMap().clear();
+  return this;};
+
+
 
 /**
  * List of repeated fields within this message type.
  * @private {!Array<number>}
  * @const
  */
-proto.pulumirpc.DiffRequest.repeatedFields_ = [5];
+proto.pulumirpc.ErrorResourceInitFailed.repeatedFields_ = [3];
 
 
 
@@ -3928,27 +10325,85 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pulumirpc.DiffRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.DiffRequest.toObject(opt_includeInstance, this);
+proto.pulumirpc.ErrorResourceInitFailed.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.ErrorResourceInitFailed.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * optional google.protobuf.Struct inputs = 4;
+ * @return {?google_protobuf_struct_pb.Struct}
+ */
+proto.pulumirpc.ConstructResponse.prototype.getInputs = function() {
+  return /** @type{?google_protobuf_struct_pb.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 4));
+};
+
+
+/**
+ * @param {?google_protobuf_struct_pb.Struct|undefined} value
+ * @return {!proto.pulumirpc.ConstructResponse} returns this
+*/
+proto.pulumirpc.ConstructResponse.prototype.setInputs = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.ConstructResponse} returns this
+ */
+proto.pulumirpc.ConstructResponse.prototype.clearInputs = function() {
+  return this.setInputs(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.pulumirpc.ConstructResponse.prototype.hasInputs = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * map<string, PropertyDependencies> inputDependencies = 5;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.pulumirpc.ConstructResponse.PropertyDependencies>}
+ */
+proto.pulumirpc.ConstructResponse.prototype.getInputdependenciesMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.pulumirpc.ConstructResponse.PropertyDependencies>} */ (
+      jspb.Message.getMapField(this, 5, opt_noLazyCreate,
+      proto.pulumirpc.ConstructResponse.PropertyDependencies));
 };
 
 
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.pulumirpc.ConstructResponse} returns this
+ */
+proto.pulumirpc.ConstructResponse.prototype.clearInputdependenciesMap = function() {
+  this.getInputdependenciesMap().clear();
+  return this;};
+
+
 /**
  * Static version of the {@see toObject} method.
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.DiffRequest} msg The msg instance to transform.
+ * @param {!proto.pulumirpc.ErrorResourceInitFailed} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.DiffRequest.toObject = function(includeInstance, msg) {
+proto.pulumirpc.ErrorResourceInitFailed.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    urn: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    olds: (f = msg.getOlds()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
-    news: (f = msg.getNews()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
-    ignorechangesList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f
+    properties: (f = msg.getProperties()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
+    reasonsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
+    inputs: (f = msg.getInputs()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -3956,29 +10411,66 @@ proto.pulumirpc.DiffRequest.toObject = function(includeInstance, msg) {
   }
   return obj;
 };
+
+
+/**
+ * repeated string childResources = 6;
+ * @return {!Array<string>}
+ */
+proto.pulumirpc.ConstructResponse.prototype.getChildresourcesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 6));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.pulumirpc.ConstructResponse} returns this
+ */
+proto.pulumirpc.ConstructResponse.prototype.setChildresourcesList = function(value) {
+  return jspb.Message.setField(this, 6, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.ConstructResponse} returns this
+ */
+proto.pulumirpc.ConstructResponse.prototype.addChildresources = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 6, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.ConstructResponse} returns this
+ */
+proto.pulumirpc.ConstructResponse.prototype.clearChildresourcesList = function() {
+  return this.setChildresourcesList([]);
+};
 }
 
 
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.DiffRequest}
+ * @return {!proto.pulumirpc.ErrorResourceInitFailed}
  */
-proto.pulumirpc.DiffRequest.deserializeBinary = function(bytes) {
+proto.pulumirpc.ErrorResourceInitFailed.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.DiffRequest;
-  return proto.pulumirpc.DiffRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pulumirpc.ErrorResourceInitFailed;
+  return proto.pulumirpc.ErrorResourceInitFailed.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pulumirpc.DiffRequest} msg The message object to deserialize into.
+ * @param {!proto.pulumirpc.ErrorResourceInitFailed} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.DiffRequest}
+ * @return {!proto.pulumirpc.ErrorResourceInitFailed}
  */
-proto.pulumirpc.DiffRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.pulumirpc.ErrorResourceInitFailed.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3990,22 +10482,18 @@ proto.pulumirpc.DiffRequest.deserializeBinaryFromReader = function(msg, reader)
       msg.setId(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUrn(value);
-      break;
-    case 3:
       var value = new google_protobuf_struct_pb.Struct;
       reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setOlds(value);
+      msg.setProperties(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addReasons(value);
       break;
     case 4:
       var value = new google_protobuf_struct_pb.Struct;
       reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setNews(value);
-      break;
-    case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addIgnorechanges(value);
+      msg.setInputs(value);
       break;
     default:
       reader.skipField();
@@ -4020,9 +10508,9 @@ proto.pulumirpc.DiffRequest.deserializeBinaryFromReader = function(msg, reader)
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pulumirpc.DiffRequest.prototype.serializeBinary = function() {
+proto.pulumirpc.ErrorResourceInitFailed.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.DiffRequest.serializeBinaryToWriter(this, writer);
+  proto.pulumirpc.ErrorResourceInitFailed.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4030,11 +10518,11 @@ proto.pulumirpc.DiffRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.DiffRequest} message
+ * @param {!proto.pulumirpc.ErrorResourceInitFailed} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.DiffRequest.serializeBinaryToWriter = function(message, writer) {
+proto.pulumirpc.ErrorResourceInitFailed.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f.length > 0) {
@@ -4043,22 +10531,22 @@ proto.pulumirpc.DiffRequest.serializeBinaryToWriter = function(message, writer)
       f
     );
   }
-  f = message.getUrn();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getOlds();
+  f = message.getProperties();
   if (f != null) {
     writer.writeMessage(
-      3,
+      2,
       f,
       google_protobuf_struct_pb.Struct.serializeBinaryToWriter
     );
   }
-  f = message.getNews();
+  f = message.getReasonsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      3,
+      f
+    );
+  }
+  f = message.getInputs();
   if (f != null) {
     writer.writeMessage(
       4,
@@ -4066,13 +10554,6 @@ proto.pulumirpc.DiffRequest.serializeBinaryToWriter = function(message, writer)
       google_protobuf_struct_pb.Struct.serializeBinaryToWriter
     );
   }
-  f = message.getIgnorechangesList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      5,
-      f
-    );
-  }
 };
 
 
@@ -4080,63 +10561,45 @@ proto.pulumirpc.DiffRequest.serializeBinaryToWriter = function(message, writer)
  * optional string id = 1;
  * @return {string}
  */
-proto.pulumirpc.DiffRequest.prototype.getId = function() {
+proto.pulumirpc.ErrorResourceInitFailed.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.pulumirpc.DiffRequest} returns this
+ * @return {!proto.pulumirpc.ErrorResourceInitFailed} returns this
  */
-proto.pulumirpc.DiffRequest.prototype.setId = function(value) {
+proto.pulumirpc.ErrorResourceInitFailed.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string urn = 2;
- * @return {string}
- */
-proto.pulumirpc.DiffRequest.prototype.getUrn = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.pulumirpc.DiffRequest} returns this
- */
-proto.pulumirpc.DiffRequest.prototype.setUrn = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
-};
-
-
-/**
- * optional google.protobuf.Struct olds = 3;
+ * optional google.protobuf.Struct properties = 2;
  * @return {?proto.google.protobuf.Struct}
  */
-proto.pulumirpc.DiffRequest.prototype.getOlds = function() {
+proto.pulumirpc.ErrorResourceInitFailed.prototype.getProperties = function() {
   return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 3));
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 2));
 };
 
 
 /**
  * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.pulumirpc.DiffRequest} returns this
+ * @return {!proto.pulumirpc.ErrorResourceInitFailed} returns this
 */
-proto.pulumirpc.DiffRequest.prototype.setOlds = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
+proto.pulumirpc.ErrorResourceInitFailed.prototype.setProperties = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.pulumirpc.DiffRequest} returns this
+ * @return {!proto.pulumirpc.ErrorResourceInitFailed} returns this
  */
-proto.pulumirpc.DiffRequest.prototype.clearOlds = function() {
-  return this.setOlds(undefined);
+proto.pulumirpc.ErrorResourceInitFailed.prototype.clearProperties = function() {
+  return this.setProperties(undefined);
 };
 
 
@@ -4144,82 +10607,82 @@ proto.pulumirpc.DiffRequest.prototype.clearOlds = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.pulumirpc.DiffRequest.prototype.hasOlds = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.pulumirpc.ErrorResourceInitFailed.prototype.hasProperties = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
 /**
- * optional google.protobuf.Struct news = 4;
- * @return {?proto.google.protobuf.Struct}
+ * repeated string reasons = 3;
+ * @return {!Array<string>}
  */
-proto.pulumirpc.DiffRequest.prototype.getNews = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 4));
+proto.pulumirpc.ErrorResourceInitFailed.prototype.getReasonsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
 };
 
 
 /**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.pulumirpc.DiffRequest} returns this
-*/
-proto.pulumirpc.DiffRequest.prototype.setNews = function(value) {
-  return jspb.Message.setWrapperField(this, 4, value);
+ * @param {!Array<string>} value
+ * @return {!proto.pulumirpc.ErrorResourceInitFailed} returns this
+ */
+proto.pulumirpc.ErrorResourceInitFailed.prototype.setReasonsList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.pulumirpc.DiffRequest} returns this
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.ErrorResourceInitFailed} returns this
  */
-proto.pulumirpc.DiffRequest.prototype.clearNews = function() {
-  return this.setNews(undefined);
+proto.pulumirpc.ErrorResourceInitFailed.prototype.addReasons = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.ErrorResourceInitFailed} returns this
  */
-proto.pulumirpc.DiffRequest.prototype.hasNews = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.pulumirpc.ErrorResourceInitFailed.prototype.clearReasonsList = function() {
+  return this.setReasonsList([]);
 };
 
 
 /**
- * repeated string ignoreChanges = 5;
- * @return {!Array<string>}
+ * optional google.protobuf.Struct inputs = 4;
+ * @return {?proto.google.protobuf.Struct}
  */
-proto.pulumirpc.DiffRequest.prototype.getIgnorechangesList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 5));
+proto.pulumirpc.ErrorResourceInitFailed.prototype.getInputs = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 4));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.pulumirpc.DiffRequest} returns this
- */
-proto.pulumirpc.DiffRequest.prototype.setIgnorechangesList = function(value) {
-  return jspb.Message.setField(this, 5, value || []);
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.pulumirpc.ErrorResourceInitFailed} returns this
+*/
+proto.pulumirpc.ErrorResourceInitFailed.prototype.setInputs = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.pulumirpc.DiffRequest} returns this
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.ErrorResourceInitFailed} returns this
  */
-proto.pulumirpc.DiffRequest.prototype.addIgnorechanges = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 5, value, opt_index);
+proto.pulumirpc.ErrorResourceInitFailed.prototype.clearInputs = function() {
+  return this.setInputs(undefined);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.pulumirpc.DiffRequest} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.pulumirpc.DiffRequest.prototype.clearIgnorechangesList = function() {
-  return this.setIgnorechangesList([]);
+proto.pulumirpc.ErrorResourceInitFailed.prototype.hasInputs = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
 
 
@@ -4239,8 +10702,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pulumirpc.PropertyDiff.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.PropertyDiff.toObject(opt_includeInstance, this);
+proto.pulumirpc.GetMappingRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.GetMappingRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -4249,14 +10712,13 @@ proto.pulumirpc.PropertyDiff.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.PropertyDiff} msg The msg instance to transform.
+ * @param {!proto.pulumirpc.GetMappingRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.PropertyDiff.toObject = function(includeInstance, msg) {
+proto.pulumirpc.GetMappingRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    kind: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    inputdiff: jspb.Message.getBooleanFieldWithDefault(msg, 2, false)
+    key: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -4270,23 +10732,23 @@ proto.pulumirpc.PropertyDiff.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.PropertyDiff}
+ * @return {!proto.pulumirpc.GetMappingRequest}
  */
-proto.pulumirpc.PropertyDiff.deserializeBinary = function(bytes) {
+proto.pulumirpc.GetMappingRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.PropertyDiff;
-  return proto.pulumirpc.PropertyDiff.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pulumirpc.GetMappingRequest;
+  return proto.pulumirpc.GetMappingRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pulumirpc.PropertyDiff} msg The message object to deserialize into.
+ * @param {!proto.pulumirpc.GetMappingRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.PropertyDiff}
+ * @return {!proto.pulumirpc.GetMappingRequest}
  */
-proto.pulumirpc.PropertyDiff.deserializeBinaryFromReader = function(msg, reader) {
+proto.pulumirpc.GetMappingRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4294,12 +10756,8 @@ proto.pulumirpc.PropertyDiff.deserializeBinaryFromReader = function(msg, reader)
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {!proto.pulumirpc.PropertyDiff.Kind} */ (reader.readEnum());
-      msg.setKind(value);
-      break;
-    case 2:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setInputdiff(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setKey(value);
       break;
     default:
       reader.skipField();
@@ -4314,9 +10772,9 @@ proto.pulumirpc.PropertyDiff.deserializeBinaryFromReader = function(msg, reader)
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pulumirpc.PropertyDiff.prototype.serializeBinary = function() {
+proto.pulumirpc.GetMappingRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.PropertyDiff.serializeBinaryToWriter(this, writer);
+  proto.pulumirpc.GetMappingRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4324,85 +10782,41 @@ proto.pulumirpc.PropertyDiff.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.PropertyDiff} message
+ * @param {!proto.pulumirpc.GetMappingRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.PropertyDiff.serializeBinaryToWriter = function(message, writer) {
+proto.pulumirpc.GetMappingRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getKind();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getKey();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getInputdiff();
-  if (f) {
-    writer.writeBool(
-      2,
-      f
-    );
-  }
-};
-
-
-/**
- * @enum {number}
- */
-proto.pulumirpc.PropertyDiff.Kind = {
-  ADD: 0,
-  ADD_REPLACE: 1,
-  DELETE: 2,
-  DELETE_REPLACE: 3,
-  UPDATE: 4,
-  UPDATE_REPLACE: 5
-};
-
-/**
- * optional Kind kind = 1;
- * @return {!proto.pulumirpc.PropertyDiff.Kind}
- */
-proto.pulumirpc.PropertyDiff.prototype.getKind = function() {
-  return /** @type {!proto.pulumirpc.PropertyDiff.Kind} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
-};
-
-
-/**
- * @param {!proto.pulumirpc.PropertyDiff.Kind} value
- * @return {!proto.pulumirpc.PropertyDiff} returns this
- */
-proto.pulumirpc.PropertyDiff.prototype.setKind = function(value) {
-  return jspb.Message.setProto3EnumField(this, 1, value);
 };
 
 
 /**
- * optional bool inputDiff = 2;
- * @return {boolean}
+ * optional string key = 1;
+ * @return {string}
  */
-proto.pulumirpc.PropertyDiff.prototype.getInputdiff = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+proto.pulumirpc.GetMappingRequest.prototype.getKey = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.pulumirpc.PropertyDiff} returns this
+ * @param {string} value
+ * @return {!proto.pulumirpc.GetMappingRequest} returns this
  */
-proto.pulumirpc.PropertyDiff.prototype.setInputdiff = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 2, value);
+proto.pulumirpc.GetMappingRequest.prototype.setKey = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.pulumirpc.DiffResponse.repeatedFields_ = [1,2,5];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -4418,8 +10832,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pulumirpc.DiffResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.DiffResponse.toObject(opt_includeInstance, this);
+proto.pulumirpc.GetMappingResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.GetMappingResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -4428,19 +10842,14 @@ proto.pulumirpc.DiffResponse.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.DiffResponse} msg The msg instance to transform.
+ * @param {!proto.pulumirpc.GetMappingResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.DiffResponse.toObject = function(includeInstance, msg) {
+proto.pulumirpc.GetMappingResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    replacesList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
-    stablesList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
-    deletebeforereplace: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
-    changes: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    diffsList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f,
-    detaileddiffMap: (f = msg.getDetaileddiffMap()) ? f.toObject(includeInstance, proto.pulumirpc.PropertyDiff.toObject) : [],
-    hasdetaileddiff: jspb.Message.getBooleanFieldWithDefault(msg, 7, false)
+    provider: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    data: msg.getData_asB64()
   };
 
   if (includeInstance) {
@@ -4454,23 +10863,23 @@ proto.pulumirpc.DiffResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.DiffResponse}
+ * @return {!proto.pulumirpc.GetMappingResponse}
  */
-proto.pulumirpc.DiffResponse.deserializeBinary = function(bytes) {
+proto.pulumirpc.GetMappingResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.DiffResponse;
-  return proto.pulumirpc.DiffResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pulumirpc.GetMappingResponse;
+  return proto.pulumirpc.GetMappingResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pulumirpc.DiffResponse} msg The message object to deserialize into.
+ * @param {!proto.pulumirpc.GetMappingResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.DiffResponse}
+ * @return {!proto.pulumirpc.GetMappingResponse}
  */
-proto.pulumirpc.DiffResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.pulumirpc.GetMappingResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4479,33 +10888,11 @@ proto.pulumirpc.DiffResponse.deserializeBinaryFromReader = function(msg, reader)
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.addReplaces(value);
+      msg.setProvider(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addStables(value);
-      break;
-    case 3:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setDeletebeforereplace(value);
-      break;
-    case 4:
-      var value = /** @type {!proto.pulumirpc.DiffResponse.DiffChanges} */ (reader.readEnum());
-      msg.setChanges(value);
-      break;
-    case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addDiffs(value);
-      break;
-    case 6:
-      var value = msg.getDetaileddiffMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.pulumirpc.PropertyDiff.deserializeBinaryFromReader, "", new proto.pulumirpc.PropertyDiff());
-         });
-      break;
-    case 7:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setHasdetaileddiff(value);
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setData(value);
       break;
     default:
       reader.skipField();
@@ -4520,9 +10907,9 @@ proto.pulumirpc.DiffResponse.deserializeBinaryFromReader = function(msg, reader)
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pulumirpc.DiffResponse.prototype.serializeBinary = function() {
+proto.pulumirpc.GetMappingResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.DiffResponse.serializeBinaryToWriter(this, writer);
+  proto.pulumirpc.GetMappingResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4530,254 +10917,86 @@ proto.pulumirpc.DiffResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.DiffResponse} message
+ * @param {!proto.pulumirpc.GetMappingResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.DiffResponse.serializeBinaryToWriter = function(message, writer) {
+proto.pulumirpc.GetMappingResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getReplacesList();
+  f = message.getProvider();
   if (f.length > 0) {
-    writer.writeRepeatedString(
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getStablesList();
+  f = message.getData_asU8();
   if (f.length > 0) {
-    writer.writeRepeatedString(
+    writer.writeBytes(
       2,
       f
     );
   }
-  f = message.getDeletebeforereplace();
-  if (f) {
-    writer.writeBool(
-      3,
-      f
-    );
-  }
-  f = message.getChanges();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      4,
-      f
-    );
-  }
-  f = message.getDiffsList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      5,
-      f
-    );
-  }
-  f = message.getDetaileddiffMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(6, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.pulumirpc.PropertyDiff.serializeBinaryToWriter);
-  }
-  f = message.getHasdetaileddiff();
-  if (f) {
-    writer.writeBool(
-      7,
-      f
-    );
-  }
-};
-
-
-/**
- * @enum {number}
- */
-proto.pulumirpc.DiffResponse.DiffChanges = {
-  DIFF_UNKNOWN: 0,
-  DIFF_NONE: 1,
-  DIFF_SOME: 2
-};
-
-/**
- * repeated string replaces = 1;
- * @return {!Array<string>}
- */
-proto.pulumirpc.DiffResponse.prototype.getReplacesList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
-};
-
-
-/**
- * @param {!Array<string>} value
- * @return {!proto.pulumirpc.DiffResponse} returns this
- */
-proto.pulumirpc.DiffResponse.prototype.setReplacesList = function(value) {
-  return jspb.Message.setField(this, 1, value || []);
-};
-
-
-/**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.pulumirpc.DiffResponse} returns this
- */
-proto.pulumirpc.DiffResponse.prototype.addReplaces = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
-};
-
-
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.pulumirpc.DiffResponse} returns this
- */
-proto.pulumirpc.DiffResponse.prototype.clearReplacesList = function() {
-  return this.setReplacesList([]);
-};
-
-
-/**
- * repeated string stables = 2;
- * @return {!Array<string>}
- */
-proto.pulumirpc.DiffResponse.prototype.getStablesList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
-};
-
-
-/**
- * @param {!Array<string>} value
- * @return {!proto.pulumirpc.DiffResponse} returns this
- */
-proto.pulumirpc.DiffResponse.prototype.setStablesList = function(value) {
-  return jspb.Message.setField(this, 2, value || []);
-};
-
-
-/**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.pulumirpc.DiffResponse} returns this
- */
-proto.pulumirpc.DiffResponse.prototype.addStables = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
-};
-
-
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.pulumirpc.DiffResponse} returns this
- */
-proto.pulumirpc.DiffResponse.prototype.clearStablesList = function() {
-  return this.setStablesList([]);
-};
-
-
-/**
- * optional bool deleteBeforeReplace = 3;
- * @return {boolean}
- */
-proto.pulumirpc.DiffResponse.prototype.getDeletebeforereplace = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.pulumirpc.DiffResponse} returns this
- */
-proto.pulumirpc.DiffResponse.prototype.setDeletebeforereplace = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 3, value);
-};
-
-
-/**
- * optional DiffChanges changes = 4;
- * @return {!proto.pulumirpc.DiffResponse.DiffChanges}
- */
-proto.pulumirpc.DiffResponse.prototype.getChanges = function() {
-  return /** @type {!proto.pulumirpc.DiffResponse.DiffChanges} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
-};
-
-
-/**
- * @param {!proto.pulumirpc.DiffResponse.DiffChanges} value
- * @return {!proto.pulumirpc.DiffResponse} returns this
- */
-proto.pulumirpc.DiffResponse.prototype.setChanges = function(value) {
-  return jspb.Message.setProto3EnumField(this, 4, value);
-};
-
-
-/**
- * repeated string diffs = 5;
- * @return {!Array<string>}
- */
-proto.pulumirpc.DiffResponse.prototype.getDiffsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 5));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.pulumirpc.DiffResponse} returns this
+ * optional string provider = 1;
+ * @return {string}
  */
-proto.pulumirpc.DiffResponse.prototype.setDiffsList = function(value) {
-  return jspb.Message.setField(this, 5, value || []);
+proto.pulumirpc.GetMappingResponse.prototype.getProvider = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.pulumirpc.DiffResponse} returns this
+ * @return {!proto.pulumirpc.GetMappingResponse} returns this
  */
-proto.pulumirpc.DiffResponse.prototype.addDiffs = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 5, value, opt_index);
+proto.pulumirpc.GetMappingResponse.prototype.setProvider = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.pulumirpc.DiffResponse} returns this
+ * optional bytes data = 2;
+ * @return {!(string|Uint8Array)}
  */
-proto.pulumirpc.DiffResponse.prototype.clearDiffsList = function() {
-  return this.setDiffsList([]);
+proto.pulumirpc.GetMappingResponse.prototype.getData = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * map<string, PropertyDiff> detailedDiff = 6;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.pulumirpc.PropertyDiff>}
+ * optional bytes data = 2;
+ * This is a type-conversion wrapper around `getData()`
+ * @return {string}
  */
-proto.pulumirpc.DiffResponse.prototype.getDetaileddiffMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.pulumirpc.PropertyDiff>} */ (
-      jspb.Message.getMapField(this, 6, opt_noLazyCreate,
-      proto.pulumirpc.PropertyDiff));
+proto.pulumirpc.GetMappingResponse.prototype.getData_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getData()));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.pulumirpc.DiffResponse} returns this
- */
-proto.pulumirpc.DiffResponse.prototype.clearDetaileddiffMap = function() {
-  this.getDetaileddiffMap().clear();
-  return this;};
-
-
-/**
- * optional bool hasDetailedDiff = 7;
- * @return {boolean}
- */
-proto.pulumirpc.DiffResponse.prototype.getHasdetaileddiff = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 7, false));
+ * optional bytes data = 2;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getData()`
+ * @return {!Uint8Array}
+ */
+proto.pulumirpc.GetMappingResponse.prototype.getData_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getData()));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.pulumirpc.DiffResponse} returns this
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.pulumirpc.GetMappingResponse} returns this
  */
-proto.pulumirpc.DiffResponse.prototype.setHasdetaileddiff = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 7, value);
+proto.pulumirpc.GetMappingResponse.prototype.setData = function(value) {
+  return jspb.Message.setProto3BytesField(this, 2, value);
 };
 
 
@@ -4797,8 +11016,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pulumirpc.CreateRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.CreateRequest.toObject(opt_includeInstance, this);
+proto.pulumirpc.ProviderSupportsFeatureRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.ProviderSupportsFeatureRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -4807,16 +11026,13 @@ proto.pulumirpc.CreateRequest.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.CreateRequest} msg The msg instance to transform.
+ * @param {!proto.pulumirpc.ProviderSupportsFeatureRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.CreateRequest.toObject = function(includeInstance, msg) {
+proto.pulumirpc.ProviderSupportsFeatureRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    urn: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    properties: (f = msg.getProperties()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
-    timeout: jspb.Message.getFloatingPointFieldWithDefault(msg, 3, 0.0),
-    preview: jspb.Message.getBooleanFieldWithDefault(msg, 4, false)
+    id: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -4830,23 +11046,23 @@ proto.pulumirpc.CreateRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.CreateRequest}
+ * @return {!proto.pulumirpc.ProviderSupportsFeatureRequest}
  */
-proto.pulumirpc.CreateRequest.deserializeBinary = function(bytes) {
+proto.pulumirpc.ProviderSupportsFeatureRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.CreateRequest;
-  return proto.pulumirpc.CreateRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pulumirpc.ProviderSupportsFeatureRequest;
+  return proto.pulumirpc.ProviderSupportsFeatureRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pulumirpc.CreateRequest} msg The message object to deserialize into.
+ * @param {!proto.pulumirpc.ProviderSupportsFeatureRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.CreateRequest}
+ * @return {!proto.pulumirpc.ProviderSupportsFeatureRequest}
  */
-proto.pulumirpc.CreateRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.pulumirpc.ProviderSupportsFeatureRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4855,20 +11071,7 @@ proto.pulumirpc.CreateRequest.deserializeBinaryFromReader = function(msg, reader
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setUrn(value);
-      break;
-    case 2:
-      var value = new google_protobuf_struct_pb.Struct;
-      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setProperties(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readDouble());
-      msg.setTimeout(value);
-      break;
-    case 4:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setPreview(value);
+      msg.setId(value);
       break;
     default:
       reader.skipField();
@@ -4883,9 +11086,9 @@ proto.pulumirpc.CreateRequest.deserializeBinaryFromReader = function(msg, reader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pulumirpc.CreateRequest.prototype.serializeBinary = function() {
+proto.pulumirpc.ProviderSupportsFeatureRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.CreateRequest.serializeBinaryToWriter(this, writer);
+  proto.pulumirpc.ProviderSupportsFeatureRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4893,132 +11096,167 @@ proto.pulumirpc.CreateRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.CreateRequest} message
+ * @param {!proto.pulumirpc.ProviderSupportsFeatureRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.CreateRequest.serializeBinaryToWriter = function(message, writer) {
+proto.pulumirpc.ProviderSupportsFeatureRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUrn();
+  f = message.getId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getProperties();
-  if (f != null) {
-    writer.writeMessage(
-      2,
-      f,
-      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
-    );
-  }
-  f = message.getTimeout();
-  if (f !== 0.0) {
-    writer.writeDouble(
-      3,
-      f
-    );
-  }
-  f = message.getPreview();
-  if (f) {
-    writer.writeBool(
-      4,
-      f
-    );
-  }
 };
 
 
 /**
- * optional string urn = 1;
+ * optional string id = 1;
  * @return {string}
  */
-proto.pulumirpc.CreateRequest.prototype.getUrn = function() {
+proto.pulumirpc.ProviderSupportsFeatureRequest.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.pulumirpc.CreateRequest} returns this
+ * @return {!proto.pulumirpc.ProviderSupportsFeatureRequest} returns this
  */
-proto.pulumirpc.CreateRequest.prototype.setUrn = function(value) {
+proto.pulumirpc.ProviderSupportsFeatureRequest.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional google.protobuf.Struct properties = 2;
- * @return {?proto.google.protobuf.Struct}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.pulumirpc.CreateRequest.prototype.getProperties = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 2));
+proto.pulumirpc.ProviderSupportsFeatureResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.ProviderSupportsFeatureResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.pulumirpc.CreateRequest} returns this
-*/
-proto.pulumirpc.CreateRequest.prototype.setProperties = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.ProviderSupportsFeatureResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.ProviderSupportsFeatureResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    hassupport: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.pulumirpc.CreateRequest} returns this
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.ProviderSupportsFeatureResponse}
  */
-proto.pulumirpc.CreateRequest.prototype.clearProperties = function() {
-  return this.setProperties(undefined);
+proto.pulumirpc.ProviderSupportsFeatureResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.ProviderSupportsFeatureResponse;
+  return proto.pulumirpc.ProviderSupportsFeatureResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.ProviderSupportsFeatureResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.ProviderSupportsFeatureResponse}
  */
-proto.pulumirpc.CreateRequest.prototype.hasProperties = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.pulumirpc.ProviderSupportsFeatureResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setHassupport(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional double timeout = 3;
- * @return {number}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.pulumirpc.CreateRequest.prototype.getTimeout = function() {
-  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 3, 0.0));
+proto.pulumirpc.ProviderSupportsFeatureResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.ProviderSupportsFeatureResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.pulumirpc.CreateRequest} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.ProviderSupportsFeatureResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.CreateRequest.prototype.setTimeout = function(value) {
-  return jspb.Message.setProto3FloatField(this, 3, value);
+proto.pulumirpc.ProviderSupportsFeatureResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getHassupport();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * optional bool preview = 4;
+ * optional bool hasSupport = 1;
  * @return {boolean}
  */
-proto.pulumirpc.CreateRequest.prototype.getPreview = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+proto.pulumirpc.ProviderSupportsFeatureResponse.prototype.getHassupport = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
 };
 
 
 /**
  * @param {boolean} value
- * @return {!proto.pulumirpc.CreateRequest} returns this
+ * @return {!proto.pulumirpc.ProviderSupportsFeatureResponse} returns this
  */
-proto.pulumirpc.CreateRequest.prototype.setPreview = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 4, value);
+proto.pulumirpc.ProviderSupportsFeatureResponse.prototype.setHassupport = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
 };
 
 
@@ -5038,8 +11276,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pulumirpc.CreateResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.CreateResponse.toObject(opt_includeInstance, this);
+proto.pulumirpc.ProviderDiagnostic.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.ProviderDiagnostic.toObject(opt_includeInstance, this);
 };
 
 
@@ -5048,14 +11286,16 @@ proto.pulumirpc.CreateResponse.prototype.toObject = function(opt_includeInstance
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.CreateResponse} msg The msg instance to transform.
+ * @param {!proto.pulumirpc.ProviderDiagnostic} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.CreateResponse.toObject = function(includeInstance, msg) {
+proto.pulumirpc.ProviderDiagnostic.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    properties: (f = msg.getProperties()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+    severity: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    summary: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    detail: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    url: jspb.Message.getFieldWithDefault(msg, 4, "")
   };
 
   if (includeInstance) {
@@ -5069,23 +11309,23 @@ proto.pulumirpc.CreateResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.CreateResponse}
+ * @return {!proto.pulumirpc.ProviderDiagnostic}
  */
-proto.pulumirpc.CreateResponse.deserializeBinary = function(bytes) {
+proto.pulumirpc.ProviderDiagnostic.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.CreateResponse;
-  return proto.pulumirpc.CreateResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pulumirpc.ProviderDiagnostic;
+  return proto.pulumirpc.ProviderDiagnostic.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pulumirpc.CreateResponse} msg The message object to deserialize into.
+ * @param {!proto.pulumirpc.ProviderDiagnostic} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.CreateResponse}
+ * @return {!proto.pulumirpc.ProviderDiagnostic}
  */
-proto.pulumirpc.CreateResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.pulumirpc.ProviderDiagnostic.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5093,13 +11333,20 @@ proto.pulumirpc.CreateResponse.deserializeBinaryFromReader = function(msg, reade
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      var value = /** @type {!proto.pulumirpc.ProviderDiagnostic.Severity} */ (reader.readEnum());
+      msg.setSeverity(value);
       break;
     case 2:
-      var value = new google_protobuf_struct_pb.Struct;
-      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setProperties(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSummary(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDetail(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUrl(value);
       break;
     default:
       reader.skipField();
@@ -5114,9 +11361,9 @@ proto.pulumirpc.CreateResponse.deserializeBinaryFromReader = function(msg, reade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pulumirpc.CreateResponse.prototype.serializeBinary = function() {
+proto.pulumirpc.ProviderDiagnostic.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.CreateResponse.serializeBinaryToWriter(this, writer);
+  proto.pulumirpc.ProviderDiagnostic.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5124,88 +11371,128 @@ proto.pulumirpc.CreateResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.CreateResponse} message
+ * @param {!proto.pulumirpc.ProviderDiagnostic} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.CreateResponse.serializeBinaryToWriter = function(message, writer) {
+proto.pulumirpc.ProviderDiagnostic.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getSeverity();
+  if (f !== 0.0) {
+    writer.writeEnum(
       1,
       f
     );
   }
-  f = message.getProperties();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getSummary();
+  if (f.length > 0) {
+    writer.writeString(
       2,
-      f,
-      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getDetail();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getUrl();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
     );
   }
 };
 
 
 /**
- * optional string id = 1;
+ * @enum {number}
+ */
+proto.pulumirpc.ProviderDiagnostic.Severity = {
+  INFO: 0,
+  WARNING: 1,
+  ERROR: 2
+};
+
+/**
+ * optional Severity severity = 1;
+ * @return {!proto.pulumirpc.ProviderDiagnostic.Severity}
+ */
+proto.pulumirpc.ProviderDiagnostic.prototype.getSeverity = function() {
+  return /** @type {!proto.pulumirpc.ProviderDiagnostic.Severity} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.pulumirpc.ProviderDiagnostic.Severity} value
+ * @return {!proto.pulumirpc.ProviderDiagnostic} returns this
+ */
+proto.pulumirpc.ProviderDiagnostic.prototype.setSeverity = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * optional string summary = 2;
  * @return {string}
  */
-proto.pulumirpc.CreateResponse.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.pulumirpc.ProviderDiagnostic.prototype.getSummary = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.pulumirpc.CreateResponse} returns this
+ * @return {!proto.pulumirpc.ProviderDiagnostic} returns this
  */
-proto.pulumirpc.CreateResponse.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.pulumirpc.ProviderDiagnostic.prototype.setSummary = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional google.protobuf.Struct properties = 2;
- * @return {?proto.google.protobuf.Struct}
+ * optional string detail = 3;
+ * @return {string}
  */
-proto.pulumirpc.CreateResponse.prototype.getProperties = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 2));
+proto.pulumirpc.ProviderDiagnostic.prototype.getDetail = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.pulumirpc.CreateResponse} returns this
-*/
-proto.pulumirpc.CreateResponse.prototype.setProperties = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
+ * @param {string} value
+ * @return {!proto.pulumirpc.ProviderDiagnostic} returns this
+ */
+proto.pulumirpc.ProviderDiagnostic.prototype.setDetail = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.pulumirpc.CreateResponse} returns this
+ * optional string url = 4;
+ * @return {string}
  */
-proto.pulumirpc.CreateResponse.prototype.clearProperties = function() {
-  return this.setProperties(undefined);
+proto.pulumirpc.ProviderDiagnostic.prototype.getUrl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.pulumirpc.ProviderDiagnostic} returns this
  */
-proto.pulumirpc.CreateResponse.prototype.hasProperties = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.pulumirpc.ProviderDiagnostic.prototype.setUrl = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 
 
 
+
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
@@ -5219,8 +11506,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pulumirpc.ReadRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.ReadRequest.toObject(opt_includeInstance, this);
+proto.pulumirpc.ConfigChangedEvent.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.ConfigChangedEvent.toObject(opt_includeInstance, this);
 };
 
 
@@ -5229,16 +11516,13 @@ proto.pulumirpc.ReadRequest.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.ReadRequest} msg The msg instance to transform.
+ * @param {!proto.pulumirpc.ConfigChangedEvent} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.ReadRequest.toObject = function(includeInstance, msg) {
+proto.pulumirpc.ConfigChangedEvent.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    urn: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    properties: (f = msg.getProperties()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
-    inputs: (f = msg.getInputs()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+    config: (f = msg.getConfig()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -5252,23 +11536,23 @@ proto.pulumirpc.ReadRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.ReadRequest}
+ * @return {!proto.pulumirpc.ConfigChangedEvent}
  */
-proto.pulumirpc.ReadRequest.deserializeBinary = function(bytes) {
+proto.pulumirpc.ConfigChangedEvent.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.ReadRequest;
-  return proto.pulumirpc.ReadRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pulumirpc.ConfigChangedEvent;
+  return proto.pulumirpc.ConfigChangedEvent.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pulumirpc.ReadRequest} msg The message object to deserialize into.
+ * @param {!proto.pulumirpc.ConfigChangedEvent} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.ReadRequest}
+ * @return {!proto.pulumirpc.ConfigChangedEvent}
  */
-proto.pulumirpc.ReadRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.pulumirpc.ConfigChangedEvent.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5276,22 +11560,9 @@ proto.pulumirpc.ReadRequest.deserializeBinaryFromReader = function(msg, reader)
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUrn(value);
-      break;
-    case 3:
-      var value = new google_protobuf_struct_pb.Struct;
-      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setProperties(value);
-      break;
-    case 4:
       var value = new google_protobuf_struct_pb.Struct;
       reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setInputs(value);
+      msg.setConfig(value);
       break;
     default:
       reader.skipField();
@@ -5306,9 +11577,9 @@ proto.pulumirpc.ReadRequest.deserializeBinaryFromReader = function(msg, reader)
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pulumirpc.ReadRequest.prototype.serializeBinary = function() {
+proto.pulumirpc.ConfigChangedEvent.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.ReadRequest.serializeBinaryToWriter(this, writer);
+  proto.pulumirpc.ConfigChangedEvent.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5316,38 +11587,16 @@ proto.pulumirpc.ReadRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.ReadRequest} message
+ * @param {!proto.pulumirpc.ConfigChangedEvent} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.ReadRequest.serializeBinaryToWriter = function(message, writer) {
+proto.pulumirpc.ConfigChangedEvent.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getUrn();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getProperties();
-  if (f != null) {
-    writer.writeMessage(
-      3,
-      f,
-      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
-    );
-  }
-  f = message.getInputs();
+  f = message.getConfig();
   if (f != null) {
     writer.writeMessage(
-      4,
+      1,
       f,
       google_protobuf_struct_pb.Struct.serializeBinaryToWriter
     );
@@ -5356,103 +11605,30 @@ proto.pulumirpc.ReadRequest.serializeBinaryToWriter = function(message, writer)
 
 
 /**
- * optional string id = 1;
- * @return {string}
- */
-proto.pulumirpc.ReadRequest.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.pulumirpc.ReadRequest} returns this
- */
-proto.pulumirpc.ReadRequest.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
-/**
- * optional string urn = 2;
- * @return {string}
- */
-proto.pulumirpc.ReadRequest.prototype.getUrn = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.pulumirpc.ReadRequest} returns this
- */
-proto.pulumirpc.ReadRequest.prototype.setUrn = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
-};
-
-
-/**
- * optional google.protobuf.Struct properties = 3;
- * @return {?proto.google.protobuf.Struct}
- */
-proto.pulumirpc.ReadRequest.prototype.getProperties = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 3));
-};
-
-
-/**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.pulumirpc.ReadRequest} returns this
-*/
-proto.pulumirpc.ReadRequest.prototype.setProperties = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.pulumirpc.ReadRequest} returns this
- */
-proto.pulumirpc.ReadRequest.prototype.clearProperties = function() {
-  return this.setProperties(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.pulumirpc.ReadRequest.prototype.hasProperties = function() {
-  return jspb.Message.getField(this, 3) != null;
-};
-
-
-/**
- * optional google.protobuf.Struct inputs = 4;
- * @return {?proto.google.protobuf.Struct}
+ * optional google.protobuf.Struct config = 1;
+ * @return {?google_protobuf_struct_pb.Struct}
  */
-proto.pulumirpc.ReadRequest.prototype.getInputs = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 4));
+proto.pulumirpc.ConfigChangedEvent.prototype.getConfig = function() {
+  return /** @type{?google_protobuf_struct_pb.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 1));
 };
 
 
 /**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.pulumirpc.ReadRequest} returns this
+ * @param {?google_protobuf_struct_pb.Struct|undefined} value
+ * @return {!proto.pulumirpc.ConfigChangedEvent} returns this
 */
-proto.pulumirpc.ReadRequest.prototype.setInputs = function(value) {
-  return jspb.Message.setWrapperField(this, 4, value);
+proto.pulumirpc.ConfigChangedEvent.prototype.setConfig = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.pulumirpc.ReadRequest} returns this
+ * @return {!proto.pulumirpc.ConfigChangedEvent} returns this
  */
-proto.pulumirpc.ReadRequest.prototype.clearInputs = function() {
-  return this.setInputs(undefined);
+proto.pulumirpc.ConfigChangedEvent.prototype.clearConfig = function() {
+  return this.setConfig(undefined);
 };
 
 
@@ -5460,8 +11636,8 @@ proto.pulumirpc.ReadRequest.prototype.clearInputs = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.pulumirpc.ReadRequest.prototype.hasInputs = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.pulumirpc.ConfigChangedEvent.prototype.hasConfig = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
@@ -5481,8 +11657,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pulumirpc.ReadResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.ReadResponse.toObject(opt_includeInstance, this);
+proto.pulumirpc.MigrateStateRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.MigrateStateRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -5491,15 +11667,15 @@ proto.pulumirpc.ReadResponse.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.ReadResponse} msg The msg instance to transform.
+ * @param {!proto.pulumirpc.MigrateStateRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.ReadResponse.toObject = function(includeInstance, msg) {
+proto.pulumirpc.MigrateStateRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    properties: (f = msg.getProperties()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
-    inputs: (f = msg.getInputs()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+    urn: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    stateversion: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    state: (f = msg.getState()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -5513,23 +11689,23 @@ proto.pulumirpc.ReadResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.ReadResponse}
+ * @return {!proto.pulumirpc.MigrateStateRequest}
  */
-proto.pulumirpc.ReadResponse.deserializeBinary = function(bytes) {
+proto.pulumirpc.MigrateStateRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.ReadResponse;
-  return proto.pulumirpc.ReadResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pulumirpc.MigrateStateRequest;
+  return proto.pulumirpc.MigrateStateRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pulumirpc.ReadResponse} msg The message object to deserialize into.
+ * @param {!proto.pulumirpc.MigrateStateRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.ReadResponse}
+ * @return {!proto.pulumirpc.MigrateStateRequest}
  */
-proto.pulumirpc.ReadResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.pulumirpc.MigrateStateRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5538,17 +11714,16 @@ proto.pulumirpc.ReadResponse.deserializeBinaryFromReader = function(msg, reader)
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      msg.setUrn(value);
       break;
     case 2:
-      var value = new google_protobuf_struct_pb.Struct;
-      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setProperties(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setStateversion(value);
       break;
     case 3:
       var value = new google_protobuf_struct_pb.Struct;
       reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setInputs(value);
+      msg.setState(value);
       break;
     default:
       reader.skipField();
@@ -5563,9 +11738,9 @@ proto.pulumirpc.ReadResponse.deserializeBinaryFromReader = function(msg, reader)
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pulumirpc.ReadResponse.prototype.serializeBinary = function() {
+proto.pulumirpc.MigrateStateRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.ReadResponse.serializeBinaryToWriter(this, writer);
+  proto.pulumirpc.MigrateStateRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5573,28 +11748,27 @@ proto.pulumirpc.ReadResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.ReadResponse} message
+ * @param {!proto.pulumirpc.MigrateStateRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.ReadResponse.serializeBinaryToWriter = function(message, writer) {
+proto.pulumirpc.MigrateStateRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getUrn();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getProperties();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getStateversion();
+  if (f !== 0) {
+    writer.writeInt32(
       2,
-      f,
-      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getInputs();
+  f = message.getState();
   if (f != null) {
     writer.writeMessage(
       3,
@@ -5606,85 +11780,66 @@ proto.pulumirpc.ReadResponse.serializeBinaryToWriter = function(message, writer)
 
 
 /**
- * optional string id = 1;
+ * optional string urn = 1;
  * @return {string}
  */
-proto.pulumirpc.ReadResponse.prototype.getId = function() {
+proto.pulumirpc.MigrateStateRequest.prototype.getUrn = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.pulumirpc.ReadResponse} returns this
+ * @return {!proto.pulumirpc.MigrateStateRequest} returns this
  */
-proto.pulumirpc.ReadResponse.prototype.setId = function(value) {
+proto.pulumirpc.MigrateStateRequest.prototype.setUrn = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional google.protobuf.Struct properties = 2;
- * @return {?proto.google.protobuf.Struct}
- */
-proto.pulumirpc.ReadResponse.prototype.getProperties = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 2));
-};
-
-
-/**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.pulumirpc.ReadResponse} returns this
-*/
-proto.pulumirpc.ReadResponse.prototype.setProperties = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.pulumirpc.ReadResponse} returns this
+ * optional int32 stateVersion = 2;
+ * @return {number}
  */
-proto.pulumirpc.ReadResponse.prototype.clearProperties = function() {
-  return this.setProperties(undefined);
+proto.pulumirpc.MigrateStateRequest.prototype.getStateversion = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.pulumirpc.MigrateStateRequest} returns this
  */
-proto.pulumirpc.ReadResponse.prototype.hasProperties = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.pulumirpc.MigrateStateRequest.prototype.setStateversion = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional google.protobuf.Struct inputs = 3;
- * @return {?proto.google.protobuf.Struct}
+ * optional google.protobuf.Struct state = 3;
+ * @return {?google_protobuf_struct_pb.Struct}
  */
-proto.pulumirpc.ReadResponse.prototype.getInputs = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
+proto.pulumirpc.MigrateStateRequest.prototype.getState = function() {
+  return /** @type{?google_protobuf_struct_pb.Struct} */ (
     jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 3));
 };
 
 
 /**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.pulumirpc.ReadResponse} returns this
+ * @param {?google_protobuf_struct_pb.Struct|undefined} value
+ * @return {!proto.pulumirpc.MigrateStateRequest} returns this
 */
-proto.pulumirpc.ReadResponse.prototype.setInputs = function(value) {
+proto.pulumirpc.MigrateStateRequest.prototype.setState = function(value) {
   return jspb.Message.setWrapperField(this, 3, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.pulumirpc.ReadResponse} returns this
+ * @return {!proto.pulumirpc.MigrateStateRequest} returns this
  */
-proto.pulumirpc.ReadResponse.prototype.clearInputs = function() {
-  return this.setInputs(undefined);
+proto.pulumirpc.MigrateStateRequest.prototype.clearState = function() {
+  return this.setState(undefined);
 };
 
 
@@ -5692,20 +11847,13 @@ proto.pulumirpc.ReadResponse.prototype.clearInputs = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.pulumirpc.ReadResponse.prototype.hasInputs = function() {
+proto.pulumirpc.MigrateStateRequest.prototype.hasState = function() {
   return jspb.Message.getField(this, 3) != null;
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.pulumirpc.UpdateRequest.repeatedFields_ = [6];
-
-
+
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
@@ -5720,8 +11868,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pulumirpc.UpdateRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.UpdateRequest.toObject(opt_includeInstance, this);
+proto.pulumirpc.MigrateStateResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.MigrateStateResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -5730,19 +11878,13 @@ proto.pulumirpc.UpdateRequest.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.UpdateRequest} msg The msg instance to transform.
+ * @param {!proto.pulumirpc.MigrateStateResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.UpdateRequest.toObject = function(includeInstance, msg) {
+proto.pulumirpc.MigrateStateResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    urn: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    olds: (f = msg.getOlds()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
-    news: (f = msg.getNews()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
-    timeout: jspb.Message.getFloatingPointFieldWithDefault(msg, 5, 0.0),
-    ignorechangesList: (f = jspb.Message.getRepeatedField(msg, 6)) == null ? undefined : f,
-    preview: jspb.Message.getBooleanFieldWithDefault(msg, 7, false)
+    state: (f = msg.getState()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -5756,23 +11898,23 @@ proto.pulumirpc.UpdateRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.UpdateRequest}
+ * @return {!proto.pulumirpc.MigrateStateResponse}
  */
-proto.pulumirpc.UpdateRequest.deserializeBinary = function(bytes) {
+proto.pulumirpc.MigrateStateResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.UpdateRequest;
-  return proto.pulumirpc.UpdateRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pulumirpc.MigrateStateResponse;
+  return proto.pulumirpc.MigrateStateResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pulumirpc.UpdateRequest} msg The message object to deserialize into.
+ * @param {!proto.pulumirpc.MigrateStateResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.UpdateRequest}
+ * @return {!proto.pulumirpc.MigrateStateResponse}
  */
-proto.pulumirpc.UpdateRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.pulumirpc.MigrateStateResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5780,34 +11922,9 @@ proto.pulumirpc.UpdateRequest.deserializeBinaryFromReader = function(msg, reader
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUrn(value);
-      break;
-    case 3:
-      var value = new google_protobuf_struct_pb.Struct;
-      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setOlds(value);
-      break;
-    case 4:
       var value = new google_protobuf_struct_pb.Struct;
       reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setNews(value);
-      break;
-    case 5:
-      var value = /** @type {number} */ (reader.readDouble());
-      msg.setTimeout(value);
-      break;
-    case 6:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addIgnorechanges(value);
-      break;
-    case 7:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setPreview(value);
+      msg.setState(value);
       break;
     default:
       reader.skipField();
@@ -5822,9 +11939,9 @@ proto.pulumirpc.UpdateRequest.deserializeBinaryFromReader = function(msg, reader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pulumirpc.UpdateRequest.prototype.serializeBinary = function() {
+proto.pulumirpc.MigrateStateResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.UpdateRequest.serializeBinaryToWriter(this, writer);
+  proto.pulumirpc.MigrateStateResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5832,127 +11949,48 @@ proto.pulumirpc.UpdateRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.UpdateRequest} message
+ * @param {!proto.pulumirpc.MigrateStateResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.UpdateRequest.serializeBinaryToWriter = function(message, writer) {
+proto.pulumirpc.MigrateStateResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getUrn();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getOlds();
-  if (f != null) {
-    writer.writeMessage(
-      3,
-      f,
-      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
-    );
-  }
-  f = message.getNews();
+  f = message.getState();
   if (f != null) {
     writer.writeMessage(
-      4,
+      1,
       f,
       google_protobuf_struct_pb.Struct.serializeBinaryToWriter
     );
   }
-  f = message.getTimeout();
-  if (f !== 0.0) {
-    writer.writeDouble(
-      5,
-      f
-    );
-  }
-  f = message.getIgnorechangesList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      6,
-      f
-    );
-  }
-  f = message.getPreview();
-  if (f) {
-    writer.writeBool(
-      7,
-      f
-    );
-  }
-};
-
-
-/**
- * optional string id = 1;
- * @return {string}
- */
-proto.pulumirpc.UpdateRequest.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.pulumirpc.UpdateRequest} returns this
- */
-proto.pulumirpc.UpdateRequest.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
-/**
- * optional string urn = 2;
- * @return {string}
- */
-proto.pulumirpc.UpdateRequest.prototype.getUrn = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.pulumirpc.UpdateRequest} returns this
- */
-proto.pulumirpc.UpdateRequest.prototype.setUrn = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional google.protobuf.Struct olds = 3;
- * @return {?proto.google.protobuf.Struct}
+ * optional google.protobuf.Struct state = 1;
+ * @return {?google_protobuf_struct_pb.Struct}
  */
-proto.pulumirpc.UpdateRequest.prototype.getOlds = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 3));
+proto.pulumirpc.MigrateStateResponse.prototype.getState = function() {
+  return /** @type{?google_protobuf_struct_pb.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 1));
 };
 
 
 /**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.pulumirpc.UpdateRequest} returns this
+ * @param {?google_protobuf_struct_pb.Struct|undefined} value
+ * @return {!proto.pulumirpc.MigrateStateResponse} returns this
 */
-proto.pulumirpc.UpdateRequest.prototype.setOlds = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
+proto.pulumirpc.MigrateStateResponse.prototype.setState = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.pulumirpc.UpdateRequest} returns this
+ * @return {!proto.pulumirpc.MigrateStateResponse} returns this
  */
-proto.pulumirpc.UpdateRequest.prototype.clearOlds = function() {
-  return this.setOlds(undefined);
+proto.pulumirpc.MigrateStateResponse.prototype.clearState = function() {
+  return this.setState(undefined);
 };
 
 
@@ -5960,118 +11998,168 @@ proto.pulumirpc.UpdateRequest.prototype.clearOlds = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.pulumirpc.UpdateRequest.prototype.hasOlds = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.pulumirpc.MigrateStateResponse.prototype.hasState = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
-/**
- * optional google.protobuf.Struct news = 4;
- * @return {?proto.google.protobuf.Struct}
- */
-proto.pulumirpc.UpdateRequest.prototype.getNews = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 4));
-};
 
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.pulumirpc.UpdateRequest} returns this
-*/
-proto.pulumirpc.UpdateRequest.prototype.setNews = function(value) {
-  return jspb.Message.setWrapperField(this, 4, value);
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pulumirpc.ResourceChangedEvent.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.ResourceChangedEvent.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.pulumirpc.UpdateRequest} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.ResourceChangedEvent} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.UpdateRequest.prototype.clearNews = function() {
-  return this.setNews(undefined);
+proto.pulumirpc.ResourceChangedEvent.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    urn: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    id: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.ResourceChangedEvent}
  */
-proto.pulumirpc.UpdateRequest.prototype.hasNews = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.pulumirpc.ResourceChangedEvent.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.ResourceChangedEvent;
+  return proto.pulumirpc.ResourceChangedEvent.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * optional double timeout = 5;
- * @return {number}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.ResourceChangedEvent} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.ResourceChangedEvent}
  */
-proto.pulumirpc.UpdateRequest.prototype.getTimeout = function() {
-  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 5, 0.0));
+proto.pulumirpc.ResourceChangedEvent.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUrn(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.pulumirpc.UpdateRequest} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.pulumirpc.UpdateRequest.prototype.setTimeout = function(value) {
-  return jspb.Message.setProto3FloatField(this, 5, value);
+proto.pulumirpc.ResourceChangedEvent.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.ResourceChangedEvent.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * repeated string ignoreChanges = 6;
- * @return {!Array<string>}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.ResourceChangedEvent} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.UpdateRequest.prototype.getIgnorechangesList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 6));
+proto.pulumirpc.ResourceChangedEvent.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUrn();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.pulumirpc.UpdateRequest} returns this
+ * optional string urn = 1;
+ * @return {string}
  */
-proto.pulumirpc.UpdateRequest.prototype.setIgnorechangesList = function(value) {
-  return jspb.Message.setField(this, 6, value || []);
+proto.pulumirpc.ResourceChangedEvent.prototype.getUrn = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.pulumirpc.UpdateRequest} returns this
- */
-proto.pulumirpc.UpdateRequest.prototype.addIgnorechanges = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 6, value, opt_index);
-};
-
-
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.pulumirpc.UpdateRequest} returns this
+ * @return {!proto.pulumirpc.ResourceChangedEvent} returns this
  */
-proto.pulumirpc.UpdateRequest.prototype.clearIgnorechangesList = function() {
-  return this.setIgnorechangesList([]);
+proto.pulumirpc.ResourceChangedEvent.prototype.setUrn = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional bool preview = 7;
- * @return {boolean}
+ * optional string id = 2;
+ * @return {string}
  */
-proto.pulumirpc.UpdateRequest.prototype.getPreview = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 7, false));
+proto.pulumirpc.ResourceChangedEvent.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.pulumirpc.UpdateRequest} returns this
+ * @param {string} value
+ * @return {!proto.pulumirpc.ResourceChangedEvent} returns this
  */
-proto.pulumirpc.UpdateRequest.prototype.setPreview = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 7, value);
+proto.pulumirpc.ResourceChangedEvent.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
@@ -6091,8 +12179,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pulumirpc.UpdateResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.UpdateResponse.toObject(opt_includeInstance, this);
+proto.pulumirpc.EstimateCostRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.EstimateCostRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -6101,13 +12189,14 @@ proto.pulumirpc.UpdateResponse.prototype.toObject = function(opt_includeInstance
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.UpdateResponse} msg The msg instance to transform.
+ * @param {!proto.pulumirpc.EstimateCostRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.UpdateResponse.toObject = function(includeInstance, msg) {
+proto.pulumirpc.EstimateCostRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    properties: (f = msg.getProperties()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+    urn: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    news: (f = msg.getNews()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -6121,23 +12210,23 @@ proto.pulumirpc.UpdateResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.UpdateResponse}
+ * @return {!proto.pulumirpc.EstimateCostRequest}
  */
-proto.pulumirpc.UpdateResponse.deserializeBinary = function(bytes) {
+proto.pulumirpc.EstimateCostRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.UpdateResponse;
-  return proto.pulumirpc.UpdateResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pulumirpc.EstimateCostRequest;
+  return proto.pulumirpc.EstimateCostRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pulumirpc.UpdateResponse} msg The message object to deserialize into.
+ * @param {!proto.pulumirpc.EstimateCostRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.UpdateResponse}
+ * @return {!proto.pulumirpc.EstimateCostRequest}
  */
-proto.pulumirpc.UpdateResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.pulumirpc.EstimateCostRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6145,9 +12234,13 @@ proto.pulumirpc.UpdateResponse.deserializeBinaryFromReader = function(msg, reade
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUrn(value);
+      break;
+    case 2:
       var value = new google_protobuf_struct_pb.Struct;
       reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setProperties(value);
+      msg.setNews(value);
       break;
     default:
       reader.skipField();
@@ -6162,9 +12255,9 @@ proto.pulumirpc.UpdateResponse.deserializeBinaryFromReader = function(msg, reade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pulumirpc.UpdateResponse.prototype.serializeBinary = function() {
+proto.pulumirpc.EstimateCostRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.UpdateResponse.serializeBinaryToWriter(this, writer);
+  proto.pulumirpc.EstimateCostRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6172,16 +12265,23 @@ proto.pulumirpc.UpdateResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.UpdateResponse} message
+ * @param {!proto.pulumirpc.EstimateCostRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.UpdateResponse.serializeBinaryToWriter = function(message, writer) {
+proto.pulumirpc.EstimateCostRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getProperties();
+  f = message.getUrn();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getNews();
   if (f != null) {
     writer.writeMessage(
-      1,
+      2,
       f,
       google_protobuf_struct_pb.Struct.serializeBinaryToWriter
     );
@@ -6190,30 +12290,48 @@ proto.pulumirpc.UpdateResponse.serializeBinaryToWriter = function(message, write
 
 
 /**
- * optional google.protobuf.Struct properties = 1;
- * @return {?proto.google.protobuf.Struct}
+ * optional string urn = 1;
+ * @return {string}
  */
-proto.pulumirpc.UpdateResponse.prototype.getProperties = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 1));
+proto.pulumirpc.EstimateCostRequest.prototype.getUrn = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.pulumirpc.UpdateResponse} returns this
+ * @param {string} value
+ * @return {!proto.pulumirpc.EstimateCostRequest} returns this
+ */
+proto.pulumirpc.EstimateCostRequest.prototype.setUrn = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct news = 2;
+ * @return {?google_protobuf_struct_pb.Struct}
+ */
+proto.pulumirpc.EstimateCostRequest.prototype.getNews = function() {
+  return /** @type{?google_protobuf_struct_pb.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 2));
+};
+
+
+/**
+ * @param {?google_protobuf_struct_pb.Struct|undefined} value
+ * @return {!proto.pulumirpc.EstimateCostRequest} returns this
 */
-proto.pulumirpc.UpdateResponse.prototype.setProperties = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+proto.pulumirpc.EstimateCostRequest.prototype.setNews = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.pulumirpc.UpdateResponse} returns this
+ * @return {!proto.pulumirpc.EstimateCostRequest} returns this
  */
-proto.pulumirpc.UpdateResponse.prototype.clearProperties = function() {
-  return this.setProperties(undefined);
+proto.pulumirpc.EstimateCostRequest.prototype.clearNews = function() {
+  return this.setNews(undefined);
 };
 
 
@@ -6221,8 +12339,8 @@ proto.pulumirpc.UpdateResponse.prototype.clearProperties = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.pulumirpc.UpdateResponse.prototype.hasProperties = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.pulumirpc.EstimateCostRequest.prototype.hasNews = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
@@ -6242,8 +12360,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pulumirpc.DeleteRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.DeleteRequest.toObject(opt_includeInstance, this);
+proto.pulumirpc.EstimateCostResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.EstimateCostResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -6252,16 +12370,15 @@ proto.pulumirpc.DeleteRequest.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.DeleteRequest} msg The msg instance to transform.
+ * @param {!proto.pulumirpc.EstimateCostResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.DeleteRequest.toObject = function(includeInstance, msg) {
+proto.pulumirpc.EstimateCostResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    urn: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    properties: (f = msg.getProperties()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
-    timeout: jspb.Message.getFloatingPointFieldWithDefault(msg, 4, 0.0)
+    monthlycostusd: jspb.Message.getFloatingPointFieldWithDefault(msg, 1, 0.0),
+    currency: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    confidence: jspb.Message.getFloatingPointFieldWithDefault(msg, 3, 0.0)
   };
 
   if (includeInstance) {
@@ -6275,23 +12392,23 @@ proto.pulumirpc.DeleteRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.DeleteRequest}
+ * @return {!proto.pulumirpc.EstimateCostResponse}
  */
-proto.pulumirpc.DeleteRequest.deserializeBinary = function(bytes) {
+proto.pulumirpc.EstimateCostResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.DeleteRequest;
-  return proto.pulumirpc.DeleteRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pulumirpc.EstimateCostResponse;
+  return proto.pulumirpc.EstimateCostResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pulumirpc.DeleteRequest} msg The message object to deserialize into.
+ * @param {!proto.pulumirpc.EstimateCostResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.DeleteRequest}
+ * @return {!proto.pulumirpc.EstimateCostResponse}
  */
-proto.pulumirpc.DeleteRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.pulumirpc.EstimateCostResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6299,21 +12416,16 @@ proto.pulumirpc.DeleteRequest.deserializeBinaryFromReader = function(msg, reader
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setMonthlycostusd(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setUrn(value);
+      msg.setCurrency(value);
       break;
     case 3:
-      var value = new google_protobuf_struct_pb.Struct;
-      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setProperties(value);
-      break;
-    case 4:
       var value = /** @type {number} */ (reader.readDouble());
-      msg.setTimeout(value);
+      msg.setConfidence(value);
       break;
     default:
       reader.skipField();
@@ -6328,9 +12440,9 @@ proto.pulumirpc.DeleteRequest.deserializeBinaryFromReader = function(msg, reader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pulumirpc.DeleteRequest.prototype.serializeBinary = function() {
+proto.pulumirpc.EstimateCostResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.DeleteRequest.serializeBinaryToWriter(this, writer);
+  proto.pulumirpc.EstimateCostResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6338,38 +12450,30 @@ proto.pulumirpc.DeleteRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.DeleteRequest} message
+ * @param {!proto.pulumirpc.EstimateCostResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.DeleteRequest.serializeBinaryToWriter = function(message, writer) {
+proto.pulumirpc.EstimateCostResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getMonthlycostusd();
+  if (f !== 0.0) {
+    writer.writeDouble(
       1,
       f
     );
   }
-  f = message.getUrn();
+  f = message.getCurrency();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getProperties();
-  if (f != null) {
-    writer.writeMessage(
-      3,
-      f,
-      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
-    );
-  }
-  f = message.getTimeout();
+  f = message.getConfidence();
   if (f !== 0.0) {
     writer.writeDouble(
-      4,
+      3,
       f
     );
   }
@@ -6377,103 +12481,217 @@ proto.pulumirpc.DeleteRequest.serializeBinaryToWriter = function(message, writer
 
 
 /**
- * optional string id = 1;
- * @return {string}
+ * optional double monthlyCostUSD = 1;
+ * @return {number}
  */
-proto.pulumirpc.DeleteRequest.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.pulumirpc.EstimateCostResponse.prototype.getMonthlycostusd = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 1, 0.0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.pulumirpc.DeleteRequest} returns this
+ * @param {number} value
+ * @return {!proto.pulumirpc.EstimateCostResponse} returns this
  */
-proto.pulumirpc.DeleteRequest.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.pulumirpc.EstimateCostResponse.prototype.setMonthlycostusd = function(value) {
+  return jspb.Message.setProto3FloatField(this, 1, value);
 };
 
 
 /**
- * optional string urn = 2;
+ * optional string currency = 2;
  * @return {string}
  */
-proto.pulumirpc.DeleteRequest.prototype.getUrn = function() {
+proto.pulumirpc.EstimateCostResponse.prototype.getCurrency = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.pulumirpc.DeleteRequest} returns this
+ * @return {!proto.pulumirpc.EstimateCostResponse} returns this
  */
-proto.pulumirpc.DeleteRequest.prototype.setUrn = function(value) {
+proto.pulumirpc.EstimateCostResponse.prototype.setCurrency = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional google.protobuf.Struct properties = 3;
- * @return {?proto.google.protobuf.Struct}
+ * optional double confidence = 3;
+ * @return {number}
  */
-proto.pulumirpc.DeleteRequest.prototype.getProperties = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 3));
+proto.pulumirpc.EstimateCostResponse.prototype.getConfidence = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 3, 0.0));
 };
 
 
 /**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.pulumirpc.DeleteRequest} returns this
-*/
-proto.pulumirpc.DeleteRequest.prototype.setProperties = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
+ * @param {number} value
+ * @return {!proto.pulumirpc.EstimateCostResponse} returns this
+ */
+proto.pulumirpc.EstimateCostResponse.prototype.setConfidence = function(value) {
+  return jspb.Message.setProto3FloatField(this, 3, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.pulumirpc.GetSupportedVersionsResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pulumirpc.GetSupportedVersionsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.GetSupportedVersionsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.GetSupportedVersionsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.GetSupportedVersionsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    versionsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.GetSupportedVersionsResponse}
+ */
+proto.pulumirpc.GetSupportedVersionsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.GetSupportedVersionsResponse;
+  return proto.pulumirpc.GetSupportedVersionsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.GetSupportedVersionsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.GetSupportedVersionsResponse}
+ */
+proto.pulumirpc.GetSupportedVersionsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var values = /** @type {!Array<number>} */ (reader.isDelimited() ? reader.readPackedInt32() : [reader.readInt32()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addVersions(values[i]);
+      }
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pulumirpc.GetSupportedVersionsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.GetSupportedVersionsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.pulumirpc.DeleteRequest} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.GetSupportedVersionsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.DeleteRequest.prototype.clearProperties = function() {
-  return this.setProperties(undefined);
+proto.pulumirpc.GetSupportedVersionsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getVersionsList();
+  if (f.length > 0) {
+    writer.writePackedInt32(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * repeated int32 versions = 1;
+ * @return {!Array<number>}
  */
-proto.pulumirpc.DeleteRequest.prototype.hasProperties = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.pulumirpc.GetSupportedVersionsResponse.prototype.getVersionsList = function() {
+  return /** @type {!Array<number>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
 
 /**
- * optional double timeout = 4;
- * @return {number}
+ * @param {!Array<number>} value
+ * @return {!proto.pulumirpc.GetSupportedVersionsResponse} returns this
  */
-proto.pulumirpc.DeleteRequest.prototype.getTimeout = function() {
-  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 4, 0.0));
+proto.pulumirpc.GetSupportedVersionsResponse.prototype.setVersionsList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.pulumirpc.DeleteRequest} returns this
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.GetSupportedVersionsResponse} returns this
  */
-proto.pulumirpc.DeleteRequest.prototype.setTimeout = function(value) {
-  return jspb.Message.setProto3FloatField(this, 4, value);
+proto.pulumirpc.GetSupportedVersionsResponse.prototype.addVersions = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
 };
 
 
-
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.GetSupportedVersionsResponse} returns this
  */
-proto.pulumirpc.ConstructRequest.repeatedFields_ = [14,15,16];
+proto.pulumirpc.GetSupportedVersionsResponse.prototype.clearVersionsList = function() {
+  return this.setVersionsList([]);
+};
+
+
 
 
 
@@ -6490,8 +12708,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pulumirpc.ConstructRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.ConstructRequest.toObject(opt_includeInstance, this);
+proto.pulumirpc.ParameterizeByValueRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.ParameterizeByValueRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -6500,29 +12718,13 @@ proto.pulumirpc.ConstructRequest.prototype.toObject = function(opt_includeInstan
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.ConstructRequest} msg The msg instance to transform.
+ * @param {!proto.pulumirpc.ParameterizeByValueRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.ConstructRequest.toObject = function(includeInstance, msg) {
+proto.pulumirpc.ParameterizeByValueRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    project: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    stack: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    configMap: (f = msg.getConfigMap()) ? f.toObject(includeInstance, undefined) : [],
-    dryrun: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
-    parallel: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    monitorendpoint: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    type: jspb.Message.getFieldWithDefault(msg, 7, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 8, ""),
-    parent: jspb.Message.getFieldWithDefault(msg, 9, ""),
-    inputs: (f = msg.getInputs()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
-    inputdependenciesMap: (f = msg.getInputdependenciesMap()) ? f.toObject(includeInstance, proto.pulumirpc.ConstructRequest.PropertyDependencies.toObject) : [],
-    protect: jspb.Message.getBooleanFieldWithDefault(msg, 12, false),
-    providersMap: (f = msg.getProvidersMap()) ? f.toObject(includeInstance, undefined) : [],
-    aliasesList: (f = jspb.Message.getRepeatedField(msg, 14)) == null ? undefined : f,
-    dependenciesList: (f = jspb.Message.getRepeatedField(msg, 15)) == null ? undefined : f,
-    configsecretkeysList: (f = jspb.Message.getRepeatedField(msg, 16)) == null ? undefined : f,
-    organization: jspb.Message.getFieldWithDefault(msg, 17, "")
+    value: msg.getValue_asB64()
   };
 
   if (includeInstance) {
@@ -6536,23 +12738,23 @@ proto.pulumirpc.ConstructRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.ConstructRequest}
+ * @return {!proto.pulumirpc.ParameterizeByValueRequest}
  */
-proto.pulumirpc.ConstructRequest.deserializeBinary = function(bytes) {
+proto.pulumirpc.ParameterizeByValueRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.ConstructRequest;
-  return proto.pulumirpc.ConstructRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pulumirpc.ParameterizeByValueRequest;
+  return proto.pulumirpc.ParameterizeByValueRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pulumirpc.ConstructRequest} msg The message object to deserialize into.
+ * @param {!proto.pulumirpc.ParameterizeByValueRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.ConstructRequest}
+ * @return {!proto.pulumirpc.ParameterizeByValueRequest}
  */
-proto.pulumirpc.ConstructRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.pulumirpc.ParameterizeByValueRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6560,79 +12762,8 @@ proto.pulumirpc.ConstructRequest.deserializeBinaryFromReader = function(msg, rea
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setProject(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setStack(value);
-      break;
-    case 3:
-      var value = msg.getConfigMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
-         });
-      break;
-    case 4:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setDryrun(value);
-      break;
-    case 5:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setParallel(value);
-      break;
-    case 6:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setMonitorendpoint(value);
-      break;
-    case 7:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setType(value);
-      break;
-    case 8:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
-      break;
-    case 9:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setParent(value);
-      break;
-    case 10:
-      var value = new google_protobuf_struct_pb.Struct;
-      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setInputs(value);
-      break;
-    case 11:
-      var value = msg.getInputdependenciesMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.pulumirpc.ConstructRequest.PropertyDependencies.deserializeBinaryFromReader, "", new proto.pulumirpc.ConstructRequest.PropertyDependencies());
-         });
-      break;
-    case 12:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setProtect(value);
-      break;
-    case 13:
-      var value = msg.getProvidersMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
-         });
-      break;
-    case 14:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addAliases(value);
-      break;
-    case 15:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addDependencies(value);
-      break;
-    case 16:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addConfigsecretkeys(value);
-      break;
-    case 17:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setOrganization(value);
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setValue(value);
       break;
     default:
       reader.skipField();
@@ -6647,9 +12778,9 @@ proto.pulumirpc.ConstructRequest.deserializeBinaryFromReader = function(msg, rea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pulumirpc.ConstructRequest.prototype.serializeBinary = function() {
+proto.pulumirpc.ParameterizeByValueRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.ConstructRequest.serializeBinaryToWriter(this, writer);
+  proto.pulumirpc.ParameterizeByValueRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6657,133 +12788,64 @@ proto.pulumirpc.ConstructRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.ConstructRequest} message
+ * @param {!proto.pulumirpc.ParameterizeByValueRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.ConstructRequest.serializeBinaryToWriter = function(message, writer) {
+proto.pulumirpc.ParameterizeByValueRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getProject();
+  f = message.getValue_asU8();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeBytes(
       1,
       f
     );
   }
-  f = message.getStack();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getConfigMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(3, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
-  }
-  f = message.getDryrun();
-  if (f) {
-    writer.writeBool(
-      4,
-      f
-    );
-  }
-  f = message.getParallel();
-  if (f !== 0) {
-    writer.writeInt32(
-      5,
-      f
-    );
-  }
-  f = message.getMonitorendpoint();
-  if (f.length > 0) {
-    writer.writeString(
-      6,
-      f
-    );
-  }
-  f = message.getType();
-  if (f.length > 0) {
-    writer.writeString(
-      7,
-      f
-    );
-  }
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
-      8,
-      f
-    );
-  }
-  f = message.getParent();
-  if (f.length > 0) {
-    writer.writeString(
-      9,
-      f
-    );
-  }
-  f = message.getInputs();
-  if (f != null) {
-    writer.writeMessage(
-      10,
-      f,
-      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
-    );
-  }
-  f = message.getInputdependenciesMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(11, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.pulumirpc.ConstructRequest.PropertyDependencies.serializeBinaryToWriter);
-  }
-  f = message.getProtect();
-  if (f) {
-    writer.writeBool(
-      12,
-      f
-    );
-  }
-  f = message.getProvidersMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(13, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
-  }
-  f = message.getAliasesList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      14,
-      f
-    );
-  }
-  f = message.getDependenciesList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      15,
-      f
-    );
-  }
-  f = message.getConfigsecretkeysList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      16,
-      f
-    );
-  }
-  f = message.getOrganization();
-  if (f.length > 0) {
-    writer.writeString(
-      17,
-      f
-    );
-  }
 };
 
 
+/**
+ * optional bytes value = 1;
+ * @return {!(string|Uint8Array)}
+ */
+proto.pulumirpc.ParameterizeByValueRequest.prototype.getValue = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * optional bytes value = 1;
+ * This is a type-conversion wrapper around `getValue()`
+ * @return {string}
+ */
+proto.pulumirpc.ParameterizeByValueRequest.prototype.getValue_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getValue()));
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * optional bytes value = 1;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getValue()`
+ * @return {!Uint8Array}
  */
-proto.pulumirpc.ConstructRequest.PropertyDependencies.repeatedFields_ = [1];
+proto.pulumirpc.ParameterizeByValueRequest.prototype.getValue_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getValue()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.pulumirpc.ParameterizeByValueRequest} returns this
+ */
+proto.pulumirpc.ParameterizeByValueRequest.prototype.setValue = function(value) {
+  return jspb.Message.setProto3BytesField(this, 1, value);
+};
+
+
 
 
 
@@ -6800,8 +12862,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pulumirpc.ConstructRequest.PropertyDependencies.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.ConstructRequest.PropertyDependencies.toObject(opt_includeInstance, this);
+proto.pulumirpc.ParameterizeByReferenceRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.ParameterizeByReferenceRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -6810,13 +12872,14 @@ proto.pulumirpc.ConstructRequest.PropertyDependencies.prototype.toObject = funct
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.ConstructRequest.PropertyDependencies} msg The msg instance to transform.
+ * @param {!proto.pulumirpc.ParameterizeByReferenceRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.ConstructRequest.PropertyDependencies.toObject = function(includeInstance, msg) {
+proto.pulumirpc.ParameterizeByReferenceRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    urnsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    version: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -6830,23 +12893,23 @@ proto.pulumirpc.ConstructRequest.PropertyDependencies.toObject = function(includ
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.ConstructRequest.PropertyDependencies}
+ * @return {!proto.pulumirpc.ParameterizeByReferenceRequest}
  */
-proto.pulumirpc.ConstructRequest.PropertyDependencies.deserializeBinary = function(bytes) {
+proto.pulumirpc.ParameterizeByReferenceRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.ConstructRequest.PropertyDependencies;
-  return proto.pulumirpc.ConstructRequest.PropertyDependencies.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pulumirpc.ParameterizeByReferenceRequest;
+  return proto.pulumirpc.ParameterizeByReferenceRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pulumirpc.ConstructRequest.PropertyDependencies} msg The message object to deserialize into.
+ * @param {!proto.pulumirpc.ParameterizeByReferenceRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.ConstructRequest.PropertyDependencies}
+ * @return {!proto.pulumirpc.ParameterizeByReferenceRequest}
  */
-proto.pulumirpc.ConstructRequest.PropertyDependencies.deserializeBinaryFromReader = function(msg, reader) {
+proto.pulumirpc.ParameterizeByReferenceRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6855,7 +12918,11 @@ proto.pulumirpc.ConstructRequest.PropertyDependencies.deserializeBinaryFromReade
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.addUrns(value);
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setVersion(value);
       break;
     default:
       reader.skipField();
@@ -6870,9 +12937,9 @@ proto.pulumirpc.ConstructRequest.PropertyDependencies.deserializeBinaryFromReade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pulumirpc.ConstructRequest.PropertyDependencies.prototype.serializeBinary = function() {
+proto.pulumirpc.ParameterizeByReferenceRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.ConstructRequest.PropertyDependencies.serializeBinaryToWriter(this, writer);
+  proto.pulumirpc.ParameterizeByReferenceRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6880,450 +12947,533 @@ proto.pulumirpc.ConstructRequest.PropertyDependencies.prototype.serializeBinary
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.ConstructRequest.PropertyDependencies} message
+ * @param {!proto.pulumirpc.ParameterizeByReferenceRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.ConstructRequest.PropertyDependencies.serializeBinaryToWriter = function(message, writer) {
+proto.pulumirpc.ParameterizeByReferenceRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUrnsList();
+  f = message.getName();
   if (f.length > 0) {
-    writer.writeRepeatedString(
+    writer.writeString(
       1,
       f
     );
   }
+  f = message.getVersion();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * repeated string urns = 1;
- * @return {!Array<string>}
- */
-proto.pulumirpc.ConstructRequest.PropertyDependencies.prototype.getUrnsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
-};
-
-
-/**
- * @param {!Array<string>} value
- * @return {!proto.pulumirpc.ConstructRequest.PropertyDependencies} returns this
- */
-proto.pulumirpc.ConstructRequest.PropertyDependencies.prototype.setUrnsList = function(value) {
-  return jspb.Message.setField(this, 1, value || []);
-};
-
-
-/**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.pulumirpc.ConstructRequest.PropertyDependencies} returns this
- */
-proto.pulumirpc.ConstructRequest.PropertyDependencies.prototype.addUrns = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
-};
-
-
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.pulumirpc.ConstructRequest.PropertyDependencies} returns this
- */
-proto.pulumirpc.ConstructRequest.PropertyDependencies.prototype.clearUrnsList = function() {
-  return this.setUrnsList([]);
-};
-
-
-/**
- * optional string project = 1;
+ * optional string name = 1;
  * @return {string}
  */
-proto.pulumirpc.ConstructRequest.prototype.getProject = function() {
+proto.pulumirpc.ParameterizeByReferenceRequest.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.pulumirpc.ConstructRequest} returns this
+ * @return {!proto.pulumirpc.ParameterizeByReferenceRequest} returns this
  */
-proto.pulumirpc.ConstructRequest.prototype.setProject = function(value) {
+proto.pulumirpc.ParameterizeByReferenceRequest.prototype.setName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string stack = 2;
+ * optional string version = 2;
  * @return {string}
  */
-proto.pulumirpc.ConstructRequest.prototype.getStack = function() {
+proto.pulumirpc.ParameterizeByReferenceRequest.prototype.getVersion = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.pulumirpc.ConstructRequest} returns this
+ * @return {!proto.pulumirpc.ParameterizeByReferenceRequest} returns this
  */
-proto.pulumirpc.ConstructRequest.prototype.setStack = function(value) {
+proto.pulumirpc.ParameterizeByReferenceRequest.prototype.setVersion = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
-/**
- * map<string, string> config = 3;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,string>}
- */
-proto.pulumirpc.ConstructRequest.prototype.getConfigMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,string>} */ (
-      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
-      null));
-};
-
-
-/**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.pulumirpc.ConstructRequest} returns this
- */
-proto.pulumirpc.ConstructRequest.prototype.clearConfigMap = function() {
-  this.getConfigMap().clear();
-  return this;};
-
-
-/**
- * optional bool dryRun = 4;
- * @return {boolean}
- */
-proto.pulumirpc.ConstructRequest.prototype.getDryrun = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.pulumirpc.ConstructRequest} returns this
- */
-proto.pulumirpc.ConstructRequest.prototype.setDryrun = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 4, value);
-};
-
 
-/**
- * optional int32 parallel = 5;
- * @return {number}
- */
-proto.pulumirpc.ConstructRequest.prototype.getParallel = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * @param {number} value
- * @return {!proto.pulumirpc.ConstructRequest} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.pulumirpc.ConstructRequest.prototype.setParallel = function(value) {
-  return jspb.Message.setProto3IntField(this, 5, value);
+proto.pulumirpc.ParameterizeResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.ParameterizeResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * optional string monitorEndpoint = 6;
- * @return {string}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.ParameterizeResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.ConstructRequest.prototype.getMonitorendpoint = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
-};
-
+proto.pulumirpc.ParameterizeResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    version: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
 
-/**
- * @param {string} value
- * @return {!proto.pulumirpc.ConstructRequest} returns this
- */
-proto.pulumirpc.ConstructRequest.prototype.setMonitorendpoint = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional string type = 7;
- * @return {string}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.ParameterizeResponse}
  */
-proto.pulumirpc.ConstructRequest.prototype.getType = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+proto.pulumirpc.ParameterizeResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.ParameterizeResponse;
+  return proto.pulumirpc.ParameterizeResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.pulumirpc.ConstructRequest} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.ParameterizeResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.ParameterizeResponse}
  */
-proto.pulumirpc.ConstructRequest.prototype.setType = function(value) {
-  return jspb.Message.setProto3StringField(this, 7, value);
+proto.pulumirpc.ParameterizeResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setVersion(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional string name = 8;
- * @return {string}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.pulumirpc.ConstructRequest.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+proto.pulumirpc.ParameterizeResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.ParameterizeResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.pulumirpc.ConstructRequest} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.ParameterizeResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.ConstructRequest.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 8, value);
+proto.pulumirpc.ParameterizeResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getVersion();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string parent = 9;
+ * optional string name = 1;
  * @return {string}
  */
-proto.pulumirpc.ConstructRequest.prototype.getParent = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+proto.pulumirpc.ParameterizeResponse.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.pulumirpc.ConstructRequest} returns this
+ * @return {!proto.pulumirpc.ParameterizeResponse} returns this
  */
-proto.pulumirpc.ConstructRequest.prototype.setParent = function(value) {
-  return jspb.Message.setProto3StringField(this, 9, value);
+proto.pulumirpc.ParameterizeResponse.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional google.protobuf.Struct inputs = 10;
- * @return {?proto.google.protobuf.Struct}
+ * optional string version = 2;
+ * @return {string}
  */
-proto.pulumirpc.ConstructRequest.prototype.getInputs = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 10));
+proto.pulumirpc.ParameterizeResponse.prototype.getVersion = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.pulumirpc.ConstructRequest} returns this
-*/
-proto.pulumirpc.ConstructRequest.prototype.setInputs = function(value) {
-  return jspb.Message.setWrapperField(this, 10, value);
+ * @param {string} value
+ * @return {!proto.pulumirpc.ParameterizeResponse} returns this
+ */
+proto.pulumirpc.ParameterizeResponse.prototype.setVersion = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
-/**
- * Clears the message field making it undefined.
- * @return {!proto.pulumirpc.ConstructRequest} returns this
- */
-proto.pulumirpc.ConstructRequest.prototype.clearInputs = function() {
-  return this.setInputs(undefined);
-};
 
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.pulumirpc.ConstructRequest.prototype.hasInputs = function() {
-  return jspb.Message.getField(this, 10) != null;
+proto.pulumirpc.PrepareImportRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.PrepareImportRequest.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * map<string, PropertyDependencies> inputDependencies = 11;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.pulumirpc.ConstructRequest.PropertyDependencies>}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.PrepareImportRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.ConstructRequest.prototype.getInputdependenciesMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.pulumirpc.ConstructRequest.PropertyDependencies>} */ (
-      jspb.Message.getMapField(this, 11, opt_noLazyCreate,
-      proto.pulumirpc.ConstructRequest.PropertyDependencies));
+proto.pulumirpc.PrepareImportRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    urn: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    id: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.pulumirpc.ConstructRequest} returns this
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.PrepareImportRequest}
  */
-proto.pulumirpc.ConstructRequest.prototype.clearInputdependenciesMap = function() {
-  this.getInputdependenciesMap().clear();
-  return this;};
+proto.pulumirpc.PrepareImportRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.PrepareImportRequest;
+  return proto.pulumirpc.PrepareImportRequest.deserializeBinaryFromReader(msg, reader);
+};
 
 
 /**
- * optional bool protect = 12;
- * @return {boolean}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.PrepareImportRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.PrepareImportRequest}
  */
-proto.pulumirpc.ConstructRequest.prototype.getProtect = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 12, false));
+proto.pulumirpc.PrepareImportRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUrn(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.pulumirpc.ConstructRequest} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.pulumirpc.ConstructRequest.prototype.setProtect = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 12, value);
+proto.pulumirpc.PrepareImportRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.PrepareImportRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * map<string, string> providers = 13;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,string>}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.PrepareImportRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.ConstructRequest.prototype.getProvidersMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,string>} */ (
-      jspb.Message.getMapField(this, 13, opt_noLazyCreate,
-      null));
+proto.pulumirpc.PrepareImportRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUrn();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.pulumirpc.ConstructRequest} returns this
+ * optional string urn = 1;
+ * @return {string}
  */
-proto.pulumirpc.ConstructRequest.prototype.clearProvidersMap = function() {
-  this.getProvidersMap().clear();
-  return this;};
+proto.pulumirpc.PrepareImportRequest.prototype.getUrn = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
 
 
 /**
- * repeated string aliases = 14;
- * @return {!Array<string>}
+ * @param {string} value
+ * @return {!proto.pulumirpc.PrepareImportRequest} returns this
  */
-proto.pulumirpc.ConstructRequest.prototype.getAliasesList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 14));
+proto.pulumirpc.PrepareImportRequest.prototype.setUrn = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.pulumirpc.ConstructRequest} returns this
+ * optional string id = 2;
+ * @return {string}
  */
-proto.pulumirpc.ConstructRequest.prototype.setAliasesList = function(value) {
-  return jspb.Message.setField(this, 14, value || []);
+proto.pulumirpc.PrepareImportRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.pulumirpc.ConstructRequest} returns this
+ * @return {!proto.pulumirpc.PrepareImportRequest} returns this
  */
-proto.pulumirpc.ConstructRequest.prototype.addAliases = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 14, value, opt_index);
+proto.pulumirpc.PrepareImportRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.pulumirpc.ConstructRequest} returns this
- */
-proto.pulumirpc.ConstructRequest.prototype.clearAliasesList = function() {
-  return this.setAliasesList([]);
-};
 
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * repeated string dependencies = 15;
- * @return {!Array<string>}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.pulumirpc.ConstructRequest.prototype.getDependenciesList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 15));
+proto.pulumirpc.PrepareImportResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.PrepareImportResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.pulumirpc.ConstructRequest} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.PrepareImportResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.ConstructRequest.prototype.setDependenciesList = function(value) {
-  return jspb.Message.setField(this, 15, value || []);
+proto.pulumirpc.PrepareImportResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    inputs: (f = msg.getInputs()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.pulumirpc.ConstructRequest} returns this
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.PrepareImportResponse}
  */
-proto.pulumirpc.ConstructRequest.prototype.addDependencies = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 15, value, opt_index);
+proto.pulumirpc.PrepareImportResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.PrepareImportResponse;
+  return proto.pulumirpc.PrepareImportResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.pulumirpc.ConstructRequest} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.PrepareImportResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.PrepareImportResponse}
  */
-proto.pulumirpc.ConstructRequest.prototype.clearDependenciesList = function() {
-  return this.setDependenciesList([]);
+proto.pulumirpc.PrepareImportResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setInputs(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * repeated string configSecretKeys = 16;
- * @return {!Array<string>}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.pulumirpc.ConstructRequest.prototype.getConfigsecretkeysList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 16));
+proto.pulumirpc.PrepareImportResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.PrepareImportResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.pulumirpc.ConstructRequest} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.PrepareImportResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.ConstructRequest.prototype.setConfigsecretkeysList = function(value) {
-  return jspb.Message.setField(this, 16, value || []);
+proto.pulumirpc.PrepareImportResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getInputs();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.pulumirpc.ConstructRequest} returns this
+ * optional google.protobuf.Struct inputs = 1;
+ * @return {?google_protobuf_struct_pb.Struct}
  */
-proto.pulumirpc.ConstructRequest.prototype.addConfigsecretkeys = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 16, value, opt_index);
+proto.pulumirpc.PrepareImportResponse.prototype.getInputs = function() {
+  return /** @type{?google_protobuf_struct_pb.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 1));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.pulumirpc.ConstructRequest} returns this
- */
-proto.pulumirpc.ConstructRequest.prototype.clearConfigsecretkeysList = function() {
-  return this.setConfigsecretkeysList([]);
+ * @param {?google_protobuf_struct_pb.Struct|undefined} value
+ * @return {!proto.pulumirpc.PrepareImportResponse} returns this
+*/
+proto.pulumirpc.PrepareImportResponse.prototype.setInputs = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
- * optional string organization = 17;
- * @return {string}
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.PrepareImportResponse} returns this
  */
-proto.pulumirpc.ConstructRequest.prototype.getOrganization = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 17, ""));
+proto.pulumirpc.PrepareImportResponse.prototype.clearInputs = function() {
+  return this.setInputs(undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.pulumirpc.ConstructRequest} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.pulumirpc.ConstructRequest.prototype.setOrganization = function(value) {
-  return jspb.Message.setProto3StringField(this, 17, value);
+proto.pulumirpc.PrepareImportResponse.prototype.hasInputs = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
@@ -7343,8 +13493,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pulumirpc.ConstructResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.ConstructResponse.toObject(opt_includeInstance, this);
+proto.pulumirpc.ConfigChecksumMatchRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.ConfigChecksumMatchRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -7353,15 +13503,14 @@ proto.pulumirpc.ConstructResponse.prototype.toObject = function(opt_includeInsta
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.ConstructResponse} msg The msg instance to transform.
+ * @param {!proto.pulumirpc.ConfigChecksumMatchRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.ConstructResponse.toObject = function(includeInstance, msg) {
+proto.pulumirpc.ConfigChecksumMatchRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     urn: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    state: (f = msg.getState()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
-    statedependenciesMap: (f = msg.getStatedependenciesMap()) ? f.toObject(includeInstance, proto.pulumirpc.ConstructResponse.PropertyDependencies.toObject) : []
+    checksum: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -7375,23 +13524,23 @@ proto.pulumirpc.ConstructResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.ConstructResponse}
+ * @return {!proto.pulumirpc.ConfigChecksumMatchRequest}
  */
-proto.pulumirpc.ConstructResponse.deserializeBinary = function(bytes) {
+proto.pulumirpc.ConfigChecksumMatchRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.ConstructResponse;
-  return proto.pulumirpc.ConstructResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pulumirpc.ConfigChecksumMatchRequest;
+  return proto.pulumirpc.ConfigChecksumMatchRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pulumirpc.ConstructResponse} msg The message object to deserialize into.
+ * @param {!proto.pulumirpc.ConfigChecksumMatchRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.ConstructResponse}
+ * @return {!proto.pulumirpc.ConfigChecksumMatchRequest}
  */
-proto.pulumirpc.ConstructResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.pulumirpc.ConfigChecksumMatchRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7403,15 +13552,8 @@ proto.pulumirpc.ConstructResponse.deserializeBinaryFromReader = function(msg, re
       msg.setUrn(value);
       break;
     case 2:
-      var value = new google_protobuf_struct_pb.Struct;
-      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setState(value);
-      break;
-    case 3:
-      var value = msg.getStatedependenciesMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.pulumirpc.ConstructResponse.PropertyDependencies.deserializeBinaryFromReader, "", new proto.pulumirpc.ConstructResponse.PropertyDependencies());
-         });
+      var value = /** @type {string} */ (reader.readString());
+      msg.setChecksum(value);
       break;
     default:
       reader.skipField();
@@ -7426,9 +13568,9 @@ proto.pulumirpc.ConstructResponse.deserializeBinaryFromReader = function(msg, re
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pulumirpc.ConstructResponse.prototype.serializeBinary = function() {
+proto.pulumirpc.ConfigChecksumMatchRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.ConstructResponse.serializeBinaryToWriter(this, writer);
+  proto.pulumirpc.ConfigChecksumMatchRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7436,11 +13578,11 @@ proto.pulumirpc.ConstructResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.ConstructResponse} message
+ * @param {!proto.pulumirpc.ConfigChecksumMatchRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.ConstructResponse.serializeBinaryToWriter = function(message, writer) {
+proto.pulumirpc.ConfigChecksumMatchRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getUrn();
   if (f.length > 0) {
@@ -7449,28 +13591,52 @@ proto.pulumirpc.ConstructResponse.serializeBinaryToWriter = function(message, wr
       f
     );
   }
-  f = message.getState();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getChecksum();
+  if (f.length > 0) {
+    writer.writeString(
       2,
-      f,
-      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getStatedependenciesMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(3, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.pulumirpc.ConstructResponse.PropertyDependencies.serializeBinaryToWriter);
-  }
 };
 
 
+/**
+ * optional string urn = 1;
+ * @return {string}
+ */
+proto.pulumirpc.ConfigChecksumMatchRequest.prototype.getUrn = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.ConfigChecksumMatchRequest} returns this
+ */
+proto.pulumirpc.ConfigChecksumMatchRequest.prototype.setUrn = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * optional string checksum = 2;
+ * @return {string}
  */
-proto.pulumirpc.ConstructResponse.PropertyDependencies.repeatedFields_ = [1];
+proto.pulumirpc.ConfigChecksumMatchRequest.prototype.getChecksum = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.ConfigChecksumMatchRequest} returns this
+ */
+proto.pulumirpc.ConfigChecksumMatchRequest.prototype.setChecksum = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
 
 
 
@@ -7487,8 +13653,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pulumirpc.ConstructResponse.PropertyDependencies.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.ConstructResponse.PropertyDependencies.toObject(opt_includeInstance, this);
+proto.pulumirpc.ConfigChecksumMatchResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.ConfigChecksumMatchResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -7497,13 +13663,13 @@ proto.pulumirpc.ConstructResponse.PropertyDependencies.prototype.toObject = func
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.ConstructResponse.PropertyDependencies} msg The msg instance to transform.
+ * @param {!proto.pulumirpc.ConfigChecksumMatchResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.ConstructResponse.PropertyDependencies.toObject = function(includeInstance, msg) {
+proto.pulumirpc.ConfigChecksumMatchResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    urnsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+    match: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
   };
 
   if (includeInstance) {
@@ -7517,23 +13683,23 @@ proto.pulumirpc.ConstructResponse.PropertyDependencies.toObject = function(inclu
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.ConstructResponse.PropertyDependencies}
+ * @return {!proto.pulumirpc.ConfigChecksumMatchResponse}
  */
-proto.pulumirpc.ConstructResponse.PropertyDependencies.deserializeBinary = function(bytes) {
+proto.pulumirpc.ConfigChecksumMatchResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.ConstructResponse.PropertyDependencies;
-  return proto.pulumirpc.ConstructResponse.PropertyDependencies.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pulumirpc.ConfigChecksumMatchResponse;
+  return proto.pulumirpc.ConfigChecksumMatchResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pulumirpc.ConstructResponse.PropertyDependencies} msg The message object to deserialize into.
+ * @param {!proto.pulumirpc.ConfigChecksumMatchResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.ConstructResponse.PropertyDependencies}
+ * @return {!proto.pulumirpc.ConfigChecksumMatchResponse}
  */
-proto.pulumirpc.ConstructResponse.PropertyDependencies.deserializeBinaryFromReader = function(msg, reader) {
+proto.pulumirpc.ConfigChecksumMatchResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7541,8 +13707,8 @@ proto.pulumirpc.ConstructResponse.PropertyDependencies.deserializeBinaryFromRead
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addUrns(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setMatch(value);
       break;
     default:
       reader.skipField();
@@ -7557,9 +13723,9 @@ proto.pulumirpc.ConstructResponse.PropertyDependencies.deserializeBinaryFromRead
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pulumirpc.ConstructResponse.PropertyDependencies.prototype.serializeBinary = function() {
+proto.pulumirpc.ConfigChecksumMatchResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.ConstructResponse.PropertyDependencies.serializeBinaryToWriter(this, writer);
+  proto.pulumirpc.ConfigChecksumMatchResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7567,15 +13733,15 @@ proto.pulumirpc.ConstructResponse.PropertyDependencies.prototype.serializeBinary
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.ConstructResponse.PropertyDependencies} message
+ * @param {!proto.pulumirpc.ConfigChecksumMatchResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.ConstructResponse.PropertyDependencies.serializeBinaryToWriter = function(message, writer) {
+proto.pulumirpc.ConfigChecksumMatchResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUrnsList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
+  f = message.getMatch();
+  if (f) {
+    writer.writeBool(
       1,
       f
     );
@@ -7584,126 +13750,213 @@ proto.pulumirpc.ConstructResponse.PropertyDependencies.serializeBinaryToWriter =
 
 
 /**
- * repeated string urns = 1;
- * @return {!Array<string>}
+ * optional bool match = 1;
+ * @return {boolean}
  */
-proto.pulumirpc.ConstructResponse.PropertyDependencies.prototype.getUrnsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+proto.pulumirpc.ConfigChecksumMatchResponse.prototype.getMatch = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.pulumirpc.ConstructResponse.PropertyDependencies} returns this
+ * @param {boolean} value
+ * @return {!proto.pulumirpc.ConfigChecksumMatchResponse} returns this
  */
-proto.pulumirpc.ConstructResponse.PropertyDependencies.prototype.setUrnsList = function(value) {
-  return jspb.Message.setField(this, 1, value || []);
+proto.pulumirpc.ConfigChecksumMatchResponse.prototype.setMatch = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.pulumirpc.ConstructResponse.PropertyDependencies} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.pulumirpc.ConstructResponse.PropertyDependencies.prototype.addUrns = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+proto.pulumirpc.WaitForResourceReadyRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.WaitForResourceReadyRequest.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.pulumirpc.ConstructResponse.PropertyDependencies} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.WaitForResourceReadyRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.ConstructResponse.PropertyDependencies.prototype.clearUrnsList = function() {
-  return this.setUrnsList([]);
+proto.pulumirpc.WaitForResourceReadyRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    urn: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    id: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    timeout: jspb.Message.getFloatingPointFieldWithDefault(msg, 3, 0.0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional string urn = 1;
- * @return {string}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.WaitForResourceReadyRequest}
  */
-proto.pulumirpc.ConstructResponse.prototype.getUrn = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.pulumirpc.WaitForResourceReadyRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.WaitForResourceReadyRequest;
+  return proto.pulumirpc.WaitForResourceReadyRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.pulumirpc.ConstructResponse} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.WaitForResourceReadyRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.WaitForResourceReadyRequest}
  */
-proto.pulumirpc.ConstructResponse.prototype.setUrn = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.pulumirpc.WaitForResourceReadyRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUrn(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setTimeout(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pulumirpc.WaitForResourceReadyRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.WaitForResourceReadyRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.WaitForResourceReadyRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.WaitForResourceReadyRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUrn();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getTimeout();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      3,
+      f
+    );
+  }
 };
 
 
 /**
- * optional google.protobuf.Struct state = 2;
- * @return {?proto.google.protobuf.Struct}
+ * optional string urn = 1;
+ * @return {string}
  */
-proto.pulumirpc.ConstructResponse.prototype.getState = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 2));
+proto.pulumirpc.WaitForResourceReadyRequest.prototype.getUrn = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.pulumirpc.ConstructResponse} returns this
-*/
-proto.pulumirpc.ConstructResponse.prototype.setState = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
+ * @param {string} value
+ * @return {!proto.pulumirpc.WaitForResourceReadyRequest} returns this
+ */
+proto.pulumirpc.WaitForResourceReadyRequest.prototype.setUrn = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.pulumirpc.ConstructResponse} returns this
+ * optional string id = 2;
+ * @return {string}
  */
-proto.pulumirpc.ConstructResponse.prototype.clearState = function() {
-  return this.setState(undefined);
+proto.pulumirpc.WaitForResourceReadyRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.pulumirpc.WaitForResourceReadyRequest} returns this
  */
-proto.pulumirpc.ConstructResponse.prototype.hasState = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.pulumirpc.WaitForResourceReadyRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * map<string, PropertyDependencies> stateDependencies = 3;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.pulumirpc.ConstructResponse.PropertyDependencies>}
+ * optional double timeout = 3;
+ * @return {number}
  */
-proto.pulumirpc.ConstructResponse.prototype.getStatedependenciesMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.pulumirpc.ConstructResponse.PropertyDependencies>} */ (
-      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
-      proto.pulumirpc.ConstructResponse.PropertyDependencies));
+proto.pulumirpc.WaitForResourceReadyRequest.prototype.getTimeout = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 3, 0.0));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.pulumirpc.ConstructResponse} returns this
+ * @param {number} value
+ * @return {!proto.pulumirpc.WaitForResourceReadyRequest} returns this
  */
-proto.pulumirpc.ConstructResponse.prototype.clearStatedependenciesMap = function() {
-  this.getStatedependenciesMap().clear();
-  return this;};
-
+proto.pulumirpc.WaitForResourceReadyRequest.prototype.setTimeout = function(value) {
+  return jspb.Message.setProto3FloatField(this, 3, value);
+};
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.pulumirpc.ErrorResourceInitFailed.repeatedFields_ = [3];
 
 
 
@@ -7720,8 +13973,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pulumirpc.ErrorResourceInitFailed.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.ErrorResourceInitFailed.toObject(opt_includeInstance, this);
+proto.pulumirpc.GetResourceAliasesRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.GetResourceAliasesRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -7730,16 +13983,13 @@ proto.pulumirpc.ErrorResourceInitFailed.prototype.toObject = function(opt_includ
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.ErrorResourceInitFailed} msg The msg instance to transform.
+ * @param {!proto.pulumirpc.GetResourceAliasesRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.ErrorResourceInitFailed.toObject = function(includeInstance, msg) {
+proto.pulumirpc.GetResourceAliasesRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    properties: (f = msg.getProperties()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
-    reasonsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
-    inputs: (f = msg.getInputs()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+    urn: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -7753,23 +14003,23 @@ proto.pulumirpc.ErrorResourceInitFailed.toObject = function(includeInstance, msg
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.ErrorResourceInitFailed}
+ * @return {!proto.pulumirpc.GetResourceAliasesRequest}
  */
-proto.pulumirpc.ErrorResourceInitFailed.deserializeBinary = function(bytes) {
+proto.pulumirpc.GetResourceAliasesRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.ErrorResourceInitFailed;
-  return proto.pulumirpc.ErrorResourceInitFailed.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pulumirpc.GetResourceAliasesRequest;
+  return proto.pulumirpc.GetResourceAliasesRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pulumirpc.ErrorResourceInitFailed} msg The message object to deserialize into.
+ * @param {!proto.pulumirpc.GetResourceAliasesRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.ErrorResourceInitFailed}
+ * @return {!proto.pulumirpc.GetResourceAliasesRequest}
  */
-proto.pulumirpc.ErrorResourceInitFailed.deserializeBinaryFromReader = function(msg, reader) {
+proto.pulumirpc.GetResourceAliasesRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7778,21 +14028,7 @@ proto.pulumirpc.ErrorResourceInitFailed.deserializeBinaryFromReader = function(m
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
-      break;
-    case 2:
-      var value = new google_protobuf_struct_pb.Struct;
-      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setProperties(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addReasons(value);
-      break;
-    case 4:
-      var value = new google_protobuf_struct_pb.Struct;
-      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
-      msg.setInputs(value);
+      msg.setUrn(value);
       break;
     default:
       reader.skipField();
@@ -7807,9 +14043,9 @@ proto.pulumirpc.ErrorResourceInitFailed.deserializeBinaryFromReader = function(m
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pulumirpc.ErrorResourceInitFailed.prototype.serializeBinary = function() {
+proto.pulumirpc.GetResourceAliasesRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.ErrorResourceInitFailed.serializeBinaryToWriter(this, writer);
+  proto.pulumirpc.GetResourceAliasesRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7817,171 +14053,193 @@ proto.pulumirpc.ErrorResourceInitFailed.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.ErrorResourceInitFailed} message
+ * @param {!proto.pulumirpc.GetResourceAliasesRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.ErrorResourceInitFailed.serializeBinaryToWriter = function(message, writer) {
+proto.pulumirpc.GetResourceAliasesRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getUrn();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getProperties();
-  if (f != null) {
-    writer.writeMessage(
-      2,
-      f,
-      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
-    );
-  }
-  f = message.getReasonsList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      3,
-      f
-    );
-  }
-  f = message.getInputs();
-  if (f != null) {
-    writer.writeMessage(
-      4,
-      f,
-      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
-    );
-  }
 };
 
 
 /**
- * optional string id = 1;
+ * optional string urn = 1;
  * @return {string}
  */
-proto.pulumirpc.ErrorResourceInitFailed.prototype.getId = function() {
+proto.pulumirpc.GetResourceAliasesRequest.prototype.getUrn = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.pulumirpc.ErrorResourceInitFailed} returns this
+ * @return {!proto.pulumirpc.GetResourceAliasesRequest} returns this
  */
-proto.pulumirpc.ErrorResourceInitFailed.prototype.setId = function(value) {
+proto.pulumirpc.GetResourceAliasesRequest.prototype.setUrn = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+
 /**
- * optional google.protobuf.Struct properties = 2;
- * @return {?proto.google.protobuf.Struct}
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.pulumirpc.ErrorResourceInitFailed.prototype.getProperties = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 2));
-};
+proto.pulumirpc.GetResourceAliasesResponse.repeatedFields_ = [1];
 
 
-/**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.pulumirpc.ErrorResourceInitFailed} returns this
-*/
-proto.pulumirpc.ErrorResourceInitFailed.prototype.setProperties = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
-};
-
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Clears the message field making it undefined.
- * @return {!proto.pulumirpc.ErrorResourceInitFailed} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.pulumirpc.ErrorResourceInitFailed.prototype.clearProperties = function() {
-  return this.setProperties(undefined);
+proto.pulumirpc.GetResourceAliasesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.GetResourceAliasesResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.GetResourceAliasesResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.ErrorResourceInitFailed.prototype.hasProperties = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.pulumirpc.GetResourceAliasesResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    aliasesList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * repeated string reasons = 3;
- * @return {!Array<string>}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.GetResourceAliasesResponse}
  */
-proto.pulumirpc.ErrorResourceInitFailed.prototype.getReasonsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
+proto.pulumirpc.GetResourceAliasesResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.GetResourceAliasesResponse;
+  return proto.pulumirpc.GetResourceAliasesResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.pulumirpc.ErrorResourceInitFailed} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.GetResourceAliasesResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.GetResourceAliasesResponse}
  */
-proto.pulumirpc.ErrorResourceInitFailed.prototype.setReasonsList = function(value) {
-  return jspb.Message.setField(this, 3, value || []);
+proto.pulumirpc.GetResourceAliasesResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addAliases(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.pulumirpc.ErrorResourceInitFailed} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.pulumirpc.ErrorResourceInitFailed.prototype.addReasons = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+proto.pulumirpc.GetResourceAliasesResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.GetResourceAliasesResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.pulumirpc.ErrorResourceInitFailed} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.GetResourceAliasesResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.ErrorResourceInitFailed.prototype.clearReasonsList = function() {
-  return this.setReasonsList([]);
+proto.pulumirpc.GetResourceAliasesResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAliasesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * optional google.protobuf.Struct inputs = 4;
- * @return {?proto.google.protobuf.Struct}
+ * repeated string aliases = 1;
+ * @return {!Array<string>}
  */
-proto.pulumirpc.ErrorResourceInitFailed.prototype.getInputs = function() {
-  return /** @type{?proto.google.protobuf.Struct} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 4));
+proto.pulumirpc.GetResourceAliasesResponse.prototype.getAliasesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
 
 /**
- * @param {?proto.google.protobuf.Struct|undefined} value
- * @return {!proto.pulumirpc.ErrorResourceInitFailed} returns this
-*/
-proto.pulumirpc.ErrorResourceInitFailed.prototype.setInputs = function(value) {
-  return jspb.Message.setWrapperField(this, 4, value);
+ * @param {!Array<string>} value
+ * @return {!proto.pulumirpc.GetResourceAliasesResponse} returns this
+ */
+proto.pulumirpc.GetResourceAliasesResponse.prototype.setAliasesList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.pulumirpc.ErrorResourceInitFailed} returns this
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.GetResourceAliasesResponse} returns this
  */
-proto.pulumirpc.ErrorResourceInitFailed.prototype.clearInputs = function() {
-  return this.setInputs(undefined);
+proto.pulumirpc.GetResourceAliasesResponse.prototype.addAliases = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.GetResourceAliasesResponse} returns this
  */
-proto.pulumirpc.ErrorResourceInitFailed.prototype.hasInputs = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.pulumirpc.GetResourceAliasesResponse.prototype.clearAliasesList = function() {
+  return this.setAliasesList([]);
 };
 
 
@@ -17,7 +17,7 @@ from google.protobuf import empty_pb2 as google_dot_protobuf_dot_empty__pb2
 from google.protobuf import struct_pb2 as google_dot_protobuf_dot_struct__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x15pulumi/provider.proto\x12\tpulumirpc\x1a\x13pulumi/plugin.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\"#\n\x10GetSchemaRequest\x12\x0f\n\x07version\x18\x01 \x01(\x05\"#\n\x11GetSchemaResponse\x12\x0e\n\x06schema\x18\x01 \x01(\t\"\xda\x01\n\x10\x43onfigureRequest\x12=\n\tvariables\x18\x01 \x03(\x0b\x32*.pulumirpc.ConfigureRequest.VariablesEntry\x12%\n\x04\x61rgs\x18\x02 \x01(\x0b\x32\x17.google.protobuf.Struct\x12\x15\n\racceptSecrets\x18\x03 \x01(\x08\x12\x17\n\x0f\x61\x63\x63\x65ptResources\x18\x04 \x01(\x08\x1a\x30\n\x0eVariablesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"s\n\x11\x43onfigureResponse\x12\x15\n\racceptSecrets\x18\x01 \x01(\x08\x12\x17\n\x0fsupportsPreview\x18\x02 \x01(\x08\x12\x17\n\x0f\x61\x63\x63\x65ptResources\x18\x03 \x01(\x08\x12\x15\n\racceptOutputs\x18\x04 \x01(\x08\"\x92\x01\n\x19\x43onfigureErrorMissingKeys\x12\x44\n\x0bmissingKeys\x18\x01 \x03(\x0b\x32/.pulumirpc.ConfigureErrorMissingKeys.MissingKey\x1a/\n\nMissingKey\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x02 \x01(\t\"\x80\x01\n\rInvokeRequest\x12\x0b\n\x03tok\x18\x01 \x01(\t\x12%\n\x04\x61rgs\x18\x02 \x01(\x0b\x32\x17.google.protobuf.StructJ\x04\x08\x03\x10\x07R\x08providerR\x07versionR\x0f\x61\x63\x63\x65ptResourcesR\x11pluginDownloadURL\"d\n\x0eInvokeResponse\x12\'\n\x06return\x18\x01 \x01(\x0b\x32\x17.google.protobuf.Struct\x12)\n\x08\x66\x61ilures\x18\x02 \x03(\x0b\x32\x17.pulumirpc.CheckFailure\"\xbe\x04\n\x0b\x43\x61llRequest\x12\x0b\n\x03tok\x18\x01 \x01(\t\x12%\n\x04\x61rgs\x18\x02 \x01(\x0b\x32\x17.google.protobuf.Struct\x12\x44\n\x0f\x61rgDependencies\x18\x03 \x03(\x0b\x32+.pulumirpc.CallRequest.ArgDependenciesEntry\x12\x10\n\x08provider\x18\x04 \x01(\t\x12\x0f\n\x07version\x18\x05 \x01(\t\x12\x19\n\x11pluginDownloadURL\x18\r \x01(\t\x12\x0f\n\x07project\x18\x06 \x01(\t\x12\r\n\x05stack\x18\x07 \x01(\t\x12\x32\n\x06\x63onfig\x18\x08 \x03(\x0b\x32\".pulumirpc.CallRequest.ConfigEntry\x12\x18\n\x10\x63onfigSecretKeys\x18\t \x03(\t\x12\x0e\n\x06\x64ryRun\x18\n \x01(\x08\x12\x10\n\x08parallel\x18\x0b \x01(\x05\x12\x17\n\x0fmonitorEndpoint\x18\x0c \x01(\t\x12\x14\n\x0corganization\x18\x0e \x01(\t\x1a$\n\x14\x41rgumentDependencies\x12\x0c\n\x04urns\x18\x01 \x03(\t\x1a\x63\n\x14\x41rgDependenciesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12:\n\x05value\x18\x02 \x01(\x0b\x32+.pulumirpc.CallRequest.ArgumentDependencies:\x02\x38\x01\x1a-\n\x0b\x43onfigEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xba\x02\n\x0c\x43\x61llResponse\x12\'\n\x06return\x18\x01 \x01(\x0b\x32\x17.google.protobuf.Struct\x12K\n\x12returnDependencies\x18\x02 \x03(\x0b\x32/.pulumirpc.CallResponse.ReturnDependenciesEntry\x12)\n\x08\x66\x61ilures\x18\x03 \x03(\x0b\x32\x17.pulumirpc.CheckFailure\x1a\"\n\x12ReturnDependencies\x12\x0c\n\x04urns\x18\x01 \x03(\t\x1a\x65\n\x17ReturnDependenciesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x39\n\x05value\x18\x02 \x01(\x0b\x32*.pulumirpc.CallResponse.ReturnDependencies:\x02\x38\x01\"\x93\x01\n\x0c\x43heckRequest\x12\x0b\n\x03urn\x18\x01 \x01(\t\x12%\n\x04olds\x18\x02 \x01(\x0b\x32\x17.google.protobuf.Struct\x12%\n\x04news\x18\x03 \x01(\x0b\x32\x17.google.protobuf.Struct\x12\x12\n\nrandomSeed\x18\x05 \x01(\x0cJ\x04\x08\x04\x10\x05R\x0esequenceNumber\"c\n\rCheckResponse\x12\'\n\x06inputs\x18\x01 \x01(\x0b\x32\x17.google.protobuf.Struct\x12)\n\x08\x66\x61ilures\x18\x02 \x03(\x0b\x32\x17.pulumirpc.CheckFailure\"0\n\x0c\x43heckFailure\x12\x10\n\x08property\x18\x01 \x01(\t\x12\x0e\n\x06reason\x18\x02 \x01(\t\"\x8b\x01\n\x0b\x44iffRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0b\n\x03urn\x18\x02 \x01(\t\x12%\n\x04olds\x18\x03 \x01(\x0b\x32\x17.google.protobuf.Struct\x12%\n\x04news\x18\x04 \x01(\x0b\x32\x17.google.protobuf.Struct\x12\x15\n\rignoreChanges\x18\x05 \x03(\t\"\xaf\x01\n\x0cPropertyDiff\x12*\n\x04kind\x18\x01 \x01(\x0e\x32\x1c.pulumirpc.PropertyDiff.Kind\x12\x11\n\tinputDiff\x18\x02 \x01(\x08\"`\n\x04Kind\x12\x07\n\x03\x41\x44\x44\x10\x00\x12\x0f\n\x0b\x41\x44\x44_REPLACE\x10\x01\x12\n\n\x06\x44\x45LETE\x10\x02\x12\x12\n\x0e\x44\x45LETE_REPLACE\x10\x03\x12\n\n\x06UPDATE\x10\x04\x12\x12\n\x0eUPDATE_REPLACE\x10\x05\"\xfa\x02\n\x0c\x44iffResponse\x12\x10\n\x08replaces\x18\x01 \x03(\t\x12\x0f\n\x07stables\x18\x02 \x03(\t\x12\x1b\n\x13\x64\x65leteBeforeReplace\x18\x03 \x01(\x08\x12\x34\n\x07\x63hanges\x18\x04 \x01(\x0e\x32#.pulumirpc.DiffResponse.DiffChanges\x12\r\n\x05\x64iffs\x18\x05 \x03(\t\x12?\n\x0c\x64\x65tailedDiff\x18\x06 \x03(\x0b\x32).pulumirpc.DiffResponse.DetailedDiffEntry\x12\x17\n\x0fhasDetailedDiff\x18\x07 \x01(\x08\x1aL\n\x11\x44\x65tailedDiffEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12&\n\x05value\x18\x02 \x01(\x0b\x32\x17.pulumirpc.PropertyDiff:\x02\x38\x01\"=\n\x0b\x44iffChanges\x12\x10\n\x0c\x44IFF_UNKNOWN\x10\x00\x12\r\n\tDIFF_NONE\x10\x01\x12\r\n\tDIFF_SOME\x10\x02\"k\n\rCreateRequest\x12\x0b\n\x03urn\x18\x01 \x01(\t\x12+\n\nproperties\x18\x02 \x01(\x0b\x32\x17.google.protobuf.Struct\x12\x0f\n\x07timeout\x18\x03 \x01(\x01\x12\x0f\n\x07preview\x18\x04 \x01(\x08\"I\n\x0e\x43reateResponse\x12\n\n\x02id\x18\x01 \x01(\t\x12+\n\nproperties\x18\x02 \x01(\x0b\x32\x17.google.protobuf.Struct\"|\n\x0bReadRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0b\n\x03urn\x18\x02 \x01(\t\x12+\n\nproperties\x18\x03 \x01(\x0b\x32\x17.google.protobuf.Struct\x12\'\n\x06inputs\x18\x04 \x01(\x0b\x32\x17.google.protobuf.Struct\"p\n\x0cReadResponse\x12\n\n\x02id\x18\x01 \x01(\t\x12+\n\nproperties\x18\x02 \x01(\x0b\x32\x17.google.protobuf.Struct\x12\'\n\x06inputs\x18\x03 \x01(\x0b\x32\x17.google.protobuf.Struct\"\xaf\x01\n\rUpdateRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0b\n\x03urn\x18\x02 \x01(\t\x12%\n\x04olds\x18\x03 \x01(\x0b\x32\x17.google.protobuf.Struct\x12%\n\x04news\x18\x04 \x01(\x0b\x32\x17.google.protobuf.Struct\x12\x0f\n\x07timeout\x18\x05 \x01(\x01\x12\x15\n\rignoreChanges\x18\x06 \x03(\t\x12\x0f\n\x07preview\x18\x07 \x01(\x08\"=\n\x0eUpdateResponse\x12+\n\nproperties\x18\x01 \x01(\x0b\x32\x17.google.protobuf.Struct\"f\n\rDeleteRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0b\n\x03urn\x18\x02 \x01(\t\x12+\n\nproperties\x18\x03 \x01(\x0b\x32\x17.google.protobuf.Struct\x12\x0f\n\x07timeout\x18\x04 \x01(\x01\"\xe4\x05\n\x10\x43onstructRequest\x12\x0f\n\x07project\x18\x01 \x01(\t\x12\r\n\x05stack\x18\x02 \x01(\t\x12\x37\n\x06\x63onfig\x18\x03 \x03(\x0b\x32\'.pulumirpc.ConstructRequest.ConfigEntry\x12\x0e\n\x06\x64ryRun\x18\x04 \x01(\x08\x12\x10\n\x08parallel\x18\x05 \x01(\x05\x12\x17\n\x0fmonitorEndpoint\x18\x06 \x01(\t\x12\x0c\n\x04type\x18\x07 \x01(\t\x12\x0c\n\x04name\x18\x08 \x01(\t\x12\x0e\n\x06parent\x18\t \x01(\t\x12\'\n\x06inputs\x18\n \x01(\x0b\x32\x17.google.protobuf.Struct\x12M\n\x11inputDependencies\x18\x0b \x03(\x0b\x32\x32.pulumirpc.ConstructRequest.InputDependenciesEntry\x12\x0f\n\x07protect\x18\x0c \x01(\x08\x12=\n\tproviders\x18\r \x03(\x0b\x32*.pulumirpc.ConstructRequest.ProvidersEntry\x12\x0f\n\x07\x61liases\x18\x0e \x03(\t\x12\x14\n\x0c\x64\x65pendencies\x18\x0f \x03(\t\x12\x18\n\x10\x63onfigSecretKeys\x18\x10 \x03(\t\x12\x14\n\x0corganization\x18\x11 \x01(\t\x1a$\n\x14PropertyDependencies\x12\x0c\n\x04urns\x18\x01 \x03(\t\x1a-\n\x0b\x43onfigEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\x1aj\n\x16InputDependenciesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12?\n\x05value\x18\x02 \x01(\x0b\x32\x30.pulumirpc.ConstructRequest.PropertyDependencies:\x02\x38\x01\x1a\x30\n\x0eProvidersEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xab\x02\n\x11\x43onstructResponse\x12\x0b\n\x03urn\x18\x01 \x01(\t\x12&\n\x05state\x18\x02 \x01(\x0b\x32\x17.google.protobuf.Struct\x12N\n\x11stateDependencies\x18\x03 \x03(\x0b\x32\x33.pulumirpc.ConstructResponse.StateDependenciesEntry\x1a$\n\x14PropertyDependencies\x12\x0c\n\x04urns\x18\x01 \x03(\t\x1ak\n\x16StateDependenciesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12@\n\x05value\x18\x02 \x01(\x0b\x32\x31.pulumirpc.ConstructResponse.PropertyDependencies:\x02\x38\x01\"\x8c\x01\n\x17\x45rrorResourceInitFailed\x12\n\n\x02id\x18\x01 \x01(\t\x12+\n\nproperties\x18\x02 \x01(\x0b\x32\x17.google.protobuf.Struct\x12\x0f\n\x07reasons\x18\x03 \x03(\t\x12\'\n\x06inputs\x18\x04 \x01(\x0b\x32\x17.google.protobuf.Struct2\xe9\x08\n\x10ResourceProvider\x12H\n\tGetSchema\x12\x1b.pulumirpc.GetSchemaRequest\x1a\x1c.pulumirpc.GetSchemaResponse\"\x00\x12\x42\n\x0b\x43heckConfig\x12\x17.pulumirpc.CheckRequest\x1a\x18.pulumirpc.CheckResponse\"\x00\x12?\n\nDiffConfig\x12\x16.pulumirpc.DiffRequest\x1a\x17.pulumirpc.DiffResponse\"\x00\x12H\n\tConfigure\x12\x1b.pulumirpc.ConfigureRequest\x1a\x1c.pulumirpc.ConfigureResponse\"\x00\x12?\n\x06Invoke\x12\x18.pulumirpc.InvokeRequest\x1a\x19.pulumirpc.InvokeResponse\"\x00\x12G\n\x0cStreamInvoke\x12\x18.pulumirpc.InvokeRequest\x1a\x19.pulumirpc.InvokeResponse\"\x00\x30\x01\x12\x39\n\x04\x43\x61ll\x12\x16.pulumirpc.CallRequest\x1a\x17.pulumirpc.CallResponse\"\x00\x12<\n\x05\x43heck\x12\x17.pulumirpc.CheckRequest\x1a\x18.pulumirpc.CheckResponse\"\x00\x12\x39\n\x04\x44iff\x12\x16.pulumirpc.DiffRequest\x1a\x17.pulumirpc.DiffResponse\"\x00\x12?\n\x06\x43reate\x12\x18.pulumirpc.CreateRequest\x1a\x19.pulumirpc.CreateResponse\"\x00\x12\x39\n\x04Read\x12\x16.pulumirpc.ReadRequest\x1a\x17.pulumirpc.ReadResponse\"\x00\x12?\n\x06Update\x12\x18.pulumirpc.UpdateRequest\x1a\x19.pulumirpc.UpdateResponse\"\x00\x12<\n\x06\x44\x65lete\x12\x18.pulumirpc.DeleteRequest\x1a\x16.google.protobuf.Empty\"\x00\x12H\n\tConstruct\x12\x1b.pulumirpc.ConstructRequest\x1a\x1c.pulumirpc.ConstructResponse\"\x00\x12:\n\x06\x43\x61ncel\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\"\x00\x12@\n\rGetPluginInfo\x12\x16.google.protobuf.Empty\x1a\x15.pulumirpc.PluginInfo\"\x00\x12;\n\x06\x41ttach\x12\x17.pulumirpc.PluginAttach\x1a\x16.google.protobuf.Empty\"\x00\x42\x34Z2github.com/pulumi/pulumi/sdk/v3/proto/go;pulumirpcb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x15pulumi/provider.proto\x12\tpulumirpc\x1a\x13pulumi/plugin.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\"#\n\x10GetSchemaRequest\x12\x0f\n\x07version\x18\x01 \x01(\x05\"5\n\x11GetSchemaResponse\x12\x0e\n\x06schema\x18\x01 \x01(\t\x12\x10\n\x08\x63hecksum\x18\x02 \x01(\t\"\xf4\x01\n\x10\x43onfigureRequest\x12=\n\tvariables\x18\x01 \x03(\x0b\x32*.pulumirpc.ConfigureRequest.VariablesEntry\x12%\n\x04\x61rgs\x18\x02 \x01(\x0b\x32\x17.google.protobuf.Struct\x12\x15\n\racceptSecrets\x18\x03 \x01(\x08\x12\x17\n\x0f\x61\x63\x63\x65ptResources\x18\x04 \x01(\x08\x12\x18\n\x10\x63onfigSecretKeys\x18\x05 \x03(\t\x1a\x30\n\x0eVariablesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x8a\x01\n\x11\x43onfigureResponse\x12\x15\n\racceptSecrets\x18\x01 \x01(\x08\x12\x17\n\x0fsupportsPreview\x18\x02 \x01(\x08\x12\x17\n\x0f\x61\x63\x63\x65ptResources\x18\x03 \x01(\x08\x12\x15\n\racceptOutputs\x18\x04 \x01(\x08\x12\x15\n\rschemaVersion\x18\x05 \x01(\x05\"\x92\x01\n\x19\x43onfigureErrorMissingKeys\x12\x44\n\x0bmissingKeys\x18\x01 \x03(\x0b\x32/.pulumirpc.ConfigureErrorMissingKeys.MissingKey\x1a/\n\nMissingKey\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x02 \x01(\t\"\x80\x01\n\rInvokeRequest\x12\x0b\n\x03tok\x18\x01 \x01(\t\x12%\n\x04\x61rgs\x18\x02 \x01(\x0b\x32\x17.google.protobuf.StructJ\x04\x08\x03\x10\x07R\x08providerR\x07versionR\x0f\x61\x63\x63\x65ptResourcesR\x11pluginDownloadURL\"\x8c\x01\n\x0eInvokeResponse\x12\'\n\x06return\x18\x01 \x01(\x0b\x32\x17.google.protobuf.Struct\x12)\n\x08\x66\x61ilures\x18\x02 \x03(\x0b\x32\x17.pulumirpc.CheckFailure\x12\x12\n\npageNumber\x18\x03 \x01(\x05\x12\x12\n\nisLastPage\x18\x04 \x01(\x08\"\xf0\x04\n\x0b\x43\x61llRequest\x12\x0b\n\x03tok\x18\x01 \x01(\t\x12%\n\x04\x61rgs\x18\x02 \x01(\x0b\x32\x17.google.protobuf.Struct\x12\x44\n\x0f\x61rgDependencies\x18\x03 \x03(\x0b\x32+.pulumirpc.CallRequest.ArgDependenciesEntry\x12\x10\n\x08provider\x18\x04 \x01(\t\x12\x0f\n\x07version\x18\x05 \x01(\t\x12\x19\n\x11pluginDownloadURL\x18\r \x01(\t\x12\x0f\n\x07project\x18\x06 \x01(\t\x12\r\n\x05stack\x18\x07 \x01(\t\x12\x32\n\x06\x63onfig\x18\x08 \x03(\x0b\x32\".pulumirpc.CallRequest.ConfigEntry\x12\x18\n\x10\x63onfigSecretKeys\x18\t \x03(\t\x12\x0e\n\x06\x64ryRun\x18\n \x01(\x08\x12\x10\n\x08parallel\x18\x0b \x01(\x05\x12\x17\n\x0fmonitorEndpoint\x18\x0c \x01(\t\x12\x14\n\x0corganization\x18\x0e \x01(\t\x12\x0f\n\x07timeout\x18\x0f \x01(\x01\x12\x0f\n\x07traceId\x18\x10 \x01(\t\x12\x0e\n\x06spanId\x18\x11 \x01(\t\x1a$\n\x14\x41rgumentDependencies\x12\x0c\n\x04urns\x18\x01 \x03(\t\x1a\x63\n\x14\x41rgDependenciesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12:\n\x05value\x18\x02 \x01(\x0b\x32+.pulumirpc.CallRequest.ArgumentDependencies:\x02\x38\x01\x1a-\n\x0b\x43onfigEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xa4\x03\n\x0c\x43\x61llResponse\x12\'\n\x06return\x18\x01 \x01(\x0b\x32\x17.google.protobuf.Struct\x12K\n\x12returnDependencies\x18\x02 \x03(\x0b\x32/.pulumirpc.CallResponse.ReturnDependenciesEntry\x12)\n\x08\x66\x61ilures\x18\x03 \x03(\x0b\x32\x17.pulumirpc.CheckFailure\x12\x16\n\x0epartialFailure\x18\x04 \x01(\x08\x12\x1c\n\x14partialFailureReason\x18\x05 \x01(\t\x12\x32\n\x0b\x64iagnostics\x18\x06 \x03(\x0b\x32\x1d.pulumirpc.ProviderDiagnostic\x1a\"\n\x12ReturnDependencies\x12\x0c\n\x04urns\x18\x01 \x03(\t\x1a\x65\n\x17ReturnDependenciesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x39\n\x05value\x18\x02 \x01(\x0b\x32*.pulumirpc.CallResponse.ReturnDependencies:\x02\x38\x01\"\xbb\x01\n\x0c\x43heckRequest\x12\x0b\n\x03urn\x18\x01 \x01(\t\x12%\n\x04olds\x18\x02 \x01(\x0b\x32\x17.google.protobuf.Struct\x12%\n\x04news\x18\x03 \x01(\x0b\x32\x17.google.protobuf.Struct\x12\x12\n\nrandomSeed\x18\x05 \x01(\x0c\x12\x12\n\nbinaryOlds\x18\x06 \x01(\x0c\x12\x12\n\nbinaryNews\x18\x07 \x01(\x0cJ\x04\x08\x04\x10\x05R\x0esequenceNumber\"\xad\x01\n\rCheckResponse\x12\'\n\x06inputs\x18\x01 \x01(\x0b\x32\x17.google.protobuf.Struct\x12)\n\x08\x66\x61ilures\x18\x02 \x03(\x0b\x32\x17.pulumirpc.CheckFailure\x12\x14\n\x0c\x62inaryInputs\x18\x03 \x01(\x0c\x12\x32\n\x0b\x64iagnostics\x18\x04 \x03(\x0b\x32\x1d.pulumirpc.ProviderDiagnostic\"\x85\x02\n\x0c\x43heckFailure\x12\x10\n\x08property\x18\x01 \x01(\t\x12\x0e\n\x06reason\x18\x02 \x01(\t\x12\x32\n\x08severity\x18\x03 \x01(\x0e\x32 .pulumirpc.CheckFailure.Severity\x12\x0c\n\x04\x63ode\x18\x04 \x01(\t\x12\x32\n\x05range\x18\x05 \x01(\x0b\x32#.pulumirpc.CheckFailure.SourceRange\x1a\x39\n\x0bSourceRange\x12\x0c\n\x04\x66ile\x18\x01 \x01(\t\x12\x0c\n\x04line\x18\x02 \x01(\x05\x12\x0e\n\x06\x63olumn\x18\x03 \x01(\x05\"\"\n\x08Severity\x12\t\n\x05\x45RROR\x10\x00\x12\x0b\n\x07WARNING\x10\x01\"\x8b\x01\n\x0b\x44iffRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0b\n\x03urn\x18\x02 \x01(\t\x12%\n\x04olds\x18\x03 \x01(\x0b\x32\x17.google.protobuf.Struct\x12%\n\x04news\x18\x04 \x01(\x0b\x32\x17.google.protobuf.Struct\x12\x15\n\rignoreChanges\x18\x05 \x03(\t\"\x93\x02\n\x0cPropertyDiff\x12*\n\x04kind\x18\x01 \x01(\x0e\x32\x1c.pulumirpc.PropertyDiff.Kind\x12\x11\n\tinputDiff\x18\x02 \x01(\x08\x12\x0e\n\x06reason\x18\x03 \x01(\t\x12(\n\x08oldValue\x18\x04 \x01(\x0b\x32\x16.google.protobuf.Value\x12(\n\x08newValue\x18\x05 \x01(\x0b\x32\x16.google.protobuf.Value\"`\n\x04Kind\x12\x07\n\x03\x41\x44\x44\x10\x00\x12\x0f\n\x0b\x41\x44\x44_REPLACE\x10\x01\x12\n\n\x06\x44\x45LETE\x10\x02\x12\x12\n\x0e\x44\x45LETE_REPLACE\x10\x03\x12\n\n\x06UPDATE\x10\x04\x12\x12\n\x0eUPDATE_REPLACE\x10\x05\"\xc9\x03\n\x0c\x44iffResponse\x12\x10\n\x08replaces\x18\x01 \x03(\t\x12\x0f\n\x07stables\x18\x02 \x03(\t\x12\x1b\n\x13\x64\x65leteBeforeReplace\x18\x03 \x01(\x08\x12\x34\n\x07\x63hanges\x18\x04 \x01(\x0e\x32#.pulumirpc.DiffResponse.DiffChanges\x12\r\n\x05\x64iffs\x18\x05 \x03(\t\x12?\n\x0c\x64\x65tailedDiff\x18\x06 \x03(\x0b\x32).pulumirpc.DiffResponse.DetailedDiffEntry\x12\x17\n\x0fhasDetailedDiff\x18\x07 \x01(\x08\x12\x19\n\x11\x61\x66\x66\x65\x63tedResources\x18\x08 \x03(\t\x12\x32\n\x0b\x64iagnostics\x18\t \x03(\x0b\x32\x1d.pulumirpc.ProviderDiagnostic\x1aL\n\x11\x44\x65tailedDiffEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12&\n\x05value\x18\x02 \x01(\x0b\x32\x17.pulumirpc.PropertyDiff:\x02\x38\x01\"=\n\x0b\x44iffChanges\x12\x10\n\x0c\x44IFF_UNKNOWN\x10\x00\x12\r\n\tDIFF_NONE\x10\x01\x12\r\n\tDIFF_SOME\x10\x02\"\x85\x01\n\rCreateRequest\x12\x0b\n\x03urn\x18\x01 \x01(\t\x12+\n\nproperties\x18\x02 \x01(\x0b\x32\x17.google.protobuf.Struct\x12\x0f\n\x07timeout\x18\x03 \x01(\x01\x12\x0f\n\x07preview\x18\x04 \x01(\x08\x12\x18\n\x10\x62inaryProperties\x18\x05 \x01(\x0c\"\x97\x01\n\x0e\x43reateResponse\x12\n\n\x02id\x18\x01 \x01(\t\x12+\n\nproperties\x18\x02 \x01(\x0b\x32\x17.google.protobuf.Struct\x12\x18\n\x10\x62inaryProperties\x18\x03 \x01(\x0c\x12\x32\n\x0b\x64iagnostics\x18\x04 \x03(\x0b\x32\x1d.pulumirpc.ProviderDiagnostic\"|\n\x0bReadRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0b\n\x03urn\x18\x02 \x01(\t\x12+\n\nproperties\x18\x03 \x01(\x0b\x32\x17.google.protobuf.Struct\x12\'\n\x06inputs\x18\x04 \x01(\x0b\x32\x17.google.protobuf.Struct\"\xa4\x01\n\x0cReadResponse\x12\n\n\x02id\x18\x01 \x01(\t\x12+\n\nproperties\x18\x02 \x01(\x0b\x32\x17.google.protobuf.Struct\x12\'\n\x06inputs\x18\x03 \x01(\x0b\x32\x17.google.protobuf.Struct\x12\x32\n\x0b\x64iagnostics\x18\x04 \x03(\x0b\x32\x1d.pulumirpc.ProviderDiagnostic\"`\n\x13MigrateStateRequest\x12\x0b\n\x03urn\x18\x01 \x01(\t\x12\x14\n\x0cstateVersion\x18\x02 \x01(\x05\x12&\n\x05state\x18\x03 \x01(\x0b\x32\x17.google.protobuf.Struct\">\n\x14MigrateStateResponse\x12&\n\x05state\x18\x01 \x01(\x0b\x32\x17.google.protobuf.Struct\"I\n\x13\x45stimateCostRequest\x12\x0b\n\x03urn\x18\x01 \x01(\t\x12%\n\x04news\x18\x02 \x01(\x0b\x32\x17.google.protobuf.Struct\"T\n\x14\x45stimateCostResponse\x12\x16\n\x0emonthlyCostUSD\x18\x01 \x01(\x01\x12\x10\n\x08\x63urrency\x18\x02 \x01(\t\x12\x12\n\nconfidence\x18\x03 \x01(\x01\"0\n\x1cGetSupportedVersionsResponse\x12\x10\n\x08versions\x18\x01 \x03(\x05\"+\n\x1aParameterizeByValueRequest\x12\r\n\x05value\x18\x01 \x01(\x0c\"?\n\x1eParameterizeByReferenceRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0f\n\x07version\x18\x02 \x01(\t\"5\n\x14ParameterizeResponse\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0f\n\x07version\x18\x02 \x01(\t\"/\n\x14PrepareImportRequest\x12\x0b\n\x03urn\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\t\"@\n\x15PrepareImportResponse\x12\'\n\x06inputs\x18\x01 \x01(\x0b\x32\x17.google.protobuf.Struct\";\n\x1a\x43onfigChecksumMatchRequest\x12\x0b\n\x03urn\x18\x01 \x01(\t\x12\x10\n\x08\x63hecksum\x18\x02 \x01(\t\",\n\x1b\x43onfigChecksumMatchResponse\x12\r\n\x05match\x18\x01 \x01(\x08\"G\n\x1bWaitForResourceReadyRequest\x12\x0b\n\x03urn\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\t\x12\x0f\n\x07timeout\x18\x03 \x01(\x01\"(\n\x19GetResourceAliasesRequest\x12\x0b\n\x03urn\x18\x01 \x01(\t\"-\n\x1aGetResourceAliasesResponse\x12\x0f\n\x07\x61liases\x18\x01 \x03(\t\"/\n\x14ResourceChangedEvent\x12\x0b\n\x03urn\x18\x01 \x01(\t\x12\n\n\x02id\x18\x02 \x01(\t\"\xd7\x01\n\rUpdateRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0b\n\x03urn\x18\x02 \x01(\t\x12%\n\x04olds\x18\x03 \x01(\x0b\x32\x17.google.protobuf.Struct\x12%\n\x04news\x18\x04 \x01(\x0b\x32\x17.google.protobuf.Struct\x12\x0f\n\x07timeout\x18\x05 \x01(\x01\x12\x15\n\rignoreChanges\x18\x06 \x03(\t\x12\x0f\n\x07preview\x18\x07 \x01(\x08\x12\x12\n\nbinaryOlds\x18\x08 \x01(\x0c\x12\x12\n\nbinaryNews\x18\t \x01(\x0c\"\x8b\x01\n\x0eUpdateResponse\x12+\n\nproperties\x18\x01 \x01(\x0b\x32\x17.google.protobuf.Struct\x12\x18\n\x10\x62inaryProperties\x18\x02 \x01(\x0c\x12\x32\n\x0b\x64iagnostics\x18\x03 \x03(\x0b\x32\x1d.pulumirpc.ProviderDiagnostic\"f\n\rDeleteRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0b\n\x03urn\x18\x02 \x01(\t\x12+\n\nproperties\x18\x03 \x01(\x0b\x32\x17.google.protobuf.Struct\x12\x0f\n\x07timeout\x18\x04 \x01(\x01\"\xe0\x07\n\x10\x43onstructRequest\x12\x0f\n\x07project\x18\x01 \x01(\t\x12\r\n\x05stack\x18\x02 \x01(\t\x12\x37\n\x06\x63onfig\x18\x03 \x03(\x0b\x32\'.pulumirpc.ConstructRequest.ConfigEntry\x12\x0e\n\x06\x64ryRun\x18\x04 \x01(\x08\x12\x10\n\x08parallel\x18\x05 \x01(\x05\x12\x17\n\x0fmonitorEndpoint\x18\x06 \x01(\t\x12\x0c\n\x04type\x18\x07 \x01(\t\x12\x0c\n\x04name\x18\x08 \x01(\t\x12\x0e\n\x06parent\x18\t \x01(\t\x12\'\n\x06inputs\x18\n \x01(\x0b\x32\x17.google.protobuf.Struct\x12M\n\x11inputDependencies\x18\x0b \x03(\x0b\x32\x32.pulumirpc.ConstructRequest.InputDependenciesEntry\x12\x0f\n\x07protect\x18\x0c \x01(\x08\x12=\n\tproviders\x18\r \x03(\x0b\x32*.pulumirpc.ConstructRequest.ProvidersEntry\x12\x0f\n\x07\x61liases\x18\x0e \x03(\t\x12\x14\n\x0c\x64\x65pendencies\x18\x0f \x03(\t\x12\x18\n\x10\x63onfigSecretKeys\x18\x10 \x03(\t\x12\x14\n\x0corganization\x18\x11 \x01(\t\x12\x42\n\x0e\x63ustomTimeouts\x18\x12 \x01(\x0b\x32*.pulumirpc.ConstructRequest.CustomTimeouts\x12\x12\n\nsdkVersion\x18\x13 \x01(\t\x12\x15\n\rengineVersion\x18\x14 \x01(\t\x12\x15\n\rignoreChanges\x18\x15 \x03(\t\x12\x0f\n\x07traceId\x18\x16 \x01(\t\x12\x0e\n\x06spanId\x18\x17 \x01(\t\x12\x11\n\tnamespace\x18\x18 \x01(\t\x1a$\n\x14PropertyDependencies\x12\x0c\n\x04urns\x18\x01 \x03(\t\x1a@\n\x0e\x43ustomTimeouts\x12\x0e\n\x06\x63reate\x18\x01 \x01(\t\x12\x0e\n\x06update\x18\x02 \x01(\t\x12\x0e\n\x06\x64\x65lete\x18\x03 \x01(\t\x1a-\n\x0b\x43onfigEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\x1aj\n\x16InputDependenciesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12?\n\x05value\x18\x02 \x01(\x0b\x32\x30.pulumirpc.ConstructRequest.PropertyDependencies:\x02\x38\x01\x1a\x30\n\x0eProvidersEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xa9\x04\n\x11\x43onstructResponse\x12\x0b\n\x03urn\x18\x01 \x01(\t\x12&\n\x05state\x18\x02 \x01(\x0b\x32\x17.google.protobuf.Struct\x12N\n\x11stateDependencies\x18\x03 \x03(\x0b\x32\x33.pulumirpc.ConstructResponse.StateDependenciesEntry\x12\'\n\x06inputs\x18\x04 \x01(\x0b\x32\x17.google.protobuf.Struct\x12N\n\x11inputDependencies\x18\x05 \x03(\x0b\x32\x33.pulumirpc.ConstructResponse.InputDependenciesEntry\x12\x16\n\x0e\x63hildResources\x18\x06 \x03(\t\x1a$\n\x14PropertyDependencies\x12\x0c\n\x04urns\x18\x01 \x03(\t\x1ak\n\x16StateDependenciesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12@\n\x05value\x18\x02 \x01(\x0b\x32\x31.pulumirpc.ConstructResponse.PropertyDependencies:\x02\x38\x01\x1ak\n\x16InputDependenciesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12@\n\x05value\x18\x02 \x01(\x0b\x32\x31.pulumirpc.ConstructResponse.PropertyDependencies:\x02\x38\x01\"\x8c\x01\n\x17\x45rrorResourceInitFailed\x12\n\n\x02id\x18\x01 \x01(\t\x12+\n\nproperties\x18\x02 \x01(\x0b\x32\x17.google.protobuf.Struct\x12\x0f\n\x07reasons\x18\x03 \x03(\t\x12\'\n\x06inputs\x18\x04 \x01(\x0b\x32\x17.google.protobuf.Struct\" \n\x11GetMappingRequest\x12\x0b\n\x03key\x18\x01 \x01(\t\"4\n\x12GetMappingResponse\x12\x10\n\x08provider\x18\x01 \x01(\t\x12\x0c\n\x04\x64\x61ta\x18\x02 \x01(\x0c\",\n\x1eProviderSupportsFeatureRequest\x12\n\n\x02id\x18\x01 \x01(\t\"5\n\x1fProviderSupportsFeatureResponse\x12\x12\n\nhasSupport\x18\x01 \x01(\x08\"\xaa\x01\n\x12ProviderDiagnostic\x12\x38\n\x08severity\x18\x01 \x01(\x0e\x32&.pulumirpc.ProviderDiagnostic.Severity\x12\x0f\n\x07summary\x18\x02 \x01(\t\x12\x0e\n\x06\x64\x65tail\x18\x03 \x01(\t\x12\x0b\n\x03url\x18\x04 \x01(\t\",\n\x08Severity\x12\x08\n\x04INFO\x10\x00\x12\x0b\n\x07WARNING\x10\x01\x12\t\n\x05\x45RROR\x10\x02\"=\n\x12\x43onfigChangedEvent\x12\'\n\x06\x63onfig\x18\x01 \x01(\x0b\x32\x17.google.protobuf.Struct2\x99\x14\n\x10ResourceProvider\x12H\n\tGetSchema\x12\x1b.pulumirpc.GetSchemaRequest\x1a\x1c.pulumirpc.GetSchemaResponse\"\x00\x12\x42\n\x0b\x43heckConfig\x12\x17.pulumirpc.CheckRequest\x1a\x18.pulumirpc.CheckResponse\"\x00\x12?\n\nDiffConfig\x12\x16.pulumirpc.DiffRequest\x1a\x17.pulumirpc.DiffResponse\"\x00\x12H\n\tConfigure\x12\x1b.pulumirpc.ConfigureRequest\x1a\x1c.pulumirpc.ConfigureResponse\"\x00\x12?\n\x06Invoke\x12\x18.pulumirpc.InvokeRequest\x1a\x19.pulumirpc.InvokeResponse\"\x00\x12G\n\x0cStreamInvoke\x12\x18.pulumirpc.InvokeRequest\x1a\x19.pulumirpc.InvokeResponse\"\x00\x30\x01\x12\x39\n\x04\x43\x61ll\x12\x16.pulumirpc.CallRequest\x1a\x17.pulumirpc.CallResponse\"\x00\x12<\n\x05\x43heck\x12\x17.pulumirpc.CheckRequest\x1a\x18.pulumirpc.CheckResponse\"\x00\x12\x39\n\x04\x44iff\x12\x16.pulumirpc.DiffRequest\x1a\x17.pulumirpc.DiffResponse\"\x00\x12?\n\x06\x43reate\x12\x18.pulumirpc.CreateRequest\x1a\x19.pulumirpc.CreateResponse\"\x00\x12\x39\n\x04Read\x12\x16.pulumirpc.ReadRequest\x1a\x17.pulumirpc.ReadResponse\"\x00\x12?\n\x06Update\x12\x18.pulumirpc.UpdateRequest\x1a\x19.pulumirpc.UpdateResponse\"\x00\x12<\n\x06\x44\x65lete\x12\x18.pulumirpc.DeleteRequest\x1a\x16.google.protobuf.Empty\"\x00\x12H\n\tConstruct\x12\x1b.pulumirpc.ConstructRequest\x1a\x1c.pulumirpc.ConstructResponse\"\x00\x12:\n\x06\x43\x61ncel\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\"\x00\x12@\n\rGetPluginInfo\x12\x16.google.protobuf.Empty\x1a\x15.pulumirpc.PluginInfo\"\x00\x12;\n\x06\x41ttach\x12\x17.pulumirpc.PluginAttach\x1a\x16.google.protobuf.Empty\"\x00\x12K\n\nGetMapping\x12\x1c.pulumirpc.GetMappingRequest\x1a\x1d.pulumirpc.GetMappingResponse\"\x00\x12j\n\x0fSupportsFeature\x12).pulumirpc.ProviderSupportsFeatureRequest\x1a*.pulumirpc.ProviderSupportsFeatureResponse\"\x00\x12\x41\n\nReadStream\x12\x16.pulumirpc.ReadRequest\x1a\x17.pulumirpc.ReadResponse\"\x00\x30\x01\x12<\n\x07Refresh\x12\x16.pulumirpc.ReadRequest\x1a\x17.pulumirpc.ReadResponse\"\x00\x12Q\n\x0cMigrateState\x12\x1e.pulumirpc.MigrateStateRequest\x1a\x1f.pulumirpc.MigrateStateResponse\"\x00\x12S\n\x14WatchResourceChanges\x12\x16.google.protobuf.Empty\x1a\x1f.pulumirpc.ResourceChangedEvent\"\x00\x30\x01\x12G\n\x0cStreamCreate\x12\x18.pulumirpc.CreateRequest\x1a\x19.pulumirpc.CreateResponse\"\x00\x30\x01\x12Q\n\x0c\x45stimateCost\x12\x1e.pulumirpc.EstimateCostRequest\x1a\x1f.pulumirpc.EstimateCostResponse\"\x00\x12Y\n\x14GetSupportedVersions\x12\x16.google.protobuf.Empty\x1a\'.pulumirpc.GetSupportedVersionsResponse\"\x00\x12_\n\x13ParameterizeByValue\x12%.pulumirpc.ParameterizeByValueRequest\x1a\x1f.pulumirpc.ParameterizeResponse\"\x00\x12g\n\x17ParameterizeByReference\x12).pulumirpc.ParameterizeByReferenceRequest\x1a\x1f.pulumirpc.ParameterizeResponse\"\x00\x12T\n\rPrepareImport\x12\x1f.pulumirpc.PrepareImportRequest\x1a .pulumirpc.PrepareImportResponse\"\x00\x12\x66\n\x13\x43onfigChecksumMatch\x12%.pulumirpc.ConfigChecksumMatchRequest\x1a&.pulumirpc.ConfigChecksumMatchResponse\"\x00\x12X\n\x14WaitForResourceReady\x12&.pulumirpc.WaitForResourceReadyRequest\x1a\x16.google.protobuf.Empty\"\x00\x12\x63\n\x12GetResourceAliases\x12$.pulumirpc.GetResourceAliasesRequest\x1a%.pulumirpc.GetResourceAliasesResponse\"\x00\x12H\n\x0bWatchConfig\x12\x16.google.protobuf.Empty\x1a\x1d.pulumirpc.ConfigChangedEvent\"\x00\x30\x01\x12\x44\n\x10ValidateProvider\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\"\x00\x42\x34Z2github.com/pulumi/pulumi/sdk/v3/proto/go;pulumirpcb\x06proto3')
 
 
 
@@ -40,6 +40,7 @@ _CALLRESPONSE_RETURNDEPENDENCIESENTRY = _CALLRESPONSE.nested_types_by_name['Retu
 _CHECKREQUEST = DESCRIPTOR.message_types_by_name['CheckRequest']
 _CHECKRESPONSE = DESCRIPTOR.message_types_by_name['CheckResponse']
 _CHECKFAILURE = DESCRIPTOR.message_types_by_name['CheckFailure']
+_CHECKFAILURE_SOURCERANGE = _CHECKFAILURE.nested_types_by_name['SourceRange']
 _DIFFREQUEST = DESCRIPTOR.message_types_by_name['DiffRequest']
 _PROPERTYDIFF = DESCRIPTOR.message_types_by_name['PropertyDiff']
 _DIFFRESPONSE = DESCRIPTOR.message_types_by_name['DiffResponse']
@@ -48,20 +49,46 @@ _CREATEREQUEST = DESCRIPTOR.message_types_by_name['CreateRequest']
 _CREATERESPONSE = DESCRIPTOR.message_types_by_name['CreateResponse']
 _READREQUEST = DESCRIPTOR.message_types_by_name['ReadRequest']
 _READRESPONSE = DESCRIPTOR.message_types_by_name['ReadResponse']
+_MIGRATESTATEREQUEST = DESCRIPTOR.message_types_by_name['MigrateStateRequest']
+_MIGRATESTATERESPONSE = DESCRIPTOR.message_types_by_name['MigrateStateResponse']
+_ESTIMATECOSTREQUEST = DESCRIPTOR.message_types_by_name['EstimateCostRequest']
+_ESTIMATECOSTRESPONSE = DESCRIPTOR.message_types_by_name['EstimateCostResponse']
+_GETSUPPORTEDVERSIONSRESPONSE = DESCRIPTOR.message_types_by_name['GetSupportedVersionsResponse']
+_PARAMETERIZEBYVALUEREQUEST = DESCRIPTOR.message_types_by_name['ParameterizeByValueRequest']
+_PARAMETERIZEBYREFERENCEREQUEST = DESCRIPTOR.message_types_by_name['ParameterizeByReferenceRequest']
+_PARAMETERIZERESPONSE = DESCRIPTOR.message_types_by_name['ParameterizeResponse']
+_PREPAREIMPORTREQUEST = DESCRIPTOR.message_types_by_name['PrepareImportRequest']
+_PREPAREIMPORTRESPONSE = DESCRIPTOR.message_types_by_name['PrepareImportResponse']
+_CONFIGCHECKSUMMATCHREQUEST = DESCRIPTOR.message_types_by_name['ConfigChecksumMatchRequest']
+_CONFIGCHECKSUMMATCHRESPONSE = DESCRIPTOR.message_types_by_name['ConfigChecksumMatchResponse']
+_WAITFORRESOURCEREADYREQUEST = DESCRIPTOR.message_types_by_name['WaitForResourceReadyRequest']
+_GETRESOURCEALIASESREQUEST = DESCRIPTOR.message_types_by_name['GetResourceAliasesRequest']
+_GETRESOURCEALIASESRESPONSE = DESCRIPTOR.message_types_by_name['GetResourceAliasesResponse']
+_RESOURCECHANGEDEVENT = DESCRIPTOR.message_types_by_name['ResourceChangedEvent']
 _UPDATEREQUEST = DESCRIPTOR.message_types_by_name['UpdateRequest']
 _UPDATERESPONSE = DESCRIPTOR.message_types_by_name['UpdateResponse']
 _DELETEREQUEST = DESCRIPTOR.message_types_by_name['DeleteRequest']
 _CONSTRUCTREQUEST = DESCRIPTOR.message_types_by_name['ConstructRequest']
 _CONSTRUCTREQUEST_PROPERTYDEPENDENCIES = _CONSTRUCTREQUEST.nested_types_by_name['PropertyDependencies']
+_CONSTRUCTREQUEST_CUSTOMTIMEOUTS = _CONSTRUCTREQUEST.nested_types_by_name['CustomTimeouts']
 _CONSTRUCTREQUEST_CONFIGENTRY = _CONSTRUCTREQUEST.nested_types_by_name['ConfigEntry']
 _CONSTRUCTREQUEST_INPUTDEPENDENCIESENTRY = _CONSTRUCTREQUEST.nested_types_by_name['InputDependenciesEntry']
 _CONSTRUCTREQUEST_PROVIDERSENTRY = _CONSTRUCTREQUEST.nested_types_by_name['ProvidersEntry']
 _CONSTRUCTRESPONSE = DESCRIPTOR.message_types_by_name['ConstructResponse']
 _CONSTRUCTRESPONSE_PROPERTYDEPENDENCIES = _CONSTRUCTRESPONSE.nested_types_by_name['PropertyDependencies']
 _CONSTRUCTRESPONSE_STATEDEPENDENCIESENTRY = _CONSTRUCTRESPONSE.nested_types_by_name['StateDependenciesEntry']
+_CONSTRUCTRESPONSE_INPUTDEPENDENCIESENTRY = _CONSTRUCTRESPONSE.nested_types_by_name['InputDependenciesEntry']
 _ERRORRESOURCEINITFAILED = DESCRIPTOR.message_types_by_name['ErrorResourceInitFailed']
+_GETMAPPINGREQUEST = DESCRIPTOR.message_types_by_name['GetMappingRequest']
+_GETMAPPINGRESPONSE = DESCRIPTOR.message_types_by_name['GetMappingResponse']
+_PROVIDERSUPPORTSFEATUREREQUEST = DESCRIPTOR.message_types_by_name['ProviderSupportsFeatureRequest']
+_PROVIDERSUPPORTSFEATURERESPONSE = DESCRIPTOR.message_types_by_name['ProviderSupportsFeatureResponse']
+_PROVIDERDIAGNOSTIC = DESCRIPTOR.message_types_by_name['ProviderDiagnostic']
+_CONFIGCHANGEDEVENT = DESCRIPTOR.message_types_by_name['ConfigChangedEvent']
+_CHECKFAILURE_SEVERITY = _CHECKFAILURE.enum_types_by_name['Severity']
 _PROPERTYDIFF_KIND = _PROPERTYDIFF.enum_types_by_name['Kind']
 _DIFFRESPONSE_DIFFCHANGES = _DIFFRESPONSE.enum_types_by_name['DiffChanges']
+_PROVIDERDIAGNOSTIC_SEVERITY = _PROVIDERDIAGNOSTIC.enum_types_by_name['Severity']
 GetSchemaRequest = _reflection.GeneratedProtocolMessageType('GetSchemaRequest', (_message.Message,), {
   'DESCRIPTOR' : _GETSCHEMAREQUEST,
   '__module__' : 'pulumi.provider_pb2'
@@ -196,11 +223,19 @@ CheckResponse = _reflection.GeneratedProtocolMessageType('CheckResponse', (_mess
 _sym_db.RegisterMessage(CheckResponse)
 
 CheckFailure = _reflection.GeneratedProtocolMessageType('CheckFailure', (_message.Message,), {
+
+  'SourceRange' : _reflection.GeneratedProtocolMessageType('SourceRange', (_message.Message,), {
+    'DESCRIPTOR' : _CHECKFAILURE_SOURCERANGE,
+    '__module__' : 'pulumi.provider_pb2'
+    # @@protoc_insertion_point(class_scope:pulumirpc.CheckFailure.SourceRange)
+    })
+  ,
   'DESCRIPTOR' : _CHECKFAILURE,
   '__module__' : 'pulumi.provider_pb2'
   # @@protoc_insertion_point(class_scope:pulumirpc.CheckFailure)
   })
 _sym_db.RegisterMessage(CheckFailure)
+_sym_db.RegisterMessage(CheckFailure.SourceRange)
 
 DiffRequest = _reflection.GeneratedProtocolMessageType('DiffRequest', (_message.Message,), {
   'DESCRIPTOR' : _DIFFREQUEST,
@@ -259,6 +294,118 @@ ReadResponse = _reflection.GeneratedProtocolMessageType('ReadResponse', (_messag
   })
 _sym_db.RegisterMessage(ReadResponse)
 
+MigrateStateRequest = _reflection.GeneratedProtocolMessageType('MigrateStateRequest', (_message.Message,), {
+  'DESCRIPTOR' : _MIGRATESTATEREQUEST,
+  '__module__' : 'pulumi.provider_pb2'
+  # @@protoc_insertion_point(class_scope:pulumirpc.MigrateStateRequest)
+  })
+_sym_db.RegisterMessage(MigrateStateRequest)
+
+MigrateStateResponse = _reflection.GeneratedProtocolMessageType('MigrateStateResponse', (_message.Message,), {
+  'DESCRIPTOR' : _MIGRATESTATERESPONSE,
+  '__module__' : 'pulumi.provider_pb2'
+  # @@protoc_insertion_point(class_scope:pulumirpc.MigrateStateResponse)
+  })
+_sym_db.RegisterMessage(MigrateStateResponse)
+
+EstimateCostRequest = _reflection.GeneratedProtocolMessageType('EstimateCostRequest', (_message.Message,), {
+  'DESCRIPTOR' : _ESTIMATECOSTREQUEST,
+  '__module__' : 'pulumi.provider_pb2'
+  # @@protoc_insertion_point(class_scope:pulumirpc.EstimateCostRequest)
+  })
+_sym_db.RegisterMessage(EstimateCostRequest)
+
+EstimateCostResponse = _reflection.GeneratedProtocolMessageType('EstimateCostResponse', (_message.Message,), {
+  'DESCRIPTOR' : _ESTIMATECOSTRESPONSE,
+  '__module__' : 'pulumi.provider_pb2'
+  # @@protoc_insertion_point(class_scope:pulumirpc.EstimateCostResponse)
+  })
+_sym_db.RegisterMessage(EstimateCostResponse)
+
+GetSupportedVersionsResponse = _reflection.GeneratedProtocolMessageType('GetSupportedVersionsResponse', (_message.Message,), {
+  'DESCRIPTOR' : _GETSUPPORTEDVERSIONSRESPONSE,
+  '__module__' : 'pulumi.provider_pb2'
+  # @@protoc_insertion_point(class_scope:pulumirpc.GetSupportedVersionsResponse)
+  })
+_sym_db.RegisterMessage(GetSupportedVersionsResponse)
+
+ParameterizeByValueRequest = _reflection.GeneratedProtocolMessageType('ParameterizeByValueRequest', (_message.Message,), {
+  'DESCRIPTOR' : _PARAMETERIZEBYVALUEREQUEST,
+  '__module__' : 'pulumi.provider_pb2'
+  # @@protoc_insertion_point(class_scope:pulumirpc.ParameterizeByValueRequest)
+  })
+_sym_db.RegisterMessage(ParameterizeByValueRequest)
+
+ParameterizeByReferenceRequest = _reflection.GeneratedProtocolMessageType('ParameterizeByReferenceRequest', (_message.Message,), {
+  'DESCRIPTOR' : _PARAMETERIZEBYREFERENCEREQUEST,
+  '__module__' : 'pulumi.provider_pb2'
+  # @@protoc_insertion_point(class_scope:pulumirpc.ParameterizeByReferenceRequest)
+  })
+_sym_db.RegisterMessage(ParameterizeByReferenceRequest)
+
+ParameterizeResponse = _reflection.GeneratedProtocolMessageType('ParameterizeResponse', (_message.Message,), {
+  'DESCRIPTOR' : _PARAMETERIZERESPONSE,
+  '__module__' : 'pulumi.provider_pb2'
+  # @@protoc_insertion_point(class_scope:pulumirpc.ParameterizeResponse)
+  })
+_sym_db.RegisterMessage(ParameterizeResponse)
+
+PrepareImportRequest = _reflection.GeneratedProtocolMessageType('PrepareImportRequest', (_message.Message,), {
+  'DESCRIPTOR' : _PREPAREIMPORTREQUEST,
+  '__module__' : 'pulumi.provider_pb2'
+  # @@protoc_insertion_point(class_scope:pulumirpc.PrepareImportRequest)
+  })
+_sym_db.RegisterMessage(PrepareImportRequest)
+
+PrepareImportResponse = _reflection.GeneratedProtocolMessageType('PrepareImportResponse', (_message.Message,), {
+  'DESCRIPTOR' : _PREPAREIMPORTRESPONSE,
+  '__module__' : 'pulumi.provider_pb2'
+  # @@protoc_insertion_point(class_scope:pulumirpc.PrepareImportResponse)
+  })
+_sym_db.RegisterMessage(PrepareImportResponse)
+
+ConfigChecksumMatchRequest = _reflection.GeneratedProtocolMessageType('ConfigChecksumMatchRequest', (_message.Message,), {
+  'DESCRIPTOR' : _CONFIGCHECKSUMMATCHREQUEST,
+  '__module__' : 'pulumi.provider_pb2'
+  # @@protoc_insertion_point(class_scope:pulumirpc.ConfigChecksumMatchRequest)
+  })
+_sym_db.RegisterMessage(ConfigChecksumMatchRequest)
+
+ConfigChecksumMatchResponse = _reflection.GeneratedProtocolMessageType('ConfigChecksumMatchResponse', (_message.Message,), {
+  'DESCRIPTOR' : _CONFIGCHECKSUMMATCHRESPONSE,
+  '__module__' : 'pulumi.provider_pb2'
+  # @@protoc_insertion_point(class_scope:pulumirpc.ConfigChecksumMatchResponse)
+  })
+_sym_db.RegisterMessage(ConfigChecksumMatchResponse)
+
+WaitForResourceReadyRequest = _reflection.GeneratedProtocolMessageType('WaitForResourceReadyRequest', (_message.Message,), {
+  'DESCRIPTOR' : _WAITFORRESOURCEREADYREQUEST,
+  '__module__' : 'pulumi.provider_pb2'
+  # @@protoc_insertion_point(class_scope:pulumirpc.WaitForResourceReadyRequest)
+  })
+_sym_db.RegisterMessage(WaitForResourceReadyRequest)
+
+GetResourceAliasesRequest = _reflection.GeneratedProtocolMessageType('GetResourceAliasesRequest', (_message.Message,), {
+  'DESCRIPTOR' : _GETRESOURCEALIASESREQUEST,
+  '__module__' : 'pulumi.provider_pb2'
+  # @@protoc_insertion_point(class_scope:pulumirpc.GetResourceAliasesRequest)
+  })
+_sym_db.RegisterMessage(GetResourceAliasesRequest)
+
+GetResourceAliasesResponse = _reflection.GeneratedProtocolMessageType('GetResourceAliasesResponse', (_message.Message,), {
+  'DESCRIPTOR' : _GETRESOURCEALIASESRESPONSE,
+  '__module__' : 'pulumi.provider_pb2'
+  # @@protoc_insertion_point(class_scope:pulumirpc.GetResourceAliasesResponse)
+  })
+_sym_db.RegisterMessage(GetResourceAliasesResponse)
+
+ResourceChangedEvent = _reflection.GeneratedProtocolMessageType('ResourceChangedEvent', (_message.Message,), {
+  'DESCRIPTOR' : _RESOURCECHANGEDEVENT,
+  '__module__' : 'pulumi.provider_pb2'
+  # @@protoc_insertion_point(class_scope:pulumirpc.ResourceChangedEvent)
+  })
+_sym_db.RegisterMessage(ResourceChangedEvent)
+
 UpdateRequest = _reflection.GeneratedProtocolMessageType('UpdateRequest', (_message.Message,), {
   'DESCRIPTOR' : _UPDATEREQUEST,
   '__module__' : 'pulumi.provider_pb2'
@@ -289,6 +436,13 @@ ConstructRequest = _reflection.GeneratedProtocolMessageType('ConstructRequest',
     })
   ,
 
+  'CustomTimeouts' : _reflection.GeneratedProtocolMessageType('CustomTimeouts', (_message.Message,), {
+    'DESCRIPTOR' : _CONSTRUCTREQUEST_CUSTOMTIMEOUTS,
+    '__module__' : 'pulumi.provider_pb2'
+    # @@protoc_insertion_point(class_scope:pulumirpc.ConstructRequest.CustomTimeouts)
+    })
+  ,
+
   'ConfigEntry' : _reflection.GeneratedProtocolMessageType('ConfigEntry', (_message.Message,), {
     'DESCRIPTOR' : _CONSTRUCTREQUEST_CONFIGENTRY,
     '__module__' : 'pulumi.provider_pb2'
@@ -315,6 +469,7 @@ ConstructRequest = _reflection.GeneratedProtocolMessageType('ConstructRequest',
   })
 _sym_db.RegisterMessage(ConstructRequest)
 _sym_db.RegisterMessage(ConstructRequest.PropertyDependencies)
+_sym_db.RegisterMessage(ConstructRequest.CustomTimeouts)
 _sym_db.RegisterMessage(ConstructRequest.ConfigEntry)
 _sym_db.RegisterMessage(ConstructRequest.InputDependenciesEntry)
 _sym_db.RegisterMessage(ConstructRequest.ProvidersEntry)
@@ -334,6 +489,13 @@ ConstructResponse = _reflection.GeneratedProtocolMessageType('ConstructResponse'
     # @@protoc_insertion_point(class_scope:pulumirpc.ConstructResponse.StateDependenciesEntry)
     })
   ,
+
+  'InputDependenciesEntry' : _reflection.GeneratedProtocolMessageType('InputDependenciesEntry', (_message.Message,), {
+    'DESCRIPTOR' : _CONSTRUCTRESPONSE_INPUTDEPENDENCIESENTRY,
+    '__module__' : 'pulumi.provider_pb2'
+    # @@protoc_insertion_point(class_scope:pulumirpc.ConstructResponse.InputDependenciesEntry)
+    })
+  ,
   'DESCRIPTOR' : _CONSTRUCTRESPONSE,
   '__module__' : 'pulumi.provider_pb2'
   # @@protoc_insertion_point(class_scope:pulumirpc.ConstructResponse)
@@ -341,6 +503,7 @@ ConstructResponse = _reflection.GeneratedProtocolMessageType('ConstructResponse'
 _sym_db.RegisterMessage(ConstructResponse)
 _sym_db.RegisterMessage(ConstructResponse.PropertyDependencies)
 _sym_db.RegisterMessage(ConstructResponse.StateDependenciesEntry)
+_sym_db.RegisterMessage(ConstructResponse.InputDependenciesEntry)
 
 ErrorResourceInitFailed = _reflection.GeneratedProtocolMessageType('ErrorResourceInitFailed', (_message.Message,), {
   'DESCRIPTOR' : _ERRORRESOURCEINITFAILED,
@@ -349,6 +512,48 @@ ErrorResourceInitFailed = _reflection.GeneratedProtocolMessageType('ErrorResourc
   })
 _sym_db.RegisterMessage(ErrorResourceInitFailed)
 
+GetMappingRequest = _reflection.GeneratedProtocolMessageType('GetMappingRequest', (_message.Message,), {
+  'DESCRIPTOR' : _GETMAPPINGREQUEST,
+  '__module__' : 'pulumi.provider_pb2'
+  # @@protoc_insertion_point(class_scope:pulumirpc.GetMappingRequest)
+  })
+_sym_db.RegisterMessage(GetMappingRequest)
+
+GetMappingResponse = _reflection.GeneratedProtocolMessageType('GetMappingResponse', (_message.Message,), {
+  'DESCRIPTOR' : _GETMAPPINGRESPONSE,
+  '__module__' : 'pulumi.provider_pb2'
+  # @@protoc_insertion_point(class_scope:pulumirpc.GetMappingResponse)
+  })
+_sym_db.RegisterMessage(GetMappingResponse)
+
+ProviderSupportsFeatureRequest = _reflection.GeneratedProtocolMessageType('ProviderSupportsFeatureRequest', (_message.Message,), {
+  'DESCRIPTOR' : _PROVIDERSUPPORTSFEATUREREQUEST,
+  '__module__' : 'pulumi.provider_pb2'
+  # @@protoc_insertion_point(class_scope:pulumirpc.ProviderSupportsFeatureRequest)
+  })
+_sym_db.RegisterMessage(ProviderSupportsFeatureRequest)
+
+ProviderSupportsFeatureResponse = _reflection.GeneratedProtocolMessageType('ProviderSupportsFeatureResponse', (_message.Message,), {
+  'DESCRIPTOR' : _PROVIDERSUPPORTSFEATURERESPONSE,
+  '__module__' : 'pulumi.provider_pb2'
+  # @@protoc_insertion_point(class_scope:pulumirpc.ProviderSupportsFeatureResponse)
+  })
+_sym_db.RegisterMessage(ProviderSupportsFeatureResponse)
+
+ProviderDiagnostic = _reflection.GeneratedProtocolMessageType('ProviderDiagnostic', (_message.Message,), {
+  'DESCRIPTOR' : _PROVIDERDIAGNOSTIC,
+  '__module__' : 'pulumi.provider_pb2'
+  # @@protoc_insertion_point(class_scope:pulumirpc.ProviderDiagnostic)
+  })
+_sym_db.RegisterMessage(ProviderDiagnostic)
+
+ConfigChangedEvent = _reflection.GeneratedProtocolMessageType('ConfigChangedEvent', (_message.Message,), {
+  'DESCRIPTOR' : _CONFIGCHANGEDEVENT,
+  '__module__' : 'pulumi.provider_pb2'
+  # @@protoc_insertion_point(class_scope:pulumirpc.ConfigChangedEvent)
+  })
+_sym_db.RegisterMessage(ConfigChangedEvent)
+
 _RESOURCEPROVIDER = DESCRIPTOR.services_by_name['ResourceProvider']
 if _descriptor._USE_C_DESCRIPTORS == False:
 
@@ -372,88 +577,144 @@ if _descriptor._USE_C_DESCRIPTORS == False:
   _CONSTRUCTREQUEST_PROVIDERSENTRY._serialized_options = b'8\001'
   _CONSTRUCTRESPONSE_STATEDEPENDENCIESENTRY._options = None
   _CONSTRUCTRESPONSE_STATEDEPENDENCIESENTRY._serialized_options = b'8\001'
+  _CONSTRUCTRESPONSE_INPUTDEPENDENCIESENTRY._options = None
+  _CONSTRUCTRESPONSE_INPUTDEPENDENCIESENTRY._serialized_options = b'8\001'
   _GETSCHEMAREQUEST._serialized_start=116
   _GETSCHEMAREQUEST._serialized_end=151
   _GETSCHEMARESPONSE._serialized_start=153
-  _GETSCHEMARESPONSE._serialized_end=188
-  _CONFIGUREREQUEST._serialized_start=191
-  _CONFIGUREREQUEST._serialized_end=409
-  _CONFIGUREREQUEST_VARIABLESENTRY._serialized_start=361
-  _CONFIGUREREQUEST_VARIABLESENTRY._serialized_end=409
-  _CONFIGURERESPONSE._serialized_start=411
-  _CONFIGURERESPONSE._serialized_end=526
-  _CONFIGUREERRORMISSINGKEYS._serialized_start=529
-  _CONFIGUREERRORMISSINGKEYS._serialized_end=675
-  _CONFIGUREERRORMISSINGKEYS_MISSINGKEY._serialized_start=628
-  _CONFIGUREERRORMISSINGKEYS_MISSINGKEY._serialized_end=675
-  _INVOKEREQUEST._serialized_start=678
-  _INVOKEREQUEST._serialized_end=806
-  _INVOKERESPONSE._serialized_start=808
-  _INVOKERESPONSE._serialized_end=908
-  _CALLREQUEST._serialized_start=911
-  _CALLREQUEST._serialized_end=1485
-  _CALLREQUEST_ARGUMENTDEPENDENCIES._serialized_start=1301
-  _CALLREQUEST_ARGUMENTDEPENDENCIES._serialized_end=1337
-  _CALLREQUEST_ARGDEPENDENCIESENTRY._serialized_start=1339
-  _CALLREQUEST_ARGDEPENDENCIESENTRY._serialized_end=1438
-  _CALLREQUEST_CONFIGENTRY._serialized_start=1440
-  _CALLREQUEST_CONFIGENTRY._serialized_end=1485
-  _CALLRESPONSE._serialized_start=1488
-  _CALLRESPONSE._serialized_end=1802
-  _CALLRESPONSE_RETURNDEPENDENCIES._serialized_start=1665
-  _CALLRESPONSE_RETURNDEPENDENCIES._serialized_end=1699
-  _CALLRESPONSE_RETURNDEPENDENCIESENTRY._serialized_start=1701
-  _CALLRESPONSE_RETURNDEPENDENCIESENTRY._serialized_end=1802
-  _CHECKREQUEST._serialized_start=1805
-  _CHECKREQUEST._serialized_end=1952
-  _CHECKRESPONSE._serialized_start=1954
-  _CHECKRESPONSE._serialized_end=2053
-  _CHECKFAILURE._serialized_start=2055
-  _CHECKFAILURE._serialized_end=2103
-  _DIFFREQUEST._serialized_start=2106
-  _DIFFREQUEST._serialized_end=2245
-  _PROPERTYDIFF._serialized_start=2248
-  _PROPERTYDIFF._serialized_end=2423
-  _PROPERTYDIFF_KIND._serialized_start=2327
-  _PROPERTYDIFF_KIND._serialized_end=2423
-  _DIFFRESPONSE._serialized_start=2426
-  _DIFFRESPONSE._serialized_end=2804
-  _DIFFRESPONSE_DETAILEDDIFFENTRY._serialized_start=2665
-  _DIFFRESPONSE_DETAILEDDIFFENTRY._serialized_end=2741
-  _DIFFRESPONSE_DIFFCHANGES._serialized_start=2743
-  _DIFFRESPONSE_DIFFCHANGES._serialized_end=2804
-  _CREATEREQUEST._serialized_start=2806
-  _CREATEREQUEST._serialized_end=2913
-  _CREATERESPONSE._serialized_start=2915
-  _CREATERESPONSE._serialized_end=2988
-  _READREQUEST._serialized_start=2990
-  _READREQUEST._serialized_end=3114
-  _READRESPONSE._serialized_start=3116
-  _READRESPONSE._serialized_end=3228
-  _UPDATEREQUEST._serialized_start=3231
-  _UPDATEREQUEST._serialized_end=3406
-  _UPDATERESPONSE._serialized_start=3408
-  _UPDATERESPONSE._serialized_end=3469
-  _DELETEREQUEST._serialized_start=3471
-  _DELETEREQUEST._serialized_end=3573
-  _CONSTRUCTREQUEST._serialized_start=3576
-  _CONSTRUCTREQUEST._serialized_end=4316
-  _CONSTRUCTREQUEST_PROPERTYDEPENDENCIES._serialized_start=4075
-  _CONSTRUCTREQUEST_PROPERTYDEPENDENCIES._serialized_end=4111
-  _CONSTRUCTREQUEST_CONFIGENTRY._serialized_start=1440
-  _CONSTRUCTREQUEST_CONFIGENTRY._serialized_end=1485
-  _CONSTRUCTREQUEST_INPUTDEPENDENCIESENTRY._serialized_start=4160
-  _CONSTRUCTREQUEST_INPUTDEPENDENCIESENTRY._serialized_end=4266
-  _CONSTRUCTREQUEST_PROVIDERSENTRY._serialized_start=4268
-  _CONSTRUCTREQUEST_PROVIDERSENTRY._serialized_end=4316
-  _CONSTRUCTRESPONSE._serialized_start=4319
-  _CONSTRUCTRESPONSE._serialized_end=4618
-  _CONSTRUCTRESPONSE_PROPERTYDEPENDENCIES._serialized_start=4075
-  _CONSTRUCTRESPONSE_PROPERTYDEPENDENCIES._serialized_end=4111
-  _CONSTRUCTRESPONSE_STATEDEPENDENCIESENTRY._serialized_start=4511
-  _CONSTRUCTRESPONSE_STATEDEPENDENCIESENTRY._serialized_end=4618
-  _ERRORRESOURCEINITFAILED._serialized_start=4621
-  _ERRORRESOURCEINITFAILED._serialized_end=4761
-  _RESOURCEPROVIDER._serialized_start=4764
-  _RESOURCEPROVIDER._serialized_end=5893
+  _GETSCHEMARESPONSE._serialized_end=206
+  _CONFIGUREREQUEST._serialized_start=209
+  _CONFIGUREREQUEST._serialized_end=453
+  _CONFIGUREREQUEST_VARIABLESENTRY._serialized_start=405
+  _CONFIGUREREQUEST_VARIABLESENTRY._serialized_end=453
+  _CONFIGURERESPONSE._serialized_start=456
+  _CONFIGURERESPONSE._serialized_end=594
+  _CONFIGUREERRORMISSINGKEYS._serialized_start=597
+  _CONFIGUREERRORMISSINGKEYS._serialized_end=743
+  _CONFIGUREERRORMISSINGKEYS_MISSINGKEY._serialized_start=696
+  _CONFIGUREERRORMISSINGKEYS_MISSINGKEY._serialized_end=743
+  _INVOKEREQUEST._serialized_start=746
+  _INVOKEREQUEST._serialized_end=874
+  _INVOKERESPONSE._serialized_start=877
+  _INVOKERESPONSE._serialized_end=1017
+  _CALLREQUEST._serialized_start=1020
+  _CALLREQUEST._serialized_end=1644
+  _CALLREQUEST_ARGUMENTDEPENDENCIES._serialized_start=1460
+  _CALLREQUEST_ARGUMENTDEPENDENCIES._serialized_end=1496
+  _CALLREQUEST_ARGDEPENDENCIESENTRY._serialized_start=1498
+  _CALLREQUEST_ARGDEPENDENCIESENTRY._serialized_end=1597
+  _CALLREQUEST_CONFIGENTRY._serialized_start=1599
+  _CALLREQUEST_CONFIGENTRY._serialized_end=1644
+  _CALLRESPONSE._serialized_start=1647
+  _CALLRESPONSE._serialized_end=2067
+  _CALLRESPONSE_RETURNDEPENDENCIES._serialized_start=1930
+  _CALLRESPONSE_RETURNDEPENDENCIES._serialized_end=1964
+  _CALLRESPONSE_RETURNDEPENDENCIESENTRY._serialized_start=1966
+  _CALLRESPONSE_RETURNDEPENDENCIESENTRY._serialized_end=2067
+  _CHECKREQUEST._serialized_start=2070
+  _CHECKREQUEST._serialized_end=2257
+  _CHECKRESPONSE._serialized_start=2260
+  _CHECKRESPONSE._serialized_end=2433
+  _CHECKFAILURE._serialized_start=2436
+  _CHECKFAILURE._serialized_end=2697
+  _CHECKFAILURE_SOURCERANGE._serialized_start=2604
+  _CHECKFAILURE_SOURCERANGE._serialized_end=2661
+  _CHECKFAILURE_SEVERITY._serialized_start=2663
+  _CHECKFAILURE_SEVERITY._serialized_end=2697
+  _DIFFREQUEST._serialized_start=2700
+  _DIFFREQUEST._serialized_end=2839
+  _PROPERTYDIFF._serialized_start=2842
+  _PROPERTYDIFF._serialized_end=3117
+  _PROPERTYDIFF_KIND._serialized_start=3021
+  _PROPERTYDIFF_KIND._serialized_end=3117
+  _DIFFRESPONSE._serialized_start=3120
+  _DIFFRESPONSE._serialized_end=3577
+  _DIFFRESPONSE_DETAILEDDIFFENTRY._serialized_start=3438
+  _DIFFRESPONSE_DETAILEDDIFFENTRY._serialized_end=3514
+  _DIFFRESPONSE_DIFFCHANGES._serialized_start=3516
+  _DIFFRESPONSE_DIFFCHANGES._serialized_end=3577
+  _CREATEREQUEST._serialized_start=3580
+  _CREATEREQUEST._serialized_end=3713
+  _CREATERESPONSE._serialized_start=3716
+  _CREATERESPONSE._serialized_end=3867
+  _READREQUEST._serialized_start=3869
+  _READREQUEST._serialized_end=3993
+  _READRESPONSE._serialized_start=3996
+  _READRESPONSE._serialized_end=4160
+  _MIGRATESTATEREQUEST._serialized_start=4162
+  _MIGRATESTATEREQUEST._serialized_end=4258
+  _MIGRATESTATERESPONSE._serialized_start=4260
+  _MIGRATESTATERESPONSE._serialized_end=4322
+  _ESTIMATECOSTREQUEST._serialized_start=4324
+  _ESTIMATECOSTREQUEST._serialized_end=4397
+  _ESTIMATECOSTRESPONSE._serialized_start=4399
+  _ESTIMATECOSTRESPONSE._serialized_end=4483
+  _GETSUPPORTEDVERSIONSRESPONSE._serialized_start=4485
+  _GETSUPPORTEDVERSIONSRESPONSE._serialized_end=4533
+  _PARAMETERIZEBYVALUEREQUEST._serialized_start=4535
+  _PARAMETERIZEBYVALUEREQUEST._serialized_end=4578
+  _PARAMETERIZEBYREFERENCEREQUEST._serialized_start=4580
+  _PARAMETERIZEBYREFERENCEREQUEST._serialized_end=4643
+  _PARAMETERIZERESPONSE._serialized_start=4645
+  _PARAMETERIZERESPONSE._serialized_end=4698
+  _PREPAREIMPORTREQUEST._serialized_start=4700
+  _PREPAREIMPORTREQUEST._serialized_end=4747
+  _PREPAREIMPORTRESPONSE._serialized_start=4749
+  _PREPAREIMPORTRESPONSE._serialized_end=4813
+  _CONFIGCHECKSUMMATCHREQUEST._serialized_start=4815
+  _CONFIGCHECKSUMMATCHREQUEST._serialized_end=4874
+  _CONFIGCHECKSUMMATCHRESPONSE._serialized_start=4876
+  _CONFIGCHECKSUMMATCHRESPONSE._serialized_end=4920
+  _WAITFORRESOURCEREADYREQUEST._serialized_start=4922
+  _WAITFORRESOURCEREADYREQUEST._serialized_end=4993
+  _GETRESOURCEALIASESREQUEST._serialized_start=4995
+  _GETRESOURCEALIASESREQUEST._serialized_end=5035
+  _GETRESOURCEALIASESRESPONSE._serialized_start=5037
+  _GETRESOURCEALIASESRESPONSE._serialized_end=5082
+  _RESOURCECHANGEDEVENT._serialized_start=5084
+  _RESOURCECHANGEDEVENT._serialized_end=5131
+  _UPDATEREQUEST._serialized_start=5134
+  _UPDATEREQUEST._serialized_end=5349
+  _UPDATERESPONSE._serialized_start=5352
+  _UPDATERESPONSE._serialized_end=5491
+  _DELETEREQUEST._serialized_start=5493
+  _DELETEREQUEST._serialized_end=5595
+  _CONSTRUCTREQUEST._serialized_start=5598
+  _CONSTRUCTREQUEST._serialized_end=6590
+  _CONSTRUCTREQUEST_PROPERTYDEPENDENCIES._serialized_start=6283
+  _CONSTRUCTREQUEST_PROPERTYDEPENDENCIES._serialized_end=6319
+  _CONSTRUCTREQUEST_CUSTOMTIMEOUTS._serialized_start=6321
+  _CONSTRUCTREQUEST_CUSTOMTIMEOUTS._serialized_end=6385
+  _CONSTRUCTREQUEST_CONFIGENTRY._serialized_start=1599
+  _CONSTRUCTREQUEST_CONFIGENTRY._serialized_end=1644
+  _CONSTRUCTREQUEST_INPUTDEPENDENCIESENTRY._serialized_start=6434
+  _CONSTRUCTREQUEST_INPUTDEPENDENCIESENTRY._serialized_end=6540
+  _CONSTRUCTREQUEST_PROVIDERSENTRY._serialized_start=6542
+  _CONSTRUCTREQUEST_PROVIDERSENTRY._serialized_end=6590
+  _CONSTRUCTRESPONSE._serialized_start=6593
+  _CONSTRUCTRESPONSE._serialized_end=7146
+  _CONSTRUCTRESPONSE_PROPERTYDEPENDENCIES._serialized_start=6283
+  _CONSTRUCTRESPONSE_PROPERTYDEPENDENCIES._serialized_end=6319
+  _CONSTRUCTRESPONSE_STATEDEPENDENCIESENTRY._serialized_start=6930
+  _CONSTRUCTRESPONSE_STATEDEPENDENCIESENTRY._serialized_end=7037
+  _CONSTRUCTRESPONSE_INPUTDEPENDENCIESENTRY._serialized_start=7039
+  _CONSTRUCTRESPONSE_INPUTDEPENDENCIESENTRY._serialized_end=7146
+  _ERRORRESOURCEINITFAILED._serialized_start=7149
+  _ERRORRESOURCEINITFAILED._serialized_end=7289
+  _GETMAPPINGREQUEST._serialized_start=7291
+  _GETMAPPINGREQUEST._serialized_end=7323
+  _GETMAPPINGRESPONSE._serialized_start=7325
+  _GETMAPPINGRESPONSE._serialized_end=7377
+  _PROVIDERSUPPORTSFEATUREREQUEST._serialized_start=7379
+  _PROVIDERSUPPORTSFEATUREREQUEST._serialized_end=7423
+  _PROVIDERSUPPORTSFEATURERESPONSE._serialized_start=7425
+  _PROVIDERSUPPORTSFEATURERESPONSE._serialized_end=7478
+  _PROVIDERDIAGNOSTIC._serialized_start=7481
+  _PROVIDERDIAGNOSTIC._serialized_end=7651
+  _PROVIDERDIAGNOSTIC_SEVERITY._serialized_start=7607
+  _PROVIDERDIAGNOSTIC_SEVERITY._serialized_end=7651
+  _CONFIGCHANGEDEVENT._serialized_start=7653
+  _CONFIGCHANGEDEVENT._serialized_end=7714
+  _RESOURCEPROVIDER._serialized_start=7717
+  _RESOURCEPROVIDER._serialized_end=10302
 # @@protoc_insertion_point(module_scope)
@@ -88,17 +88,42 @@ class CallResponse:
 
 class CheckResponse:
     def __init__(
-        self, inputs: Optional[Struct] = None, failures: List[CheckFailure] = []
+        self,
+        inputs: Optional[Struct] = None,
+        failures: List[CheckFailure] = [],
+        diagnostics: List[ProviderDiagnostic] = [],
     ) -> None:
         pass
     inputs: Struct
     failures: List[CheckFailure]
+    diagnostics: List[ProviderDiagnostic]
 
 class CheckFailure:
-    def __init__(self, property: str, reason: str) -> None:
+    ERROR: int
+    WARNING: int
+
+    def __init__(
+        self, property: str, reason: str, severity: int = 0, code: str = ""
+    ) -> None:
         pass
     property: str
     reason: str
+    severity: int
+    code: str
+
+class ProviderDiagnostic:
+    INFO: int
+    WARNING: int
+    ERROR: int
+
+    def __init__(
+        self, severity: int = 0, summary: str = "", detail: str = "", url: str = ""
+    ) -> None:
+        pass
+    severity: int
+    summary: str
+    detail: str
+    url: str
 
 class ConfigureResponse:
     def __init__(
@@ -103,6 +103,91 @@ class ResourceProviderStub(object):
                 request_serializer=pulumi_dot_plugin__pb2.PluginAttach.SerializeToString,
                 response_deserializer=google_dot_protobuf_dot_empty__pb2.Empty.FromString,
                 )
+        self.GetMapping = channel.unary_unary(
+                '/pulumirpc.ResourceProvider/GetMapping',
+                request_serializer=pulumi_dot_provider__pb2.GetMappingRequest.SerializeToString,
+                response_deserializer=pulumi_dot_provider__pb2.GetMappingResponse.FromString,
+                )
+        self.SupportsFeature = channel.unary_unary(
+                '/pulumirpc.ResourceProvider/SupportsFeature',
+                request_serializer=pulumi_dot_provider__pb2.ProviderSupportsFeatureRequest.SerializeToString,
+                response_deserializer=pulumi_dot_provider__pb2.ProviderSupportsFeatureResponse.FromString,
+                )
+        self.ReadStream = channel.unary_stream(
+                '/pulumirpc.ResourceProvider/ReadStream',
+                request_serializer=pulumi_dot_provider__pb2.ReadRequest.SerializeToString,
+                response_deserializer=pulumi_dot_provider__pb2.ReadResponse.FromString,
+                )
+        self.Refresh = channel.unary_unary(
+                '/pulumirpc.ResourceProvider/Refresh',
+                request_serializer=pulumi_dot_provider__pb2.ReadRequest.SerializeToString,
+                response_deserializer=pulumi_dot_provider__pb2.ReadResponse.FromString,
+                )
+        self.MigrateState = channel.unary_unary(
+                '/pulumirpc.ResourceProvider/MigrateState',
+                request_serializer=pulumi_dot_provider__pb2.MigrateStateRequest.SerializeToString,
+                response_deserializer=pulumi_dot_provider__pb2.MigrateStateResponse.FromString,
+                )
+        self.WatchResourceChanges = channel.unary_stream(
+                '/pulumirpc.ResourceProvider/WatchResourceChanges',
+                request_serializer=google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
+                response_deserializer=pulumi_dot_provider__pb2.ResourceChangedEvent.FromString,
+                )
+        self.StreamCreate = channel.unary_stream(
+                '/pulumirpc.ResourceProvider/StreamCreate',
+                request_serializer=pulumi_dot_provider__pb2.CreateRequest.SerializeToString,
+                response_deserializer=pulumi_dot_provider__pb2.CreateResponse.FromString,
+                )
+        self.EstimateCost = channel.unary_unary(
+                '/pulumirpc.ResourceProvider/EstimateCost',
+                request_serializer=pulumi_dot_provider__pb2.EstimateCostRequest.SerializeToString,
+                response_deserializer=pulumi_dot_provider__pb2.EstimateCostResponse.FromString,
+                )
+        self.GetSupportedVersions = channel.unary_unary(
+                '/pulumirpc.ResourceProvider/GetSupportedVersions',
+                request_serializer=google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
+                response_deserializer=pulumi_dot_provider__pb2.GetSupportedVersionsResponse.FromString,
+                )
+        self.ParameterizeByValue = channel.unary_unary(
+                '/pulumirpc.ResourceProvider/ParameterizeByValue',
+                request_serializer=pulumi_dot_provider__pb2.ParameterizeByValueRequest.SerializeToString,
+                response_deserializer=pulumi_dot_provider__pb2.ParameterizeResponse.FromString,
+                )
+        self.ParameterizeByReference = channel.unary_unary(
+                '/pulumirpc.ResourceProvider/ParameterizeByReference',
+                request_serializer=pulumi_dot_provider__pb2.ParameterizeByReferenceRequest.SerializeToString,
+                response_deserializer=pulumi_dot_provider__pb2.ParameterizeResponse.FromString,
+                )
+        self.PrepareImport = channel.unary_unary(
+                '/pulumirpc.ResourceProvider/PrepareImport',
+                request_serializer=pulumi_dot_provider__pb2.PrepareImportRequest.SerializeToString,
+                response_deserializer=pulumi_dot_provider__pb2.PrepareImportResponse.FromString,
+                )
+        self.ConfigChecksumMatch = channel.unary_unary(
+                '/pulumirpc.ResourceProvider/ConfigChecksumMatch',
+                request_serializer=pulumi_dot_provider__pb2.ConfigChecksumMatchRequest.SerializeToString,
+                response_deserializer=pulumi_dot_provider__pb2.ConfigChecksumMatchResponse.FromString,
+                )
+        self.WaitForResourceReady = channel.unary_unary(
+                '/pulumirpc.ResourceProvider/WaitForResourceReady',
+                request_serializer=pulumi_dot_provider__pb2.WaitForResourceReadyRequest.SerializeToString,
+                response_deserializer=google_dot_protobuf_dot_empty__pb2.Empty.FromString,
+                )
+        self.GetResourceAliases = channel.unary_unary(
+                '/pulumirpc.ResourceProvider/GetResourceAliases',
+                request_serializer=pulumi_dot_provider__pb2.GetResourceAliasesRequest.SerializeToString,
+                response_deserializer=pulumi_dot_provider__pb2.GetResourceAliasesResponse.FromString,
+                )
+        self.WatchConfig = channel.unary_stream(
+                '/pulumirpc.ResourceProvider/WatchConfig',
+                request_serializer=google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
+                response_deserializer=pulumi_dot_provider__pb2.ConfigChangedEvent.FromString,
+                )
+        self.ValidateProvider = channel.unary_unary(
+                '/pulumirpc.ResourceProvider/ValidateProvider',
+                request_serializer=google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
+                response_deserializer=google_dot_protobuf_dot_empty__pb2.Empty.FromString,
+                )
 
 
 class ResourceProviderServicer(object):
@@ -240,6 +325,155 @@ class ResourceProviderServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def GetMapping(self, request, context):
+        """GetMapping fetches the mapping for this resource provider, if any. A provider should return an empty
+        response (not an error) if it doesn't have a mapping for the given key.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def SupportsFeature(self, request, context):
+        """SupportsFeature returns true if the provider supports the given feature. Providers should return false (not an
+        error) for features that they do not recognize.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ReadStream(self, request, context):
+        """ReadStream reads the current live state associated with a resource, like Read, but streams interim states back
+        to the caller until the read is complete. The last response sent is the final state of the resource.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def Refresh(self, request, context):
+        """Refresh reads the current live state associated with a resource as part of a refresh. Unlike Read, which is
+        also used to import resources, Refresh should report a resource that no longer exists by returning empty
+        properties rather than an error. Callers fall back to Read if this method is unimplemented.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def MigrateState(self, request, context):
+        """MigrateState upgrades the stored state of a resource that was written by a version of the provider with a
+        different state schema version than the one the provider reported from Configure. Callers use the stored state
+        unchanged if this method is unimplemented.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def WatchResourceChanges(self, request, context):
+        """WatchResourceChanges streams notifications of changes that the provider observes in the live state of the
+        resources it manages, e.g. changes made outside of Pulumi. Callers refresh the state of a changed resource before
+        diffing it. The stream stays open until the caller cancels it; callers ignore the method if it is unimplemented.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def StreamCreate(self, request, context):
+        """StreamCreate allocates a new instance of the provided resource, like Create, but streams interim states back to
+        the caller while the resource is being created, e.g. while a long-running job makes progress. Interim responses
+        leave the ID empty; the final response carries the ID and state of the created resource. Callers fall back to
+        Create if this method is unimplemented.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def EstimateCost(self, request, context):
+        """EstimateCost estimates the monthly cost of running a resource with the given inputs, e.g. so that a preview can
+        report the cost of the changes it proposes. Callers omit the resource from any estimate if this method is
+        unimplemented.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetSupportedVersions(self, request, context):
+        """GetSupportedVersions returns the schema versions that GetSchema can serve. Callers request version 0 if this
+        method is unimplemented.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ParameterizeByValue(self, request, context):
+        """ParameterizeByValue parameterizes the provider with an opaque value, e.g. the description of a bridged
+        sub-provider, and returns the package that the parameterized provider implements. Callers invoke it after
+        Configure and before any resource operations.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ParameterizeByReference(self, request, context):
+        """ParameterizeByReference parameterizes the provider with a package that it knows how to resolve itself, e.g. from
+        a registry, and returns the package that the parameterized provider implements. Callers invoke it after
+        Configure and before any resource operations.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def PrepareImport(self, request, context):
+        """PrepareImport normalizes the ID of a resource that is about to be imported and returns the minimal inputs that
+        are required to import it. Callers pass these inputs to Read, and fall back to the inputs returned by Read if
+        this method is unimplemented.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ConfigChecksumMatch(self, request, context):
+        """ConfigChecksumMatch reports whether the given checksum matches the checksum of the configuration that the
+        provider last validated for the given provider resource. Callers skip CheckConfig when it matches, and always
+        call CheckConfig if this method is unimplemented.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def WaitForResourceReady(self, request, context):
+        """WaitForResourceReady blocks until a resource that was just created is ready to be used, or until the timeout
+        expires, in which case it fails with DEADLINE_EXCEEDED. The engine calls it after Create for resources whose
+        schema sets waitForReady, if the provider supports the waitForResourceReady feature.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetResourceAliases(self, request, context):
+        """GetResourceAliases returns the URNs under which a resource may have been stored by earlier versions of the
+        provider, e.g. because its type has since been renamed.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def WatchConfig(self, request, context):
+        """WatchConfig streams the provider's configuration each time it changes outside of Pulumi, e.g. because a secret
+        that the provider reads has been rotated. Callers re-validate the new configuration and reconfigure the provider
+        with it. The stream stays open until the caller cancels it; callers ignore the method if it is unimplemented.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ValidateProvider(self, request, context):
+        """ValidateProvider runs provider-level pre-flight checks, such as verifying credentials or permissions, without
+        touching any resources. The engine calls it after Configure and before the first resource operation. An error
+        status fails the deployment; callers fall back to a liveness check if the method is unimplemented.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_ResourceProviderServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -328,6 +562,91 @@ def add_ResourceProviderServicer_to_server(servicer, server):
                     request_deserializer=pulumi_dot_plugin__pb2.PluginAttach.FromString,
                     response_serializer=google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
             ),
+            'GetMapping': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetMapping,
+                    request_deserializer=pulumi_dot_provider__pb2.GetMappingRequest.FromString,
+                    response_serializer=pulumi_dot_provider__pb2.GetMappingResponse.SerializeToString,
+            ),
+            'SupportsFeature': grpc.unary_unary_rpc_method_handler(
+                    servicer.SupportsFeature,
+                    request_deserializer=pulumi_dot_provider__pb2.ProviderSupportsFeatureRequest.FromString,
+                    response_serializer=pulumi_dot_provider__pb2.ProviderSupportsFeatureResponse.SerializeToString,
+            ),
+            'ReadStream': grpc.unary_stream_rpc_method_handler(
+                    servicer.ReadStream,
+                    request_deserializer=pulumi_dot_provider__pb2.ReadRequest.FromString,
+                    response_serializer=pulumi_dot_provider__pb2.ReadResponse.SerializeToString,
+            ),
+            'Refresh': grpc.unary_unary_rpc_method_handler(
+                    servicer.Refresh,
+                    request_deserializer=pulumi_dot_provider__pb2.ReadRequest.FromString,
+                    response_serializer=pulumi_dot_provider__pb2.ReadResponse.SerializeToString,
+            ),
+            'MigrateState': grpc.unary_unary_rpc_method_handler(
+                    servicer.MigrateState,
+                    request_deserializer=pulumi_dot_provider__pb2.MigrateStateRequest.FromString,
+                    response_serializer=pulumi_dot_provider__pb2.MigrateStateResponse.SerializeToString,
+            ),
+            'WatchResourceChanges': grpc.unary_stream_rpc_method_handler(
+                    servicer.WatchResourceChanges,
+                    request_deserializer=google_dot_protobuf_dot_empty__pb2.Empty.FromString,
+                    response_serializer=pulumi_dot_provider__pb2.ResourceChangedEvent.SerializeToString,
+            ),
+            'StreamCreate': grpc.unary_stream_rpc_method_handler(
+                    servicer.StreamCreate,
+                    request_deserializer=pulumi_dot_provider__pb2.CreateRequest.FromString,
+                    response_serializer=pulumi_dot_provider__pb2.CreateResponse.SerializeToString,
+            ),
+            'EstimateCost': grpc.unary_unary_rpc_method_handler(
+                    servicer.EstimateCost,
+                    request_deserializer=pulumi_dot_provider__pb2.EstimateCostRequest.FromString,
+                    response_serializer=pulumi_dot_provider__pb2.EstimateCostResponse.SerializeToString,
+            ),
+            'GetSupportedVersions': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetSupportedVersions,
+                    request_deserializer=google_dot_protobuf_dot_empty__pb2.Empty.FromString,
+                    response_serializer=pulumi_dot_provider__pb2.GetSupportedVersionsResponse.SerializeToString,
+            ),
+            'ParameterizeByValue': grpc.unary_unary_rpc_method_handler(
+                    servicer.ParameterizeByValue,
+                    request_deserializer=pulumi_dot_provider__pb2.ParameterizeByValueRequest.FromString,
+                    response_serializer=pulumi_dot_provider__pb2.ParameterizeResponse.SerializeToString,
+            ),
+            'ParameterizeByReference': grpc.unary_unary_rpc_method_handler(
+                    servicer.ParameterizeByReference,
+                    request_deserializer=pulumi_dot_provider__pb2.ParameterizeByReferenceRequest.FromString,
+                    response_serializer=pulumi_dot_provider__pb2.ParameterizeResponse.SerializeToString,
+            ),
+            'PrepareImport': grpc.unary_unary_rpc_method_handler(
+                    servicer.PrepareImport,
+                    request_deserializer=pulumi_dot_provider__pb2.PrepareImportRequest.FromString,
+                    response_serializer=pulumi_dot_provider__pb2.PrepareImportResponse.SerializeToString,
+            ),
+            'ConfigChecksumMatch': grpc.unary_unary_rpc_method_handler(
+                    servicer.ConfigChecksumMatch,
+                    request_deserializer=pulumi_dot_provider__pb2.ConfigChecksumMatchRequest.FromString,
+                    response_serializer=pulumi_dot_provider__pb2.ConfigChecksumMatchResponse.SerializeToString,
+            ),
+            'WaitForResourceReady': grpc.unary_unary_rpc_method_handler(
+                    servicer.WaitForResourceReady,
+                    request_deserializer=pulumi_dot_provider__pb2.WaitForResourceReadyRequest.FromString,
+                    response_serializer=google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
+            ),
+            'GetResourceAliases': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetResourceAliases,
+                    request_deserializer=pulumi_dot_provider__pb2.GetResourceAliasesRequest.FromString,
+                    response_serializer=pulumi_dot_provider__pb2.GetResourceAliasesResponse.SerializeToString,
+            ),
+            'WatchConfig': grpc.unary_stream_rpc_method_handler(
+                    servicer.WatchConfig,
+                    request_deserializer=google_dot_protobuf_dot_empty__pb2.Empty.FromString,
+                    response_serializer=pulumi_dot_provider__pb2.ConfigChangedEvent.SerializeToString,
+            ),
+            'ValidateProvider': grpc.unary_unary_rpc_method_handler(
+                    servicer.ValidateProvider,
+                    request_deserializer=google_dot_protobuf_dot_empty__pb2.Empty.FromString,
+                    response_serializer=google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'pulumirpc.ResourceProvider', rpc_method_handlers)
@@ -628,3 +947,292 @@ class ResourceProvider(object):
             google_dot_protobuf_dot_empty__pb2.Empty.FromString,
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetMapping(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pulumirpc.ResourceProvider/GetMapping',
+            pulumi_dot_provider__pb2.GetMappingRequest.SerializeToString,
+            pulumi_dot_provider__pb2.GetMappingResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def SupportsFeature(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pulumirpc.ResourceProvider/SupportsFeature',
+            pulumi_dot_provider__pb2.ProviderSupportsFeatureRequest.SerializeToString,
+            pulumi_dot_provider__pb2.ProviderSupportsFeatureResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ReadStream(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(request, target, '/pulumirpc.ResourceProvider/ReadStream',
+            pulumi_dot_provider__pb2.ReadRequest.SerializeToString,
+            pulumi_dot_provider__pb2.ReadResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def Refresh(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pulumirpc.ResourceProvider/Refresh',
+            pulumi_dot_provider__pb2.ReadRequest.SerializeToString,
+            pulumi_dot_provider__pb2.ReadResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def MigrateState(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pulumirpc.ResourceProvider/MigrateState',
+            pulumi_dot_provider__pb2.MigrateStateRequest.SerializeToString,
+            pulumi_dot_provider__pb2.MigrateStateResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def WatchResourceChanges(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(request, target, '/pulumirpc.ResourceProvider/WatchResourceChanges',
+            google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
+            pulumi_dot_provider__pb2.ResourceChangedEvent.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def StreamCreate(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(request, target, '/pulumirpc.ResourceProvider/StreamCreate',
+            pulumi_dot_provider__pb2.CreateRequest.SerializeToString,
+            pulumi_dot_provider__pb2.CreateResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def EstimateCost(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pulumirpc.ResourceProvider/EstimateCost',
+            pulumi_dot_provider__pb2.EstimateCostRequest.SerializeToString,
+            pulumi_dot_provider__pb2.EstimateCostResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetSupportedVersions(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pulumirpc.ResourceProvider/GetSupportedVersions',
+            google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
+            pulumi_dot_provider__pb2.GetSupportedVersionsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ParameterizeByValue(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pulumirpc.ResourceProvider/ParameterizeByValue',
+            pulumi_dot_provider__pb2.ParameterizeByValueRequest.SerializeToString,
+            pulumi_dot_provider__pb2.ParameterizeResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ParameterizeByReference(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pulumirpc.ResourceProvider/ParameterizeByReference',
+            pulumi_dot_provider__pb2.ParameterizeByReferenceRequest.SerializeToString,
+            pulumi_dot_provider__pb2.ParameterizeResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def PrepareImport(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pulumirpc.ResourceProvider/PrepareImport',
+            pulumi_dot_provider__pb2.PrepareImportRequest.SerializeToString,
+            pulumi_dot_provider__pb2.PrepareImportResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ConfigChecksumMatch(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pulumirpc.ResourceProvider/ConfigChecksumMatch',
+            pulumi_dot_provider__pb2.ConfigChecksumMatchRequest.SerializeToString,
+            pulumi_dot_provider__pb2.ConfigChecksumMatchResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def WaitForResourceReady(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pulumirpc.ResourceProvider/WaitForResourceReady',
+            pulumi_dot_provider__pb2.WaitForResourceReadyRequest.SerializeToString,
+            google_dot_protobuf_dot_empty__pb2.Empty.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetResourceAliases(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pulumirpc.ResourceProvider/GetResourceAliases',
+            pulumi_dot_provider__pb2.GetResourceAliasesRequest.SerializeToString,
+            pulumi_dot_provider__pb2.GetResourceAliasesResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def WatchConfig(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(request, target, '/pulumirpc.ResourceProvider/WatchConfig',
+            google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
+            pulumi_dot_provider__pb2.ConfigChangedEvent.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ValidateProvider(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pulumirpc.ResourceProvider/ValidateProvider',
+            google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
+            google_dot_protobuf_dot_empty__pb2.Empty.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
@@ -19,6 +19,8 @@ import (
 	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
 
 	pbempty "github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type Resource struct {
@@ -218,6 +220,90 @@ func (p *testcomponentProvider) Read(ctx context.Context, req *pulumirpc.ReadReq
 	}, nil
 }
 
+func (p *testcomponentProvider) Refresh(ctx context.Context,
+	req *pulumirpc.ReadRequest) (*pulumirpc.ReadResponse, error) {
+	return p.Read(ctx, req)
+}
+
+func (p *testcomponentProvider) MigrateState(ctx context.Context,
+	req *pulumirpc.MigrateStateRequest) (*pulumirpc.MigrateStateResponse, error) {
+	return &pulumirpc.MigrateStateResponse{State: req.GetState()}, nil
+}
+
+// WatchResourceChanges streams notifications of changes to the live state of resources. The provider never observes
+// such changes, so the stream stays open without sending any events until it is canceled.
+func (p *testcomponentProvider) WatchResourceChanges(_ *pbempty.Empty,
+	server pulumirpc.ResourceProvider_WatchResourceChangesServer) error {
+	<-server.Context().Done()
+	return nil
+}
+
+// GetSupportedVersions returns the schema versions that GetSchema can serve. The provider only serves version 0.
+func (p *testcomponentProvider) GetSupportedVersions(ctx context.Context,
+	req *pbempty.Empty) (*pulumirpc.GetSupportedVersionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "GetSupportedVersions is not yet implemented")
+}
+
+// ParameterizeByValue parameterizes the provider with an opaque value. The provider is not parameterized.
+func (p *testcomponentProvider) ParameterizeByValue(ctx context.Context,
+	req *pulumirpc.ParameterizeByValueRequest) (*pulumirpc.ParameterizeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "ParameterizeByValue is not yet implemented")
+}
+
+// ParameterizeByReference parameterizes the provider with a package. The provider is not parameterized.
+func (p *testcomponentProvider) ParameterizeByReference(ctx context.Context,
+	req *pulumirpc.ParameterizeByReferenceRequest) (*pulumirpc.ParameterizeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "ParameterizeByReference is not yet implemented")
+}
+
+// PrepareImport returns the inputs required to import a resource. The provider does not support import.
+func (p *testcomponentProvider) PrepareImport(ctx context.Context,
+	req *pulumirpc.PrepareImportRequest) (*pulumirpc.PrepareImportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "PrepareImport is not yet implemented")
+}
+
+// ConfigChecksumMatch reports whether a config checksum matches the last validated config.
+func (p *testcomponentProvider) ConfigChecksumMatch(ctx context.Context,
+	req *pulumirpc.ConfigChecksumMatchRequest) (*pulumirpc.ConfigChecksumMatchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "ConfigChecksumMatch is not yet implemented")
+}
+
+// GetResourceAliases returns the URNs that a resource may previously have been stored under.
+func (p *testcomponentProvider) GetResourceAliases(ctx context.Context,
+	req *pulumirpc.GetResourceAliasesRequest) (*pulumirpc.GetResourceAliasesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "GetResourceAliases is not yet implemented")
+}
+
+// WaitForResourceReady blocks until a newly created resource is ready to be used.
+func (p *testcomponentProvider) WaitForResourceReady(ctx context.Context,
+	req *pulumirpc.WaitForResourceReadyRequest) (*pbempty.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "WaitForResourceReady is not yet implemented")
+}
+
+// EstimateCost estimates the monthly cost of a resource. The provider does not price its resources.
+func (p *testcomponentProvider) EstimateCost(ctx context.Context,
+	req *pulumirpc.EstimateCostRequest) (*pulumirpc.EstimateCostResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "EstimateCost is not yet implemented")
+}
+
+func (p *testcomponentProvider) StreamCreate(req *pulumirpc.CreateRequest,
+	server pulumirpc.ResourceProvider_StreamCreateServer) error {
+	resp, err := p.Create(server.Context(), req)
+	if err != nil {
+		return err
+	}
+	return server.Send(resp)
+}
+
+func (p *testcomponentProvider) ReadStream(req *pulumirpc.ReadRequest,
+	server pulumirpc.ResourceProvider_ReadStreamServer) error {
+	resp, err := p.Read(server.Context(), req)
+	if err != nil {
+		return err
+	}
+	return server.Send(resp)
+}
+
 func (p *testcomponentProvider) Update(ctx context.Context,
 	req *pulumirpc.UpdateRequest) (*pulumirpc.UpdateResponse, error) {
 	return &pulumirpc.UpdateResponse{
@@ -239,6 +325,16 @@ func (p *testcomponentProvider) Attach(ctx context.Context, req *pulumirpc.Plugi
 	return &pbempty.Empty{}, nil
 }
 
+func (p *testcomponentProvider) GetMapping(context.Context,
+	*pulumirpc.GetMappingRequest) (*pulumirpc.GetMappingResponse, error) {
+	return &pulumirpc.GetMappingResponse{}, nil
+}
+
+func (p *testcomponentProvider) SupportsFeature(context.Context,
+	*pulumirpc.ProviderSupportsFeatureRequest) (*pulumirpc.ProviderSupportsFeatureResponse, error) {
+	return &pulumirpc.ProviderSupportsFeatureResponse{}, nil
+}
+
 func (p *testcomponentProvider) GetSchema(ctx context.Context,
 	req *pulumirpc.GetSchemaRequest) (*pulumirpc.GetSchemaResponse, error) {
 	return &pulumirpc.GetSchemaResponse{}, nil
@@ -27,6 +27,8 @@ import (
 	rpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
 
 	pbempty "github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -148,6 +150,107 @@ func (k *testproviderProvider) Read(ctx context.Context, req *rpc.ReadRequest) (
 	return provider.Read(ctx, req)
 }
 
+// Refresh reads the current live state associated with a resource as part of a refresh.
+func (k *testproviderProvider) Refresh(ctx context.Context, req *rpc.ReadRequest) (*rpc.ReadResponse, error) {
+	return k.Read(ctx, req)
+}
+
+// MigrateState upgrades the stored state of a resource. The test provider's state is unversioned, so the state is
+// returned as-is.
+func (k *testproviderProvider) MigrateState(ctx context.Context,
+	req *rpc.MigrateStateRequest) (*rpc.MigrateStateResponse, error) {
+	return &rpc.MigrateStateResponse{State: req.GetState()}, nil
+}
+
+// WatchResourceChanges streams notifications of changes to the live state of resources. The provider never observes
+// such changes, so the stream stays open without sending any events until it is canceled.
+func (k *testproviderProvider) WatchResourceChanges(_ *pbempty.Empty,
+	server rpc.ResourceProvider_WatchResourceChangesServer) error {
+	<-server.Context().Done()
+	return nil
+}
+
+// GetSupportedVersions returns the schema versions that GetSchema can serve. The provider only serves version 0.
+func (k *testproviderProvider) GetSupportedVersions(ctx context.Context,
+	req *pbempty.Empty) (*rpc.GetSupportedVersionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "GetSupportedVersions is not yet implemented")
+}
+
+// ParameterizeByValue parameterizes the provider with an opaque value. The provider is not parameterized.
+func (k *testproviderProvider) ParameterizeByValue(ctx context.Context,
+	req *rpc.ParameterizeByValueRequest) (*rpc.ParameterizeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "ParameterizeByValue is not yet implemented")
+}
+
+// ParameterizeByReference parameterizes the provider with a package. The provider is not parameterized.
+func (k *testproviderProvider) ParameterizeByReference(ctx context.Context,
+	req *rpc.ParameterizeByReferenceRequest) (*rpc.ParameterizeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "ParameterizeByReference is not yet implemented")
+}
+
+// PrepareImport returns the inputs required to import a resource. The provider does not support import.
+func (k *testproviderProvider) PrepareImport(ctx context.Context,
+	req *rpc.PrepareImportRequest) (*rpc.PrepareImportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "PrepareImport is not yet implemented")
+}
+
+// ConfigChecksumMatch reports whether a config checksum matches the last validated config. The provider does not
+// cache validated config.
+func (k *testproviderProvider) ConfigChecksumMatch(ctx context.Context,
+	req *rpc.ConfigChecksumMatchRequest) (*rpc.ConfigChecksumMatchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "ConfigChecksumMatch is not yet implemented")
+}
+
+// GetResourceAliases returns the URNs that a resource may previously have been stored under. The provider has never
+// renamed its resource types, so it declares no aliases.
+func (k *testproviderProvider) GetResourceAliases(ctx context.Context,
+	req *rpc.GetResourceAliasesRequest) (*rpc.GetResourceAliasesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "GetResourceAliases is not yet implemented")
+}
+
+// WatchConfig streams the provider's configuration each time it changes outside of Pulumi. The provider's
+// configuration never changes, so the method is not implemented.
+func (k *testproviderProvider) WatchConfig(_ *pbempty.Empty, server rpc.ResourceProvider_WatchConfigServer) error {
+	return status.Error(codes.Unimplemented, "WatchConfig is not yet implemented")
+}
+
+// ValidateProvider runs the provider's pre-flight checks. The provider has no credentials to check.
+func (k *testproviderProvider) ValidateProvider(context.Context, *pbempty.Empty) (*pbempty.Empty, error) {
+	return &pbempty.Empty{}, nil
+}
+
+// WaitForResourceReady blocks until a newly created resource is ready to be used. The provider's resources are ready
+// as soon as they are created.
+func (k *testproviderProvider) WaitForResourceReady(ctx context.Context,
+	req *rpc.WaitForResourceReadyRequest) (*pbempty.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "WaitForResourceReady is not yet implemented")
+}
+
+// EstimateCost estimates the monthly cost of a resource. The provider does not price its resources.
+func (k *testproviderProvider) EstimateCost(ctx context.Context,
+	req *rpc.EstimateCostRequest) (*rpc.EstimateCostResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "EstimateCost is not yet implemented")
+}
+
+// StreamCreate allocates a new instance of the provided resource, sending it back as a single message.
+func (k *testproviderProvider) StreamCreate(req *rpc.CreateRequest,
+	server rpc.ResourceProvider_StreamCreateServer) error {
+	resp, err := k.Create(server.Context(), req)
+	if err != nil {
+		return err
+	}
+	return server.Send(resp)
+}
+
+// ReadStream reads the current live state associated with a resource, sending it back as a single message.
+func (k *testproviderProvider) ReadStream(req *rpc.ReadRequest, server rpc.ResourceProvider_ReadStreamServer) error {
+	resp, err := k.Read(server.Context(), req)
+	if err != nil {
+		return err
+	}
+	return server.Send(resp)
+}
+
 // Update updates an existing resource with new values.
 func (k *testproviderProvider) Update(ctx context.Context, req *rpc.UpdateRequest) (*rpc.UpdateResponse, error) {
 	provider, ty, ok := providerForURN(req.GetUrn())
@@ -183,6 +286,15 @@ func (k *testproviderProvider) Attach(ctx context.Context, req *rpc.PluginAttach
 	return &pbempty.Empty{}, nil
 }
 
+func (k *testproviderProvider) GetMapping(context.Context, *rpc.GetMappingRequest) (*rpc.GetMappingResponse, error) {
+	return &rpc.GetMappingResponse{}, nil
+}
+
+func (k *testproviderProvider) SupportsFeature(context.Context,
+	*rpc.ProviderSupportsFeatureRequest) (*rpc.ProviderSupportsFeatureResponse, error) {
+	return &rpc.ProviderSupportsFeatureResponse{}, nil
+}
+
 // GetSchema returns the JSON-serialized schema for the provider.
 func (k *testproviderProvider) GetSchema(ctx context.Context,
 	req *rpc.GetSchemaRequest) (*rpc.GetSchemaResponse, error) {